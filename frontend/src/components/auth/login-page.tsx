@@ -1,19 +1,54 @@
+import { useEffect, useState } from 'react'
 import { IconSlack } from '../Icons'
 import { useTranslation } from '../../i18n'
 
+interface IdentityProvider {
+  name: string
+  label: string
+}
+
+// The default provider (the first one the server lists) is reached through the
+// bare /api/auth/login, so a deployment with only Sign in with Slack keeps the
+// URL it always had.
+function loginURL(provider?: string) {
+  const params = new URLSearchParams()
+  if (provider) {
+    params.set('provider', provider)
+  }
+  const here = window.location.pathname + window.location.search + window.location.hash
+  if (here && here !== '/') {
+    params.set('return_to', here)
+  }
+  const query = params.toString()
+  return query ? `/api/auth/login?${query}` : '/api/auth/login'
+}
+
 export function LoginPage() {
   const { t } = useTranslation()
+  const [providers, setProviders] = useState<IdentityProvider[] | null>(null)
 
-  const handleLogin = () => {
-    const here = window.location.pathname + window.location.search + window.location.hash
-    if (here && here !== '/') {
-      const params = new URLSearchParams({ return_to: here })
-      window.location.href = `/api/auth/login?${params.toString()}`
-    } else {
-      window.location.href = '/api/auth/login'
+  useEffect(() => {
+    if (typeof fetch !== 'function') {
+      return
     }
+    fetch('/api/auth/providers', { credentials: 'include' })
+      .then((res) => (res.ok ? res.json() : null))
+      .then((data) => {
+        if (data && Array.isArray(data.providers) && data.providers.length > 0) {
+          setProviders(data.providers)
+        }
+      })
+      .catch(() => undefined)
+  }, [])
+
+  const handleLogin = (provider?: string) => {
+    window.location.href = loginURL(provider)
   }
 
+  // Until the provider list arrives (or when it cannot be read), offer the
+  // default sign-in, which has always been Slack's.
+  const buttons = providers ?? [{ name: 'slack', label: 'Slack' }]
+
   return (
     <div className="login-stage" style={{ minHeight: '100vh' }}>
       <div className="login-card">
@@ -22,10 +57,18 @@ export function LoginPage() {
         </div>
         <h1>{t('appName')}</h1>
         <p className="tag">{t('appSubtitle')}</p>
-        <button className="btn slack" onClick={handleLogin} style={{ margin: '0 auto' }}>
-          <IconSlack size={20} />
-          {t('btnSignInSlack')}
-        </button>
+        <div style={{ display: 'flex', flexDirection: 'column', alignItems: 'center', gap: 8 }}>
+          {buttons.map((p, i) => (
+            <button
+              key={p.name}
+              className={p.name === 'slack' ? 'btn slack' : 'btn'}
+              onClick={() => handleLogin(i === 0 ? undefined : p.name)}
+            >
+              {p.name === 'slack' && <IconSlack size={20} />}
+              {p.name === 'slack' ? t('btnSignInSlack') : t('btnSignInWith', { provider: p.label })}
+            </button>
+          ))}
+        </div>
       </div>
       <div className="login-foot">© 2026 Hecatoncheires</div>
     </div>
@@ -58,6 +58,26 @@ describe('CaseJobList', () => {
     expect(screen.getByText('0 9 * * *')).toBeInTheDocument()
   })
 
+  it('shows the field and target values of a field-change trigger', () => {
+    const severityJob: CaseJob = {
+      id: 'severity',
+      name: 'Escalate',
+      description: '',
+      strategy: 'SIMPLE',
+      quiet: false,
+      prompt: 'x',
+      trigger: {
+        caseEvents: ['FIELD_CHANGED'],
+        caseField: 'severity',
+        caseTo: ['critical', 'high'],
+        schedule: null,
+      },
+    }
+    renderList({ jobs: [severityJob] })
+    expect(screen.getByText('severity')).toBeInTheDocument()
+    expect(screen.getByText('→ critical, high')).toBeInTheDocument()
+  })
+
   it('hides the prompt until a row is expanded, then toggles it', () => {
     renderList()
     expect(screen.queryByText('TRIAGE PROMPT BODY')).toBeNull()
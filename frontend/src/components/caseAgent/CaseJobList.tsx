@@ -2,20 +2,31 @@ import { useState } from 'react'
 
 import { useTranslation } from '../../i18n'
 import {
+  IconActions,
   IconCalendar,
   IconCheck,
   IconChevRight,
+  IconEdit,
   IconPlay,
   IconPlus,
   IconRefresh,
   IconRobot,
+  IconSlack,
+  IconUser,
   IconWarn,
 } from '../Icons'
 import Button from '../Button'
 import { intervalLabel } from '../../utils/jobTrigger'
 import styles from './CaseJobList.module.css'
 
-export type CaseLifecycleEvent = 'CREATED' | 'CLOSED'
+export type CaseLifecycleEvent =
+  | 'CREATED'
+  | 'CLOSED'
+  | 'FIELD_CHANGED'
+  | 'STATUS_CHANGED'
+  | 'ASSIGNED'
+  | 'ACTION_COMPLETED'
+  | 'MESSAGE_ATTACHED'
 
 export interface JobSchedule {
   everySeconds: number | null
@@ -24,6 +35,8 @@ export interface JobSchedule {
 
 export interface JobTrigger {
   caseEvents: CaseLifecycleEvent[]
+  caseField?: string | null
+  caseTo?: string[]
   schedule: JobSchedule | null
 }
 
@@ -215,6 +228,18 @@ function JobRow({
   )
 }
 
+// TriggerTo renders the `to` narrowing of a case-change trigger: the Job fires
+// only when the new value is one of these.
+function TriggerTo({ values }: { values?: string[] }) {
+  const { t } = useTranslation()
+  if (!values || values.length === 0) return null
+  return (
+    <code className={styles.triggerFilter} title={t('caseAgentJobTriggerToTitle')}>
+      → {values.join(', ')}
+    </code>
+  )
+}
+
 function TriggerBadges({ trigger }: { trigger: JobTrigger }) {
   const { t } = useTranslation()
   const badges: React.ReactNode[] = []
@@ -234,6 +259,45 @@ function TriggerBadges({ trigger }: { trigger: JobTrigger }) {
           {t('caseAgentJobTriggerClosed')}
         </span>,
       )
+    } else if (ev === 'FIELD_CHANGED') {
+      badges.push(
+        <span key="field_changed" className={[styles.badge, styles.badgeCase].join(' ')}>
+          <IconEdit size={13} />
+          {t('caseAgentJobTriggerFieldChanged')}
+          {trigger.caseField && <code className={styles.triggerFilter}>{trigger.caseField}</code>}
+          <TriggerTo values={trigger.caseTo} />
+        </span>,
+      )
+    } else if (ev === 'STATUS_CHANGED') {
+      badges.push(
+        <span key="status_changed" className={[styles.badge, styles.badgeCase].join(' ')}>
+          <IconRefresh size={13} />
+          {t('caseAgentJobTriggerStatusChanged')}
+          <TriggerTo values={trigger.caseTo} />
+        </span>,
+      )
+    } else if (ev === 'ASSIGNED') {
+      badges.push(
+        <span key="assigned" className={[styles.badge, styles.badgeCase].join(' ')}>
+          <IconUser size={13} />
+          {t('caseAgentJobTriggerAssigned')}
+          <TriggerTo values={trigger.caseTo} />
+        </span>,
+      )
+    } else if (ev === 'ACTION_COMPLETED') {
+      badges.push(
+        <span key="action_completed" className={[styles.badge, styles.badgeCase].join(' ')}>
+          <IconActions size={13} />
+          {t('caseAgentJobTriggerActionCompleted')}
+        </span>,
+      )
+    } else if (ev === 'MESSAGE_ATTACHED') {
+      badges.push(
+        <span key="message_attached" className={[styles.badge, styles.badgeCase].join(' ')}>
+          <IconSlack size={13} />
+          {t('caseAgentJobTriggerMessageAttached')}
+        </span>,
+      )
     }
   }
 
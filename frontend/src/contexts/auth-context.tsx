@@ -10,6 +10,12 @@ interface User {
   sub: string;
   email: string;
   name: string;
+  // Identity provider the session signed in with ("slack", or the OIDC
+  // provider name).
+  provider?: string;
+  // A read-only session (an SSO user with no Slack account) can browse but
+  // every mutation is refused by the server.
+  read_only?: boolean;
 }
 
 interface AuthContextType {
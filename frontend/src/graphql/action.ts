@@ -31,6 +31,14 @@ const ACTION_FIELDS = `
     done
     total
   }
+  blockedByIDs
+  blocked
+  blockedBy {
+    id
+    workspaceId
+    title
+    status
+  }
 `
 
 export const GET_ACTIONS = gql`
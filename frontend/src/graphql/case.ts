@@ -95,6 +95,7 @@ export const GET_CASE = gql`
       ...CaseListFields
       channelUserCount
       slackChannelURL
+      mergedIntoID
       actions(filter: $actionsFilter) {
         id
         workspaceId
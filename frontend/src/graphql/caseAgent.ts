@@ -114,6 +114,8 @@ export const GET_CASE_JOBS = gql`
       prompt
       trigger {
         caseEvents
+        caseField
+        caseTo
         schedule {
           everySeconds
           cron
@@ -9,6 +9,11 @@ const KNOWLEDGE_FIELDS = gql`
       id
       name
     }
+    validUntil
+    reviewBy
+    reviewFlaggedAt
+    expired
+    reviewDue
     createdAt
     updatedAt
   }
@@ -32,15 +37,39 @@ export const GET_KNOWLEDGE = gql`
   }
 `
 
-export const SEARCH_KNOWLEDGE = gql`
+export const GET_KNOWLEDGE_DUE_FOR_REVIEW = gql`
   ${KNOWLEDGE_FIELDS}
-  query SearchKnowledge($workspaceId: String!, $query: String!, $tagIds: [ID!], $limit: Int) {
-    searchKnowledge(workspaceId: $workspaceId, query: $query, tagIds: $tagIds, limit: $limit) {
+  query GetKnowledgeDueForReview($workspaceId: String!) {
+    knowledgeDueForReview(workspaceId: $workspaceId) {
       ...KnowledgeFields
     }
   }
 `
 
+export const SEARCH_KNOWLEDGE = gql`
+  ${KNOWLEDGE_FIELDS}
+  query SearchKnowledge(
+    $workspaceId: String!
+    $query: String!
+    $tagIds: [ID!]
+    $limit: Int
+    $includeExpired: Boolean
+  ) {
+    searchKnowledge(
+      workspaceId: $workspaceId
+      query: $query
+      tagIds: $tagIds
+      limit: $limit
+      includeExpired: $includeExpired
+    ) {
+      score
+      knowledge {
+        ...KnowledgeFields
+      }
+    }
+  }
+`
+
 export const CREATE_KNOWLEDGE = gql`
   ${KNOWLEDGE_FIELDS}
   mutation CreateKnowledge($workspaceId: String!, $input: CreateKnowledgeInput!) {
@@ -70,6 +70,7 @@ export const en: Messages = {
 
   // Auth / Login
   btnSignInSlack: 'Sign in with Slack',
+  btnSignInWith: 'Sign in with {provider}',
   loginDescription: 'Authenticate using your Slack workspace',
   ariaUserMenu: 'User menu',
   btnLogout: 'Logout',
@@ -208,6 +209,7 @@ export const en: Messages = {
   placeholderSearchCaseBoard: 'Search cases by title...',
   errorMoveCase: 'Could not move the case. Please try again.',
   labelSlackThread: 'Slack thread',
+  labelMergedInto: 'Merged into #{id}',
   btnNewAction: 'New Action',
   titleActionFormNew: 'New Action',
   titleActionFormEdit: 'Edit Action',
@@ -229,6 +231,8 @@ export const en: Messages = {
   btnArchive: 'Archive',
   btnUnarchive: 'Unarchive',
   badgeArchived: 'Archived',
+  badgeBlocked: 'Blocked',
+  labelBlockedBy: 'Blocked by',
   lblViewOpenActions: 'Open',
   lblViewArchivedActions: 'Archived',
   menuArchiveColumnActions: 'Archive all in this column',
@@ -517,6 +521,12 @@ export const en: Messages = {
   caseAgentJobsRetry: 'Retry',
   caseAgentJobTriggerCreated: 'On case created',
   caseAgentJobTriggerClosed: 'On case closed',
+  caseAgentJobTriggerFieldChanged: 'On field change',
+  caseAgentJobTriggerStatusChanged: 'On status change',
+  caseAgentJobTriggerAssigned: 'On assignment',
+  caseAgentJobTriggerActionCompleted: 'On action completed',
+  caseAgentJobTriggerMessageAttached: 'On message attached',
+  caseAgentJobTriggerToTitle: 'Fires only when the new value is one of these',
   caseAgentJobEveryDays: 'Every {count}d',
   caseAgentJobEveryHours: 'Every {count}h',
   caseAgentJobEveryMinutes: 'Every {count}m',
@@ -706,6 +716,15 @@ export const en: Messages = {
   titleDeleteKnowledge: 'Delete Knowledge',
   msgDeleteKnowledgeConfirm: 'Are you sure you want to delete <strong>{title}</strong>?',
   warningDeleteKnowledgePermanent: 'This action cannot be undone.',
+  knowledgeFilterNeedsReview: 'Needs review ({count})',
+  emptyKnowledgeReview: 'No knowledge entries are due for review.',
+  badgeKnowledgeExpired: 'Expired',
+  badgeKnowledgeReviewDue: 'Review due',
+  labelKnowledgeValidUntil: 'Valid until',
+  labelKnowledgeReviewBy: 'Review by',
+  hintKnowledgeReviewDates: 'Agents stop seeing the entry after its valid-until date. The creator is notified in Slack when either date is reached.',
+  msgKnowledgeExpired: 'This entry has expired and is hidden from agent search. Update it and move the valid-until date, or delete it.',
+  msgKnowledgeReviewDue: 'This entry is due for review. Confirm it is still accurate, then move the review date.',
   btnSaveHint: '⌘+Enter to save',
 
   // Case reference field
@@ -70,6 +70,7 @@ export const ja: Messages = {
 
   // Auth / Login
   btnSignInSlack: 'Slack でサインイン',
+  btnSignInWith: '{provider} でサインイン',
   loginDescription: 'Slack ワークスペースで認証します',
   ariaUserMenu: 'ユーザーメニュー',
   btnLogout: 'ログアウト',
@@ -208,6 +209,7 @@ export const ja: Messages = {
   placeholderSearchCaseBoard: 'タイトルでケースを検索...',
   errorMoveCase: 'ケースを移動できませんでした。もう一度お試しください。',
   labelSlackThread: 'Slack スレッド',
+  labelMergedInto: '#{id} に統合済み',
   btnNewAction: '新規アクション',
   titleActionFormNew: '新規アクション',
   titleActionFormEdit: 'アクションを編集',
@@ -229,6 +231,8 @@ export const ja: Messages = {
   btnArchive: 'アーカイブ',
   btnUnarchive: 'アーカイブ解除',
   badgeArchived: 'アーカイブ済み',
+  badgeBlocked: '待ち',
+  labelBlockedBy: '待ち合わせ先',
   lblViewOpenActions: '進行中',
   lblViewArchivedActions: 'アーカイブ済み',
   menuArchiveColumnActions: 'この列をすべてアーカイブ',
@@ -517,6 +521,12 @@ export const ja: Messages = {
   caseAgentJobsRetry: '再試行',
   caseAgentJobTriggerCreated: 'ケース作成時',
   caseAgentJobTriggerClosed: 'ケースクローズ時',
+  caseAgentJobTriggerFieldChanged: 'フィールド変更時',
+  caseAgentJobTriggerStatusChanged: 'ステータス変更時',
+  caseAgentJobTriggerAssigned: '担当者アサイン時',
+  caseAgentJobTriggerActionCompleted: 'アクション完了時',
+  caseAgentJobTriggerMessageAttached: 'メッセージ添付時',
+  caseAgentJobTriggerToTitle: '新しい値がこれらのいずれかの場合のみ実行',
   caseAgentJobEveryDays: '{count}日ごと',
   caseAgentJobEveryHours: '{count}時間ごと',
   caseAgentJobEveryMinutes: '{count}分ごと',
@@ -706,6 +716,15 @@ export const ja: Messages = {
   titleDeleteKnowledge: 'ナレッジを削除',
   msgDeleteKnowledgeConfirm: '<strong>{title}</strong> を削除してもよろしいですか？',
   warningDeleteKnowledgePermanent: 'この操作は取り消せません。',
+  knowledgeFilterNeedsReview: '要レビュー ({count})',
+  emptyKnowledgeReview: 'レビュー期日を迎えたナレッジはありません。',
+  badgeKnowledgeExpired: '期限切れ',
+  badgeKnowledgeReviewDue: '要レビュー',
+  labelKnowledgeValidUntil: '有効期限',
+  labelKnowledgeReviewBy: 'レビュー期日',
+  hintKnowledgeReviewDates: '有効期限を過ぎるとエージェントの検索結果に含まれなくなります。いずれかの日付に達すると作成者に Slack で通知されます。',
+  msgKnowledgeExpired: 'このナレッジは有効期限切れのため、エージェントの検索結果から除外されています。内容を更新して有効期限を延ばすか、削除してください。',
+  msgKnowledgeReviewDue: 'このナレッジはレビュー期日を迎えています。内容が正しいか確認し、レビュー期日を更新してください。',
   btnSaveHint: '⌘+Enter で保存',
 
   // Case reference field
@@ -68,6 +68,7 @@ export const msgKeys = {
 
   // Auth / Login
   btnSignInSlack: 'btnSignInSlack',
+  btnSignInWith: 'btnSignInWith',
   loginDescription: 'loginDescription',
   ariaUserMenu: 'ariaUserMenu',
   btnLogout: 'btnLogout',
@@ -206,6 +207,7 @@ export const msgKeys = {
   placeholderSearchCaseBoard: 'placeholderSearchCaseBoard',
   errorMoveCase: 'errorMoveCase',
   labelSlackThread: 'labelSlackThread',
+  labelMergedInto: 'labelMergedInto',
   btnNewAction: 'btnNewAction',
   titleActionFormNew: 'titleActionFormNew',
   titleActionFormEdit: 'titleActionFormEdit',
@@ -227,6 +229,8 @@ export const msgKeys = {
   btnArchive: 'btnArchive',
   btnUnarchive: 'btnUnarchive',
   badgeArchived: 'badgeArchived',
+  badgeBlocked: 'badgeBlocked',
+  labelBlockedBy: 'labelBlockedBy',
   lblViewOpenActions: 'lblViewOpenActions',
   lblViewArchivedActions: 'lblViewArchivedActions',
   menuArchiveColumnActions: 'menuArchiveColumnActions',
@@ -516,6 +520,12 @@ export const msgKeys = {
   caseAgentJobsRetry: 'caseAgentJobsRetry',
   caseAgentJobTriggerCreated: 'caseAgentJobTriggerCreated',
   caseAgentJobTriggerClosed: 'caseAgentJobTriggerClosed',
+  caseAgentJobTriggerFieldChanged: 'caseAgentJobTriggerFieldChanged',
+  caseAgentJobTriggerStatusChanged: 'caseAgentJobTriggerStatusChanged',
+  caseAgentJobTriggerAssigned: 'caseAgentJobTriggerAssigned',
+  caseAgentJobTriggerActionCompleted: 'caseAgentJobTriggerActionCompleted',
+  caseAgentJobTriggerMessageAttached: 'caseAgentJobTriggerMessageAttached',
+  caseAgentJobTriggerToTitle: 'caseAgentJobTriggerToTitle',
   caseAgentJobEveryDays: 'caseAgentJobEveryDays',
   caseAgentJobEveryHours: 'caseAgentJobEveryHours',
   caseAgentJobEveryMinutes: 'caseAgentJobEveryMinutes',
@@ -701,6 +711,15 @@ export const msgKeys = {
   titleDeleteKnowledge: 'titleDeleteKnowledge',
   msgDeleteKnowledgeConfirm: 'msgDeleteKnowledgeConfirm',
   warningDeleteKnowledgePermanent: 'warningDeleteKnowledgePermanent',
+  knowledgeFilterNeedsReview: 'knowledgeFilterNeedsReview',
+  emptyKnowledgeReview: 'emptyKnowledgeReview',
+  badgeKnowledgeExpired: 'badgeKnowledgeExpired',
+  badgeKnowledgeReviewDue: 'badgeKnowledgeReviewDue',
+  labelKnowledgeValidUntil: 'labelKnowledgeValidUntil',
+  labelKnowledgeReviewBy: 'labelKnowledgeReviewBy',
+  hintKnowledgeReviewDates: 'hintKnowledgeReviewDates',
+  msgKnowledgeExpired: 'msgKnowledgeExpired',
+  msgKnowledgeReviewDue: 'msgKnowledgeReviewDue',
   btnSaveHint: 'btnSaveHint',
 
   // Case reference field
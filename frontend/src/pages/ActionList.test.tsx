@@ -83,6 +83,9 @@ const actionRow = (
   createdAt: '2026-05-01T00:00:00Z',
   updatedAt: '2026-05-01T00:00:00Z',
   stepProgress: { __typename: 'StepProgress', done: 0, total: 0 },
+  blockedByIDs: [],
+  blocked: false,
+  blockedBy: [],
 })
 
 const allOpenActions = [
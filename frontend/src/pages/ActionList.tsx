@@ -39,6 +39,7 @@ interface ActionRow {
   dueDate?: string | null
   createdAt: string
   stepProgress?: { done: number; total: number }
+  blocked?: boolean
 }
 
 function formatDue(iso?: string | null) {
@@ -391,6 +392,11 @@ export default function ActionList() {
                           {a.stepProgress.done}/{a.stepProgress.total}
                         </span>
                       )}
+                      {a.blocked && (
+                        <span className={styles.blockedBadge} data-testid="action-card-blocked">
+                          {t('badgeBlocked')}
+                        </span>
+                      )}
                       <span className="spacer" />
                       <span className="mono" style={{ fontSize: 11 }}>{formatDue(a.dueDate)}</span>
                     </div>
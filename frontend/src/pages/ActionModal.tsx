@@ -127,6 +127,15 @@ export default function ActionModal({ actionId, onClose }: ActionModalProps) {
           {t('badgeArchived')}
         </span>
       )}
+      {action?.blocked && (
+        <span
+          className="badge"
+          data-testid="action-blocked-badge"
+          style={{ fontSize: 10, flex: '0 0 auto', color: 'var(--danger)' }}
+        >
+          {t('badgeBlocked')}
+        </span>
+      )}
       {savedFlash && (
         <span className="badge open" style={{ fontSize: 10, flex: '0 0 auto' }}>
           <IconCheck size={9} sw={2.5} />
@@ -318,6 +327,18 @@ export default function ActionModal({ actionId, onClose }: ActionModalProps) {
                 />
               </div>
             </div>
+            {action.blockedBy?.length > 0 && (
+              <div className="row" style={{ gap: 'var(--sp-4)', alignItems: 'baseline' }}>
+                <span className="soft" style={{ width: 78, flexShrink: 0, fontSize: 12, whiteSpace: 'nowrap' }}>{t('labelBlockedBy')}</span>
+                <div style={{ flex: 1, minWidth: 0, fontSize: 13 }} data-testid="action-blocked-by">
+                  {action.blockedBy.map((b: { id: number; title: string; status: string }) => (
+                    <div key={b.id}>
+                      #{b.id} {b.title} <span className="soft">· {statusLabel(b.status)}</span>
+                    </div>
+                  ))}
+                </div>
+              </div>
+            )}
           </div>
 
           <div style={{ marginBottom: 'var(--sp-8)' }}>
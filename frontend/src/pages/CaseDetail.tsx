@@ -867,6 +867,14 @@ export default function CaseDetail() {
                 />
               )}
             </div>
+            {c.mergedIntoID != null && (
+              <Link
+                data-testid="aside-merged-into"
+                to={`/ws/${currentWorkspace!.id}/cases/${c.mergedIntoID}`}
+              >
+                {t('labelMergedInto', { id: c.mergedIntoID })}
+              </Link>
+            )}
           </section>
 
           {c.status !== 'DRAFT' && (
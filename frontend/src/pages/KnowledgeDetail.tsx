@@ -27,6 +27,15 @@ function formatDateTime(iso: string) {
   return d.toLocaleString()
 }
 
+// The review dates are edited as plain dates and stored as UTC midnight.
+function toDateInput(iso: string | null | undefined): string {
+  return iso ? iso.slice(0, 10) : ''
+}
+
+function fromDateInput(date: string): string | undefined {
+  return /^\d{4}-\d{2}-\d{2}$/.test(date) ? `${date}T00:00:00Z` : undefined
+}
+
 export default function KnowledgeDetail() {
   const { id } = useParams<{ id: string }>()
   const navigate = useNavigate()
@@ -39,6 +48,8 @@ export default function KnowledgeDetail() {
   const [title, setTitle] = useState('')
   const [claim, setClaim] = useState('')
   const [tags, setTags] = useState<string[]>([])
+  const [validUntil, setValidUntil] = useState('')
+  const [reviewBy, setReviewBy] = useState('')
   const [previewMode, setPreviewMode] = useState(false)
   const [showDelete, setShowDelete] = useState(false)
   const [saving, setSaving] = useState(false)
@@ -68,6 +79,8 @@ export default function KnowledgeDetail() {
       setTitle(data.knowledge.title)
       setClaim(data.knowledge.claim ?? '')
       setTags(data.knowledge.tags.map((tag: { id: string }) => tag.id))
+      setValidUntil(toDateInput(data.knowledge.validUntil))
+      setReviewBy(toDateInput(data.knowledge.reviewBy))
       setInitialized(true)
     }
   }, [data, initialized])
@@ -113,7 +126,13 @@ export default function KnowledgeDetail() {
         const result = await createKnowledge({
           variables: {
             workspaceId: currentWorkspace.id,
-            input: { title: title.trim(), claim: claim || undefined, tagIds: tags },
+            input: {
+              title: title.trim(),
+              claim: claim || undefined,
+              tagIds: tags,
+              validUntil: fromDateInput(validUntil),
+              reviewBy: fromDateInput(reviewBy),
+            },
           },
         })
         const newId = result.data?.createKnowledge?.id
@@ -121,10 +140,23 @@ export default function KnowledgeDetail() {
           navigate(`/ws/${currentWorkspace.id}/knowledge/${newId}`, { replace: true })
         }
       } else {
+        // Send a date only when it changed, so an ordinary save does not touch
+        // the review state; an emptied date is cleared explicitly.
+        const dates: Record<string, string | boolean> = {}
+        if (validUntil !== toDateInput(knowledge?.validUntil)) {
+          const v = fromDateInput(validUntil)
+          if (v) dates.validUntil = v
+          else dates.clearValidUntil = true
+        }
+        if (reviewBy !== toDateInput(knowledge?.reviewBy)) {
+          const v = fromDateInput(reviewBy)
+          if (v) dates.reviewBy = v
+          else dates.clearReviewBy = true
+        }
         await updateKnowledge({
           variables: {
             workspaceId: currentWorkspace.id,
-            input: { id: id!, title: title.trim(), claim: claim || undefined, tagIds: tags },
+            input: { id: id!, title: title.trim(), claim: claim || undefined, tagIds: tags, ...dates },
           },
         })
       }
@@ -335,6 +367,44 @@ export default function KnowledgeDetail() {
               </div>
             )}
           </div>
+
+          {/* Review dates */}
+          <div className="card" style={{ padding: '14px 16px' }}>
+            {knowledge?.expired ? (
+              <div style={{ color: 'var(--danger)', fontSize: 'var(--t-xs)', marginBottom: 8 }}>
+                {t('msgKnowledgeExpired')}
+              </div>
+            ) : knowledge?.reviewDue ? (
+              <div style={{ color: 'var(--warn)', fontSize: 'var(--t-xs)', marginBottom: 8 }}>
+                {t('msgKnowledgeReviewDue')}
+              </div>
+            ) : null}
+            <label className="field-label" htmlFor="knowledge-valid-until">
+              {t('labelKnowledgeValidUntil')}
+            </label>
+            <input
+              id="knowledge-valid-until"
+              type="date"
+              value={validUntil}
+              onChange={(e) => setValidUntil(e.target.value)}
+              style={{ width: '100%', marginBottom: 10 }}
+              data-testid="knowledge-valid-until-input"
+            />
+            <label className="field-label" htmlFor="knowledge-review-by">
+              {t('labelKnowledgeReviewBy')}
+            </label>
+            <input
+              id="knowledge-review-by"
+              type="date"
+              value={reviewBy}
+              onChange={(e) => setReviewBy(e.target.value)}
+              style={{ width: '100%' }}
+              data-testid="knowledge-review-by-input"
+            />
+            <div className="soft" style={{ fontSize: 'var(--t-xs)', marginTop: 6 }}>
+              {t('hintKnowledgeReviewDates')}
+            </div>
+          </div>
         </div>
       </div>
 
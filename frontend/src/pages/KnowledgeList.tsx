@@ -1,7 +1,7 @@
 import { useState, useEffect } from 'react'
 import { useNavigate } from 'react-router'
 import { useQuery } from '@apollo/client'
-import { GET_KNOWLEDGES, SEARCH_KNOWLEDGE } from '../graphql/knowledge'
+import { GET_KNOWLEDGES, GET_KNOWLEDGE_DUE_FOR_REVIEW, SEARCH_KNOWLEDGE } from '../graphql/knowledge'
 import { GET_TAGS } from '../graphql/tag'
 import { useWorkspace } from '../contexts/workspace-context'
 import { useTranslation } from '../i18n'
@@ -18,6 +18,10 @@ interface KnowledgeRow {
   title: string
   claim: string
   tags: TagRef[]
+  validUntil: string | null
+  reviewBy: string | null
+  expired: boolean
+  reviewDue: boolean
   createdAt: string
   updatedAt: string
 }
@@ -68,6 +72,7 @@ export default function KnowledgeList() {
   const [searchQuery, setSearchQuery] = useState('')
   const [selectedTagIds, setSelectedTagIds] = useState<string[]>([])
   const [debouncedQuery, setDebouncedQuery] = useState('')
+  const [reviewOnly, setReviewOnly] = useState(false)
 
   // Debounce search input
   useEffect(() => {
@@ -82,7 +87,12 @@ export default function KnowledgeList() {
 
   const { data: listData, loading: listLoading } = useQuery(GET_KNOWLEDGES, {
     variables: { workspaceId: currentWorkspace?.id, tagIds: selectedTagIds.length > 0 ? selectedTagIds : undefined },
-    skip: !currentWorkspace || debouncedQuery.trim().length > 0,
+    skip: !currentWorkspace || debouncedQuery.trim().length > 0 || reviewOnly,
+  })
+
+  const { data: reviewData, loading: reviewLoading } = useQuery(GET_KNOWLEDGE_DUE_FOR_REVIEW, {
+    variables: { workspaceId: currentWorkspace?.id },
+    skip: !currentWorkspace,
   })
 
   const { data: searchData, loading: searchLoading } = useQuery(SEARCH_KNOWLEDGE, {
@@ -91,15 +101,21 @@ export default function KnowledgeList() {
       query: debouncedQuery,
       tagIds: selectedTagIds.length > 0 ? selectedTagIds : undefined,
       limit: 50,
+      // The list shows expired entries (badged), so search does too; only
+      // agents get them filtered out by default.
+      includeExpired: true,
     },
-    skip: !currentWorkspace || debouncedQuery.trim().length === 0,
+    skip: !currentWorkspace || debouncedQuery.trim().length === 0 || reviewOnly,
   })
 
   const isSearching = debouncedQuery.trim().length > 0
-  const loading = isSearching ? searchLoading : listLoading
-  const knowledges: KnowledgeRow[] = isSearching
-    ? (searchData?.searchKnowledge ?? [])
-    : (listData?.knowledges ?? [])
+  const dueForReview: KnowledgeRow[] = reviewData?.knowledgeDueForReview ?? []
+  const loading = reviewOnly ? reviewLoading : isSearching ? searchLoading : listLoading
+  const knowledges: KnowledgeRow[] = reviewOnly
+    ? dueForReview
+    : isSearching
+      ? (searchData?.searchKnowledge ?? []).map((hit: { knowledge: KnowledgeRow }) => hit.knowledge)
+      : (listData?.knowledges ?? [])
   const allTags: TagRef[] = tagsData?.tags ?? []
 
   const toggleTag = (tagId: string) => {
@@ -140,8 +156,29 @@ export default function KnowledgeList() {
         />
       </div>
 
+      {/* Review filter: entries expired or past their review date */}
+      {dueForReview.length > 0 && (
+        <div className="row" style={{ gap: 6, marginBottom: 12 }}>
+          <button
+            type="button"
+            className="chip"
+            onClick={() => setReviewOnly((v) => !v)}
+            data-testid="knowledge-review-filter"
+            style={{
+              cursor: 'pointer',
+              background: reviewOnly ? 'var(--warn)' : undefined,
+              color: reviewOnly ? 'var(--bg-elev)' : undefined,
+              border: 'none',
+              fontWeight: reviewOnly ? 600 : undefined,
+            }}
+          >
+            {t('knowledgeFilterNeedsReview', { count: dueForReview.length })}
+          </button>
+        </div>
+      )}
+
       {/* Tag filter chips */}
-      {allTags.length > 0 && (
+      {!reviewOnly && allTags.length > 0 && (
         <div className="row" style={{ flexWrap: 'wrap', gap: 6, marginBottom: 16 }}>
           <button
             type="button"
@@ -189,7 +226,7 @@ export default function KnowledgeList() {
 
       {!loading && knowledges.length === 0 && (
         <div style={{ padding: 48, textAlign: 'center', color: 'var(--fg-soft)' }}>
-          {isSearching ? t('emptyKnowledgeSearch') : t('emptyKnowledge')}
+          {reviewOnly ? t('emptyKnowledgeReview') : isSearching ? t('emptyKnowledgeSearch') : t('emptyKnowledge')}
         </div>
       )}
 
@@ -202,8 +239,23 @@ export default function KnowledgeList() {
               style={{ padding: '14px 16px', cursor: 'pointer' }}
               onClick={() => navigate(`/ws/${currentWorkspace!.id}/knowledge/${k.id}`)}
             >
-              <div style={{ fontWeight: 600, fontSize: 'var(--t-base)', marginBottom: 4 }}>
-                {k.title}
+              <div className="row" style={{ gap: 8, alignItems: 'center', marginBottom: 4 }}>
+                <span style={{ fontWeight: 600, fontSize: 'var(--t-base)' }}>{k.title}</span>
+                {k.expired ? (
+                  <span
+                    className="chip"
+                    style={{ fontSize: 'var(--t-xs)', background: 'var(--danger)', color: 'var(--bg-elev)' }}
+                  >
+                    {t('badgeKnowledgeExpired')}
+                  </span>
+                ) : k.reviewDue ? (
+                  <span
+                    className="chip"
+                    style={{ fontSize: 'var(--t-xs)', background: 'var(--warn)', color: 'var(--bg-elev)' }}
+                  >
+                    {t('badgeKnowledgeReviewDue')}
+                  </span>
+                ) : null}
               </div>
               {k.claim && (
                 <div
@@ -7,6 +7,7 @@ package policy
 
 import (
 	"context"
+	"errors"
 
 	"github.com/m-mizutani/goerr/v2"
 	"github.com/m-mizutani/opaq"
@@ -44,9 +45,13 @@ func New(filePaths []string) (interfaces.PolicyClient, error) {
 // out. The opaq client logs the input at debug level through the project
 // logger, so the Authorization header and the env allow-list are redacted by
 // the logger's masq configuration. We therefore do not attach input to the
-// error context here.
+// error context here. A query the policy defines nothing for is reported as
+// interfaces.ErrPolicyUndefined.
 func (c *client) Query(ctx context.Context, query string, input, out any) error {
 	if err := c.opaq.Query(ctx, query, input, out); err != nil {
+		if errors.Is(err, opaq.ErrNoEvalResult) {
+			return goerr.Wrap(interfaces.ErrPolicyUndefined, "Rego query produced no result", goerr.V("query", query))
+		}
 		return goerr.Wrap(err, "failed to evaluate Rego query", goerr.V("query", query))
 	}
 	return nil
@@ -6,6 +6,7 @@ import (
 
 	"github.com/m-mizutani/gt"
 	"github.com/secmon-lab/hecatoncheires/pkg/adapter/policy"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/authz"
 )
 
@@ -71,3 +72,38 @@ func TestQuery_DenyWithoutAuthorizationHeader(t *testing.T) {
 	gt.NoError(t, c.Query(ctx, "data.auth.mcp", in, &result))
 	gt.Value(t, result.Allow).Equal(false)
 }
+
+func TestQuery_UndefinedEntrypointIsErrPolicyUndefined(t *testing.T) {
+	c := newClient(t)
+	// sample.rego defines only data.auth.mcp.
+	var decision authz.Decision
+	err := c.Query(context.Background(), authz.MutationQuery, authz.OperationInput{Operation: "closeCase"}, &decision)
+	gt.Error(t, err).Is(interfaces.ErrPolicyUndefined)
+}
+
+func TestQuery_OperationPolicy(t *testing.T) {
+	c, err := policy.New([]string{"testdata/operation.rego", "testdata/operation_graphql.rego"})
+	gt.NoError(t, err).Required()
+	ctx := context.Background()
+
+	job := authz.Actor{Kind: authz.ActorJob, JobID: "triage"}
+	var decision authz.Decision
+	gt.NoError(t, c.Query(ctx, authz.AgentToolQuery, authz.OperationInput{
+		Operation: "case__close_case", WorkspaceID: "prod", Actor: job,
+	}, &decision))
+	gt.Value(t, decision.Allow).Equal(false)
+	gt.Value(t, decision.Reason).Equal("jobs may not close cases in prod")
+
+	decision = authz.Decision{}
+	gt.NoError(t, c.Query(ctx, authz.AgentToolQuery, authz.OperationInput{
+		Operation: "case__close_case", WorkspaceID: "dev", Actor: job,
+	}, &decision))
+	gt.Value(t, decision.Allow).Equal(true)
+
+	decision = authz.Decision{}
+	gt.NoError(t, c.Query(ctx, authz.MutationQuery, authz.OperationInput{
+		Operation: "deleteKnowledge", WorkspaceID: "prod",
+		Actor: authz.Actor{Kind: authz.ActorUser, UserID: "U0ADMIN"},
+	}, &decision))
+	gt.Value(t, decision.Allow).Equal(true)
+}
@@ -0,0 +1,15 @@
+package auth.tool
+
+# Agent tool calls: everything is allowed except closing a case from a Job in
+# the prod workspace.
+default allow := false
+
+deny_reason := "jobs may not close cases in prod" if {
+	input.operation == "case__close_case"
+	input.actor.kind == "job"
+	input.workspace_id == "prod"
+}
+
+allow if not deny_reason
+
+reason := deny_reason
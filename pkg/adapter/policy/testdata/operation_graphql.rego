@@ -0,0 +1,15 @@
+package auth.graphql
+
+# GraphQL mutations: only the listed admins may delete knowledge.
+admins := {"U0ADMIN"}
+
+default allow := false
+
+allow if input.operation != "deleteKnowledge"
+
+allow if {
+	input.operation == "deleteKnowledge"
+	input.actor.user_id in admins
+}
+
+reason := "only admins may delete knowledge" if not allow
@@ -0,0 +1,104 @@
+package interaction
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/m-mizutani/goerr/v2"
+)
+
+// ErrApprovalRequired is the error a gated tool call fails with when it reaches
+// the tool without a recorded decision. The strategies ask before they call, so
+// seeing it means a caller bypassed the gate — the call is refused rather than
+// run unapproved.
+var ErrApprovalRequired = errors.New("tool call requires human approval")
+
+// ErrApprovalDenied is the error a gated tool call fails with when the person
+// asked declined it. It reaches the model as the call's result, so the run can
+// report the refusal instead of retrying blind.
+var ErrApprovalDenied = errors.New("tool call was denied by a human reviewer")
+
+// ApprovalRequest describes one tool call waiting for a human decision.
+type ApprovalRequest struct {
+	// Tool is the tool name the model called (e.g. "case__close_case").
+	Tool string `json:"tool"`
+	// Arguments are the call's arguments, exactly as the tool will receive them.
+	Arguments map[string]any `json:"arguments,omitempty"`
+}
+
+// ApprovalTicket is what a decision must be delivered back to: the suspended
+// Process and the await it is parked on. The host records it next to the prompt
+// it posts, because the decision arrives later, out of band, on an instance that
+// never saw the call.
+type ApprovalTicket struct {
+	ProcessID string
+	AwaitKey  string
+}
+
+// Approval is a human's decision on one ApprovalRequest.
+type Approval struct {
+	Approved bool   `json:"approved"`
+	UserID   string `json:"user_id,omitempty"`
+	// Reason explains a denial the reviewer did not make themselves, e.g. a
+	// prompt that could not be delivered.
+	Reason string `json:"reason,omitempty"`
+}
+
+// Encode renders the decision as the await response a host delivers.
+func (a Approval) Encode() ([]byte, error) {
+	raw, err := json.Marshal(a)
+	if err != nil {
+		return nil, goerr.Wrap(err, "encode approval")
+	}
+	return raw, nil
+}
+
+// DecodeApproval reads back an await response produced by Approval.Encode.
+func DecodeApproval(raw []byte) (Approval, error) {
+	var a Approval
+	if err := json.Unmarshal(raw, &a); err != nil {
+		return Approval{}, goerr.Wrap(err, "decode approval")
+	}
+	return a, nil
+}
+
+// ApprovalGate is the host port that decides which tool calls wait for a human
+// and delivers the prompt for them. It is bound to one run by the host, so
+// neither method takes the run's identity.
+type ApprovalGate interface {
+	// Requires reports whether a call to tool must be approved before it runs.
+	Requires(ctx context.Context, tool string) bool
+	// Request delivers the approval prompt for req. It must not block on the
+	// decision: the run suspends after it returns and resumes when the host
+	// responds to ticket.
+	Request(ctx context.Context, ticket ApprovalTicket, req ApprovalRequest) error
+}
+
+type approvalGateKey struct{}
+
+// WithApprovalGate makes gate available to the strategy steps run under ctx.
+func WithApprovalGate(ctx context.Context, gate ApprovalGate) context.Context {
+	return context.WithValue(ctx, approvalGateKey{}, gate)
+}
+
+// ApprovalGateFrom returns the gate installed by WithApprovalGate, or nil when
+// the run has none — in which case no call waits.
+func ApprovalGateFrom(ctx context.Context) ApprovalGate {
+	g, _ := ctx.Value(approvalGateKey{}).(ApprovalGate)
+	return g
+}
+
+type approvalKey struct{ callID string }
+
+// WithApproval records the decision for the tool call callID, so the enforcing
+// middleware can tell an approved call from one that never asked.
+func WithApproval(ctx context.Context, callID string, a Approval) context.Context {
+	return context.WithValue(ctx, approvalKey{callID: callID}, a)
+}
+
+// ApprovalFor returns the decision recorded for callID, if any.
+func ApprovalFor(ctx context.Context, callID string) (Approval, bool) {
+	a, ok := ctx.Value(approvalKey{callID: callID}).(Approval)
+	return a, ok
+}
@@ -1,6 +1,7 @@
 package interaction_test
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -165,3 +166,29 @@ func TestRequest_Validate(t *testing.T) {
 		gt.Error(t, r.Validate())
 	})
 }
+
+func TestApproval(t *testing.T) {
+	t.Run("round-trips through the await response", func(t *testing.T) {
+		raw, err := interaction.Approval{Approved: true, UserID: "U1"}.Encode()
+		gt.NoError(t, err).Required()
+		got, err := interaction.DecodeApproval(raw)
+		gt.NoError(t, err).Required()
+		gt.Value(t, got).Equal(interaction.Approval{Approved: true, UserID: "U1"})
+
+		_, err = interaction.DecodeApproval([]byte("yes"))
+		gt.Error(t, err)
+	})
+
+	t.Run("a decision belongs to one call", func(t *testing.T) {
+		ctx := interaction.WithApproval(context.Background(), "c1", interaction.Approval{Approved: true})
+		a, ok := interaction.ApprovalFor(ctx, "c1")
+		gt.Bool(t, ok).True()
+		gt.Bool(t, a.Approved).True()
+		_, ok = interaction.ApprovalFor(ctx, "c2")
+		gt.Bool(t, ok).False()
+	})
+
+	t.Run("no gate is installed by default", func(t *testing.T) {
+		gt.Value(t, interaction.ApprovalGateFrom(context.Background())).Equal(nil)
+	})
+}
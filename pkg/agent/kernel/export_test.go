@@ -1,6 +1,8 @@
 package kernel
 
 import (
+	"context"
+
 	"github.com/gollem-dev/agentkit"
 	"github.com/gollem-dev/gollem"
 
@@ -93,3 +95,21 @@ const (
 func ToolErrorValuesHandlerForTest(next agentkit.ToolCallHandler) agentkit.ToolCallHandler {
 	return toolErrorValuesMiddleware()(next)
 }
+
+// ToolPolicyHandlerForTest applies the policy middleware to next, running it
+// under sc the way a claim would.
+func ToolPolicyHandlerForTest(a ToolAuthorizer, sc Scope, next agentkit.ToolCallHandler) agentkit.ToolCallHandler {
+	h := toolPolicyMiddleware(a)(next)
+	return func(ctx context.Context, req *agentkit.ToolCallRequest) (map[string]any, error) {
+		return h(withScope(ctx, sc), req)
+	}
+}
+
+// ToolApprovalHandlerForTest applies the approval middleware to next, running it
+// under sc the way a claim would.
+func ToolApprovalHandlerForTest(a ToolApprovals, sc Scope, next agentkit.ToolCallHandler) agentkit.ToolCallHandler {
+	h := toolApprovalMiddleware(a)(next)
+	return func(ctx context.Context, req *agentkit.ToolCallRequest) (map[string]any, error) {
+		return h(withScope(ctx, sc), req)
+	}
+}
@@ -177,6 +177,12 @@ func Build(d Deps) (*agentkit.Kernel, error) {
 		// feedback middlewares cover disjoint error classes — argument rejections
 		// against everything else — so neither renders into the other's output.
 		agentkit.WithToolCallMiddleware(toolErrorValuesMiddleware()),
+		// Inside the trace bracket, so a refused call still appears on the timeline
+		// and in the archive, with the refusal as its error.
+		agentkit.WithToolCallMiddleware(toolPolicyMiddleware(d.Tools.Authorizer)),
+		// Inside the policy: a call the policy refuses is refused whatever a
+		// person decided, and the timeline records either refusal the same way.
+		agentkit.WithToolCallMiddleware(toolApprovalMiddleware(d.Tools.Approvals)),
 		agentkit.WithLogger(logging.Default()),
 	}
 	// One role binding per model a Job may name. They can only be given here:
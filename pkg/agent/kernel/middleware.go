@@ -18,8 +18,10 @@ import (
 	"github.com/m-mizutani/masq"
 
 	"github.com/secmon-lab/hecatoncheires/pkg/agent/agenttrace"
+	"github.com/secmon-lab/hecatoncheires/pkg/agent/interaction"
 	"github.com/secmon-lab/hecatoncheires/pkg/agent/runtrace"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/authz"
 	"github.com/secmon-lab/hecatoncheires/pkg/i18n"
 	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
 	"github.com/secmon-lab/hecatoncheires/pkg/utils/logging"
@@ -39,6 +41,20 @@ func traceHandlerFrom(ctx context.Context) trace.Handler {
 	return h
 }
 
+type scopeKey struct{}
+
+// withScope stashes the claim's Scope for the tool-call middlewares, which see
+// only the call and not the Process it belongs to. Claim-scoped, like the trace
+// handler above.
+func withScope(ctx context.Context, sc Scope) context.Context {
+	return context.WithValue(ctx, scopeKey{}, sc)
+}
+
+func scopeFrom(ctx context.Context) Scope {
+	sc, _ := ctx.Value(scopeKey{}).(Scope)
+	return sc
+}
+
 // claimMiddleware brackets a worker's whole run on one Process. It is where the
 // request-scoped context a transition needs is assembled — logger fields, the
 // user's language, the access actor — and where the trace sinks are opened and
@@ -74,6 +90,12 @@ func claimMiddleware(d Deps) agentkit.ClaimMiddleware {
 			if sc.ActorUserID != "" {
 				ctx = auth.ContextWithToken(ctx, &auth.Token{Sub: sc.ActorUserID})
 			}
+			ctx = withScope(ctx, sc)
+			// The strategies find the gate through the context; bound to this
+			// claim's scope, so the prompt lands in this run's thread.
+			if d.Tools.Approvals != nil {
+				ctx = interaction.WithApprovalGate(ctx, approvalGate{approvals: d.Tools.Approvals, sc: sc})
+			}
 
 			recorder := trace.New(
 				trace.WithRepository(d.Trace),
@@ -275,6 +297,102 @@ func toolCallMiddleware() agentkit.ToolCallMiddleware {
 	}
 }
 
+// toolPolicyMiddleware asks the operator's policy whether a tool call may run,
+// before it runs. The toolsets already decide which tools a run HAS; this
+// decides, per call, whether a rule the compliance team wrote lets this actor
+// use one here — "no Job closes a case in the prod workspace" is a statement
+// about the arguments and the actor, not about the palette.
+//
+// A refusal is returned as the call's error rather than ending the run: the
+// model is told the call was denied (and why, when the policy gave a reason)
+// and can report that instead of retrying blind.
+func toolPolicyMiddleware(a ToolAuthorizer) agentkit.ToolCallMiddleware {
+	return func(next agentkit.ToolCallHandler) agentkit.ToolCallHandler {
+		if a == nil {
+			return next
+		}
+		return func(ctx context.Context, req *agentkit.ToolCallRequest) (map[string]any, error) {
+			in := toolCallInput(scopeFrom(ctx), req.Call.Name, req.Call.Arguments)
+			if err := a.AuthorizeToolCall(ctx, in); err != nil {
+				return nil, goerr.Wrap(err, "tool call refused", goerr.V("tool", req.Call.Name))
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// toolApprovalMiddleware enforces the operator's human-approval gate on the call
+// itself. The strategies ask before they call (react.RequestApproval) and make
+// the call with the decision in the context; this is what turns that decision
+// into the call's outcome. A denial is returned as the call's error, so the
+// model is told the action was not taken.
+//
+// A gated call that arrives with no decision is refused rather than run: it came
+// through a path that did not ask, and running it would be exactly the
+// unattended effect the operator gated.
+func toolApprovalMiddleware(a ToolApprovals) agentkit.ToolCallMiddleware {
+	return func(next agentkit.ToolCallHandler) agentkit.ToolCallHandler {
+		if a == nil {
+			return next
+		}
+		return func(ctx context.Context, req *agentkit.ToolCallRequest) (map[string]any, error) {
+			if !a.RequiresApproval(scopeFrom(ctx).WorkspaceID, req.Call.Name) {
+				return next(ctx, req)
+			}
+			decision, ok := interaction.ApprovalFor(ctx, req.Call.ID)
+			if !ok {
+				return nil, goerr.Wrap(interaction.ErrApprovalRequired, "tool call refused",
+					goerr.V("tool", req.Call.Name))
+			}
+			if !decision.Approved {
+				opts := []goerr.Option{goerr.V("tool", req.Call.Name)}
+				if decision.UserID != "" {
+					opts = append(opts, goerr.V("denied_by", decision.UserID))
+				}
+				if decision.Reason != "" {
+					opts = append(opts, goerr.V("reason", decision.Reason))
+				}
+				return nil, goerr.Wrap(interaction.ErrApprovalDenied, "tool call refused", opts...)
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// approvalGate binds ToolApprovals to one claim's scope, which is the shape
+// the strategies ask through.
+type approvalGate struct {
+	approvals ToolApprovals
+	sc        Scope
+}
+
+func (g approvalGate) Requires(_ context.Context, tool string) bool {
+	return g.approvals.RequiresApproval(g.sc.WorkspaceID, tool)
+}
+
+func (g approvalGate) Request(ctx context.Context, ticket interaction.ApprovalTicket, req interaction.ApprovalRequest) error {
+	return g.approvals.RequestApproval(ctx, g.sc, ticket, req)
+}
+
+// toolCallInput describes one tool call to the policy. A run tied to a Job
+// acts as that Job, whoever triggered it; any other run is an agent acting for
+// the person it answers.
+func toolCallInput(sc Scope, name string, args map[string]any) authz.OperationInput {
+	actor := authz.Actor{Kind: authz.ActorAgent, UserID: sc.ActorUserID}
+	if sc.JobID != "" {
+		actor.Kind = authz.ActorJob
+		actor.JobID = sc.JobID
+		actor.RunID = sc.JobRunID
+	}
+	return authz.OperationInput{
+		Operation:   name,
+		WorkspaceID: sc.WorkspaceID,
+		CaseID:      sc.CaseID,
+		Actor:       actor,
+		Args:        args,
+	}
+}
+
 // toolArgsFeedbackMiddleware states the shape of the arguments a rejected tool
 // call actually carried, so the model can tell WHICH argument it got wrong.
 //
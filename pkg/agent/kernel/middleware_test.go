@@ -22,11 +22,13 @@ import (
 	"github.com/m-mizutani/gt"
 
 	"github.com/secmon-lab/hecatoncheires/pkg/agent/budget"
+	"github.com/secmon-lab/hecatoncheires/pkg/agent/interaction"
 	"github.com/secmon-lab/hecatoncheires/pkg/agent/kernel"
 	"github.com/secmon-lab/hecatoncheires/pkg/agent/react"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/authz"
 	"github.com/secmon-lab/hecatoncheires/pkg/i18n"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/agentarchive"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
@@ -1327,3 +1329,142 @@ func spanKinds(t *trace.Trace) map[string]bool {
 	}
 	return out
 }
+
+// recordingAuthorizer captures what the policy would be asked and answers
+// with err.
+type recordingAuthorizer struct {
+	got []authz.OperationInput
+	err error
+}
+
+func (a *recordingAuthorizer) AuthorizeToolCall(_ context.Context, in authz.OperationInput) error {
+	a.got = append(a.got, in)
+	return a.err
+}
+
+func TestToolPolicyGatesEveryCall(t *testing.T) {
+	ctx := context.Background()
+	req := &agentkit.ToolCallRequest{
+		Call: gollem.FunctionCall{ID: "c1", Name: "case__close_case", Arguments: map[string]any{"reason": "done"}},
+	}
+	var ran bool
+	next := func(context.Context, *agentkit.ToolCallRequest) (map[string]any, error) {
+		ran = true
+		return map[string]any{"ok": true}, nil
+	}
+
+	t.Run("a Job run is described as the Job", func(t *testing.T) {
+		ran = false
+		a := &recordingAuthorizer{}
+		sc := kernel.Scope{WorkspaceID: "ws", CaseID: 7, ActorUserID: "U1", JobID: "triage", JobRunID: "run-1"}
+		out, err := kernel.ToolPolicyHandlerForTest(a, sc, next)(ctx, req)
+		gt.NoError(t, err)
+		gt.Value(t, out).Equal(map[string]any{"ok": true})
+		gt.Bool(t, ran).True()
+		gt.Array(t, a.got).Length(1).Required()
+		gt.Value(t, a.got[0]).Equal(authz.OperationInput{
+			Operation:   "case__close_case",
+			WorkspaceID: "ws",
+			CaseID:      7,
+			Actor:       authz.Actor{Kind: authz.ActorJob, UserID: "U1", JobID: "triage", RunID: "run-1"},
+			Args:        map[string]any{"reason": "done"},
+		})
+	})
+
+	t.Run("any other run is an agent acting for its user", func(t *testing.T) {
+		a := &recordingAuthorizer{}
+		sc := kernel.Scope{WorkspaceID: "ws", ActorUserID: "U1"}
+		_, err := kernel.ToolPolicyHandlerForTest(a, sc, next)(ctx, req)
+		gt.NoError(t, err)
+		gt.Array(t, a.got).Length(1).Required()
+		gt.Value(t, a.got[0].Actor).Equal(authz.Actor{Kind: authz.ActorAgent, UserID: "U1"})
+	})
+
+	t.Run("a refused call never reaches the tool", func(t *testing.T) {
+		ran = false
+		denied := goerr.New("denied")
+		a := &recordingAuthorizer{err: denied}
+		_, err := kernel.ToolPolicyHandlerForTest(a, kernel.Scope{WorkspaceID: "ws"}, next)(ctx, req)
+		gt.Error(t, err).Is(denied)
+		gt.Bool(t, ran).False()
+	})
+
+	t.Run("no authorizer runs the call", func(t *testing.T) {
+		ran = false
+		_, err := kernel.ToolPolicyHandlerForTest(nil, kernel.Scope{}, next)(ctx, req)
+		gt.NoError(t, err)
+		gt.Bool(t, ran).True()
+	})
+}
+
+// staticApprovals gates the listed tools in every workspace except "open".
+type staticApprovals struct {
+	tools []string
+}
+
+func (a *staticApprovals) RequiresApproval(workspaceID, tool string) bool {
+	return workspaceID != "open" && slices.Contains(a.tools, tool)
+}
+
+func (a *staticApprovals) RequestApproval(context.Context, kernel.Scope, interaction.ApprovalTicket, interaction.ApprovalRequest) error {
+	return nil
+}
+
+func TestToolApprovalGatesConfiguredCalls(t *testing.T) {
+	ctx := context.Background()
+	req := &agentkit.ToolCallRequest{
+		Call: gollem.FunctionCall{ID: "c1", Name: "case__close_case", Arguments: map[string]any{"reason": "done"}},
+	}
+	var ran bool
+	next := func(context.Context, *agentkit.ToolCallRequest) (map[string]any, error) {
+		ran = true
+		return map[string]any{"ok": true}, nil
+	}
+	a := &staticApprovals{tools: []string{"case__close_case"}}
+	sc := kernel.Scope{WorkspaceID: "ws"}
+
+	t.Run("a gated call without a decision never reaches the tool", func(t *testing.T) {
+		ran = false
+		_, err := kernel.ToolApprovalHandlerForTest(a, sc, next)(ctx, req)
+		gt.Error(t, err).Is(interaction.ErrApprovalRequired)
+		gt.Bool(t, ran).False()
+	})
+
+	t.Run("an approved call runs", func(t *testing.T) {
+		ran = false
+		approved := interaction.WithApproval(ctx, "c1", interaction.Approval{Approved: true, UserID: "U1"})
+		out, err := kernel.ToolApprovalHandlerForTest(a, sc, next)(approved, req)
+		gt.NoError(t, err)
+		gt.Value(t, out).Equal(map[string]any{"ok": true})
+		gt.Bool(t, ran).True()
+	})
+
+	t.Run("a denied call is refused with who denied it", func(t *testing.T) {
+		ran = false
+		denied := interaction.WithApproval(ctx, "c1", interaction.Approval{UserID: "U2"})
+		_, err := kernel.ToolApprovalHandlerForTest(a, sc, next)(denied, req)
+		gt.Error(t, err).Is(interaction.ErrApprovalDenied)
+		gt.Value(t, goerr.Values(err)["denied_by"]).Equal("U2")
+		gt.Bool(t, ran).False()
+	})
+
+	t.Run("a decision for another call does not count", func(t *testing.T) {
+		ran = false
+		other := interaction.WithApproval(ctx, "c2", interaction.Approval{Approved: true})
+		_, err := kernel.ToolApprovalHandlerForTest(a, sc, next)(other, req)
+		gt.Error(t, err).Is(interaction.ErrApprovalRequired)
+		gt.Bool(t, ran).False()
+	})
+
+	t.Run("ungated tools and workspaces run", func(t *testing.T) {
+		ran = false
+		_, err := kernel.ToolApprovalHandlerForTest(a, kernel.Scope{WorkspaceID: "open"}, next)(ctx, req)
+		gt.NoError(t, err)
+		gt.Bool(t, ran).True()
+
+		ran = false
+		_, err = kernel.ToolApprovalHandlerForTest(nil, sc, next)(ctx, req)
+		gt.NoError(t, err)
+		gt.Bool(t, ran).True()
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/gollem-dev/gollem"
 	"github.com/m-mizutani/goerr/v2"
 
+	"github.com/secmon-lab/hecatoncheires/pkg/agent/interaction"
 	"github.com/secmon-lab/hecatoncheires/pkg/agent/tool/casemulti"
 	"github.com/secmon-lab/hecatoncheires/pkg/agent/tool/casewriter"
 	"github.com/secmon-lab/hecatoncheires/pkg/agent/tool/core"
@@ -21,6 +22,7 @@ import (
 	"github.com/secmon-lab/hecatoncheires/pkg/agent/tool/wsmeta"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/authz"
 	"github.com/secmon-lab/hecatoncheires/pkg/usecase/agent"
 	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
 )
@@ -65,6 +67,33 @@ type ToolDeps struct {
 
 	KnowledgeAccessor knowledgetool.KnowledgeAccessor
 	KnowledgeMutator  knowledgetool.KnowledgeMutator
+
+	// Authorizer is consulted before every tool call (see
+	// toolPolicyMiddleware). Nil runs every call the toolsets expose.
+	Authorizer ToolAuthorizer
+
+	// Approvals names the tool calls that wait for a person before they run and
+	// delivers the prompt for them (see toolApprovalMiddleware). Nil gates
+	// nothing.
+	Approvals ToolApprovals
+}
+
+// ToolAuthorizer decides whether one agent tool call may run. A non-nil error
+// refuses the call; it is returned to the model as the call's failure, so the
+// run carries on without the refused effect.
+type ToolAuthorizer interface {
+	AuthorizeToolCall(ctx context.Context, in authz.OperationInput) error
+}
+
+// ToolApprovals is the operator's human-approval gate for agent tool calls.
+type ToolApprovals interface {
+	// RequiresApproval reports whether a call to tool in the workspace must be
+	// approved by a person before it runs.
+	RequiresApproval(workspaceID, tool string) bool
+	// RequestApproval delivers the approval prompt for one call of the run sc
+	// describes. It must not wait for the decision: the host responds to ticket
+	// once a person has made it.
+	RequestApproval(ctx context.Context, sc Scope, ticket interaction.ApprovalTicket, req interaction.ApprovalRequest) error
 }
 
 // Validate enforces the required-field contract.
@@ -339,6 +368,7 @@ func buildToolSetDeps(d ToolDeps, sc Scope, entry *model.WorkspaceEntry, target
 			multi.StatusSet = entry.CaseStatusSet
 		} else {
 			multi.ActionUC = d.CaseMultiActionUC
+			multi.ActionStatusSet = entry.ActionStatusSet
 		}
 		deps.CaseMulti = multi
 	}
@@ -66,7 +66,7 @@ func hasPrefixIn(names []string, prefix string) bool {
 // these tests assert which tools get built, never invoke them.
 type stubKnowledgeAccessor struct{}
 
-func (stubKnowledgeAccessor) SearchKnowledge(context.Context, string, string, []model.TagID, int) ([]*model.Knowledge, error) {
+func (stubKnowledgeAccessor) SearchKnowledge(context.Context, string, string, []model.TagID, int, bool) ([]*model.KnowledgeSearchHit, error) {
 	return nil, nil
 }
 
@@ -0,0 +1,77 @@
+package react
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gollem-dev/agentkit"
+	"github.com/gollem-dev/gollem"
+	"github.com/m-mizutani/goerr/v2"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/agent/interaction"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
+)
+
+// RequestApproval asks the run's approval gate whether call may run unattended
+// and, when it may not, delivers the prompt and returns the payload of the
+// Question the caller must suspend on under key. suspend is false when the call
+// needs no approval, and the caller makes it with the returned context.
+//
+// A prompt that cannot be delivered does not park the run: nobody would ever see
+// it, and the run would hold its thread forever. The call is denied instead — the
+// returned context carries the denial, so the enforcing middleware answers the
+// call with it and the model can report that the action was not taken.
+//
+// The prompt is delivered BEFORE the suspend so the host can record the ticket
+// next to it — the decision arrives out of band, and without the key there is
+// nothing to Respond to. A replayed transition would post twice, which is one
+// more reason every Serve in this application bounds unclean reclaims to 0.
+//
+// It is exported for planexec, whose planner tool phase gates its calls the same
+// way this strategy's tool phase does.
+func RequestApproval(ctx context.Context, sys agentkit.Syscalls, call gollem.FunctionCall,
+	key agentkit.AwaitKey,
+) (context.Context, []byte, bool) {
+	gate := interaction.ApprovalGateFrom(ctx)
+	if gate == nil || !gate.Requires(ctx, call.Name) {
+		return ctx, nil, false
+	}
+
+	req := interaction.ApprovalRequest{Tool: call.Name, Arguments: call.Arguments}
+	ticket := interaction.ApprovalTicket{ProcessID: string(sys.ProcessID()), AwaitKey: string(key)}
+	payload, err := json.Marshal(req)
+	if err == nil {
+		err = gate.Request(ctx, ticket, req)
+	}
+	if err != nil {
+		errutil.Handle(ctx, goerr.Wrap(err, "deliver tool approval prompt",
+			goerr.V("tool", call.Name), goerr.V("call_id", call.ID)), "deliver tool approval prompt")
+		return interaction.WithApproval(ctx, call.ID, interaction.Approval{
+			Reason: "the approval prompt could not be delivered",
+		}), nil, false
+	}
+	return ctx, payload, true
+}
+
+// ApprovalContext reads the decision delivered on key and returns a context
+// carrying it for call, which the caller then makes. An await that ended without
+// a decision denies the call rather than failing the run: the model is told the
+// action was not taken and can say so.
+func ApprovalContext(ctx context.Context, sys agentkit.Syscalls, call gollem.FunctionCall,
+	key agentkit.AwaitKey,
+) (context.Context, error) {
+	aw, ok := sys.Await(key)
+	if !ok {
+		return ctx, goerr.New("the approval await is missing", goerr.V("key", string(key)))
+	}
+	if aw.Status != agentkit.AwaitResponded {
+		return interaction.WithApproval(ctx, call.ID, interaction.Approval{
+			Reason: "the approval request was not answered",
+		}), nil
+	}
+	decision, err := interaction.DecodeApproval(aw.Response)
+	if err != nil {
+		return ctx, goerr.Wrap(err, "read the approval decision", goerr.V("key", string(key)))
+	}
+	return interaction.WithApproval(ctx, call.ID, decision), nil
+}
@@ -0,0 +1,139 @@
+package react_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gollem-dev/agentkit"
+	agentprocmemory "github.com/gollem-dev/agentkit/repository/memory"
+	"github.com/gollem-dev/gollem"
+	"github.com/m-mizutani/gt"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/agent/interaction"
+	"github.com/secmon-lab/hecatoncheires/pkg/agent/react"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/agentarchive"
+)
+
+// recordingGate gates one tool and records every prompt it was asked to post.
+type recordingGate struct {
+	tool string
+
+	mu      sync.Mutex
+	tickets []interaction.ApprovalTicket
+}
+
+func (g *recordingGate) Requires(_ context.Context, tool string) bool { return tool == g.tool }
+
+func (g *recordingGate) Request(_ context.Context, ticket interaction.ApprovalTicket, _ interaction.ApprovalRequest) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.tickets = append(g.tickets, ticket)
+	return nil
+}
+
+func (g *recordingGate) Tickets() []interaction.ApprovalTicket {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([]interaction.ApprovalTicket(nil), g.tickets...)
+}
+
+// A gated call waits for a person, and what they decided reaches the call: the
+// approved call runs, the denied one is answered with the refusal and never runs.
+// The enforcing middleware stands in for the kernel's, which is what turns the
+// decision in the context into the call's outcome.
+func TestGatedToolCallWaitsForApproval(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		approved bool
+		runs     int
+	}{
+		{name: "approved", approved: true, runs: 1},
+		{name: "denied", approved: false, runs: 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tool := &recordingTool{name: "case__close_case"}
+			free := &recordingTool{name: "probe__ping"}
+			llm, _ := scriptedLLM(t,
+				callResponse(
+					&gollem.FunctionCall{ID: "c1", Name: "probe__ping", Arguments: map[string]any{}},
+					&gollem.FunctionCall{ID: "c2", Name: "case__close_case", Arguments: map[string]any{"reason": "done"}},
+				),
+				textResponse("finished"),
+			)
+			gate := &recordingGate{tool: "case__close_case"}
+
+			reg := agentkit.NewRegistry()
+			handle, err := react.Register(reg, "react-test", 1, generousBudget().Limiter(),
+				agentkit.WithHistoryStore[react.Output](agentarchive.NewMemoryHistoryStore()))
+			gt.NoError(t, err).Required()
+			k, err := agentkit.New(agentprocmemory.New(), llm, reg,
+				agentkit.WithToolFactory(func(context.Context, *agentkit.Process) ([]gollem.Tool, error) {
+					return []gollem.Tool{tool, free}, nil
+				}),
+				agentkit.WithClaimMiddleware(func(next agentkit.ClaimHandler) agentkit.ClaimHandler {
+					return func(ctx context.Context, req *agentkit.ClaimRequest) (agentkit.ClaimOutcome, error) {
+						return next(interaction.WithApprovalGate(ctx, gate), req)
+					}
+				}),
+				agentkit.WithToolCallMiddleware(func(next agentkit.ToolCallHandler) agentkit.ToolCallHandler {
+					return func(ctx context.Context, req *agentkit.ToolCallRequest) (map[string]any, error) {
+						if req.Call.Name != gate.tool {
+							return next(ctx, req)
+						}
+						if a, ok := interaction.ApprovalFor(ctx, req.Call.ID); !ok || !a.Approved {
+							return nil, interaction.ErrApprovalDenied
+						}
+						return next(ctx, req)
+					}
+				}))
+			gt.NoError(t, err).Required()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			served := make(chan error, 1)
+			go func() { served <- k.Serve(ctx, agentkit.WithPollInterval(5*time.Millisecond)) }()
+			defer func() {
+				cancel()
+				<-served
+			}()
+
+			pid, err := handle.Spawn(ctx, k, react.Input{SystemPrompt: "be helpful", Prompt: "close it"})
+			gt.NoError(t, err).Required()
+
+			for len(gate.Tickets()) == 0 {
+				select {
+				case <-ctx.Done():
+					t.Fatal("the gated call never asked for approval")
+				case <-time.After(5 * time.Millisecond):
+				}
+			}
+			// The call ahead of it ran; the gated one waits.
+			gt.Array(t, free.Calls()).Length(1)
+			gt.Array(t, tool.Calls()).Length(0)
+
+			ticket := gate.Tickets()[0]
+			gt.Value(t, ticket.ProcessID).Equal(string(pid))
+			raw, err := interaction.Approval{Approved: tc.approved, UserID: "U1"}.Encode()
+			gt.NoError(t, err).Required()
+			gt.NoError(t, k.Respond(ctx, pid, agentkit.AwaitKey(ticket.AwaitKey), raw)).Required()
+
+			for {
+				proc, err := k.GetProcess(ctx, pid)
+				gt.NoError(t, err).Required()
+				if proc.Status.Terminal() {
+					gt.Value(t, proc.Status).Equal(agentkit.ProcessSucceeded)
+					break
+				}
+				select {
+				case <-ctx.Done():
+					t.Fatal("the run did not finish after the decision")
+				case <-time.After(5 * time.Millisecond):
+				}
+			}
+			gt.Array(t, tool.Calls()).Length(tc.runs)
+			gt.Array(t, gate.Tickets()).Length(1)
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/gollem-dev/agentkit"
@@ -40,6 +41,9 @@ const (
 	phaseGenerate = "generate"
 	// phaseTool runs one tool call the model asked for.
 	phaseTool = "tool"
+	// phaseApproval makes a gated tool call once a person has decided on it. It is
+	// reached only from phaseTool, through a suspend on ApprovalKey.
+	phaseApproval = "approval"
 )
 
 // Input is the launch input. Both fields are required: this strategy knows
@@ -75,6 +79,11 @@ type state struct {
 	// conversation, so the next Generate continues from it and sends no input.
 	ToolsAnswered bool     `json:"tools_answered,omitempty"`
 	Texts         []string `json:"texts,omitempty"`
+	// ApprovalKey is the await a call gated on human approval is parked on.
+	// Approvals counts the awaits this run has opened, so each gets a fresh key:
+	// agentkit closes an await once it is answered.
+	ApprovalKey agentkit.AwaitKey `json:"approval_key,omitempty"`
+	Approvals   int               `json:"approvals,omitempty"`
 }
 
 // Option configures the strategy.
@@ -143,6 +152,8 @@ func (s *strategy) Step(ctx context.Context, sys agentkit.Syscalls, st state) (s
 		return s.stepGenerate(ctx, sys, st)
 	case phaseTool:
 		return s.stepTool(ctx, sys, st)
+	case phaseApproval:
+		return s.stepApproval(ctx, sys, st)
 	default:
 		return st, agentkit.Decision[Output]{}, goerr.New("react: unknown phase", goerr.V("phase", st.Phase))
 	}
@@ -251,19 +262,62 @@ func noticeInstruction(msg string) string {
 // time-based one can refuse here, and swallowing that would spend past a ceiling
 // its owner had already declared closed. Do not remove it on the grounds that no
 // test drives it; no test can, with the limiter this application ships.
+//
+// A call the operator gated on human approval is not made here: the run suspends
+// until a person decides (see RequestApproval), and stepApproval makes it.
 func (s *strategy) stepTool(ctx context.Context, sys agentkit.Syscalls, st state) (state, agentkit.Decision[Output], error) {
 	if len(st.Pending) == 0 {
 		st.Phase = phaseGenerate
 		return st, agentkit.Continue[Output](), nil
 	}
 
-	call := st.Pending[0]
-	st.Pending = st.Pending[1:]
-	if call == nil {
+	if st.Pending[0] == nil {
+		st.Pending = st.Pending[1:]
 		return st, agentkit.Continue[Output](), nil
 	}
+	call := toolargs.Coerce(sys.Tools(), *st.Pending[0])
+
+	// A call the operator gated waits for a person. It stays at the head of
+	// Pending across the suspend, so the transition that resumes makes exactly
+	// this call — the one the person was shown.
+	key := agentkit.AwaitKey(fmt.Sprintf("approval:%d", st.Approvals+1))
+	ctx, payload, suspend := RequestApproval(ctx, sys, call, key)
+	if suspend {
+		st.Approvals++
+		st.ApprovalKey = key
+		st.Phase = phaseApproval
+		return st, agentkit.Suspend[Output](agentkit.Question(key, payload)), nil
+	}
+
+	st.Pending = st.Pending[1:]
+	return s.callTool(ctx, sys, st, call)
+}
+
+// stepApproval makes the call a person has decided on. The decision rides in the
+// context, and the kernel's approval middleware either lets the call through or
+// answers it with the denial, so a denied call is still answered in the
+// conversation and the model learns it was not made.
+func (s *strategy) stepApproval(ctx context.Context, sys agentkit.Syscalls, st state) (state, agentkit.Decision[Output], error) {
+	if len(st.Pending) == 0 || st.Pending[0] == nil {
+		return st, agentkit.Decision[Output]{}, goerr.New("react: no call is waiting for approval",
+			goerr.V("key", string(st.ApprovalKey)))
+	}
+	call := toolargs.Coerce(sys.Tools(), *st.Pending[0])
 
-	if _, err := sys.Session().CallTool(ctx, toolargs.Coerce(sys.Tools(), *call)); err != nil {
+	ctx, err := ApprovalContext(ctx, sys, call, st.ApprovalKey)
+	if err != nil {
+		return st, agentkit.Decision[Output]{}, goerr.Wrap(err, "react: resume from approval",
+			goerr.V("tool", call.Name))
+	}
+	st.ApprovalKey = ""
+	st.Pending = st.Pending[1:]
+	return s.callTool(ctx, sys, st, call)
+}
+
+// callTool makes call, which the caller has already taken off Pending, and
+// answers it in the conversation.
+func (s *strategy) callTool(ctx context.Context, sys agentkit.Syscalls, st state, call gollem.FunctionCall) (state, agentkit.Decision[Output], error) {
+	if _, err := sys.Session().CallTool(ctx, call); err != nil {
 		if errors.Is(err, agentkit.ErrLimitExceeded) {
 			return st, agentkit.Decision[Output]{}, goerr.Wrap(err, "react: tool call refused by the budget",
 				goerr.V("tool", call.Name))
@@ -279,6 +333,7 @@ func (s *strategy) stepTool(ctx context.Context, sys agentkit.Syscalls, st state
 	// result, which is what the model reacts to on its next turn.
 	st.ToolsAnswered = true
 
+	st.Phase = phaseTool
 	if len(st.Pending) == 0 {
 		st.Phase = phaseGenerate
 	}
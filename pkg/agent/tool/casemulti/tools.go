@@ -145,8 +145,9 @@ type ActionUsecase interface {
 	// always pair it with a prior CaseUsecase.GetCase access check.
 	GetAction(ctx context.Context, workspaceID string, id int64, opts ...interfaces.ActionListOptions) (*model.Action, error)
 	// CreateAction is invoked by case__create_action. See the package doc for
-	// why this method carries no actorID.
-	CreateAction(ctx context.Context, workspaceID string, caseID int64, title, description string) (*model.Action, error)
+	// why this method carries no actorID. blockedBy names actions of the same
+	// case the new one waits on.
+	CreateAction(ctx context.Context, workspaceID string, caseID int64, title, description string, blockedBy []int64) (*model.Action, error)
 	// UpdateAction is invoked by both case__update_action and
 	// case__update_action_status (the latter sets only patch.Status), mirroring
 	// how pkg/agent/tool/core's update_action_status tool reuses the same
@@ -164,6 +165,8 @@ type ActionUpdate struct {
 	Title       *string
 	Description *string
 	Status      *types.ActionStatus
+	// BlockedBy replaces the action's blockers; an empty slice clears them.
+	BlockedBy *[]int64
 }
 
 // Deps groups the dependencies the casemulti tools need. WorkspaceID and
@@ -203,6 +206,10 @@ type Deps struct {
 	// would misfire, since a channel-mode config carrying an unused
 	// [case.status] section still resolves a non-nil status set.
 	StatusSet *model.ActionStatusSet
+	// ActionStatusSet tells case__list_actions which action statuses are
+	// closed, so it can report whether an action is still blocked. nil falls
+	// back to the default set.
+	ActionStatusSet *model.ActionStatusSet
 }
 
 // New returns the cross-case tools. Returns nil (empty) when CaseUC == nil so
@@ -323,8 +330,9 @@ func caseToDetailMap(c *model.Case) map[string]any {
 	}
 }
 
-// actionToListMap renders an Action as a compact map for case__list_actions entries.
-func actionToListMap(a *model.Action) map[string]any {
+// actionToListMap renders an Action as a compact map for case__list_actions
+// entries. blocked is whether any of its blockers is still open.
+func actionToListMap(a *model.Action, blocked bool) map[string]any {
 	item := map[string]any{
 		"id":          a.ID,
 		"case_id":     a.CaseID,
@@ -332,6 +340,8 @@ func actionToListMap(a *model.Action) map[string]any {
 		"status":      a.Status.String(),
 		"assignee_id": a.AssigneeID,
 		"archived":    a.IsArchived(),
+		"blocked_by":  blockedByIDs(a),
+		"blocked":     blocked,
 	}
 	if a.DueDate != nil {
 		item["due_date"] = a.DueDate.Format(time.RFC3339)
@@ -351,6 +361,7 @@ func actionToDetailMap(a *model.Action) map[string]any {
 		"status":      a.Status.String(),
 		"assignee_id": a.AssigneeID,
 		"archived":    a.IsArchived(),
+		"blocked_by":  blockedByIDs(a),
 		"created_at":  a.CreatedAt.Format(time.RFC3339),
 		"updated_at":  a.UpdatedAt.Format(time.RFC3339),
 	}
@@ -360,6 +371,48 @@ func actionToDetailMap(a *model.Action) map[string]any {
 	return m
 }
 
+// blockedByIDs returns a's blockers as a non-nil slice, so the tool output
+// always carries the key as a list.
+func blockedByIDs(a *model.Action) []int64 {
+	if a.BlockedBy == nil {
+		return []int64{}
+	}
+	return a.BlockedBy
+}
+
+// blockedByParameter is the shared `blocked_by` gollem.Parameter schema for
+// case__create_action / case__update_action.
+func blockedByParameter(description string) *gollem.Parameter {
+	return &gollem.Parameter{
+		Type:        gollem.TypeArray,
+		Description: description,
+		Items:       &gollem.Parameter{Type: gollem.TypeInteger},
+	}
+}
+
+// toInt64Slice decodes a `blocked_by` argument. JSON numbers arrive as
+// float64, as for tool.ExtractInt64.
+func toInt64Slice(v any) ([]int64, error) {
+	items, ok := v.([]any)
+	if !ok {
+		return nil, goerr.New("value must be an array of integers", goerr.V("type", typeOf(v)))
+	}
+	out := make([]int64, 0, len(items))
+	for _, item := range items {
+		switch n := item.(type) {
+		case float64:
+			out = append(out, int64(n))
+		case int:
+			out = append(out, int64(n))
+		case int64:
+			out = append(out, n)
+		default:
+			return nil, goerr.New("array item must be an integer", goerr.V("type", typeOf(item)))
+		}
+	}
+	return out, nil
+}
+
 // actionStepToMap renders an ActionStep for case__add_action_step /
 // case__set_action_step_done responses.
 func actionStepToMap(s *model.ActionStep) map[string]any {
@@ -983,7 +1036,7 @@ type listActionsTool struct {
 func (t *listActionsTool) Spec() gollem.ToolSpec {
 	return gollem.ToolSpec{
 		Name:        "case__list_actions",
-		Description: "List the (non-archived) actions of a case.",
+		Description: "List the (non-archived) actions of a case. Each entry carries blocked_by, the IDs of the actions it waits on, and blocked, whether any of them is still open.",
 		Parameters: map[string]*gollem.Parameter{
 			"case_id": {
 				Type:        gollem.TypeInteger,
@@ -1009,12 +1062,17 @@ func (t *listActionsTool) Run(ctx context.Context, args map[string]any) (map[str
 			goerr.V("case_id", caseID))
 	}
 
+	statusSet := t.deps.ActionStatusSet
+	if statusSet == nil {
+		statusSet = model.DefaultActionStatusSet()
+	}
 	items := make([]map[string]any, 0, len(actions))
 	for _, a := range actions {
 		if a == nil {
 			continue
 		}
-		items = append(items, actionToListMap(a))
+		blocked := len(model.OpenActionBlockers(a, actions, statusSet)) > 0
+		items = append(items, actionToListMap(a, blocked))
 	}
 	return map[string]any{"actions": items}, nil
 }
@@ -1089,6 +1147,7 @@ func (t *createActionTool) Spec() gollem.ToolSpec {
 				Type:        gollem.TypeString,
 				Description: "Detailed description of the action.",
 			},
+			"blocked_by": blockedByParameter("IDs of actions of the same case the new action waits on."),
 		},
 	}
 }
@@ -1103,6 +1162,13 @@ func (t *createActionTool) Run(ctx context.Context, args map[string]any) (map[st
 		return nil, goerr.New("title is required")
 	}
 	description, _ := args["description"].(string)
+	var blockedBy []int64
+	if v, ok := args["blocked_by"]; ok && v != nil {
+		blockedBy, err = toInt64Slice(v)
+		if err != nil {
+			return nil, goerr.Wrap(err, "invalid blocked_by")
+		}
+	}
 
 	// The parent case must be accessible before creating an action under it,
 	// mirroring case__get_action's access-first ordering (CreateAction itself
@@ -1114,7 +1180,7 @@ func (t *createActionTool) Run(ctx context.Context, args map[string]any) (map[st
 
 	tool.Update(ctx, fmt.Sprintf("Creating action under case #%d: %s", caseID, title))
 
-	created, err := t.deps.ActionUC.CreateAction(ctx, t.deps.WorkspaceID, caseID, title, description)
+	created, err := t.deps.ActionUC.CreateAction(ctx, t.deps.WorkspaceID, caseID, title, description, blockedBy)
 	if err != nil {
 		return nil, goerr.Wrap(err, "failed to create action",
 			goerr.V("workspace_id", t.deps.WorkspaceID),
@@ -1131,7 +1197,7 @@ type updateActionTool struct {
 func (t *updateActionTool) Spec() gollem.ToolSpec {
 	return gollem.ToolSpec{
 		Name:        "case__update_action",
-		Description: "Update an existing action's title and/or description. Use case__update_action_status to change its status.",
+		Description: "Update an existing action's title, description and/or blockers. Use case__update_action_status to change its status.",
 		Parameters: map[string]*gollem.Parameter{
 			"case_id": {
 				Type:        gollem.TypeInteger,
@@ -1151,6 +1217,8 @@ func (t *updateActionTool) Spec() gollem.ToolSpec {
 				Type:        gollem.TypeString,
 				Description: "New description for the action. Omit to preserve the existing description.",
 			},
+			"blocked_by": blockedByParameter("IDs of actions of the same case this action waits on, replacing the current list. " +
+				"Pass an empty list to clear it; omit to preserve it. A dependency cycle is rejected."),
 		},
 	}
 }
@@ -1192,8 +1260,17 @@ func (t *updateActionTool) Run(ctx context.Context, args map[string]any) (map[st
 		hasUpdate = true
 	}
 
+	if v, ok := args["blocked_by"]; ok && v != nil {
+		ids, err := toInt64Slice(v)
+		if err != nil {
+			return nil, goerr.Wrap(err, "invalid blocked_by")
+		}
+		patch.BlockedBy = &ids
+		hasUpdate = true
+	}
+
 	if !hasUpdate {
-		return nil, goerr.New("update_action requires at least one of title, description")
+		return nil, goerr.New("update_action requires at least one of title, description, blocked_by")
 	}
 
 	tool.Update(ctx, fmt.Sprintf("Updating action #%d...", actionID))
@@ -142,6 +142,7 @@ func (f *fakeCaseUC) UpdateCaseStatus(_ context.Context, _ string, id int64, boa
 type createActionCall struct {
 	caseID             int64
 	title, description string
+	blockedBy          []int64
 }
 
 type updateActionCall struct {
@@ -205,8 +206,8 @@ func (f *fakeActionUC) GetAction(_ context.Context, _ string, id int64, _ ...int
 	return a, nil
 }
 
-func (f *fakeActionUC) CreateAction(_ context.Context, _ string, caseID int64, title, description string) (*model.Action, error) {
-	f.createCalls = append(f.createCalls, createActionCall{caseID: caseID, title: title, description: description})
+func (f *fakeActionUC) CreateAction(_ context.Context, _ string, caseID int64, title, description string, blockedBy []int64) (*model.Action, error) {
+	f.createCalls = append(f.createCalls, createActionCall{caseID: caseID, title: title, description: description, blockedBy: blockedBy})
 	if f.createErr != nil {
 		return nil, f.createErr
 	}
@@ -722,6 +723,29 @@ func TestListActionsTool(t *testing.T) {
 	gt.String(t, items[0]["title"].(string)).Equal("a1")
 }
 
+func TestListActionsTool_Blocked(t *testing.T) {
+	actionUC := &fakeActionUC{listResp: []*model.Action{
+		{ID: 1, CaseID: 3, Title: "investigate", Status: types.ActionStatusInProgress},
+		{ID: 2, CaseID: 3, Title: "contain", Status: types.ActionStatusCompleted},
+		{ID: 3, CaseID: 3, Title: "mitigate", Status: types.ActionStatusTodo, BlockedBy: []int64{1}},
+		{ID: 4, CaseID: 3, Title: "report", Status: types.ActionStatusTodo, BlockedBy: []int64{2}},
+	}}
+	tools := casemulti.New(casemulti.Deps{WorkspaceID: "ws", CaseUC: &fakeCaseUC{}, ActionUC: actionUC})
+	la := toolByName(t, tools, "case__list_actions")
+	gt.Value(t, la).NotNil().Required()
+
+	out, err := la.Run(context.Background(), map[string]any{"case_id": int64(3)})
+	gt.NoError(t, err).Required()
+	items := out["actions"].([]map[string]any)
+	gt.Array(t, items).Length(4).Required()
+	gt.Bool(t, items[0]["blocked"].(bool)).False()
+	gt.Array(t, items[0]["blocked_by"].([]int64)).Length(0)
+	gt.Bool(t, items[2]["blocked"].(bool)).True()
+	gt.Array(t, items[2]["blocked_by"].([]int64)).Equal([]int64{1})
+	// Its only blocker is completed.
+	gt.Bool(t, items[3]["blocked"].(bool)).False()
+}
+
 // ---------------------------------------------------------------------------
 // case__get_action
 // ---------------------------------------------------------------------------
@@ -793,9 +817,36 @@ func TestCreateActionTool(t *testing.T) {
 	gt.Number(t, actionUC.createCalls[0].caseID).Equal(int64(3))
 	gt.String(t, actionUC.createCalls[0].title).Equal("new action")
 	gt.String(t, actionUC.createCalls[0].description).Equal("desc")
+	gt.Array(t, actionUC.createCalls[0].blockedBy).Length(0)
 	gt.Number(t, out["id"].(int64)).Equal(int64(10))
 }
 
+func TestCreateActionTool_BlockedBy(t *testing.T) {
+	caseUC := &fakeCaseUC{casesByID: map[int64]*model.Case{3: {ID: 3, Status: types.CaseStatusOpen}}}
+	actionUC := &fakeActionUC{createResp: &model.Action{ID: 10, CaseID: 3, Title: "mitigate", BlockedBy: []int64{7, 8}}}
+	tools := casemulti.New(casemulti.Deps{WorkspaceID: "ws", CaseUC: caseUC, ActionUC: actionUC})
+	ca := toolByName(t, tools, "case__create_action")
+	gt.Value(t, ca).NotNil().Required()
+
+	out, err := ca.Run(context.Background(), map[string]any{
+		"case_id":    int64(3),
+		"title":      "mitigate",
+		"blocked_by": []any{float64(7), float64(8)},
+	})
+	gt.NoError(t, err).Required()
+	gt.Array(t, actionUC.createCalls).Length(1).Required()
+	gt.Array(t, actionUC.createCalls[0].blockedBy).Equal([]int64{7, 8})
+	gt.Array(t, out["blocked_by"].([]int64)).Equal([]int64{7, 8})
+
+	_, err = ca.Run(context.Background(), map[string]any{
+		"case_id":    int64(3),
+		"title":      "mitigate",
+		"blocked_by": []any{"seven"},
+	})
+	gt.Error(t, err)
+	gt.Array(t, actionUC.createCalls).Length(1)
+}
+
 func TestCreateActionTool_ParentCaseAccessDenied(t *testing.T) {
 	caseUC := &fakeCaseUC{casesByID: map[int64]*model.Case{3: {ID: 3, AccessDenied: true}}}
 	actionUC := &fakeActionUC{createResp: &model.Action{ID: 10}}
@@ -836,6 +887,31 @@ func TestUpdateActionTool(t *testing.T) {
 	gt.Value(t, call.patch.Status).Nil()
 	gt.String(t, call.actorID).Equal("U1")
 	gt.String(t, out["title"].(string)).Equal("new title")
+	gt.Value(t, call.patch.BlockedBy).Nil()
+}
+
+func TestUpdateActionTool_BlockedBy(t *testing.T) {
+	caseUC := &fakeCaseUC{casesByID: map[int64]*model.Case{3: {ID: 3, Status: types.CaseStatusOpen}}}
+	actionUC := &fakeActionUC{
+		actionsByID: map[int64]*model.Action{1: {ID: 1, CaseID: 3, BlockedBy: []int64{2}}},
+		updateResp:  &model.Action{ID: 1, CaseID: 3},
+	}
+	tools := casemulti.New(casemulti.Deps{WorkspaceID: "ws", ActorID: "U1", CaseUC: caseUC, ActionUC: actionUC})
+	ua := toolByName(t, tools, "case__update_action")
+	gt.Value(t, ua).NotNil().Required()
+
+	// An empty list is an update on its own: it clears the blockers.
+	_, err := ua.Run(context.Background(), map[string]any{
+		"case_id":    int64(3),
+		"action_id":  int64(1),
+		"blocked_by": []any{},
+	})
+	gt.NoError(t, err).Required()
+	gt.Array(t, actionUC.updateCalls).Length(1).Required()
+	call := actionUC.updateCalls[0]
+	gt.Value(t, call.patch.BlockedBy).NotNil().Required()
+	gt.Array(t, *call.patch.BlockedBy).Length(0)
+	gt.Value(t, call.patch.Title).Nil()
 }
 
 func TestUpdateActionTool_CaseMismatch(t *testing.T) {
@@ -209,6 +209,21 @@ func (m *mockRepo) GetToken(ctx context.Context, tokenID auth.TokenID) (*auth.To
 func (m *mockRepo) DeleteToken(ctx context.Context, tokenID auth.TokenID) error {
 	panic("unexpected call: DeleteToken()")
 }
+func (m *mockRepo) PutAPIToken(ctx context.Context, token *auth.APIToken) error {
+	panic("unexpected call: PutAPIToken()")
+}
+func (m *mockRepo) GetAPIToken(ctx context.Context, tokenID auth.TokenID) (*auth.APIToken, error) {
+	panic("unexpected call: GetAPIToken()")
+}
+func (m *mockRepo) ListAPITokens(ctx context.Context, sub string) ([]*auth.APIToken, error) {
+	panic("unexpected call: ListAPITokens()")
+}
+func (m *mockRepo) DeleteAPIToken(ctx context.Context, tokenID auth.TokenID) error {
+	panic("unexpected call: DeleteAPIToken()")
+}
+func (m *mockRepo) TouchAPIToken(ctx context.Context, tokenID auth.TokenID, usedAt time.Time) error {
+	panic("unexpected call: TouchAPIToken()")
+}
 func (m *mockRepo) AssistLog() interfaces.AssistLogRepository {
 	panic("unexpected call: AssistLog()")
 }
@@ -248,6 +263,21 @@ func (m *mockRepo) HomeMessage() interfaces.HomeMessageRepository {
 func (m *mockRepo) AssigneeRanking() interfaces.AssigneeRankingRepository {
 	panic("unexpected call: AssigneeRanking()")
 }
+func (m *mockRepo) ExportState() interfaces.ExportStateRepository {
+	panic("unexpected call: ExportState()")
+}
+func (m *mockRepo) WebhookDelivery() interfaces.WebhookDeliveryRepository {
+	panic("unexpected call: WebhookDelivery()")
+}
+func (m *mockRepo) Alert() interfaces.AlertRepository {
+	panic("unexpected call: Alert()")
+}
+func (m *mockRepo) ActionReminder() interfaces.ActionReminderRepository {
+	panic("unexpected call: ActionReminder()")
+}
+func (m *mockRepo) SLABreach() interfaces.SLABreachRepository {
+	panic("unexpected call: SLABreach()")
+}
 func (m *mockRepo) Memo() interfaces.MemoRepository {
 	panic("unexpected call: Memo()")
 }
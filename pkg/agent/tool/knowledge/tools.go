@@ -26,7 +26,7 @@ import (
 // KnowledgeAccessor is the read surface the knowledge tools depend on. Defined
 // here so the package does not import pkg/usecase (which would create a cycle).
 type KnowledgeAccessor interface {
-	SearchKnowledge(ctx context.Context, workspaceID, query string, tagIDs []model.TagID, limit int) ([]*model.Knowledge, error)
+	SearchKnowledge(ctx context.Context, workspaceID, query string, tagIDs []model.TagID, limit int, includeExpired bool) ([]*model.KnowledgeSearchHit, error)
 	GetKnowledge(ctx context.Context, workspaceID string, id model.KnowledgeID) (*model.Knowledge, error)
 	ListTags(ctx context.Context, workspaceID string) ([]*model.Tag, error)
 }
@@ -80,20 +80,29 @@ func NewReadOnly(deps Deps) []gollem.Tool {
 
 // knowledgeToMap renders a knowledge entry for a tool response. The embedding is
 // intentionally omitted. Tags are surfaced as their ids (tag_ids); call
-// list_tags to resolve ids to names.
+// list_tags to resolve ids to names. valid_until / review_by appear only when
+// set; expired tells the agent not to rely on the entry as current fact.
 func knowledgeToMap(k *model.Knowledge) map[string]any {
 	ids := make([]string, 0, len(k.TagIDs))
 	for _, id := range k.TagIDs {
 		ids = append(ids, string(id))
 	}
-	return map[string]any{
+	out := map[string]any{
 		"id":         string(k.ID),
 		"title":      k.Title,
 		"claim":      k.Claim,
 		"tag_ids":    ids,
+		"expired":    k.IsExpired(time.Now()),
 		"created_at": k.CreatedAt.Format(time.RFC3339),
 		"updated_at": k.UpdatedAt.Format(time.RFC3339),
 	}
+	if k.ValidUntil != nil {
+		out["valid_until"] = k.ValidUntil.Format(time.RFC3339)
+	}
+	if k.ReviewBy != nil {
+		out["review_by"] = k.ReviewBy.Format(time.RFC3339)
+	}
+	return out
 }
 
 // tagToMap renders a tag for a tool response.
@@ -113,9 +122,11 @@ func (t *searchKnowledgeTool) Spec() gollem.ToolSpec {
 		Name: "knowledge__search_knowledge",
 		Description: "Search the workspace-wide shared knowledge base for entries relevant to a " +
 			"query. Knowledge captures organization-specific facts / rules / decisions that are not " +
-			"in your general knowledge. Results are ranked by semantic relevance (falling back to " +
-			"keyword match). Each result lists its tag_ids; use list_tags to resolve ids to names. " +
-			"Optionally pre-filter by tag_ids (AND).",
+			"in your general knowledge. Results are ranked by a hybrid of keyword (BM25) and semantic " +
+			"similarity, so exact identifiers such as hostnames or ticket IDs match too; each result " +
+			"carries its score (higher is better, comparable only within one search). Each result lists its tag_ids; use list_tags to resolve ids to names. " +
+			"Optionally pre-filter by tag_ids (AND). Entries past their valid_until date are " +
+			"excluded unless include_expired is true.",
 		Parameters: map[string]*gollem.Parameter{
 			"query": {
 				Type:        gollem.TypeString,
@@ -131,6 +142,11 @@ func (t *searchKnowledgeTool) Spec() gollem.ToolSpec {
 				Type:        gollem.TypeNumber,
 				Description: "Maximum number of entries to return. Defaults to 10.",
 			},
+			"include_expired": {
+				Type: gollem.TypeBoolean,
+				Description: "Also return expired entries (marked expired: true). Use only when " +
+					"historical context is needed; an expired entry is no longer authoritative.",
+			},
 		},
 	}
 }
@@ -151,13 +167,15 @@ func (t *searchKnowledgeTool) Run(ctx context.Context, args map[string]any) (map
 			limit = int(f)
 		}
 	}
-	items, err := t.deps.Accessor.SearchKnowledge(ctx, t.deps.WorkspaceID, query, tagIDs, limit)
+	includeExpired, _ := args["include_expired"].(bool)
+	hits, err := t.deps.Accessor.SearchKnowledge(ctx, t.deps.WorkspaceID, query, tagIDs, limit, includeExpired)
 	if err != nil {
 		return nil, goerr.Wrap(err, "failed to search knowledge", goerr.V("workspace_id", t.deps.WorkspaceID))
 	}
-	out := make([]map[string]any, len(items))
-	for i, k := range items {
-		out[i] = knowledgeToMap(k)
+	out := make([]map[string]any, len(hits))
+	for i, h := range hits {
+		out[i] = knowledgeToMap(h.Knowledge)
+		out[i]["score"] = h.Score
 	}
 	return map[string]any{"knowledge": out}, nil
 }
@@ -20,16 +20,22 @@ type fakeAccessor struct {
 	lastQuery    string
 	lastTagIDs   []model.TagID
 	lastLimit    int
+	lastExpired  bool
 	items        []*model.Knowledge
 	tags         []*model.Tag
 }
 
-func (f *fakeAccessor) SearchKnowledge(ctx context.Context, workspaceID, query string, tagIDs []model.TagID, limit int) ([]*model.Knowledge, error) {
+func (f *fakeAccessor) SearchKnowledge(ctx context.Context, workspaceID, query string, tagIDs []model.TagID, limit int, includeExpired bool) ([]*model.KnowledgeSearchHit, error) {
 	f.searchCalls++
 	f.lastQuery = query
 	f.lastTagIDs = tagIDs
 	f.lastLimit = limit
-	return f.items, nil
+	f.lastExpired = includeExpired
+	hits := make([]*model.KnowledgeSearchHit, len(f.items))
+	for i, k := range f.items {
+		hits[i] = &model.KnowledgeSearchHit{Knowledge: k, Score: float64(len(f.items) - i)}
+	}
+	return hits, nil
 }
 
 func (f *fakeAccessor) GetKnowledge(ctx context.Context, workspaceID string, id model.KnowledgeID) (*model.Knowledge, error) {
@@ -191,6 +197,7 @@ func TestSearchToolRun(t *testing.T) {
 	gt.Array(t, acc.lastTagIDs).Length(1).Required()
 	gt.Value(t, acc.lastTagIDs[0]).Equal(tagID1)
 	gt.Number(t, acc.lastLimit).Equal(5)
+	gt.Bool(t, acc.lastExpired).False()
 
 	knowledge, ok := out["knowledge"].([]map[string]any)
 	gt.Bool(t, ok).True()
@@ -202,6 +209,38 @@ func TestSearchToolRun(t *testing.T) {
 	gt.Bool(t, ok).True()
 	gt.Array(t, tagIDs).Length(1).Required()
 	gt.String(t, tagIDs[0]).Equal(string(tagID1))
+	gt.Value(t, item["score"]).Equal(any(float64(1)))
+}
+
+func TestSearchToolIncludeExpired(t *testing.T) {
+	expiredAt := time.Now().Add(-time.Hour)
+	acc := &fakeAccessor{items: []*model.Knowledge{
+		{
+			ID:         "k1",
+			Title:      "Old runbook",
+			TagIDs:     []model.TagID{model.NewTagID()},
+			ValidUntil: &expiredAt,
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
+		},
+	}}
+	tools := knowledgetool.NewReadOnly(knowledgetool.Deps{WorkspaceID: "ws", Accessor: acc})
+	tl := findTool(t, tools, "knowledge__search_knowledge")
+
+	out, err := tl.Run(context.Background(), map[string]any{
+		"query":           "runbook",
+		"include_expired": true,
+	})
+	gt.NoError(t, err).Required()
+	gt.Bool(t, acc.lastExpired).True()
+
+	knowledge, ok := out["knowledge"].([]map[string]any)
+	gt.Bool(t, ok).True()
+	gt.Array(t, knowledge).Length(1).Required()
+	gt.Value(t, knowledge[0]["expired"]).Equal(true)
+	gt.Value(t, knowledge[0]["valid_until"]).Equal(expiredAt.Format(time.RFC3339))
+	_, hasReviewBy := knowledge[0]["review_by"]
+	gt.Bool(t, hasReviewBy).False()
 }
 
 func TestSearchToolRequiresQuery(t *testing.T) {
@@ -45,6 +45,10 @@ const maxWorkspaceEmojiRunes = 16
 // `#team-support`) without over-constraining future ID shapes.
 var slackChannelIDPattern = regexp.MustCompile(`^[CG][A-Z0-9]+$`)
 
+// slackUserIDPattern is the same lenient check for a Slack user ID, which
+// starts with U, or W on Enterprise Grid.
+var slackUserIDPattern = regexp.MustCompile(`^[UW][A-Z0-9]+$`)
+
 // reactionEmojiPattern matches a normalized Slack reaction emoji name (no
 // surrounding colons). Slack emoji names are lowercase and may include digits,
 // underscores, hyphens, apostrophes, and plus signs (e.g. "+1", "white_check_mark").
@@ -233,8 +237,62 @@ type AppConfig struct {
 	Case      *CaseSection        `toml:"case"`
 	Memo      *MemoSection        `toml:"memo"`
 	Jobs      []JobSection        `toml:"job"`
+	Approval  ApprovalSection     `toml:"approval"`
+	Webhooks  []WebhookSection    `toml:"webhook"`
+	Ingest    *IngestSection      `toml:"ingest"`
+	Reminder  *ReminderSection    `toml:"reminder"`
+	SLA       *SLASection         `toml:"sla"`
+}
+
+// ApprovalSection represents the [approval] section in a TOML config: the agent
+// tools whose calls wait for a person in the run's Slack thread to approve them.
+type ApprovalSection struct {
+	// Tools are full tool names as the agent sees them, e.g. "case__close_case".
+	Tools []string `toml:"tools"`
+	// Approvers are the Slack user IDs who may answer the prompts of a run
+	// that has no case, such as the workspace agent's. Such a run has no
+	// members to check, so without approvers its gated calls are denied.
+	Approvers []string `toml:"approvers"`
 }
 
+// validate checks that every tool entry looks like a tool name, every
+// approver like a Slack user ID, and that each is listed once. Whether a tool
+// of that name exists is not checked: the palette differs per agent kind and
+// grows with integrations, and an entry for a tool a run does not have simply
+// never matches.
+func (s ApprovalSection) validate() error {
+	seen := make(map[string]bool, len(s.Tools))
+	for idx, name := range s.Tools {
+		if !approvalToolPattern.MatchString(name) {
+			return goerr.Wrap(ErrInvalidApprovalTool, "approval tool must be a <toolset>__<tool> name",
+				goerr.V("index", idx), goerr.V("tool", name))
+		}
+		if seen[name] {
+			return goerr.Wrap(ErrInvalidApprovalTool, "duplicate approval tool",
+				goerr.V("index", idx), goerr.V("tool", name))
+		}
+		seen[name] = true
+	}
+
+	approvers := make(map[string]bool, len(s.Approvers))
+	for idx, userID := range s.Approvers {
+		if !slackUserIDPattern.MatchString(userID) {
+			return goerr.Wrap(ErrInvalidApprovalApprover, "approver must be a Slack user ID",
+				goerr.V("index", idx), goerr.V("approver", userID))
+		}
+		if approvers[userID] {
+			return goerr.Wrap(ErrInvalidApprovalApprover, "duplicate approver",
+				goerr.V("index", idx), goerr.V("approver", userID))
+		}
+		approvers[userID] = true
+	}
+	return nil
+}
+
+// approvalToolPattern matches the "<toolset>__<tool>" naming every agent tool
+// follows.
+var approvalToolPattern = regexp.MustCompile(`^[a-z0-9]+__[a-z0-9_]+$`)
+
 // MemoSection represents the [memo] section in a TOML config. When omitted
 // (nil) or with no fields, the workspace does not enable the memo feature.
 type MemoSection struct {
@@ -299,6 +357,23 @@ type WorkspaceConfig struct {
 	// WorkspaceAgentPrompt is the resolved custom prompt for the workspace agent
 	// (from [slack.workspace_agent] prompt/prompt_file), empty when unset.
 	WorkspaceAgentPrompt string
+	// ApprovalTools are the agent tools whose calls need human approval, from
+	// [approval] tools.
+	ApprovalTools []string
+	// ApprovalApprovers are the Slack users who may approve the calls of a
+	// run without a case, from [approval] approvers.
+	ApprovalApprovers []string
+	// Webhooks are the outbound webhooks from [[webhook]], with secret_env
+	// resolved.
+	Webhooks []*model.Webhook
+	// Ingest is the alert mapping from [ingest], nil when absent.
+	Ingest *model.IngestMapping
+	// ActionReminder is the due-date reminder policy from [reminder], nil
+	// when absent.
+	ActionReminder *model.ActionReminderPolicy
+	// SLA is the response and resolution targets from [sla], nil when
+	// absent.
+	SLA *model.SLAPolicy
 }
 
 // Labels represents entity display labels
@@ -487,6 +562,27 @@ func (a *AppConfig) Validate() error {
 		return err
 	}
 
+	if err := a.Approval.validate(); err != nil {
+		return goerr.Wrap(err, "invalid [approval] section")
+	}
+
+	// Structural pass only: secret_env is resolved in parseWorkspaceConfig.
+	if _, err := a.resolveWebhooks(false); err != nil {
+		return goerr.Wrap(err, "invalid [[webhook]] section")
+	}
+
+	if _, err := a.resolveIngest(); err != nil {
+		return goerr.Wrap(err, "invalid [ingest] section")
+	}
+
+	if _, err := a.resolveReminder(); err != nil {
+		return goerr.Wrap(err, "invalid [reminder] section")
+	}
+
+	if _, err := a.resolveSLA(); err != nil {
+		return goerr.Wrap(err, "invalid [sla] section")
+	}
+
 	return nil
 }
 
@@ -697,8 +793,9 @@ type WorkspaceConfigSource struct {
 	Data []byte
 	// BaseDir is the directory a relative prompt_file is resolved against.
 	// Leave it empty for a document that has no directory of its own (one
-	// submitted over HTTP): the parse then reads no files at all and the
-	// prompt_file contents stay unresolved, which is the structural-validation
+	// submitted over HTTP): the parse then reads no files or environment
+	// variables at all, and the prompt_file contents and a webhook's
+	// secret_env stay unresolved, which is the structural-validation
 	// mode JobSection.Validate and WorkspaceAgentSection.resolvePrompt already
 	// implement. Resolving a submitted document's prompt_file against the
 	// server's filesystem would turn config submission into an arbitrary file
@@ -872,6 +969,29 @@ func parseWorkspaceConfig(src WorkspaceConfigSource) (*WorkspaceConfig, error) {
 		return nil, goerr.Wrap(err, "failed to resolve jobs", goerr.V(ConfigPathKey, path))
 	}
 
+	// secret_env is read only for a document from disk: a document submitted
+	// over HTTP resolves nothing from the server's environment, as it reads
+	// no prompt_file.
+	webhooks, err := appCfg.resolveWebhooks(baseDir != "")
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to resolve webhooks", goerr.V(ConfigPathKey, path))
+	}
+
+	ingest, err := appCfg.resolveIngest()
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to resolve ingest mapping", goerr.V(ConfigPathKey, path))
+	}
+
+	reminder, err := appCfg.resolveReminder()
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to resolve reminder policy", goerr.V(ConfigPathKey, path))
+	}
+
+	sla, err := appCfg.resolveSLA()
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to resolve sla policy", goerr.V(ConfigPathKey, path))
+	}
+
 	caseMode := model.CaseMode(appCfg.Slack.Mode).Normalize()
 	caseTrigger := model.CaseTrigger(appCfg.Slack.Trigger).Normalize()
 	caseStatusSet, err := appCfg.resolveCaseStatusSet()
@@ -914,6 +1034,10 @@ func parseWorkspaceConfig(src WorkspaceConfigSource) (*WorkspaceConfig, error) {
 			logging.Default().Warn("thread-mode workspace ignores channel-mode Slack settings (channel_prefix / invite / welcome_messages)",
 				"workspace_id", wsID, "config_path", path)
 		}
+		if appCfg.Reminder != nil {
+			logging.Default().Warn("thread-mode workspace ignores [reminder]: it has no Actions",
+				"workspace_id", wsID, "config_path", path)
+		}
 	} else {
 		if appCfg.Case != nil {
 			logging.Default().Warn("channel-mode workspace ignores [case.status]",
@@ -952,6 +1076,12 @@ func parseWorkspaceConfig(src WorkspaceConfigSource) (*WorkspaceConfig, error) {
 		ReactionEmoji:        normalizeReactionEmoji(appCfg.Slack.Reaction),
 		WorkspaceChannelID:   appCfg.Slack.WorkspaceChannel,
 		WorkspaceAgentPrompt: workspaceAgentPrompt,
+		ApprovalTools:        appCfg.Approval.Tools,
+		ApprovalApprovers:    appCfg.Approval.Approvers,
+		Webhooks:             webhooks,
+		Ingest:               ingest,
+		ActionReminder:       reminder,
+		SLA:                  sla,
 	}, nil
 }
 
@@ -1037,6 +1167,12 @@ func BuildWorkspaceRegistry(workspaceConfigs []*WorkspaceConfig) *model.Workspac
 			ReactionEmoji:           wc.ReactionEmoji,
 			SlackWorkspaceChannelID: wc.WorkspaceChannelID,
 			WorkspaceAgentPrompt:    wc.WorkspaceAgentPrompt,
+			ApprovalTools:           wc.ApprovalTools,
+			ApprovalApprovers:       wc.ApprovalApprovers,
+			Webhooks:                wc.Webhooks,
+			Ingest:                  wc.Ingest,
+			ActionReminder:          wc.ActionReminder,
+			SLA:                     wc.SLA,
 		})
 	}
 
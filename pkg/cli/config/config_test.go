@@ -2524,3 +2524,84 @@ reference_workspace = "risk"
 	gt.Value(t, err).NotNil()
 	gt.Error(t, err).Is(config.ErrUnexpectedReferenceWorkspace)
 }
+
+func TestLoadWorkspaceConfigs_Approval(t *testing.T) {
+	load := func(t *testing.T, approval string) ([]*config.WorkspaceConfig, error) {
+		t.Helper()
+		content := `
+[workspace]
+id = "risk"
+name = "Risk"
+` + approval
+		configPath := filepath.Join(t.TempDir(), "risk.toml")
+		gt.NoError(t, os.WriteFile(configPath, []byte(content), 0644)).Required()
+		return config.LoadWorkspaceConfigs([]string{configPath})
+	}
+
+	t.Run("tools reach the registry", func(t *testing.T) {
+		configs, err := load(t, `
+[approval]
+tools = ["case__close_case", "memo__apply_memo_changes"]
+`)
+		gt.NoError(t, err).Required()
+		gt.Array(t, configs).Length(1).Required()
+		gt.Array(t, configs[0].ApprovalTools).Equal([]string{"case__close_case", "memo__apply_memo_changes"})
+
+		entry, err := config.BuildWorkspaceRegistry(configs).Get("risk")
+		gt.NoError(t, err).Required()
+		gt.Bool(t, entry.RequiresApproval("case__close_case")).True()
+		gt.Bool(t, entry.RequiresApproval("case__update_case")).False()
+	})
+
+	t.Run("omitted gates nothing", func(t *testing.T) {
+		configs, err := load(t, "")
+		gt.NoError(t, err).Required()
+		gt.Array(t, configs[0].ApprovalTools).Length(0)
+		gt.Array(t, configs[0].ApprovalApprovers).Length(0)
+	})
+
+	t.Run("approvers reach the registry", func(t *testing.T) {
+		configs, err := load(t, `
+[approval]
+tools = ["case__close_case"]
+approvers = ["U0SECLEAD", "W0GRIDUSER"]
+`)
+		gt.NoError(t, err).Required()
+
+		entry, err := config.BuildWorkspaceRegistry(configs).Get("risk")
+		gt.NoError(t, err).Required()
+		gt.Array(t, entry.ApprovalApprovers).Equal([]string{"U0SECLEAD", "W0GRIDUSER"})
+	})
+
+	t.Run("an approver that is not a user ID is rejected", func(t *testing.T) {
+		_, err := load(t, `
+[approval]
+approvers = ["@seclead"]
+`)
+		gt.Error(t, err).Is(config.ErrInvalidApprovalApprover)
+	})
+
+	t.Run("a duplicate approver is rejected", func(t *testing.T) {
+		_, err := load(t, `
+[approval]
+approvers = ["U0SECLEAD", "U0SECLEAD"]
+`)
+		gt.Error(t, err).Is(config.ErrInvalidApprovalApprover)
+	})
+
+	t.Run("a name without a toolset is rejected", func(t *testing.T) {
+		_, err := load(t, `
+[approval]
+tools = ["close_case"]
+`)
+		gt.Error(t, err).Is(config.ErrInvalidApprovalTool)
+	})
+
+	t.Run("a duplicate is rejected", func(t *testing.T) {
+		_, err := load(t, `
+[approval]
+tools = ["case__close_case", "case__close_case"]
+`)
+		gt.Error(t, err).Is(config.ErrInvalidApprovalTool)
+	})
+}
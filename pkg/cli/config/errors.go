@@ -110,6 +110,30 @@ var (
 	// --config (1 file = 1 workspace); the global config is for deployment-wide
 	// settings only, so mixing the two is rejected loudly rather than ignored.
 	ErrGlobalConfigContainsWorkspace = goerr.New("global config file must not contain a [workspace] section")
+	// ErrInvalidApprovalTool is returned when an [approval] tools entry is not a
+	// "<toolset>__<tool>" name or appears more than once.
+	ErrInvalidApprovalTool = goerr.New("invalid [approval] tool name")
+	// ErrInvalidApprovalApprover is returned when an [approval] approvers
+	// entry is not a Slack user ID or appears more than once.
+	ErrInvalidApprovalApprover = goerr.New("invalid [approval] approver")
+	// ErrInvalidWebhook is returned when a [[webhook]] entry is malformed: a
+	// bad id or url, an unknown event, an unparsable template, or a secret
+	// that cannot be resolved.
+	ErrInvalidWebhook = goerr.New("invalid [[webhook]] entry")
+	// ErrInvalidIngest is returned when the [ingest] section has no title, an
+	// unparsable template, or maps a field that [[fields]] does not define.
+	ErrInvalidIngest = goerr.New("invalid [ingest] section")
+	// ErrInvalidReminder is returned when a [reminder] duration is not a
+	// valid Go duration or is negative.
+	ErrInvalidReminder = goerr.New("invalid [reminder] section")
+	// ErrInvalidSLA is returned when an [sla] rule names an unknown or
+	// non-select field or option, sets no valid duration, or a pause status
+	// is not an open, non-initial [case.status] of a thread-mode workspace.
+	ErrInvalidSLA = goerr.New("invalid [sla] section")
+	// ErrInvalidOIDCConfig is returned when --oidc-issuer is set without the
+	// rest of what the provider needs, with an unusable --oidc-name, or
+	// without --oidc-allowed-domains or the --oidc-allow-any-domain opt-out.
+	ErrInvalidOIDCConfig = goerr.New("invalid OIDC configuration")
 
 	// --- Global config ([export]) ---
 
@@ -128,6 +152,16 @@ var (
 	// ErrDuplicateExportConfig is returned when more than one global config file
 	// defines an [export] section.
 	ErrDuplicateExportConfig = goerr.New("duplicate [export] section across global config files")
+	// ErrInvalidExportNamespace is returned when an [[export.file.workspace]] or
+	// [[export.postgres.workspace]] namespace is not a valid directory / schema
+	// name.
+	ErrInvalidExportNamespace = goerr.New("invalid export namespace")
+	// ErrInvalidExportSink is returned when --export-sink names a sink that is
+	// not configured, or when it is omitted while several sinks are.
+	ErrInvalidExportSink = goerr.New("invalid export sink selection")
+	// ErrInvalidExportMode is returned when --export-mode is neither full nor
+	// incremental.
+	ErrInvalidExportMode = goerr.New("invalid export mode")
 
 	// --- Global config ([agent], [[llm_model]]) ---
 
@@ -171,5 +205,8 @@ const (
 	WorkspaceGroupIDKey = "workspace_group_id"
 	GroupMemberKey      = "group_member"
 	ExportDatasetKey    = "dataset"
+	ExportNamespaceKey  = "namespace"
+	ExportSinkKey       = "export_sink"
+	ExportModeKey       = "export_mode"
 	LLMModelRefKey      = "llm_model"
 )
@@ -0,0 +1,68 @@
+package config
+
+import (
+	"strings"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/urfave/cli/v3"
+)
+
+// Export run modes accepted by --export-mode.
+const (
+	ExportModeFull        = "full"
+	ExportModeIncremental = "incremental"
+)
+
+// Export holds the CLI flags of the `export` subcommand. Where the data goes is
+// configured in [export] of the global config; these flags only pick one of the
+// configured sinks and carry the credential that must not live in a TOML file.
+type Export struct {
+	sink        string
+	postgresDSN string
+	mode        string
+}
+
+// Flags returns the CLI flags for export sink selection.
+func (e *Export) Flags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:        "export-sink",
+			Usage:       "Export sink to write to (bigquery, file, postgres). Optional when [export] configures exactly one",
+			Sources:     cli.EnvVars("HECATONCHEIRES_EXPORT_SINK"),
+			Destination: &e.sink,
+		},
+		&cli.StringFlag{
+			Name:        "export-postgres-dsn",
+			Usage:       "PostgreSQL connection string for the postgres export sink",
+			Sources:     cli.EnvVars("HECATONCHEIRES_EXPORT_POSTGRES_DSN"),
+			Destination: &e.postgresDSN,
+		},
+		&cli.StringFlag{
+			Name:        "export-mode",
+			Usage:       "Export mode: full (refresh every table) or incremental (merge rows changed since the last run; bigquery and postgres only)",
+			Sources:     cli.EnvVars("HECATONCHEIRES_EXPORT_MODE"),
+			Value:       ExportModeFull,
+			Destination: &e.mode,
+		},
+	}
+}
+
+// Sink returns the --export-sink value (empty when unset).
+func (e *Export) Sink() string { return e.sink }
+
+// PostgresDSN returns the --export-postgres-dsn value.
+func (e *Export) PostgresDSN() string { return e.postgresDSN }
+
+// Mode returns the --export-mode value, lower-cased and validated.
+func (e *Export) Mode() (string, error) {
+	mode := strings.ToLower(e.mode)
+	switch mode {
+	case "", ExportModeFull:
+		return ExportModeFull, nil
+	case ExportModeIncremental:
+		return mode, nil
+	default:
+		return "", goerr.Wrap(ErrInvalidExportMode, "--export-mode must be full or incremental",
+			goerr.V(ExportModeKey, e.mode))
+	}
+}
@@ -0,0 +1,53 @@
+package config_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/urfave/cli/v3"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/cli/config"
+)
+
+// runExport parses args through a throwaway command so the export flags'
+// defaults and env-var wiring are exercised the same way the real command does.
+func runExport(t *testing.T, args ...string) *config.Export {
+	t.Helper()
+	var e config.Export
+	cmd := &cli.Command{
+		Name:  "test",
+		Flags: e.Flags(),
+		Action: func(_ context.Context, _ *cli.Command) error {
+			return nil
+		},
+	}
+	gt.NoError(t, cmd.Run(context.Background(), append([]string{"test"}, args...))).Required()
+	return &e
+}
+
+func TestExport_Mode(t *testing.T) {
+	t.Run("defaults to full", func(t *testing.T) {
+		mode, err := runExport(t).Mode()
+		gt.NoError(t, err).Required()
+		gt.String(t, mode).Equal(config.ExportModeFull)
+	})
+
+	t.Run("incremental from the flag, case-insensitively", func(t *testing.T) {
+		mode, err := runExport(t, "--export-mode", "Incremental").Mode()
+		gt.NoError(t, err).Required()
+		gt.String(t, mode).Equal(config.ExportModeIncremental)
+	})
+
+	t.Run("incremental from the environment", func(t *testing.T) {
+		t.Setenv("HECATONCHEIRES_EXPORT_MODE", "incremental")
+		mode, err := runExport(t).Mode()
+		gt.NoError(t, err).Required()
+		gt.String(t, mode).Equal(config.ExportModeIncremental)
+	})
+
+	t.Run("unknown mode is rejected", func(t *testing.T) {
+		_, err := runExport(t, "--export-mode", "delta").Mode()
+		gt.Error(t, err).Is(config.ErrInvalidExportMode)
+	})
+}
@@ -18,6 +18,11 @@ func NewSlackForTest(clientID, clientSecret, botToken, signingSecret, noAuthUID
 }
 
 // SetOrgLevelForTest sets org-level detection results for testing purposes
+// SetAppTokenForTest sets the Socket Mode app-level token.
+func (x *Slack) SetAppTokenForTest(token string) {
+	x.appToken = token
+}
+
 func (x *Slack) SetOrgLevelForTest(isOrgLevel bool, authTeamID string) {
 	x.isOrgLevel = isOrgLevel
 	x.authTeamID = authTeamID
@@ -229,26 +229,130 @@ func (a *AppConfig) ConfigureGroups(c *cli.Command, ws *model.WorkspaceRegistry)
 
 // ExportSection represents the [export] section of a global config file: the
 // deployment-wide configuration for the `export` subcommand. Nil when no global
-// config declares [export] (the feature is then unavailable).
+// config declares [export] (the feature is then unavailable). At least one sink
+// sub-section must be present; when several are, --export-sink picks one.
 type ExportSection struct {
 	// IncludePrivate is the default for every workspace: when true, private Cases
 	// (and their Actions / Memos) are exported too. Defaults to false — private
 	// data is NOT exported unless explicitly opted in. A per-workspace mapping may
 	// override it.
 	IncludePrivate bool `toml:"include_private"`
-	// BigQuery is the BigQuery sink configuration. Required (the only sink today).
+	// BigQuery is the BigQuery sink configuration.
 	BigQuery *ExportBigQuerySection `toml:"bigquery"`
+	// File is the local-file (Parquet / NDJSON) sink configuration.
+	File *ExportFileSection `toml:"file"`
+	// Postgres is the PostgreSQL sink configuration. The DSN is a credential and
+	// is supplied through --export-postgres-dsn, not here.
+	Postgres *ExportPostgresSection `toml:"postgres"`
 }
 
+// ExportSinkKind names one export sink. It is both the [export.<kind>] section
+// name and the value --export-sink accepts.
+type ExportSinkKind string
+
+const (
+	ExportSinkBigQuery ExportSinkKind = "bigquery"
+	ExportSinkFile     ExportSinkKind = "file"
+	ExportSinkPostgres ExportSinkKind = "postgres"
+)
+
+// ExportFileFormat is the on-disk encoding of the file sink.
+type ExportFileFormat string
+
+const (
+	ExportFileFormatParquet ExportFileFormat = "parquet"
+	ExportFileFormatNDJSON  ExportFileFormat = "ndjson"
+)
+
 // IncludePrivateFor returns the effective include_private for a mapping: the
 // mapping's own value when set, otherwise the section-level default.
 func (s *ExportSection) IncludePrivateFor(m ExportWorkspaceMapping) bool {
-	if m.IncludePrivate != nil {
-		return *m.IncludePrivate
+	return s.includePrivate(m.IncludePrivate)
+}
+
+func (s *ExportSection) includePrivate(override *bool) bool {
+	if override != nil {
+		return *override
 	}
 	return s.IncludePrivate
 }
 
+// ExportTarget is the sink-neutral form of one workspace mapping: the workspace,
+// its namespace inside the selected sink (dataset, directory or schema) and the
+// resolved include_private.
+type ExportTarget struct {
+	WorkspaceID    string
+	Namespace      string
+	IncludePrivate bool
+}
+
+// Targets resolves the workspace mappings of the given sink. An unconfigured
+// sink yields nil.
+func (s *ExportSection) Targets(kind ExportSinkKind) []ExportTarget {
+	var out []ExportTarget
+	switch kind {
+	case ExportSinkBigQuery:
+		if s.BigQuery == nil {
+			return nil
+		}
+		for _, m := range s.BigQuery.Workspaces {
+			out = append(out, ExportTarget{WorkspaceID: m.ID, Namespace: m.Dataset, IncludePrivate: s.IncludePrivateFor(m)})
+		}
+	case ExportSinkFile:
+		if s.File == nil {
+			return nil
+		}
+		for _, m := range s.File.Workspaces {
+			out = append(out, ExportTarget{WorkspaceID: m.ID, Namespace: m.Namespace, IncludePrivate: s.includePrivate(m.IncludePrivate)})
+		}
+	case ExportSinkPostgres:
+		if s.Postgres == nil {
+			return nil
+		}
+		for _, m := range s.Postgres.Workspaces {
+			out = append(out, ExportTarget{WorkspaceID: m.ID, Namespace: m.Namespace, IncludePrivate: s.includePrivate(m.IncludePrivate)})
+		}
+	}
+	return out
+}
+
+// ConfiguredSinks lists the sinks that have a sub-section, in a fixed order.
+func (s *ExportSection) ConfiguredSinks() []ExportSinkKind {
+	var out []ExportSinkKind
+	if s.BigQuery != nil {
+		out = append(out, ExportSinkBigQuery)
+	}
+	if s.File != nil {
+		out = append(out, ExportSinkFile)
+	}
+	if s.Postgres != nil {
+		out = append(out, ExportSinkPostgres)
+	}
+	return out
+}
+
+// SelectSink resolves the sink a run writes to. An explicit name must refer to
+// a configured sink; an empty name is accepted only when exactly one sink is
+// configured, so adding a second sink never silently changes where an
+// unattended export goes.
+func (s *ExportSection) SelectSink(name string) (ExportSinkKind, error) {
+	configured := s.ConfiguredSinks()
+	if name == "" {
+		if len(configured) != 1 {
+			return "", goerr.Wrap(ErrInvalidExportSink,
+				"--export-sink is required when more than one export sink is configured",
+				goerr.V("configured", configured))
+		}
+		return configured[0], nil
+	}
+	kind := ExportSinkKind(strings.ToLower(name))
+	if !slices.Contains(configured, kind) {
+		return "", goerr.Wrap(ErrInvalidExportSink, "export sink is not configured in [export]",
+			goerr.V(ExportSinkKey, name), goerr.V("configured", configured))
+	}
+	return kind, nil
+}
+
 // ExportBigQuerySection configures the BigQuery export sink.
 type ExportBigQuerySection struct {
 	// Project is the destination GCP project ID. Required.
@@ -273,13 +377,83 @@ type ExportWorkspaceMapping struct {
 	IncludePrivate *bool `toml:"include_private"`
 }
 
-// Validate checks the export section against the workspace registry: BigQuery
-// project presence, dataset-name validity, uniqueness of workspace ids and
-// dataset names, and existence of every referenced workspace.
+// ExportFileSection configures the local-file export sink: one file per table
+// under <dir>/<namespace>/, or the same layout packed into a tar.gz.
+type ExportFileSection struct {
+	// Dir is the output directory. Exactly one of Dir or Tarball is required.
+	Dir string `toml:"dir"`
+	// Tarball is the path of a .tar.gz written once the run finishes.
+	Tarball string `toml:"tarball"`
+	// Format is "parquet" (default) or "ndjson".
+	Format ExportFileFormat `toml:"format"`
+	// Workspaces maps each exported workspace to its subdirectory.
+	Workspaces []ExportNamespaceMapping `toml:"workspace"`
+}
+
+// EffectiveFormat returns Format, defaulting to Parquet.
+func (s *ExportFileSection) EffectiveFormat() ExportFileFormat {
+	if s.Format == "" {
+		return ExportFileFormatParquet
+	}
+	return s.Format
+}
+
+// ExportPostgresSection configures the PostgreSQL export sink.
+type ExportPostgresSection struct {
+	// Workspaces maps each exported workspace to its destination schema.
+	Workspaces []ExportNamespaceMapping `toml:"workspace"`
+}
+
+// ExportNamespaceMapping maps one workspace to a namespace of a non-BigQuery
+// sink: a subdirectory for the file sink, a schema for PostgreSQL.
+type ExportNamespaceMapping struct {
+	ID        string `toml:"id"`
+	Namespace string `toml:"namespace"`
+	// IncludePrivate overrides the section-level default, as on
+	// ExportWorkspaceMapping.
+	IncludePrivate *bool `toml:"include_private"`
+}
+
+// maxPostgresIdentLength is PostgreSQL's identifier length limit (NAMEDATALEN-1).
+const maxPostgresIdentLength = 63
+
+// Validate checks the export section against the workspace registry: at least
+// one sink, each configured sink's own settings, namespace validity, uniqueness
+// of workspace ids and namespaces per sink, and existence of every referenced
+// workspace.
 func (s *ExportSection) Validate(ws *model.WorkspaceRegistry) error {
-	if s.BigQuery == nil {
-		return goerr.Wrap(ErrInvalidExportConfig, "[export.bigquery] section is required")
+	if len(s.ConfiguredSinks()) == 0 {
+		return goerr.Wrap(ErrInvalidExportConfig,
+			"[export] requires one of [export.bigquery], [export.file] or [export.postgres]")
 	}
+	if s.BigQuery != nil {
+		if err := s.validateBigQuery(ws); err != nil {
+			return err
+		}
+	}
+	if s.File != nil {
+		if (s.File.Dir == "") == (s.File.Tarball == "") {
+			return goerr.Wrap(ErrInvalidExportConfig, "[export.file] requires exactly one of dir or tarball")
+		}
+		switch s.File.EffectiveFormat() {
+		case ExportFileFormatParquet, ExportFileFormatNDJSON:
+		default:
+			return goerr.Wrap(ErrInvalidExportConfig, "[export.file] format must be \"parquet\" or \"ndjson\"",
+				goerr.V("format", s.File.Format))
+		}
+		if err := validateNamespaceMappings("export.file", s.File.Workspaces, 0, ws); err != nil {
+			return err
+		}
+	}
+	if s.Postgres != nil {
+		if err := validateNamespaceMappings("export.postgres", s.Postgres.Workspaces, maxPostgresIdentLength, ws); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ExportSection) validateBigQuery(ws *model.WorkspaceRegistry) error {
 	if s.BigQuery.Project == "" {
 		return goerr.Wrap(ErrInvalidExportConfig, "[export.bigquery] project is required")
 	}
@@ -313,6 +487,45 @@ func (s *ExportSection) Validate(ws *model.WorkspaceRegistry) error {
 	return nil
 }
 
+// validateNamespaceMappings checks the [[<section>.workspace]] list of a
+// non-BigQuery sink. Namespaces use the dataset charset so the same name works
+// as a directory on every OS and as an unquoted-safe SQL identifier; maxLen
+// additionally bounds it when non-zero.
+func validateNamespaceMappings(section string, mappings []ExportNamespaceMapping, maxLen int, ws *model.WorkspaceRegistry) error {
+	seenID := make(map[string]bool, len(mappings))
+	seenNS := make(map[string]bool, len(mappings))
+	for _, m := range mappings {
+		if m.ID == "" {
+			return goerr.Wrap(ErrInvalidExportConfig, "[["+section+".workspace]] id is required")
+		}
+		if !datasetIDPattern.MatchString(m.Namespace) || (maxLen > 0 && len(m.Namespace) > maxLen) {
+			return goerr.Wrap(ErrInvalidExportNamespace,
+				"namespace must be letters/numbers/underscores only",
+				goerr.V(WorkspaceIDKey, m.ID), goerr.V(ExportNamespaceKey, m.Namespace),
+				goerr.V("max_length", maxLen))
+		}
+		if seenID[m.ID] {
+			return goerr.Wrap(ErrDuplicateExportWorkspace, "duplicate export workspace id",
+				goerr.V(WorkspaceIDKey, m.ID))
+		}
+		// Namespaces are compared case-insensitively: common filesystems fold
+		// directory names, so "Risk" and "risk" would share one directory.
+		ns := strings.ToLower(m.Namespace)
+		if seenNS[ns] {
+			return goerr.Wrap(ErrDuplicateExportWorkspace, "duplicate export namespace",
+				goerr.V(ExportNamespaceKey, m.Namespace))
+		}
+		seenID[m.ID] = true
+		seenNS[ns] = true
+		if _, err := ws.Get(m.ID); err != nil {
+			return goerr.Wrap(ErrUnknownExportWorkspace,
+				"export workspace mapping references an unknown workspace",
+				goerr.V(WorkspaceIDKey, m.ID))
+		}
+	}
+	return nil
+}
+
 // LoadExportConfig walks the given file/dir paths, parses each .toml as a
 // GlobalConfig, and returns the single [export] section found. It returns (nil,
 // nil) when no file declares [export], and an error when more than one does (the
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"slices"
+	"strings"
 	"testing"
 
 	"github.com/m-mizutani/gt"
@@ -392,6 +393,135 @@ func TestExportSection_Validate(t *testing.T) {
 	})
 }
 
+const multiSinkExportTOML = `
+[export]
+
+[export.bigquery]
+project = "my-project"
+
+[[export.bigquery.workspace]]
+id = "risk"
+dataset = "hecato_risk"
+
+[export.file]
+dir = "/var/lib/hecatoncheires/export"
+format = "ndjson"
+
+[[export.file.workspace]]
+id = "risk"
+namespace = "risk"
+include_private = true
+
+[export.postgres]
+
+[[export.postgres.workspace]]
+id = "incident"
+namespace = "incident"
+`
+
+func TestLoadExportConfig_MultipleSinks(t *testing.T) {
+	section, err := config.LoadExportConfig([]string{writeGlobalConfig(t, "e.toml", multiSinkExportTOML)})
+	gt.NoError(t, err).Required()
+	gt.NoError(t, section.Validate(wsRegistry("risk", "incident"))).Required()
+
+	gt.Array(t, section.ConfiguredSinks()).Equal([]config.ExportSinkKind{
+		config.ExportSinkBigQuery, config.ExportSinkFile, config.ExportSinkPostgres,
+	})
+	gt.Value(t, section.File.EffectiveFormat()).Equal(config.ExportFileFormatNDJSON)
+
+	gt.Array(t, section.Targets(config.ExportSinkBigQuery)).Equal([]config.ExportTarget{
+		{WorkspaceID: "risk", Namespace: "hecato_risk", IncludePrivate: false},
+	})
+	gt.Array(t, section.Targets(config.ExportSinkFile)).Equal([]config.ExportTarget{
+		{WorkspaceID: "risk", Namespace: "risk", IncludePrivate: true},
+	})
+	gt.Array(t, section.Targets(config.ExportSinkPostgres)).Equal([]config.ExportTarget{
+		{WorkspaceID: "incident", Namespace: "incident", IncludePrivate: false},
+	})
+}
+
+func TestExportSection_SelectSink(t *testing.T) {
+	single := &config.ExportSection{File: &config.ExportFileSection{Dir: "/tmp/x"}}
+	multi := &config.ExportSection{
+		BigQuery: &config.ExportBigQuerySection{Project: "p"},
+		Postgres: &config.ExportPostgresSection{},
+	}
+
+	t.Run("single sink needs no name", func(t *testing.T) {
+		kind, err := single.SelectSink("")
+		gt.NoError(t, err)
+		gt.Value(t, kind).Equal(config.ExportSinkFile)
+	})
+
+	t.Run("several sinks require a name", func(t *testing.T) {
+		_, err := multi.SelectSink("")
+		gt.Error(t, err).Is(config.ErrInvalidExportSink)
+	})
+
+	t.Run("explicit name is case-insensitive", func(t *testing.T) {
+		kind, err := multi.SelectSink("Postgres")
+		gt.NoError(t, err)
+		gt.Value(t, kind).Equal(config.ExportSinkPostgres)
+	})
+
+	t.Run("unconfigured sink is rejected", func(t *testing.T) {
+		_, err := multi.SelectSink("file")
+		gt.Error(t, err).Is(config.ErrInvalidExportSink)
+	})
+}
+
+func TestExportSection_ValidateFileAndPostgres(t *testing.T) {
+	reg := wsRegistry("risk", "incident")
+
+	t.Run("file requires dir or tarball", func(t *testing.T) {
+		s := &config.ExportSection{File: &config.ExportFileSection{}}
+		gt.Error(t, s.Validate(reg)).Is(config.ErrInvalidExportConfig)
+	})
+
+	t.Run("file rejects both dir and tarball", func(t *testing.T) {
+		s := &config.ExportSection{File: &config.ExportFileSection{Dir: "/a", Tarball: "/b.tar.gz"}}
+		gt.Error(t, s.Validate(reg)).Is(config.ErrInvalidExportConfig)
+	})
+
+	t.Run("file rejects an unknown format", func(t *testing.T) {
+		s := &config.ExportSection{File: &config.ExportFileSection{Dir: "/a", Format: "csv"}}
+		gt.Error(t, s.Validate(reg)).Is(config.ErrInvalidExportConfig)
+	})
+
+	t.Run("namespace must be a safe identifier", func(t *testing.T) {
+		s := &config.ExportSection{File: &config.ExportFileSection{
+			Dir:        "/a",
+			Workspaces: []config.ExportNamespaceMapping{{ID: "risk", Namespace: "../risk"}},
+		}}
+		gt.Error(t, s.Validate(reg)).Is(config.ErrInvalidExportNamespace)
+	})
+
+	t.Run("namespaces collide case-insensitively", func(t *testing.T) {
+		s := &config.ExportSection{File: &config.ExportFileSection{
+			Dir: "/a",
+			Workspaces: []config.ExportNamespaceMapping{
+				{ID: "risk", Namespace: "Risk"},
+				{ID: "incident", Namespace: "risk"},
+			},
+		}}
+		gt.Error(t, s.Validate(reg)).Is(config.ErrDuplicateExportWorkspace)
+	})
+
+	t.Run("postgres schema length is bounded", func(t *testing.T) {
+		s := &config.ExportSection{Postgres: &config.ExportPostgresSection{
+			Workspaces: []config.ExportNamespaceMapping{{ID: "risk", Namespace: strings.Repeat("a", 64)}},
+		}}
+		gt.Error(t, s.Validate(reg)).Is(config.ErrInvalidExportNamespace)
+	})
+
+	t.Run("postgres unknown workspace", func(t *testing.T) {
+		s := &config.ExportSection{Postgres: &config.ExportPostgresSection{
+			Workspaces: []config.ExportNamespaceMapping{{ID: "nope", Namespace: "nope"}},
+		}}
+		gt.Error(t, s.Validate(reg)).Is(config.ErrUnknownExportWorkspace)
+	})
+}
+
 // TestLoadLLMModels_Basic pins the resolved form of a definition: the reference
 // name comes from the alias when there is one, prices are converted out of the
 // dollars-per-million-tokens an operator writes, and the provider survives.
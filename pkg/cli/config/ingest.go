@@ -0,0 +1,142 @@
+package config
+
+import (
+	"log/slog"
+	"maps"
+	"os"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/urfave/cli/v3"
+)
+
+// IngestSection is the TOML shape of the [ingest] section: how an alert
+// posted to the workspace's ingest endpoint becomes a case. Each value is a
+// Go text/template executed over the alert's JSON body.
+type IngestSection struct {
+	// Title renders the case title. Required.
+	Title       string `toml:"title"`
+	Description string `toml:"description"`
+	// Fingerprint renders the key that identifies repeats of an alert.
+	// Empty uses the rendered title.
+	Fingerprint string `toml:"fingerprint"`
+	// Fields maps a [[fields]] ID to the template of its value.
+	Fields map[string]string `toml:"fields"`
+	// Reporter is the Slack user ID reported for a case an alert opens when
+	// the ingest policy returns no user. A channel-mode case needs one.
+	Reporter string `toml:"reporter"`
+}
+
+// resolveIngest validates the [ingest] section and returns the mapping it
+// describes, or nil when the section is absent.
+func (a *AppConfig) resolveIngest() (*model.IngestMapping, error) {
+	s := a.Ingest
+	if s == nil {
+		return nil, nil
+	}
+	if s.Title == "" {
+		return nil, goerr.Wrap(ErrInvalidIngest, "ingest title is required")
+	}
+
+	templates := map[string]string{
+		"title":       s.Title,
+		"description": s.Description,
+		"fingerprint": s.Fingerprint,
+	}
+	known := make(map[string]bool, len(a.Fields))
+	for _, f := range a.Fields {
+		known[f.ID] = true
+	}
+	for id, text := range s.Fields {
+		if !known[id] {
+			return nil, goerr.Wrap(ErrInvalidIngest, "ingest field is not defined in [[fields]]",
+				goerr.V("field_id", id))
+		}
+		templates["fields."+id] = text
+	}
+	for name, text := range templates {
+		if text == "" {
+			continue
+		}
+		if _, err := model.ParseIngestTemplate(name, text); err != nil {
+			return nil, goerr.Wrap(ErrInvalidIngest, "invalid ingest template",
+				goerr.V("template", name), goerr.V("parse_error", err.Error()))
+		}
+	}
+
+	return &model.IngestMapping{
+		Title:       s.Title,
+		Description: s.Description,
+		Fingerprint: s.Fingerprint,
+		Fields:      maps.Clone(s.Fields),
+		Reporter:    s.Reporter,
+	}, nil
+}
+
+// Ingest holds the configuration for the alert ingestion endpoint. Like MCP
+// it is authorized by the shared Policy configuration, and is only served
+// when a policy is configured.
+type Ingest struct {
+	enabled bool
+	// envPassthrough is read from the cli.Command in Configure, since
+	// urfave/cli/v3 StringSliceFlag does not support Destination.
+	envPassthrough []string
+}
+
+// Flags returns CLI flags for the ingestion endpoint.
+func (i *Ingest) Flags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{
+			Name:        "ingest",
+			Usage:       "Enable the alert ingestion endpoint at /hooks/ingest/{workspace} (requires --policy)",
+			Sources:     cli.EnvVars("HECATONCHEIRES_INGEST"),
+			Destination: &i.enabled,
+			Category:    "Ingest",
+		},
+		&cli.StringSliceFlag{
+			Name:     "ingest-env",
+			Usage:    "Names of environment variables to expose to the ingest Rego policy as input.env (allow-list). Can be specified multiple times.",
+			Sources:  cli.EnvVars("HECATONCHEIRES_INGEST_ENV"),
+			Category: "Ingest",
+		},
+	}
+}
+
+// IsEnabled reports whether the ingestion endpoint should be wired.
+func (i *Ingest) IsEnabled() bool {
+	return i.enabled
+}
+
+// Configure validates the ingestion configuration against the shared policy
+// client and returns the env snapshot. When disabled it returns (nil, nil);
+// when enabled without a policy it returns an error, since the policy is the
+// endpoint's only credential check.
+func (i *Ingest) Configure(c *cli.Command, pc interfaces.PolicyClient) (map[string]string, error) {
+	if !i.enabled {
+		return nil, nil
+	}
+
+	i.envPassthrough = c.StringSlice("ingest-env")
+
+	if pc == nil {
+		return nil, goerr.New("--ingest requires at least one --policy path; refusing to accept alerts without an authorization policy")
+	}
+
+	out := make(map[string]string, len(i.envPassthrough))
+	for _, name := range i.envPassthrough {
+		if v, ok := os.LookupEnv(name); ok {
+			out[name] = v
+		}
+	}
+	return out, nil
+}
+
+// LogAttrs returns log attributes describing the ingestion configuration.
+// Only the env variable names are logged, never their values.
+func (i *Ingest) LogAttrs() []slog.Attr {
+	return []slog.Attr{
+		slog.Bool("enabled", i.enabled),
+		slog.Any("env_passthrough", i.envPassthrough),
+	}
+}
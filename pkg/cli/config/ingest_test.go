@@ -0,0 +1,79 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/cli/config"
+)
+
+func TestLoadWorkspaceConfigs_Ingest(t *testing.T) {
+	load := func(t *testing.T, ingest string) ([]*config.WorkspaceConfig, error) {
+		t.Helper()
+		content := `
+[workspace]
+id = "soc"
+name = "SOC"
+
+[[fields]]
+id = "severity"
+name = "Severity"
+type = "text"
+` + ingest
+		configPath := filepath.Join(t.TempDir(), "soc.toml")
+		gt.NoError(t, os.WriteFile(configPath, []byte(content), 0644)).Required()
+		return config.LoadWorkspaceConfigs([]string{configPath})
+	}
+
+	t.Run("mapping reaches the registry", func(t *testing.T) {
+		configs, err := load(t, `
+[ingest]
+title = "{{ .rule.name }}"
+description = "{{ json . }}"
+fingerprint = "{{ .rule.id }}"
+reporter = "UALERTBOT"
+
+[ingest.fields]
+severity = "{{ .severity }}"
+`)
+		gt.NoError(t, err).Required()
+
+		entry, err := config.BuildWorkspaceRegistry(configs).Get("soc")
+		gt.NoError(t, err).Required()
+		gt.Value(t, entry.Ingest).NotNil().Required()
+		gt.Value(t, entry.Ingest.Title).Equal("{{ .rule.name }}")
+		gt.Value(t, entry.Ingest.Fields["severity"]).Equal("{{ .severity }}")
+		gt.Value(t, entry.Ingest.Reporter).Equal("UALERTBOT")
+	})
+
+	t.Run("omitted accepts no alerts", func(t *testing.T) {
+		configs, err := load(t, "")
+		gt.NoError(t, err).Required()
+		gt.Value(t, configs[0].Ingest).Nil()
+	})
+
+	for name, tc := range map[string]string{
+		"missing title": `
+[ingest]
+description = "{{ .detail }}"
+`,
+		"malformed template": `
+[ingest]
+title = "{{ .rule "
+`,
+		"unknown field": `
+[ingest]
+title = "{{ .rule }}"
+
+[ingest.fields]
+priority = "{{ .priority }}"
+`,
+	} {
+		t.Run(name+" is rejected", func(t *testing.T) {
+			_, err := load(t, tc)
+			gt.Error(t, err).Is(config.ErrInvalidIngest)
+		})
+	}
+}
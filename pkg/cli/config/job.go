@@ -82,9 +82,13 @@ type JobEventsSection struct {
 
 // CaseEventSection is the filter for `events.case`. The TOML `on` field is
 // always an array; we deliberately do not accept a single string so the
-// schema stays type-safe.
+// schema stays type-safe. `field` narrows field_changed to one custom field
+// ID, and `to` narrows field_changed / status_changed / assigned to the given
+// new values (option IDs, board status IDs or Slack user IDs).
 type CaseEventSection struct {
-	On []string `toml:"on"`
+	On    []string `toml:"on"`
+	Field string   `toml:"field"`
+	To    []string `toml:"to"`
 }
 
 // ScheduledEventSection is the filter for `events.scheduled`. Exactly one
@@ -281,7 +285,13 @@ func (c *CaseEventSection) toModel() (*model.CaseEventConfig, error) {
 		seen[lc] = struct{}{}
 		on = append(on, lc)
 	}
-	return &model.CaseEventConfig{On: on}, nil
+	out := &model.CaseEventConfig{On: on, Field: c.Field, To: c.To}
+	// The remaining cross-field rules (field / to need a lifecycle they can
+	// apply to) live on the domain type so there is exactly one copy.
+	if err := out.Validate(); err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
 func (s *ScheduledEventSection) toModel() (*model.ScheduledEventConfig, error) {
@@ -5,25 +5,23 @@ import (
 	"os"
 
 	"github.com/m-mizutani/goerr/v2"
-	"github.com/secmon-lab/hecatoncheires/pkg/adapter/policy"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
 	"github.com/urfave/cli/v3"
 )
 
 // MCP holds the configuration for the MCP (Model Context Protocol) server
-// endpoint and its Rego-based authorization. The MCP endpoint is only exposed
-// when enabled, and only when at least one policy path is supplied — we never
-// serve MCP without an authorization policy.
+// endpoint. Its Rego policy comes from the shared Policy configuration; the
+// MCP endpoint is only exposed when enabled, and only when a policy is
+// configured — we never serve MCP without an authorization policy.
 type MCP struct {
 	enabled bool
-	// policyPaths and envPassthrough are read from the cli.Command in
-	// Configure, since urfave/cli/v3 StringSliceFlag does not support
-	// Destination.
-	policyPaths    []string
+	// envPassthrough is read from the cli.Command in Configure, since
+	// urfave/cli/v3 StringSliceFlag does not support Destination.
 	envPassthrough []string
 }
 
-// Flags returns CLI flags for the MCP server and its policy source.
+// Flags returns CLI flags for the MCP server. The policy source is configured
+// by Policy.
 func (m *MCP) Flags() []cli.Flag {
 	return []cli.Flag{
 		&cli.BoolFlag{
@@ -33,12 +31,6 @@ func (m *MCP) Flags() []cli.Flag {
 			Sources:     cli.EnvVars("HECATONCHEIRES_MCP"),
 			Destination: &m.enabled,
 		},
-		&cli.StringSliceFlag{
-			Name:     "policy",
-			Usage:    "Paths to Rego policy files or directories used to authorize MCP requests (data.auth.mcp). Can be specified multiple times.",
-			Sources:  cli.EnvVars("HECATONCHEIRES_POLICY"),
-			Category: "MCP",
-		},
 		&cli.StringSliceFlag{
 			Name:     "mcp-env",
 			Usage:    "Names of environment variables to expose to the Rego policy as input.env (allow-list). Can be specified multiple times.",
@@ -53,31 +45,26 @@ func (m *MCP) IsEnabled() bool {
 	return m.enabled
 }
 
-// Configure builds the PolicyClient and the env snapshot when MCP is enabled.
-// It reads the slice flags from c (StringSliceFlag has no Destination).
+// Configure validates the MCP configuration against the shared policy client
+// and returns the env snapshot. It reads the slice flags from c
+// (StringSliceFlag has no Destination).
 //
-// When MCP is disabled it returns (nil, nil, nil). When MCP is enabled without
-// any policy path it returns an error: exposing the MCP endpoint without an
-// authorization policy would be an unauthenticated data leak, so we refuse to
-// start rather than fall back to an open endpoint.
-func (m *MCP) Configure(c *cli.Command) (interfaces.PolicyClient, map[string]string, error) {
+// When MCP is disabled it returns (nil, nil). When MCP is enabled without a
+// policy (pc is nil: no --policy path) it returns an error: exposing the MCP
+// endpoint without an authorization policy would be an unauthenticated data
+// leak, so we refuse to start rather than fall back to an open endpoint.
+func (m *MCP) Configure(c *cli.Command, pc interfaces.PolicyClient) (map[string]string, error) {
 	if !m.enabled {
-		return nil, nil, nil
+		return nil, nil
 	}
 
-	m.policyPaths = c.StringSlice("policy")
 	m.envPassthrough = c.StringSlice("mcp-env")
 
-	if len(m.policyPaths) == 0 {
-		return nil, nil, goerr.New("--mcp requires at least one --policy path; refusing to expose the MCP endpoint without an authorization policy")
-	}
-
-	pc, err := policy.New(m.policyPaths)
-	if err != nil {
-		return nil, nil, goerr.Wrap(err, "failed to build policy client for MCP")
+	if pc == nil {
+		return nil, goerr.New("--mcp requires at least one --policy path; refusing to expose the MCP endpoint without an authorization policy")
 	}
 
-	return pc, m.envSnapshot(), nil
+	return m.envSnapshot(), nil
 }
 
 // envSnapshot reads the current values of the allow-listed environment
@@ -98,7 +85,6 @@ func (m *MCP) envSnapshot() map[string]string {
 func (m *MCP) LogAttrs() []slog.Attr {
 	return []slog.Attr{
 		slog.Bool("enabled", m.enabled),
-		slog.Int("policy_paths", len(m.policyPaths)),
 		slog.Any("env_passthrough", m.envPassthrough),
 	}
 }
@@ -10,20 +10,25 @@ import (
 	"github.com/urfave/cli/v3"
 )
 
-// runMCPConfigure parses args into an MCP config, invokes Configure inside the
-// command Action (where the parsed cli.Command is available), and returns the
-// results.
+// runMCPConfigure parses args into the Policy and MCP configs, invokes both
+// Configure calls inside the command Action (where the parsed cli.Command is
+// available) the way serve does, and returns the results.
 func runMCPConfigure(t *testing.T, args []string) (*config.MCP, interfaces.PolicyClient, map[string]string, error) {
 	t.Helper()
+	var p config.Policy
 	var m config.MCP
 	var pc interfaces.PolicyClient
 	var env map[string]string
 	var cfgErr error
 	cmd := &cli.Command{
 		Name:  "test",
-		Flags: m.Flags(),
+		Flags: append(p.Flags(), m.Flags()...),
 		Action: func(_ context.Context, c *cli.Command) error {
-			pc, env, cfgErr = m.Configure(c)
+			pc, cfgErr = p.Configure(c)
+			if cfgErr != nil {
+				return nil
+			}
+			env, cfgErr = m.Configure(c, pc)
 			return nil
 		},
 	}
@@ -31,6 +36,22 @@ func runMCPConfigure(t *testing.T, args []string) (*config.MCP, interfaces.Polic
 	return &m, pc, env, cfgErr
 }
 
+func TestPolicy_WithoutPathHasNoClient(t *testing.T) {
+	_, pc, _, err := runMCPConfigure(t, nil)
+	gt.NoError(t, err)
+	gt.Value(t, pc).Nil()
+}
+
+func TestPolicy_WithoutMCPStillBuildsClient(t *testing.T) {
+	// The policy also governs GraphQL mutations and agent tool calls, so it is
+	// compiled whether or not the MCP endpoint is enabled.
+	m, pc, env, err := runMCPConfigure(t, []string{"--policy", "testdata/policy"})
+	gt.NoError(t, err).Required()
+	gt.Bool(t, m.IsEnabled()).False()
+	gt.Value(t, pc).NotNil()
+	gt.Value(t, env).Nil()
+}
+
 func TestMCP_DisabledByDefault(t *testing.T) {
 	m, pc, env, err := runMCPConfigure(t, nil)
 	gt.NoError(t, err)
@@ -0,0 +1,66 @@
+package config_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/cli/config"
+	"github.com/urfave/cli/v3"
+)
+
+// oidcDiscovery serves a discovery document naming itself as the issuer, so
+// Configure gets past validation without a real issuer.
+func oidcDiscovery(t *testing.T) *httptest.Server {
+	t.Helper()
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 srv.URL,
+			"authorization_endpoint": srv.URL + "/authorize",
+			"token_endpoint":         srv.URL + "/token",
+			"jwks_uri":               srv.URL + "/jwks",
+		})
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func configureOIDC(t *testing.T, args ...string) error {
+	t.Helper()
+	srv := oidcDiscovery(t)
+	var x config.OIDC
+	var configureErr error
+	cmd := &cli.Command{
+		Name:  "test",
+		Flags: x.Flags(),
+		Action: func(ctx context.Context, c *cli.Command) error {
+			_, configureErr = x.Configure(ctx, c, "https://hc.example")
+			return nil
+		},
+	}
+	base := []string{"test", "--oidc-issuer=" + srv.URL, "--oidc-client-id=client", "--oidc-client-secret=secret"}
+	gt.NoError(t, cmd.Run(context.Background(), append(base, args...))).Required()
+	return configureErr
+}
+
+func TestOIDCConfigure_AllowedDomains(t *testing.T) {
+	t.Run("no allowed domains is refused", func(t *testing.T) {
+		gt.Error(t, configureOIDC(t)).Is(config.ErrInvalidOIDCConfig)
+	})
+
+	t.Run("blank allowed domains are refused", func(t *testing.T) {
+		gt.Error(t, configureOIDC(t, "--oidc-allowed-domains= ")).Is(config.ErrInvalidOIDCConfig)
+	})
+
+	t.Run("allowed domains are accepted", func(t *testing.T) {
+		gt.NoError(t, configureOIDC(t, "--oidc-allowed-domains=example.com"))
+	})
+
+	t.Run("the explicit opt-out is accepted", func(t *testing.T) {
+		gt.NoError(t, configureOIDC(t, "--oidc-allow-any-domain"))
+	})
+}
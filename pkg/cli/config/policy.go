@@ -0,0 +1,54 @@
+package config
+
+import (
+	"log/slog"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/adapter/policy"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/urfave/cli/v3"
+)
+
+// Policy holds the Rego policy source shared by every authorization surface:
+// the MCP endpoint (data.auth.mcp), GraphQL mutations (data.auth.graphql) and
+// agent tool calls (data.auth.tool). One bundle is compiled once and queried
+// at each entrypoint.
+type Policy struct {
+	// paths is read from the cli.Command in Configure, since urfave/cli/v3
+	// StringSliceFlag does not support Destination.
+	paths []string
+}
+
+// Flags returns CLI flags for the policy source.
+func (p *Policy) Flags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:     "policy",
+			Usage:    "Paths to Rego policy files or directories. Authorizes MCP requests (data.auth.mcp), GraphQL mutations (data.auth.graphql) and agent tool calls (data.auth.tool). Can be specified multiple times.",
+			Sources:  cli.EnvVars("HECATONCHEIRES_POLICY"),
+			Category: "Policy",
+		},
+	}
+}
+
+// Configure compiles the policy files. It returns (nil, nil) when no path is
+// given: without a policy, mutations and tool calls are governed by the
+// built-in rules alone and the MCP endpoint cannot be enabled.
+func (p *Policy) Configure(c *cli.Command) (interfaces.PolicyClient, error) {
+	p.paths = c.StringSlice("policy")
+	if len(p.paths) == 0 {
+		return nil, nil
+	}
+	pc, err := policy.New(p.paths)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to build policy client")
+	}
+	return pc, nil
+}
+
+// LogAttrs returns log attributes describing the policy configuration.
+func (p *Policy) LogAttrs() []slog.Attr {
+	return []slog.Attr{
+		slog.Int("paths", len(p.paths)),
+	}
+}
@@ -0,0 +1,58 @@
+package config
+
+import (
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+// defaultReminderBefore is how long ahead of the due date the assignee is
+// reminded when [reminder] omits before.
+const defaultReminderBefore = 24 * time.Hour
+
+// ReminderSection is the TOML shape of the [reminder] section: when the tick
+// sweep reminds people of Action due dates. Durations are Go durations
+// ("24h", "90m"); "0" turns the stage off.
+type ReminderSection struct {
+	// Before is how long ahead of the due date the assignee gets a DM.
+	// Empty uses 24h.
+	Before string `toml:"before"`
+	// Escalate is the grace period after the due date before the case
+	// assignees are told the action is still open. Empty never escalates.
+	Escalate string `toml:"escalate"`
+}
+
+// resolveReminder validates the [reminder] section and returns the policy it
+// describes, or nil when the section is absent.
+func (a *AppConfig) resolveReminder() (*model.ActionReminderPolicy, error) {
+	s := a.Reminder
+	if s == nil {
+		return nil, nil
+	}
+	before, err := parseReminderDuration("before", s.Before, defaultReminderBefore)
+	if err != nil {
+		return nil, err
+	}
+	escalate, err := parseReminderDuration("escalate", s.Escalate, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &model.ActionReminderPolicy{Before: before, EscalateAfter: escalate}, nil
+}
+
+func parseReminderDuration(key, value string, fallback time.Duration) (time.Duration, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, goerr.Wrap(ErrInvalidReminder, "invalid duration",
+			goerr.V("key", key), goerr.V("value", value))
+	}
+	if d < 0 {
+		return 0, goerr.Wrap(ErrInvalidReminder, "duration must not be negative",
+			goerr.V("key", key), goerr.V("value", value))
+	}
+	return d, nil
+}
@@ -0,0 +1,72 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/cli/config"
+)
+
+func TestLoadWorkspaceConfigs_Reminder(t *testing.T) {
+	load := func(t *testing.T, reminder string) ([]*config.WorkspaceConfig, error) {
+		t.Helper()
+		content := `
+[workspace]
+id = "soc"
+name = "SOC"
+` + reminder
+		configPath := filepath.Join(t.TempDir(), "soc.toml")
+		gt.NoError(t, os.WriteFile(configPath, []byte(content), 0644)).Required()
+		return config.LoadWorkspaceConfigs([]string{configPath})
+	}
+
+	t.Run("policy reaches the registry", func(t *testing.T) {
+		configs, err := load(t, `
+[reminder]
+before = "12h"
+escalate = "48h"
+`)
+		gt.NoError(t, err).Required()
+
+		entry, err := config.BuildWorkspaceRegistry(configs).Get("soc")
+		gt.NoError(t, err).Required()
+		gt.Value(t, entry.ActionReminder).NotNil().Required()
+		gt.Value(t, entry.ActionReminder.Before).Equal(12 * time.Hour)
+		gt.Value(t, entry.ActionReminder.EscalateAfter).Equal(48 * time.Hour)
+	})
+
+	t.Run("empty section uses the defaults", func(t *testing.T) {
+		configs, err := load(t, `
+[reminder]
+`)
+		gt.NoError(t, err).Required()
+		gt.Value(t, configs[0].ActionReminder).NotNil().Required()
+		gt.Value(t, configs[0].ActionReminder.Before).Equal(24 * time.Hour)
+		gt.Value(t, configs[0].ActionReminder.EscalateAfter).Equal(time.Duration(0))
+	})
+
+	t.Run("omitted sends no reminders", func(t *testing.T) {
+		configs, err := load(t, "")
+		gt.NoError(t, err).Required()
+		gt.Value(t, configs[0].ActionReminder).Nil()
+	})
+
+	for name, tc := range map[string]string{
+		"malformed duration": `
+[reminder]
+before = "a day"
+`,
+		"negative duration": `
+[reminder]
+escalate = "-1h"
+`,
+	} {
+		t.Run(name+" is rejected", func(t *testing.T) {
+			_, err := load(t, tc)
+			gt.Error(t, err).Is(config.ErrInvalidReminder)
+		})
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/agentproc"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/firestore"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/postgres"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/sqlite"
 	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
 	"github.com/secmon-lab/hecatoncheires/pkg/utils/logging"
 	"github.com/urfave/cli/v3"
@@ -21,6 +23,8 @@ type Repository struct {
 	backend    string
 	projectID  string
 	databaseID string
+	dsn        string
+	sqlitePath string
 }
 
 // Flags returns CLI flags for repository configuration
@@ -28,7 +32,7 @@ func (r *Repository) Flags() []cli.Flag {
 	return []cli.Flag{
 		&cli.StringFlag{
 			Name:        "repository-backend",
-			Usage:       "Repository backend type (firestore or memory)",
+			Usage:       "Repository backend type (firestore, postgres, sqlite or memory)",
 			Value:       "firestore",
 			Sources:     cli.EnvVars("HECATONCHEIRES_REPOSITORY_BACKEND"),
 			Destination: &r.backend,
@@ -45,6 +49,18 @@ func (r *Repository) Flags() []cli.Flag {
 			Sources:     cli.EnvVars("HECATONCHEIRES_FIRESTORE_DATABASE_ID"),
 			Destination: &r.databaseID,
 		},
+		&cli.StringFlag{
+			Name:        "postgres-dsn",
+			Usage:       "PostgreSQL connection string (required when using postgres backend)",
+			Sources:     cli.EnvVars("HECATONCHEIRES_POSTGRES_DSN"),
+			Destination: &r.dsn,
+		},
+		&cli.StringFlag{
+			Name:        "sqlite-path",
+			Usage:       "SQLite database file path (required when using sqlite backend)",
+			Sources:     cli.EnvVars("HECATONCHEIRES_SQLITE_PATH"),
+			Destination: &r.sqlitePath,
+		},
 	}
 }
 
@@ -63,6 +79,16 @@ func (r *Repository) DatabaseID() string {
 	return r.databaseID
 }
 
+// PostgresDSN returns the PostgreSQL connection string
+func (r *Repository) PostgresDSN() string {
+	return r.dsn
+}
+
+// SQLitePath returns the SQLite database file path
+func (r *Repository) SQLitePath() string {
+	return r.sqlitePath
+}
+
 // LogAttrs returns log attributes for the repository configuration
 func (r *Repository) LogAttrs() []slog.Attr {
 	attrs := []slog.Attr{
@@ -74,12 +100,15 @@ func (r *Repository) LogAttrs() []slog.Attr {
 			slog.String("database_id", r.databaseID),
 		)
 	}
+	if r.backend == "sqlite" {
+		attrs = append(attrs, slog.String("sqlite_path", r.sqlitePath))
+	}
 	return attrs
 }
 
 // ConfigureAgentProcess builds the agentkit Process store on the same backend
 // as the application repository. The returned cleanup closes the store's own
-// Firestore client and must be called on shutdown.
+// client or connection pool and must be called on shutdown.
 //
 // It is a separate client from Configure's because the two implement different
 // contracts and are consumed by different layers; sharing one would put the
@@ -94,14 +123,7 @@ func (r *Repository) ConfigureAgentProcess(ctx context.Context) (agentkit.Reposi
 		if err != nil {
 			return nil, nil, goerr.Wrap(err, "failed to initialize the agent process repository")
 		}
-		cleanup := func() {
-			if err := repo.Close(); err != nil {
-				errutil.Handle(context.Background(),
-					goerr.Wrap(err, "failed to close the agent process repository"),
-					"failed to close the agent process repository")
-			}
-		}
-		return repo, cleanup, nil
+		return repo, closeAgentProcess(repo), nil
 
 	case "memory":
 		// The in-memory store keeps agent processes in this instance only, so a
@@ -109,6 +131,68 @@ func (r *Repository) ConfigureAgentProcess(ctx context.Context) (agentkit.Reposi
 		// already promises for every other entity.
 		return agentprocmemory.New(), func() {}, nil
 
+	case "postgres":
+		if r.dsn == "" {
+			return nil, nil, goerr.New("postgres-dsn is required when using postgres backend")
+		}
+		repo, err := agentproc.NewPostgres(ctx, r.dsn)
+		if err != nil {
+			return nil, nil, goerr.Wrap(err, "failed to initialize the agent process repository")
+		}
+		return repo, closeAgentProcess(repo), nil
+
+	case "sqlite":
+		if r.sqlitePath == "" {
+			return nil, nil, goerr.New("sqlite-path is required when using sqlite backend")
+		}
+		repo, err := agentproc.NewSQLite(r.sqlitePath)
+		if err != nil {
+			return nil, nil, goerr.Wrap(err, "failed to initialize the agent process repository")
+		}
+		return repo, closeAgentProcess(repo), nil
+
+	default:
+		return nil, nil, goerr.New("invalid repository backend", goerr.V("backend", r.backend))
+	}
+}
+
+// closeAgentProcess is the shutdown cleanup for an agent process store.
+func closeAgentProcess(repo interface{ Close() error }) func() {
+	return func() {
+		if err := repo.Close(); err != nil {
+			errutil.Handle(context.Background(),
+				goerr.Wrap(err, "failed to close the agent process repository"),
+				"failed to close the agent process repository")
+		}
+	}
+}
+
+// ConfigureLiveRelay builds the relay that carries GraphQL subscription events
+// between instances. Only Firestore has one; the other backends return a nil
+// relay, so subscriptions see the changes made through the instance serving
+// them and nothing else. The returned cleanup must be called on shutdown.
+func (r *Repository) ConfigureLiveRelay(ctx context.Context) (interfaces.LiveEventRelay, func(), error) {
+	switch r.backend {
+	case "firestore":
+		if r.projectID == "" {
+			return nil, nil, goerr.New("firestore-project-id is required when using firestore backend")
+		}
+		relay, err := firestore.NewLiveEventRelay(ctx, r.projectID, r.databaseID)
+		if err != nil {
+			return nil, nil, goerr.Wrap(err, "failed to initialize the live event relay")
+		}
+		cleanup := func() {
+			if err := relay.Close(); err != nil {
+				errutil.Handle(context.Background(),
+					goerr.Wrap(err, "failed to close the live event relay"),
+					"failed to close the live event relay")
+			}
+		}
+		return relay, cleanup, nil
+
+	case "memory", "postgres", "sqlite":
+		return nil, func() {}, nil
+
 	default:
 		return nil, nil, goerr.New("invalid repository backend", goerr.V("backend", r.backend))
 	}
@@ -132,6 +216,28 @@ func (r *Repository) Configure(ctx context.Context) (interfaces.Repository, erro
 		)
 		return repo, nil
 
+	case "postgres":
+		if r.dsn == "" {
+			return nil, goerr.New("postgres-dsn is required when using postgres backend")
+		}
+		repo, err := postgres.New(ctx, r.dsn)
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to initialize postgres repository")
+		}
+		logging.Default().Info("Using PostgreSQL repository")
+		return repo, nil
+
+	case "sqlite":
+		if r.sqlitePath == "" {
+			return nil, goerr.New("sqlite-path is required when using sqlite backend")
+		}
+		repo, err := sqlite.New(ctx, r.sqlitePath)
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to initialize sqlite repository")
+		}
+		logging.Default().Info("Using SQLite repository", "path", r.sqlitePath)
+		return repo, nil
+
 	case "memory":
 		logging.Default().Info("Using in-memory repository (development mode)")
 		return memory.New(), nil
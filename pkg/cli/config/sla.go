@@ -0,0 +1,135 @@
+package config
+
+import (
+	"slices"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+)
+
+// SLASection is the TOML shape of the [sla] section: the response and
+// resolution targets cases are held to, keyed off a select field.
+type SLASection struct {
+	// Pause lists [case.status] ids during which the clocks stop. Thread
+	// mode only.
+	Pause []string `toml:"pause"`
+	// Rules are tried in order; the first matching a case applies.
+	Rules []SLARuleSection `toml:"rule"`
+}
+
+// SLARuleSection is one [[sla.rule]] entry. Durations are Go durations
+// ("1h", "90m"); an empty one sets no clock.
+type SLARuleSection struct {
+	// Field is the id of a select or multi-select field in [[fields]].
+	Field string `toml:"field"`
+	// Value is the option id the field must hold.
+	Value string `toml:"value"`
+	// Response is the time allowed until the first response.
+	Response string `toml:"response"`
+	// Resolution is the time allowed until the case is closed.
+	Resolution string `toml:"resolution"`
+}
+
+// resolveSLA validates the [sla] section and returns the policy it
+// describes, or nil when the section is absent.
+func (a *AppConfig) resolveSLA() (*model.SLAPolicy, error) {
+	s := a.SLA
+	if s == nil {
+		return nil, nil
+	}
+
+	policy := &model.SLAPolicy{}
+	for idx, r := range s.Rules {
+		if err := a.validateSLARuleField(r); err != nil {
+			return nil, goerr.Wrap(err, "invalid [[sla.rule]] entry", goerr.V("index", idx))
+		}
+		response, err := parseSLADuration("response", r.Response)
+		if err != nil {
+			return nil, goerr.Wrap(err, "invalid [[sla.rule]] entry", goerr.V("index", idx))
+		}
+		resolution, err := parseSLADuration("resolution", r.Resolution)
+		if err != nil {
+			return nil, goerr.Wrap(err, "invalid [[sla.rule]] entry", goerr.V("index", idx))
+		}
+		if response == 0 && resolution == 0 {
+			return nil, goerr.Wrap(ErrInvalidSLA, "sla rule sets neither response nor resolution",
+				goerr.V("index", idx))
+		}
+		policy.Rules = append(policy.Rules, model.SLARule{
+			FieldID:    r.Field,
+			Value:      r.Value,
+			Response:   response,
+			Resolution: resolution,
+		})
+	}
+
+	if len(s.Pause) > 0 {
+		if !model.CaseMode(a.Slack.Mode).IsThread() {
+			return nil, goerr.Wrap(ErrInvalidSLA, "[sla] pause requires mode = \"thread\"")
+		}
+		set, err := a.resolveCaseStatusSet()
+		if err != nil {
+			return nil, goerr.Wrap(err, "invalid [case] section")
+		}
+		for _, id := range s.Pause {
+			if set == nil || !set.IsValid(id) {
+				return nil, goerr.Wrap(ErrInvalidSLA, "sla pause status is not defined in [case.status]",
+					goerr.V("status", id))
+			}
+			if set.IsClosed(id) {
+				return nil, goerr.Wrap(ErrInvalidSLA, "sla pause status must not be a closed status",
+					goerr.V("status", id))
+			}
+			// A case enters its initial status without a status change, so
+			// no pause would ever be opened for it.
+			if id == set.InitialID() {
+				return nil, goerr.Wrap(ErrInvalidSLA, "sla pause status must not be the initial status",
+					goerr.V("status", id))
+			}
+		}
+		policy.PauseStatuses = slices.Clone(s.Pause)
+	}
+
+	return policy, nil
+}
+
+// validateSLARuleField checks that the rule names a select or multi-select
+// field and one of its options.
+func (a *AppConfig) validateSLARuleField(r SLARuleSection) error {
+	for _, f := range a.Fields {
+		if f.ID != r.Field {
+			continue
+		}
+		if ft := types.FieldType(f.Type); ft != types.FieldTypeSelect && ft != types.FieldTypeMultiSelect {
+			return goerr.Wrap(ErrInvalidSLA, "sla field must be a select or multi-select field",
+				goerr.V("field_id", r.Field), goerr.V("type", f.Type))
+		}
+		for _, opt := range f.Options {
+			if opt.ID == r.Value {
+				return nil
+			}
+		}
+		return goerr.Wrap(ErrInvalidSLA, "sla value is not an option of the field",
+			goerr.V("field_id", r.Field), goerr.V("value", r.Value))
+	}
+	return goerr.Wrap(ErrInvalidSLA, "sla field is not defined in [[fields]]",
+		goerr.V("field_id", r.Field))
+}
+
+func parseSLADuration(key, value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, goerr.Wrap(ErrInvalidSLA, "invalid duration",
+			goerr.V("key", key), goerr.V("value", value))
+	}
+	if d <= 0 {
+		return 0, goerr.Wrap(ErrInvalidSLA, "duration must be positive",
+			goerr.V("key", key), goerr.V("value", value))
+	}
+	return d, nil
+}
@@ -0,0 +1,161 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/cli/config"
+)
+
+func TestLoadWorkspaceConfigs_SLA(t *testing.T) {
+	const fields = `
+[[fields]]
+id = "severity"
+name = "Severity"
+type = "select"
+
+  [[fields.options]]
+  id = "critical"
+  name = "Critical"
+
+  [[fields.options]]
+  id = "low"
+  name = "Low"
+
+[[fields]]
+id = "summary"
+name = "Summary"
+type = "text"
+`
+	const thread = `
+[slack]
+mode = "thread"
+channel = "C0123ABC"
+
+[case]
+initial = "TRIAGE"
+closed = ["DONE"]
+
+  [[case.status]]
+  id = "TRIAGE"
+  name = "Triage"
+
+  [[case.status]]
+  id = "WAITING"
+  name = "Waiting on reporter"
+
+  [[case.status]]
+  id = "DONE"
+  name = "Done"
+`
+	load := func(t *testing.T, body string) ([]*config.WorkspaceConfig, error) {
+		t.Helper()
+		content := `
+[workspace]
+id = "soc"
+name = "SOC"
+` + fields + body
+		configPath := filepath.Join(t.TempDir(), "soc.toml")
+		gt.NoError(t, os.WriteFile(configPath, []byte(content), 0644)).Required()
+		return config.LoadWorkspaceConfigs([]string{configPath})
+	}
+
+	t.Run("policy reaches the registry", func(t *testing.T) {
+		configs, err := load(t, thread+`
+[sla]
+pause = ["WAITING"]
+
+[[sla.rule]]
+field = "severity"
+value = "critical"
+response = "1h"
+resolution = "24h"
+
+[[sla.rule]]
+field = "severity"
+value = "low"
+resolution = "168h"
+`)
+		gt.NoError(t, err).Required()
+
+		entry, err := config.BuildWorkspaceRegistry(configs).Get("soc")
+		gt.NoError(t, err).Required()
+		gt.Value(t, entry.SLA).NotNil().Required()
+		gt.Value(t, entry.SLA.PauseStatuses).Equal([]string{"WAITING"})
+		gt.Array(t, entry.SLA.Rules).Length(2).Required()
+		gt.Value(t, entry.SLA.Rules[0].FieldID).Equal("severity")
+		gt.Value(t, entry.SLA.Rules[0].Value).Equal("critical")
+		gt.Value(t, entry.SLA.Rules[0].Response).Equal(time.Hour)
+		gt.Value(t, entry.SLA.Rules[0].Resolution).Equal(24 * time.Hour)
+		gt.Value(t, entry.SLA.Rules[1].Response).Equal(time.Duration(0))
+		gt.Value(t, entry.SLA.Rules[1].Resolution).Equal(168 * time.Hour)
+	})
+
+	t.Run("omitted sets no targets", func(t *testing.T) {
+		configs, err := load(t, "")
+		gt.NoError(t, err).Required()
+		gt.Value(t, configs[0].SLA).Nil()
+	})
+
+	for name, body := range map[string]string{
+		"unknown field": `
+[[sla.rule]]
+field = "priority"
+value = "critical"
+response = "1h"
+`,
+		"non-select field": `
+[[sla.rule]]
+field = "summary"
+value = "critical"
+response = "1h"
+`,
+		"unknown option": `
+[[sla.rule]]
+field = "severity"
+value = "urgent"
+response = "1h"
+`,
+		"no durations": `
+[[sla.rule]]
+field = "severity"
+value = "critical"
+`,
+		"malformed duration": `
+[[sla.rule]]
+field = "severity"
+value = "critical"
+response = "an hour"
+`,
+		"zero duration": `
+[[sla.rule]]
+field = "severity"
+value = "critical"
+response = "0s"
+`,
+		"pause in channel mode": `
+[sla]
+pause = ["WAITING"]
+`,
+		"unknown pause status": thread + `
+[sla]
+pause = ["BLOCKED"]
+`,
+		"closed pause status": thread + `
+[sla]
+pause = ["DONE"]
+`,
+		"initial pause status": thread + `
+[sla]
+pause = ["TRIAGE"]
+`,
+	} {
+		t.Run(name+" is rejected", func(t *testing.T) {
+			_, err := load(t, body)
+			gt.Error(t, err).Is(config.ErrInvalidSLA)
+		})
+	}
+}
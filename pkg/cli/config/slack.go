@@ -3,6 +3,7 @@ package config
 import (
 	"context"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/m-mizutani/goerr/v2"
@@ -33,6 +34,7 @@ type Slack struct {
 	botToken                 string
 	userOAuthToken           string
 	signingSecret            string
+	appToken                 string
 	noAuthUID                string
 	notificationSlotDuration time.Duration
 
@@ -46,8 +48,9 @@ type Slack struct {
 }
 
 // Flags is the full Slack surface: the runtime flags below plus the ones only
-// an HTTP process uses (the OAuth client pair and the signing secret that
-// verifies inbound webhooks). A command that serves no endpoints registers
+// a serving process uses (the OAuth client pair, the signing secret that
+// verifies inbound webhooks, and the app-level token that opens a Socket Mode
+// connection instead). A command that serves no endpoints registers
 // RuntimeFlags instead, so its deployment is never asked for credentials it
 // cannot use.
 func (x *Slack) Flags() []cli.Flag {
@@ -73,6 +76,13 @@ func (x *Slack) Flags() []cli.Flag {
 			Destination: &x.signingSecret,
 			Sources:     cli.EnvVars("HECATONCHEIRES_SLACK_SIGNING_SECRET"),
 		},
+		&cli.StringFlag{
+			Name:        "slack-app-token",
+			Usage:       "Slack App-Level Token (xapp-..., connections:write) to receive events, interactions and slash commands over Socket Mode instead of the /hooks/slack webhooks",
+			Category:    "Slack",
+			Destination: &x.appToken,
+			Sources:     cli.EnvVars("HECATONCHEIRES_SLACK_APP_TOKEN"),
+		},
 	}, x.RuntimeFlags()...)
 }
 
@@ -126,6 +136,7 @@ func (x *Slack) LogAttrs() []slog.Attr {
 		slog.Bool("bot_token_set", x.botToken != ""),
 		slog.Bool("user_oauth_token_set", x.userOAuthToken != ""),
 		slog.Bool("signing_secret_set", x.signingSecret != ""),
+		slog.Bool("app_token_set", x.appToken != ""),
 	}
 }
 
@@ -139,8 +150,10 @@ func (x *Slack) NoAuthUID() string {
 	return x.noAuthUID
 }
 
-// Configure creates an AuthUseCase if Slack is configured, otherwise returns NoAuthnUseCase
-func (x *Slack) Configure(ctx context.Context, repo interfaces.Repository, baseURL string) (usecase.AuthUseCaseInterface, error) {
+// Configure creates an AuthUseCase over Sign in with Slack (when configured)
+// and the extra identity providers, otherwise returns NoAuthnUseCase. no-auth
+// mode takes precedence over every provider.
+func (x *Slack) Configure(ctx context.Context, repo interfaces.Repository, baseURL string, extra ...usecase.IdentityProvider) (usecase.AuthUseCaseInterface, error) {
 	// If no-auth mode is enabled, validate and use the specified user
 	if x.noAuthUID != "" {
 		// If bot token is available, validate user exists in Slack
@@ -169,16 +182,22 @@ func (x *Slack) Configure(ctx context.Context, repo interfaces.Repository, baseU
 		return usecase.NewNoAuthnUseCase(repo, x.noAuthUID, "test@example.com", "Test User"), nil
 	}
 
-	// If Slack OAuth configuration is complete, use Slack authentication
+	// Sign in with Slack comes first, so it stays the default provider;
+	// further providers (OIDC) are offered next to it.
+	var providers []usecase.IdentityProvider
 	if x.clientID != "" && x.clientSecret != "" && baseURL != "" {
 		// Build callback URL from base URL
 		callbackURL := baseURL + "/api/auth/callback"
-		return usecase.NewAuthUseCase(repo, x.clientID, x.clientSecret, callbackURL, usecase.WithBotToken(x.botToken)), nil
+		providers = append(providers, usecase.NewSlackIdentityProvider(x.clientID, x.clientSecret, callbackURL))
+	}
+	providers = append(providers, extra...)
+	if len(providers) > 0 {
+		return usecase.NewAuthUseCase(repo, providers...), nil
 	}
 
-	// If Slack configuration is incomplete, warn and fall back to simple no-auth mode
+	// If no identity provider is configured, warn and fall back to simple no-auth mode
 	logging.Default().Warn("Slack configuration is incomplete - running without authentication (development mode only)")
-	logging.Default().Warn("Set --slack-client-id, --slack-client-secret, and --base-url for Slack OAuth, or use --no-auth with --slack-bot-token")
+	logging.Default().Warn("Set --slack-client-id, --slack-client-secret, and --base-url for Slack OAuth, --oidc-issuer for OpenID Connect, or use --no-auth with --slack-bot-token")
 
 	// Use a default test user
 	defaultUserID := "U_DEFAULT_TEST"
@@ -364,3 +383,31 @@ func (x *Slack) IsWebhookConfigured() bool {
 func (x *Slack) SigningSecret() string {
 	return x.signingSecret
 }
+
+// IsSocketModeConfigured reports whether Slack should be received over Socket
+// Mode, i.e. an app-level token is set.
+func (x *Slack) IsSocketModeConfigured() bool {
+	return x.appToken != ""
+}
+
+// AppToken returns the Slack app-level token used for Socket Mode
+func (x *Slack) AppToken() string {
+	return x.appToken
+}
+
+// ValidateSocketMode checks the Socket Mode settings. The app-level token only
+// opens the connection; everything the handlers do in reply goes through the
+// bot token, so one without the other is a misconfiguration. A no-op when
+// Socket Mode is not configured.
+func (x *Slack) ValidateSocketMode() error {
+	if x.appToken == "" {
+		return nil
+	}
+	if !strings.HasPrefix(x.appToken, "xapp-") {
+		return goerr.New("--slack-app-token must be an app-level token (xapp-...)")
+	}
+	if x.botToken == "" {
+		return goerr.New("--slack-app-token requires --slack-bot-token")
+	}
+	return nil
+}
@@ -294,4 +294,33 @@ func TestSlackRuntimeFlags_OmitsTheHTTPOnlyCredentials(t *testing.T) {
 	gt.Bool(t, full["slack-client-id"]).True()
 	gt.Bool(t, full["slack-client-secret"]).True()
 	gt.Bool(t, full["slack-signing-secret"]).True()
+	gt.Bool(t, runtime["slack-app-token"]).False()
+	gt.Bool(t, full["slack-app-token"]).True()
+}
+
+func TestSlackValidateSocketMode(t *testing.T) {
+	tests := []struct {
+		name     string
+		botToken string
+		appToken string
+		wantErr  bool
+	}{
+		{"not configured", "", "", false},
+		{"app and bot tokens", "xoxb-token", "xapp-1-token", false},
+		{"app token without bot token", "", "xapp-1-token", true},
+		{"bot token passed as app token", "xoxb-token", "xoxb-token", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := config.NewSlackForTest("", "", tt.botToken, "", "")
+			s.SetAppTokenForTest(tt.appToken)
+			gt.Value(t, s.IsSocketModeConfigured()).Equal(tt.appToken != "")
+			if tt.wantErr {
+				gt.Error(t, s.ValidateSocketMode())
+			} else {
+				gt.NoError(t, s.ValidateSocketMode())
+			}
+		})
+	}
 }
@@ -0,0 +1,112 @@
+package config
+
+import (
+	"net/url"
+	"os"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+// WebhookSection is the TOML shape of a [[webhook]] entry: an outbound
+// webhook the workspace's case and Job run events are delivered to.
+type WebhookSection struct {
+	// ID names the webhook in the delivery history. snake_case, unique within
+	// the workspace.
+	ID  string `toml:"id"`
+	URL string `toml:"url"`
+	// Events narrows the delivered events, e.g. ["case.created"]. Empty
+	// delivers every event.
+	Events []string `toml:"events"`
+	// Secret is the HMAC-SHA256 signing key. SecretEnv names an environment
+	// variable holding it instead, so the key need not live in the config
+	// file. At most one of the two may be set; neither sends unsigned
+	// requests.
+	Secret    string `toml:"secret"`
+	SecretEnv string `toml:"secret_env"`
+	// Template is a Go text/template rendering the request body from the
+	// event payload. Empty sends the payload as JSON.
+	Template string `toml:"template"`
+}
+
+// Validate checks a single WebhookSection and returns the model.Webhook it
+// describes. With lookupEnv unset, secret_env is only checked for shape and
+// the returned Secret is left empty, so a structural pass does not depend on
+// the environment.
+func (s *WebhookSection) Validate(lookupEnv bool) (*model.Webhook, error) {
+	if s.ID == "" {
+		return nil, goerr.Wrap(ErrInvalidWebhook, "webhook id is required")
+	}
+	if !jobIDPattern.MatchString(s.ID) {
+		return nil, goerr.Wrap(ErrInvalidWebhook, "webhook id must be snake_case (^[a-z0-9]+(_[a-z0-9]+)*$)",
+			goerr.V("webhook_id", s.ID))
+	}
+
+	u, err := url.Parse(s.URL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return nil, goerr.Wrap(ErrInvalidWebhook, "webhook url must be an absolute http(s) URL",
+			goerr.V("webhook_id", s.ID), goerr.V("url", s.URL))
+	}
+
+	events := make([]model.WebhookEventType, 0, len(s.Events))
+	for _, name := range s.Events {
+		ev := model.WebhookEventType(name)
+		if !ev.IsValid() {
+			return nil, goerr.Wrap(ErrInvalidWebhook, "unknown webhook event",
+				goerr.V("webhook_id", s.ID), goerr.V("event", name),
+				goerr.V("valid", model.AllWebhookEventTypes()))
+		}
+		events = append(events, ev)
+	}
+
+	if s.Secret != "" && s.SecretEnv != "" {
+		return nil, goerr.Wrap(ErrInvalidWebhook, "webhook secret and secret_env are mutually exclusive",
+			goerr.V("webhook_id", s.ID))
+	}
+	secret := s.Secret
+	if s.SecretEnv != "" && lookupEnv {
+		secret = os.Getenv(s.SecretEnv)
+		if secret == "" {
+			return nil, goerr.Wrap(ErrInvalidWebhook, "webhook secret_env names an unset environment variable",
+				goerr.V("webhook_id", s.ID), goerr.V("secret_env", s.SecretEnv))
+		}
+	}
+
+	if s.Template != "" {
+		if _, err := model.ParseWebhookTemplate(s.Template); err != nil {
+			return nil, goerr.Wrap(ErrInvalidWebhook, "invalid webhook template",
+				goerr.V("webhook_id", s.ID), goerr.V("parse_error", err.Error()))
+		}
+	}
+
+	return &model.Webhook{
+		ID:       s.ID,
+		URL:      s.URL,
+		Events:   events,
+		Secret:   secret,
+		Template: s.Template,
+	}, nil
+}
+
+// resolveWebhooks validates every [[webhook]] entry and rejects duplicate
+// IDs. lookupEnv is passed through to WebhookSection.Validate.
+func (a *AppConfig) resolveWebhooks(lookupEnv bool) ([]*model.Webhook, error) {
+	if len(a.Webhooks) == 0 {
+		return nil, nil
+	}
+	webhooks := make([]*model.Webhook, 0, len(a.Webhooks))
+	seen := make(map[string]bool, len(a.Webhooks))
+	for idx := range a.Webhooks {
+		w, err := a.Webhooks[idx].Validate(lookupEnv)
+		if err != nil {
+			return nil, goerr.Wrap(err, "invalid webhook", goerr.V("webhook_index", idx))
+		}
+		if seen[w.ID] {
+			return nil, goerr.Wrap(ErrInvalidWebhook, "duplicate webhook id within workspace",
+				goerr.V("webhook_id", w.ID))
+		}
+		seen[w.ID] = true
+		webhooks = append(webhooks, w)
+	}
+	return webhooks, nil
+}
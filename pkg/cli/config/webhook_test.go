@@ -0,0 +1,116 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/cli/config"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+func TestLoadWorkspaceConfigs_Webhook(t *testing.T) {
+	load := func(t *testing.T, webhooks string) ([]*config.WorkspaceConfig, error) {
+		t.Helper()
+		content := `
+[workspace]
+id = "risk"
+name = "Risk"
+` + webhooks
+		configPath := filepath.Join(t.TempDir(), "risk.toml")
+		gt.NoError(t, os.WriteFile(configPath, []byte(content), 0644)).Required()
+		return config.LoadWorkspaceConfigs([]string{configPath})
+	}
+
+	t.Run("webhooks reach the registry", func(t *testing.T) {
+		t.Setenv("TEST_WEBHOOK_SECRET", "s3cret")
+		configs, err := load(t, `
+[[webhook]]
+id = "siem"
+url = "https://siem.example.com/hook"
+events = ["case.created", "case.closed"]
+secret_env = "TEST_WEBHOOK_SECRET"
+
+[[webhook]]
+id = "chat"
+url = "https://chat.example.com/hook"
+template = '{"text": {{ json .Case.Title }}}'
+`)
+		gt.NoError(t, err).Required()
+		gt.Array(t, configs[0].Webhooks).Length(2).Required()
+
+		entry, err := config.BuildWorkspaceRegistry(configs).Get("risk")
+		gt.NoError(t, err).Required()
+		siem := entry.Webhook("siem")
+		gt.Value(t, siem).NotNil().Required()
+		gt.Value(t, siem.Secret).Equal("s3cret")
+		gt.Bool(t, siem.Listens(model.WebhookEventCaseCreated)).True()
+		gt.Bool(t, siem.Listens(model.WebhookEventCaseAssigned)).False()
+
+		chat := entry.Webhook("chat")
+		gt.Value(t, chat).NotNil().Required()
+		gt.Value(t, chat.Secret).Equal("")
+		gt.Bool(t, chat.Listens(model.WebhookEventCaseAssigned)).True()
+
+		gt.Value(t, entry.Webhook("missing")).Nil()
+	})
+
+	t.Run("omitted declares none", func(t *testing.T) {
+		configs, err := load(t, "")
+		gt.NoError(t, err).Required()
+		gt.Array(t, configs[0].Webhooks).Length(0)
+	})
+
+	for name, tc := range map[string]string{
+		"bad id": `
+[[webhook]]
+id = "Bad-ID"
+url = "https://example.com/hook"
+`,
+		"relative url": `
+[[webhook]]
+id = "siem"
+url = "/hook"
+`,
+		"unknown event": `
+[[webhook]]
+id = "siem"
+url = "https://example.com/hook"
+events = ["created"]
+`,
+		"secret and secret_env": `
+[[webhook]]
+id = "siem"
+url = "https://example.com/hook"
+secret = "a"
+secret_env = "B"
+`,
+		"unset secret_env": `
+[[webhook]]
+id = "siem"
+url = "https://example.com/hook"
+secret_env = "TEST_WEBHOOK_SECRET_UNSET"
+`,
+		"malformed template": `
+[[webhook]]
+id = "siem"
+url = "https://example.com/hook"
+template = "{{ .Case.Title "
+`,
+		"duplicate id": `
+[[webhook]]
+id = "siem"
+url = "https://example.com/a"
+
+[[webhook]]
+id = "siem"
+url = "https://example.com/b"
+`,
+	} {
+		t.Run(name+" is rejected", func(t *testing.T) {
+			_, err := load(t, tc)
+			gt.Error(t, err).Is(config.ErrInvalidWebhook)
+		})
+	}
+}
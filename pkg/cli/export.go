@@ -2,11 +2,14 @@ package cli
 
 import (
 	"context"
+	"errors"
 
 	"github.com/m-mizutani/goerr/v2"
 	"github.com/secmon-lab/hecatoncheires/pkg/cli/config"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
 	"github.com/secmon-lab/hecatoncheires/pkg/service/bqexport"
+	"github.com/secmon-lab/hecatoncheires/pkg/service/fileexport"
+	"github.com/secmon-lab/hecatoncheires/pkg/service/pgexport"
 	"github.com/secmon-lab/hecatoncheires/pkg/usecase/export"
 	"github.com/secmon-lab/hecatoncheires/pkg/utils/logging"
 	"github.com/secmon-lab/hecatoncheires/pkg/utils/safe"
@@ -14,18 +17,23 @@ import (
 )
 
 // cmdExport builds the `export` subcommand: read the current data of every
-// workspace listed under [export] in the global config and full-refresh it into
-// BigQuery, one dataset per workspace and one table per entity.
+// workspace mapped under the selected sink in [export] of the global config and
+// full-refresh it there — one namespace (BigQuery dataset, directory or
+// Postgres schema) per workspace and one table per entity. With
+// --export-mode=incremental only the rows changed since the previous run are
+// merged into the BigQuery or Postgres tables.
 func cmdExport() *cli.Command {
 	var (
-		repoCfg config.Repository
-		appCfg  config.AppConfig
+		repoCfg   config.Repository
+		appCfg    config.AppConfig
+		exportOpt config.Export
 	)
 	flags := append(repoCfg.Flags(), appCfg.Flags()...)
+	flags = append(flags, exportOpt.Flags()...)
 
 	return &cli.Command{
 		Name:  "export",
-		Usage: "Export the current data of every configured workspace to BigQuery",
+		Usage: "Export the current data of every configured workspace to BigQuery, Parquet/NDJSON files or PostgreSQL",
 		Flags: flags,
 		Action: func(ctx context.Context, c *cli.Command) error {
 			logger := logging.From(ctx)
@@ -49,47 +57,120 @@ func cmdExport() *cli.Command {
 				return cli.Exit("no [export] section found in --global-config; nothing to export", 1)
 			}
 
-			targets, err := buildExportTargets(exportCfg, registry)
+			kind, err := exportCfg.SelectSink(exportOpt.Sink())
+			if err != nil {
+				return err
+			}
+			mode, err := exportOpt.Mode()
+			if err != nil {
+				return err
+			}
+
+			targets, err := buildExportTargets(exportCfg.Targets(kind), registry)
 			if err != nil {
 				return err
 			}
 
-			sink, err := bqexport.New(ctx, exportCfg.BigQuery.Project, exportCfg.BigQuery.Location)
+			sink, err := newExportSink(ctx, kind, exportCfg, exportOpt)
 			if err != nil {
-				return goerr.Wrap(err, "failed to initialize BigQuery sink")
+				return err
 			}
-			defer safe.Close(ctx, sink)
 
 			logger.Info("starting export",
-				"project", exportCfg.BigQuery.Project,
+				"sink", kind,
+				"mode", mode,
 				"workspaces", len(targets))
 
-			exporter := export.New(repo, sink)
-			if err := exporter.Run(ctx, targets); err != nil {
-				return goerr.Wrap(err, "export completed with errors")
+			// The sink kind keys the incremental watermarks, so the same
+			// workspace can be exported to several sinks independently.
+			exporter := export.New(repo, sink,
+				export.WithMode(export.Mode(mode)),
+				export.WithSinkName(string(kind)))
+			runErr := exporter.Run(ctx, targets)
+			if err := finishExportSink(sink, runErr); err != nil {
+				runErr = errors.Join(runErr, goerr.Wrap(err, "failed to finish export sink", goerr.V("sink", kind)))
+			}
+			if runErr != nil {
+				return goerr.Wrap(runErr, "export completed with errors")
 			}
 
-			logger.Info("export finished", "workspaces", len(targets))
+			logger.Info("export finished", "sink", kind, "workspaces", len(targets))
 			return nil
 		},
 	}
 }
 
-// buildExportTargets resolves each export workspace mapping to a Target. The
-// mapping was already validated (workspace existence + dataset format) by
-// ConfigureExport, so a Get failure here is unexpected but still surfaced.
-func buildExportTargets(cfg *config.ExportSection, registry *model.WorkspaceRegistry) ([]export.Target, error) {
-	targets := make([]export.Target, 0, len(cfg.BigQuery.Workspaces))
-	for _, m := range cfg.BigQuery.Workspaces {
-		entry, err := registry.Get(m.ID)
+// finishExportSink ends the run on the sink. The file sink writes its tarball on
+// Close, so a Close failure is part of the run's outcome rather than cleanup
+// noise; and after a failed run a sink that can hold its output back is
+// aborted instead, so a partial export never replaces the previous good one.
+func finishExportSink(sink export.Sink, runErr error) error {
+	if runErr != nil {
+		if a, ok := sink.(export.Aborter); ok {
+			return a.Abort()
+		}
+	}
+	return sink.Close()
+}
+
+// newExportSink constructs the sink selected for this run from its [export]
+// sub-section (already validated by ConfigureExport) and the export flags.
+func newExportSink(ctx context.Context, kind config.ExportSinkKind, cfg *config.ExportSection, opt config.Export) (export.Sink, error) {
+	switch kind {
+	case config.ExportSinkBigQuery:
+		sink, err := bqexport.New(ctx, cfg.BigQuery.Project, cfg.BigQuery.Location)
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to initialize BigQuery sink")
+		}
+		return sink, nil
+
+	case config.ExportSinkFile:
+		format := fileexport.Format(cfg.File.EffectiveFormat())
+		var (
+			sink *fileexport.Sink
+			err  error
+		)
+		if cfg.File.Tarball != "" {
+			sink, err = fileexport.NewTarball(cfg.File.Tarball, format)
+		} else {
+			sink, err = fileexport.New(cfg.File.Dir, format)
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to initialize file sink")
+		}
+		return sink, nil
+
+	case config.ExportSinkPostgres:
+		if opt.PostgresDSN() == "" {
+			return nil, cli.Exit("--export-postgres-dsn (HECATONCHEIRES_EXPORT_POSTGRES_DSN) is required for the postgres export sink", 1)
+		}
+		sink, err := pgexport.New(ctx, opt.PostgresDSN())
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to initialize PostgreSQL sink")
+		}
+		return sink, nil
+
+	default:
+		return nil, goerr.New("unsupported export sink", goerr.V("sink", kind))
+	}
+}
+
+// buildExportTargets resolves each export mapping of the selected sink to a
+// Target. The mappings were already validated (workspace existence + namespace
+// format) by ConfigureExport, so a Get failure here is unexpected but still
+// surfaced.
+func buildExportTargets(mappings []config.ExportTarget, registry *model.WorkspaceRegistry) ([]export.Target, error) {
+	targets := make([]export.Target, 0, len(mappings))
+	for _, m := range mappings {
+		entry, err := registry.Get(m.WorkspaceID)
 		if err != nil {
 			return nil, goerr.Wrap(err, "failed to resolve export workspace",
-				goerr.V("workspace_id", m.ID))
+				goerr.V("workspace_id", m.WorkspaceID))
 		}
 		targets = append(targets, export.Target{
 			Entry:          entry,
-			Namespace:      m.Dataset,
-			ExcludePrivate: !cfg.IncludePrivateFor(m),
+			Namespace:      m.Namespace,
+			ExcludePrivate: !m.IncludePrivate,
 		})
 	}
 	return targets, nil
@@ -0,0 +1,40 @@
+package cli_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/cli"
+	"github.com/secmon-lab/hecatoncheires/pkg/service/fileexport"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase/export"
+)
+
+func TestFinishExportSink_FailedRunKeepsPreviousTarball(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "export.tar.gz")
+	tbl := &export.Table{
+		Name:    "cases",
+		Columns: []export.Column{{Name: "id", Type: export.TypeInt}},
+		Rows:    []map[string]any{{"id": int64(1)}},
+	}
+
+	good, err := fileexport.NewTarball(path, fileexport.FormatNDJSON)
+	gt.NoError(t, err).Required()
+	gt.NoError(t, good.WriteTable(context.Background(), "risk", tbl)).Required()
+	gt.NoError(t, cli.FinishExportSinkForTest(good, nil)).Required()
+	before, err := os.ReadFile(path)
+	gt.NoError(t, err).Required()
+
+	failed, err := fileexport.NewTarball(path, fileexport.FormatNDJSON)
+	gt.NoError(t, err).Required()
+	tbl.Name = "actions"
+	gt.NoError(t, failed.WriteTable(context.Background(), "risk", tbl)).Required()
+	gt.NoError(t, cli.FinishExportSinkForTest(failed, errors.New("read cases failed")))
+
+	after, err := os.ReadFile(path)
+	gt.NoError(t, err).Required()
+	gt.String(t, string(after)).Equal(string(before))
+}
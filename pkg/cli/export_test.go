@@ -157,3 +157,6 @@ var CmdDiagnosisForTest = cmdDiagnosis
 
 // CmdFixUnsentActionForTest exposes cmdFixUnsentAction.
 var CmdFixUnsentActionForTest = cmdFixUnsentAction
+
+// FinishExportSinkForTest exposes finishExportSink.
+var FinishExportSinkForTest = finishExportSink
@@ -62,7 +62,9 @@ const (
 type tickRuntime struct {
 	repo     interfaces.Repository
 	registry *model.WorkspaceRegistry
-	scanner  *job.ScheduledScanner
+	// scanner runs every sweep of one tick: the scheduled-Job scan, then the
+	// knowledge review, action reminder, SLA and webhook-retry sweeps.
+	scanner tickSweeps
 	// durable is the agent runtime the dispatched runs execute on. The sweep owns
 	// its worker: it spawns the runs and then drains them in the foreground, so a
 	// scheduled sweep does not depend on a serve instance being up to execute what
@@ -129,6 +131,13 @@ func buildTickRuntime(
 	}
 
 	ucOpts := integrations.ucOpts
+	policyClient, err := integrationCfg.Policy.Configure(c)
+	if err != nil {
+		return nil, goerr.Wrap(err, "configure authorization policy for the sweep")
+	}
+	if policyClient != nil {
+		ucOpts = append(ucOpts, usecase.WithPolicy(policyClient))
+	}
 	// The usecase set needs the LLM and embedding clients too, not just the agent
 	// runtime.
 	//
@@ -197,20 +206,29 @@ func buildTickRuntime(
 		return nil, goerr.Wrap(err, "build job runtime")
 	}
 	durable.Runtime.AttachRunner(jobRunner)
-	uc.Case.SetEventPublisher(jobUC)
+	eventPublisher := usecase.CaseEventPublishers{jobUC, uc.Webhook}
+	uc.Case.SetEventPublisher(eventPublisher)
+	uc.Action.SetEventPublisher(eventPublisher)
 
 	scanner := job.NewScheduledScanner(job.ScannerDeps{
-		Repo:      repo,
-		Registry:  registry,
-		Publisher: jobUC,
+		Repo:         repo,
+		Registry:     registry,
+		Publisher:    jobUC,
+		RunPublisher: uc.Webhook,
 	})
 
 	return &tickRuntime{
 		repo:     repo,
 		registry: registry,
-		scanner:  scanner,
-		durable:  durable.Runtime,
-		cleanup:  cleanup,
+		scanner: tickSweeps{
+			scanner,
+			tickSweepFunc(uc.KnowledgeReview.Sweep),
+			tickSweepFunc(uc.ActionReminder.Sweep),
+			tickSweepFunc(uc.SLA.Sweep),
+			tickSweepFunc(uc.Webhook.Sweep),
+		},
+		durable: durable.Runtime,
+		cleanup: cleanup,
 	}, nil
 }
 
@@ -234,6 +252,10 @@ type tickIntegrationConfigs struct {
 	// URL, so without this the link is dropped from a scheduled run's message but
 	// present on the identical message from serve.
 	BaseURL string
+	// Policy is the Rego policy the runs' tool calls are authorized against —
+	// the same bundle serve enforces, so a Job refused a tool there is refused
+	// it here too.
+	Policy *config.Policy
 }
 
 // Validate enforces the non-nil contract above.
@@ -247,6 +269,9 @@ func (c tickIntegrationConfigs) Validate() error {
 	if c.WebFetch == nil {
 		return goerr.New("webfetch configuration is required")
 	}
+	if c.Policy == nil {
+		return goerr.New("policy configuration is required")
+	}
 	return nil
 }
 
@@ -624,6 +649,7 @@ func buildJobRuntime(deps jobRuntimeDeps) (*job.UseCase, *job.JobRunner, error)
 		SlackNotifier: slackNotifier,
 		Reflector:     reflector,
 		Durable:       deps.Durable,
+		RunPublisher:  deps.UC.Webhook,
 	}
 	// The interactive-Job question form is Block Kit posted/updated directly
 	// via the Slack service (the narrow SlackNotifier cannot carry blocks).
@@ -311,6 +311,7 @@ func TestTickIntegrationConfigs_Validate(t *testing.T) {
 			Slack:    &config.Slack{},
 			Jira:     &config.Jira{},
 			WebFetch: &config.WebFetch{},
+			Policy:   &config.Policy{},
 		}
 	}
 	gt.NoError(t, full().Validate())
@@ -319,6 +320,7 @@ func TestTickIntegrationConfigs_Validate(t *testing.T) {
 		"slack":    func(c *cli.TickIntegrationConfigsForTest) { c.Slack = nil },
 		"jira":     func(c *cli.TickIntegrationConfigsForTest) { c.Jira = nil },
 		"webfetch": func(c *cli.TickIntegrationConfigsForTest) { c.WebFetch = nil },
+		"policy":   func(c *cli.TickIntegrationConfigsForTest) { c.Policy = nil },
 	}
 	for name, drop := range testCases {
 		t.Run("missing "+name, func(t *testing.T) {
@@ -338,6 +340,7 @@ func TestConfigureTickIntegrations_UnconfiguredLeavesClientsNil(t *testing.T) {
 			Slack:    &config.Slack{},
 			Jira:     &config.Jira{},
 			WebFetch: &config.WebFetch{},
+			Policy:   &config.Policy{},
 			BaseURL:  "https://hecatoncheires.example.com",
 		})
 	gt.NoError(t, err).Required()
@@ -364,6 +367,7 @@ func TestConfigureTickIntegrations_SlackBotTokenWiresTheService(t *testing.T) {
 			Slack:    slackCfg,
 			Jira:     &config.Jira{},
 			WebFetch: &config.WebFetch{},
+			Policy:   &config.Policy{},
 		})
 	gt.NoError(t, err).Required()
 	gt.Value(t, slackSvc).NotNil()
@@ -389,6 +393,7 @@ func TestConfigureTickIntegrations_UserTokenWiresTheReadClients(t *testing.T) {
 			Slack:    slackCfg,
 			Jira:     &config.Jira{},
 			WebFetch: &config.WebFetch{},
+			Policy:   &config.Policy{},
 		})
 	gt.NoError(t, err).Required()
 
@@ -406,6 +411,7 @@ func TestConfigureTickIntegrations_NotionTokenWiresTheToolClient(t *testing.T) {
 			Slack:       &config.Slack{},
 			Jira:        &config.Jira{},
 			WebFetch:    &config.WebFetch{},
+			Policy:      &config.Policy{},
 			NotionToken: "secret_test_token",
 		})
 	gt.NoError(t, err).Required()
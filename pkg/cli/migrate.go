@@ -5,25 +5,36 @@ import (
 
 	"github.com/m-mizutani/fireconf"
 	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/postgres"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/sqlite"
 	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
 	"github.com/secmon-lab/hecatoncheires/pkg/utils/logging"
 	"github.com/urfave/cli/v3"
 )
 
 func cmdMigrate() *cli.Command {
+	var backend string
 	var projectID string
 	var databaseID string
+	var dsn string
+	var sqlitePath string
 	var dryRun bool
 
 	return &cli.Command{
 		Name:    "migrate",
 		Aliases: []string{"m"},
-		Usage:   "Migrate Firestore indexes",
+		Usage:   "Migrate Firestore indexes or the PostgreSQL or SQLite schema",
 		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "repository-backend",
+				Usage:       "Repository backend to migrate (firestore, postgres or sqlite)",
+				Value:       "firestore",
+				Sources:     cli.EnvVars("HECATONCHEIRES_REPOSITORY_BACKEND"),
+				Destination: &backend,
+			},
 			&cli.StringFlag{
 				Name:        "firestore-project-id",
-				Usage:       "Firestore Project ID (required)",
-				Required:    true,
+				Usage:       "Firestore Project ID (required for the firestore backend)",
 				Sources:     cli.EnvVars("HECATONCHEIRES_FIRESTORE_PROJECT_ID"),
 				Destination: &projectID,
 			},
@@ -33,6 +44,18 @@ func cmdMigrate() *cli.Command {
 				Sources:     cli.EnvVars("HECATONCHEIRES_FIRESTORE_DATABASE_ID"),
 				Destination: &databaseID,
 			},
+			&cli.StringFlag{
+				Name:        "postgres-dsn",
+				Usage:       "PostgreSQL connection string (required for the postgres backend)",
+				Sources:     cli.EnvVars("HECATONCHEIRES_POSTGRES_DSN"),
+				Destination: &dsn,
+			},
+			&cli.StringFlag{
+				Name:        "sqlite-path",
+				Usage:       "SQLite database file path (required for the sqlite backend)",
+				Sources:     cli.EnvVars("HECATONCHEIRES_SQLITE_PATH"),
+				Destination: &sqlitePath,
+			},
 			&cli.BoolFlag{
 				Name:        "dry-run",
 				Usage:       "Preview changes without applying",
@@ -42,6 +65,19 @@ func cmdMigrate() *cli.Command {
 		Action: func(ctx context.Context, c *cli.Command) error {
 			logger := logging.Default()
 
+			switch backend {
+			case "firestore":
+				if projectID == "" {
+					return goerr.New("firestore-project-id is required when migrating the firestore backend")
+				}
+			case "postgres":
+				return migratePostgres(ctx, dsn, dryRun)
+			case "sqlite":
+				return migrateSQLite(ctx, sqlitePath, dryRun)
+			default:
+				return goerr.New("invalid repository backend for migrate", goerr.V("backend", backend))
+			}
+
 			logger.Info("Migrate configuration",
 				"projectID", projectID,
 				"databaseID", databaseID,
@@ -106,13 +142,95 @@ func cmdMigrate() *cli.Command {
 	}
 }
 
+// migratePostgres applies the embedded schema migrations the running build
+// expects. With dryRun it only lists the pending ones.
+func migratePostgres(ctx context.Context, dsn string, dryRun bool) error {
+	logger := logging.Default()
+	if dsn == "" {
+		return goerr.New("postgres-dsn is required when migrating the postgres backend")
+	}
+
+	logger.Info("Migrate configuration", "backend", "postgres", "dryRun", dryRun)
+
+	migrations, err := postgres.Migrate(ctx, dsn, dryRun)
+	if err != nil {
+		return goerr.Wrap(err, "failed to migrate postgres schema")
+	}
+	logMigrations(migrations, dryRun, func(m postgres.Migration) (int, string) { return m.Version, m.Name })
+	return nil
+}
+
+// migrateSQLite applies the embedded schema migrations to the database file,
+// creating it when it does not exist. With dryRun it only lists the pending
+// ones.
+func migrateSQLite(ctx context.Context, path string, dryRun bool) error {
+	logger := logging.Default()
+	if path == "" {
+		return goerr.New("sqlite-path is required when migrating the sqlite backend")
+	}
+
+	logger.Info("Migrate configuration", "backend", "sqlite", "path", path, "dryRun", dryRun)
+
+	migrations, err := sqlite.Migrate(ctx, path, dryRun)
+	if err != nil {
+		return goerr.Wrap(err, "failed to migrate sqlite schema")
+	}
+	logMigrations(migrations, dryRun, func(m sqlite.Migration) (int, string) { return m.Version, m.Name })
+	return nil
+}
+
+// logMigrations reports the migrations a run applied or, with dryRun, would
+// apply.
+func logMigrations[M any](migrations []M, dryRun bool, describe func(M) (int, string)) {
+	logger := logging.Default()
+	if len(migrations) == 0 {
+		logger.Info("No changes required")
+		return
+	}
+	for _, m := range migrations {
+		version, name := describe(m)
+		if dryRun {
+			logger.Info("Pending migration", "version", version, "name", name)
+		} else {
+			logger.Info("Applied migration", "version", version, "name", name)
+		}
+	}
+}
+
 // getIndexConfig returns the Firestore index configuration.
-// Currently empty: the previous knowledges / memories vector indexes were
-// removed when those features were demolished. The skeleton is preserved so
-// the upcoming redesign can register new collections here without rewiring
-// the migrate subcommand.
+//
+// The memos and jobRuns collection-group indexes serve an incremental
+// export's read of what changed in a workspace (MemoRepository and
+// JobRunRepository ListUpdatedAfter): both collections live under each Case,
+// so only a collection-group query reaches them without listing every Case.
+//
+// The cases indexes serve CaseRepository.Search sorted by a timestamp, which
+// breaks ties on the numeric ID field; each sort direction needs its own.
 func getIndexConfig() *fireconf.Config {
+	updatedInWorkspace := fireconf.Index{
+		QueryScope: fireconf.QueryScopeCollectionGroup,
+		Fields: []fireconf.IndexField{
+			{Path: "WorkspaceID", Order: fireconf.OrderAscending},
+			{Path: "UpdatedAt", Order: fireconf.OrderAscending},
+		},
+	}
+	var caseSearch []fireconf.Index
+	for _, field := range []string{"CreatedAt", "UpdatedAt"} {
+		for _, order := range []fireconf.Order{fireconf.OrderAscending, fireconf.OrderDescending} {
+			caseSearch = append(caseSearch, fireconf.Index{
+				QueryScope: fireconf.QueryScopeCollection,
+				Fields: []fireconf.IndexField{
+					{Path: field, Order: order},
+					{Path: "ID", Order: order},
+				},
+			})
+		}
+	}
 	return &fireconf.Config{
-		Collections: []fireconf.Collection{},
+		Collections: []fireconf.Collection{
+			{Name: "cases", Indexes: caseSearch},
+			{Name: "memos", Indexes: []fireconf.Index{updatedInWorkspace}},
+			{Name: "jobRuns", Indexes: []fireconf.Index{updatedInWorkspace}},
+		},
 	}
 }
@@ -10,15 +10,20 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/gollem-dev/agentkit"
 	"github.com/gollem-dev/gollem"
 	"github.com/gollem-dev/gollem/trace"
 	"github.com/m-mizutani/goerr/v2"
+	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
 	agentkernel "github.com/secmon-lab/hecatoncheires/pkg/agent/kernel"
@@ -29,6 +34,7 @@ import (
 	httpctrl "github.com/secmon-lab/hecatoncheires/pkg/controller/http"
 	"github.com/secmon-lab/hecatoncheires/pkg/i18n"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/agentarchive"
+	"github.com/secmon-lab/hecatoncheires/pkg/service/livebus"
 	"github.com/secmon-lab/hecatoncheires/pkg/service/notion"
 	"github.com/secmon-lab/hecatoncheires/pkg/service/slack"
 	"github.com/secmon-lab/hecatoncheires/pkg/service/worker"
@@ -54,8 +60,16 @@ func logAttrsToArgs(attrs []slog.Attr) []any {
 // HTTP status. The ErrorPresenter tags client-faulted errors (validation,
 // not-found, access-denied) with extensions.code; this middleware reads those
 // codes and returns 4xx for them. Genuine server faults stay 5xx.
+//
+// Subscription streams (a WebSocket upgrade or Server-Sent Events) pass
+// through untouched: buffering would swallow the hijack and every event.
 func graphqlErrorStatusMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isStreamingRequest(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
 		rec := &responseRecorder{
 			ResponseWriter: w,
 			body:           &bytes.Buffer{},
@@ -78,6 +92,12 @@ func graphqlErrorStatusMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// isStreamingRequest reports whether r opens a GraphQL subscription stream.
+func isStreamingRequest(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket") ||
+		strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+}
+
 // gqlErrorEnvelope is the shape we care about in a GraphQL error response —
 // just enough to read extensions.code for HTTP status mapping.
 type gqlErrorEnvelope struct {
@@ -166,6 +186,7 @@ func cmdServe() *cli.Command {
 	var appCfg config.AppConfig
 	var repoCfg config.Repository
 	var slackCfg config.Slack
+	var oidcCfg config.OIDC
 	var llmCfg config.LLM
 	var embCfg config.Embedding
 	var homeMsgCfg config.HomeMessageLLM
@@ -175,7 +196,9 @@ func cmdServe() *cli.Command {
 	var webfetchCfg config.WebFetch
 	var storageCfg config.Storage
 	var sentryCfg config.Sentry
+	var policyCfg config.Policy
 	var mcpCfg config.MCP
+	var ingestCfg config.Ingest
 	var jobCfg config.JobConcurrency
 	var agentCfg config.Agent
 
@@ -233,6 +256,7 @@ func cmdServe() *cli.Command {
 	flags = append(flags, appCfg.Flags()...)
 	flags = append(flags, repoCfg.Flags()...)
 	flags = append(flags, slackCfg.Flags()...)
+	flags = append(flags, oidcCfg.Flags()...)
 	flags = append(flags, llmCfg.Flags()...)
 	flags = append(flags, embCfg.Flags()...)
 	flags = append(flags, homeMsgCfg.Flags()...)
@@ -241,7 +265,9 @@ func cmdServe() *cli.Command {
 	flags = append(flags, webfetchCfg.Flags()...)
 	flags = append(flags, storageCfg.Flags()...)
 	flags = append(flags, sentryCfg.Flags()...)
+	flags = append(flags, policyCfg.Flags()...)
 	flags = append(flags, mcpCfg.Flags()...)
+	flags = append(flags, ingestCfg.Flags()...)
 	flags = append(flags, jobCfg.Flags()...)
 	flags = append(flags, agentCfg.Flags()...)
 
@@ -287,13 +313,53 @@ func cmdServe() *cli.Command {
 				}
 			}()
 
+			// Every Case, Action and JobRunEvent write publishes a live event
+			// for the GraphQL subscriptions. The repository is wrapped rather
+			// than each usecase taught to publish because the Job runtime
+			// appends JobRunEvents below the usecase layer. With Firestore the
+			// events are also relayed, so a subscription held open on one
+			// instance sees writes made on another.
+			liveRelay, closeLiveRelay, err := repoCfg.ConfigureLiveRelay(ctx)
+			if err != nil {
+				return goerr.Wrap(err, "failed to configure live event relay")
+			}
+			defer closeLiveRelay()
+			var liveOpts []livebus.Option
+			if liveRelay != nil {
+				liveOpts = append(liveOpts, livebus.WithRelay(liveRelay))
+			}
+			liveBus := livebus.New(liveOpts...)
+			repo = livebus.PublishingRepository(repo, liveBus)
+			// Registered after closeLiveRelay, so it runs first: the listener
+			// stops before its client is closed.
+			liveCtx, stopLive := context.WithCancel(ctx)
+			liveDone := make(chan struct{})
+			defer func() {
+				stopLive()
+				<-liveDone
+			}()
+			async.DispatchCancelable(liveCtx, func(c context.Context) error {
+				defer close(liveDone)
+				return liveBus.Run(c)
+			})
+
 			// Set no-auth UID if provided
 			if noAuthUID != "" {
 				slackCfg.SetNoAuthUID(noAuthUID)
 			}
 
-			// Configure authentication
-			authUC, err := slackCfg.Configure(ctx, repo, baseURL)
+			// Configure authentication. The OIDC provider, when set, is offered
+			// next to Sign in with Slack.
+			var extraProviders []usecase.IdentityProvider
+			oidcProvider, err := oidcCfg.Configure(ctx, c, baseURL)
+			if err != nil {
+				return goerr.Wrap(err, "failed to configure OIDC provider")
+			}
+			if oidcProvider != nil {
+				extraProviders = append(extraProviders, oidcProvider)
+				logging.Default().Info("OIDC authentication enabled", logAttrsToArgs(oidcCfg.LogAttrs())...)
+			}
+			authUC, err := slackCfg.Configure(ctx, repo, baseURL, extraProviders...)
 			if err != nil {
 				return goerr.Wrap(err, "failed to configure authentication")
 			}
@@ -316,6 +382,7 @@ func cmdServe() *cli.Command {
 			ucOpts := []usecase.Option{
 				usecase.WithAuth(authUC),
 				usecase.WithBaseURL(baseURL),
+				usecase.WithLiveEventBus(liveBus),
 			}
 
 			// Initialize Notion services if token is provided. Two clients are
@@ -516,8 +583,37 @@ func cmdServe() *cli.Command {
 			}()
 
 			ucOpts = append(ucOpts, usecase.WithWorkspaceGroups(groupRegistry))
+
+			// The Rego policy is compiled before the usecases, so GraphQL
+			// mutations and agent tool calls are authorized from the first
+			// request. The MCP endpoint below is authorized by the same client.
+			policyClient, err := policyCfg.Configure(c)
+			if err != nil {
+				return goerr.Wrap(err, "failed to configure authorization policy")
+			}
+			if policyClient != nil {
+				ucOpts = append(ucOpts, usecase.WithPolicy(policyClient))
+				logging.Default().Info("Authorization policy enabled", logAttrsToArgs(policyCfg.LogAttrs())...)
+			}
+
 			uc := usecase.New(repo, registry, ucOpts...)
 
+			// Keep the Slack App Home tabs this instance published current as
+			// cases and actions change. Stopped before the repository it reads
+			// from is closed.
+			if uc.AppHome != nil {
+				appHomeCtx, stopAppHome := context.WithCancel(ctx)
+				appHomeDone := make(chan struct{})
+				defer func() {
+					stopAppHome()
+					<-appHomeDone
+				}()
+				async.DispatchCancelable(appHomeCtx, func(c context.Context) error {
+					defer close(appHomeDone)
+					return uc.AppHome.Run(c)
+				})
+			}
+
 			// Interactive Jobs suspend a run and resume it from a later Slack
 			// submit — possibly on a different instance — so their conversation
 			// history MUST live in a shared backend (Cloud Storage). Fail loudly
@@ -687,16 +783,27 @@ func cmdServe() *cli.Command {
 			// itself out through the runner, which only exists now.
 			durableJobs.AttachRunner(jobRunner)
 			logging.Default().Info("Agent Job runtime configured", logAttrsToArgs(jobCfg.LogAttrs())...)
-			uc.Case.SetEventPublisher(jobUC)
+			// Case events go to the Job dispatcher and to the workspace's
+			// [[webhook]] entries alike.
+			eventPublisher := usecase.CaseEventPublishers{jobUC, uc.Webhook}
+			uc.Case.SetEventPublisher(eventPublisher)
+			uc.Action.SetEventPublisher(eventPublisher)
 			// The web UI's manual Run button drives the same runner through
 			// JobRunUseCase.TriggerJob.
 			uc.JobRun.SetTrigger(jobRunner)
 			tickScanner := job.NewScheduledScanner(job.ScannerDeps{
-				Repo:      repo,
-				Registry:  registry,
-				Publisher: jobUC,
+				Repo:         repo,
+				Registry:     registry,
+				Publisher:    jobUC,
+				RunPublisher: uc.Webhook,
+			})
+			tickHook := httpctrl.NewTickHookHandler(tickSweeps{
+				tickScanner,
+				tickSweepFunc(uc.KnowledgeReview.Sweep),
+				tickSweepFunc(uc.ActionReminder.Sweep),
+				tickSweepFunc(uc.SLA.Sweep),
+				tickSweepFunc(uc.Webhook.Sweep),
 			})
-			tickHook := httpctrl.NewTickHookHandler(tickScanner)
 
 			// Start Slack user refresh worker if Slack service is available
 			// N+1 Prevention Policy: Worker uses DeleteAll → SaveMany (Replace strategy)
@@ -711,9 +818,29 @@ func cmdServe() *cli.Command {
 
 			// Create GraphQL handler with dataloaders
 			resolver := gqlctrl.NewResolver(repo, uc)
-			srv := handler.NewDefaultServer(
-				gqlctrl.NewExecutableSchema(gqlctrl.Config{Resolvers: resolver}),
-			)
+			// NewDefaultServer's setup plus Server-Sent Events, so a client
+			// that cannot open a WebSocket can still subscribe. SSE goes first:
+			// it only claims POSTs that accept text/event-stream, which POST
+			// would otherwise take.
+			srv := handler.New(gqlctrl.NewExecutableSchema(gqlctrl.Config{Resolvers: resolver}))
+			srv.AddTransport(transport.SSE{})
+			srv.AddTransport(transport.Websocket{KeepAlivePingInterval: 10 * time.Second})
+			srv.AddTransport(transport.Options{})
+			srv.AddTransport(transport.GET{})
+			srv.AddTransport(transport.POST{})
+			srv.AddTransport(transport.MultipartForm{})
+			srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+			srv.Use(extension.Introspection{})
+			srv.Use(extension.AutomaticPersistedQuery{Cache: lru.New[string](100)})
+			srv.AroundResponses(gqlctrl.SubscriptionDataLoadersMiddleware(repo, slackSvc))
+			// A read-only session (an SSO user with no Slack account, or a
+			// read-only API token) and an API token used outside its
+			// workspaces are refused before the policy is consulted.
+			srv.AroundFields(gqlctrl.ReadOnlySessionMiddleware())
+			srv.AroundFields(gqlctrl.WorkspaceScopeMiddleware())
+			if uc.Authorizer != nil {
+				srv.AroundFields(gqlctrl.MutationPolicyMiddleware(uc.Authorizer, repo.Action()))
+			}
 
 			// Configure error presenter with stack traces and client/server
 			// classification (extensions.code is read by graphqlErrorStatusMiddleware
@@ -787,26 +914,37 @@ func cmdServe() *cli.Command {
 				httpOpts = append(httpOpts, httpctrl.WithSlackService(slackSvc))
 			}
 
-			// Add Slack webhook handler if configured
-			if slackCfg.IsWebhookConfigured() {
+			// Slack reaches the event, interaction and slash command handlers
+			// over the signed /hooks/slack webhooks, over a Socket Mode
+			// connection, or both; the handlers are the same either way.
+			var slackSocketReceiver *httpctrl.SlackSocketModeReceiver
+			if slackCfg.IsWebhookConfigured() || slackCfg.IsSocketModeConfigured() {
+				if err := slackCfg.ValidateSocketMode(); err != nil {
+					return goerr.Wrap(err, "invalid Slack Socket Mode configuration")
+				}
 				slackWebhookHandler := httpctrl.NewSlackWebhookHandler(uc.Slack)
-				httpOpts = append(httpOpts, httpctrl.WithSlackWebhook(slackWebhookHandler, slackCfg.SigningSecret()))
-				logging.Default().Info("Slack webhook handler enabled")
-
-				// Add Slack interaction handler (shares signing secret with webhook)
 				slackInteractionHandler := httpctrl.NewSlackInteractionHandler(
 					uc.Action, uc.Agent, uc.Slack, uc.Case, uc.MentionProposal, jobRunner,
 				)
-
-				// Add slash command handler
 				slackCommandHandler := httpctrl.NewSlackCommandHandler(uc.Slack)
-				httpOpts = append(httpOpts, httpctrl.WithSlackInteraction(slackInteractionHandler))
-				httpOpts = append(httpOpts, httpctrl.WithSlackCommand(slackCommandHandler))
-				logging.Default().Info("Slack interaction handler enabled")
-				logging.Default().Info("Slack slash command handler enabled")
+
+				if slackCfg.IsWebhookConfigured() {
+					httpOpts = append(httpOpts, httpctrl.WithSlackWebhook(slackWebhookHandler, slackCfg.SigningSecret()))
+					httpOpts = append(httpOpts, httpctrl.WithSlackInteraction(slackInteractionHandler))
+					httpOpts = append(httpOpts, httpctrl.WithSlackCommand(slackCommandHandler))
+					logging.Default().Info("Slack webhook handler enabled")
+					logging.Default().Info("Slack interaction handler enabled")
+					logging.Default().Info("Slack slash command handler enabled")
+				}
+				if slackCfg.IsSocketModeConfigured() {
+					slackSocketReceiver = httpctrl.NewSlackSocketModeReceiver(slackCfg.AppToken(),
+						slackWebhookHandler, slackInteractionHandler, slackCommandHandler)
+					logging.Default().Info("Slack Socket Mode receiver enabled")
+				}
 			}
 
-			// Register the scheduled-Job sweep webhook.
+			// Register the tick webhook: the scheduled-Job scan, then the knowledge
+			// review, action reminder, SLA and webhook-retry sweeps.
 			httpOpts = append(httpOpts, httpctrl.WithTickHook(tickHook))
 
 			// Register the DB consistency check endpoint. Its configuration comes
@@ -819,17 +957,30 @@ func cmdServe() *cli.Command {
 			// --mcp is set without a --policy: we never expose the MCP data
 			// surface without a Rego authorization policy.
 			if mcpCfg.IsEnabled() {
-				policyClient, mcpEnv, err := mcpCfg.Configure(c)
+				mcpEnv, err := mcpCfg.Configure(c, policyClient)
 				if err != nil {
 					return goerr.Wrap(err, "failed to configure MCP endpoint")
 				}
-				mcpHandler := httpctrl.NewMCPHandler(uc.Case, uc.Action, registry, policyClient, mcpEnv)
+				mcpHandler := httpctrl.NewMCPHandler(uc.Case, uc.Action, uc.ActionStep, uc.Memo, uc.Knowledge, uc.Tag, registry, policyClient, mcpEnv)
 				httpOpts = append(httpOpts, httpctrl.WithMCP(mcpHandler))
 				logging.Default().Info("MCP endpoint enabled", logAttrsToArgs(mcpCfg.LogAttrs())...)
 			} else {
 				logging.Default().Info("MCP endpoint disabled")
 			}
 
+			// Wire the alert ingestion endpoint when enabled. As with MCP,
+			// Configure refuses --ingest without a --policy: the policy is
+			// the only thing standing between the endpoint and the internet.
+			if ingestCfg.IsEnabled() {
+				ingestEnv, err := ingestCfg.Configure(c, policyClient)
+				if err != nil {
+					return goerr.Wrap(err, "failed to configure alert ingestion endpoint")
+				}
+				ingestHandler := httpctrl.NewIngestHandler(uc.AlertIngest, registry, policyClient, ingestEnv)
+				httpOpts = append(httpOpts, httpctrl.WithIngest(ingestHandler))
+				logging.Default().Info("alert ingestion endpoint enabled", logAttrsToArgs(ingestCfg.LogAttrs())...)
+			}
+
 			// Start the agent runtime worker. It claims runnable agent processes
 			// from the shared store and drives one transition at a time, and it
 			// is also what makes eager dispatch work: agentkit only dispatches a
@@ -893,8 +1044,19 @@ func cmdServe() *cli.Command {
 			sigCh := make(chan os.Signal, 1)
 			signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
 
-			// Start server in goroutine
-			errCh := make(chan error, 1)
+			// Start server in goroutine. The Socket Mode receiver, when
+			// enabled, reports a fatal connection error on the same channel.
+			errCh := make(chan error, 2)
+			if slackSocketReceiver != nil {
+				socketCtx, stopSocket := context.WithCancel(ctx)
+				defer stopSocket()
+				go func() {
+					logging.Default().Info("Starting Slack Socket Mode receiver")
+					if err := slackSocketReceiver.Run(socketCtx); err != nil {
+						errCh <- err
+					}
+				}()
+			}
 			go func() {
 				logging.Default().Info("Starting HTTP server", "addr", addr, "graphiql", enableGraphiQL)
 				if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
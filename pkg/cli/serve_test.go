@@ -116,3 +116,24 @@ func TestGraphqlErrorStatusMiddleware_MapsClientErrorsTo4xx(t *testing.T) {
 		})
 	}
 }
+
+func TestGraphqlErrorStatusMiddleware_PassesStreamsThrough(t *testing.T) {
+	for name, setHeader := range map[string]func(*http.Request){
+		"websocket upgrade":  func(r *http.Request) { r.Header.Set("Upgrade", "websocket") },
+		"server-sent events": func(r *http.Request) { r.Header.Set("Accept", "text/event-stream") },
+	} {
+		t.Run(name, func(t *testing.T) {
+			var sawRecorder bool
+			h := cli.GraphqlErrorStatusMiddlewareForTest(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, sawRecorder = w.(*httptest.ResponseRecorder)
+				w.WriteHeader(http.StatusSwitchingProtocols)
+			}))
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/graphql", nil)
+			setHeader(req)
+			h.ServeHTTP(rec, req)
+			gt.Bool(t, sawRecorder).True()
+			gt.Number(t, rec.Code).Equal(http.StatusSwitchingProtocols)
+		})
+	}
+}
@@ -2,18 +2,44 @@ package cli
 
 import (
 	"context"
+	"errors"
 
 	"github.com/m-mizutani/goerr/v2"
 	"github.com/urfave/cli/v3"
 
 	"github.com/secmon-lab/hecatoncheires/pkg/cli/config"
+	httpctrl "github.com/secmon-lab/hecatoncheires/pkg/controller/http"
 	"github.com/secmon-lab/hecatoncheires/pkg/utils/async"
 	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
 	"github.com/secmon-lab/hecatoncheires/pkg/utils/logging"
 )
 
+// tickSweepFunc adapts a sweep function to httpctrl.TickScanner.
+type tickSweepFunc func(ctx context.Context) error
+
+// Scan implements httpctrl.TickScanner.
+func (f tickSweepFunc) Scan(ctx context.Context) error { return f(ctx) }
+
+// tickSweeps runs every sweep one tick fires, in order. A failing sweep does
+// not stop the ones after it: the scheduled-Job scan erroring on one workspace
+// must not also hold back the knowledge review notifications. The errors are
+// joined.
+type tickSweeps []httpctrl.TickScanner
+
+// Scan implements httpctrl.TickScanner.
+func (s tickSweeps) Scan(ctx context.Context) error {
+	var errs []error
+	for _, sweep := range s {
+		if err := sweep.Scan(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // cmdTick is the `hecatoncheires tick` subcommand: a one-shot sweep over
-// every workspace's scheduled Jobs. The same logic backs `POST /hooks/tick`.
+// every workspace's scheduled Jobs, its knowledge review dates, its Action
+// due dates and its case SLAs. The same logic backs `POST /hooks/tick`.
 // Wire to Cloud Scheduler (or any cron) — the command exits when the sweep
 // and every run it dispatched have finished.
 //
@@ -42,6 +68,7 @@ func cmdTick() *cli.Command {
 	var slackCfg config.Slack
 	var jiraCfg config.Jira
 	var webfetchCfg config.WebFetch
+	var policyCfg config.Policy
 	var notionToken string
 	var baseURL string
 
@@ -71,6 +98,7 @@ func cmdTick() *cli.Command {
 	flags = append(flags, slackCfg.RuntimeFlags()...)
 	flags = append(flags, jiraCfg.Flags()...)
 	flags = append(flags, webfetchCfg.Flags()...)
+	flags = append(flags, policyCfg.Flags()...)
 
 	return &cli.Command{
 		Name:  "tick",
@@ -90,6 +118,7 @@ func cmdTick() *cli.Command {
 					WebFetch:    &webfetchCfg,
 					NotionToken: notionToken,
 					BaseURL:     baseURL,
+					Policy:      &policyCfg,
 				}, c)
 			if err != nil {
 				return goerr.Wrap(err, "failed to build tick runtime")
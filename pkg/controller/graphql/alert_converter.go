@@ -0,0 +1,17 @@
+package graphql
+
+import (
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	graphql1 "github.com/secmon-lab/hecatoncheires/pkg/domain/model/graphql"
+)
+
+func toGraphQLAlert(a *model.Alert) *graphql1.Alert {
+	return &graphql1.Alert{
+		ID:          a.ID.String(),
+		Title:       a.Title,
+		Fingerprint: a.Fingerprint,
+		Payload:     a.Payload,
+		Repeat:      a.Repeat,
+		ReceivedAt:  a.ReceivedAt,
+	}
+}
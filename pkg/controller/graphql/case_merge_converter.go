@@ -0,0 +1,13 @@
+package graphql
+
+import (
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	graphql1 "github.com/secmon-lab/hecatoncheires/pkg/domain/model/graphql"
+)
+
+func toGraphQLDuplicateCandidate(c *model.CaseDuplicateCandidate, workspaceID string) *graphql1.DuplicateCandidate {
+	return &graphql1.DuplicateCandidate{
+		Case:  toGraphQLCase(c.Case, workspaceID),
+		Score: c.Score,
+	}
+}
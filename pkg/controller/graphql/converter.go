@@ -3,12 +3,16 @@ package graphql
 import (
 	"context"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
 	graphql1 "github.com/secmon-lab/hecatoncheires/pkg/domain/model/graphql"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/slack"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
 )
 
 // toGraphQLSlackMessage converts a domain slack.Message to its GraphQL view.
@@ -172,6 +176,11 @@ func toGraphQLCase(c *model.Case, workspaceID string) *graphql1.Case {
 		v := c.BoardStatus
 		boardStatus = &v
 	}
+	var mergedIntoID *int
+	if c.MergedInto != 0 {
+		v := int(c.MergedInto)
+		mergedIntoID = &v
+	}
 
 	return &graphql1.Case{
 		ID:                    int(c.ID),
@@ -189,6 +198,7 @@ func toGraphQLCase(c *model.Case, workspaceID string) *graphql1.Case {
 		SlackThreadTS:         slackThreadTS,
 		IsThreadBound:         c.IsThreadBound(),
 		BoardStatus:           boardStatus,
+		MergedIntoID:          mergedIntoID,
 		Fields:                toGraphQLFieldValues(c.FieldValues),
 		AgentAdditionalPrompt: agentPrompt,
 		AgentSourceIDs:        agentSourceIDs,
@@ -249,6 +259,11 @@ func toGraphQLAction(a *model.Action, workspaceID string) *graphql1.Action {
 		assigneeID = &s
 	}
 
+	blockedByIDs := make([]int, 0, len(a.BlockedBy))
+	for _, id := range a.BlockedBy {
+		blockedByIDs = append(blockedByIDs, int(id))
+	}
+
 	return &graphql1.Action{
 		ID:             int(a.ID),
 		WorkspaceID:    workspaceID,
@@ -263,9 +278,39 @@ func toGraphQLAction(a *model.Action, workspaceID string) *graphql1.Action {
 		ArchivedAt:     a.ArchivedAt,
 		CreatedAt:      a.CreatedAt,
 		UpdatedAt:      a.UpdatedAt,
+		BlockedByIDs:   blockedByIDs,
 	}
 }
 
+// toInt64IDs converts GraphQL Int IDs to storage IDs. The result is non-nil
+// for a non-nil ids, so an explicit empty list stays distinguishable from an
+// absent one.
+func toInt64IDs(ids []int) []int64 {
+	if ids == nil {
+		return nil
+	}
+	out := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, int64(id))
+	}
+	return out
+}
+
+// loadSiblingActions returns every action of obj's case, archived ones
+// included, through the per-request loader. It returns none when the caller
+// cannot access the case.
+func loadSiblingActions(ctx context.Context, obj *graphql1.Action) ([]*model.Action, error) {
+	loaders := GetDataLoaders(ctx)
+	c, err := loaders.Case.Load(ctx, MakeCaseKey(obj.WorkspaceID, int64(obj.CaseID)))()
+	if err != nil {
+		return nil, err
+	}
+	if c == nil || c.AccessDenied {
+		return nil, nil
+	}
+	return loaders.AllActionsByCaseLoader.Load(ctx, MakeActionsByCaseKey(obj.WorkspaceID, int64(obj.CaseID)))()
+}
+
 // toGraphQLFieldValues converts domain FieldValues map to GraphQL FieldValue slice
 func toGraphQLFieldValues(fieldValues map[string]model.FieldValue) []*graphql1.FieldValue {
 	if fieldValues == nil {
@@ -395,20 +440,105 @@ func (r *Resolver) tagByIDFor(ctx context.Context, workspaceID string) (map[mode
 // embedding vector is intentionally not exposed. TagIDs are resolved to Tag
 // objects via tagByID; ids missing from the map are skipped (never a nil
 // element) and the slice is never nil, to satisfy the [Tag!]! contract.
+// expired / reviewDue are evaluated at conversion time.
 func toGraphQLKnowledge(k *model.Knowledge, tagByID map[model.TagID]*model.Tag) *graphql1.Knowledge {
-	tags := make([]*graphql1.Tag, 0, len(k.TagIDs))
-	for _, id := range k.TagIDs {
+	now := time.Now()
+	return &graphql1.Knowledge{
+		ID:              string(k.ID),
+		Title:           k.Title,
+		Claim:           k.Claim,
+		Tags:            toGraphQLTags(k.TagIDs, tagByID),
+		ValidUntil:      k.ValidUntil,
+		ReviewBy:        k.ReviewBy,
+		ReviewFlaggedAt: k.ReviewFlaggedAt,
+		Expired:         k.IsExpired(now),
+		ReviewDue:       k.IsReviewDue(now),
+		CreatedAt:       k.CreatedAt,
+		UpdatedAt:       k.UpdatedAt,
+	}
+}
+
+// toGraphQLKnowledgeSearchHit maps one ranked search result.
+func toGraphQLKnowledgeSearchHit(h *model.KnowledgeSearchHit, tagByID map[model.TagID]*model.Tag) *graphql1.KnowledgeSearchHit {
+	return &graphql1.KnowledgeSearchHit{
+		Knowledge:    toGraphQLKnowledge(h.Knowledge, tagByID),
+		Score:        h.Score,
+		LexicalScore: h.LexicalScore,
+		VectorScore:  h.VectorScore,
+	}
+}
+
+// toGraphQLTags resolves tag ids through tagByID with the same skip-missing,
+// never-nil contract as toGraphQLKnowledge.
+// toGraphQLAPIToken maps a personal access token. The secret hash never
+// leaves the server.
+func toGraphQLAPIToken(t *auth.APIToken) *graphql1.APIToken {
+	return &graphql1.APIToken{
+		ID:           t.ID.String(),
+		Name:         t.Name,
+		WorkspaceIds: t.Workspaces,
+		ReadOnly:     t.ReadOnly,
+		ExpiresAt:    t.ExpiresAt,
+		CreatedAt:    t.CreatedAt,
+		LastUsedAt:   t.LastUsedAt,
+		Expired:      t.IsExpired(),
+	}
+}
+
+func toGraphQLTags(ids []model.TagID, tagByID map[model.TagID]*model.Tag) []*graphql1.Tag {
+	tags := make([]*graphql1.Tag, 0, len(ids))
+	for _, id := range ids {
 		if t, ok := tagByID[id]; ok {
 			tags = append(tags, toGraphQLTag(t))
 		}
 	}
-	return &graphql1.Knowledge{
-		ID:        string(k.ID),
-		Title:     k.Title,
-		Claim:     k.Claim,
-		Tags:      tags,
-		CreatedAt: k.CreatedAt,
-		UpdatedAt: k.UpdatedAt,
+	return tags
+}
+
+// toGraphQLKnowledgeRevision maps a domain KnowledgeRevision. Empty
+// attribution fields and a zero RestoredFrom become null.
+func toGraphQLKnowledgeRevision(r *model.KnowledgeRevision, tagByID map[model.TagID]*model.Tag) *graphql1.KnowledgeRevision {
+	optional := func(s string) *string {
+		if s == "" {
+			return nil
+		}
+		return &s
+	}
+	var restoredFrom *int
+	if r.RestoredFrom > 0 {
+		v := r.RestoredFrom
+		restoredFrom = &v
+	}
+	return &graphql1.KnowledgeRevision{
+		Revision:     r.Revision,
+		Action:       graphql1.KnowledgeRevisionAction(strings.ToUpper(string(r.Action))),
+		Title:        r.Title,
+		Claim:        r.Claim,
+		Tags:         toGraphQLTags(r.TagIDs, tagByID),
+		EditorID:     optional(r.EditorID),
+		JobID:        optional(r.JobID),
+		RunID:        optional(r.RunID),
+		RestoredFrom: restoredFrom,
+		CreatedAt:    r.CreatedAt,
+	}
+}
+
+// toGraphQLKnowledgeRevisionDiff maps a domain KnowledgeRevisionDiff.
+func toGraphQLKnowledgeRevisionDiff(d *model.KnowledgeRevisionDiff, tagByID map[model.TagID]*model.Tag) *graphql1.KnowledgeRevisionDiff {
+	lines := func(in []model.DiffLine) []*graphql1.DiffLine {
+		out := make([]*graphql1.DiffLine, len(in))
+		for i, l := range in {
+			out[i] = &graphql1.DiffLine{Op: graphql1.DiffOp(strings.ToUpper(string(l.Op))), Text: l.Text}
+		}
+		return out
+	}
+	return &graphql1.KnowledgeRevisionDiff{
+		From:        toGraphQLKnowledgeRevision(d.From, tagByID),
+		To:          toGraphQLKnowledgeRevision(d.To, tagByID),
+		Title:       lines(d.Title),
+		Claim:       lines(d.Claim),
+		AddedTags:   toGraphQLTags(d.AddedTagIDs, tagByID),
+		RemovedTags: toGraphQLTags(d.RemovedTagIDs, tagByID),
 	}
 }
 
@@ -451,3 +581,56 @@ func toGraphQLCaseRef(ref model.CaseRef) *graphql1.CaseRef {
 		WorkspaceID: ref.WorkspaceID,
 	}
 }
+
+// toSearchCasesInput maps the optional searchCases arguments to the usecase
+// input. A nil filter matches every non-draft case; a nil sort is the domain
+// default, newest-created first.
+func toSearchCasesInput(filter *graphql1.CaseSearchFilter, sortInput *graphql1.CaseSortInput, first *int, after *string) usecase.SearchCasesInput {
+	in := usecase.SearchCasesInput{After: after}
+	if first != nil {
+		in.First = *first
+	}
+	if sortInput != nil {
+		in.Sort = interfaces.CaseSort{
+			Key:       interfaces.CaseSortKey(sortInput.Key),
+			Ascending: sortInput.Direction == graphql1.SortDirectionAsc,
+		}
+	}
+	if filter == nil {
+		return in
+	}
+
+	deref := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+	derefTime := func(t *time.Time) time.Time {
+		if t == nil {
+			return time.Time{}
+		}
+		return *t
+	}
+
+	in.Status = filter.Status
+	in.AssigneeID = deref(filter.AssigneeID)
+	in.ReporterID = deref(filter.ReporterID)
+	in.Text = deref(filter.Text)
+	in.CreatedFrom = derefTime(filter.CreatedFrom)
+	in.CreatedTo = derefTime(filter.CreatedTo)
+	in.UpdatedFrom = derefTime(filter.UpdatedFrom)
+	in.UpdatedTo = derefTime(filter.UpdatedTo)
+	in.IsTest = filter.IsTest
+	for _, f := range filter.Fields {
+		in.FieldFilters = append(in.FieldFilters, interfaces.CaseFieldFilter{
+			FieldID:   types.FieldID(f.FieldID),
+			OptionIDs: f.OptionIds,
+			DateFrom:  derefTime(f.DateFrom),
+			DateTo:    derefTime(f.DateTo),
+			NumberMin: f.NumberMin,
+			NumberMax: f.NumberMax,
+		})
+	}
+	return in
+}
@@ -43,6 +43,19 @@ func (r *queryResolver) MyDueActions(ctx context.Context) ([]*graphql1.MyDueActi
 	return result, nil
 }
 
+// OpenSLABreaches is the resolver for the openSLABreaches field.
+func (r *queryResolver) OpenSLABreaches(ctx context.Context) ([]*graphql1.OpenSLABreach, error) {
+	items, err := r.UseCases.Dashboard.ListOpenSLABreaches(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*graphql1.OpenSLABreach, len(items))
+	for i, it := range items {
+		result[i] = toGraphQLOpenSLABreach(it)
+	}
+	return result, nil
+}
+
 // FavoriteWorkspaceIds is the resolver for the favoriteWorkspaceIds field.
 func (r *queryResolver) FavoriteWorkspaceIds(ctx context.Context) ([]string, error) {
 	return r.UseCases.Dashboard.GetFavoriteWorkspaces(ctx)
@@ -168,6 +168,9 @@ func (c *slackUserCallCounter) GetByIDs(ctx context.Context, ids []model.SlackUs
 	c.calls.Add(1)
 	return c.inner.GetByIDs(ctx, ids)
 }
+func (c *slackUserCallCounter) GetByEmail(ctx context.Context, email string) (*model.SlackUser, error) {
+	return c.inner.GetByEmail(ctx, email)
+}
 func (c *slackUserCallCounter) SaveMany(ctx context.Context, users []*model.SlackUser) error {
 	return c.inner.SaveMany(ctx, users)
 }
@@ -73,6 +73,7 @@ func ErrorCode(err error) string {
 		errors.Is(err, model.ErrCaseFieldValidation),
 		errors.Is(err, model.ErrInvalidNotionID),
 		errors.Is(err, model.ErrInvalidGitHubRepo),
+		errors.Is(err, model.ErrActionDependency),
 		errors.Is(err, usecase.ErrUnknownUser),
 		errors.Is(err, usecase.ErrInvalidArgument),
 		errors.Is(err, usecase.ErrCaseThreadModeNoActions):
@@ -82,12 +83,15 @@ func ErrorCode(err error) string {
 		errors.Is(err, usecase.ErrActionStepNotFound),
 		errors.Is(err, usecase.ErrActionCommentNotFound),
 		errors.Is(err, usecase.ErrJobNotFound),
+		errors.Is(err, usecase.ErrAPITokenNotFound),
+		errors.Is(err, usecase.ErrWebhookDeliveryNotFound),
 		errors.Is(err, model.ErrWorkspaceNotFound):
 		return ErrCodeNotFound
 	case errors.Is(err, usecase.ErrAccessDenied):
 		return ErrCodeForbidden
 	case errors.Is(err, usecase.ErrCaseAlreadyClosed),
 		errors.Is(err, usecase.ErrCaseAlreadyOpen),
+		errors.Is(err, usecase.ErrCaseAlreadyMerged),
 		errors.Is(err, usecase.ErrJobAlreadyRunning),
 		errors.Is(err, usecase.ErrDuplicateField):
 		return ErrCodeConflict
@@ -27,6 +27,7 @@ func TestErrorCode(t *testing.T) {
 		{"case is draft", goerr.Wrap(usecase.ErrCaseIsDraft, "x"), gqlctrl.ErrCodeInvalidStatusTransition},
 		{"thread-mode lifecycle via status", goerr.Wrap(usecase.ErrCaseThreadModeUseStatus, "x"), gqlctrl.ErrCodeInvalidStatusTransition},
 		{"thread-mode case no actions", goerr.Wrap(usecase.ErrCaseThreadModeNoActions, "x"), gqlctrl.ErrCodeBadUserInput},
+		{"invalid action dependency", goerr.Wrap(model.ErrActionDependency, "x"), gqlctrl.ErrCodeBadUserInput},
 		{"private case in thread mode", goerr.Wrap(usecase.ErrCasePrivateThreadModeUnsupported, "x"), gqlctrl.ErrCodeBadUserInput},
 		{"field validation failed", goerr.Wrap(usecase.ErrFieldValidationFailed, "x"), gqlctrl.ErrCodeFieldValidationFailed},
 		{"activation failed", goerr.Wrap(usecase.ErrActivationFailed, "x"), gqlctrl.ErrCodeActivationFailed},
@@ -36,6 +37,8 @@ func TestErrorCode(t *testing.T) {
 		{"private joined with activation failed → bad user input", errors.Join(usecase.ErrActivationFailed, goerr.Wrap(usecase.ErrCasePrivateThreadModeUnsupported, "x")), gqlctrl.ErrCodeBadUserInput},
 		{"case not found", goerr.Wrap(usecase.ErrCaseNotFound, "x"), gqlctrl.ErrCodeNotFound},
 		{"job not triggerable", goerr.Wrap(usecase.ErrJobNotFound, "x"), gqlctrl.ErrCodeNotFound},
+		{"API token not found", goerr.Wrap(usecase.ErrAPITokenNotFound, "x"), gqlctrl.ErrCodeNotFound},
+		{"webhook delivery not found", goerr.Wrap(usecase.ErrWebhookDeliveryNotFound, "x"), gqlctrl.ErrCodeNotFound},
 		{"job already running", goerr.Wrap(usecase.ErrJobAlreadyRunning, "x"), gqlctrl.ErrCodeConflict},
 		{"access denied", goerr.Wrap(usecase.ErrAccessDenied, "x"), gqlctrl.ErrCodeForbidden},
 		{"already closed", goerr.Wrap(usecase.ErrCaseAlreadyClosed, "x"), gqlctrl.ErrCodeConflict},
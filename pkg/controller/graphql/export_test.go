@@ -31,3 +31,8 @@ var ToGraphQLActionCommentForTest = toGraphQLActionComment
 // so the external graphql_test package can assert the domain → GraphQL field
 // type enum bridge (notably the markdown mapping).
 var ToGraphQLFieldTypeForTest = toGraphQLFieldType
+
+// ToGraphQLKnowledgeRevisionDiffForTest exposes the unexported
+// toGraphQLKnowledgeRevisionDiff converter so a test can pin the enum casing
+// and the empty-attribution → null rule of the revision history view.
+var ToGraphQLKnowledgeRevisionDiffForTest = toGraphQLKnowledgeRevisionDiff
@@ -34,20 +34,37 @@ type ResolverRoot interface {
 	ActionComment() ActionCommentResolver
 	ActionEvent() ActionEventResolver
 	Case() CaseResolver
+	KnowledgeRevision() KnowledgeRevisionResolver
 	Memo() MemoResolver
 	Mutation() MutationResolver
 	Query() QueryResolver
+	Subscription() SubscriptionResolver
 }
 
 type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	APIToken struct {
+		CreatedAt    func(childComplexity int) int
+		Expired      func(childComplexity int) int
+		ExpiresAt    func(childComplexity int) int
+		ID           func(childComplexity int) int
+		LastUsedAt   func(childComplexity int) int
+		Name         func(childComplexity int) int
+		ReadOnly     func(childComplexity int) int
+		WorkspaceIds func(childComplexity int) int
+	}
+
 	Action struct {
 		Archived       func(childComplexity int) int
 		ArchivedAt     func(childComplexity int) int
 		Assignee       func(childComplexity int) int
 		AssigneeID     func(childComplexity int) int
+		Blocked        func(childComplexity int) int
+		BlockedBy      func(childComplexity int) int
+		BlockedByIDs   func(childComplexity int) int
+		Blocking       func(childComplexity int) int
 		Case           func(childComplexity int) int
 		CaseID         func(childComplexity int) int
 		Comments       func(childComplexity int, limit *int, cursor *string) int
@@ -66,6 +83,12 @@ type ComplexityRoot struct {
 		WorkspaceID    func(childComplexity int) int
 	}
 
+	ActionChangeEvent struct {
+		Action   func(childComplexity int) int
+		ActionID func(childComplexity int) int
+		Kind     func(childComplexity int) int
+	}
+
 	ActionComment struct {
 		ActionID  func(childComplexity int) int
 		Author    func(childComplexity int) int
@@ -131,6 +154,15 @@ type ComplexityRoot struct {
 		Total func(childComplexity int) int
 	}
 
+	Alert struct {
+		Fingerprint func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Payload     func(childComplexity int) int
+		ReceivedAt  func(childComplexity int) int
+		Repeat      func(childComplexity int) int
+		Title       func(childComplexity int) int
+	}
+
 	AssistLog struct {
 		Actions   func(childComplexity int) int
 		CaseID    func(childComplexity int) int
@@ -152,6 +184,7 @@ type ComplexityRoot struct {
 		Actions               func(childComplexity int, filter *graphql1.ActionArchiveFilter) int
 		AgentAdditionalPrompt func(childComplexity int) int
 		AgentSources          func(childComplexity int) int
+		Alerts                func(childComplexity int) int
 		AssigneeIDs           func(childComplexity int) int
 		Assignees             func(childComplexity int) int
 		BoardStatus           func(childComplexity int) int
@@ -159,13 +192,17 @@ type ComplexityRoot struct {
 		ChannelUsers          func(childComplexity int, limit *int, offset *int, filter *string) int
 		CreatedAt             func(childComplexity int) int
 		Description           func(childComplexity int) int
+		DuplicateCandidates   func(childComplexity int) int
 		Fields                func(childComplexity int) int
 		ID                    func(childComplexity int) int
 		IsPrivate             func(childComplexity int) int
 		IsTest                func(childComplexity int) int
 		IsThreadBound         func(childComplexity int) int
+		MergedIntoID          func(childComplexity int) int
 		Reporter              func(childComplexity int) int
 		ReporterID            func(childComplexity int) int
+		SLABreaches           func(childComplexity int) int
+		SLAClocks             func(childComplexity int) int
 		SlackChannelID        func(childComplexity int) int
 		SlackChannelName      func(childComplexity int) int
 		SlackChannelURL       func(childComplexity int) int
@@ -177,6 +214,17 @@ type ComplexityRoot struct {
 		WorkspaceID           func(childComplexity int) int
 	}
 
+	CaseChangeEvent struct {
+		Case   func(childComplexity int) int
+		CaseID func(childComplexity int) int
+		Kind   func(childComplexity int) int
+	}
+
+	CaseConnection struct {
+		Items      func(childComplexity int) int
+		NextCursor func(childComplexity int) int
+	}
+
 	CaseJob struct {
 		Description func(childComplexity int) int
 		ID          func(childComplexity int) int
@@ -201,6 +249,21 @@ type ComplexityRoot struct {
 		TotalCount func(childComplexity int) int
 	}
 
+	CreatedAPIToken struct {
+		Secret func(childComplexity int) int
+		Token  func(childComplexity int) int
+	}
+
+	DiffLine struct {
+		Op   func(childComplexity int) int
+		Text func(childComplexity int) int
+	}
+
+	DuplicateCandidate struct {
+		Case  func(childComplexity int) int
+		Score func(childComplexity int) int
+	}
+
 	EntityLabels struct {
 		Case func(childComplexity int) int
 	}
@@ -380,16 +443,53 @@ type ComplexityRoot struct {
 
 	JobTrigger struct {
 		CaseEvents func(childComplexity int) int
+		CaseField  func(childComplexity int) int
+		CaseTo     func(childComplexity int) int
 		Schedule   func(childComplexity int) int
 	}
 
 	Knowledge struct {
-		Claim     func(childComplexity int) int
-		CreatedAt func(childComplexity int) int
-		ID        func(childComplexity int) int
-		Tags      func(childComplexity int) int
-		Title     func(childComplexity int) int
-		UpdatedAt func(childComplexity int) int
+		Claim           func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		Expired         func(childComplexity int) int
+		ID              func(childComplexity int) int
+		ReviewBy        func(childComplexity int) int
+		ReviewDue       func(childComplexity int) int
+		ReviewFlaggedAt func(childComplexity int) int
+		Tags            func(childComplexity int) int
+		Title           func(childComplexity int) int
+		UpdatedAt       func(childComplexity int) int
+		ValidUntil      func(childComplexity int) int
+	}
+
+	KnowledgeRevision struct {
+		Action       func(childComplexity int) int
+		Claim        func(childComplexity int) int
+		CreatedAt    func(childComplexity int) int
+		Editor       func(childComplexity int) int
+		EditorID     func(childComplexity int) int
+		JobID        func(childComplexity int) int
+		RestoredFrom func(childComplexity int) int
+		Revision     func(childComplexity int) int
+		RunID        func(childComplexity int) int
+		Tags         func(childComplexity int) int
+		Title        func(childComplexity int) int
+	}
+
+	KnowledgeRevisionDiff struct {
+		AddedTags   func(childComplexity int) int
+		Claim       func(childComplexity int) int
+		From        func(childComplexity int) int
+		RemovedTags func(childComplexity int) int
+		Title       func(childComplexity int) int
+		To          func(childComplexity int) int
+	}
+
+	KnowledgeSearchHit struct {
+		Knowledge    func(childComplexity int) int
+		LexicalScore func(childComplexity int) int
+		Score        func(childComplexity int) int
+		VectorScore  func(childComplexity int) int
 	}
 
 	Memo struct {
@@ -409,59 +509,64 @@ type ComplexityRoot struct {
 	}
 
 	Mutation struct {
-		AddActionStep           func(childComplexity int, workspaceID string, input graphql1.AddActionStepInput) int
-		ArchiveAction           func(childComplexity int, workspaceID string, id int) int
-		ArchiveMemo             func(childComplexity int, workspaceID string, caseID int, id string) int
-		AssignCase              func(childComplexity int, workspaceID string, id int, userIDs []string) int
-		BulkArchiveActions      func(childComplexity int, workspaceID string, ids []int) int
-		CloseCase               func(childComplexity int, workspaceID string, id int) int
-		CreateAction            func(childComplexity int, workspaceID string, input graphql1.CreateActionInput) int
-		CreateActionComment     func(childComplexity int, workspaceID string, input graphql1.CreateActionCommentInput) int
-		CreateCase              func(childComplexity int, workspaceID string, input graphql1.CreateCaseInput) int
-		CreateCaseImport        func(childComplexity int, workspaceID string, input graphql1.CreateCaseImportInput) int
-		CreateDraft             func(childComplexity int, workspaceID string, input graphql1.CreateDraftInput) int
-		CreateGitHubSource      func(childComplexity int, workspaceID string, input graphql1.CreateGitHubSourceInput) int
-		CreateKnowledge         func(childComplexity int, workspaceID string, input graphql1.CreateKnowledgeInput) int
-		CreateMemo              func(childComplexity int, workspaceID string, input graphql1.CreateMemoInput) int
-		CreateNotionDBSource    func(childComplexity int, workspaceID string, input graphql1.CreateNotionDBSourceInput) int
-		CreateNotionPageSource  func(childComplexity int, workspaceID string, input graphql1.CreateNotionPageSourceInput) int
-		CreateSlackSource       func(childComplexity int, workspaceID string, input graphql1.CreateSlackSourceInput) int
-		CreateTag               func(childComplexity int, workspaceID string, name *string) int
-		DeleteActionComment     func(childComplexity int, workspaceID string, input graphql1.DeleteActionCommentInput) int
-		DeleteActionStep        func(childComplexity int, workspaceID string, input graphql1.DeleteActionStepInput) int
-		DeleteCase              func(childComplexity int, workspaceID string, id int) int
-		DeleteKnowledge         func(childComplexity int, workspaceID string, id string) int
-		DeleteSource            func(childComplexity int, workspaceID string, id string) int
-		DeleteTag               func(childComplexity int, workspaceID string, id string) int
-		DiscardDraft            func(childComplexity int, workspaceID string, id int) int
-		ExecuteCaseImport       func(childComplexity int, workspaceID string, id string) int
-		Noop                    func(childComplexity int) int
-		PostActionSlackMessage  func(childComplexity int, workspaceID string, id int) int
-		RenameActionStep        func(childComplexity int, workspaceID string, input graphql1.RenameActionStepInput) int
-		ReopenCase              func(childComplexity int, workspaceID string, id int) int
-		SetActionStepDone       func(childComplexity int, workspaceID string, input graphql1.SetActionStepDoneInput) int
-		SetFavoriteWorkspaces   func(childComplexity int, workspaceIds []string) int
-		SubmitDraft             func(childComplexity int, workspaceID string, id int, input *graphql1.SubmitDraftInput) int
-		SyncCaseChannelUsers    func(childComplexity int, workspaceID string, id int) int
-		TriggerCaseJob          func(childComplexity int, workspaceID string, caseID int, jobID string) int
-		UnarchiveAction         func(childComplexity int, workspaceID string, id int) int
-		UnarchiveMemo           func(childComplexity int, workspaceID string, caseID int, id string) int
-		UnassignCase            func(childComplexity int, workspaceID string, id int, userIDs []string) int
-		UpdateAction            func(childComplexity int, workspaceID string, input graphql1.UpdateActionInput) int
-		UpdateActionComment     func(childComplexity int, workspaceID string, input graphql1.UpdateActionCommentInput) int
-		UpdateCase              func(childComplexity int, workspaceID string, input graphql1.UpdateCaseInput) int
-		UpdateCaseAgentSettings func(childComplexity int, workspaceID string, input graphql1.UpdateCaseAgentSettingsInput) int
-		UpdateCaseStatus        func(childComplexity int, workspaceID string, input graphql1.UpdateCaseStatusInput) int
-		UpdateGitHubSource      func(childComplexity int, workspaceID string, input graphql1.UpdateGitHubSourceInput) int
-		UpdateKnowledge         func(childComplexity int, workspaceID string, input graphql1.UpdateKnowledgeInput) int
-		UpdateMemo              func(childComplexity int, workspaceID string, input graphql1.UpdateMemoInput) int
-		UpdateNotionDBSource    func(childComplexity int, workspaceID string, input graphql1.UpdateNotionDBSourceInput) int
-		UpdateNotionPageSource  func(childComplexity int, workspaceID string, input graphql1.UpdateNotionPageSourceInput) int
-		UpdateSlackSource       func(childComplexity int, workspaceID string, input graphql1.UpdateSlackSourceInput) int
-		UpdateSource            func(childComplexity int, workspaceID string, input graphql1.UpdateSourceInput) int
-		UpdateTag               func(childComplexity int, workspaceID string, id string, name *string) int
-		ValidateNotionDb        func(childComplexity int, workspaceID string, databaseID string) int
-		ValidateNotionPage      func(childComplexity int, workspaceID string, pageID string) int
+		AddActionStep            func(childComplexity int, workspaceID string, input graphql1.AddActionStepInput) int
+		ArchiveAction            func(childComplexity int, workspaceID string, id int) int
+		ArchiveMemo              func(childComplexity int, workspaceID string, caseID int, id string) int
+		AssignCase               func(childComplexity int, workspaceID string, id int, userIDs []string) int
+		BulkArchiveActions       func(childComplexity int, workspaceID string, ids []int) int
+		CloseCase                func(childComplexity int, workspaceID string, id int) int
+		CreateAPIToken           func(childComplexity int, input graphql1.CreateAPITokenInput) int
+		CreateAction             func(childComplexity int, workspaceID string, input graphql1.CreateActionInput) int
+		CreateActionComment      func(childComplexity int, workspaceID string, input graphql1.CreateActionCommentInput) int
+		CreateCase               func(childComplexity int, workspaceID string, input graphql1.CreateCaseInput) int
+		CreateCaseImport         func(childComplexity int, workspaceID string, input graphql1.CreateCaseImportInput) int
+		CreateDraft              func(childComplexity int, workspaceID string, input graphql1.CreateDraftInput) int
+		CreateGitHubSource       func(childComplexity int, workspaceID string, input graphql1.CreateGitHubSourceInput) int
+		CreateKnowledge          func(childComplexity int, workspaceID string, input graphql1.CreateKnowledgeInput) int
+		CreateMemo               func(childComplexity int, workspaceID string, input graphql1.CreateMemoInput) int
+		CreateNotionDBSource     func(childComplexity int, workspaceID string, input graphql1.CreateNotionDBSourceInput) int
+		CreateNotionPageSource   func(childComplexity int, workspaceID string, input graphql1.CreateNotionPageSourceInput) int
+		CreateSlackSource        func(childComplexity int, workspaceID string, input graphql1.CreateSlackSourceInput) int
+		CreateTag                func(childComplexity int, workspaceID string, name *string) int
+		DeleteActionComment      func(childComplexity int, workspaceID string, input graphql1.DeleteActionCommentInput) int
+		DeleteActionStep         func(childComplexity int, workspaceID string, input graphql1.DeleteActionStepInput) int
+		DeleteCase               func(childComplexity int, workspaceID string, id int) int
+		DeleteKnowledge          func(childComplexity int, workspaceID string, id string) int
+		DeleteSource             func(childComplexity int, workspaceID string, id string) int
+		DeleteTag                func(childComplexity int, workspaceID string, id string) int
+		DiscardDraft             func(childComplexity int, workspaceID string, id int) int
+		ExecuteCaseImport        func(childComplexity int, workspaceID string, id string) int
+		MergeCases               func(childComplexity int, workspaceID string, targetID int, sourceIds []int) int
+		Noop                     func(childComplexity int) int
+		PostActionSlackMessage   func(childComplexity int, workspaceID string, id int) int
+		RenameActionStep         func(childComplexity int, workspaceID string, input graphql1.RenameActionStepInput) int
+		ReopenCase               func(childComplexity int, workspaceID string, id int) int
+		RestoreKnowledgeRevision func(childComplexity int, workspaceID string, id string, revision int) int
+		RetryWebhookDelivery     func(childComplexity int, workspaceID string, id string) int
+		RevokeAPIToken           func(childComplexity int, id string) int
+		SetActionStepDone        func(childComplexity int, workspaceID string, input graphql1.SetActionStepDoneInput) int
+		SetFavoriteWorkspaces    func(childComplexity int, workspaceIds []string) int
+		SubmitDraft              func(childComplexity int, workspaceID string, id int, input *graphql1.SubmitDraftInput) int
+		SyncCaseChannelUsers     func(childComplexity int, workspaceID string, id int) int
+		TriggerCaseJob           func(childComplexity int, workspaceID string, caseID int, jobID string) int
+		UnarchiveAction          func(childComplexity int, workspaceID string, id int) int
+		UnarchiveMemo            func(childComplexity int, workspaceID string, caseID int, id string) int
+		UnassignCase             func(childComplexity int, workspaceID string, id int, userIDs []string) int
+		UpdateAction             func(childComplexity int, workspaceID string, input graphql1.UpdateActionInput) int
+		UpdateActionComment      func(childComplexity int, workspaceID string, input graphql1.UpdateActionCommentInput) int
+		UpdateCase               func(childComplexity int, workspaceID string, input graphql1.UpdateCaseInput) int
+		UpdateCaseAgentSettings  func(childComplexity int, workspaceID string, input graphql1.UpdateCaseAgentSettingsInput) int
+		UpdateCaseStatus         func(childComplexity int, workspaceID string, input graphql1.UpdateCaseStatusInput) int
+		UpdateGitHubSource       func(childComplexity int, workspaceID string, input graphql1.UpdateGitHubSourceInput) int
+		UpdateKnowledge          func(childComplexity int, workspaceID string, input graphql1.UpdateKnowledgeInput) int
+		UpdateMemo               func(childComplexity int, workspaceID string, input graphql1.UpdateMemoInput) int
+		UpdateNotionDBSource     func(childComplexity int, workspaceID string, input graphql1.UpdateNotionDBSourceInput) int
+		UpdateNotionPageSource   func(childComplexity int, workspaceID string, input graphql1.UpdateNotionPageSourceInput) int
+		UpdateSlackSource        func(childComplexity int, workspaceID string, input graphql1.UpdateSlackSourceInput) int
+		UpdateSource             func(childComplexity int, workspaceID string, input graphql1.UpdateSourceInput) int
+		UpdateTag                func(childComplexity int, workspaceID string, id string, name *string) int
+		ValidateNotionDb         func(childComplexity int, workspaceID string, databaseID string) int
+		ValidateNotionPage       func(childComplexity int, workspaceID string, pageID string) int
 	}
 
 	MyDueAction struct {
@@ -507,46 +612,78 @@ type ComplexityRoot struct {
 		Valid        func(childComplexity int) int
 	}
 
+	OpenSLABreach struct {
+		Breach        func(childComplexity int) int
+		CaseID        func(childComplexity int) int
+		CaseTitle     func(childComplexity int) int
+		WorkspaceID   func(childComplexity int) int
+		WorkspaceName func(childComplexity int) int
+	}
+
 	Query struct {
-		Action               func(childComplexity int, workspaceID string, id int) int
-		Actions              func(childComplexity int, workspaceID string, filter *graphql1.ActionArchiveFilter) int
-		ActionsByCase        func(childComplexity int, workspaceID string, caseID int, filter *graphql1.ActionArchiveFilter) int
-		AssistLogs           func(childComplexity int, workspaceID string, caseID int, limit *int, offset *int) int
-		Case                 func(childComplexity int, workspaceID string, id int) int
-		CaseImport           func(childComplexity int, workspaceID string, id string) int
-		CaseJobRunLogs       func(childComplexity int, workspaceID string, caseID int, first *int, after *string) int
-		CaseJobs             func(childComplexity int, workspaceID string, caseID int) int
-		CaseRefsByIds        func(childComplexity int, workspaceID string, ids []int) int
-		CaseStatusConfig     func(childComplexity int, workspaceID string) int
-		Cases                func(childComplexity int, workspaceID string, status *types.CaseStatus) int
-		Drafts               func(childComplexity int, workspaceID string) int
-		FavoriteWorkspaceIds func(childComplexity int) int
-		FieldConfiguration   func(childComplexity int, workspaceID string) int
-		FrequentAssigneeIDs  func(childComplexity int, workspaceID string) int
-		Health               func(childComplexity int) int
-		HomeMessage          func(childComplexity int, clientTime time.Time, lang string) int
-		JobRunEvents         func(childComplexity int, workspaceID string, caseID int, runID string) int
-		JobRunLog            func(childComplexity int, workspaceID string, caseID int, runID string) int
-		Knowledge            func(childComplexity int, workspaceID string, id string) int
-		Knowledges           func(childComplexity int, workspaceID string, tagIds []string) int
-		Memo                 func(childComplexity int, workspaceID string, caseID int, id string) int
-		MemoConfiguration    func(childComplexity int, workspaceID string) int
-		MemosByCase          func(childComplexity int, workspaceID string, caseID int, filter *graphql1.MemoArchiveFilter) int
-		MyDueActions         func(childComplexity int) int
-		MyOpenCases          func(childComplexity int) int
-		OpenCaseActions      func(childComplexity int, workspaceID string) int
-		ReferenceableCases   func(childComplexity int, workspaceID string, query *string, limit *int) int
-		SearchKnowledge      func(childComplexity int, workspaceID string, query string, tagIds []string, limit *int) int
-		SlackJoinedChannels  func(childComplexity int) int
-		SlackUsers           func(childComplexity int) int
-		Source               func(childComplexity int, workspaceID string, id string) int
-		Sources              func(childComplexity int, workspaceID string) int
-		Tag                  func(childComplexity int, workspaceID string, id string) int
-		Tags                 func(childComplexity int, workspaceID string) int
-		ValidateGitHubRepo   func(childComplexity int, workspaceID string, repository string) int
-		Workspace            func(childComplexity int, workspaceID string) int
-		WorkspaceGroups      func(childComplexity int) int
-		Workspaces           func(childComplexity int) int
+		APITokens             func(childComplexity int) int
+		Action                func(childComplexity int, workspaceID string, id int) int
+		Actions               func(childComplexity int, workspaceID string, filter *graphql1.ActionArchiveFilter) int
+		ActionsByCase         func(childComplexity int, workspaceID string, caseID int, filter *graphql1.ActionArchiveFilter) int
+		AssistLogs            func(childComplexity int, workspaceID string, caseID int, limit *int, offset *int) int
+		Case                  func(childComplexity int, workspaceID string, id int) int
+		CaseImport            func(childComplexity int, workspaceID string, id string) int
+		CaseJobRunLogs        func(childComplexity int, workspaceID string, caseID int, first *int, after *string) int
+		CaseJobs              func(childComplexity int, workspaceID string, caseID int) int
+		CaseRefsByIds         func(childComplexity int, workspaceID string, ids []int) int
+		CaseStatusConfig      func(childComplexity int, workspaceID string) int
+		Cases                 func(childComplexity int, workspaceID string, status *types.CaseStatus) int
+		Drafts                func(childComplexity int, workspaceID string) int
+		FavoriteWorkspaceIds  func(childComplexity int) int
+		FieldConfiguration    func(childComplexity int, workspaceID string) int
+		FrequentAssigneeIDs   func(childComplexity int, workspaceID string) int
+		Health                func(childComplexity int) int
+		HomeMessage           func(childComplexity int, clientTime time.Time, lang string) int
+		JobRunEvents          func(childComplexity int, workspaceID string, caseID int, runID string) int
+		JobRunLog             func(childComplexity int, workspaceID string, caseID int, runID string) int
+		Knowledge             func(childComplexity int, workspaceID string, id string) int
+		KnowledgeDueForReview func(childComplexity int, workspaceID string) int
+		KnowledgeRevisionDiff func(childComplexity int, workspaceID string, id string, from int, to int) int
+		KnowledgeRevisions    func(childComplexity int, workspaceID string, id string) int
+		Knowledges            func(childComplexity int, workspaceID string, tagIds []string) int
+		Memo                  func(childComplexity int, workspaceID string, caseID int, id string) int
+		MemoConfiguration     func(childComplexity int, workspaceID string) int
+		MemosByCase           func(childComplexity int, workspaceID string, caseID int, filter *graphql1.MemoArchiveFilter) int
+		MyDueActions          func(childComplexity int) int
+		MyOpenCases           func(childComplexity int) int
+		OpenCaseActions       func(childComplexity int, workspaceID string) int
+		OpenSLABreaches       func(childComplexity int) int
+		ReferenceableCases    func(childComplexity int, workspaceID string, query *string, limit *int) int
+		SearchCases           func(childComplexity int, workspaceID string, filter *graphql1.CaseSearchFilter, sort *graphql1.CaseSortInput, first *int, after *string) int
+		SearchKnowledge       func(childComplexity int, workspaceID string, query string, tagIds []string, limit *int, includeExpired *bool) int
+		SlackJoinedChannels   func(childComplexity int) int
+		SlackUsers            func(childComplexity int) int
+		Source                func(childComplexity int, workspaceID string, id string) int
+		Sources               func(childComplexity int, workspaceID string) int
+		Tag                   func(childComplexity int, workspaceID string, id string) int
+		Tags                  func(childComplexity int, workspaceID string) int
+		ValidateGitHubRepo    func(childComplexity int, workspaceID string, repository string) int
+		WebhookDeliveries     func(childComplexity int, workspaceID string, status *graphql1.WebhookDeliveryStatus, first *int) int
+		Workspace             func(childComplexity int, workspaceID string) int
+		WorkspaceGroups       func(childComplexity int) int
+		Workspaces            func(childComplexity int) int
+	}
+
+	SLABreach struct {
+		DueAt         func(childComplexity int) int
+		Kind          func(childComplexity int) int
+		RecordedAt    func(childComplexity int) int
+		TargetSeconds func(childComplexity int) int
+	}
+
+	SLAClock struct {
+		Breached       func(childComplexity int) int
+		DueAt          func(childComplexity int) int
+		ElapsedSeconds func(childComplexity int) int
+		Kind           func(childComplexity int) int
+		Paused         func(childComplexity int) int
+		StoppedAt      func(childComplexity int) int
+		TargetSeconds  func(childComplexity int) int
 	}
 
 	SlackChannel struct {
@@ -609,6 +746,12 @@ type ComplexityRoot struct {
 		UpdatedAt   func(childComplexity int) int
 	}
 
+	Subscription struct {
+		ActionChanged    func(childComplexity int, workspaceID string, caseID int) int
+		CaseChanged      func(childComplexity int, workspaceID string) int
+		JobRunEventAdded func(childComplexity int, workspaceID string, caseID int, runID string, afterSequence *int) int
+	}
+
 	Tag struct {
 		CreatedAt func(childComplexity int) int
 		ID        func(childComplexity int) int
@@ -616,6 +759,22 @@ type ComplexityRoot struct {
 		UpdatedAt func(childComplexity int) int
 	}
 
+	WebhookDelivery struct {
+		Attempts       func(childComplexity int) int
+		CaseID         func(childComplexity int) int
+		CreatedAt      func(childComplexity int) int
+		DeliveredAt    func(childComplexity int) int
+		Event          func(childComplexity int) int
+		ID             func(childComplexity int) int
+		LastAttemptAt  func(childComplexity int) int
+		LastError      func(childComplexity int) int
+		LastStatusCode func(childComplexity int) int
+		NextAttemptAt  func(childComplexity int) int
+		Payload        func(childComplexity int) int
+		Status         func(childComplexity int) int
+		WebhookID      func(childComplexity int) int
+	}
+
 	Workspace struct {
 		ID   func(childComplexity int) int
 		Name func(childComplexity int) int
@@ -643,6 +802,10 @@ type ActionResolver interface {
 	Comments(ctx context.Context, obj *graphql1.Action, limit *int, cursor *string) (*graphql1.ActionCommentConnection, error)
 	Steps(ctx context.Context, obj *graphql1.Action) ([]*graphql1.ActionStep, error)
 	StepProgress(ctx context.Context, obj *graphql1.Action) (*graphql1.ActionStepProgress, error)
+
+	BlockedBy(ctx context.Context, obj *graphql1.Action) ([]*graphql1.Action, error)
+	Blocking(ctx context.Context, obj *graphql1.Action) ([]*graphql1.Action, error)
+	Blocked(ctx context.Context, obj *graphql1.Action) (bool, error)
 }
 type ActionCommentResolver interface {
 	Author(ctx context.Context, obj *graphql1.ActionComment) (*graphql1.SlackUser, error)
@@ -661,12 +824,19 @@ type CaseResolver interface {
 	SlackChannelName(ctx context.Context, obj *graphql1.Case) (*string, error)
 	SlackChannelURL(ctx context.Context, obj *graphql1.Case) (*string, error)
 
+	DuplicateCandidates(ctx context.Context, obj *graphql1.Case) ([]*graphql1.DuplicateCandidate, error)
 	Fields(ctx context.Context, obj *graphql1.Case) ([]*graphql1.FieldValue, error)
 	Actions(ctx context.Context, obj *graphql1.Case, filter *graphql1.ActionArchiveFilter) ([]*graphql1.Action, error)
 	SlackMessages(ctx context.Context, obj *graphql1.Case, limit *int, cursor *string) (*graphql1.SlackMessageConnection, error)
+	Alerts(ctx context.Context, obj *graphql1.Case) ([]*graphql1.Alert, error)
+	SLAClocks(ctx context.Context, obj *graphql1.Case) ([]*graphql1.SLAClock, error)
+	SLABreaches(ctx context.Context, obj *graphql1.Case) ([]*graphql1.SLABreach, error)
 
 	AgentSources(ctx context.Context, obj *graphql1.Case) ([]*graphql1.Source, error)
 }
+type KnowledgeRevisionResolver interface {
+	Editor(ctx context.Context, obj *graphql1.KnowledgeRevision) (*graphql1.SlackUser, error)
+}
 type MemoResolver interface {
 	Case(ctx context.Context, obj *graphql1.Memo) (*graphql1.Case, error)
 
@@ -681,6 +851,7 @@ type MutationResolver interface {
 	DeleteCase(ctx context.Context, workspaceID string, id int) (bool, error)
 	CloseCase(ctx context.Context, workspaceID string, id int) (*graphql1.Case, error)
 	ReopenCase(ctx context.Context, workspaceID string, id int) (*graphql1.Case, error)
+	MergeCases(ctx context.Context, workspaceID string, targetID int, sourceIds []int) (*graphql1.Case, error)
 	UpdateCaseStatus(ctx context.Context, workspaceID string, input graphql1.UpdateCaseStatusInput) (*graphql1.Case, error)
 	SyncCaseChannelUsers(ctx context.Context, workspaceID string, id int) (*graphql1.Case, error)
 	CreateDraft(ctx context.Context, workspaceID string, input graphql1.CreateDraftInput) (*graphql1.Case, error)
@@ -722,9 +893,13 @@ type MutationResolver interface {
 	CreateKnowledge(ctx context.Context, workspaceID string, input graphql1.CreateKnowledgeInput) (*graphql1.Knowledge, error)
 	UpdateKnowledge(ctx context.Context, workspaceID string, input graphql1.UpdateKnowledgeInput) (*graphql1.Knowledge, error)
 	DeleteKnowledge(ctx context.Context, workspaceID string, id string) (bool, error)
+	RestoreKnowledgeRevision(ctx context.Context, workspaceID string, id string, revision int) (*graphql1.Knowledge, error)
 	CreateTag(ctx context.Context, workspaceID string, name *string) (*graphql1.Tag, error)
 	UpdateTag(ctx context.Context, workspaceID string, id string, name *string) (*graphql1.Tag, error)
 	DeleteTag(ctx context.Context, workspaceID string, id string) (bool, error)
+	CreateAPIToken(ctx context.Context, input graphql1.CreateAPITokenInput) (*graphql1.CreatedAPIToken, error)
+	RevokeAPIToken(ctx context.Context, id string) (bool, error)
+	RetryWebhookDelivery(ctx context.Context, workspaceID string, id string) (*graphql1.WebhookDelivery, error)
 	SetFavoriteWorkspaces(ctx context.Context, workspaceIds []string) ([]string, error)
 }
 type QueryResolver interface {
@@ -734,6 +909,7 @@ type QueryResolver interface {
 	WorkspaceGroups(ctx context.Context) ([]*graphql1.WorkspaceGroup, error)
 	Cases(ctx context.Context, workspaceID string, status *types.CaseStatus) ([]*graphql1.Case, error)
 	Case(ctx context.Context, workspaceID string, id int) (*graphql1.Case, error)
+	SearchCases(ctx context.Context, workspaceID string, filter *graphql1.CaseSearchFilter, sort *graphql1.CaseSortInput, first *int, after *string) (*graphql1.CaseConnection, error)
 	Drafts(ctx context.Context, workspaceID string) ([]*graphql1.Case, error)
 	ReferenceableCases(ctx context.Context, workspaceID string, query *string, limit *int) ([]*graphql1.CaseRef, error)
 	CaseRefsByIds(ctx context.Context, workspaceID string, ids []int) ([]*graphql1.CaseRef, error)
@@ -746,6 +922,8 @@ type QueryResolver interface {
 	FrequentAssigneeIDs(ctx context.Context, workspaceID string) ([]string, error)
 	SlackUsers(ctx context.Context) ([]*graphql1.SlackUser, error)
 	SlackJoinedChannels(ctx context.Context) ([]*graphql1.SlackChannelInfo, error)
+	APITokens(ctx context.Context) ([]*graphql1.APIToken, error)
+	WebhookDeliveries(ctx context.Context, workspaceID string, status *graphql1.WebhookDeliveryStatus, first *int) ([]*graphql1.WebhookDelivery, error)
 	Sources(ctx context.Context, workspaceID string) ([]*graphql1.Source, error)
 	Source(ctx context.Context, workspaceID string, id string) (*graphql1.Source, error)
 	ValidateGitHubRepo(ctx context.Context, workspaceID string, repository string) (*graphql1.GitHubRepoValidationResult, error)
@@ -760,14 +938,23 @@ type QueryResolver interface {
 	MemoConfiguration(ctx context.Context, workspaceID string) (*graphql1.MemoConfiguration, error)
 	Knowledges(ctx context.Context, workspaceID string, tagIds []string) ([]*graphql1.Knowledge, error)
 	Knowledge(ctx context.Context, workspaceID string, id string) (*graphql1.Knowledge, error)
-	SearchKnowledge(ctx context.Context, workspaceID string, query string, tagIds []string, limit *int) ([]*graphql1.Knowledge, error)
+	KnowledgeRevisions(ctx context.Context, workspaceID string, id string) ([]*graphql1.KnowledgeRevision, error)
+	KnowledgeRevisionDiff(ctx context.Context, workspaceID string, id string, from int, to int) (*graphql1.KnowledgeRevisionDiff, error)
+	KnowledgeDueForReview(ctx context.Context, workspaceID string) ([]*graphql1.Knowledge, error)
+	SearchKnowledge(ctx context.Context, workspaceID string, query string, tagIds []string, limit *int, includeExpired *bool) ([]*graphql1.KnowledgeSearchHit, error)
 	Tags(ctx context.Context, workspaceID string) ([]*graphql1.Tag, error)
 	Tag(ctx context.Context, workspaceID string, id string) (*graphql1.Tag, error)
 	MyOpenCases(ctx context.Context) ([]*graphql1.MyOpenCase, error)
 	MyDueActions(ctx context.Context) ([]*graphql1.MyDueAction, error)
+	OpenSLABreaches(ctx context.Context) ([]*graphql1.OpenSLABreach, error)
 	FavoriteWorkspaceIds(ctx context.Context) ([]string, error)
 	HomeMessage(ctx context.Context, clientTime time.Time, lang string) (*graphql1.HomeMessage, error)
 }
+type SubscriptionResolver interface {
+	CaseChanged(ctx context.Context, workspaceID string) (<-chan *graphql1.CaseChangeEvent, error)
+	ActionChanged(ctx context.Context, workspaceID string, caseID int) (<-chan *graphql1.ActionChangeEvent, error)
+	JobRunEventAdded(ctx context.Context, workspaceID string, caseID int, runID string, afterSequence *int) (<-chan *graphql1.JobRunEvent, error)
+}
 
 // endregion ************************** generated!.gotpl **************************
 
@@ -787,6 +974,55 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 	_ = ec
 	switch typeName + "." + field {
 
+	case "APIToken.createdAt":
+		if e.ComplexityRoot.APIToken.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.APIToken.CreatedAt(childComplexity), true
+	case "APIToken.expired":
+		if e.ComplexityRoot.APIToken.Expired == nil {
+			break
+		}
+
+		return e.ComplexityRoot.APIToken.Expired(childComplexity), true
+	case "APIToken.expiresAt":
+		if e.ComplexityRoot.APIToken.ExpiresAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.APIToken.ExpiresAt(childComplexity), true
+	case "APIToken.id":
+		if e.ComplexityRoot.APIToken.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.APIToken.ID(childComplexity), true
+	case "APIToken.lastUsedAt":
+		if e.ComplexityRoot.APIToken.LastUsedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.APIToken.LastUsedAt(childComplexity), true
+	case "APIToken.name":
+		if e.ComplexityRoot.APIToken.Name == nil {
+			break
+		}
+
+		return e.ComplexityRoot.APIToken.Name(childComplexity), true
+	case "APIToken.readOnly":
+		if e.ComplexityRoot.APIToken.ReadOnly == nil {
+			break
+		}
+
+		return e.ComplexityRoot.APIToken.ReadOnly(childComplexity), true
+	case "APIToken.workspaceIds":
+		if e.ComplexityRoot.APIToken.WorkspaceIds == nil {
+			break
+		}
+
+		return e.ComplexityRoot.APIToken.WorkspaceIds(childComplexity), true
+
 	case "Action.archived":
 		if e.ComplexityRoot.Action.Archived == nil {
 			break
@@ -811,6 +1047,30 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.Action.AssigneeID(childComplexity), true
+	case "Action.blocked":
+		if e.ComplexityRoot.Action.Blocked == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Action.Blocked(childComplexity), true
+	case "Action.blockedBy":
+		if e.ComplexityRoot.Action.BlockedBy == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Action.BlockedBy(childComplexity), true
+	case "Action.blockedByIDs":
+		if e.ComplexityRoot.Action.BlockedByIDs == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Action.BlockedByIDs(childComplexity), true
+	case "Action.blocking":
+		if e.ComplexityRoot.Action.Blocking == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Action.Blocking(childComplexity), true
 	case "Action.case":
 		if e.ComplexityRoot.Action.Case == nil {
 			break
@@ -923,6 +1183,25 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Action.WorkspaceID(childComplexity), true
 
+	case "ActionChangeEvent.action":
+		if e.ComplexityRoot.ActionChangeEvent.Action == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ActionChangeEvent.Action(childComplexity), true
+	case "ActionChangeEvent.actionId":
+		if e.ComplexityRoot.ActionChangeEvent.ActionID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ActionChangeEvent.ActionID(childComplexity), true
+	case "ActionChangeEvent.kind":
+		if e.ComplexityRoot.ActionChangeEvent.Kind == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ActionChangeEvent.Kind(childComplexity), true
+
 	case "ActionComment.actionID":
 		if e.ComplexityRoot.ActionComment.ActionID == nil {
 			break
@@ -1177,6 +1456,43 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.ActionStepProgress.Total(childComplexity), true
 
+	case "Alert.fingerprint":
+		if e.ComplexityRoot.Alert.Fingerprint == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Alert.Fingerprint(childComplexity), true
+	case "Alert.id":
+		if e.ComplexityRoot.Alert.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Alert.ID(childComplexity), true
+	case "Alert.payload":
+		if e.ComplexityRoot.Alert.Payload == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Alert.Payload(childComplexity), true
+	case "Alert.receivedAt":
+		if e.ComplexityRoot.Alert.ReceivedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Alert.ReceivedAt(childComplexity), true
+	case "Alert.repeat":
+		if e.ComplexityRoot.Alert.Repeat == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Alert.Repeat(childComplexity), true
+	case "Alert.title":
+		if e.ComplexityRoot.Alert.Title == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Alert.Title(childComplexity), true
+
 	case "AssistLog.actions":
 		if e.ComplexityRoot.AssistLog.Actions == nil {
 			break
@@ -1268,6 +1584,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.Case.AgentSources(childComplexity), true
+	case "Case.alerts":
+		if e.ComplexityRoot.Case.Alerts == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Case.Alerts(childComplexity), true
 	case "Case.assigneeIDs":
 		if e.ComplexityRoot.Case.AssigneeIDs == nil {
 			break
@@ -1315,6 +1637,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.Case.Description(childComplexity), true
+	case "Case.duplicateCandidates":
+		if e.ComplexityRoot.Case.DuplicateCandidates == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Case.DuplicateCandidates(childComplexity), true
 	case "Case.fields":
 		if e.ComplexityRoot.Case.Fields == nil {
 			break
@@ -1345,6 +1673,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.Case.IsThreadBound(childComplexity), true
+	case "Case.mergedIntoID":
+		if e.ComplexityRoot.Case.MergedIntoID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Case.MergedIntoID(childComplexity), true
 	case "Case.reporter":
 		if e.ComplexityRoot.Case.Reporter == nil {
 			break
@@ -1357,6 +1691,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.Case.ReporterID(childComplexity), true
+	case "Case.slaBreaches":
+		if e.ComplexityRoot.Case.SLABreaches == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Case.SLABreaches(childComplexity), true
+	case "Case.slaClocks":
+		if e.ComplexityRoot.Case.SLAClocks == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Case.SLAClocks(childComplexity), true
 	case "Case.slackChannelID":
 		if e.ComplexityRoot.Case.SlackChannelID == nil {
 			break
@@ -1417,6 +1763,38 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Case.WorkspaceID(childComplexity), true
 
+	case "CaseChangeEvent.case":
+		if e.ComplexityRoot.CaseChangeEvent.Case == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CaseChangeEvent.Case(childComplexity), true
+	case "CaseChangeEvent.caseId":
+		if e.ComplexityRoot.CaseChangeEvent.CaseID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CaseChangeEvent.CaseID(childComplexity), true
+	case "CaseChangeEvent.kind":
+		if e.ComplexityRoot.CaseChangeEvent.Kind == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CaseChangeEvent.Kind(childComplexity), true
+
+	case "CaseConnection.items":
+		if e.ComplexityRoot.CaseConnection.Items == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CaseConnection.Items(childComplexity), true
+	case "CaseConnection.nextCursor":
+		if e.ComplexityRoot.CaseConnection.NextCursor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CaseConnection.NextCursor(childComplexity), true
+
 	case "CaseJob.description":
 		if e.ComplexityRoot.CaseJob.Description == nil {
 			break
@@ -1510,6 +1888,45 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.ChannelUserConnection.TotalCount(childComplexity), true
 
+	case "CreatedAPIToken.secret":
+		if e.ComplexityRoot.CreatedAPIToken.Secret == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CreatedAPIToken.Secret(childComplexity), true
+	case "CreatedAPIToken.token":
+		if e.ComplexityRoot.CreatedAPIToken.Token == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CreatedAPIToken.Token(childComplexity), true
+
+	case "DiffLine.op":
+		if e.ComplexityRoot.DiffLine.Op == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DiffLine.Op(childComplexity), true
+	case "DiffLine.text":
+		if e.ComplexityRoot.DiffLine.Text == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DiffLine.Text(childComplexity), true
+
+	case "DuplicateCandidate.case":
+		if e.ComplexityRoot.DuplicateCandidate.Case == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DuplicateCandidate.Case(childComplexity), true
+	case "DuplicateCandidate.score":
+		if e.ComplexityRoot.DuplicateCandidate.Score == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DuplicateCandidate.Score(childComplexity), true
+
 	case "EntityLabels.case":
 		if e.ComplexityRoot.EntityLabels.Case == nil {
 			break
@@ -2204,6 +2621,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.JobTrigger.CaseEvents(childComplexity), true
+	case "JobTrigger.caseField":
+		if e.ComplexityRoot.JobTrigger.CaseField == nil {
+			break
+		}
+
+		return e.ComplexityRoot.JobTrigger.CaseField(childComplexity), true
+	case "JobTrigger.caseTo":
+		if e.ComplexityRoot.JobTrigger.CaseTo == nil {
+			break
+		}
+
+		return e.ComplexityRoot.JobTrigger.CaseTo(childComplexity), true
 	case "JobTrigger.schedule":
 		if e.ComplexityRoot.JobTrigger.Schedule == nil {
 			break
@@ -2223,12 +2652,36 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.Knowledge.CreatedAt(childComplexity), true
+	case "Knowledge.expired":
+		if e.ComplexityRoot.Knowledge.Expired == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Knowledge.Expired(childComplexity), true
 	case "Knowledge.id":
 		if e.ComplexityRoot.Knowledge.ID == nil {
 			break
 		}
 
 		return e.ComplexityRoot.Knowledge.ID(childComplexity), true
+	case "Knowledge.reviewBy":
+		if e.ComplexityRoot.Knowledge.ReviewBy == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Knowledge.ReviewBy(childComplexity), true
+	case "Knowledge.reviewDue":
+		if e.ComplexityRoot.Knowledge.ReviewDue == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Knowledge.ReviewDue(childComplexity), true
+	case "Knowledge.reviewFlaggedAt":
+		if e.ComplexityRoot.Knowledge.ReviewFlaggedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Knowledge.ReviewFlaggedAt(childComplexity), true
 	case "Knowledge.tags":
 		if e.ComplexityRoot.Knowledge.Tags == nil {
 			break
@@ -2247,6 +2700,141 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.Knowledge.UpdatedAt(childComplexity), true
+	case "Knowledge.validUntil":
+		if e.ComplexityRoot.Knowledge.ValidUntil == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Knowledge.ValidUntil(childComplexity), true
+
+	case "KnowledgeRevision.action":
+		if e.ComplexityRoot.KnowledgeRevision.Action == nil {
+			break
+		}
+
+		return e.ComplexityRoot.KnowledgeRevision.Action(childComplexity), true
+	case "KnowledgeRevision.claim":
+		if e.ComplexityRoot.KnowledgeRevision.Claim == nil {
+			break
+		}
+
+		return e.ComplexityRoot.KnowledgeRevision.Claim(childComplexity), true
+	case "KnowledgeRevision.createdAt":
+		if e.ComplexityRoot.KnowledgeRevision.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.KnowledgeRevision.CreatedAt(childComplexity), true
+	case "KnowledgeRevision.editor":
+		if e.ComplexityRoot.KnowledgeRevision.Editor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.KnowledgeRevision.Editor(childComplexity), true
+	case "KnowledgeRevision.editorID":
+		if e.ComplexityRoot.KnowledgeRevision.EditorID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.KnowledgeRevision.EditorID(childComplexity), true
+	case "KnowledgeRevision.jobID":
+		if e.ComplexityRoot.KnowledgeRevision.JobID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.KnowledgeRevision.JobID(childComplexity), true
+	case "KnowledgeRevision.restoredFrom":
+		if e.ComplexityRoot.KnowledgeRevision.RestoredFrom == nil {
+			break
+		}
+
+		return e.ComplexityRoot.KnowledgeRevision.RestoredFrom(childComplexity), true
+	case "KnowledgeRevision.revision":
+		if e.ComplexityRoot.KnowledgeRevision.Revision == nil {
+			break
+		}
+
+		return e.ComplexityRoot.KnowledgeRevision.Revision(childComplexity), true
+	case "KnowledgeRevision.runID":
+		if e.ComplexityRoot.KnowledgeRevision.RunID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.KnowledgeRevision.RunID(childComplexity), true
+	case "KnowledgeRevision.tags":
+		if e.ComplexityRoot.KnowledgeRevision.Tags == nil {
+			break
+		}
+
+		return e.ComplexityRoot.KnowledgeRevision.Tags(childComplexity), true
+	case "KnowledgeRevision.title":
+		if e.ComplexityRoot.KnowledgeRevision.Title == nil {
+			break
+		}
+
+		return e.ComplexityRoot.KnowledgeRevision.Title(childComplexity), true
+
+	case "KnowledgeRevisionDiff.addedTags":
+		if e.ComplexityRoot.KnowledgeRevisionDiff.AddedTags == nil {
+			break
+		}
+
+		return e.ComplexityRoot.KnowledgeRevisionDiff.AddedTags(childComplexity), true
+	case "KnowledgeRevisionDiff.claim":
+		if e.ComplexityRoot.KnowledgeRevisionDiff.Claim == nil {
+			break
+		}
+
+		return e.ComplexityRoot.KnowledgeRevisionDiff.Claim(childComplexity), true
+	case "KnowledgeRevisionDiff.from":
+		if e.ComplexityRoot.KnowledgeRevisionDiff.From == nil {
+			break
+		}
+
+		return e.ComplexityRoot.KnowledgeRevisionDiff.From(childComplexity), true
+	case "KnowledgeRevisionDiff.removedTags":
+		if e.ComplexityRoot.KnowledgeRevisionDiff.RemovedTags == nil {
+			break
+		}
+
+		return e.ComplexityRoot.KnowledgeRevisionDiff.RemovedTags(childComplexity), true
+	case "KnowledgeRevisionDiff.title":
+		if e.ComplexityRoot.KnowledgeRevisionDiff.Title == nil {
+			break
+		}
+
+		return e.ComplexityRoot.KnowledgeRevisionDiff.Title(childComplexity), true
+	case "KnowledgeRevisionDiff.to":
+		if e.ComplexityRoot.KnowledgeRevisionDiff.To == nil {
+			break
+		}
+
+		return e.ComplexityRoot.KnowledgeRevisionDiff.To(childComplexity), true
+
+	case "KnowledgeSearchHit.knowledge":
+		if e.ComplexityRoot.KnowledgeSearchHit.Knowledge == nil {
+			break
+		}
+
+		return e.ComplexityRoot.KnowledgeSearchHit.Knowledge(childComplexity), true
+	case "KnowledgeSearchHit.lexicalScore":
+		if e.ComplexityRoot.KnowledgeSearchHit.LexicalScore == nil {
+			break
+		}
+
+		return e.ComplexityRoot.KnowledgeSearchHit.LexicalScore(childComplexity), true
+	case "KnowledgeSearchHit.score":
+		if e.ComplexityRoot.KnowledgeSearchHit.Score == nil {
+			break
+		}
+
+		return e.ComplexityRoot.KnowledgeSearchHit.Score(childComplexity), true
+	case "KnowledgeSearchHit.vectorScore":
+		if e.ComplexityRoot.KnowledgeSearchHit.VectorScore == nil {
+			break
+		}
+
+		return e.ComplexityRoot.KnowledgeSearchHit.VectorScore(childComplexity), true
 
 	case "Memo.archivedAt":
 		if e.ComplexityRoot.Memo.ArchivedAt == nil {
@@ -2376,6 +2964,17 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.Mutation.CloseCase(childComplexity, args["workspaceId"].(string), args["id"].(int)), true
+	case "Mutation.createAPIToken":
+		if e.ComplexityRoot.Mutation.CreateAPIToken == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createAPIToken_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.CreateAPIToken(childComplexity, args["input"].(graphql1.CreateAPITokenInput)), true
 	case "Mutation.createAction":
 		if e.ComplexityRoot.Mutation.CreateAction == nil {
 			break
@@ -2596,6 +3195,17 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.Mutation.ExecuteCaseImport(childComplexity, args["workspaceId"].(string), args["id"].(string)), true
+	case "Mutation.mergeCases":
+		if e.ComplexityRoot.Mutation.MergeCases == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_mergeCases_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.MergeCases(childComplexity, args["workspaceId"].(string), args["targetId"].(int), args["sourceIds"].([]int)), true
 	case "Mutation.noop":
 		if e.ComplexityRoot.Mutation.Noop == nil {
 			break
@@ -2635,6 +3245,39 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.Mutation.ReopenCase(childComplexity, args["workspaceId"].(string), args["id"].(int)), true
+	case "Mutation.restoreKnowledgeRevision":
+		if e.ComplexityRoot.Mutation.RestoreKnowledgeRevision == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_restoreKnowledgeRevision_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.RestoreKnowledgeRevision(childComplexity, args["workspaceId"].(string), args["id"].(string), args["revision"].(int)), true
+	case "Mutation.retryWebhookDelivery":
+		if e.ComplexityRoot.Mutation.RetryWebhookDelivery == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_retryWebhookDelivery_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.RetryWebhookDelivery(childComplexity, args["workspaceId"].(string), args["id"].(string)), true
+	case "Mutation.revokeAPIToken":
+		if e.ComplexityRoot.Mutation.RevokeAPIToken == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeAPIToken_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Mutation.RevokeAPIToken(childComplexity, args["id"].(string)), true
 	case "Mutation.setActionStepDone":
 		if e.ComplexityRoot.Mutation.SetActionStepDone == nil {
 			break
@@ -3045,6 +3688,43 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.NotionPageValidationResult.Valid(childComplexity), true
 
+	case "OpenSLABreach.breach":
+		if e.ComplexityRoot.OpenSLABreach.Breach == nil {
+			break
+		}
+
+		return e.ComplexityRoot.OpenSLABreach.Breach(childComplexity), true
+	case "OpenSLABreach.caseId":
+		if e.ComplexityRoot.OpenSLABreach.CaseID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.OpenSLABreach.CaseID(childComplexity), true
+	case "OpenSLABreach.caseTitle":
+		if e.ComplexityRoot.OpenSLABreach.CaseTitle == nil {
+			break
+		}
+
+		return e.ComplexityRoot.OpenSLABreach.CaseTitle(childComplexity), true
+	case "OpenSLABreach.workspaceId":
+		if e.ComplexityRoot.OpenSLABreach.WorkspaceID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.OpenSLABreach.WorkspaceID(childComplexity), true
+	case "OpenSLABreach.workspaceName":
+		if e.ComplexityRoot.OpenSLABreach.WorkspaceName == nil {
+			break
+		}
+
+		return e.ComplexityRoot.OpenSLABreach.WorkspaceName(childComplexity), true
+
+	case "Query.apiTokens":
+		if e.ComplexityRoot.Query.APITokens == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Query.APITokens(childComplexity), true
 	case "Query.action":
 		if e.ComplexityRoot.Query.Action == nil {
 			break
@@ -3256,6 +3936,39 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.Query.Knowledge(childComplexity, args["workspaceId"].(string), args["id"].(string)), true
+	case "Query.knowledgeDueForReview":
+		if e.ComplexityRoot.Query.KnowledgeDueForReview == nil {
+			break
+		}
+
+		args, err := ec.field_Query_knowledgeDueForReview_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.KnowledgeDueForReview(childComplexity, args["workspaceId"].(string)), true
+	case "Query.knowledgeRevisionDiff":
+		if e.ComplexityRoot.Query.KnowledgeRevisionDiff == nil {
+			break
+		}
+
+		args, err := ec.field_Query_knowledgeRevisionDiff_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.KnowledgeRevisionDiff(childComplexity, args["workspaceId"].(string), args["id"].(string), args["from"].(int), args["to"].(int)), true
+	case "Query.knowledgeRevisions":
+		if e.ComplexityRoot.Query.KnowledgeRevisions == nil {
+			break
+		}
+
+		args, err := ec.field_Query_knowledgeRevisions_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.KnowledgeRevisions(childComplexity, args["workspaceId"].(string), args["id"].(string)), true
 	case "Query.knowledges":
 		if e.ComplexityRoot.Query.Knowledges == nil {
 			break
@@ -3323,6 +4036,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.Query.OpenCaseActions(childComplexity, args["workspaceId"].(string)), true
+	case "Query.openSLABreaches":
+		if e.ComplexityRoot.Query.OpenSLABreaches == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Query.OpenSLABreaches(childComplexity), true
 	case "Query.referenceableCases":
 		if e.ComplexityRoot.Query.ReferenceableCases == nil {
 			break
@@ -3334,6 +4053,17 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.Query.ReferenceableCases(childComplexity, args["workspaceId"].(string), args["query"].(*string), args["limit"].(*int)), true
+	case "Query.searchCases":
+		if e.ComplexityRoot.Query.SearchCases == nil {
+			break
+		}
+
+		args, err := ec.field_Query_searchCases_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.SearchCases(childComplexity, args["workspaceId"].(string), args["filter"].(*graphql1.CaseSearchFilter), args["sort"].(*graphql1.CaseSortInput), args["first"].(*int), args["after"].(*string)), true
 	case "Query.searchKnowledge":
 		if e.ComplexityRoot.Query.SearchKnowledge == nil {
 			break
@@ -3344,7 +4074,7 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.ComplexityRoot.Query.SearchKnowledge(childComplexity, args["workspaceId"].(string), args["query"].(string), args["tagIds"].([]string), args["limit"].(*int)), true
+		return e.ComplexityRoot.Query.SearchKnowledge(childComplexity, args["workspaceId"].(string), args["query"].(string), args["tagIds"].([]string), args["limit"].(*int), args["includeExpired"].(*bool)), true
 	case "Query.slackJoinedChannels":
 		if e.ComplexityRoot.Query.SlackJoinedChannels == nil {
 			break
@@ -3412,6 +4142,17 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.Query.ValidateGitHubRepo(childComplexity, args["workspaceId"].(string), args["repository"].(string)), true
+	case "Query.webhookDeliveries":
+		if e.ComplexityRoot.Query.WebhookDeliveries == nil {
+			break
+		}
+
+		args, err := ec.field_Query_webhookDeliveries_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.WebhookDeliveries(childComplexity, args["workspaceId"].(string), args["status"].(*graphql1.WebhookDeliveryStatus), args["first"].(*int)), true
 	case "Query.workspace":
 		if e.ComplexityRoot.Query.Workspace == nil {
 			break
@@ -3436,6 +4177,74 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Query.Workspaces(childComplexity), true
 
+	case "SLABreach.dueAt":
+		if e.ComplexityRoot.SLABreach.DueAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SLABreach.DueAt(childComplexity), true
+	case "SLABreach.kind":
+		if e.ComplexityRoot.SLABreach.Kind == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SLABreach.Kind(childComplexity), true
+	case "SLABreach.recordedAt":
+		if e.ComplexityRoot.SLABreach.RecordedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SLABreach.RecordedAt(childComplexity), true
+	case "SLABreach.targetSeconds":
+		if e.ComplexityRoot.SLABreach.TargetSeconds == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SLABreach.TargetSeconds(childComplexity), true
+
+	case "SLAClock.breached":
+		if e.ComplexityRoot.SLAClock.Breached == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SLAClock.Breached(childComplexity), true
+	case "SLAClock.dueAt":
+		if e.ComplexityRoot.SLAClock.DueAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SLAClock.DueAt(childComplexity), true
+	case "SLAClock.elapsedSeconds":
+		if e.ComplexityRoot.SLAClock.ElapsedSeconds == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SLAClock.ElapsedSeconds(childComplexity), true
+	case "SLAClock.kind":
+		if e.ComplexityRoot.SLAClock.Kind == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SLAClock.Kind(childComplexity), true
+	case "SLAClock.paused":
+		if e.ComplexityRoot.SLAClock.Paused == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SLAClock.Paused(childComplexity), true
+	case "SLAClock.stoppedAt":
+		if e.ComplexityRoot.SLAClock.StoppedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SLAClock.StoppedAt(childComplexity), true
+	case "SLAClock.targetSeconds":
+		if e.ComplexityRoot.SLAClock.TargetSeconds == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SLAClock.TargetSeconds(childComplexity), true
+
 	case "SlackChannel.id":
 		if e.ComplexityRoot.SlackChannel.ID == nil {
 			break
@@ -3660,6 +4469,40 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Source.UpdatedAt(childComplexity), true
 
+	case "Subscription.actionChanged":
+		if e.ComplexityRoot.Subscription.ActionChanged == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_actionChanged_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Subscription.ActionChanged(childComplexity, args["workspaceId"].(string), args["caseId"].(int)), true
+	case "Subscription.caseChanged":
+		if e.ComplexityRoot.Subscription.CaseChanged == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_caseChanged_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Subscription.CaseChanged(childComplexity, args["workspaceId"].(string)), true
+	case "Subscription.jobRunEventAdded":
+		if e.ComplexityRoot.Subscription.JobRunEventAdded == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_jobRunEventAdded_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Subscription.JobRunEventAdded(childComplexity, args["workspaceId"].(string), args["caseId"].(int), args["runId"].(string), args["afterSequence"].(*int)), true
+
 	case "Tag.createdAt":
 		if e.ComplexityRoot.Tag.CreatedAt == nil {
 			break
@@ -3685,6 +4528,85 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Tag.UpdatedAt(childComplexity), true
 
+	case "WebhookDelivery.attempts":
+		if e.ComplexityRoot.WebhookDelivery.Attempts == nil {
+			break
+		}
+
+		return e.ComplexityRoot.WebhookDelivery.Attempts(childComplexity), true
+	case "WebhookDelivery.caseId":
+		if e.ComplexityRoot.WebhookDelivery.CaseID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.WebhookDelivery.CaseID(childComplexity), true
+	case "WebhookDelivery.createdAt":
+		if e.ComplexityRoot.WebhookDelivery.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.WebhookDelivery.CreatedAt(childComplexity), true
+	case "WebhookDelivery.deliveredAt":
+		if e.ComplexityRoot.WebhookDelivery.DeliveredAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.WebhookDelivery.DeliveredAt(childComplexity), true
+	case "WebhookDelivery.event":
+		if e.ComplexityRoot.WebhookDelivery.Event == nil {
+			break
+		}
+
+		return e.ComplexityRoot.WebhookDelivery.Event(childComplexity), true
+	case "WebhookDelivery.id":
+		if e.ComplexityRoot.WebhookDelivery.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.WebhookDelivery.ID(childComplexity), true
+	case "WebhookDelivery.lastAttemptAt":
+		if e.ComplexityRoot.WebhookDelivery.LastAttemptAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.WebhookDelivery.LastAttemptAt(childComplexity), true
+	case "WebhookDelivery.lastError":
+		if e.ComplexityRoot.WebhookDelivery.LastError == nil {
+			break
+		}
+
+		return e.ComplexityRoot.WebhookDelivery.LastError(childComplexity), true
+	case "WebhookDelivery.lastStatusCode":
+		if e.ComplexityRoot.WebhookDelivery.LastStatusCode == nil {
+			break
+		}
+
+		return e.ComplexityRoot.WebhookDelivery.LastStatusCode(childComplexity), true
+	case "WebhookDelivery.nextAttemptAt":
+		if e.ComplexityRoot.WebhookDelivery.NextAttemptAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.WebhookDelivery.NextAttemptAt(childComplexity), true
+	case "WebhookDelivery.payload":
+		if e.ComplexityRoot.WebhookDelivery.Payload == nil {
+			break
+		}
+
+		return e.ComplexityRoot.WebhookDelivery.Payload(childComplexity), true
+	case "WebhookDelivery.status":
+		if e.ComplexityRoot.WebhookDelivery.Status == nil {
+			break
+		}
+
+		return e.ComplexityRoot.WebhookDelivery.Status(childComplexity), true
+	case "WebhookDelivery.webhookId":
+		if e.ComplexityRoot.WebhookDelivery.WebhookID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.WebhookDelivery.WebhookID(childComplexity), true
+
 	case "Workspace.id":
 		if e.ComplexityRoot.Workspace.ID == nil {
 			break
@@ -3732,6 +4654,10 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	ec := newExecutionContext(opCtx, e, make(chan graphql.DeferredResult))
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
 		ec.unmarshalInputAddActionStepInput,
+		ec.unmarshalInputCaseFieldFilterInput,
+		ec.unmarshalInputCaseSearchFilter,
+		ec.unmarshalInputCaseSortInput,
+		ec.unmarshalInputCreateAPITokenInput,
 		ec.unmarshalInputCreateActionCommentInput,
 		ec.unmarshalInputCreateActionInput,
 		ec.unmarshalInputCreateCaseImportInput,
@@ -3806,6 +4732,23 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
 
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, opCtx.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
 			return &graphql.Response{
 				Data: buf.Bytes(),
 			}
@@ -3859,6 +4802,15 @@ type MyDueAction {
   caseTitle: String!
 }
 
+# A recorded SLA breach of an accessible open Case in an [sla] workspace.
+type OpenSLABreach {
+  workspaceId: String!
+  workspaceName: String!
+  caseId: Int!
+  caseTitle: String!
+  breach: SLABreach!
+}
+
 # The home greeting. message is empty when no greeting LLM is configured; the
 # frontend then shows a static fallback.
 type HomeMessage {
@@ -3868,6 +4820,7 @@ type HomeMessage {
 extend type Query {
   myOpenCases: [MyOpenCase!]!
   myDueActions: [MyDueAction!]!
+  openSLABreaches: [OpenSLABreach!]!
   favoriteWorkspaceIds: [String!]!
   homeMessage(clientTime: Time!, lang: String!): HomeMessage!
 }
@@ -4075,6 +5028,15 @@ type Case {
   # thread-mode cases; null for channel-mode cases. Validity is workspace-
   # scoped: resolve display from caseStatusConfig.
   boardStatus: String
+  # mergedIntoID is the case this one was merged into by mergeCases; null
+  # for a case that was not merged. A merged case is closed and its
+  # actions, memos, messages and job history live on that case.
+  mergedIntoID: Int
+  # duplicateCandidates is the open cases whose content is close to this
+  # one's, most similar first (at most three). Empty when no embedding
+  # client is configured, for cases created before one was, and when
+  # accessDenied.
+  duplicateCandidates: [DuplicateCandidate!]!
   fields: [FieldValue!]!       # Resolved from case_field_values via DataLoader
   # actions exposes the case's actions. The ` + "`" + `filter` + "`" + ` argument selects which
   # archive slice to return (ACTIVE / ARCHIVED / ALL); the default ACTIVE
@@ -4082,6 +5044,17 @@ type Case {
   # through its own dataloader so callers can mix filters within a request.
   actions(filter: ActionArchiveFilter = ACTIVE): [Action!]!
   slackMessages(limit: Int, cursor: String): SlackMessageConnection!
+  # alerts is the case's alert timeline, oldest first: the alert posted to the
+  # ingest endpoint that opened it, then each repeat folded into it. Empty for
+  # a case that was not opened by an alert, and when accessDenied.
+  alerts: [Alert!]!
+  # slaClocks is the case's response and resolution clocks under the
+  # workspace's [sla] rules, computed at query time. Empty when no rule
+  # matches the case, for drafts, and when accessDenied.
+  slaClocks: [SLAClock!]!
+  # slaBreaches is the targets the case missed, as recorded by the tick
+  # sweep. Empty when accessDenied.
+  slaBreaches: [SLABreach!]!
   # Case-specific Markdown snippet appended to the Job system prompt
   # at agent execution time. Empty string when unset.
   agentAdditionalPrompt: String!
@@ -4093,6 +5066,97 @@ type Case {
   updatedAt: Time!
 }
 
+# An open case suggested as a duplicate of another. score is the cosine
+# similarity of the two cases' embeddings, between 0 and 1.
+type DuplicateCandidate {
+  case: Case!
+  score: Float!
+}
+
+enum SLAKind {
+  RESPONSE
+  RESOLUTION
+}
+
+# One SLA clock of a case. Durations are in seconds; elapsedSeconds excludes
+# the time the clock was paused. dueAt is when the clock reaches its target
+# if it keeps running; stoppedAt is set once the case responded (RESPONSE)
+# or was closed (RESOLUTION).
+type SLAClock {
+  kind: SLAKind!
+  targetSeconds: Int!
+  elapsedSeconds: Int!
+  dueAt: Time!
+  stoppedAt: Time
+  paused: Boolean!
+  breached: Boolean!
+}
+
+# A target a case missed. A case has at most one breach per kind.
+type SLABreach {
+  kind: SLAKind!
+  targetSeconds: Int!
+  dueAt: Time!
+  recordedAt: Time!
+}
+
+# Cursor-paginated slice of searchCases results. nextCursor is null when the
+# caller has reached the last page.
+type CaseConnection {
+  items: [Case!]!
+  nextCursor: String
+}
+
+# Field searchCases orders by. Ties always break on the case id.
+enum CaseSortKey {
+  CREATED_AT
+  UPDATED_AT
+  ID
+}
+
+enum SortDirection {
+  ASC
+  DESC
+}
+
+input CaseSortInput {
+  key: CaseSortKey!
+  direction: SortDirection!
+}
+
+# Filter on one custom field value. Every set criterion must hold; a case
+# without a value for the field never matches.
+input CaseFieldFilterInput {
+  fieldId: String!
+  # Matches a select / user value that is one of the ids, or a multi-select /
+  # multi-user value containing at least one of them.
+  optionIds: [String!]
+  # Date values in [dateFrom, dateTo).
+  dateFrom: Time
+  dateTo: Time
+  # Number values in [numberMin, numberMax].
+  numberMin: Float
+  numberMax: Float
+}
+
+# Filters of searchCases. Unset filters match everything; set filters are
+# ANDed. Time ranges are half-open [from, to).
+input CaseSearchFilter {
+  # Without a status, DRAFT cases are excluded as in ` + "`" + `cases` + "`" + `.
+  status: CaseStatus
+  assigneeId: String
+  reporterId: String
+  fields: [CaseFieldFilterInput!]
+  # Case-insensitive substring of the title, the description or a text-like
+  # field value.
+  text: String
+  createdFrom: Time
+  createdTo: Time
+  updatedFrom: Time
+  updatedTo: Time
+  isTest: Boolean
+}
+
 type Action {
   id: Int!
   # workspaceId scopes id, exactly as on Case: Action ids come from a
@@ -4126,6 +5190,16 @@ type Action {
   # Aggregate progress over the Action's steps. Both fields are 0 when the
   # caller cannot access the parent Case (private case + non-member).
   stepProgress: ActionStepProgress!
+  # IDs of the actions of the same case this one waits on, as stored.
+  blockedByIDs: [Int!]!
+  # The actions this one waits on, in blockedByIDs order, whatever their
+  # status. Archived or moved blockers are left out.
+  blockedBy: [Action!]!
+  # The active actions of the same case that wait on this one.
+  blocking: [Action!]!
+  # blocked is true while any blocker is neither archived nor in a closed
+  # status. It is derived, independent of the action's own status.
+  blocked: Boolean!
 }
 
 enum ActionEventKind {
@@ -4236,6 +5310,82 @@ type Tag {
   updatedAt: Time!
 }
 
+# APIToken — a personal access token for calling this API from scripts and
+# dashboards with an ` + "`" + `Authorization: Bearer` + "`" + ` header. It acts as the user who
+# created it. The secret is returned once, by createAPIToken, and never again.
+type APIToken {
+  id: ID!
+  name: String!
+  "Workspace IDs the token is limited to; null admits every workspace."
+  workspaceIds: [String!]
+  "A read-only token cannot run any mutation."
+  readOnly: Boolean!
+  expiresAt: Time!
+  createdAt: Time!
+  "When the token was last used, to the minute; null if never."
+  lastUsedAt: Time
+  expired: Boolean!
+}
+
+input CreateAPITokenInput {
+  name: String!
+  "Limit the token to these workspaces; omit for every workspace."
+  workspaceIds: [String!]
+  readOnly: Boolean
+  "Lifetime in days, 1 to 365. Defaults to 90."
+  expiresInDays: Int
+}
+
+type CreatedAPIToken {
+  token: APIToken!
+  "The bearer credential. Shown only once; store it now."
+  secret: String!
+}
+
+enum WebhookDeliveryStatus {
+  PENDING
+  DELIVERED
+  DEAD
+}
+
+# WebhookDelivery — one case event queued for one of the workspace's
+# [[webhook]] entries. A failed attempt is retried with exponential backoff;
+# once the attempts run out the delivery is DEAD, and stays in the
+# dead-letter list until retryWebhookDelivery requeues it.
+type WebhookDelivery {
+  id: ID!
+  "The [[webhook]] entry's id."
+  webhookId: String!
+  "The event type, e.g. case.created."
+  event: String!
+  caseId: Int!
+  status: WebhookDeliveryStatus!
+  "The request body sent on every attempt."
+  payload: String!
+  attempts: Int!
+  "When a PENDING delivery is next attempted; null otherwise."
+  nextAttemptAt: Time
+  lastAttemptAt: Time
+  "The last response's HTTP status; null when no response was received."
+  lastStatusCode: Int
+  lastError: String
+  deliveredAt: Time
+  createdAt: Time!
+}
+
+# Alert — one alert received on the workspace's ingest endpoint.
+type Alert {
+  id: ID!
+  title: String!
+  "Hex SHA-256 of the rendered fingerprint; shared by repeats of one alert."
+  fingerprint: String!
+  "The alert's JSON body, truncated to 64 KiB."
+  payload: String!
+  "True when the alert was folded into this already-open case."
+  repeat: Boolean!
+  receivedAt: Time!
+}
+
 # Knowledge — workspace-wide shared knowledge entries. Unlike Memo, knowledge is
 # not scoped to a case and carries no custom fields: a single Markdown claim body
 # plus tags. Tags are resolved from the referenced tag ids. The embedding vector
@@ -4247,15 +5397,87 @@ type Knowledge {
   # claim is a single Markdown text body (empty string when not yet written).
   claim: String!
   tags: [Tag!]!
+  # validUntil is when the entry stops being authoritative. Once passed, the
+  # entry is expired: agents' knowledge__search_knowledge skips it by default.
+  validUntil: Time
+  # reviewBy is when the owner should re-check the entry.
+  reviewBy: Time
+  # reviewFlaggedAt is when the tick sweep flagged the entry as due (expired or
+  # past reviewBy) and notified its creator. Cleared when either date changes.
+  reviewFlaggedAt: Time
+  expired: Boolean!
+  # reviewDue is true when the entry is expired or past reviewBy.
+  reviewDue: Boolean!
   createdAt: Time!
   updatedAt: Time!
 }
 
+# KnowledgeSearchHit is one ranked searchKnowledge result. score orders the
+# results and is only comparable within one search; lexicalScore is the BM25
+# match normalized to the best hit, vectorScore the embedding similarity (null
+# when no embedding was available for the query or the entry).
+type KnowledgeSearchHit {
+  knowledge: Knowledge!
+  score: Float!
+  lexicalScore: Float!
+  vectorScore: Float
+}
+
+# KnowledgeRevision is an immutable snapshot of a Knowledge entry's content
+# after one write. Every create / update / restore appends one; revisions are
+# numbered from 1 per entry and never rewritten.
+type KnowledgeRevision {
+  revision: Int!
+  action: KnowledgeRevisionAction!
+  title: String!
+  claim: String!
+  tags: [Tag!]!
+  # editorID is the Slack user who made the change; null when an agent did.
+  editorID: String
+  editor: SlackUser
+  # jobID / runID identify the Job run whose agent made the change.
+  jobID: String
+  runID: String
+  # restoredFrom is the revision a RESTORED revision copied back.
+  restoredFrom: Int
+  createdAt: Time!
+}
+
+enum KnowledgeRevisionAction {
+  CREATED
+  UPDATED
+  RESTORED
+}
+
+# KnowledgeRevisionDiff compares two revisions of one entry, read as from → to.
+# title and claim are line diffs; tag changes are listed separately.
+type KnowledgeRevisionDiff {
+  from: KnowledgeRevision!
+  to: KnowledgeRevision!
+  title: [DiffLine!]!
+  claim: [DiffLine!]!
+  addedTags: [Tag!]!
+  removedTags: [Tag!]!
+}
+
+type DiffLine {
+  op: DiffOp!
+  text: String!
+}
+
+enum DiffOp {
+  EQUAL
+  INSERT
+  DELETE
+}
+
 input CreateKnowledgeInput {
   title: String!
   claim: String
   # At least one existing tag id is required. Tags must be created beforehand.
   tagIds: [ID!]!
+  validUntil: Time
+  reviewBy: Time
 }
 
 input UpdateKnowledgeInput {
@@ -4264,6 +5486,10 @@ input UpdateKnowledgeInput {
   claim: String
   # Omit to leave unchanged; when provided it must contain at least one existing tag id.
   tagIds: [ID!]
+  validUntil: Time
+  reviewBy: Time
+  clearValidUntil: Boolean
+  clearReviewBy: Boolean
 }
 
 # ActionEvent records a single change to an Action, surfaced in the
@@ -4369,6 +5595,8 @@ input CreateActionInput {
   # Status id. Must match a status defined for the workspace.
   status: String
   dueDate: Time
+  # Actions of the same case the new action waits on.
+  blockedBy: [Int!]
 }
 
 input UpdateActionInput {
@@ -4383,6 +5611,10 @@ input UpdateActionInput {
   dueDate: Time
   clearDueDate: Boolean
   clearAssignee: Boolean
+  # Replaces the action's blockers; an empty list clears them. A blocker must
+  # be an active action of the same case and must not wait on this action,
+  # directly or transitively.
+  blockedBy: [Int!]
 }
 
 input UpdateCaseStatusInput {
@@ -4626,6 +5858,14 @@ type Query {
   cases(workspaceId: String!, status: CaseStatus): [Case!]!
   case(workspaceId: String!, id: Int!): Case
 
+  # Server-side filtered, sorted and cursor-paginated case listing. sort
+  # defaults to CREATED_AT DESC; ` + "`" + `first` + "`" + ` defaults to 50 and is clamped to 200;
+  # ` + "`" + `after` + "`" + ` is the opaque cursor returned from the previous page with the
+  # same sort. Private cases the caller cannot access are returned with
+  # accessDenied set, or omitted when a filter other than status or the
+  # created/updated ranges is set.
+  searchCases(workspaceId: String!, filter: CaseSearchFilter, sort: CaseSortInput, first: Int, after: String): CaseConnection!
+
   # Drafts authored by the current user in this workspace. Draft cases are
   # only visible to their reporter and never appear in the general ` + "`" + `cases` + "`" + `
   # listing; the dedicated query keeps that author-scoped path explicit and
@@ -4668,6 +5908,13 @@ type Query {
   slackUsers: [SlackUser!]!
   slackJoinedChannels: [SlackChannelInfo!]!
 
+  # Personal access tokens of the signed-in user, newest first.
+  apiTokens: [APIToken!]!
+
+  # Outbound webhook delivery history, newest first. status narrows it, e.g.
+  # to DEAD for the dead-letter list. first defaults to 50, at most 200.
+  webhookDeliveries(workspaceId: String!, status: WebhookDeliveryStatus, first: Int): [WebhookDelivery!]!
+
   # Sources
   sources(workspaceId: String!): [Source!]!
   source(workspaceId: String!, id: String!): Source
@@ -4714,9 +5961,16 @@ type Query {
   # Knowledge — workspace-wide shared knowledge.
   knowledges(workspaceId: String!, tagIds: [ID!]): [Knowledge!]!
   knowledge(workspaceId: String!, id: ID!): Knowledge
-  # Semantic search over knowledge; falls back to substring matching when no
-  # embedding is available. ` + "`" + `tagIds` + "`" + ` applies an AND pre-filter.
-  searchKnowledge(workspaceId: String!, query: String!, tagIds: [ID!], limit: Int): [Knowledge!]!
+  # Revision history of a knowledge entry, oldest first.
+  knowledgeRevisions(workspaceId: String!, id: ID!): [KnowledgeRevision!]!
+  knowledgeRevisionDiff(workspaceId: String!, id: ID!, from: Int!, to: Int!): KnowledgeRevisionDiff!
+  # Entries that are expired or past their review date, longest-overdue first.
+  knowledgeDueForReview(workspaceId: String!): [Knowledge!]!
+  # Hybrid search over knowledge: BM25 keyword scoring fused with embedding
+  # similarity (keyword-only when no embedding is available), then reranked,
+  # best first. ` + "`" + `tagIds` + "`" + ` applies an AND pre-filter. Expired entries are left out
+  # unless ` + "`" + `includeExpired` + "`" + ` is true.
+  searchKnowledge(workspaceId: String!, query: String!, tagIds: [ID!], limit: Int, includeExpired: Boolean): [KnowledgeSearchHit!]!
 
   # Tags — workspace-wide classification labels.
   tags(workspaceId: String!): [Tag!]!
@@ -4739,6 +5993,11 @@ type Mutation {
   deleteCase(workspaceId: String!, id: Int!): Boolean!
   closeCase(workspaceId: String!, id: Int!): Case!
   reopenCase(workspaceId: String!, id: Int!): Case!
+  # mergeCases folds the source cases into targetId: their actions, memos,
+  # Slack messages and job-run history move onto the target, each source is
+  # closed with mergedIntoID set, and both sides get a note in Slack linking
+  # them. The target must be open. Returns the target.
+  mergeCases(workspaceId: String!, targetId: Int!, sourceIds: [Int!]!): Case!
   # updateCaseStatus sets a thread-mode case's board status (Kanban column).
   # The lifecycle status is synced server-side (a closed board status closes
   # the case). Used by the Kanban drag-and-drop.
@@ -4850,12 +6109,62 @@ type Mutation {
   createKnowledge(workspaceId: String!, input: CreateKnowledgeInput!): Knowledge!
   updateKnowledge(workspaceId: String!, input: UpdateKnowledgeInput!): Knowledge!
   deleteKnowledge(workspaceId: String!, id: ID!): Boolean!
+  # Roll the entry's content back to an earlier revision. The rollback is
+  # recorded as a new RESTORED revision.
+  restoreKnowledgeRevision(workspaceId: String!, id: ID!, revision: Int!): Knowledge!
 
   # Tags — workspace-wide classification labels referenced by Knowledge.
   createTag(workspaceId: String!, name: String): Tag!
   updateTag(workspaceId: String!, id: ID!, name: String): Tag!
   # deleteTag removes a tag; it fails when any knowledge entry still references it.
   deleteTag(workspaceId: String!, id: ID!): Boolean!
+
+  # Personal access tokens. Neither can be run with an API token.
+  createAPIToken(input: CreateAPITokenInput!): CreatedAPIToken!
+  # revokeAPIToken deletes one of the signed-in user's tokens; it stops
+  # working immediately.
+  revokeAPIToken(id: ID!): Boolean!
+
+  # retryWebhookDelivery requeues a DEAD delivery with a fresh set of attempts
+  # and attempts it at once.
+  retryWebhookDelivery(workspaceId: String!, id: ID!): WebhookDelivery!
+}
+
+# Live updates, over WebSocket (graphql-transport-ws or graphql-ws) or
+# Server-Sent Events. Each event is re-read with the subscriber's
+# permissions, exactly as the matching query would return it. Delivery is
+# best-effort: a client that must not miss a change re-reads after it
+# reconnects.
+type Subscription {
+  # Every case created, updated or deleted in the workspace.
+  caseChanged(workspaceId: String!): CaseChangeEvent!
+  # Every action of the case created, updated (archiving included) or
+  # deleted.
+  actionChanged(workspaceId: String!, caseId: Int!): ActionChangeEvent!
+  # Each event appended to the job run's timeline, in sequence order. With
+  # afterSequence, the events already recorded after it are sent first, so a
+  # client can resume where its jobRunEvents query left off.
+  jobRunEventAdded(workspaceId: String!, caseId: Int!, runId: String!, afterSequence: Int): JobRunEvent!
+}
+
+enum LiveChangeKind {
+  CREATED
+  UPDATED
+  DELETED
+}
+
+type CaseChangeEvent {
+  kind: LiveChangeKind!
+  caseId: Int!
+  # The case after the change; null when it was deleted.
+  case: Case
+}
+
+type ActionChangeEvent {
+  kind: LiveChangeKind!
+  actionId: Int!
+  # The action after the change; null when it was deleted.
+  action: Action
 }
 
 input UpdateCaseAgentSettingsInput {
@@ -4964,6 +6273,11 @@ type JobRunEvent {
 enum CaseLifecycleEvent {
   CREATED
   CLOSED
+  FIELD_CHANGED
+  STATUS_CHANGED
+  ASSIGNED
+  ACTION_COMPLETED
+  MESSAGE_ATTACHED
 }
 
 # JobSchedule is the scheduled-trigger detail of a Job. Exactly one of
@@ -4980,8 +6294,12 @@ type JobSchedule {
 # Case. caseEvents lists subscribed lifecycle events (empty when the Job
 # does not listen to the case domain); schedule is non-null only when the
 # Job has a scheduled trigger. A Job always has at least one of the two.
+# caseField / caseTo echo the optional ` + "`" + `field` + "`" + ` / ` + "`" + `to` + "`" + ` narrowing of the case
+# events (null / empty when the Job does not narrow).
 type JobTrigger {
   caseEvents: [CaseLifecycleEvent!]!
+  caseField: String
+  caseTo: [String!]!
   schedule: JobSchedule
 }
 
@@ -5137,6 +6455,28 @@ var parsedSchema = gqlparser.MustLoadSchema(sources...)
 // Each function is generated once per unique object type, deduplicating the
 // switch statements that were previously inlined in every fieldContext_* function.
 
+func (ec *executionContext) childFields_APIToken(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "id":
+		return ec.fieldContext_APIToken_id(ctx, field)
+	case "name":
+		return ec.fieldContext_APIToken_name(ctx, field)
+	case "workspaceIds":
+		return ec.fieldContext_APIToken_workspaceIds(ctx, field)
+	case "readOnly":
+		return ec.fieldContext_APIToken_readOnly(ctx, field)
+	case "expiresAt":
+		return ec.fieldContext_APIToken_expiresAt(ctx, field)
+	case "createdAt":
+		return ec.fieldContext_APIToken_createdAt(ctx, field)
+	case "lastUsedAt":
+		return ec.fieldContext_APIToken_lastUsedAt(ctx, field)
+	case "expired":
+		return ec.fieldContext_APIToken_expired(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type APIToken", field.Name)
+}
+
 func (ec *executionContext) childFields_Action(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "id":
@@ -5179,10 +6519,30 @@ func (ec *executionContext) childFields_Action(ctx context.Context, field graphq
 		return ec.fieldContext_Action_steps(ctx, field)
 	case "stepProgress":
 		return ec.fieldContext_Action_stepProgress(ctx, field)
+	case "blockedByIDs":
+		return ec.fieldContext_Action_blockedByIDs(ctx, field)
+	case "blockedBy":
+		return ec.fieldContext_Action_blockedBy(ctx, field)
+	case "blocking":
+		return ec.fieldContext_Action_blocking(ctx, field)
+	case "blocked":
+		return ec.fieldContext_Action_blocked(ctx, field)
 	}
 	return nil, fmt.Errorf("no field named %q was found under type Action", field.Name)
 }
 
+func (ec *executionContext) childFields_ActionChangeEvent(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "kind":
+		return ec.fieldContext_ActionChangeEvent_kind(ctx, field)
+	case "actionId":
+		return ec.fieldContext_ActionChangeEvent_actionId(ctx, field)
+	case "action":
+		return ec.fieldContext_ActionChangeEvent_action(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type ActionChangeEvent", field.Name)
+}
+
 func (ec *executionContext) childFields_ActionComment(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "id":
@@ -5313,6 +6673,24 @@ func (ec *executionContext) childFields_ActionStepProgress(ctx context.Context,
 	return nil, fmt.Errorf("no field named %q was found under type ActionStepProgress", field.Name)
 }
 
+func (ec *executionContext) childFields_Alert(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "id":
+		return ec.fieldContext_Alert_id(ctx, field)
+	case "title":
+		return ec.fieldContext_Alert_title(ctx, field)
+	case "fingerprint":
+		return ec.fieldContext_Alert_fingerprint(ctx, field)
+	case "payload":
+		return ec.fieldContext_Alert_payload(ctx, field)
+	case "repeat":
+		return ec.fieldContext_Alert_repeat(ctx, field)
+	case "receivedAt":
+		return ec.fieldContext_Alert_receivedAt(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type Alert", field.Name)
+}
+
 func (ec *executionContext) childFields_AssistLog(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "id":
@@ -5387,12 +6765,22 @@ func (ec *executionContext) childFields_Case(ctx context.Context, field graphql.
 		return ec.fieldContext_Case_isThreadBound(ctx, field)
 	case "boardStatus":
 		return ec.fieldContext_Case_boardStatus(ctx, field)
+	case "mergedIntoID":
+		return ec.fieldContext_Case_mergedIntoID(ctx, field)
+	case "duplicateCandidates":
+		return ec.fieldContext_Case_duplicateCandidates(ctx, field)
 	case "fields":
 		return ec.fieldContext_Case_fields(ctx, field)
 	case "actions":
 		return ec.fieldContext_Case_actions(ctx, field)
 	case "slackMessages":
 		return ec.fieldContext_Case_slackMessages(ctx, field)
+	case "alerts":
+		return ec.fieldContext_Case_alerts(ctx, field)
+	case "slaClocks":
+		return ec.fieldContext_Case_slaClocks(ctx, field)
+	case "slaBreaches":
+		return ec.fieldContext_Case_slaBreaches(ctx, field)
 	case "agentAdditionalPrompt":
 		return ec.fieldContext_Case_agentAdditionalPrompt(ctx, field)
 	case "agentSources":
@@ -5405,6 +6793,28 @@ func (ec *executionContext) childFields_Case(ctx context.Context, field graphql.
 	return nil, fmt.Errorf("no field named %q was found under type Case", field.Name)
 }
 
+func (ec *executionContext) childFields_CaseChangeEvent(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "kind":
+		return ec.fieldContext_CaseChangeEvent_kind(ctx, field)
+	case "caseId":
+		return ec.fieldContext_CaseChangeEvent_caseId(ctx, field)
+	case "case":
+		return ec.fieldContext_CaseChangeEvent_case(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type CaseChangeEvent", field.Name)
+}
+
+func (ec *executionContext) childFields_CaseConnection(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "items":
+		return ec.fieldContext_CaseConnection_items(ctx, field)
+	case "nextCursor":
+		return ec.fieldContext_CaseConnection_nextCursor(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type CaseConnection", field.Name)
+}
+
 func (ec *executionContext) childFields_CaseJob(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "id":
@@ -5453,6 +6863,36 @@ func (ec *executionContext) childFields_ChannelUserConnection(ctx context.Contex
 	return nil, fmt.Errorf("no field named %q was found under type ChannelUserConnection", field.Name)
 }
 
+func (ec *executionContext) childFields_CreatedAPIToken(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "token":
+		return ec.fieldContext_CreatedAPIToken_token(ctx, field)
+	case "secret":
+		return ec.fieldContext_CreatedAPIToken_secret(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type CreatedAPIToken", field.Name)
+}
+
+func (ec *executionContext) childFields_DiffLine(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "op":
+		return ec.fieldContext_DiffLine_op(ctx, field)
+	case "text":
+		return ec.fieldContext_DiffLine_text(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type DiffLine", field.Name)
+}
+
+func (ec *executionContext) childFields_DuplicateCandidate(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "case":
+		return ec.fieldContext_DuplicateCandidate_case(ctx, field)
+	case "score":
+		return ec.fieldContext_DuplicateCandidate_score(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type DuplicateCandidate", field.Name)
+}
+
 func (ec *executionContext) childFields_EntityLabels(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "case":
@@ -5803,6 +7243,10 @@ func (ec *executionContext) childFields_JobTrigger(ctx context.Context, field gr
 	switch field.Name {
 	case "caseEvents":
 		return ec.fieldContext_JobTrigger_caseEvents(ctx, field)
+	case "caseField":
+		return ec.fieldContext_JobTrigger_caseField(ctx, field)
+	case "caseTo":
+		return ec.fieldContext_JobTrigger_caseTo(ctx, field)
 	case "schedule":
 		return ec.fieldContext_JobTrigger_schedule(ctx, field)
 	}
@@ -5819,6 +7263,16 @@ func (ec *executionContext) childFields_Knowledge(ctx context.Context, field gra
 		return ec.fieldContext_Knowledge_claim(ctx, field)
 	case "tags":
 		return ec.fieldContext_Knowledge_tags(ctx, field)
+	case "validUntil":
+		return ec.fieldContext_Knowledge_validUntil(ctx, field)
+	case "reviewBy":
+		return ec.fieldContext_Knowledge_reviewBy(ctx, field)
+	case "reviewFlaggedAt":
+		return ec.fieldContext_Knowledge_reviewFlaggedAt(ctx, field)
+	case "expired":
+		return ec.fieldContext_Knowledge_expired(ctx, field)
+	case "reviewDue":
+		return ec.fieldContext_Knowledge_reviewDue(ctx, field)
 	case "createdAt":
 		return ec.fieldContext_Knowledge_createdAt(ctx, field)
 	case "updatedAt":
@@ -5827,6 +7281,66 @@ func (ec *executionContext) childFields_Knowledge(ctx context.Context, field gra
 	return nil, fmt.Errorf("no field named %q was found under type Knowledge", field.Name)
 }
 
+func (ec *executionContext) childFields_KnowledgeRevision(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "revision":
+		return ec.fieldContext_KnowledgeRevision_revision(ctx, field)
+	case "action":
+		return ec.fieldContext_KnowledgeRevision_action(ctx, field)
+	case "title":
+		return ec.fieldContext_KnowledgeRevision_title(ctx, field)
+	case "claim":
+		return ec.fieldContext_KnowledgeRevision_claim(ctx, field)
+	case "tags":
+		return ec.fieldContext_KnowledgeRevision_tags(ctx, field)
+	case "editorID":
+		return ec.fieldContext_KnowledgeRevision_editorID(ctx, field)
+	case "editor":
+		return ec.fieldContext_KnowledgeRevision_editor(ctx, field)
+	case "jobID":
+		return ec.fieldContext_KnowledgeRevision_jobID(ctx, field)
+	case "runID":
+		return ec.fieldContext_KnowledgeRevision_runID(ctx, field)
+	case "restoredFrom":
+		return ec.fieldContext_KnowledgeRevision_restoredFrom(ctx, field)
+	case "createdAt":
+		return ec.fieldContext_KnowledgeRevision_createdAt(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type KnowledgeRevision", field.Name)
+}
+
+func (ec *executionContext) childFields_KnowledgeRevisionDiff(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "from":
+		return ec.fieldContext_KnowledgeRevisionDiff_from(ctx, field)
+	case "to":
+		return ec.fieldContext_KnowledgeRevisionDiff_to(ctx, field)
+	case "title":
+		return ec.fieldContext_KnowledgeRevisionDiff_title(ctx, field)
+	case "claim":
+		return ec.fieldContext_KnowledgeRevisionDiff_claim(ctx, field)
+	case "addedTags":
+		return ec.fieldContext_KnowledgeRevisionDiff_addedTags(ctx, field)
+	case "removedTags":
+		return ec.fieldContext_KnowledgeRevisionDiff_removedTags(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type KnowledgeRevisionDiff", field.Name)
+}
+
+func (ec *executionContext) childFields_KnowledgeSearchHit(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "knowledge":
+		return ec.fieldContext_KnowledgeSearchHit_knowledge(ctx, field)
+	case "score":
+		return ec.fieldContext_KnowledgeSearchHit_score(ctx, field)
+	case "lexicalScore":
+		return ec.fieldContext_KnowledgeSearchHit_lexicalScore(ctx, field)
+	case "vectorScore":
+		return ec.fieldContext_KnowledgeSearchHit_vectorScore(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type KnowledgeSearchHit", field.Name)
+}
+
 func (ec *executionContext) childFields_Memo(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "id":
@@ -5917,6 +7431,56 @@ func (ec *executionContext) childFields_NotionPageValidationResult(ctx context.C
 	return nil, fmt.Errorf("no field named %q was found under type NotionPageValidationResult", field.Name)
 }
 
+func (ec *executionContext) childFields_OpenSLABreach(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "workspaceId":
+		return ec.fieldContext_OpenSLABreach_workspaceId(ctx, field)
+	case "workspaceName":
+		return ec.fieldContext_OpenSLABreach_workspaceName(ctx, field)
+	case "caseId":
+		return ec.fieldContext_OpenSLABreach_caseId(ctx, field)
+	case "caseTitle":
+		return ec.fieldContext_OpenSLABreach_caseTitle(ctx, field)
+	case "breach":
+		return ec.fieldContext_OpenSLABreach_breach(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type OpenSLABreach", field.Name)
+}
+
+func (ec *executionContext) childFields_SLABreach(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "kind":
+		return ec.fieldContext_SLABreach_kind(ctx, field)
+	case "targetSeconds":
+		return ec.fieldContext_SLABreach_targetSeconds(ctx, field)
+	case "dueAt":
+		return ec.fieldContext_SLABreach_dueAt(ctx, field)
+	case "recordedAt":
+		return ec.fieldContext_SLABreach_recordedAt(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type SLABreach", field.Name)
+}
+
+func (ec *executionContext) childFields_SLAClock(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "kind":
+		return ec.fieldContext_SLAClock_kind(ctx, field)
+	case "targetSeconds":
+		return ec.fieldContext_SLAClock_targetSeconds(ctx, field)
+	case "elapsedSeconds":
+		return ec.fieldContext_SLAClock_elapsedSeconds(ctx, field)
+	case "dueAt":
+		return ec.fieldContext_SLAClock_dueAt(ctx, field)
+	case "stoppedAt":
+		return ec.fieldContext_SLAClock_stoppedAt(ctx, field)
+	case "paused":
+		return ec.fieldContext_SLAClock_paused(ctx, field)
+	case "breached":
+		return ec.fieldContext_SLAClock_breached(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type SLAClock", field.Name)
+}
+
 func (ec *executionContext) childFields_SlackChannel(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "id":
@@ -6043,6 +7607,38 @@ func (ec *executionContext) childFields_Tag(ctx context.Context, field graphql.C
 	return nil, fmt.Errorf("no field named %q was found under type Tag", field.Name)
 }
 
+func (ec *executionContext) childFields_WebhookDelivery(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "id":
+		return ec.fieldContext_WebhookDelivery_id(ctx, field)
+	case "webhookId":
+		return ec.fieldContext_WebhookDelivery_webhookId(ctx, field)
+	case "event":
+		return ec.fieldContext_WebhookDelivery_event(ctx, field)
+	case "caseId":
+		return ec.fieldContext_WebhookDelivery_caseId(ctx, field)
+	case "status":
+		return ec.fieldContext_WebhookDelivery_status(ctx, field)
+	case "payload":
+		return ec.fieldContext_WebhookDelivery_payload(ctx, field)
+	case "attempts":
+		return ec.fieldContext_WebhookDelivery_attempts(ctx, field)
+	case "nextAttemptAt":
+		return ec.fieldContext_WebhookDelivery_nextAttemptAt(ctx, field)
+	case "lastAttemptAt":
+		return ec.fieldContext_WebhookDelivery_lastAttemptAt(ctx, field)
+	case "lastStatusCode":
+		return ec.fieldContext_WebhookDelivery_lastStatusCode(ctx, field)
+	case "lastError":
+		return ec.fieldContext_WebhookDelivery_lastError(ctx, field)
+	case "deliveredAt":
+		return ec.fieldContext_WebhookDelivery_deliveredAt(ctx, field)
+	case "createdAt":
+		return ec.fieldContext_WebhookDelivery_createdAt(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type WebhookDelivery", field.Name)
+}
+
 func (ec *executionContext) childFields_Workspace(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "id":
@@ -6463,6 +8059,20 @@ func (ec *executionContext) field_Mutation_closeCase_args(ctx context.Context, r
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_createAPIToken_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input",
+		func(ctx context.Context, v any) (graphql1.CreateAPITokenInput, error) {
+			return ec.unmarshalNCreateAPITokenInput2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCreateAPITokenInput(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_createActionComment_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -6903,6 +8513,36 @@ func (ec *executionContext) field_Mutation_executeCaseImport_args(ctx context.Co
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_mergeCases_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "workspaceId",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNString2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["workspaceId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "targetId",
+		func(ctx context.Context, v any) (int, error) {
+			return ec.unmarshalNInt2int(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["targetId"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "sourceIds",
+		func(ctx context.Context, v any) ([]int, error) {
+			return ec.unmarshalNInt2ᚕintᚄ(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["sourceIds"] = arg2
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_postActionSlackMessage_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -6969,6 +8609,72 @@ func (ec *executionContext) field_Mutation_reopenCase_args(ctx context.Context,
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_restoreKnowledgeRevision_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "workspaceId",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNString2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["workspaceId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "id",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNID2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "revision",
+		func(ctx context.Context, v any) (int, error) {
+			return ec.unmarshalNInt2int(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["revision"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_retryWebhookDelivery_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "workspaceId",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNString2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["workspaceId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "id",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNID2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_revokeAPIToken_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNID2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_setActionStepDone_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -7919,6 +9625,80 @@ func (ec *executionContext) field_Query_jobRunLog_args(ctx context.Context, rawA
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_knowledgeDueForReview_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "workspaceId",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNString2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["workspaceId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_knowledgeRevisionDiff_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "workspaceId",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNString2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["workspaceId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "id",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNID2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "from",
+		func(ctx context.Context, v any) (int, error) {
+			return ec.unmarshalNInt2int(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "to",
+		func(ctx context.Context, v any) (int, error) {
+			return ec.unmarshalNInt2int(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_knowledgeRevisions_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "workspaceId",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNString2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["workspaceId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "id",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNID2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_knowledge_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -8081,6 +9861,52 @@ func (ec *executionContext) field_Query_referenceableCases_args(ctx context.Cont
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_searchCases_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "workspaceId",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNString2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["workspaceId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "filter",
+		func(ctx context.Context, v any) (*graphql1.CaseSearchFilter, error) {
+			return ec.unmarshalOCaseSearchFilter2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCaseSearchFilter(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["filter"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "sort",
+		func(ctx context.Context, v any) (*graphql1.CaseSortInput, error) {
+			return ec.unmarshalOCaseSortInput2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCaseSortInput(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["sort"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "first",
+		func(ctx context.Context, v any) (*int, error) {
+			return ec.unmarshalOInt2ᚖint(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg3
+	arg4, err := graphql.ProcessArgField(ctx, rawArgs, "after",
+		func(ctx context.Context, v any) (*string, error) {
+			return ec.unmarshalOString2ᚖstring(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["after"] = arg4
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_searchKnowledge_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -8116,6 +9942,14 @@ func (ec *executionContext) field_Query_searchKnowledge_args(ctx context.Context
 		return nil, err
 	}
 	args["limit"] = arg3
+	arg4, err := graphql.ProcessArgField(ctx, rawArgs, "includeExpired",
+		func(ctx context.Context, v any) (*bool, error) {
+			return ec.unmarshalOBoolean2ᚖbool(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["includeExpired"] = arg4
 	return args, nil
 }
 
@@ -8213,6 +10047,36 @@ func (ec *executionContext) field_Query_validateGitHubRepo_args(ctx context.Cont
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_webhookDeliveries_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "workspaceId",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNString2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["workspaceId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "status",
+		func(ctx context.Context, v any) (*graphql1.WebhookDeliveryStatus, error) {
+			return ec.unmarshalOWebhookDeliveryStatus2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐWebhookDeliveryStatus(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["status"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "first",
+		func(ctx context.Context, v any) (*int, error) {
+			return ec.unmarshalOInt2ᚖint(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg2
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_workspace_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -8227,6 +10091,80 @@ func (ec *executionContext) field_Query_workspace_args(ctx context.Context, rawA
 	return args, nil
 }
 
+func (ec *executionContext) field_Subscription_actionChanged_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "workspaceId",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNString2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["workspaceId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "caseId",
+		func(ctx context.Context, v any) (int, error) {
+			return ec.unmarshalNInt2int(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["caseId"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_caseChanged_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "workspaceId",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNString2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["workspaceId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_jobRunEventAdded_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "workspaceId",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNString2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["workspaceId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "caseId",
+		func(ctx context.Context, v any) (int, error) {
+			return ec.unmarshalNInt2int(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["caseId"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "runId",
+		func(ctx context.Context, v any) (string, error) {
+			return ec.unmarshalNString2string(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["runId"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "afterSequence",
+		func(ctx context.Context, v any) (*int, error) {
+			return ec.unmarshalOInt2ᚖint(ctx, v)
+		})
+	if err != nil {
+		return nil, err
+	}
+	args["afterSequence"] = arg3
+	return args, nil
+}
+
 func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -8287,6 +10225,190 @@ func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArg
 
 // region    **************************** field.gotpl *****************************
 
+func (ec *executionContext) _APIToken_id(ctx context.Context, field graphql.CollectedField, obj *graphql1.APIToken) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_APIToken_id(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNID2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_APIToken_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("APIToken", field, false, false, errors.New("field of type ID does not have child fields"))
+}
+
+func (ec *executionContext) _APIToken_name(ctx context.Context, field graphql.CollectedField, obj *graphql1.APIToken) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_APIToken_name(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_APIToken_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("APIToken", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _APIToken_workspaceIds(ctx context.Context, field graphql.CollectedField, obj *graphql1.APIToken) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_APIToken_workspaceIds(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.WorkspaceIds, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []string) graphql.Marshaler {
+			return ec.marshalOString2ᚕstringᚄ(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_APIToken_workspaceIds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("APIToken", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _APIToken_readOnly(ctx context.Context, field graphql.CollectedField, obj *graphql1.APIToken) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_APIToken_readOnly(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ReadOnly, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v bool) graphql.Marshaler {
+			return ec.marshalNBoolean2bool(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_APIToken_readOnly(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("APIToken", field, false, false, errors.New("field of type Boolean does not have child fields"))
+}
+
+func (ec *executionContext) _APIToken_expiresAt(ctx context.Context, field graphql.CollectedField, obj *graphql1.APIToken) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_APIToken_expiresAt(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ExpiresAt, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v time.Time) graphql.Marshaler {
+			return ec.marshalNTime2timeᚐTime(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_APIToken_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("APIToken", field, false, false, errors.New("field of type Time does not have child fields"))
+}
+
+func (ec *executionContext) _APIToken_createdAt(ctx context.Context, field graphql.CollectedField, obj *graphql1.APIToken) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_APIToken_createdAt(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v time.Time) graphql.Marshaler {
+			return ec.marshalNTime2timeᚐTime(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_APIToken_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("APIToken", field, false, false, errors.New("field of type Time does not have child fields"))
+}
+
+func (ec *executionContext) _APIToken_lastUsedAt(ctx context.Context, field graphql.CollectedField, obj *graphql1.APIToken) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_APIToken_lastUsedAt(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.LastUsedAt, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *time.Time) graphql.Marshaler {
+			return ec.marshalOTime2ᚖtimeᚐTime(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_APIToken_lastUsedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("APIToken", field, false, false, errors.New("field of type Time does not have child fields"))
+}
+
+func (ec *executionContext) _APIToken_expired(ctx context.Context, field graphql.CollectedField, obj *graphql1.APIToken) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_APIToken_expired(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Expired, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v bool) graphql.Marshaler {
+			return ec.marshalNBoolean2bool(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_APIToken_expired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("APIToken", field, false, false, errors.New("field of type Boolean does not have child fields"))
+}
+
 func (ec *executionContext) _Action_id(ctx context.Context, field graphql.CollectedField, obj *graphql1.Action) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -8846,6 +10968,194 @@ func (ec *executionContext) fieldContext_Action_stepProgress(_ context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _Action_blockedByIDs(ctx context.Context, field graphql.CollectedField, obj *graphql1.Action) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Action_blockedByIDs(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.BlockedByIDs, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []int) graphql.Marshaler {
+			return ec.marshalNInt2ᚕintᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Action_blockedByIDs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("Action", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _Action_blockedBy(ctx context.Context, field graphql.CollectedField, obj *graphql1.Action) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Action_blockedBy(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.Action().BlockedBy(ctx, obj)
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*graphql1.Action) graphql.Marshaler {
+			return ec.marshalNAction2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐActionᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Action_blockedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Action",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_Action(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Action_blocking(ctx context.Context, field graphql.CollectedField, obj *graphql1.Action) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Action_blocking(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.Action().Blocking(ctx, obj)
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*graphql1.Action) graphql.Marshaler {
+			return ec.marshalNAction2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐActionᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Action_blocking(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Action",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_Action(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Action_blocked(ctx context.Context, field graphql.CollectedField, obj *graphql1.Action) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Action_blocked(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.Action().Blocked(ctx, obj)
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v bool) graphql.Marshaler {
+			return ec.marshalNBoolean2bool(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Action_blocked(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("Action", field, true, true, errors.New("field of type Boolean does not have child fields"))
+}
+
+func (ec *executionContext) _ActionChangeEvent_kind(ctx context.Context, field graphql.CollectedField, obj *graphql1.ActionChangeEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ActionChangeEvent_kind(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Kind, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v graphql1.LiveChangeKind) graphql.Marshaler {
+			return ec.marshalNLiveChangeKind2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐLiveChangeKind(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ActionChangeEvent_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("ActionChangeEvent", field, false, false, errors.New("field of type LiveChangeKind does not have child fields"))
+}
+
+func (ec *executionContext) _ActionChangeEvent_actionId(ctx context.Context, field graphql.CollectedField, obj *graphql1.ActionChangeEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ActionChangeEvent_actionId(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ActionID, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_ActionChangeEvent_actionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("ActionChangeEvent", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _ActionChangeEvent_action(ctx context.Context, field graphql.CollectedField, obj *graphql1.ActionChangeEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_ActionChangeEvent_action(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Action, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *graphql1.Action) graphql.Marshaler {
+			return ec.marshalOAction2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐAction(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_ActionChangeEvent_action(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ActionChangeEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_Action(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _ActionComment_id(ctx context.Context, field graphql.CollectedField, obj *graphql1.ActionComment) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -9852,6 +12162,144 @@ func (ec *executionContext) fieldContext_ActionStepProgress_total(_ context.Cont
 	return graphql.NewScalarFieldContext("ActionStepProgress", field, false, false, errors.New("field of type Int does not have child fields"))
 }
 
+func (ec *executionContext) _Alert_id(ctx context.Context, field graphql.CollectedField, obj *graphql1.Alert) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Alert_id(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNID2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Alert_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("Alert", field, false, false, errors.New("field of type ID does not have child fields"))
+}
+
+func (ec *executionContext) _Alert_title(ctx context.Context, field graphql.CollectedField, obj *graphql1.Alert) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Alert_title(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Title, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Alert_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("Alert", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _Alert_fingerprint(ctx context.Context, field graphql.CollectedField, obj *graphql1.Alert) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Alert_fingerprint(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Fingerprint, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Alert_fingerprint(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("Alert", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _Alert_payload(ctx context.Context, field graphql.CollectedField, obj *graphql1.Alert) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Alert_payload(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Payload, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Alert_payload(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("Alert", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _Alert_repeat(ctx context.Context, field graphql.CollectedField, obj *graphql1.Alert) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Alert_repeat(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Repeat, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v bool) graphql.Marshaler {
+			return ec.marshalNBoolean2bool(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Alert_repeat(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("Alert", field, false, false, errors.New("field of type Boolean does not have child fields"))
+}
+
+func (ec *executionContext) _Alert_receivedAt(ctx context.Context, field graphql.CollectedField, obj *graphql1.Alert) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Alert_receivedAt(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ReceivedAt, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v time.Time) graphql.Marshaler {
+			return ec.marshalNTime2timeᚐTime(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Alert_receivedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("Alert", field, false, false, errors.New("field of type Time does not have child fields"))
+}
+
 func (ec *executionContext) _AssistLog_id(ctx context.Context, field graphql.CollectedField, obj *graphql1.AssistLog) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -10590,6 +13038,61 @@ func (ec *executionContext) fieldContext_Case_boardStatus(_ context.Context, fie
 	return graphql.NewScalarFieldContext("Case", field, false, false, errors.New("field of type String does not have child fields"))
 }
 
+func (ec *executionContext) _Case_mergedIntoID(ctx context.Context, field graphql.CollectedField, obj *graphql1.Case) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Case_mergedIntoID(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.MergedIntoID, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *int) graphql.Marshaler {
+			return ec.marshalOInt2ᚖint(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_Case_mergedIntoID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("Case", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _Case_duplicateCandidates(ctx context.Context, field graphql.CollectedField, obj *graphql1.Case) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Case_duplicateCandidates(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.Case().DuplicateCandidates(ctx, obj)
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*graphql1.DuplicateCandidate) graphql.Marshaler {
+			return ec.marshalNDuplicateCandidate2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐDuplicateCandidateᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Case_duplicateCandidates(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Case",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_DuplicateCandidate(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Case_fields(ctx context.Context, field graphql.CollectedField, obj *graphql1.Case) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -10710,6 +13213,102 @@ func (ec *executionContext) fieldContext_Case_slackMessages(ctx context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _Case_alerts(ctx context.Context, field graphql.CollectedField, obj *graphql1.Case) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Case_alerts(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.Case().Alerts(ctx, obj)
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*graphql1.Alert) graphql.Marshaler {
+			return ec.marshalNAlert2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐAlertᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Case_alerts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Case",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_Alert(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Case_slaClocks(ctx context.Context, field graphql.CollectedField, obj *graphql1.Case) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Case_slaClocks(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.Case().SLAClocks(ctx, obj)
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*graphql1.SLAClock) graphql.Marshaler {
+			return ec.marshalNSLAClock2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐSLAClockᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Case_slaClocks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Case",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_SLAClock(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Case_slaBreaches(ctx context.Context, field graphql.CollectedField, obj *graphql1.Case) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Case_slaBreaches(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.Case().SLABreaches(ctx, obj)
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*graphql1.SLABreach) graphql.Marshaler {
+			return ec.marshalNSLABreach2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐSLABreachᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Case_slaBreaches(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Case",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_SLABreach(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Case_agentAdditionalPrompt(ctx context.Context, field graphql.CollectedField, obj *graphql1.Case) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -10811,6 +13410,139 @@ func (ec *executionContext) fieldContext_Case_updatedAt(_ context.Context, field
 	return graphql.NewScalarFieldContext("Case", field, false, false, errors.New("field of type Time does not have child fields"))
 }
 
+func (ec *executionContext) _CaseChangeEvent_kind(ctx context.Context, field graphql.CollectedField, obj *graphql1.CaseChangeEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_CaseChangeEvent_kind(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Kind, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v graphql1.LiveChangeKind) graphql.Marshaler {
+			return ec.marshalNLiveChangeKind2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐLiveChangeKind(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_CaseChangeEvent_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("CaseChangeEvent", field, false, false, errors.New("field of type LiveChangeKind does not have child fields"))
+}
+
+func (ec *executionContext) _CaseChangeEvent_caseId(ctx context.Context, field graphql.CollectedField, obj *graphql1.CaseChangeEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_CaseChangeEvent_caseId(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CaseID, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_CaseChangeEvent_caseId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("CaseChangeEvent", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _CaseChangeEvent_case(ctx context.Context, field graphql.CollectedField, obj *graphql1.CaseChangeEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_CaseChangeEvent_case(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Case, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *graphql1.Case) graphql.Marshaler {
+			return ec.marshalOCase2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCase(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_CaseChangeEvent_case(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CaseChangeEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_Case(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CaseConnection_items(ctx context.Context, field graphql.CollectedField, obj *graphql1.CaseConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_CaseConnection_items(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Items, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*graphql1.Case) graphql.Marshaler {
+			return ec.marshalNCase2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCaseᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_CaseConnection_items(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CaseConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_Case(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CaseConnection_nextCursor(ctx context.Context, field graphql.CollectedField, obj *graphql1.CaseConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_CaseConnection_nextCursor(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.NextCursor, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_CaseConnection_nextCursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("CaseConnection", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
 func (ec *executionContext) _CaseJob_id(ctx context.Context, field graphql.CollectedField, obj *graphql1.CaseJob) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -11174,6 +13906,162 @@ func (ec *executionContext) fieldContext_ChannelUserConnection_hasMore(_ context
 	return graphql.NewScalarFieldContext("ChannelUserConnection", field, false, false, errors.New("field of type Boolean does not have child fields"))
 }
 
+func (ec *executionContext) _CreatedAPIToken_token(ctx context.Context, field graphql.CollectedField, obj *graphql1.CreatedAPIToken) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_CreatedAPIToken_token(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Token, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *graphql1.APIToken) graphql.Marshaler {
+			return ec.marshalNAPIToken2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐAPIToken(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_CreatedAPIToken_token(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CreatedAPIToken",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_APIToken(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CreatedAPIToken_secret(ctx context.Context, field graphql.CollectedField, obj *graphql1.CreatedAPIToken) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_CreatedAPIToken_secret(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Secret, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_CreatedAPIToken_secret(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("CreatedAPIToken", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _DiffLine_op(ctx context.Context, field graphql.CollectedField, obj *graphql1.DiffLine) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DiffLine_op(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Op, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v graphql1.DiffOp) graphql.Marshaler {
+			return ec.marshalNDiffOp2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐDiffOp(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DiffLine_op(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DiffLine", field, false, false, errors.New("field of type DiffOp does not have child fields"))
+}
+
+func (ec *executionContext) _DiffLine_text(ctx context.Context, field graphql.CollectedField, obj *graphql1.DiffLine) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DiffLine_text(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Text, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DiffLine_text(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DiffLine", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _DuplicateCandidate_case(ctx context.Context, field graphql.CollectedField, obj *graphql1.DuplicateCandidate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DuplicateCandidate_case(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Case, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *graphql1.Case) graphql.Marshaler {
+			return ec.marshalNCase2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCase(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DuplicateCandidate_case(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DuplicateCandidate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_Case(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DuplicateCandidate_score(ctx context.Context, field graphql.CollectedField, obj *graphql1.DuplicateCandidate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_DuplicateCandidate_score(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Score, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v float64) graphql.Marshaler {
+			return ec.marshalNFloat2float64(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_DuplicateCandidate_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("DuplicateCandidate", field, false, false, errors.New("field of type Float does not have child fields"))
+}
+
 func (ec *executionContext) _EntityLabels_case(ctx context.Context, field graphql.CollectedField, obj *graphql1.EntityLabels) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -13939,6 +16827,52 @@ func (ec *executionContext) fieldContext_JobTrigger_caseEvents(_ context.Context
 	return graphql.NewScalarFieldContext("JobTrigger", field, false, false, errors.New("field of type CaseLifecycleEvent does not have child fields"))
 }
 
+func (ec *executionContext) _JobTrigger_caseField(ctx context.Context, field graphql.CollectedField, obj *graphql1.JobTrigger) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_JobTrigger_caseField(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CaseField, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_JobTrigger_caseField(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("JobTrigger", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _JobTrigger_caseTo(ctx context.Context, field graphql.CollectedField, obj *graphql1.JobTrigger) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_JobTrigger_caseTo(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CaseTo, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []string) graphql.Marshaler {
+			return ec.marshalNString2ᚕstringᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_JobTrigger_caseTo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("JobTrigger", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
 func (ec *executionContext) _JobTrigger_schedule(ctx context.Context, field graphql.CollectedField, obj *graphql1.JobTrigger) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -14072,6 +17006,121 @@ func (ec *executionContext) fieldContext_Knowledge_tags(_ context.Context, field
 	return fc, nil
 }
 
+func (ec *executionContext) _Knowledge_validUntil(ctx context.Context, field graphql.CollectedField, obj *graphql1.Knowledge) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Knowledge_validUntil(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ValidUntil, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *time.Time) graphql.Marshaler {
+			return ec.marshalOTime2ᚖtimeᚐTime(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_Knowledge_validUntil(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("Knowledge", field, false, false, errors.New("field of type Time does not have child fields"))
+}
+
+func (ec *executionContext) _Knowledge_reviewBy(ctx context.Context, field graphql.CollectedField, obj *graphql1.Knowledge) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Knowledge_reviewBy(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ReviewBy, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *time.Time) graphql.Marshaler {
+			return ec.marshalOTime2ᚖtimeᚐTime(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_Knowledge_reviewBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("Knowledge", field, false, false, errors.New("field of type Time does not have child fields"))
+}
+
+func (ec *executionContext) _Knowledge_reviewFlaggedAt(ctx context.Context, field graphql.CollectedField, obj *graphql1.Knowledge) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Knowledge_reviewFlaggedAt(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ReviewFlaggedAt, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *time.Time) graphql.Marshaler {
+			return ec.marshalOTime2ᚖtimeᚐTime(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_Knowledge_reviewFlaggedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("Knowledge", field, false, false, errors.New("field of type Time does not have child fields"))
+}
+
+func (ec *executionContext) _Knowledge_expired(ctx context.Context, field graphql.CollectedField, obj *graphql1.Knowledge) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Knowledge_expired(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Expired, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v bool) graphql.Marshaler {
+			return ec.marshalNBoolean2bool(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Knowledge_expired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("Knowledge", field, false, false, errors.New("field of type Boolean does not have child fields"))
+}
+
+func (ec *executionContext) _Knowledge_reviewDue(ctx context.Context, field graphql.CollectedField, obj *graphql1.Knowledge) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Knowledge_reviewDue(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ReviewDue, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v bool) graphql.Marshaler {
+			return ec.marshalNBoolean2bool(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Knowledge_reviewDue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("Knowledge", field, false, false, errors.New("field of type Boolean does not have child fields"))
+}
+
 func (ec *executionContext) _Knowledge_createdAt(ctx context.Context, field graphql.CollectedField, obj *graphql1.Knowledge) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -14118,6 +17167,570 @@ func (ec *executionContext) fieldContext_Knowledge_updatedAt(_ context.Context,
 	return graphql.NewScalarFieldContext("Knowledge", field, false, false, errors.New("field of type Time does not have child fields"))
 }
 
+func (ec *executionContext) _KnowledgeRevision_revision(ctx context.Context, field graphql.CollectedField, obj *graphql1.KnowledgeRevision) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_KnowledgeRevision_revision(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Revision, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_KnowledgeRevision_revision(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("KnowledgeRevision", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _KnowledgeRevision_action(ctx context.Context, field graphql.CollectedField, obj *graphql1.KnowledgeRevision) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_KnowledgeRevision_action(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Action, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v graphql1.KnowledgeRevisionAction) graphql.Marshaler {
+			return ec.marshalNKnowledgeRevisionAction2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐKnowledgeRevisionAction(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_KnowledgeRevision_action(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("KnowledgeRevision", field, false, false, errors.New("field of type KnowledgeRevisionAction does not have child fields"))
+}
+
+func (ec *executionContext) _KnowledgeRevision_title(ctx context.Context, field graphql.CollectedField, obj *graphql1.KnowledgeRevision) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_KnowledgeRevision_title(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Title, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_KnowledgeRevision_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("KnowledgeRevision", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _KnowledgeRevision_claim(ctx context.Context, field graphql.CollectedField, obj *graphql1.KnowledgeRevision) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_KnowledgeRevision_claim(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Claim, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_KnowledgeRevision_claim(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("KnowledgeRevision", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _KnowledgeRevision_tags(ctx context.Context, field graphql.CollectedField, obj *graphql1.KnowledgeRevision) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_KnowledgeRevision_tags(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Tags, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*graphql1.Tag) graphql.Marshaler {
+			return ec.marshalNTag2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐTagᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_KnowledgeRevision_tags(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "KnowledgeRevision",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_Tag(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _KnowledgeRevision_editorID(ctx context.Context, field graphql.CollectedField, obj *graphql1.KnowledgeRevision) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_KnowledgeRevision_editorID(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.EditorID, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_KnowledgeRevision_editorID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("KnowledgeRevision", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _KnowledgeRevision_editor(ctx context.Context, field graphql.CollectedField, obj *graphql1.KnowledgeRevision) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_KnowledgeRevision_editor(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.KnowledgeRevision().Editor(ctx, obj)
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *graphql1.SlackUser) graphql.Marshaler {
+			return ec.marshalOSlackUser2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐSlackUser(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_KnowledgeRevision_editor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "KnowledgeRevision",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_SlackUser(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _KnowledgeRevision_jobID(ctx context.Context, field graphql.CollectedField, obj *graphql1.KnowledgeRevision) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_KnowledgeRevision_jobID(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.JobID, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_KnowledgeRevision_jobID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("KnowledgeRevision", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _KnowledgeRevision_runID(ctx context.Context, field graphql.CollectedField, obj *graphql1.KnowledgeRevision) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_KnowledgeRevision_runID(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.RunID, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_KnowledgeRevision_runID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("KnowledgeRevision", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _KnowledgeRevision_restoredFrom(ctx context.Context, field graphql.CollectedField, obj *graphql1.KnowledgeRevision) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_KnowledgeRevision_restoredFrom(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.RestoredFrom, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *int) graphql.Marshaler {
+			return ec.marshalOInt2ᚖint(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_KnowledgeRevision_restoredFrom(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("KnowledgeRevision", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _KnowledgeRevision_createdAt(ctx context.Context, field graphql.CollectedField, obj *graphql1.KnowledgeRevision) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_KnowledgeRevision_createdAt(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v time.Time) graphql.Marshaler {
+			return ec.marshalNTime2timeᚐTime(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_KnowledgeRevision_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("KnowledgeRevision", field, false, false, errors.New("field of type Time does not have child fields"))
+}
+
+func (ec *executionContext) _KnowledgeRevisionDiff_from(ctx context.Context, field graphql.CollectedField, obj *graphql1.KnowledgeRevisionDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_KnowledgeRevisionDiff_from(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.From, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *graphql1.KnowledgeRevision) graphql.Marshaler {
+			return ec.marshalNKnowledgeRevision2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐKnowledgeRevision(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_KnowledgeRevisionDiff_from(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "KnowledgeRevisionDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_KnowledgeRevision(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _KnowledgeRevisionDiff_to(ctx context.Context, field graphql.CollectedField, obj *graphql1.KnowledgeRevisionDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_KnowledgeRevisionDiff_to(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.To, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *graphql1.KnowledgeRevision) graphql.Marshaler {
+			return ec.marshalNKnowledgeRevision2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐKnowledgeRevision(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_KnowledgeRevisionDiff_to(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "KnowledgeRevisionDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_KnowledgeRevision(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _KnowledgeRevisionDiff_title(ctx context.Context, field graphql.CollectedField, obj *graphql1.KnowledgeRevisionDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_KnowledgeRevisionDiff_title(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Title, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*graphql1.DiffLine) graphql.Marshaler {
+			return ec.marshalNDiffLine2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐDiffLineᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_KnowledgeRevisionDiff_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "KnowledgeRevisionDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_DiffLine(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _KnowledgeRevisionDiff_claim(ctx context.Context, field graphql.CollectedField, obj *graphql1.KnowledgeRevisionDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_KnowledgeRevisionDiff_claim(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Claim, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*graphql1.DiffLine) graphql.Marshaler {
+			return ec.marshalNDiffLine2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐDiffLineᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_KnowledgeRevisionDiff_claim(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "KnowledgeRevisionDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_DiffLine(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _KnowledgeRevisionDiff_addedTags(ctx context.Context, field graphql.CollectedField, obj *graphql1.KnowledgeRevisionDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_KnowledgeRevisionDiff_addedTags(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.AddedTags, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*graphql1.Tag) graphql.Marshaler {
+			return ec.marshalNTag2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐTagᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_KnowledgeRevisionDiff_addedTags(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "KnowledgeRevisionDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_Tag(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _KnowledgeRevisionDiff_removedTags(ctx context.Context, field graphql.CollectedField, obj *graphql1.KnowledgeRevisionDiff) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_KnowledgeRevisionDiff_removedTags(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.RemovedTags, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*graphql1.Tag) graphql.Marshaler {
+			return ec.marshalNTag2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐTagᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_KnowledgeRevisionDiff_removedTags(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "KnowledgeRevisionDiff",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_Tag(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _KnowledgeSearchHit_knowledge(ctx context.Context, field graphql.CollectedField, obj *graphql1.KnowledgeSearchHit) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_KnowledgeSearchHit_knowledge(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Knowledge, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *graphql1.Knowledge) graphql.Marshaler {
+			return ec.marshalNKnowledge2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐKnowledge(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_KnowledgeSearchHit_knowledge(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "KnowledgeSearchHit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_Knowledge(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _KnowledgeSearchHit_score(ctx context.Context, field graphql.CollectedField, obj *graphql1.KnowledgeSearchHit) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_KnowledgeSearchHit_score(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Score, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v float64) graphql.Marshaler {
+			return ec.marshalNFloat2float64(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_KnowledgeSearchHit_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("KnowledgeSearchHit", field, false, false, errors.New("field of type Float does not have child fields"))
+}
+
+func (ec *executionContext) _KnowledgeSearchHit_lexicalScore(ctx context.Context, field graphql.CollectedField, obj *graphql1.KnowledgeSearchHit) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_KnowledgeSearchHit_lexicalScore(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.LexicalScore, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v float64) graphql.Marshaler {
+			return ec.marshalNFloat2float64(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_KnowledgeSearchHit_lexicalScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("KnowledgeSearchHit", field, false, false, errors.New("field of type Float does not have child fields"))
+}
+
+func (ec *executionContext) _KnowledgeSearchHit_vectorScore(ctx context.Context, field graphql.CollectedField, obj *graphql1.KnowledgeSearchHit) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_KnowledgeSearchHit_vectorScore(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.VectorScore, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *float64) graphql.Marshaler {
+			return ec.marshalOFloat2ᚖfloat64(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_KnowledgeSearchHit_vectorScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("KnowledgeSearchHit", field, false, false, errors.New("field of type Float does not have child fields"))
+}
+
 func (ec *executionContext) _Memo_id(ctx context.Context, field graphql.CollectedField, obj *graphql1.Memo) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -14706,6 +18319,50 @@ func (ec *executionContext) fieldContext_Mutation_reopenCase(ctx context.Context
 	return fc, nil
 }
 
+func (ec *executionContext) _Mutation_mergeCases(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Mutation_mergeCases(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().MergeCases(ctx, fc.Args["workspaceId"].(string), fc.Args["targetId"].(int), fc.Args["sourceIds"].([]int))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *graphql1.Case) graphql.Marshaler {
+			return ec.marshalNCase2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCase(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Mutation_mergeCases(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_Case(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_mergeCases_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Mutation_updateCaseStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -16510,6 +20167,50 @@ func (ec *executionContext) fieldContext_Mutation_deleteKnowledge(ctx context.Co
 	return fc, nil
 }
 
+func (ec *executionContext) _Mutation_restoreKnowledgeRevision(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Mutation_restoreKnowledgeRevision(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().RestoreKnowledgeRevision(ctx, fc.Args["workspaceId"].(string), fc.Args["id"].(string), fc.Args["revision"].(int))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *graphql1.Knowledge) graphql.Marshaler {
+			return ec.marshalNKnowledge2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐKnowledge(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Mutation_restoreKnowledgeRevision(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_Knowledge(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_restoreKnowledgeRevision_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Mutation_createTag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -16642,6 +20343,138 @@ func (ec *executionContext) fieldContext_Mutation_deleteTag(ctx context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _Mutation_createAPIToken(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Mutation_createAPIToken(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().CreateAPIToken(ctx, fc.Args["input"].(graphql1.CreateAPITokenInput))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *graphql1.CreatedAPIToken) graphql.Marshaler {
+			return ec.marshalNCreatedAPIToken2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCreatedAPIToken(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Mutation_createAPIToken(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_CreatedAPIToken(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createAPIToken_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_revokeAPIToken(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Mutation_revokeAPIToken(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().RevokeAPIToken(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v bool) graphql.Marshaler {
+			return ec.marshalNBoolean2bool(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Mutation_revokeAPIToken(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_revokeAPIToken_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_retryWebhookDelivery(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Mutation_retryWebhookDelivery(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Mutation().RetryWebhookDelivery(ctx, fc.Args["workspaceId"].(string), fc.Args["id"].(string))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *graphql1.WebhookDelivery) graphql.Marshaler {
+			return ec.marshalNWebhookDelivery2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐWebhookDelivery(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Mutation_retryWebhookDelivery(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_WebhookDelivery(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_retryWebhookDelivery_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Mutation_setFavoriteWorkspaces(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -17279,6 +21112,130 @@ func (ec *executionContext) fieldContext_NotionPageValidationResult_errorMessage
 	return graphql.NewScalarFieldContext("NotionPageValidationResult", field, false, false, errors.New("field of type String does not have child fields"))
 }
 
+func (ec *executionContext) _OpenSLABreach_workspaceId(ctx context.Context, field graphql.CollectedField, obj *graphql1.OpenSLABreach) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_OpenSLABreach_workspaceId(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.WorkspaceID, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_OpenSLABreach_workspaceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("OpenSLABreach", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _OpenSLABreach_workspaceName(ctx context.Context, field graphql.CollectedField, obj *graphql1.OpenSLABreach) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_OpenSLABreach_workspaceName(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.WorkspaceName, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_OpenSLABreach_workspaceName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("OpenSLABreach", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _OpenSLABreach_caseId(ctx context.Context, field graphql.CollectedField, obj *graphql1.OpenSLABreach) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_OpenSLABreach_caseId(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CaseID, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_OpenSLABreach_caseId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("OpenSLABreach", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _OpenSLABreach_caseTitle(ctx context.Context, field graphql.CollectedField, obj *graphql1.OpenSLABreach) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_OpenSLABreach_caseTitle(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CaseTitle, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_OpenSLABreach_caseTitle(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("OpenSLABreach", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _OpenSLABreach_breach(ctx context.Context, field graphql.CollectedField, obj *graphql1.OpenSLABreach) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_OpenSLABreach_breach(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Breach, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *graphql1.SLABreach) graphql.Marshaler {
+			return ec.marshalNSLABreach2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐSLABreach(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_OpenSLABreach_breach(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OpenSLABreach",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_SLABreach(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_health(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -17498,6 +21455,50 @@ func (ec *executionContext) fieldContext_Query_case(ctx context.Context, field g
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_searchCases(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query_searchCases(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().SearchCases(ctx, fc.Args["workspaceId"].(string), fc.Args["filter"].(*graphql1.CaseSearchFilter), fc.Args["sort"].(*graphql1.CaseSortInput), fc.Args["first"].(*int), fc.Args["after"].(*string))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *graphql1.CaseConnection) graphql.Marshaler {
+			return ec.marshalNCaseConnection2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCaseConnection(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Query_searchCases(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_CaseConnection(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_searchCases_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_drafts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -18002,6 +22003,82 @@ func (ec *executionContext) fieldContext_Query_slackJoinedChannels(_ context.Con
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_apiTokens(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query_apiTokens(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.Query().APITokens(ctx)
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*graphql1.APIToken) graphql.Marshaler {
+			return ec.marshalNAPIToken2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐAPITokenᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Query_apiTokens(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_APIToken(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_webhookDeliveries(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query_webhookDeliveries(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().WebhookDeliveries(ctx, fc.Args["workspaceId"].(string), fc.Args["status"].(*graphql1.WebhookDeliveryStatus), fc.Args["first"].(*int))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*graphql1.WebhookDelivery) graphql.Marshaler {
+			return ec.marshalNWebhookDelivery2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐWebhookDeliveryᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Query_webhookDeliveries(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_WebhookDelivery(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_webhookDeliveries_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_sources(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -18618,17 +22695,105 @@ func (ec *executionContext) fieldContext_Query_knowledge(ctx context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_searchKnowledge(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_knowledgeRevisions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
 		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return ec.fieldContext_Query_searchKnowledge(ctx, field)
+			return ec.fieldContext_Query_knowledgeRevisions(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().KnowledgeRevisions(ctx, fc.Args["workspaceId"].(string), fc.Args["id"].(string))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*graphql1.KnowledgeRevision) graphql.Marshaler {
+			return ec.marshalNKnowledgeRevision2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐKnowledgeRevisionᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Query_knowledgeRevisions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_KnowledgeRevision(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_knowledgeRevisions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_knowledgeRevisionDiff(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query_knowledgeRevisionDiff(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().KnowledgeRevisionDiff(ctx, fc.Args["workspaceId"].(string), fc.Args["id"].(string), fc.Args["from"].(int), fc.Args["to"].(int))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *graphql1.KnowledgeRevisionDiff) graphql.Marshaler {
+			return ec.marshalNKnowledgeRevisionDiff2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐKnowledgeRevisionDiff(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Query_knowledgeRevisionDiff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_KnowledgeRevisionDiff(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_knowledgeRevisionDiff_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_knowledgeDueForReview(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query_knowledgeDueForReview(ctx, field)
 		},
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().SearchKnowledge(ctx, fc.Args["workspaceId"].(string), fc.Args["query"].(string), fc.Args["tagIds"].([]string), fc.Args["limit"].(*int))
+			return ec.Resolvers.Query().KnowledgeDueForReview(ctx, fc.Args["workspaceId"].(string))
 		},
 		nil,
 		func(ctx context.Context, selections ast.SelectionSet, v []*graphql1.Knowledge) graphql.Marshaler {
@@ -18638,7 +22803,7 @@ func (ec *executionContext) _Query_searchKnowledge(ctx context.Context, field gr
 		true,
 	)
 }
-func (ec *executionContext) fieldContext_Query_searchKnowledge(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_knowledgeDueForReview(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -18655,6 +22820,50 @@ func (ec *executionContext) fieldContext_Query_searchKnowledge(ctx context.Conte
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_knowledgeDueForReview_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_searchKnowledge(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query_searchKnowledge(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().SearchKnowledge(ctx, fc.Args["workspaceId"].(string), fc.Args["query"].(string), fc.Args["tagIds"].([]string), fc.Args["limit"].(*int), fc.Args["includeExpired"].(*bool))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*graphql1.KnowledgeSearchHit) graphql.Marshaler {
+			return ec.marshalNKnowledgeSearchHit2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐKnowledgeSearchHitᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Query_searchKnowledge(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_KnowledgeSearchHit(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
 	if fc.Args, err = ec.field_Query_searchKnowledge_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
@@ -18814,6 +23023,38 @@ func (ec *executionContext) fieldContext_Query_myDueActions(_ context.Context, f
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_openSLABreaches(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Query_openSLABreaches(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.Query().OpenSLABreaches(ctx)
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v []*graphql1.OpenSLABreach) graphql.Marshaler {
+			return ec.marshalNOpenSLABreach2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐOpenSLABreachᚄ(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Query_openSLABreaches(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_OpenSLABreach(ctx, field)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_favoriteWorkspaceIds(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -18957,6 +23198,259 @@ func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field
 	return fc, nil
 }
 
+func (ec *executionContext) _SLABreach_kind(ctx context.Context, field graphql.CollectedField, obj *graphql1.SLABreach) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SLABreach_kind(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Kind, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v graphql1.SLAKind) graphql.Marshaler {
+			return ec.marshalNSLAKind2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐSLAKind(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SLABreach_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("SLABreach", field, false, false, errors.New("field of type SLAKind does not have child fields"))
+}
+
+func (ec *executionContext) _SLABreach_targetSeconds(ctx context.Context, field graphql.CollectedField, obj *graphql1.SLABreach) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SLABreach_targetSeconds(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TargetSeconds, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SLABreach_targetSeconds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("SLABreach", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _SLABreach_dueAt(ctx context.Context, field graphql.CollectedField, obj *graphql1.SLABreach) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SLABreach_dueAt(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.DueAt, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v time.Time) graphql.Marshaler {
+			return ec.marshalNTime2timeᚐTime(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SLABreach_dueAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("SLABreach", field, false, false, errors.New("field of type Time does not have child fields"))
+}
+
+func (ec *executionContext) _SLABreach_recordedAt(ctx context.Context, field graphql.CollectedField, obj *graphql1.SLABreach) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SLABreach_recordedAt(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.RecordedAt, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v time.Time) graphql.Marshaler {
+			return ec.marshalNTime2timeᚐTime(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SLABreach_recordedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("SLABreach", field, false, false, errors.New("field of type Time does not have child fields"))
+}
+
+func (ec *executionContext) _SLAClock_kind(ctx context.Context, field graphql.CollectedField, obj *graphql1.SLAClock) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SLAClock_kind(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Kind, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v graphql1.SLAKind) graphql.Marshaler {
+			return ec.marshalNSLAKind2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐSLAKind(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SLAClock_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("SLAClock", field, false, false, errors.New("field of type SLAKind does not have child fields"))
+}
+
+func (ec *executionContext) _SLAClock_targetSeconds(ctx context.Context, field graphql.CollectedField, obj *graphql1.SLAClock) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SLAClock_targetSeconds(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.TargetSeconds, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SLAClock_targetSeconds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("SLAClock", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _SLAClock_elapsedSeconds(ctx context.Context, field graphql.CollectedField, obj *graphql1.SLAClock) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SLAClock_elapsedSeconds(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ElapsedSeconds, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SLAClock_elapsedSeconds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("SLAClock", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _SLAClock_dueAt(ctx context.Context, field graphql.CollectedField, obj *graphql1.SLAClock) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SLAClock_dueAt(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.DueAt, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v time.Time) graphql.Marshaler {
+			return ec.marshalNTime2timeᚐTime(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SLAClock_dueAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("SLAClock", field, false, false, errors.New("field of type Time does not have child fields"))
+}
+
+func (ec *executionContext) _SLAClock_stoppedAt(ctx context.Context, field graphql.CollectedField, obj *graphql1.SLAClock) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SLAClock_stoppedAt(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.StoppedAt, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *time.Time) graphql.Marshaler {
+			return ec.marshalOTime2ᚖtimeᚐTime(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_SLAClock_stoppedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("SLAClock", field, false, false, errors.New("field of type Time does not have child fields"))
+}
+
+func (ec *executionContext) _SLAClock_paused(ctx context.Context, field graphql.CollectedField, obj *graphql1.SLAClock) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SLAClock_paused(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Paused, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v bool) graphql.Marshaler {
+			return ec.marshalNBoolean2bool(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SLAClock_paused(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("SLAClock", field, false, false, errors.New("field of type Boolean does not have child fields"))
+}
+
+func (ec *executionContext) _SLAClock_breached(ctx context.Context, field graphql.CollectedField, obj *graphql1.SLAClock) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_SLAClock_breached(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Breached, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v bool) graphql.Marshaler {
+			return ec.marshalNBoolean2bool(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_SLAClock_breached(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("SLAClock", field, false, false, errors.New("field of type Boolean does not have child fields"))
+}
+
 func (ec *executionContext) _SlackChannel_id(ctx context.Context, field graphql.CollectedField, obj *graphql1.SlackChannel) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -19812,6 +24306,138 @@ func (ec *executionContext) fieldContext_Source_updatedAt(_ context.Context, fie
 	return graphql.NewScalarFieldContext("Source", field, false, false, errors.New("field of type Time does not have child fields"))
 }
 
+func (ec *executionContext) _Subscription_caseChanged(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Subscription_caseChanged(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Subscription().CaseChanged(ctx, fc.Args["workspaceId"].(string))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *graphql1.CaseChangeEvent) graphql.Marshaler {
+			return ec.marshalNCaseChangeEvent2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCaseChangeEvent(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Subscription_caseChanged(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_CaseChangeEvent(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_caseChanged_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_actionChanged(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Subscription_actionChanged(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Subscription().ActionChanged(ctx, fc.Args["workspaceId"].(string), fc.Args["caseId"].(int))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *graphql1.ActionChangeEvent) graphql.Marshaler {
+			return ec.marshalNActionChangeEvent2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐActionChangeEvent(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Subscription_actionChanged(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_ActionChangeEvent(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_actionChanged_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_jobRunEventAdded(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_Subscription_jobRunEventAdded(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Subscription().JobRunEventAdded(ctx, fc.Args["workspaceId"].(string), fc.Args["caseId"].(int), fc.Args["runId"].(string), fc.Args["afterSequence"].(*int))
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *graphql1.JobRunEvent) graphql.Marshaler {
+			return ec.marshalNJobRunEvent2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐJobRunEvent(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_Subscription_jobRunEventAdded(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.childFields_JobRunEvent(ctx, field)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_jobRunEventAdded_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Tag_id(ctx context.Context, field graphql.CollectedField, obj *graphql1.Tag) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -19904,6 +24530,305 @@ func (ec *executionContext) fieldContext_Tag_updatedAt(_ context.Context, field
 	return graphql.NewScalarFieldContext("Tag", field, false, false, errors.New("field of type Time does not have child fields"))
 }
 
+func (ec *executionContext) _WebhookDelivery_id(ctx context.Context, field graphql.CollectedField, obj *graphql1.WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_WebhookDelivery_id(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNID2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_WebhookDelivery_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("WebhookDelivery", field, false, false, errors.New("field of type ID does not have child fields"))
+}
+
+func (ec *executionContext) _WebhookDelivery_webhookId(ctx context.Context, field graphql.CollectedField, obj *graphql1.WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_WebhookDelivery_webhookId(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.WebhookID, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_WebhookDelivery_webhookId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("WebhookDelivery", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _WebhookDelivery_event(ctx context.Context, field graphql.CollectedField, obj *graphql1.WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_WebhookDelivery_event(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Event, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_WebhookDelivery_event(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("WebhookDelivery", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _WebhookDelivery_caseId(ctx context.Context, field graphql.CollectedField, obj *graphql1.WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_WebhookDelivery_caseId(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CaseID, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_WebhookDelivery_caseId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("WebhookDelivery", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _WebhookDelivery_status(ctx context.Context, field graphql.CollectedField, obj *graphql1.WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_WebhookDelivery_status(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v graphql1.WebhookDeliveryStatus) graphql.Marshaler {
+			return ec.marshalNWebhookDeliveryStatus2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐWebhookDeliveryStatus(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_WebhookDelivery_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("WebhookDelivery", field, false, false, errors.New("field of type WebhookDeliveryStatus does not have child fields"))
+}
+
+func (ec *executionContext) _WebhookDelivery_payload(ctx context.Context, field graphql.CollectedField, obj *graphql1.WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_WebhookDelivery_payload(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Payload, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v string) graphql.Marshaler {
+			return ec.marshalNString2string(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_WebhookDelivery_payload(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("WebhookDelivery", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _WebhookDelivery_attempts(ctx context.Context, field graphql.CollectedField, obj *graphql1.WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_WebhookDelivery_attempts(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.Attempts, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v int) graphql.Marshaler {
+			return ec.marshalNInt2int(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_WebhookDelivery_attempts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("WebhookDelivery", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _WebhookDelivery_nextAttemptAt(ctx context.Context, field graphql.CollectedField, obj *graphql1.WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_WebhookDelivery_nextAttemptAt(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.NextAttemptAt, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *time.Time) graphql.Marshaler {
+			return ec.marshalOTime2ᚖtimeᚐTime(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_WebhookDelivery_nextAttemptAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("WebhookDelivery", field, false, false, errors.New("field of type Time does not have child fields"))
+}
+
+func (ec *executionContext) _WebhookDelivery_lastAttemptAt(ctx context.Context, field graphql.CollectedField, obj *graphql1.WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_WebhookDelivery_lastAttemptAt(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.LastAttemptAt, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *time.Time) graphql.Marshaler {
+			return ec.marshalOTime2ᚖtimeᚐTime(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_WebhookDelivery_lastAttemptAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("WebhookDelivery", field, false, false, errors.New("field of type Time does not have child fields"))
+}
+
+func (ec *executionContext) _WebhookDelivery_lastStatusCode(ctx context.Context, field graphql.CollectedField, obj *graphql1.WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_WebhookDelivery_lastStatusCode(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.LastStatusCode, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *int) graphql.Marshaler {
+			return ec.marshalOInt2ᚖint(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_WebhookDelivery_lastStatusCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("WebhookDelivery", field, false, false, errors.New("field of type Int does not have child fields"))
+}
+
+func (ec *executionContext) _WebhookDelivery_lastError(ctx context.Context, field graphql.CollectedField, obj *graphql1.WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_WebhookDelivery_lastError(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.LastError, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *string) graphql.Marshaler {
+			return ec.marshalOString2ᚖstring(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_WebhookDelivery_lastError(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("WebhookDelivery", field, false, false, errors.New("field of type String does not have child fields"))
+}
+
+func (ec *executionContext) _WebhookDelivery_deliveredAt(ctx context.Context, field graphql.CollectedField, obj *graphql1.WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_WebhookDelivery_deliveredAt(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.DeliveredAt, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v *time.Time) graphql.Marshaler {
+			return ec.marshalOTime2ᚖtimeᚐTime(ctx, selections, v)
+		},
+		true,
+		false,
+	)
+}
+func (ec *executionContext) fieldContext_WebhookDelivery_deliveredAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("WebhookDelivery", field, false, false, errors.New("field of type Time does not have child fields"))
+}
+
+func (ec *executionContext) _WebhookDelivery_createdAt(ctx context.Context, field graphql.CollectedField, obj *graphql1.WebhookDelivery) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return ec.fieldContext_WebhookDelivery_createdAt(ctx, field)
+		},
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		func(ctx context.Context, selections ast.SelectionSet, v time.Time) graphql.Marshaler {
+			return ec.marshalNTime2timeᚐTime(ctx, selections, v)
+		},
+		true,
+		true,
+	)
+}
+func (ec *executionContext) fieldContext_WebhookDelivery_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	return graphql.NewScalarFieldContext("WebhookDelivery", field, false, false, errors.New("field of type Time does not have child fields"))
+}
+
 func (ec *executionContext) _Workspace_id(ctx context.Context, field graphql.CollectedField, obj *graphql1.Workspace) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -21147,6 +26072,252 @@ func (ec *executionContext) unmarshalInputAddActionStepInput(ctx context.Context
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputCaseFieldFilterInput(ctx context.Context, obj any) (graphql1.CaseFieldFilterInput, error) {
+	var it graphql1.CaseFieldFilterInput
+	if obj == nil {
+		return it, nil
+	}
+
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"fieldId", "optionIds", "dateFrom", "dateTo", "numberMin", "numberMax"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "fieldId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fieldId"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FieldID = data
+		case "optionIds":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("optionIds"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OptionIds = data
+		case "dateFrom":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dateFrom"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DateFrom = data
+		case "dateTo":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dateTo"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DateTo = data
+		case "numberMin":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("numberMin"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.NumberMin = data
+		case "numberMax":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("numberMax"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.NumberMax = data
+		}
+	}
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCaseSearchFilter(ctx context.Context, obj any) (graphql1.CaseSearchFilter, error) {
+	var it graphql1.CaseSearchFilter
+	if obj == nil {
+		return it, nil
+	}
+
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"status", "assigneeId", "reporterId", "fields", "text", "createdFrom", "createdTo", "updatedFrom", "updatedTo", "isTest"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "status":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
+			data, err := ec.unmarshalOCaseStatus2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋtypesᚐCaseStatus(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Status = data
+		case "assigneeId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("assigneeId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AssigneeID = data
+		case "reporterId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reporterId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ReporterID = data
+		case "fields":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fields"))
+			data, err := ec.unmarshalOCaseFieldFilterInput2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCaseFieldFilterInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Fields = data
+		case "text":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("text"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Text = data
+		case "createdFrom":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("createdFrom"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CreatedFrom = data
+		case "createdTo":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("createdTo"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CreatedTo = data
+		case "updatedFrom":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("updatedFrom"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.UpdatedFrom = data
+		case "updatedTo":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("updatedTo"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.UpdatedTo = data
+		case "isTest":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isTest"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IsTest = data
+		}
+	}
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCaseSortInput(ctx context.Context, obj any) (graphql1.CaseSortInput, error) {
+	var it graphql1.CaseSortInput
+	if obj == nil {
+		return it, nil
+	}
+
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"key", "direction"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "key":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
+			data, err := ec.unmarshalNCaseSortKey2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCaseSortKey(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Key = data
+		case "direction":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("direction"))
+			data, err := ec.unmarshalNSortDirection2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐSortDirection(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Direction = data
+		}
+	}
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateAPITokenInput(ctx context.Context, obj any) (graphql1.CreateAPITokenInput, error) {
+	var it graphql1.CreateAPITokenInput
+	if obj == nil {
+		return it, nil
+	}
+
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name", "workspaceIds", "readOnly", "expiresInDays"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "workspaceIds":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("workspaceIds"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.WorkspaceIds = data
+		case "readOnly":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("readOnly"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ReadOnly = data
+		case "expiresInDays":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expiresInDays"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExpiresInDays = data
+		}
+	}
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputCreateActionCommentInput(ctx context.Context, obj any) (graphql1.CreateActionCommentInput, error) {
 	var it graphql1.CreateActionCommentInput
 	if obj == nil {
@@ -21195,7 +26366,7 @@ func (ec *executionContext) unmarshalInputCreateActionInput(ctx context.Context,
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"caseID", "title", "description", "assigneeID", "slackMessageTS", "status", "dueDate"}
+	fieldsInOrder := [...]string{"caseID", "title", "description", "assigneeID", "slackMessageTS", "status", "dueDate", "blockedBy"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -21251,6 +26422,13 @@ func (ec *executionContext) unmarshalInputCreateActionInput(ctx context.Context,
 				return it, err
 			}
 			it.DueDate = data
+		case "blockedBy":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("blockedBy"))
+			data, err := ec.unmarshalOInt2ᚕintᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.BlockedBy = data
 		}
 	}
 	return it, nil
@@ -21485,7 +26663,7 @@ func (ec *executionContext) unmarshalInputCreateKnowledgeInput(ctx context.Conte
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"title", "claim", "tagIds"}
+	fieldsInOrder := [...]string{"title", "claim", "tagIds", "validUntil", "reviewBy"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -21513,6 +26691,20 @@ func (ec *executionContext) unmarshalInputCreateKnowledgeInput(ctx context.Conte
 				return it, err
 			}
 			it.TagIds = data
+		case "validUntil":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("validUntil"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ValidUntil = data
+		case "reviewBy":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reviewBy"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ReviewBy = data
 		}
 	}
 	return it, nil
@@ -22034,7 +27226,7 @@ func (ec *executionContext) unmarshalInputUpdateActionInput(ctx context.Context,
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"id", "caseID", "title", "description", "assigneeID", "slackMessageTS", "status", "dueDate", "clearDueDate", "clearAssignee"}
+	fieldsInOrder := [...]string{"id", "caseID", "title", "description", "assigneeID", "slackMessageTS", "status", "dueDate", "clearDueDate", "clearAssignee", "blockedBy"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -22111,6 +27303,13 @@ func (ec *executionContext) unmarshalInputUpdateActionInput(ctx context.Context,
 				return it, err
 			}
 			it.ClearAssignee = data
+		case "blockedBy":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("blockedBy"))
+			data, err := ec.unmarshalOInt2ᚕintᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.BlockedBy = data
 		}
 	}
 	return it, nil
@@ -22324,7 +27523,7 @@ func (ec *executionContext) unmarshalInputUpdateKnowledgeInput(ctx context.Conte
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"id", "title", "claim", "tagIds"}
+	fieldsInOrder := [...]string{"id", "title", "claim", "tagIds", "validUntil", "reviewBy", "clearValidUntil", "clearReviewBy"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -22359,6 +27558,34 @@ func (ec *executionContext) unmarshalInputUpdateKnowledgeInput(ctx context.Conte
 				return it, err
 			}
 			it.TagIds = data
+		case "validUntil":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("validUntil"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ValidUntil = data
+		case "reviewBy":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reviewBy"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ReviewBy = data
+		case "clearValidUntil":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clearValidUntil"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ClearValidUntil = data
+		case "clearReviewBy":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clearReviewBy"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ClearReviewBy = data
 		}
 	}
 	return it, nil
@@ -22703,6 +27930,79 @@ func (ec *executionContext) _SourceConfig(ctx context.Context, sel ast.Selection
 
 // region    **************************** object.gotpl ****************************
 
+var aPITokenImplementors = []string{"APIToken"}
+
+func (ec *executionContext) _APIToken(ctx context.Context, sel ast.SelectionSet, obj *graphql1.APIToken) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, aPITokenImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferredFieldSet := graphql.NewFieldSet(nil)
+	deferLabelToView := make(map[string]*graphql.FieldSetView)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("APIToken")
+		case "id":
+			out.Values[i] = ec._APIToken_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._APIToken_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "workspaceIds":
+			out.Values[i] = ec._APIToken_workspaceIds(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		case "readOnly":
+			out.Values[i] = ec._APIToken_readOnly(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "expiresAt":
+			out.Values[i] = ec._APIToken_expiresAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._APIToken_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "lastUsedAt":
+			out.Values[i] = ec._APIToken_lastUsedAt(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		case "expired":
+			out.Values[i] = ec._APIToken_expired(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
+
+	ec.ProcessDeferredGroup(graphql.DeferredGroup{
+		Defers:   deferLabelToView,
+		Path:     graphql.GetPath(ctx),
+		FieldSet: deferredFieldSet,
+		Context:  ctx,
+	})
+
+	return out
+}
+
 var actionImplementors = []string{"Action"}
 
 func (ec *executionContext) _Action(ctx context.Context, sel ast.SelectionSet, obj *graphql1.Action) graphql.Marshaler {
@@ -23045,6 +28345,125 @@ func (ec *executionContext) _Action(ctx context.Context, sel ast.SelectionSet, o
 				continue
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "blockedByIDs":
+			out.Values[i] = ec._Action_blockedByIDs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "blockedBy":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Action_blockedBy(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.IsDeferred() {
+				deferredFieldSet.AddField(field)
+				fieldIndex := len(deferredFieldSet.Values) - 1
+				deferredFieldSet.Concurrently(fieldIndex, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, deferredFieldSet)
+				})
+
+				for _, deferrable := range field.Deferrables {
+					view, ok := deferLabelToView[deferrable.Label]
+					if !ok {
+						view = deferredFieldSet.NewView()
+						deferLabelToView[deferrable.Label] = view
+					}
+					view.AddIndices(fieldIndex)
+				}
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "blocking":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Action_blocking(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.IsDeferred() {
+				deferredFieldSet.AddField(field)
+				fieldIndex := len(deferredFieldSet.Values) - 1
+				deferredFieldSet.Concurrently(fieldIndex, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, deferredFieldSet)
+				})
+
+				for _, deferrable := range field.Deferrables {
+					view, ok := deferLabelToView[deferrable.Label]
+					if !ok {
+						view = deferredFieldSet.NewView()
+						deferLabelToView[deferrable.Label] = view
+					}
+					view.AddIndices(fieldIndex)
+				}
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "blocked":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Action_blocked(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.IsDeferred() {
+				deferredFieldSet.AddField(field)
+				fieldIndex := len(deferredFieldSet.Values) - 1
+				deferredFieldSet.Concurrently(fieldIndex, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, deferredFieldSet)
+				})
+
+				for _, deferrable := range field.Deferrables {
+					view, ok := deferLabelToView[deferrable.Label]
+					if !ok {
+						view = deferredFieldSet.NewView()
+						deferLabelToView[deferrable.Label] = view
+					}
+					view.AddIndices(fieldIndex)
+				}
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -23067,6 +28486,54 @@ func (ec *executionContext) _Action(ctx context.Context, sel ast.SelectionSet, o
 	return out
 }
 
+var actionChangeEventImplementors = []string{"ActionChangeEvent"}
+
+func (ec *executionContext) _ActionChangeEvent(ctx context.Context, sel ast.SelectionSet, obj *graphql1.ActionChangeEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, actionChangeEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferredFieldSet := graphql.NewFieldSet(nil)
+	deferLabelToView := make(map[string]*graphql.FieldSetView)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ActionChangeEvent")
+		case "kind":
+			out.Values[i] = ec._ActionChangeEvent_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "actionId":
+			out.Values[i] = ec._ActionChangeEvent_actionId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "action":
+			out.Values[i] = ec._ActionChangeEvent_action(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
+
+	ec.ProcessDeferredGroup(graphql.DeferredGroup{
+		Defers:   deferLabelToView,
+		Path:     graphql.GetPath(ctx),
+		FieldSet: deferredFieldSet,
+		Context:  ctx,
+	})
+
+	return out
+}
+
 var actionCommentImplementors = []string{"ActionComment"}
 
 func (ec *executionContext) _ActionComment(ctx context.Context, sel ast.SelectionSet, obj *graphql1.ActionComment) graphql.Marshaler {
@@ -23602,6 +29069,69 @@ func (ec *executionContext) _ActionStepProgress(ctx context.Context, sel ast.Sel
 	return out
 }
 
+var alertImplementors = []string{"Alert"}
+
+func (ec *executionContext) _Alert(ctx context.Context, sel ast.SelectionSet, obj *graphql1.Alert) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, alertImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferredFieldSet := graphql.NewFieldSet(nil)
+	deferLabelToView := make(map[string]*graphql.FieldSetView)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Alert")
+		case "id":
+			out.Values[i] = ec._Alert_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "title":
+			out.Values[i] = ec._Alert_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fingerprint":
+			out.Values[i] = ec._Alert_fingerprint(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "payload":
+			out.Values[i] = ec._Alert_payload(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "repeat":
+			out.Values[i] = ec._Alert_repeat(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "receivedAt":
+			out.Values[i] = ec._Alert_receivedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
+
+	ec.ProcessDeferredGroup(graphql.DeferredGroup{
+		Defers:   deferLabelToView,
+		Path:     graphql.GetPath(ctx),
+		FieldSet: deferredFieldSet,
+		Context:  ctx,
+	})
+
+	return out
+}
+
 var assistLogImplementors = []string{"AssistLog"}
 
 func (ec *executionContext) _AssistLog(ctx context.Context, sel ast.SelectionSet, obj *graphql1.AssistLog) graphql.Marshaler {
@@ -23808,7 +29338,174 @@ func (ec *executionContext) _Case(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "channelUsers":
+		case "channelUsers":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Case_channelUsers(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.IsDeferred() {
+				deferredFieldSet.AddField(field)
+				fieldIndex := len(deferredFieldSet.Values) - 1
+				deferredFieldSet.Concurrently(fieldIndex, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, deferredFieldSet)
+				})
+
+				for _, deferrable := range field.Deferrables {
+					view, ok := deferLabelToView[deferrable.Label]
+					if !ok {
+						view = deferredFieldSet.NewView()
+						deferLabelToView[deferrable.Label] = view
+					}
+					view.AddIndices(fieldIndex)
+				}
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "reporterID":
+			out.Values[i] = ec._Case_reporterID(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "reporter":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Case_reporter(ctx, field, obj)
+				if res == graphql.RequiredNull {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.IsDeferred() {
+				deferredFieldSet.AddField(field)
+				fieldIndex := len(deferredFieldSet.Values) - 1
+				deferredFieldSet.Concurrently(fieldIndex, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, deferredFieldSet)
+				})
+
+				for _, deferrable := range field.Deferrables {
+					view, ok := deferLabelToView[deferrable.Label]
+					if !ok {
+						view = deferredFieldSet.NewView()
+						deferLabelToView[deferrable.Label] = view
+					}
+					view.AddIndices(fieldIndex)
+				}
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "assigneeIDs":
+			out.Values[i] = ec._Case_assigneeIDs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "assignees":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Case_assignees(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.IsDeferred() {
+				deferredFieldSet.AddField(field)
+				fieldIndex := len(deferredFieldSet.Values) - 1
+				deferredFieldSet.Concurrently(fieldIndex, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, deferredFieldSet)
+				})
+
+				for _, deferrable := range field.Deferrables {
+					view, ok := deferLabelToView[deferrable.Label]
+					if !ok {
+						view = deferredFieldSet.NewView()
+						deferLabelToView[deferrable.Label] = view
+					}
+					view.AddIndices(fieldIndex)
+				}
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "slackChannelID":
+			out.Values[i] = ec._Case_slackChannelID(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "slackChannelName":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Case_slackChannelName(ctx, field, obj)
+				if res == graphql.RequiredNull {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.IsDeferred() {
+				deferredFieldSet.AddField(field)
+				fieldIndex := len(deferredFieldSet.Values) - 1
+				deferredFieldSet.Concurrently(fieldIndex, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, deferredFieldSet)
+				})
+
+				for _, deferrable := range field.Deferrables {
+					view, ok := deferLabelToView[deferrable.Label]
+					if !ok {
+						view = deferredFieldSet.NewView()
+						deferLabelToView[deferrable.Label] = view
+					}
+					view.AddIndices(fieldIndex)
+				}
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "slackChannelURL":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -23817,8 +29514,8 @@ func (ec *executionContext) _Case(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Case_channelUsers(ctx, field, obj)
-				if res == graphql.Null {
+				res = ec._Case_slackChannelURL(ctx, field, obj)
+				if res == graphql.RequiredNull {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -23846,12 +29543,27 @@ func (ec *executionContext) _Case(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "reporterID":
-			out.Values[i] = ec._Case_reporterID(ctx, field, obj)
+		case "slackThreadTS":
+			out.Values[i] = ec._Case_slackThreadTS(ctx, field, obj)
 			if out.Values[i] == graphql.RequiredNull {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "reporter":
+		case "isThreadBound":
+			out.Values[i] = ec._Case_isThreadBound(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "boardStatus":
+			out.Values[i] = ec._Case_boardStatus(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "mergedIntoID":
+			out.Values[i] = ec._Case_mergedIntoID(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "duplicateCandidates":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -23860,8 +29572,8 @@ func (ec *executionContext) _Case(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Case_reporter(ctx, field, obj)
-				if res == graphql.RequiredNull {
+				res = ec._Case_duplicateCandidates(ctx, field, obj)
+				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -23889,12 +29601,7 @@ func (ec *executionContext) _Case(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "assigneeIDs":
-			out.Values[i] = ec._Case_assigneeIDs(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "assignees":
+		case "fields":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -23903,7 +29610,7 @@ func (ec *executionContext) _Case(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Case_assignees(ctx, field, obj)
+				res = ec._Case_fields(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -23932,12 +29639,7 @@ func (ec *executionContext) _Case(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "slackChannelID":
-			out.Values[i] = ec._Case_slackChannelID(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "slackChannelName":
+		case "actions":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -23946,8 +29648,8 @@ func (ec *executionContext) _Case(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Case_slackChannelName(ctx, field, obj)
-				if res == graphql.RequiredNull {
+				res = ec._Case_actions(ctx, field, obj)
+				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -23975,7 +29677,7 @@ func (ec *executionContext) _Case(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "slackChannelURL":
+		case "slackMessages":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -23984,8 +29686,8 @@ func (ec *executionContext) _Case(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Case_slackChannelURL(ctx, field, obj)
-				if res == graphql.RequiredNull {
+				res = ec._Case_slackMessages(ctx, field, obj)
+				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
@@ -24013,22 +29715,7 @@ func (ec *executionContext) _Case(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "slackThreadTS":
-			out.Values[i] = ec._Case_slackThreadTS(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "isThreadBound":
-			out.Values[i] = ec._Case_isThreadBound(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "boardStatus":
-			out.Values[i] = ec._Case_boardStatus(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "fields":
+		case "alerts":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -24037,7 +29724,7 @@ func (ec *executionContext) _Case(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Case_fields(ctx, field, obj)
+				res = ec._Case_alerts(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -24066,7 +29753,7 @@ func (ec *executionContext) _Case(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "actions":
+		case "slaClocks":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -24075,7 +29762,7 @@ func (ec *executionContext) _Case(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Case_actions(ctx, field, obj)
+				res = ec._Case_slaClocks(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -24104,7 +29791,7 @@ func (ec *executionContext) _Case(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "slackMessages":
+		case "slaBreaches":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -24113,7 +29800,7 @@ func (ec *executionContext) _Case(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Case_slackMessages(ctx, field, obj)
+				res = ec._Case_slaBreaches(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -24216,6 +29903,97 @@ func (ec *executionContext) _Case(ctx context.Context, sel ast.SelectionSet, obj
 	return out
 }
 
+var caseChangeEventImplementors = []string{"CaseChangeEvent"}
+
+func (ec *executionContext) _CaseChangeEvent(ctx context.Context, sel ast.SelectionSet, obj *graphql1.CaseChangeEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, caseChangeEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferredFieldSet := graphql.NewFieldSet(nil)
+	deferLabelToView := make(map[string]*graphql.FieldSetView)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CaseChangeEvent")
+		case "kind":
+			out.Values[i] = ec._CaseChangeEvent_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "caseId":
+			out.Values[i] = ec._CaseChangeEvent_caseId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "case":
+			out.Values[i] = ec._CaseChangeEvent_case(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
+
+	ec.ProcessDeferredGroup(graphql.DeferredGroup{
+		Defers:   deferLabelToView,
+		Path:     graphql.GetPath(ctx),
+		FieldSet: deferredFieldSet,
+		Context:  ctx,
+	})
+
+	return out
+}
+
+var caseConnectionImplementors = []string{"CaseConnection"}
+
+func (ec *executionContext) _CaseConnection(ctx context.Context, sel ast.SelectionSet, obj *graphql1.CaseConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, caseConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferredFieldSet := graphql.NewFieldSet(nil)
+	deferLabelToView := make(map[string]*graphql.FieldSetView)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CaseConnection")
+		case "items":
+			out.Values[i] = ec._CaseConnection_items(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "nextCursor":
+			out.Values[i] = ec._CaseConnection_nextCursor(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
+
+	ec.ProcessDeferredGroup(graphql.DeferredGroup{
+		Defers:   deferLabelToView,
+		Path:     graphql.GetPath(ctx),
+		FieldSet: deferredFieldSet,
+		Context:  ctx,
+	})
+
+	return out
+}
+
 var caseJobImplementors = []string{"CaseJob"}
 
 func (ec *executionContext) _CaseJob(ctx context.Context, sel ast.SelectionSet, obj *graphql1.CaseJob) graphql.Marshaler {
@@ -24390,6 +30168,135 @@ func (ec *executionContext) _ChannelUserConnection(ctx context.Context, sel ast.
 	return out
 }
 
+var createdAPITokenImplementors = []string{"CreatedAPIToken"}
+
+func (ec *executionContext) _CreatedAPIToken(ctx context.Context, sel ast.SelectionSet, obj *graphql1.CreatedAPIToken) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, createdAPITokenImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferredFieldSet := graphql.NewFieldSet(nil)
+	deferLabelToView := make(map[string]*graphql.FieldSetView)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CreatedAPIToken")
+		case "token":
+			out.Values[i] = ec._CreatedAPIToken_token(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "secret":
+			out.Values[i] = ec._CreatedAPIToken_secret(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
+
+	ec.ProcessDeferredGroup(graphql.DeferredGroup{
+		Defers:   deferLabelToView,
+		Path:     graphql.GetPath(ctx),
+		FieldSet: deferredFieldSet,
+		Context:  ctx,
+	})
+
+	return out
+}
+
+var diffLineImplementors = []string{"DiffLine"}
+
+func (ec *executionContext) _DiffLine(ctx context.Context, sel ast.SelectionSet, obj *graphql1.DiffLine) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, diffLineImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferredFieldSet := graphql.NewFieldSet(nil)
+	deferLabelToView := make(map[string]*graphql.FieldSetView)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DiffLine")
+		case "op":
+			out.Values[i] = ec._DiffLine_op(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "text":
+			out.Values[i] = ec._DiffLine_text(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
+
+	ec.ProcessDeferredGroup(graphql.DeferredGroup{
+		Defers:   deferLabelToView,
+		Path:     graphql.GetPath(ctx),
+		FieldSet: deferredFieldSet,
+		Context:  ctx,
+	})
+
+	return out
+}
+
+var duplicateCandidateImplementors = []string{"DuplicateCandidate"}
+
+func (ec *executionContext) _DuplicateCandidate(ctx context.Context, sel ast.SelectionSet, obj *graphql1.DuplicateCandidate) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, duplicateCandidateImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferredFieldSet := graphql.NewFieldSet(nil)
+	deferLabelToView := make(map[string]*graphql.FieldSetView)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DuplicateCandidate")
+		case "case":
+			out.Values[i] = ec._DuplicateCandidate_case(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "score":
+			out.Values[i] = ec._DuplicateCandidate_score(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
+
+	ec.ProcessDeferredGroup(graphql.DeferredGroup{
+		Defers:   deferLabelToView,
+		Path:     graphql.GetPath(ctx),
+		FieldSet: deferredFieldSet,
+		Context:  ctx,
+	})
+
+	return out
+}
+
 var entityLabelsImplementors = []string{"EntityLabels"}
 
 func (ec *executionContext) _EntityLabels(ctx context.Context, sel ast.SelectionSet, obj *graphql1.EntityLabels) graphql.Marshaler {
@@ -25628,10 +31535,315 @@ func (ec *executionContext) _JobRunLogConnection(ctx context.Context, sel ast.Se
 	return out
 }
 
-var jobScheduleImplementors = []string{"JobSchedule"}
+var jobScheduleImplementors = []string{"JobSchedule"}
+
+func (ec *executionContext) _JobSchedule(ctx context.Context, sel ast.SelectionSet, obj *graphql1.JobSchedule) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, jobScheduleImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferredFieldSet := graphql.NewFieldSet(nil)
+	deferLabelToView := make(map[string]*graphql.FieldSetView)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("JobSchedule")
+		case "everySeconds":
+			out.Values[i] = ec._JobSchedule_everySeconds(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		case "cron":
+			out.Values[i] = ec._JobSchedule_cron(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
+
+	ec.ProcessDeferredGroup(graphql.DeferredGroup{
+		Defers:   deferLabelToView,
+		Path:     graphql.GetPath(ctx),
+		FieldSet: deferredFieldSet,
+		Context:  ctx,
+	})
+
+	return out
+}
+
+var jobTriggerImplementors = []string{"JobTrigger"}
+
+func (ec *executionContext) _JobTrigger(ctx context.Context, sel ast.SelectionSet, obj *graphql1.JobTrigger) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, jobTriggerImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferredFieldSet := graphql.NewFieldSet(nil)
+	deferLabelToView := make(map[string]*graphql.FieldSetView)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("JobTrigger")
+		case "caseEvents":
+			out.Values[i] = ec._JobTrigger_caseEvents(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "caseField":
+			out.Values[i] = ec._JobTrigger_caseField(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		case "caseTo":
+			out.Values[i] = ec._JobTrigger_caseTo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "schedule":
+			out.Values[i] = ec._JobTrigger_schedule(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
+
+	ec.ProcessDeferredGroup(graphql.DeferredGroup{
+		Defers:   deferLabelToView,
+		Path:     graphql.GetPath(ctx),
+		FieldSet: deferredFieldSet,
+		Context:  ctx,
+	})
+
+	return out
+}
+
+var knowledgeImplementors = []string{"Knowledge"}
+
+func (ec *executionContext) _Knowledge(ctx context.Context, sel ast.SelectionSet, obj *graphql1.Knowledge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, knowledgeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferredFieldSet := graphql.NewFieldSet(nil)
+	deferLabelToView := make(map[string]*graphql.FieldSetView)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Knowledge")
+		case "id":
+			out.Values[i] = ec._Knowledge_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "title":
+			out.Values[i] = ec._Knowledge_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "claim":
+			out.Values[i] = ec._Knowledge_claim(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "tags":
+			out.Values[i] = ec._Knowledge_tags(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "validUntil":
+			out.Values[i] = ec._Knowledge_validUntil(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		case "reviewBy":
+			out.Values[i] = ec._Knowledge_reviewBy(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		case "reviewFlaggedAt":
+			out.Values[i] = ec._Knowledge_reviewFlaggedAt(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		case "expired":
+			out.Values[i] = ec._Knowledge_expired(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reviewDue":
+			out.Values[i] = ec._Knowledge_reviewDue(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._Knowledge_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updatedAt":
+			out.Values[i] = ec._Knowledge_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
+
+	ec.ProcessDeferredGroup(graphql.DeferredGroup{
+		Defers:   deferLabelToView,
+		Path:     graphql.GetPath(ctx),
+		FieldSet: deferredFieldSet,
+		Context:  ctx,
+	})
+
+	return out
+}
+
+var knowledgeRevisionImplementors = []string{"KnowledgeRevision"}
+
+func (ec *executionContext) _KnowledgeRevision(ctx context.Context, sel ast.SelectionSet, obj *graphql1.KnowledgeRevision) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, knowledgeRevisionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferredFieldSet := graphql.NewFieldSet(nil)
+	deferLabelToView := make(map[string]*graphql.FieldSetView)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("KnowledgeRevision")
+		case "revision":
+			out.Values[i] = ec._KnowledgeRevision_revision(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "action":
+			out.Values[i] = ec._KnowledgeRevision_action(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "title":
+			out.Values[i] = ec._KnowledgeRevision_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "claim":
+			out.Values[i] = ec._KnowledgeRevision_claim(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "tags":
+			out.Values[i] = ec._KnowledgeRevision_tags(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "editorID":
+			out.Values[i] = ec._KnowledgeRevision_editorID(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "editor":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._KnowledgeRevision_editor(ctx, field, obj)
+				if res == graphql.RequiredNull {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.IsDeferred() {
+				deferredFieldSet.AddField(field)
+				fieldIndex := len(deferredFieldSet.Values) - 1
+				deferredFieldSet.Concurrently(fieldIndex, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, deferredFieldSet)
+				})
+
+				for _, deferrable := range field.Deferrables {
+					view, ok := deferLabelToView[deferrable.Label]
+					if !ok {
+						view = deferredFieldSet.NewView()
+						deferLabelToView[deferrable.Label] = view
+					}
+					view.AddIndices(fieldIndex)
+				}
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "jobID":
+			out.Values[i] = ec._KnowledgeRevision_jobID(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "runID":
+			out.Values[i] = ec._KnowledgeRevision_runID(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "restoredFrom":
+			out.Values[i] = ec._KnowledgeRevision_restoredFrom(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "createdAt":
+			out.Values[i] = ec._KnowledgeRevision_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
+
+	ec.ProcessDeferredGroup(graphql.DeferredGroup{
+		Defers:   deferLabelToView,
+		Path:     graphql.GetPath(ctx),
+		FieldSet: deferredFieldSet,
+		Context:  ctx,
+	})
+
+	return out
+}
+
+var knowledgeRevisionDiffImplementors = []string{"KnowledgeRevisionDiff"}
 
-func (ec *executionContext) _JobSchedule(ctx context.Context, sel ast.SelectionSet, obj *graphql1.JobSchedule) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, jobScheduleImplementors)
+func (ec *executionContext) _KnowledgeRevisionDiff(ctx context.Context, sel ast.SelectionSet, obj *graphql1.KnowledgeRevisionDiff) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, knowledgeRevisionDiffImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferredFieldSet := graphql.NewFieldSet(nil)
@@ -25639,58 +31851,35 @@ func (ec *executionContext) _JobSchedule(ctx context.Context, sel ast.SelectionS
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("JobSchedule")
-		case "everySeconds":
-			out.Values[i] = ec._JobSchedule_everySeconds(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+			out.Values[i] = graphql.MarshalString("KnowledgeRevisionDiff")
+		case "from":
+			out.Values[i] = ec._KnowledgeRevisionDiff_from(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "cron":
-			out.Values[i] = ec._JobSchedule_cron(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+		case "to":
+			out.Values[i] = ec._KnowledgeRevisionDiff_to(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
-
-	ec.ProcessDeferredGroup(graphql.DeferredGroup{
-		Defers:   deferLabelToView,
-		Path:     graphql.GetPath(ctx),
-		FieldSet: deferredFieldSet,
-		Context:  ctx,
-	})
-
-	return out
-}
-
-var jobTriggerImplementors = []string{"JobTrigger"}
-
-func (ec *executionContext) _JobTrigger(ctx context.Context, sel ast.SelectionSet, obj *graphql1.JobTrigger) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, jobTriggerImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferredFieldSet := graphql.NewFieldSet(nil)
-	deferLabelToView := make(map[string]*graphql.FieldSetView)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("JobTrigger")
-		case "caseEvents":
-			out.Values[i] = ec._JobTrigger_caseEvents(ctx, field, obj)
+		case "title":
+			out.Values[i] = ec._KnowledgeRevisionDiff_title(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "schedule":
-			out.Values[i] = ec._JobTrigger_schedule(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
+		case "claim":
+			out.Values[i] = ec._KnowledgeRevisionDiff_claim(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "addedTags":
+			out.Values[i] = ec._KnowledgeRevisionDiff_addedTags(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "removedTags":
+			out.Values[i] = ec._KnowledgeRevisionDiff_removedTags(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		default:
@@ -25714,10 +31903,10 @@ func (ec *executionContext) _JobTrigger(ctx context.Context, sel ast.SelectionSe
 	return out
 }
 
-var knowledgeImplementors = []string{"Knowledge"}
+var knowledgeSearchHitImplementors = []string{"KnowledgeSearchHit"}
 
-func (ec *executionContext) _Knowledge(ctx context.Context, sel ast.SelectionSet, obj *graphql1.Knowledge) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, knowledgeImplementors)
+func (ec *executionContext) _KnowledgeSearchHit(ctx context.Context, sel ast.SelectionSet, obj *graphql1.KnowledgeSearchHit) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, knowledgeSearchHitImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferredFieldSet := graphql.NewFieldSet(nil)
@@ -25725,35 +31914,25 @@ func (ec *executionContext) _Knowledge(ctx context.Context, sel ast.SelectionSet
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Knowledge")
-		case "id":
-			out.Values[i] = ec._Knowledge_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "title":
-			out.Values[i] = ec._Knowledge_title(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("KnowledgeSearchHit")
+		case "knowledge":
+			out.Values[i] = ec._KnowledgeSearchHit_knowledge(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "claim":
-			out.Values[i] = ec._Knowledge_claim(ctx, field, obj)
+		case "score":
+			out.Values[i] = ec._KnowledgeSearchHit_score(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "tags":
-			out.Values[i] = ec._Knowledge_tags(ctx, field, obj)
+		case "lexicalScore":
+			out.Values[i] = ec._KnowledgeSearchHit_lexicalScore(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "createdAt":
-			out.Values[i] = ec._Knowledge_createdAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "updatedAt":
-			out.Values[i] = ec._Knowledge_updatedAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "vectorScore":
+			out.Values[i] = ec._KnowledgeSearchHit_vectorScore(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
 		default:
@@ -26035,6 +32214,13 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "mergeCases":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_mergeCases(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "updateCaseStatus":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_updateCaseStatus(ctx, field)
@@ -26322,6 +32508,13 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "restoreKnowledgeRevision":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_restoreKnowledgeRevision(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "createTag":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_createTag(ctx, field)
@@ -26343,6 +32536,27 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "createAPIToken":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createAPIToken(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "revokeAPIToken":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_revokeAPIToken(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "retryWebhookDelivery":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_retryWebhookDelivery(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "setFavoriteWorkspaces":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_setFavoriteWorkspaces(ctx, field)
@@ -26371,10 +32585,169 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 	return out
 }
 
-var myDueActionImplementors = []string{"MyDueAction"}
+var myDueActionImplementors = []string{"MyDueAction"}
+
+func (ec *executionContext) _MyDueAction(ctx context.Context, sel ast.SelectionSet, obj *graphql1.MyDueAction) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, myDueActionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferredFieldSet := graphql.NewFieldSet(nil)
+	deferLabelToView := make(map[string]*graphql.FieldSetView)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MyDueAction")
+		case "workspaceId":
+			out.Values[i] = ec._MyDueAction_workspaceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "workspaceName":
+			out.Values[i] = ec._MyDueAction_workspaceName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "action":
+			out.Values[i] = ec._MyDueAction_action(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "caseId":
+			out.Values[i] = ec._MyDueAction_caseId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "caseTitle":
+			out.Values[i] = ec._MyDueAction_caseTitle(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
+
+	ec.ProcessDeferredGroup(graphql.DeferredGroup{
+		Defers:   deferLabelToView,
+		Path:     graphql.GetPath(ctx),
+		FieldSet: deferredFieldSet,
+		Context:  ctx,
+	})
+
+	return out
+}
+
+var myOpenCaseImplementors = []string{"MyOpenCase"}
+
+func (ec *executionContext) _MyOpenCase(ctx context.Context, sel ast.SelectionSet, obj *graphql1.MyOpenCase) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, myOpenCaseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferredFieldSet := graphql.NewFieldSet(nil)
+	deferLabelToView := make(map[string]*graphql.FieldSetView)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MyOpenCase")
+		case "workspaceId":
+			out.Values[i] = ec._MyOpenCase_workspaceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "workspaceName":
+			out.Values[i] = ec._MyOpenCase_workspaceName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "case":
+			out.Values[i] = ec._MyOpenCase_case(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "stalled":
+			out.Values[i] = ec._MyOpenCase_stalled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
+
+	ec.ProcessDeferredGroup(graphql.DeferredGroup{
+		Defers:   deferLabelToView,
+		Path:     graphql.GetPath(ctx),
+		FieldSet: deferredFieldSet,
+		Context:  ctx,
+	})
+
+	return out
+}
+
+var notionDBConfigImplementors = []string{"NotionDBConfig", "SourceConfig"}
+
+func (ec *executionContext) _NotionDBConfig(ctx context.Context, sel ast.SelectionSet, obj *graphql1.NotionDBConfig) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, notionDBConfigImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferredFieldSet := graphql.NewFieldSet(nil)
+	deferLabelToView := make(map[string]*graphql.FieldSetView)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("NotionDBConfig")
+		case "databaseID":
+			out.Values[i] = ec._NotionDBConfig_databaseID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "databaseTitle":
+			out.Values[i] = ec._NotionDBConfig_databaseTitle(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "databaseURL":
+			out.Values[i] = ec._NotionDBConfig_databaseURL(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
+
+	ec.ProcessDeferredGroup(graphql.DeferredGroup{
+		Defers:   deferLabelToView,
+		Path:     graphql.GetPath(ctx),
+		FieldSet: deferredFieldSet,
+		Context:  ctx,
+	})
+
+	return out
+}
+
+var notionDBValidationResultImplementors = []string{"NotionDBValidationResult"}
 
-func (ec *executionContext) _MyDueAction(ctx context.Context, sel ast.SelectionSet, obj *graphql1.MyDueAction) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, myDueActionImplementors)
+func (ec *executionContext) _NotionDBValidationResult(ctx context.Context, sel ast.SelectionSet, obj *graphql1.NotionDBValidationResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, notionDBValidationResultImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferredFieldSet := graphql.NewFieldSet(nil)
@@ -26382,30 +32755,25 @@ func (ec *executionContext) _MyDueAction(ctx context.Context, sel ast.SelectionS
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("MyDueAction")
-		case "workspaceId":
-			out.Values[i] = ec._MyDueAction_workspaceId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "workspaceName":
-			out.Values[i] = ec._MyDueAction_workspaceName(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("NotionDBValidationResult")
+		case "valid":
+			out.Values[i] = ec._NotionDBValidationResult_valid(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "action":
-			out.Values[i] = ec._MyDueAction_action(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "databaseTitle":
+			out.Values[i] = ec._NotionDBValidationResult_databaseTitle(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
-		case "caseId":
-			out.Values[i] = ec._MyDueAction_caseId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "databaseURL":
+			out.Values[i] = ec._NotionDBValidationResult_databaseURL(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
-		case "caseTitle":
-			out.Values[i] = ec._MyDueAction_caseTitle(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "errorMessage":
+			out.Values[i] = ec._NotionDBValidationResult_errorMessage(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
 		default:
@@ -26429,10 +32797,10 @@ func (ec *executionContext) _MyDueAction(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var myOpenCaseImplementors = []string{"MyOpenCase"}
+var notionPageConfigImplementors = []string{"NotionPageConfig", "SourceConfig"}
 
-func (ec *executionContext) _MyOpenCase(ctx context.Context, sel ast.SelectionSet, obj *graphql1.MyOpenCase) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, myOpenCaseImplementors)
+func (ec *executionContext) _NotionPageConfig(ctx context.Context, sel ast.SelectionSet, obj *graphql1.NotionPageConfig) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, notionPageConfigImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferredFieldSet := graphql.NewFieldSet(nil)
@@ -26440,72 +32808,29 @@ func (ec *executionContext) _MyOpenCase(ctx context.Context, sel ast.SelectionSe
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("MyOpenCase")
-		case "workspaceId":
-			out.Values[i] = ec._MyOpenCase_workspaceId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "workspaceName":
-			out.Values[i] = ec._MyOpenCase_workspaceName(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "case":
-			out.Values[i] = ec._MyOpenCase_case(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("NotionPageConfig")
+		case "pageID":
+			out.Values[i] = ec._NotionPageConfig_pageID(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "stalled":
-			out.Values[i] = ec._MyOpenCase_stalled(ctx, field, obj)
+		case "pageTitle":
+			out.Values[i] = ec._NotionPageConfig_pageTitle(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
-
-	ec.ProcessDeferredGroup(graphql.DeferredGroup{
-		Defers:   deferLabelToView,
-		Path:     graphql.GetPath(ctx),
-		FieldSet: deferredFieldSet,
-		Context:  ctx,
-	})
-
-	return out
-}
-
-var notionDBConfigImplementors = []string{"NotionDBConfig", "SourceConfig"}
-
-func (ec *executionContext) _NotionDBConfig(ctx context.Context, sel ast.SelectionSet, obj *graphql1.NotionDBConfig) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, notionDBConfigImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferredFieldSet := graphql.NewFieldSet(nil)
-	deferLabelToView := make(map[string]*graphql.FieldSetView)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("NotionDBConfig")
-		case "databaseID":
-			out.Values[i] = ec._NotionDBConfig_databaseID(ctx, field, obj)
+		case "pageURL":
+			out.Values[i] = ec._NotionPageConfig_pageURL(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "databaseTitle":
-			out.Values[i] = ec._NotionDBConfig_databaseTitle(ctx, field, obj)
+		case "recursive":
+			out.Values[i] = ec._NotionPageConfig_recursive(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "databaseURL":
-			out.Values[i] = ec._NotionDBConfig_databaseURL(ctx, field, obj)
+		case "maxDepth":
+			out.Values[i] = ec._NotionPageConfig_maxDepth(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -26530,10 +32855,10 @@ func (ec *executionContext) _NotionDBConfig(ctx context.Context, sel ast.Selecti
 	return out
 }
 
-var notionDBValidationResultImplementors = []string{"NotionDBValidationResult"}
+var notionPageValidationResultImplementors = []string{"NotionPageValidationResult"}
 
-func (ec *executionContext) _NotionDBValidationResult(ctx context.Context, sel ast.SelectionSet, obj *graphql1.NotionDBValidationResult) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, notionDBValidationResultImplementors)
+func (ec *executionContext) _NotionPageValidationResult(ctx context.Context, sel ast.SelectionSet, obj *graphql1.NotionPageValidationResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, notionPageValidationResultImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferredFieldSet := graphql.NewFieldSet(nil)
@@ -26541,24 +32866,24 @@ func (ec *executionContext) _NotionDBValidationResult(ctx context.Context, sel a
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("NotionDBValidationResult")
+			out.Values[i] = graphql.MarshalString("NotionPageValidationResult")
 		case "valid":
-			out.Values[i] = ec._NotionDBValidationResult_valid(ctx, field, obj)
+			out.Values[i] = ec._NotionPageValidationResult_valid(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "databaseTitle":
-			out.Values[i] = ec._NotionDBValidationResult_databaseTitle(ctx, field, obj)
+		case "pageTitle":
+			out.Values[i] = ec._NotionPageValidationResult_pageTitle(ctx, field, obj)
 			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
-		case "databaseURL":
-			out.Values[i] = ec._NotionDBValidationResult_databaseURL(ctx, field, obj)
+		case "pageURL":
+			out.Values[i] = ec._NotionPageValidationResult_pageURL(ctx, field, obj)
 			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
 		case "errorMessage":
-			out.Values[i] = ec._NotionDBValidationResult_errorMessage(ctx, field, obj)
+			out.Values[i] = ec._NotionPageValidationResult_errorMessage(ctx, field, obj)
 			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
@@ -26583,10 +32908,10 @@ func (ec *executionContext) _NotionDBValidationResult(ctx context.Context, sel a
 	return out
 }
 
-var notionPageConfigImplementors = []string{"NotionPageConfig", "SourceConfig"}
+var openSLABreachImplementors = []string{"OpenSLABreach"}
 
-func (ec *executionContext) _NotionPageConfig(ctx context.Context, sel ast.SelectionSet, obj *graphql1.NotionPageConfig) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, notionPageConfigImplementors)
+func (ec *executionContext) _OpenSLABreach(ctx context.Context, sel ast.SelectionSet, obj *graphql1.OpenSLABreach) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, openSLABreachImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferredFieldSet := graphql.NewFieldSet(nil)
@@ -26594,85 +32919,32 @@ func (ec *executionContext) _NotionPageConfig(ctx context.Context, sel ast.Selec
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("NotionPageConfig")
-		case "pageID":
-			out.Values[i] = ec._NotionPageConfig_pageID(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "pageTitle":
-			out.Values[i] = ec._NotionPageConfig_pageTitle(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("OpenSLABreach")
+		case "workspaceId":
+			out.Values[i] = ec._OpenSLABreach_workspaceId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "pageURL":
-			out.Values[i] = ec._NotionPageConfig_pageURL(ctx, field, obj)
+		case "workspaceName":
+			out.Values[i] = ec._OpenSLABreach_workspaceName(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "recursive":
-			out.Values[i] = ec._NotionPageConfig_recursive(ctx, field, obj)
+		case "caseId":
+			out.Values[i] = ec._OpenSLABreach_caseId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "maxDepth":
-			out.Values[i] = ec._NotionPageConfig_maxDepth(ctx, field, obj)
+		case "caseTitle":
+			out.Values[i] = ec._OpenSLABreach_caseTitle(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
-
-	ec.ProcessDeferredGroup(graphql.DeferredGroup{
-		Defers:   deferLabelToView,
-		Path:     graphql.GetPath(ctx),
-		FieldSet: deferredFieldSet,
-		Context:  ctx,
-	})
-
-	return out
-}
-
-var notionPageValidationResultImplementors = []string{"NotionPageValidationResult"}
-
-func (ec *executionContext) _NotionPageValidationResult(ctx context.Context, sel ast.SelectionSet, obj *graphql1.NotionPageValidationResult) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, notionPageValidationResultImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferredFieldSet := graphql.NewFieldSet(nil)
-	deferLabelToView := make(map[string]*graphql.FieldSetView)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("NotionPageValidationResult")
-		case "valid":
-			out.Values[i] = ec._NotionPageValidationResult_valid(ctx, field, obj)
+		case "breach":
+			out.Values[i] = ec._OpenSLABreach_breach(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "pageTitle":
-			out.Values[i] = ec._NotionPageValidationResult_pageTitle(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		case "pageURL":
-			out.Values[i] = ec._NotionPageValidationResult_pageURL(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		case "errorMessage":
-			out.Values[i] = ec._NotionPageValidationResult_errorMessage(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -26845,6 +33117,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "searchCases":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_searchCases(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "drafts":
 			field := field
@@ -27109,6 +33403,50 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "apiTokens":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_apiTokens(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "webhookDeliveries":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_webhookDeliveries(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "sources":
 			field := field
@@ -27417,6 +33755,72 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "knowledgeRevisions":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_knowledgeRevisions(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "knowledgeRevisionDiff":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_knowledgeRevisionDiff(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "knowledgeDueForReview":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_knowledgeDueForReview(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "searchKnowledge":
 			field := field
@@ -27527,6 +33931,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "openSLABreaches":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_openSLABreaches(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "favoriteWorkspaceIds":
 			field := field
@@ -27607,6 +34033,127 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 	return out
 }
 
+var sLABreachImplementors = []string{"SLABreach"}
+
+func (ec *executionContext) _SLABreach(ctx context.Context, sel ast.SelectionSet, obj *graphql1.SLABreach) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sLABreachImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferredFieldSet := graphql.NewFieldSet(nil)
+	deferLabelToView := make(map[string]*graphql.FieldSetView)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SLABreach")
+		case "kind":
+			out.Values[i] = ec._SLABreach_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "targetSeconds":
+			out.Values[i] = ec._SLABreach_targetSeconds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dueAt":
+			out.Values[i] = ec._SLABreach_dueAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "recordedAt":
+			out.Values[i] = ec._SLABreach_recordedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
+
+	ec.ProcessDeferredGroup(graphql.DeferredGroup{
+		Defers:   deferLabelToView,
+		Path:     graphql.GetPath(ctx),
+		FieldSet: deferredFieldSet,
+		Context:  ctx,
+	})
+
+	return out
+}
+
+var sLAClockImplementors = []string{"SLAClock"}
+
+func (ec *executionContext) _SLAClock(ctx context.Context, sel ast.SelectionSet, obj *graphql1.SLAClock) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sLAClockImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferredFieldSet := graphql.NewFieldSet(nil)
+	deferLabelToView := make(map[string]*graphql.FieldSetView)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SLAClock")
+		case "kind":
+			out.Values[i] = ec._SLAClock_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "targetSeconds":
+			out.Values[i] = ec._SLAClock_targetSeconds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "elapsedSeconds":
+			out.Values[i] = ec._SLAClock_elapsedSeconds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dueAt":
+			out.Values[i] = ec._SLAClock_dueAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "stoppedAt":
+			out.Values[i] = ec._SLAClock_stoppedAt(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		case "paused":
+			out.Values[i] = ec._SLAClock_paused(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "breached":
+			out.Values[i] = ec._SLAClock_breached(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
+
+	ec.ProcessDeferredGroup(graphql.DeferredGroup{
+		Defers:   deferLabelToView,
+		Path:     graphql.GetPath(ctx),
+		FieldSet: deferredFieldSet,
+		Context:  ctx,
+	})
+
+	return out
+}
+
 var slackChannelImplementors = []string{"SlackChannel"}
 
 func (ec *executionContext) _SlackChannel(ctx context.Context, sel ast.SelectionSet, obj *graphql1.SlackChannel) graphql.Marshaler {
@@ -27925,10 +34472,213 @@ func (ec *executionContext) _SlackMessageConnection(ctx context.Context, sel ast
 	return out
 }
 
-var slackUserImplementors = []string{"SlackUser"}
+var slackUserImplementors = []string{"SlackUser"}
+
+func (ec *executionContext) _SlackUser(ctx context.Context, sel ast.SelectionSet, obj *graphql1.SlackUser) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, slackUserImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferredFieldSet := graphql.NewFieldSet(nil)
+	deferLabelToView := make(map[string]*graphql.FieldSetView)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SlackUser")
+		case "id":
+			out.Values[i] = ec._SlackUser_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._SlackUser_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "realName":
+			out.Values[i] = ec._SlackUser_realName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "imageUrl":
+			out.Values[i] = ec._SlackUser_imageUrl(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
+
+	ec.ProcessDeferredGroup(graphql.DeferredGroup{
+		Defers:   deferLabelToView,
+		Path:     graphql.GetPath(ctx),
+		FieldSet: deferredFieldSet,
+		Context:  ctx,
+	})
+
+	return out
+}
+
+var sourceImplementors = []string{"Source"}
+
+func (ec *executionContext) _Source(ctx context.Context, sel ast.SelectionSet, obj *graphql1.Source) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sourceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferredFieldSet := graphql.NewFieldSet(nil)
+	deferLabelToView := make(map[string]*graphql.FieldSetView)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Source")
+		case "id":
+			out.Values[i] = ec._Source_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._Source_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "sourceType":
+			out.Values[i] = ec._Source_sourceType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "description":
+			out.Values[i] = ec._Source_description(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "enabled":
+			out.Values[i] = ec._Source_enabled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "config":
+			out.Values[i] = ec._Source_config(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._Source_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updatedAt":
+			out.Values[i] = ec._Source_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
+
+	ec.ProcessDeferredGroup(graphql.DeferredGroup{
+		Defers:   deferLabelToView,
+		Path:     graphql.GetPath(ctx),
+		FieldSet: deferredFieldSet,
+		Context:  ctx,
+	})
+
+	return out
+}
+
+var subscriptionImplementors = []string{"Subscription"}
+
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		graphql.AddErrorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
+
+	switch fields[0].Name {
+	case "caseChanged":
+		return ec._Subscription_caseChanged(ctx, fields[0])
+	case "actionChanged":
+		return ec._Subscription_actionChanged(ctx, fields[0])
+	case "jobRunEventAdded":
+		return ec._Subscription_jobRunEventAdded(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
+var tagImplementors = []string{"Tag"}
+
+func (ec *executionContext) _Tag(ctx context.Context, sel ast.SelectionSet, obj *graphql1.Tag) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, tagImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferredFieldSet := graphql.NewFieldSet(nil)
+	deferLabelToView := make(map[string]*graphql.FieldSetView)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Tag")
+		case "id":
+			out.Values[i] = ec._Tag_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._Tag_name(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._Tag_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updatedAt":
+			out.Values[i] = ec._Tag_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
+
+	ec.ProcessDeferredGroup(graphql.DeferredGroup{
+		Defers:   deferLabelToView,
+		Path:     graphql.GetPath(ctx),
+		FieldSet: deferredFieldSet,
+		Context:  ctx,
+	})
+
+	return out
+}
+
+var webhookDeliveryImplementors = []string{"WebhookDelivery"}
 
-func (ec *executionContext) _SlackUser(ctx context.Context, sel ast.SelectionSet, obj *graphql1.SlackUser) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, slackUserImplementors)
+func (ec *executionContext) _WebhookDelivery(ctx context.Context, sel ast.SelectionSet, obj *graphql1.WebhookDelivery) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, webhookDeliveryImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferredFieldSet := graphql.NewFieldSet(nil)
@@ -27936,150 +34686,69 @@ func (ec *executionContext) _SlackUser(ctx context.Context, sel ast.SelectionSet
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("SlackUser")
+			out.Values[i] = graphql.MarshalString("WebhookDelivery")
 		case "id":
-			out.Values[i] = ec._SlackUser_id(ctx, field, obj)
+			out.Values[i] = ec._WebhookDelivery_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "name":
-			out.Values[i] = ec._SlackUser_name(ctx, field, obj)
+		case "webhookId":
+			out.Values[i] = ec._WebhookDelivery_webhookId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "realName":
-			out.Values[i] = ec._SlackUser_realName(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "imageUrl":
-			out.Values[i] = ec._SlackUser_imageUrl(ctx, field, obj)
-			if out.Values[i] == graphql.RequiredNull {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
-
-	ec.ProcessDeferredGroup(graphql.DeferredGroup{
-		Defers:   deferLabelToView,
-		Path:     graphql.GetPath(ctx),
-		FieldSet: deferredFieldSet,
-		Context:  ctx,
-	})
-
-	return out
-}
-
-var sourceImplementors = []string{"Source"}
-
-func (ec *executionContext) _Source(ctx context.Context, sel ast.SelectionSet, obj *graphql1.Source) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, sourceImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferredFieldSet := graphql.NewFieldSet(nil)
-	deferLabelToView := make(map[string]*graphql.FieldSetView)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Source")
-		case "id":
-			out.Values[i] = ec._Source_id(ctx, field, obj)
+		case "event":
+			out.Values[i] = ec._WebhookDelivery_event(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "name":
-			out.Values[i] = ec._Source_name(ctx, field, obj)
+		case "caseId":
+			out.Values[i] = ec._WebhookDelivery_caseId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "sourceType":
-			out.Values[i] = ec._Source_sourceType(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._WebhookDelivery_status(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "description":
-			out.Values[i] = ec._Source_description(ctx, field, obj)
+		case "payload":
+			out.Values[i] = ec._WebhookDelivery_payload(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "enabled":
-			out.Values[i] = ec._Source_enabled(ctx, field, obj)
+		case "attempts":
+			out.Values[i] = ec._WebhookDelivery_attempts(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "config":
-			out.Values[i] = ec._Source_config(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "nextAttemptAt":
+			out.Values[i] = ec._WebhookDelivery_nextAttemptAt(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
-		case "createdAt":
-			out.Values[i] = ec._Source_createdAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "lastAttemptAt":
+			out.Values[i] = ec._WebhookDelivery_lastAttemptAt(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
-		case "updatedAt":
-			out.Values[i] = ec._Source_updatedAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "lastStatusCode":
+			out.Values[i] = ec._WebhookDelivery_lastStatusCode(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.Deferred, int32(min(len(deferLabelToView), math.MaxInt32)))
-
-	ec.ProcessDeferredGroup(graphql.DeferredGroup{
-		Defers:   deferLabelToView,
-		Path:     graphql.GetPath(ctx),
-		FieldSet: deferredFieldSet,
-		Context:  ctx,
-	})
-
-	return out
-}
-
-var tagImplementors = []string{"Tag"}
-
-func (ec *executionContext) _Tag(ctx context.Context, sel ast.SelectionSet, obj *graphql1.Tag) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, tagImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferredFieldSet := graphql.NewFieldSet(nil)
-	deferLabelToView := make(map[string]*graphql.FieldSetView)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Tag")
-		case "id":
-			out.Values[i] = ec._Tag_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
+		case "lastError":
+			out.Values[i] = ec._WebhookDelivery_lastError(ctx, field, obj)
+			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
-		case "name":
-			out.Values[i] = ec._Tag_name(ctx, field, obj)
+		case "deliveredAt":
+			out.Values[i] = ec._WebhookDelivery_deliveredAt(ctx, field, obj)
 			if out.Values[i] == graphql.RequiredNull {
 				out.Invalids++
 			}
 		case "createdAt":
-			out.Values[i] = ec._Tag_createdAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "updatedAt":
-			out.Values[i] = ec._Tag_updatedAt(ctx, field, obj)
+			out.Values[i] = ec._WebhookDelivery_createdAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -28592,6 +35261,32 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 
 // region    ***************************** type.gotpl *****************************
 
+func (ec *executionContext) marshalNAPIToken2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐAPITokenᚄ(ctx context.Context, sel ast.SelectionSet, v []*graphql1.APIToken) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNAPIToken2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐAPIToken(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNAPIToken2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐAPIToken(ctx context.Context, sel ast.SelectionSet, v *graphql1.APIToken) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._APIToken(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNAction2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐAction(ctx context.Context, sel ast.SelectionSet, v graphql1.Action) graphql.Marshaler {
 	return ec._Action(ctx, sel, &v)
 }
@@ -28622,6 +35317,20 @@ func (ec *executionContext) marshalNAction2ᚖgithubᚗcomᚋsecmonᚑlabᚋheca
 	return ec._Action(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNActionChangeEvent2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐActionChangeEvent(ctx context.Context, sel ast.SelectionSet, v graphql1.ActionChangeEvent) graphql.Marshaler {
+	return ec._ActionChangeEvent(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNActionChangeEvent2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐActionChangeEvent(ctx context.Context, sel ast.SelectionSet, v *graphql1.ActionChangeEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ActionChangeEvent(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNActionComment2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐActionComment(ctx context.Context, sel ast.SelectionSet, v graphql1.ActionComment) graphql.Marshaler {
 	return ec._ActionComment(ctx, sel, &v)
 }
@@ -28801,6 +35510,32 @@ func (ec *executionContext) unmarshalNAddActionStepInput2githubᚗcomᚋsecmon
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalNAlert2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐAlertᚄ(ctx context.Context, sel ast.SelectionSet, v []*graphql1.Alert) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNAlert2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐAlert(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNAlert2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐAlert(ctx context.Context, sel ast.SelectionSet, v *graphql1.Alert) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Alert(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNAny2interface(ctx context.Context, v any) (any, error) {
 	res, err := graphql.UnmarshalAny(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -28909,6 +35644,39 @@ func (ec *executionContext) marshalNCase2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecato
 	return ec._Case(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNCaseChangeEvent2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCaseChangeEvent(ctx context.Context, sel ast.SelectionSet, v graphql1.CaseChangeEvent) graphql.Marshaler {
+	return ec._CaseChangeEvent(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCaseChangeEvent2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCaseChangeEvent(ctx context.Context, sel ast.SelectionSet, v *graphql1.CaseChangeEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CaseChangeEvent(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCaseConnection2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCaseConnection(ctx context.Context, sel ast.SelectionSet, v graphql1.CaseConnection) graphql.Marshaler {
+	return ec._CaseConnection(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCaseConnection2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCaseConnection(ctx context.Context, sel ast.SelectionSet, v *graphql1.CaseConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CaseConnection(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNCaseFieldFilterInput2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCaseFieldFilterInput(ctx context.Context, v any) (*graphql1.CaseFieldFilterInput, error) {
+	res, err := ec.unmarshalInputCaseFieldFilterInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalNCaseJob2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCaseJobᚄ(ctx context.Context, sel ast.SelectionSet, v []*graphql1.CaseJob) graphql.Marshaler {
 	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
 		fc := graphql.GetFieldContext(ctx)
@@ -29001,6 +35769,16 @@ func (ec *executionContext) marshalNCaseRef2ᚖgithubᚗcomᚋsecmonᚑlabᚋhec
 	return ec._CaseRef(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNCaseSortKey2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCaseSortKey(ctx context.Context, v any) (graphql1.CaseSortKey, error) {
+	var res graphql1.CaseSortKey
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNCaseSortKey2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCaseSortKey(ctx context.Context, sel ast.SelectionSet, v graphql1.CaseSortKey) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) unmarshalNCaseStatus2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋtypesᚐCaseStatus(ctx context.Context, v any) (types.CaseStatus, error) {
 	tmp, err := graphql.UnmarshalString(v)
 	res := types.CaseStatus(tmp)
@@ -29032,6 +35810,11 @@ func (ec *executionContext) marshalNChannelUserConnection2ᚖgithubᚗcomᚋsecm
 	return ec._ChannelUserConnection(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNCreateAPITokenInput2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCreateAPITokenInput(ctx context.Context, v any) (graphql1.CreateAPITokenInput, error) {
+	res, err := ec.unmarshalInputCreateAPITokenInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNCreateActionCommentInput2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCreateActionCommentInput(ctx context.Context, v any) (graphql1.CreateActionCommentInput, error) {
 	res, err := ec.unmarshalInputCreateActionCommentInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -29087,6 +35870,20 @@ func (ec *executionContext) unmarshalNCreateSlackSourceInput2githubᚗcomᚋsecm
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalNCreatedAPIToken2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCreatedAPIToken(ctx context.Context, sel ast.SelectionSet, v graphql1.CreatedAPIToken) graphql.Marshaler {
+	return ec._CreatedAPIToken(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCreatedAPIToken2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCreatedAPIToken(ctx context.Context, sel ast.SelectionSet, v *graphql1.CreatedAPIToken) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CreatedAPIToken(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNDeleteActionCommentInput2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐDeleteActionCommentInput(ctx context.Context, v any) (graphql1.DeleteActionCommentInput, error) {
 	res, err := ec.unmarshalInputDeleteActionCommentInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -29097,6 +35894,68 @@ func (ec *executionContext) unmarshalNDeleteActionStepInput2githubᚗcomᚋsecmo
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalNDiffLine2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐDiffLineᚄ(ctx context.Context, sel ast.SelectionSet, v []*graphql1.DiffLine) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNDiffLine2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐDiffLine(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNDiffLine2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐDiffLine(ctx context.Context, sel ast.SelectionSet, v *graphql1.DiffLine) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._DiffLine(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNDiffOp2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐDiffOp(ctx context.Context, v any) (graphql1.DiffOp, error) {
+	var res graphql1.DiffOp
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNDiffOp2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐDiffOp(ctx context.Context, sel ast.SelectionSet, v graphql1.DiffOp) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNDuplicateCandidate2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐDuplicateCandidateᚄ(ctx context.Context, sel ast.SelectionSet, v []*graphql1.DuplicateCandidate) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNDuplicateCandidate2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐDuplicateCandidate(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNDuplicateCandidate2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐDuplicateCandidate(ctx context.Context, sel ast.SelectionSet, v *graphql1.DuplicateCandidate) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._DuplicateCandidate(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNEntityLabels2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐEntityLabels(ctx context.Context, sel ast.SelectionSet, v *graphql1.EntityLabels) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -29562,6 +36421,10 @@ func (ec *executionContext) marshalNJSON2string(ctx context.Context, sel ast.Sel
 	return res
 }
 
+func (ec *executionContext) marshalNJobRunEvent2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐJobRunEvent(ctx context.Context, sel ast.SelectionSet, v graphql1.JobRunEvent) graphql.Marshaler {
+	return ec._JobRunEvent(ctx, sel, &v)
+}
+
 func (ec *executionContext) marshalNJobRunEvent2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐJobRunEventᚄ(ctx context.Context, sel ast.SelectionSet, v []*graphql1.JobRunEvent) graphql.Marshaler {
 	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
 		fc := graphql.GetFieldContext(ctx)
@@ -29702,6 +36565,92 @@ func (ec *executionContext) marshalNKnowledge2ᚖgithubᚗcomᚋsecmonᚑlabᚋh
 	return ec._Knowledge(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNKnowledgeRevision2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐKnowledgeRevisionᚄ(ctx context.Context, sel ast.SelectionSet, v []*graphql1.KnowledgeRevision) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNKnowledgeRevision2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐKnowledgeRevision(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNKnowledgeRevision2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐKnowledgeRevision(ctx context.Context, sel ast.SelectionSet, v *graphql1.KnowledgeRevision) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._KnowledgeRevision(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNKnowledgeRevisionAction2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐKnowledgeRevisionAction(ctx context.Context, v any) (graphql1.KnowledgeRevisionAction, error) {
+	var res graphql1.KnowledgeRevisionAction
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNKnowledgeRevisionAction2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐKnowledgeRevisionAction(ctx context.Context, sel ast.SelectionSet, v graphql1.KnowledgeRevisionAction) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNKnowledgeRevisionDiff2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐKnowledgeRevisionDiff(ctx context.Context, sel ast.SelectionSet, v graphql1.KnowledgeRevisionDiff) graphql.Marshaler {
+	return ec._KnowledgeRevisionDiff(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNKnowledgeRevisionDiff2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐKnowledgeRevisionDiff(ctx context.Context, sel ast.SelectionSet, v *graphql1.KnowledgeRevisionDiff) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._KnowledgeRevisionDiff(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNKnowledgeSearchHit2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐKnowledgeSearchHitᚄ(ctx context.Context, sel ast.SelectionSet, v []*graphql1.KnowledgeSearchHit) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNKnowledgeSearchHit2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐKnowledgeSearchHit(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNKnowledgeSearchHit2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐKnowledgeSearchHit(ctx context.Context, sel ast.SelectionSet, v *graphql1.KnowledgeSearchHit) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._KnowledgeSearchHit(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNLiveChangeKind2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐLiveChangeKind(ctx context.Context, v any) (graphql1.LiveChangeKind, error) {
+	var res graphql1.LiveChangeKind
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNLiveChangeKind2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐLiveChangeKind(ctx context.Context, sel ast.SelectionSet, v graphql1.LiveChangeKind) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) marshalNMemo2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐMemo(ctx context.Context, sel ast.SelectionSet, v graphql1.Memo) graphql.Marshaler {
 	return ec._Memo(ctx, sel, &v)
 }
@@ -29826,11 +36775,99 @@ func (ec *executionContext) marshalNNotionPageValidationResult2ᚖgithubᚗcom
 	return ec._NotionPageValidationResult(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNOpenSLABreach2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐOpenSLABreachᚄ(ctx context.Context, sel ast.SelectionSet, v []*graphql1.OpenSLABreach) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNOpenSLABreach2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐOpenSLABreach(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNOpenSLABreach2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐOpenSLABreach(ctx context.Context, sel ast.SelectionSet, v *graphql1.OpenSLABreach) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._OpenSLABreach(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNRenameActionStepInput2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐRenameActionStepInput(ctx context.Context, v any) (graphql1.RenameActionStepInput, error) {
 	res, err := ec.unmarshalInputRenameActionStepInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalNSLABreach2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐSLABreachᚄ(ctx context.Context, sel ast.SelectionSet, v []*graphql1.SLABreach) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNSLABreach2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐSLABreach(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNSLABreach2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐSLABreach(ctx context.Context, sel ast.SelectionSet, v *graphql1.SLABreach) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SLABreach(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNSLAClock2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐSLAClockᚄ(ctx context.Context, sel ast.SelectionSet, v []*graphql1.SLAClock) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNSLAClock2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐSLAClock(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNSLAClock2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐSLAClock(ctx context.Context, sel ast.SelectionSet, v *graphql1.SLAClock) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SLAClock(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNSLAKind2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐSLAKind(ctx context.Context, v any) (graphql1.SLAKind, error) {
+	var res graphql1.SLAKind
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSLAKind2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐSLAKind(ctx context.Context, sel ast.SelectionSet, v graphql1.SLAKind) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) unmarshalNSetActionStepDoneInput2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐSetActionStepDoneInput(ctx context.Context, v any) (graphql1.SetActionStepDoneInput, error) {
 	res, err := ec.unmarshalInputSetActionStepDoneInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -29980,6 +37017,16 @@ func (ec *executionContext) marshalNSlackUser2ᚖgithubᚗcomᚋsecmonᚑlabᚋh
 	return ec._SlackUser(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNSortDirection2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐSortDirection(ctx context.Context, v any) (graphql1.SortDirection, error) {
+	var res graphql1.SortDirection
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSortDirection2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐSortDirection(ctx context.Context, sel ast.SelectionSet, v graphql1.SortDirection) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) marshalNSource2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐSource(ctx context.Context, sel ast.SelectionSet, v graphql1.Source) graphql.Marshaler {
 	return ec._Source(ctx, sel, &v)
 }
@@ -30181,6 +37228,46 @@ func (ec *executionContext) unmarshalNUpdateSourceInput2githubᚗcomᚋsecmonᚑ
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalNWebhookDelivery2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐWebhookDelivery(ctx context.Context, sel ast.SelectionSet, v graphql1.WebhookDelivery) graphql.Marshaler {
+	return ec._WebhookDelivery(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNWebhookDelivery2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐWebhookDeliveryᚄ(ctx context.Context, sel ast.SelectionSet, v []*graphql1.WebhookDelivery) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNWebhookDelivery2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐWebhookDelivery(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNWebhookDelivery2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐWebhookDelivery(ctx context.Context, sel ast.SelectionSet, v *graphql1.WebhookDelivery) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._WebhookDelivery(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNWebhookDeliveryStatus2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐWebhookDeliveryStatus(ctx context.Context, v any) (graphql1.WebhookDeliveryStatus, error) {
+	var res graphql1.WebhookDeliveryStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNWebhookDeliveryStatus2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐWebhookDeliveryStatus(ctx context.Context, sel ast.SelectionSet, v graphql1.WebhookDeliveryStatus) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) marshalNWorkspace2githubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐWorkspace(ctx context.Context, sel ast.SelectionSet, v graphql1.Workspace) graphql.Marshaler {
 	return ec._Workspace(ctx, sel, &v)
 }
@@ -30444,6 +37531,39 @@ func (ec *executionContext) marshalOCase2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecato
 	return ec._Case(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOCaseFieldFilterInput2ᚕᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCaseFieldFilterInputᚄ(ctx context.Context, v any) ([]*graphql1.CaseFieldFilterInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	vSlice := graphql.CoerceList(v)
+	var err error
+	res := make([]*graphql1.CaseFieldFilterInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNCaseFieldFilterInput2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCaseFieldFilterInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalOCaseSearchFilter2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCaseSearchFilter(ctx context.Context, v any) (*graphql1.CaseSearchFilter, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputCaseSearchFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOCaseSortInput2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐCaseSortInput(ctx context.Context, v any) (*graphql1.CaseSortInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputCaseSortInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalOCaseStatus2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋtypesᚐCaseStatus(ctx context.Context, v any) (*types.CaseStatus, error) {
 	if v == nil {
 		return nil, nil
@@ -30499,6 +37619,23 @@ func (ec *executionContext) unmarshalOFieldValueInput2ᚕᚖgithubᚗcomᚋsecmo
 	return res, nil
 }
 
+func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v any) (*float64, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOFloat2ᚖfloat64(ctx context.Context, sel ast.SelectionSet, v *float64) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	res := graphql.MarshalFloatContext(*v)
+	return graphql.WrapContextMarshaler(ctx, res)
+}
+
 func (ec *executionContext) unmarshalOID2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
 	if v == nil {
 		return nil, nil
@@ -30541,6 +37678,41 @@ func (ec *executionContext) marshalOImportIssue2ᚖgithubᚗcomᚋsecmonᚑlab
 	return ec._ImportIssue(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOInt2ᚕintᚄ(ctx context.Context, v any) ([]int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	vSlice := graphql.CoerceList(v)
+	var err error
+	res := make([]int, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNInt2int(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOInt2ᚕintᚄ(ctx context.Context, sel ast.SelectionSet, v []int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNInt2int(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v any) (*int, error) {
 	if v == nil {
 		return nil, nil
@@ -30726,6 +37898,22 @@ func (ec *executionContext) marshalOTime2ᚖtimeᚐTime(ctx context.Context, sel
 	return res
 }
 
+func (ec *executionContext) unmarshalOWebhookDeliveryStatus2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐWebhookDeliveryStatus(ctx context.Context, v any) (*graphql1.WebhookDeliveryStatus, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(graphql1.WebhookDeliveryStatus)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOWebhookDeliveryStatus2ᚖgithubᚗcomᚋsecmonᚑlabᚋhecatoncheiresᚋpkgᚋdomainᚋmodelᚋgraphqlᚐWebhookDeliveryStatus(ctx context.Context, sel ast.SelectionSet, v *graphql1.WebhookDeliveryStatus) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
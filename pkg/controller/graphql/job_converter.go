@@ -46,13 +46,22 @@ func jobStrategyToGraphQL(s model.JobStrategy) graphql1.JobStrategy {
 // a scheduled trigger.
 func toGraphQLJobTrigger(ev model.JobEvents) *graphql1.JobTrigger {
 	caseEvents := make([]graphql1.CaseLifecycleEvent, 0)
+	caseTo := make([]string, 0)
+	var caseField *string
 	if ev.Case != nil {
 		for _, lc := range ev.Case.On {
 			caseEvents = append(caseEvents, caseLifecycleToGraphQL(lc))
 		}
+		if ev.Case.Field != "" {
+			field := ev.Case.Field
+			caseField = &field
+		}
+		caseTo = append(caseTo, ev.Case.To...)
 	}
 	return &graphql1.JobTrigger{
 		CaseEvents: caseEvents,
+		CaseField:  caseField,
+		CaseTo:     caseTo,
 		Schedule:   toGraphQLJobSchedule(ev.Scheduled),
 	}
 }
@@ -61,6 +70,16 @@ func caseLifecycleToGraphQL(lc model.CaseLifecycle) graphql1.CaseLifecycleEvent
 	switch lc {
 	case model.CaseLifecycleClosed:
 		return graphql1.CaseLifecycleEventClosed
+	case model.CaseLifecycleFieldChanged:
+		return graphql1.CaseLifecycleEventFieldChanged
+	case model.CaseLifecycleStatusChanged:
+		return graphql1.CaseLifecycleEventStatusChanged
+	case model.CaseLifecycleAssigned:
+		return graphql1.CaseLifecycleEventAssigned
+	case model.CaseLifecycleActionCompleted:
+		return graphql1.CaseLifecycleEventActionCompleted
+	case model.CaseLifecycleMessageAttached:
+		return graphql1.CaseLifecycleEventMessageAttached
 	default:
 		return graphql1.CaseLifecycleEventCreated
 	}
@@ -0,0 +1,50 @@
+package graphql_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	graphqlctrl "github.com/secmon-lab/hecatoncheires/pkg/controller/graphql"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	graphql1 "github.com/secmon-lab/hecatoncheires/pkg/domain/model/graphql"
+)
+
+func TestToGraphQLKnowledgeRevisionDiff(t *testing.T) {
+	now := time.Now()
+	kept := &model.Tag{ID: "t-kept", Name: "kept"}
+	added := &model.Tag{ID: "t-added", Name: "added"}
+	tagByID := map[model.TagID]*model.Tag{kept.ID: kept, added.ID: added}
+
+	from := &model.KnowledgeRevision{
+		Revision: 1, Action: model.KnowledgeRevisionCreated,
+		Title: "t", Claim: "a", TagIDs: []model.TagID{kept.ID, "t-deleted"},
+		EditorID: "U1", CreatedAt: now,
+	}
+	to := &model.KnowledgeRevision{
+		Revision: 3, Action: model.KnowledgeRevisionRestored, RestoredFrom: 1,
+		Title: "t", Claim: "b", TagIDs: []model.TagID{kept.ID, added.ID},
+		JobID: "triage", RunID: "run-1", CreatedAt: now,
+	}
+
+	g := graphqlctrl.ToGraphQLKnowledgeRevisionDiffForTest(model.DiffKnowledgeRevisions(from, to), tagByID)
+
+	gt.Value(t, g.From.Action).Equal(graphql1.KnowledgeRevisionActionCreated)
+	gt.Value(t, *g.From.EditorID).Equal("U1")
+	gt.True(t, g.From.JobID == nil)
+	gt.True(t, g.From.RestoredFrom == nil)
+	// A tag id that no longer resolves is skipped, never a nil element.
+	gt.Array(t, g.From.Tags).Length(1)
+
+	gt.Value(t, g.To.Action).Equal(graphql1.KnowledgeRevisionActionRestored)
+	gt.True(t, g.To.EditorID == nil)
+	gt.Value(t, *g.To.RunID).Equal("run-1")
+	gt.Value(t, *g.To.RestoredFrom).Equal(1)
+
+	gt.Array(t, g.Claim).Length(2).Required()
+	gt.Value(t, g.Claim[0].Op).Equal(graphql1.DiffOpDelete)
+	gt.Value(t, g.Claim[1].Op).Equal(graphql1.DiffOpInsert)
+	gt.Array(t, g.AddedTags).Length(1).Required()
+	gt.Value(t, *g.AddedTags[0].Name).Equal("added")
+	gt.Array(t, g.RemovedTags).Length(0)
+}
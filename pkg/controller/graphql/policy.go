@@ -0,0 +1,221 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/authz"
+)
+
+// MutationAuthorizer is the policy check every root mutation passes before its
+// resolver runs.
+type MutationAuthorizer interface {
+	AuthorizeMutation(ctx context.Context, in authz.OperationInput) error
+}
+
+// MutationPolicyMiddleware returns a gqlgen field middleware that authorizes
+// every root Mutation field against a before resolving it. Queries and nested
+// fields pass straight through: the policy governs what a caller may change,
+// and read access stays with the existing private-case rules.
+//
+// Mutations that name an action instead of a case are resolved to the
+// action's case through actions, so a policy can govern them per case too.
+//
+// A refusal surfaces as the field's error; the error presenter classifies the
+// wrapped usecase.ErrAccessDenied as FORBIDDEN.
+func MutationPolicyMiddleware(a MutationAuthorizer, actions interfaces.ActionRepository) graphql.FieldMiddleware {
+	return func(ctx context.Context, next graphql.Resolver) (any, error) {
+		fc := graphql.GetFieldContext(ctx)
+		if a == nil || fc == nil || fc.Object != "Mutation" {
+			return next(ctx)
+		}
+		in := mutationInput(ctx, fc.Field.Name, fc.Args)
+		if err := resolveMutationCases(ctx, actions, &in); err != nil {
+			return nil, err
+		}
+		if err := a.AuthorizeMutation(ctx, in); err != nil {
+			return nil, err
+		}
+		return next(ctx)
+	}
+}
+
+// caseIDMutations are the mutations whose `id` argument (or input.id) is a case
+// ID. Every other mutation names its case, if any, as caseId / caseID.
+var caseIDMutations = map[string]bool{
+	"updateCase":           true,
+	"assignCase":           true,
+	"unassignCase":         true,
+	"deleteCase":           true,
+	"closeCase":            true,
+	"reopenCase":           true,
+	"updateCaseStatus":     true,
+	"syncCaseChannelUsers": true,
+	"submitDraft":          true,
+	"discardDraft":         true,
+}
+
+// actionIDMutations maps the mutations that target an existing action to the
+// argument (or input field) holding its ID. Their case is the action's case.
+var actionIDMutations = map[string]string{
+	"updateAction":           "id",
+	"archiveAction":          "id",
+	"unarchiveAction":        "id",
+	"postActionSlackMessage": "id",
+	"addActionStep":          "actionId",
+	"setActionStepDone":      "actionId",
+	"renameActionStep":       "actionId",
+	"deleteActionStep":       "actionId",
+	"createActionComment":    "actionId",
+	"updateActionComment":    "actionId",
+	"deleteActionComment":    "actionId",
+}
+
+// mutationInput describes one mutation to the policy. The arguments go through
+// a JSON round-trip so the policy sees the same field names the client sent,
+// not the generated Go structs they were decoded into. The actor is the
+// authenticated user, when there is one.
+func mutationInput(ctx context.Context, name string, rawArgs map[string]any) authz.OperationInput {
+	args := map[string]any{}
+	if raw, err := json.Marshal(rawArgs); err == nil {
+		// Cannot fail for a map json.Marshal just produced; on the impossible
+		// error the policy sees empty args, which a restrictive rule refuses.
+		_ = json.Unmarshal(raw, &args)
+	}
+
+	in := authz.OperationInput{
+		Operation: name,
+		Actor:     authz.Actor{Kind: authz.ActorUser},
+		Args:      args,
+	}
+	in.WorkspaceID, _ = args["workspaceId"].(string)
+	in.CaseID = mutationCaseID(name, args)
+	if token, err := auth.TokenFromContext(ctx); err == nil {
+		in.Actor.UserID = token.Sub
+		in.Actor.Email = token.Email
+	}
+	return in
+}
+
+// resolveMutationCases fills in.CaseID (and in.CaseIDs) for the mutations
+// whose arguments name actions or several cases rather than one case:
+//   - an action mutation targets the action's case; updateAction moving the
+//     action to another case (input.caseID) also lists the destination.
+//   - bulkArchiveActions lists the cases of all its actions, and targets the
+//     case when they share one.
+//   - mergeCases targets the merge target and lists it with every source.
+//
+// An action that does not exist resolves to no case; the resolver reports it.
+func resolveMutationCases(ctx context.Context, actions interfaces.ActionRepository, in *authz.OperationInput) error {
+	input, _ := in.Args["input"].(map[string]any)
+
+	var actionIDs []int64
+	switch in.Operation {
+	case "mergeCases":
+		in.CaseID = argInt(in.Args, "targetId")
+		in.CaseIDs = appendCaseID(nil, in.CaseID)
+		for _, id := range argInts(in.Args, "sourceIds") {
+			in.CaseIDs = appendCaseID(in.CaseIDs, id)
+		}
+		return nil
+	case "bulkArchiveActions":
+		actionIDs = argInts(in.Args, "ids")
+	default:
+		key, ok := actionIDMutations[in.Operation]
+		if !ok {
+			return nil
+		}
+		id := argInt(in.Args, key)
+		if id == 0 {
+			id = argInt(input, key)
+		}
+		if id != 0 {
+			actionIDs = []int64{id}
+		}
+	}
+	if len(actionIDs) == 0 || actions == nil {
+		in.CaseID = 0
+		return nil
+	}
+
+	found, err := actions.GetByIDs(ctx, in.WorkspaceID, actionIDs)
+	if err != nil {
+		return goerr.Wrap(err, "failed to resolve the cases of a mutation's actions",
+			goerr.V("operation", in.Operation), goerr.V("workspace_id", in.WorkspaceID))
+	}
+
+	var caseIDs []int64
+	for _, id := range actionIDs {
+		if a, ok := found[id]; ok {
+			caseIDs = appendCaseID(caseIDs, a.CaseID)
+		}
+	}
+	if in.Operation == "updateAction" {
+		caseIDs = appendCaseID(caseIDs, argInt(input, "caseID"))
+	}
+
+	in.CaseID = 0
+	if len(caseIDs) > 0 && (len(actionIDs) == 1 || len(caseIDs) == 1) {
+		in.CaseID = caseIDs[0]
+	}
+	if len(caseIDs) > 1 || in.Operation == "bulkArchiveActions" {
+		in.CaseIDs = caseIDs
+	}
+	return nil
+}
+
+// argInt reads an integer argument; JSON numbers decode as float64 and IDs
+// are far below 2^53. 0 when absent.
+func argInt(args map[string]any, key string) int64 {
+	if v, ok := args[key].(float64); ok {
+		return int64(v)
+	}
+	return 0
+}
+
+func argInts(args map[string]any, key string) []int64 {
+	raw, _ := args[key].([]any)
+	out := make([]int64, 0, len(raw))
+	for _, v := range raw {
+		if f, ok := v.(float64); ok {
+			out = append(out, int64(f))
+		}
+	}
+	return out
+}
+
+// appendCaseID appends id unless it is 0 or already present.
+func appendCaseID(ids []int64, id int64) []int64 {
+	if id == 0 {
+		return ids
+	}
+	for _, existing := range ids {
+		if existing == id {
+			return ids
+		}
+	}
+	return append(ids, id)
+}
+
+// mutationCaseID finds the case a mutation targets, looking at the top-level
+// arguments first and then inside `input`. 0 when it targets none.
+func mutationCaseID(name string, args map[string]any) int64 {
+	keys := []string{"caseId", "caseID"}
+	if caseIDMutations[name] {
+		keys = append(keys, "id")
+	}
+	input, _ := args["input"].(map[string]any)
+	for _, scope := range []map[string]any{args, input} {
+		for _, key := range keys {
+			// JSON numbers decode as float64; case IDs are far below 2^53.
+			if v, ok := scope[key].(float64); ok {
+				return int64(v)
+			}
+		}
+	}
+	return 0
+}
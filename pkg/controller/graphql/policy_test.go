@@ -0,0 +1,166 @@
+package graphql_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/m-mizutani/gt"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	gqlctrl "github.com/secmon-lab/hecatoncheires/pkg/controller/graphql"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/authz"
+	graphql1 "github.com/secmon-lab/hecatoncheires/pkg/domain/model/graphql"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+)
+
+type recordingMutationAuthorizer struct {
+	got []authz.OperationInput
+	err error
+}
+
+func (a *recordingMutationAuthorizer) AuthorizeMutation(_ context.Context, in authz.OperationInput) error {
+	a.got = append(a.got, in)
+	return a.err
+}
+
+func fieldCtx(object, name string, args map[string]any) context.Context {
+	ctx := auth.ContextWithToken(context.Background(), &auth.Token{Sub: "U1", Email: "u1@example.com"})
+	return graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: object,
+		Field:  graphql.CollectedField{Field: &ast.Field{Name: name}},
+		Args:   args,
+	})
+}
+
+func TestMutationPolicyMiddleware(t *testing.T) {
+	resolved := func(context.Context) (any, error) { return "resolved", nil }
+
+	t.Run("a mutation is described to the policy", func(t *testing.T) {
+		a := &recordingMutationAuthorizer{}
+		ctx := fieldCtx("Mutation", "closeCase", map[string]any{"workspaceId": "ws", "id": 42})
+		res, err := gqlctrl.MutationPolicyMiddleware(a, nil)(ctx, resolved)
+		gt.NoError(t, err)
+		gt.Value(t, res).Equal(any("resolved"))
+		gt.Array(t, a.got).Length(1).Required()
+		gt.Value(t, a.got[0]).Equal(authz.OperationInput{
+			Operation:   "closeCase",
+			WorkspaceID: "ws",
+			CaseID:      42,
+			Actor:       authz.Actor{Kind: authz.ActorUser, UserID: "U1", Email: "u1@example.com"},
+			Args:        map[string]any{"workspaceId": "ws", "id": float64(42)},
+		})
+	})
+
+	t.Run("the case is found inside a generated input struct", func(t *testing.T) {
+		a := &recordingMutationAuthorizer{}
+		ctx := fieldCtx("Mutation", "createMemo", map[string]any{
+			"workspaceId": "ws",
+			"input":       graphql1.CreateMemoInput{CaseID: 7, Title: "note"},
+		})
+		_, err := gqlctrl.MutationPolicyMiddleware(a, nil)(ctx, resolved)
+		gt.NoError(t, err)
+		gt.Array(t, a.got).Length(1).Required()
+		gt.Number(t, a.got[0].CaseID).Equal(7)
+	})
+
+	t.Run("an action mutation targets the action's case", func(t *testing.T) {
+		repo := memory.New()
+		action, err := repo.Action().Create(context.Background(), "ws", &model.Action{CaseID: 5, Title: "block sender"})
+		gt.NoError(t, err).Required()
+
+		a := &recordingMutationAuthorizer{}
+		ctx := fieldCtx("Mutation", "archiveAction", map[string]any{"workspaceId": "ws", "id": action.ID})
+		_, err = gqlctrl.MutationPolicyMiddleware(a, repo.Action())(ctx, resolved)
+		gt.NoError(t, err)
+
+		ctx = fieldCtx("Mutation", "addActionStep", map[string]any{
+			"workspaceId": "ws",
+			"input":       graphql1.AddActionStepInput{ActionID: int(action.ID), Title: "ask mail team"},
+		})
+		_, err = gqlctrl.MutationPolicyMiddleware(a, repo.Action())(ctx, resolved)
+		gt.NoError(t, err)
+
+		gt.Array(t, a.got).Length(2).Required()
+		gt.Number(t, a.got[0].CaseID).Equal(5)
+		gt.Number(t, a.got[1].CaseID).Equal(5)
+		gt.Array(t, a.got[1].CaseIDs).Length(0)
+	})
+
+	t.Run("an action moved to another case lists both cases", func(t *testing.T) {
+		repo := memory.New()
+		action, err := repo.Action().Create(context.Background(), "ws", &model.Action{CaseID: 5, Title: "block sender"})
+		gt.NoError(t, err).Required()
+		dest := 9
+
+		a := &recordingMutationAuthorizer{}
+		ctx := fieldCtx("Mutation", "updateAction", map[string]any{
+			"workspaceId": "ws",
+			"input":       graphql1.UpdateActionInput{ID: int(action.ID), CaseID: &dest},
+		})
+		_, err = gqlctrl.MutationPolicyMiddleware(a, repo.Action())(ctx, resolved)
+		gt.NoError(t, err)
+		gt.Array(t, a.got).Length(1).Required()
+		gt.Number(t, a.got[0].CaseID).Equal(5)
+		gt.Value(t, a.got[0].CaseIDs).Equal([]int64{5, 9})
+	})
+
+	t.Run("an unknown action resolves to no case", func(t *testing.T) {
+		a := &recordingMutationAuthorizer{}
+		ctx := fieldCtx("Mutation", "archiveAction", map[string]any{"workspaceId": "ws", "id": 3})
+		_, err := gqlctrl.MutationPolicyMiddleware(a, memory.New().Action())(ctx, resolved)
+		gt.NoError(t, err)
+		gt.Array(t, a.got).Length(1).Required()
+		gt.Number(t, a.got[0].CaseID).Equal(0)
+	})
+
+	t.Run("a bulk archive lists the cases of its actions", func(t *testing.T) {
+		repo := memory.New()
+		a1, err := repo.Action().Create(context.Background(), "ws", &model.Action{CaseID: 5, Title: "one"})
+		gt.NoError(t, err).Required()
+		a2, err := repo.Action().Create(context.Background(), "ws", &model.Action{CaseID: 6, Title: "two"})
+		gt.NoError(t, err).Required()
+
+		a := &recordingMutationAuthorizer{}
+		ctx := fieldCtx("Mutation", "bulkArchiveActions", map[string]any{"workspaceId": "ws", "ids": []int{int(a1.ID), int(a2.ID)}})
+		_, err = gqlctrl.MutationPolicyMiddleware(a, repo.Action())(ctx, resolved)
+		gt.NoError(t, err)
+		gt.Array(t, a.got).Length(1).Required()
+		gt.Number(t, a.got[0].CaseID).Equal(0)
+		gt.Value(t, a.got[0].CaseIDs).Equal([]int64{5, 6})
+	})
+
+	t.Run("a merge targets the target and lists every case", func(t *testing.T) {
+		a := &recordingMutationAuthorizer{}
+		ctx := fieldCtx("Mutation", "mergeCases", map[string]any{"workspaceId": "ws", "targetId": 4, "sourceIds": []int{7, 8}})
+		_, err := gqlctrl.MutationPolicyMiddleware(a, nil)(ctx, resolved)
+		gt.NoError(t, err)
+		gt.Array(t, a.got).Length(1).Required()
+		gt.Number(t, a.got[0].CaseID).Equal(4)
+		gt.Value(t, a.got[0].CaseIDs).Equal([]int64{4, 7, 8})
+	})
+
+	t.Run("a refusal stops the resolver", func(t *testing.T) {
+		denied := goerr.New("denied")
+		a := &recordingMutationAuthorizer{err: denied}
+		called := false
+		ctx := fieldCtx("Mutation", "deleteKnowledge", map[string]any{"workspaceId": "ws", "id": "k1"})
+		_, err := gqlctrl.MutationPolicyMiddleware(a, nil)(ctx, func(context.Context) (any, error) {
+			called = true
+			return nil, nil
+		})
+		gt.Error(t, err).Is(denied)
+		gt.Bool(t, called).False()
+	})
+
+	t.Run("queries are not consulted", func(t *testing.T) {
+		a := &recordingMutationAuthorizer{}
+		ctx := fieldCtx("Query", "cases", map[string]any{"workspaceId": "ws"})
+		_, err := gqlctrl.MutationPolicyMiddleware(a, nil)(ctx, resolved)
+		gt.NoError(t, err)
+		gt.Array(t, a.got).Length(0)
+	})
+}
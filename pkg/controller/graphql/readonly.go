@@ -0,0 +1,38 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+)
+
+// apiTokenMutations manage the caller's own personal access tokens. A
+// read-only browser session may run them; the tokens it creates are read-only
+// in turn.
+var apiTokenMutations = map[string]bool{
+	"createAPIToken": true,
+	"revokeAPIToken": true,
+}
+
+// ReadOnlySessionMiddleware returns a gqlgen field middleware that refuses
+// every root Mutation field for a read-only session — a user signed in through
+// an identity provider with no Slack account behind them, or a read-only
+// personal access token. Queries pass, so the session can browse whatever the
+// private-case rules let it see.
+func ReadOnlySessionMiddleware() graphql.FieldMiddleware {
+	return func(ctx context.Context, next graphql.Resolver) (any, error) {
+		fc := graphql.GetFieldContext(ctx)
+		if fc == nil || fc.Object != "Mutation" {
+			return next(ctx)
+		}
+		if token, err := auth.TokenFromContext(ctx); err == nil && token.ReadOnly &&
+			(token.APIToken || !apiTokenMutations[fc.Field.Name]) {
+			return nil, goerr.Wrap(usecase.ErrAccessDenied, "read-only session cannot change anything",
+				goerr.V("operation", fc.Field.Name), goerr.V("provider", token.Provider))
+		}
+		return next(ctx)
+	}
+}
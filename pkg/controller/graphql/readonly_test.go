@@ -0,0 +1,62 @@
+package graphql_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/m-mizutani/gt"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	gqlctrl "github.com/secmon-lab/hecatoncheires/pkg/controller/graphql"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+)
+
+func TestReadOnlySessionMiddleware(t *testing.T) {
+	resolved := func(context.Context) (any, error) { return "resolved", nil }
+	readOnlyCtx := func(object, name string) context.Context {
+		ctx := auth.ContextWithToken(context.Background(),
+			&auth.Token{Sub: "okta:00u1", Email: "auditor@example.com", Provider: "okta", ReadOnly: true})
+		return graphql.WithFieldContext(ctx, &graphql.FieldContext{
+			Object: object,
+			Field:  graphql.CollectedField{Field: &ast.Field{Name: name}},
+		})
+	}
+
+	t.Run("a read-only session cannot run a mutation", func(t *testing.T) {
+		_, err := gqlctrl.ReadOnlySessionMiddleware()(readOnlyCtx("Mutation", "closeCase"), resolved)
+		gt.Error(t, err).Is(usecase.ErrAccessDenied)
+	})
+
+	t.Run("a read-only session can query", func(t *testing.T) {
+		res, err := gqlctrl.ReadOnlySessionMiddleware()(readOnlyCtx("Query", "cases"), resolved)
+		gt.NoError(t, err)
+		gt.Value(t, res).Equal(any("resolved"))
+	})
+
+	t.Run("a read-only session can manage its API tokens", func(t *testing.T) {
+		res, err := gqlctrl.ReadOnlySessionMiddleware()(readOnlyCtx("Mutation", "createAPIToken"), resolved)
+		gt.NoError(t, err)
+		gt.Value(t, res).Equal(any("resolved"))
+	})
+
+	t.Run("a read-only API token cannot run a mutation", func(t *testing.T) {
+		for _, name := range []string{"closeCase", "createAPIToken"} {
+			ctx := auth.ContextWithToken(context.Background(),
+				&auth.Token{Sub: "U1", Email: "u1@example.com", ReadOnly: true, APIToken: true})
+			ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+				Object: "Mutation",
+				Field:  graphql.CollectedField{Field: &ast.Field{Name: name}},
+			})
+			_, err := gqlctrl.ReadOnlySessionMiddleware()(ctx, resolved)
+			gt.Error(t, err).Is(usecase.ErrAccessDenied)
+		}
+	})
+
+	t.Run("a regular session can run a mutation", func(t *testing.T) {
+		res, err := gqlctrl.ReadOnlySessionMiddleware()(fieldCtx("Mutation", "closeCase", nil), resolved)
+		gt.NoError(t, err)
+		gt.Value(t, res).Equal(any("resolved"))
+	})
+}
@@ -8,6 +8,7 @@ package graphql
 import (
 	"context"
 	"encoding/json"
+	"slices"
 	"strings"
 
 	goerr "github.com/m-mizutani/goerr/v2"
@@ -214,6 +215,57 @@ func (r *actionResolver) StepProgress(ctx context.Context, obj *graphql1.Action)
 	return &graphql1.ActionStepProgress{Done: done, Total: total}, nil
 }
 
+// BlockedBy is the resolver for the blockedBy field.
+func (r *actionResolver) BlockedBy(ctx context.Context, obj *graphql1.Action) ([]*graphql1.Action, error) {
+	out := []*graphql1.Action{}
+	if len(obj.BlockedByIDs) == 0 {
+		return out, nil
+	}
+	siblings, err := loadSiblingActions(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*model.Action, len(siblings))
+	for _, s := range siblings {
+		byID[s.ID] = s
+	}
+	for _, id := range obj.BlockedByIDs {
+		if b, ok := byID[int64(id)]; ok && !b.IsArchived() {
+			out = append(out, toGraphQLAction(b, obj.WorkspaceID))
+		}
+	}
+	return out, nil
+}
+
+// Blocking is the resolver for the blocking field.
+func (r *actionResolver) Blocking(ctx context.Context, obj *graphql1.Action) ([]*graphql1.Action, error) {
+	siblings, err := loadSiblingActions(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	out := []*graphql1.Action{}
+	for _, s := range siblings {
+		if !s.IsArchived() && slices.Contains(s.BlockedBy, int64(obj.ID)) {
+			out = append(out, toGraphQLAction(s, obj.WorkspaceID))
+		}
+	}
+	return out, nil
+}
+
+// Blocked is the resolver for the blocked field.
+func (r *actionResolver) Blocked(ctx context.Context, obj *graphql1.Action) (bool, error) {
+	if len(obj.BlockedByIDs) == 0 {
+		return false, nil
+	}
+	siblings, err := loadSiblingActions(ctx, obj)
+	if err != nil {
+		return false, err
+	}
+	a := &model.Action{ID: int64(obj.ID), CaseID: int64(obj.CaseID), BlockedBy: toInt64IDs(obj.BlockedByIDs)}
+	open := model.OpenActionBlockers(a, siblings, r.UseCases.Case.GetActionStatusSet(obj.WorkspaceID))
+	return len(open) > 0, nil
+}
+
 // Author is the resolver for the author field.
 func (r *actionCommentResolver) Author(ctx context.Context, obj *graphql1.ActionComment) (*graphql1.SlackUser, error) {
 	if obj.AuthorID == "" {
@@ -386,6 +438,22 @@ func (r *caseResolver) SlackChannelURL(ctx context.Context, obj *graphql1.Case)
 	return &url, nil
 }
 
+// DuplicateCandidates is the resolver for the duplicateCandidates field.
+func (r *caseResolver) DuplicateCandidates(ctx context.Context, obj *graphql1.Case) ([]*graphql1.DuplicateCandidate, error) {
+	if obj.AccessDenied {
+		return []*graphql1.DuplicateCandidate{}, nil
+	}
+	candidates, err := r.UseCases.Case.DuplicateCandidates(ctx, obj.WorkspaceID, int64(obj.ID))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to find duplicate candidates")
+	}
+	out := make([]*graphql1.DuplicateCandidate, len(candidates))
+	for i, c := range candidates {
+		out[i] = toGraphQLDuplicateCandidate(c, obj.WorkspaceID)
+	}
+	return out, nil
+}
+
 // Fields is the resolver for the fields field.
 func (r *caseResolver) Fields(ctx context.Context, obj *graphql1.Case) ([]*graphql1.FieldValue, error) {
 	if obj.AccessDenied {
@@ -453,6 +521,60 @@ func (r *caseResolver) SlackMessages(ctx context.Context, obj *graphql1.Case, li
 	}, nil
 }
 
+// Alerts is the resolver for the alerts field.
+func (r *caseResolver) Alerts(ctx context.Context, obj *graphql1.Case) ([]*graphql1.Alert, error) {
+	if obj.AccessDenied {
+		return []*graphql1.Alert{}, nil
+	}
+	alerts, err := r.UseCases.AlertIngest.ListByCase(ctx, obj.WorkspaceID, int64(obj.ID))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list case alerts")
+	}
+	out := make([]*graphql1.Alert, len(alerts))
+	for i, a := range alerts {
+		out[i] = toGraphQLAlert(a)
+	}
+	return out, nil
+}
+
+// SLAClocks is the resolver for the slaClocks field.
+func (r *caseResolver) SLAClocks(ctx context.Context, obj *graphql1.Case) ([]*graphql1.SLAClock, error) {
+	if obj.AccessDenied {
+		return []*graphql1.SLAClock{}, nil
+	}
+	// The clocks need the case's SLA timestamps and field values, which the
+	// GraphQL model does not carry.
+	c, err := GetDataLoaders(ctx).Case.Load(ctx, MakeCaseKey(obj.WorkspaceID, int64(obj.ID)))()
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return []*graphql1.SLAClock{}, nil
+	}
+	clocks := r.UseCases.SLA.Clocks(obj.WorkspaceID, c)
+	out := make([]*graphql1.SLAClock, len(clocks))
+	for i, clock := range clocks {
+		out[i] = toGraphQLSLAClock(clock)
+	}
+	return out, nil
+}
+
+// SLABreaches is the resolver for the slaBreaches field.
+func (r *caseResolver) SLABreaches(ctx context.Context, obj *graphql1.Case) ([]*graphql1.SLABreach, error) {
+	if obj.AccessDenied {
+		return []*graphql1.SLABreach{}, nil
+	}
+	breaches, err := r.UseCases.SLA.ListByCase(ctx, obj.WorkspaceID, int64(obj.ID))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list case sla breaches")
+	}
+	out := make([]*graphql1.SLABreach, len(breaches))
+	for i, b := range breaches {
+		out[i] = toGraphQLSLABreach(b)
+	}
+	return out, nil
+}
+
 // AgentSources is the resolver for the agentSources field.
 func (r *caseResolver) AgentSources(ctx context.Context, obj *graphql1.Case) ([]*graphql1.Source, error) {
 	if obj == nil || obj.AccessDenied {
@@ -493,6 +615,19 @@ func (r *caseResolver) AgentSources(ctx context.Context, obj *graphql1.Case) ([]
 	return out, nil
 }
 
+// Editor is the resolver for the editor field.
+func (r *knowledgeRevisionResolver) Editor(ctx context.Context, obj *graphql1.KnowledgeRevision) (*graphql1.SlackUser, error) {
+	if obj.EditorID == nil {
+		return nil, nil
+	}
+	loaders := GetDataLoaders(ctx)
+	user, err := loaders.SlackUser.Load(ctx, *obj.EditorID)()
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
 // Case is the resolver for the case field.
 func (r *memoResolver) Case(ctx context.Context, obj *graphql1.Memo) (*graphql1.Case, error) {
 	loaders := GetDataLoaders(ctx)
@@ -609,6 +744,19 @@ func (r *mutationResolver) ReopenCase(ctx context.Context, workspaceID string, i
 	return toGraphQLCase(reopened, workspaceID), nil
 }
 
+// MergeCases is the resolver for the mergeCases field.
+func (r *mutationResolver) MergeCases(ctx context.Context, workspaceID string, targetID int, sourceIds []int) (*graphql1.Case, error) {
+	sources := make([]int64, len(sourceIds))
+	for i, id := range sourceIds {
+		sources[i] = int64(id)
+	}
+	merged, err := r.UseCases.Case.MergeCases(ctx, workspaceID, int64(targetID), sources)
+	if err != nil {
+		return nil, err
+	}
+	return toGraphQLCase(merged, workspaceID), nil
+}
+
 // UpdateCaseStatus is the resolver for the updateCaseStatus field.
 func (r *mutationResolver) UpdateCaseStatus(ctx context.Context, workspaceID string, input graphql1.UpdateCaseStatusInput) (*graphql1.Case, error) {
 	updated, err := r.UseCases.Case.UpdateCaseStatus(ctx, workspaceID, int64(input.ID), input.Status)
@@ -710,7 +858,7 @@ func (r *mutationResolver) CreateAction(ctx context.Context, workspaceID string,
 		description = *input.Description
 	}
 
-	created, err := r.UseCases.Action.CreateAction(ctx, workspaceID, int64(input.CaseID), input.Title, description, assigneeID, slackMessageTS, status, input.DueDate)
+	created, err := r.UseCases.Action.CreateAction(ctx, workspaceID, int64(input.CaseID), input.Title, description, assigneeID, slackMessageTS, status, input.DueDate, toInt64IDs(input.BlockedBy)...)
 	if err != nil {
 		return nil, err
 	}
@@ -747,6 +895,12 @@ func (r *mutationResolver) UpdateAction(ctx context.Context, workspaceID string,
 		clearAssignee = *input.ClearAssignee
 	}
 
+	var blockedBy *[]int64
+	if input.BlockedBy != nil {
+		ids := toInt64IDs(input.BlockedBy)
+		blockedBy = &ids
+	}
+
 	actor := usecase.ActorRef{Kind: usecase.ActorKindSystem}
 	if token, tokenErr := auth.TokenFromContext(ctx); tokenErr == nil {
 		actor = usecase.ActorRef{Kind: usecase.ActorKindSlackUser, ID: token.Sub}
@@ -763,6 +917,7 @@ func (r *mutationResolver) UpdateAction(ctx context.Context, workspaceID string,
 		DueDate:        input.DueDate,
 		ClearDueDate:   clearDueDate,
 		ClearAssignee:  clearAssignee,
+		BlockedBy:      blockedBy,
 		SlackSync:      usecase.SlackSyncFull,
 		Actor:          actor,
 	})
@@ -1191,9 +1346,11 @@ func (r *mutationResolver) CreateKnowledge(ctx context.Context, workspaceID stri
 		claim = *input.Claim
 	}
 	created, err := r.UseCases.Knowledge.CreateKnowledge(ctx, workspaceID, usecase.CreateKnowledgeInput{
-		Title:  input.Title,
-		Claim:  claim,
-		TagIDs: toTagIDs(input.TagIds),
+		Title:      input.Title,
+		Claim:      claim,
+		TagIDs:     toTagIDs(input.TagIds),
+		ValidUntil: input.ValidUntil,
+		ReviewBy:   input.ReviewBy,
 	})
 	if err != nil {
 		return nil, err
@@ -1215,10 +1372,14 @@ func (r *mutationResolver) UpdateKnowledge(ctx context.Context, workspaceID stri
 		tagIDs = &ids
 	}
 	updated, err := r.UseCases.Knowledge.UpdateKnowledge(ctx, workspaceID, usecase.UpdateKnowledgeInput{
-		ID:     model.KnowledgeID(input.ID),
-		Title:  input.Title,
-		Claim:  input.Claim,
-		TagIDs: tagIDs,
+		ID:              model.KnowledgeID(input.ID),
+		Title:           input.Title,
+		Claim:           input.Claim,
+		TagIDs:          tagIDs,
+		ValidUntil:      input.ValidUntil,
+		ReviewBy:        input.ReviewBy,
+		ClearValidUntil: input.ClearValidUntil != nil && *input.ClearValidUntil,
+		ClearReviewBy:   input.ClearReviewBy != nil && *input.ClearReviewBy,
 	})
 	if err != nil {
 		return nil, err
@@ -1238,6 +1399,19 @@ func (r *mutationResolver) DeleteKnowledge(ctx context.Context, workspaceID stri
 	return true, nil
 }
 
+// RestoreKnowledgeRevision is the resolver for the restoreKnowledgeRevision field.
+func (r *mutationResolver) RestoreKnowledgeRevision(ctx context.Context, workspaceID string, id string, revision int) (*graphql1.Knowledge, error) {
+	restored, err := r.UseCases.Knowledge.RestoreKnowledgeRevision(ctx, workspaceID, model.KnowledgeID(id), revision)
+	if err != nil {
+		return nil, err
+	}
+	tagByID, err := r.tagByIDFor(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	return toGraphQLKnowledge(restored, tagByID), nil
+}
+
 // CreateTag is the resolver for the createTag field.
 func (r *mutationResolver) CreateTag(ctx context.Context, workspaceID string, name *string) (*graphql1.Tag, error) {
 	n := ""
@@ -1272,6 +1446,43 @@ func (r *mutationResolver) DeleteTag(ctx context.Context, workspaceID string, id
 	return true, nil
 }
 
+// CreateAPIToken is the resolver for the createAPIToken field.
+func (r *mutationResolver) CreateAPIToken(ctx context.Context, input graphql1.CreateAPITokenInput) (*graphql1.CreatedAPIToken, error) {
+	in := usecase.CreateAPITokenInput{
+		Name:         input.Name,
+		WorkspaceIDs: input.WorkspaceIds,
+	}
+	if input.ReadOnly != nil {
+		in.ReadOnly = *input.ReadOnly
+	}
+	if input.ExpiresInDays != nil {
+		in.ExpiresInDays = *input.ExpiresInDays
+	}
+
+	token, secret, err := r.UseCases.APIToken.Create(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return &graphql1.CreatedAPIToken{Token: toGraphQLAPIToken(token), Secret: secret}, nil
+}
+
+// RevokeAPIToken is the resolver for the revokeAPIToken field.
+func (r *mutationResolver) RevokeAPIToken(ctx context.Context, id string) (bool, error) {
+	if err := r.UseCases.APIToken.Revoke(ctx, auth.TokenID(id)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RetryWebhookDelivery is the resolver for the retryWebhookDelivery field.
+func (r *mutationResolver) RetryWebhookDelivery(ctx context.Context, workspaceID string, id string) (*graphql1.WebhookDelivery, error) {
+	d, err := r.UseCases.Webhook.RetryDelivery(ctx, workspaceID, model.WebhookDeliveryID(id))
+	if err != nil {
+		return nil, err
+	}
+	return toGraphQLWebhookDelivery(d), nil
+}
+
 // Health is the resolver for the health field.
 func (r *queryResolver) Health(ctx context.Context) (string, error) {
 	return "ok", nil
@@ -1361,6 +1572,22 @@ func (r *queryResolver) Case(ctx context.Context, workspaceID string, id int) (*
 	return toGraphQLCase(c, workspaceID), nil
 }
 
+// SearchCases is the resolver for the searchCases field.
+func (r *queryResolver) SearchCases(ctx context.Context, workspaceID string, filter *graphql1.CaseSearchFilter, sort *graphql1.CaseSortInput, first *int, after *string) (*graphql1.CaseConnection, error) {
+	result, err := r.UseCases.Case.SearchCases(ctx, workspaceID, toSearchCasesInput(filter, sort, first, after))
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*graphql1.Case, len(result.Items))
+	for i, c := range result.Items {
+		items[i] = toGraphQLCase(c, workspaceID)
+	}
+	return &graphql1.CaseConnection{
+		Items:      items,
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
 // Drafts is the resolver for the drafts field.
 func (r *queryResolver) Drafts(ctx context.Context, workspaceID string) ([]*graphql1.Case, error) {
 	drafts, err := r.UseCases.Case.ListDrafts(ctx, workspaceID)
@@ -1583,6 +1810,36 @@ func (r *queryResolver) SlackJoinedChannels(ctx context.Context) ([]*graphql1.Sl
 	return result, nil
 }
 
+// APITokens is the resolver for the apiTokens field.
+func (r *queryResolver) APITokens(ctx context.Context) ([]*graphql1.APIToken, error) {
+	tokens, err := r.UseCases.APIToken.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*graphql1.APIToken, len(tokens))
+	for i, t := range tokens {
+		result[i] = toGraphQLAPIToken(t)
+	}
+	return result, nil
+}
+
+// WebhookDeliveries is the resolver for the webhookDeliveries field.
+func (r *queryResolver) WebhookDeliveries(ctx context.Context, workspaceID string, status *graphql1.WebhookDeliveryStatus, first *int) ([]*graphql1.WebhookDelivery, error) {
+	limit := 0
+	if first != nil {
+		limit = *first
+	}
+	deliveries, err := r.UseCases.Webhook.ListDeliveries(ctx, workspaceID, webhookDeliveryStatusFromGraphQL(status), limit)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*graphql1.WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		result[i] = toGraphQLWebhookDelivery(d)
+	}
+	return result, nil
+}
+
 // Sources is the resolver for the sources field.
 func (r *queryResolver) Sources(ctx context.Context, workspaceID string) ([]*graphql1.Source, error) {
 	sources, err := r.UseCases.Source.ListSources(ctx, workspaceID)
@@ -1878,13 +2135,63 @@ func (r *queryResolver) Knowledge(ctx context.Context, workspaceID string, id st
 	return toGraphQLKnowledge(k, tagByID), nil
 }
 
+// KnowledgeRevisions is the resolver for the knowledgeRevisions field.
+func (r *queryResolver) KnowledgeRevisions(ctx context.Context, workspaceID string, id string) ([]*graphql1.KnowledgeRevision, error) {
+	revs, err := r.UseCases.Knowledge.ListKnowledgeRevisions(ctx, workspaceID, model.KnowledgeID(id))
+	if err != nil {
+		return nil, err
+	}
+	tagByID, err := r.tagByIDFor(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*graphql1.KnowledgeRevision, len(revs))
+	for i, rev := range revs {
+		result[i] = toGraphQLKnowledgeRevision(rev, tagByID)
+	}
+	return result, nil
+}
+
+// KnowledgeRevisionDiff is the resolver for the knowledgeRevisionDiff field.
+func (r *queryResolver) KnowledgeRevisionDiff(ctx context.Context, workspaceID string, id string, from int, to int) (*graphql1.KnowledgeRevisionDiff, error) {
+	diff, err := r.UseCases.Knowledge.DiffKnowledgeRevisions(ctx, workspaceID, model.KnowledgeID(id), from, to)
+	if err != nil {
+		return nil, err
+	}
+	tagByID, err := r.tagByIDFor(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	return toGraphQLKnowledgeRevisionDiff(diff, tagByID), nil
+}
+
+// KnowledgeDueForReview is the resolver for the knowledgeDueForReview field.
+func (r *queryResolver) KnowledgeDueForReview(ctx context.Context, workspaceID string) ([]*graphql1.Knowledge, error) {
+	items, err := r.UseCases.Knowledge.ListKnowledgeDueForReview(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	tagByID, err := r.tagByIDFor(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*graphql1.Knowledge, len(items))
+	for i, k := range items {
+		result[i] = toGraphQLKnowledge(k, tagByID)
+	}
+	return result, nil
+}
+
 // SearchKnowledge is the resolver for the searchKnowledge field.
-func (r *queryResolver) SearchKnowledge(ctx context.Context, workspaceID string, query string, tagIds []string, limit *int) ([]*graphql1.Knowledge, error) {
+func (r *queryResolver) SearchKnowledge(ctx context.Context, workspaceID string, query string, tagIds []string, limit *int, includeExpired *bool) ([]*graphql1.KnowledgeSearchHit, error) {
 	in := usecase.SearchKnowledgeInput{Query: query, TagIDs: toTagIDs(tagIds)}
 	if limit != nil {
 		in.Limit = *limit
 	}
-	items, err := r.UseCases.Knowledge.SearchKnowledge(ctx, workspaceID, in)
+	if includeExpired != nil {
+		in.IncludeExpired = *includeExpired
+	}
+	hits, err := r.UseCases.Knowledge.SearchKnowledge(ctx, workspaceID, in)
 	if err != nil {
 		return nil, err
 	}
@@ -1892,9 +2199,9 @@ func (r *queryResolver) SearchKnowledge(ctx context.Context, workspaceID string,
 	if err != nil {
 		return nil, err
 	}
-	result := make([]*graphql1.Knowledge, len(items))
-	for i, k := range items {
-		result[i] = toGraphQLKnowledge(k, tagByID)
+	result := make([]*graphql1.KnowledgeSearchHit, len(hits))
+	for i, h := range hits {
+		result[i] = toGraphQLKnowledgeSearchHit(h, tagByID)
 	}
 	return result, nil
 }
@@ -1921,6 +2228,51 @@ func (r *queryResolver) Tag(ctx context.Context, workspaceID string, id string)
 	return toGraphQLTag(t), nil
 }
 
+// CaseChanged is the resolver for the caseChanged field.
+func (r *subscriptionResolver) CaseChanged(ctx context.Context, workspaceID string) (<-chan *graphql1.CaseChangeEvent, error) {
+	if r.UseCases.Live == nil {
+		return nil, errLiveUpdatesDisabled
+	}
+	changes, err := r.UseCases.Live.SubscribeCases(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	return convertStream(ctx, changes, func(c *usecase.CaseChange) (*graphql1.CaseChangeEvent, error) {
+		return toGraphQLCaseChangeEvent(c, workspaceID), nil
+	}), nil
+}
+
+// ActionChanged is the resolver for the actionChanged field.
+func (r *subscriptionResolver) ActionChanged(ctx context.Context, workspaceID string, caseID int) (<-chan *graphql1.ActionChangeEvent, error) {
+	if r.UseCases.Live == nil {
+		return nil, errLiveUpdatesDisabled
+	}
+	changes, err := r.UseCases.Live.SubscribeActions(ctx, workspaceID, int64(caseID))
+	if err != nil {
+		return nil, err
+	}
+	return convertStream(ctx, changes, func(c *usecase.ActionChange) (*graphql1.ActionChangeEvent, error) {
+		return toGraphQLActionChangeEvent(c, workspaceID), nil
+	}), nil
+}
+
+// JobRunEventAdded is the resolver for the jobRunEventAdded field.
+func (r *subscriptionResolver) JobRunEventAdded(ctx context.Context, workspaceID string, caseID int, runID string, afterSequence *int) (<-chan *graphql1.JobRunEvent, error) {
+	if r.UseCases.Live == nil {
+		return nil, errLiveUpdatesDisabled
+	}
+	var after *int64
+	if afterSequence != nil {
+		v := int64(*afterSequence)
+		after = &v
+	}
+	events, err := r.UseCases.Live.SubscribeJobRunEvents(ctx, workspaceID, int64(caseID), runID, after)
+	if err != nil {
+		return nil, err
+	}
+	return convertStream(ctx, events, toGraphQLJobRunEvent), nil
+}
+
 // Action returns ActionResolver implementation.
 func (r *Resolver) Action() ActionResolver { return &actionResolver{r} }
 
@@ -1933,6 +2285,11 @@ func (r *Resolver) ActionEvent() ActionEventResolver { return &actionEventResolv
 // Case returns CaseResolver implementation.
 func (r *Resolver) Case() CaseResolver { return &caseResolver{r} }
 
+// KnowledgeRevision returns KnowledgeRevisionResolver implementation.
+func (r *Resolver) KnowledgeRevision() KnowledgeRevisionResolver {
+	return &knowledgeRevisionResolver{r}
+}
+
 // Memo returns MemoResolver implementation.
 func (r *Resolver) Memo() MemoResolver { return &memoResolver{r} }
 
@@ -1942,12 +2299,17 @@ func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
 type (
-	actionResolver        struct{ *Resolver }
-	actionCommentResolver struct{ *Resolver }
-	actionEventResolver   struct{ *Resolver }
-	caseResolver          struct{ *Resolver }
-	memoResolver          struct{ *Resolver }
-	mutationResolver      struct{ *Resolver }
-	queryResolver         struct{ *Resolver }
+	actionResolver            struct{ *Resolver }
+	actionCommentResolver     struct{ *Resolver }
+	actionEventResolver       struct{ *Resolver }
+	caseResolver              struct{ *Resolver }
+	knowledgeRevisionResolver struct{ *Resolver }
+	memoResolver              struct{ *Resolver }
+	mutationResolver          struct{ *Resolver }
+	queryResolver             struct{ *Resolver }
+	subscriptionResolver      struct{ *Resolver }
 )
@@ -0,0 +1,43 @@
+package graphql
+
+import (
+	"strings"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	graphql1 "github.com/secmon-lab/hecatoncheires/pkg/domain/model/graphql"
+)
+
+func toGraphQLSLAKind(k model.SLAKind) graphql1.SLAKind {
+	return graphql1.SLAKind(strings.ToUpper(string(k)))
+}
+
+func toGraphQLSLAClock(c model.SLAClock) *graphql1.SLAClock {
+	return &graphql1.SLAClock{
+		Kind:           toGraphQLSLAKind(c.Kind),
+		TargetSeconds:  int(c.Target.Seconds()),
+		ElapsedSeconds: int(c.Elapsed.Seconds()),
+		DueAt:          c.DueAt,
+		StoppedAt:      c.StoppedAt,
+		Paused:         c.Paused,
+		Breached:       c.Breached,
+	}
+}
+
+func toGraphQLSLABreach(b *model.SLABreach) *graphql1.SLABreach {
+	return &graphql1.SLABreach{
+		Kind:          toGraphQLSLAKind(b.Kind),
+		TargetSeconds: int(b.Target.Seconds()),
+		DueAt:         b.DueAt,
+		RecordedAt:    b.RecordedAt,
+	}
+}
+
+func toGraphQLOpenSLABreach(m *model.OpenSLABreach) *graphql1.OpenSLABreach {
+	return &graphql1.OpenSLABreach{
+		WorkspaceID:   m.WorkspaceID,
+		WorkspaceName: m.WorkspaceName,
+		CaseID:        int(m.CaseID),
+		CaseTitle:     m.CaseTitle,
+		Breach:        toGraphQLSLABreach(m.Breach),
+	}
+}
@@ -0,0 +1,90 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	graphql1 "github.com/secmon-lab/hecatoncheires/pkg/domain/model/graphql"
+	slacksvc "github.com/secmon-lab/hecatoncheires/pkg/service/slack"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+var errLiveUpdatesDisabled = goerr.New("live updates are not enabled on this server")
+
+// convertStream converts each value of in until in is closed or ctx is done.
+// A value that fails to convert is reported and skipped rather than ending
+// the subscription.
+func convertStream[S, T any](ctx context.Context, in <-chan S, convert func(S) (T, error)) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for v := range in {
+			converted, err := convert(v)
+			if err != nil {
+				errutil.Handle(ctx, goerr.Wrap(err, "failed to convert subscription event"),
+					"failed to convert subscription event")
+				continue
+			}
+			select {
+			case out <- converted:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func toGraphQLLiveChangeKind(c model.LiveChange) graphql1.LiveChangeKind {
+	switch c {
+	case model.LiveChangeUpdated:
+		return graphql1.LiveChangeKindUpdated
+	case model.LiveChangeDeleted:
+		return graphql1.LiveChangeKindDeleted
+	default:
+		return graphql1.LiveChangeKindCreated
+	}
+}
+
+func toGraphQLCaseChangeEvent(c *usecase.CaseChange, workspaceID string) *graphql1.CaseChangeEvent {
+	ev := &graphql1.CaseChangeEvent{
+		Kind:   toGraphQLLiveChangeKind(c.Change),
+		CaseID: int(c.CaseID),
+	}
+	if c.Case != nil {
+		ev.Case = toGraphQLCase(c.Case, workspaceID)
+	}
+	return ev
+}
+
+func toGraphQLActionChangeEvent(c *usecase.ActionChange, workspaceID string) *graphql1.ActionChangeEvent {
+	ev := &graphql1.ActionChangeEvent{
+		Kind:     toGraphQLLiveChangeKind(c.Change),
+		ActionID: int(c.ActionID),
+	}
+	if c.Action != nil {
+		ev.Action = toGraphQLAction(c.Action, workspaceID)
+	}
+	return ev
+}
+
+// SubscriptionDataLoadersMiddleware gives every response of a subscription a
+// fresh set of DataLoaders. The set installed per HTTP request would
+// otherwise live as long as the subscription does, and its cache would keep
+// serving the reporter, assignees and actions it loaded for the first event
+// to every later one.
+func SubscriptionDataLoadersMiddleware(repo interfaces.Repository, slackSvc slacksvc.Service) graphql.ResponseMiddleware {
+	return func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+		if graphql.HasOperationContext(ctx) {
+			if op := graphql.GetOperationContext(ctx).Operation; op != nil && op.Operation == ast.Subscription {
+				ctx = WithDataLoaders(ctx, NewDataLoaders(repo, slackSvc))
+			}
+		}
+		return next(ctx)
+	}
+}
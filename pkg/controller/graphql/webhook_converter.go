@@ -0,0 +1,55 @@
+package graphql
+
+import (
+	"strings"
+	"time"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	graphql1 "github.com/secmon-lab/hecatoncheires/pkg/domain/model/graphql"
+)
+
+// toGraphQLWebhookDelivery maps a WebhookDelivery. Unset times, status code
+// and error become null; nextAttemptAt is only reported while the delivery
+// is still pending.
+func toGraphQLWebhookDelivery(d *model.WebhookDelivery) *graphql1.WebhookDelivery {
+	gql := &graphql1.WebhookDelivery{
+		ID:            d.ID.String(),
+		WebhookID:     d.WebhookID,
+		Event:         string(d.Event),
+		CaseID:        int(d.CaseID),
+		Status:        graphql1.WebhookDeliveryStatus(strings.ToUpper(string(d.Status))),
+		Payload:       d.Payload,
+		Attempts:      d.Attempts,
+		LastAttemptAt: optionalTime(d.LastAttemptAt),
+		DeliveredAt:   optionalTime(d.DeliveredAt),
+		CreatedAt:     d.CreatedAt,
+	}
+	if d.Status == model.WebhookDeliveryPending {
+		gql.NextAttemptAt = optionalTime(d.NextAttemptAt)
+	}
+	if d.LastStatusCode != 0 {
+		code := d.LastStatusCode
+		gql.LastStatusCode = &code
+	}
+	if d.LastError != "" {
+		msg := d.LastError
+		gql.LastError = &msg
+	}
+	return gql
+}
+
+// webhookDeliveryStatusFromGraphQL maps the optional status filter; nil
+// lists every status.
+func webhookDeliveryStatusFromGraphQL(s *graphql1.WebhookDeliveryStatus) model.WebhookDeliveryStatus {
+	if s == nil {
+		return ""
+	}
+	return model.WebhookDeliveryStatus(strings.ToLower(string(*s)))
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
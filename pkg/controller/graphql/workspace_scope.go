@@ -0,0 +1,78 @@
+package graphql
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+)
+
+// workspaceFreeFields are the root fields that read or change nothing inside
+// a workspace, so a workspace-scoped token may call them whatever its scope.
+var workspaceFreeFields = map[string]bool{
+	"health":               true,
+	"noop":                 true,
+	"workspaces":           true,
+	"workspaceGroups":      true,
+	"slackUsers":           true,
+	"slackJoinedChannels":  true,
+	"favoriteWorkspaceIds": true,
+	"homeMessage":          true,
+	"apiTokens":            true,
+	"createAPIToken":       true,
+	"revokeAPIToken":       true,
+}
+
+// WorkspaceScopeMiddleware returns a gqlgen field middleware that confines a
+// request whose token is scoped to workspaces (a personal access token created
+// with a workspace list) to those workspaces. It checks root Query and
+// Mutation fields: one naming a workspaceId must name an admitted workspace,
+// and one naming none must be in workspaceFreeFields. Anything else — such as
+// the cross-workspace dashboard feeds — is refused, so a root field added
+// later fails closed for scoped tokens until it is classified.
+//
+// A refusal surfaces as the field's error; the error presenter classifies the
+// wrapped usecase.ErrAccessDenied as FORBIDDEN.
+func WorkspaceScopeMiddleware() graphql.FieldMiddleware {
+	return func(ctx context.Context, next graphql.Resolver) (any, error) {
+		fc := graphql.GetFieldContext(ctx)
+		if fc == nil || (fc.Object != "Query" && fc.Object != "Mutation" && fc.Object != "Subscription") {
+			return next(ctx)
+		}
+		token, err := auth.TokenFromContext(ctx)
+		if err != nil || token.Workspaces == nil {
+			return next(ctx)
+		}
+
+		name := fc.Field.Name
+		if strings.HasPrefix(name, "__") {
+			return next(ctx)
+		}
+
+		var requested []string
+		switch {
+		case fc.Args["workspaceId"] != nil:
+			id, _ := fc.Args["workspaceId"].(string)
+			requested = []string{id}
+		case name == "setFavoriteWorkspaces":
+			requested, _ = fc.Args["workspaceIds"].([]string)
+		case workspaceFreeFields[name]:
+			return next(ctx)
+		default:
+			return nil, goerr.Wrap(usecase.ErrAccessDenied, "operation spans workspaces outside the token's scope",
+				goerr.V("operation", name))
+		}
+
+		for _, id := range requested {
+			if !slices.Contains(token.Workspaces, id) {
+				return nil, goerr.Wrap(usecase.ErrAccessDenied, "workspace is outside the token's scope",
+					goerr.V("operation", name), goerr.V("workspace_id", id))
+			}
+		}
+		return next(ctx)
+	}
+}
@@ -0,0 +1,55 @@
+package graphql_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/m-mizutani/gt"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	gqlctrl "github.com/secmon-lab/hecatoncheires/pkg/controller/graphql"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+)
+
+func TestWorkspaceScopeMiddleware(t *testing.T) {
+	resolved := func(context.Context) (any, error) { return "resolved", nil }
+	scopedCtx := func(object, name string, args map[string]any) context.Context {
+		ctx := auth.ContextWithToken(context.Background(),
+			&auth.Token{Sub: "U1", Email: "u1@example.com", APIToken: true, Workspaces: []string{"ws-a"}})
+		return graphql.WithFieldContext(ctx, &graphql.FieldContext{
+			Object: object,
+			Field:  graphql.CollectedField{Field: &ast.Field{Name: name}},
+			Args:   args,
+		})
+	}
+
+	for _, tc := range []struct {
+		name    string
+		ctx     context.Context
+		allowed bool
+	}{
+		{"a query in scope", scopedCtx("Query", "cases", map[string]any{"workspaceId": "ws-a"}), true},
+		{"a query out of scope", scopedCtx("Query", "cases", map[string]any{"workspaceId": "ws-b"}), false},
+		{"a mutation out of scope", scopedCtx("Mutation", "closeCase", map[string]any{"workspaceId": "ws-b", "id": 1}), false},
+		{"a subscription out of scope", scopedCtx("Subscription", "caseChanged", map[string]any{"workspaceId": "ws-b"}), false},
+		{"a workspace-free query", scopedCtx("Query", "workspaces", nil), true},
+		{"introspection", scopedCtx("Query", "__schema", nil), true},
+		{"a cross-workspace query", scopedCtx("Query", "myOpenCases", nil), false},
+		{"favorites in scope", scopedCtx("Mutation", "setFavoriteWorkspaces", map[string]any{"workspaceIds": []string{"ws-a"}}), true},
+		{"favorites out of scope", scopedCtx("Mutation", "setFavoriteWorkspaces", map[string]any{"workspaceIds": []string{"ws-a", "ws-b"}}), false},
+		{"a nested field", scopedCtx("Case", "actions", nil), true},
+		{"an unscoped token", fieldCtx("Query", "myOpenCases", nil), true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := gqlctrl.WorkspaceScopeMiddleware()(tc.ctx, resolved)
+			if tc.allowed {
+				gt.NoError(t, err)
+				gt.Value(t, res).Equal(any("resolved"))
+			} else {
+				gt.Error(t, err).Is(usecase.ErrAccessDenied)
+			}
+		})
+	}
+}
@@ -5,10 +5,12 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/url"
 	"strings"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/m-mizutani/goerr/v2"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
 	"github.com/secmon-lab/hecatoncheires/pkg/service/slack"
@@ -66,9 +68,15 @@ type profileImages struct {
 }
 
 type userMeResponse struct {
-	Sub   string `json:"sub"`
-	Email string `json:"email"`
-	Name  string `json:"name"`
+	Sub      string `json:"sub"`
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	Provider string `json:"provider,omitempty"`
+	ReadOnly bool   `json:"read_only,omitempty"`
+}
+
+type providersResponse struct {
+	Providers []usecase.IdentityProviderInfo `json:"providers"`
 }
 
 type errorResponse struct {
@@ -141,13 +149,34 @@ func authLoginHandler(authUC AuthUseCase) http.HandlerFunc {
 			http.SetCookie(w, returnToCookie)
 		}
 
-		// Redirect to Slack OAuth
-		authURL := authUC.GetAuthURL(state)
+		// Redirect to the chosen identity provider (the default one when the
+		// request names none)
+		authURL, err := authUC.GetAuthURL(r.URL.Query().Get("provider"), state)
+		if err != nil {
+			errutil.HandleHTTP(r.Context(), w, err, authErrorStatus(err))
+			return
+		}
 		http.Redirect(w, r, authURL, http.StatusTemporaryRedirect)
 	}
 }
 
-// authCallbackHandler handles the OAuth callback
+// authErrorStatus maps a sign-in failure to its HTTP status: a provider that
+// is not configured is 404, a user the deployment does not admit is 403, and
+// anything else is the server's (or the provider's) failure.
+func authErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, usecase.ErrUnknownIdentityProvider):
+		return http.StatusNotFound
+	case errors.Is(err, usecase.ErrIdentityNotAllowed):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// authCallbackHandler handles the OAuth callback. The provider comes from the
+// route (/api/auth/callback/{provider}); the bare /api/auth/callback is Sign in
+// with Slack's, which predates the other providers.
 func authCallbackHandler(authUC AuthUseCase) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Verify state parameter
@@ -201,10 +230,15 @@ func authCallbackHandler(authUC AuthUseCase) http.HandlerFunc {
 			return
 		}
 
+		provider := chi.URLParam(r, "provider")
+		if provider == "" {
+			provider = usecase.SlackIdentityProviderName
+		}
+
 		// Exchange code for token
-		token, err := authUC.HandleCallback(r.Context(), code)
+		token, err := authUC.HandleCallback(r.Context(), provider, code)
 		if err != nil {
-			errutil.HandleHTTP(r.Context(), w, err, http.StatusInternalServerError)
+			errutil.HandleHTTP(r.Context(), w, err, authErrorStatus(err))
 			return
 		}
 
@@ -370,9 +404,22 @@ func authMeHandler(authUC AuthUseCase) http.HandlerFunc {
 
 		// Return user info
 		writeJSON(r.Context(), w, http.StatusOK, userMeResponse{
-			Sub:   token.Sub,
-			Email: token.Email,
-			Name:  token.Name,
+			Sub:      token.Sub,
+			Email:    token.Email,
+			Name:     token.Name,
+			Provider: token.Provider,
+			ReadOnly: token.ReadOnly,
 		})
 	}
 }
+
+// authProvidersHandler lists the identity providers the login page offers
+func authProvidersHandler(authUC AuthUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		providers := authUC.Providers()
+		if providers == nil {
+			providers = []usecase.IdentityProviderInfo{}
+		}
+		writeJSON(r.Context(), w, http.StatusOK, providersResponse{Providers: providers})
+	}
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"strings"
 	"testing"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/m-mizutani/gt"
 	httpctrl "github.com/secmon-lab/hecatoncheires/pkg/controller/http"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
 )
 
 // fakeAuthUC is a minimal in-memory stand-in for usecase.AuthUseCaseInterface
@@ -19,13 +22,40 @@ type fakeAuthUC struct {
 	isNoAuthn        bool
 	authURL          string
 	handleCallbackFn func(ctx context.Context, code string) (*auth.Token, error)
+
+	// providers, when set, are the only provider names the fake knows; ""
+	// always resolves. provider records the name of the last call.
+	providers []string
+	provider  string
+}
+
+func (f *fakeAuthUC) Providers() []usecase.IdentityProviderInfo {
+	var out []usecase.IdentityProviderInfo
+	for _, name := range f.providers {
+		out = append(out, usecase.IdentityProviderInfo{Name: name, Label: name})
+	}
+	return out
 }
 
-func (f *fakeAuthUC) GetAuthURL(state string) string {
-	return f.authURL + "?state=" + state
+func (f *fakeAuthUC) resolve(provider string) error {
+	f.provider = provider
+	if provider == "" || f.providers == nil || slices.Contains(f.providers, provider) {
+		return nil
+	}
+	return usecase.ErrUnknownIdentityProvider
+}
+
+func (f *fakeAuthUC) GetAuthURL(provider, state string) (string, error) {
+	if err := f.resolve(provider); err != nil {
+		return "", err
+	}
+	return f.authURL + "?state=" + state, nil
 }
 
-func (f *fakeAuthUC) HandleCallback(ctx context.Context, code string) (*auth.Token, error) {
+func (f *fakeAuthUC) HandleCallback(ctx context.Context, provider, code string) (*auth.Token, error) {
+	if err := f.resolve(provider); err != nil {
+		return nil, err
+	}
 	if f.handleCallbackFn != nil {
 		return f.handleCallbackFn(ctx, code)
 	}
@@ -36,6 +66,16 @@ func (f *fakeAuthUC) ValidateToken(ctx context.Context, id auth.TokenID, secret
 	return auth.NewToken("U1", "user@example.com", "Test User"), nil
 }
 
+// ValidateAPIToken admits only the bearer credential "hct_good".
+func (f *fakeAuthUC) ValidateAPIToken(ctx context.Context, bearer string) (*auth.Token, error) {
+	if bearer != "hct_good" {
+		return nil, usecase.ErrAccessDenied
+	}
+	token := auth.NewToken("U_PAT", "pat@example.com", "PAT User")
+	token.APIToken = true
+	return token, nil
+}
+
 func (f *fakeAuthUC) Logout(ctx context.Context, id auth.TokenID) error {
 	return nil
 }
@@ -145,6 +185,29 @@ func TestAuthLoginHandler(t *testing.T) {
 		gt.Value(t, firstCookie(rec, httpctrl.ReturnToCookieNameForTest)).Nil()
 	})
 
+	t.Run("provider query selects the identity provider", func(t *testing.T) {
+		uc := &fakeAuthUC{authURL: "https://idp.example/auth", providers: []string{"slack", "okta"}}
+		h := httpctrl.AuthLoginHandlerForTest(uc)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/auth/login?provider=okta", nil)
+		rec := httptest.NewRecorder()
+		h(rec, req)
+
+		gt.Number(t, rec.Code).Equal(http.StatusTemporaryRedirect)
+		gt.String(t, uc.provider).Equal("okta")
+	})
+
+	t.Run("unknown provider is 404", func(t *testing.T) {
+		uc := &fakeAuthUC{authURL: "https://idp.example/auth", providers: []string{"slack"}}
+		h := httpctrl.AuthLoginHandlerForTest(uc)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/auth/login?provider=nope", nil)
+		rec := httptest.NewRecorder()
+		h(rec, req)
+
+		gt.Number(t, rec.Code).Equal(http.StatusNotFound)
+	})
+
 	t.Run("no-auth mode honours valid return_to", func(t *testing.T) {
 		uc := &fakeAuthUC{isNoAuthn: true}
 		h := httpctrl.AuthLoginHandlerForTest(uc)
@@ -247,4 +310,38 @@ func TestAuthCallbackHandler(t *testing.T) {
 		gt.Array(t, clears).Length(1).Required()
 		gt.Number(t, clears[0].MaxAge).Equal(-1)
 	})
+
+	t.Run("the route names the provider, and the bare callback is Slack's", func(t *testing.T) {
+		uc := &fakeAuthUC{providers: []string{"slack", "okta"}}
+		r := chi.NewRouter()
+		r.Get("/api/auth/callback", httpctrl.AuthCallbackHandlerForTest(uc))
+		r.Get("/api/auth/callback/{provider}", httpctrl.AuthCallbackHandlerForTest(uc))
+
+		for path, want := range map[string]string{
+			"/api/auth/callback":      "slack",
+			"/api/auth/callback/okta": "okta",
+		} {
+			req := httptest.NewRequest(http.MethodGet, path+"?code=ok&state="+stateValue, nil)
+			req.AddCookie(&http.Cookie{Name: "oauth_state", Value: stateValue})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			gt.Number(t, rec.Code).Equal(http.StatusTemporaryRedirect)
+			gt.String(t, uc.provider).Equal(want)
+			gt.Value(t, firstCookie(rec, "token_id")).NotNil()
+		}
+	})
+
+	t.Run("a user the provider does not admit is 403", func(t *testing.T) {
+		uc := &fakeAuthUC{handleCallbackFn: func(context.Context, string) (*auth.Token, error) {
+			return nil, usecase.ErrIdentityNotAllowed
+		}}
+		h := httpctrl.AuthCallbackHandlerForTest(uc)
+
+		rec := httptest.NewRecorder()
+		h(rec, makeCallbackRequest(""))
+
+		gt.Number(t, rec.Code).Equal(http.StatusForbidden)
+		gt.Value(t, firstCookie(rec, "token_id")).Nil()
+	})
 }
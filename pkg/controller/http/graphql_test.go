@@ -5083,18 +5083,25 @@ func TestGraphQLHandler_KnowledgeLifecycle(t *testing.T) {
 	gt.Value(t, tagsOut.Tags[1].ID).Equal(githubID)
 	gt.Value(t, tagsOut.Tags[1].Name).Equal("github")
 
-	// searchKnowledge (substring fallback, no embed client wired in tests)
+	// searchKnowledge (lexical only, no embed client wired in tests)
 	rec = executeGraphQLRequest(t, h,
-		`query($ws: String!, $q: String!) { searchKnowledge(workspaceId: $ws, query: $q) { id title } }`,
+		`query($ws: String!, $q: String!) { searchKnowledge(workspaceId: $ws, query: $q) { score lexicalScore vectorScore knowledge { id title } } }`,
 		map[string]interface{}{"ws": testWorkspaceID, "q": "github"})
 	resp = parseGraphQLResponse(t, rec)
 	gt.Array(t, resp.Errors).Length(0).Required()
 	var searchOut struct {
-		SearchKnowledge []knowledgePayload `json:"searchKnowledge"`
+		SearchKnowledge []struct {
+			Score        float64          `json:"score"`
+			LexicalScore float64          `json:"lexicalScore"`
+			VectorScore  *float64         `json:"vectorScore"`
+			Knowledge    knowledgePayload `json:"knowledge"`
+		} `json:"searchKnowledge"`
 	}
 	gt.NoError(t, json.Unmarshal(resp.Data, &searchOut)).Required()
 	gt.Array(t, searchOut.SearchKnowledge).Length(1).Required()
-	gt.String(t, searchOut.SearchKnowledge[0].ID).Equal(created.ID)
+	gt.String(t, searchOut.SearchKnowledge[0].Knowledge.ID).Equal(created.ID)
+	gt.Number(t, searchOut.SearchKnowledge[0].LexicalScore).Equal(1)
+	gt.Value(t, searchOut.SearchKnowledge[0].VectorScore).Nil()
 
 	// Update (title + tags). A new tag must be created before it can be referenced.
 	securityID := createTagForTest(t, h, testWorkspaceID, "security")
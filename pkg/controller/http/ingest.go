@@ -0,0 +1,154 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/m-mizutani/goerr/v2"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/authz"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
+)
+
+// ingestMaxBodySize bounds one alert. Alert payloads are a few KiB; only
+// the first model.AlertPayloadMaxLen bytes are stored anyway.
+const ingestMaxBodySize = 1 << 20
+
+// IngestHandler exposes POST /hooks/ingest/{ws_id}: an alerting system posts
+// a JSON alert, and the workspace's [ingest] section turns it into a case.
+//
+// Every alert is authorized against data.auth.ingest before the workspace is
+// looked up. The body is read and decoded first, because the policy sees it
+// as input.alert, so a body that is too large or not a JSON object is refused
+// with 413 or 400 before any credential is checked. The policy is the only
+// credential check — it typically compares a header against a shared token
+// from input.env — so a policy that defines no decision, or fails to
+// evaluate, rejects the alert.
+type IngestHandler struct {
+	ingestUC *usecase.AlertIngestUseCase
+	registry *model.WorkspaceRegistry
+	policy   interfaces.PolicyClient
+	env      map[string]string
+}
+
+// NewIngestHandler builds the handler. policy is mandatory, as for the MCP
+// endpoint.
+func NewIngestHandler(ingestUC *usecase.AlertIngestUseCase, registry *model.WorkspaceRegistry, policy interfaces.PolicyClient, env map[string]string) *IngestHandler {
+	if policy == nil {
+		panic("ingest handler requires a non-nil policy client")
+	}
+	return &IngestHandler{
+		ingestUC: ingestUC,
+		registry: registry,
+		policy:   policy,
+		env:      env,
+	}
+}
+
+// ingestResponse is the JSON body of an accepted alert.
+type ingestResponse struct {
+	AlertID string `json:"alert_id"`
+	CaseID  int64  `json:"case_id"`
+	Repeat  bool   `json:"repeat"`
+}
+
+// ServeHTTP implements http.Handler. It answers 201 when the alert opened a
+// case and 200 when it was folded into an open one.
+func (h *IngestHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	workspaceID := chi.URLParam(r, "ws_id")
+
+	// The body is decoded before the policy runs, since the policy reads it.
+	// The workspace is looked up only after the policy accepted the alert,
+	// so every caller that is not authorized gets the same answer whether or
+	// not the workspace exists or takes alerts.
+	raw, err := io.ReadAll(http.MaxBytesReader(w, r.Body, ingestMaxBodySize))
+	if err != nil {
+		status := http.StatusBadRequest
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		errutil.HandleHTTP(ctx, w, goerr.Wrap(err, "failed to read alert", goerr.T(errutil.TagBenign)), status)
+		return
+	}
+	var alert map[string]any
+	if err := json.Unmarshal(raw, &alert); err != nil || alert == nil {
+		errutil.HandleHTTP(ctx, w, goerr.New("alert must be a JSON object", goerr.T(errutil.TagBenign)), http.StatusBadRequest)
+		return
+	}
+
+	input := authz.IngestInput{
+		Req: &authz.HTTPRequest{
+			Method: r.Method,
+			Path:   r.URL.Path,
+			Header: map[string][]string(r.Header.Clone()),
+		},
+		Env:         h.env,
+		WorkspaceID: workspaceID,
+		Alert:       alert,
+	}
+	if input.Env == nil {
+		input.Env = map[string]string{}
+	}
+	var decision authz.IngestDecision
+	if err := h.policy.Query(ctx, authz.IngestQuery, input, &decision); err != nil {
+		if !errors.Is(err, interfaces.ErrPolicyUndefined) {
+			errutil.Handle(ctx, goerr.Wrap(err, "ingest authorization policy evaluation failed",
+				goerr.V("workspace_id", workspaceID)), "ingest authorization policy evaluation failed")
+		}
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+	if !decision.Allow {
+		msg := "forbidden"
+		if decision.Reason != "" {
+			msg += ": " + decision.Reason
+		}
+		http.Error(w, msg, http.StatusForbidden)
+		return
+	}
+	if decision.User != "" {
+		ctx = auth.ContextWithToken(ctx, &auth.Token{Sub: decision.User})
+	}
+
+	// An unknown workspace and one that takes no alerts look the same.
+	entry, err := h.registry.Get(workspaceID)
+	if err != nil || entry.Ingest == nil {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+
+	result, err := h.ingestUC.Ingest(ctx, workspaceID, alert, raw)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrAlertMapping),
+			errors.Is(err, model.ErrCaseFieldValidation),
+			errors.Is(err, model.ErrMissingRequired),
+			errors.Is(err, usecase.ErrUnknownUser):
+			// The alert does not fit the mapping: the sender's problem.
+			errutil.HandleHTTP(ctx, w, goerr.With(err, goerr.T(errutil.TagBenign)), http.StatusUnprocessableEntity)
+		default:
+			errutil.Handle(ctx, goerr.Wrap(err, "failed to ingest alert"), "failed to ingest alert")
+			http.Error(w, "failed to ingest alert", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	status := http.StatusCreated
+	if result.Repeat {
+		status = http.StatusOK
+	}
+	writeJSON(ctx, w, status, ingestResponse{
+		AlertID: result.Alert.ID.String(),
+		CaseID:  result.Case.ID,
+		Repeat:  result.Repeat,
+	})
+}
@@ -0,0 +1,142 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/m-mizutani/gt"
+
+	httpctrl "github.com/secmon-lab/hecatoncheires/pkg/controller/http"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/authz"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+)
+
+// ingestPolicy allows a request whose X-Token header matches the env token.
+type ingestPolicy struct {
+	undefined bool
+	lastInput authz.IngestInput
+}
+
+func (p *ingestPolicy) Query(_ context.Context, query string, input, out any) error {
+	if query != authz.IngestQuery || p.undefined {
+		return interfaces.ErrPolicyUndefined
+	}
+	in := input.(authz.IngestInput)
+	p.lastInput = in
+	d := out.(*authz.IngestDecision)
+	tokens := in.Req.Header["X-Token"]
+	d.Allow = len(tokens) == 1 && tokens[0] == in.Env["INGEST_TOKEN"]
+	d.User = "UALERTBOT"
+	if !d.Allow {
+		d.Reason = "bad token"
+	}
+	return nil
+}
+
+func newIngestRouter(t *testing.T, policy interfaces.PolicyClient) http.Handler {
+	t.Helper()
+	repo := memory.New()
+	registry := model.NewWorkspaceRegistry()
+	registry.Register(&model.WorkspaceEntry{
+		Workspace: model.Workspace{ID: "soc", Name: "SOC"},
+		Ingest:    &model.IngestMapping{Title: "{{ .rule }}"},
+	})
+	registry.Register(&model.WorkspaceEntry{
+		Workspace: model.Workspace{ID: "plain", Name: "Plain"},
+	})
+	caseUC := usecase.NewCaseUseCase(repo, registry, nil, nil, "")
+	h := httpctrl.NewIngestHandler(usecase.NewAlertIngestUseCase(repo, registry, caseUC, nil),
+		registry, policy, map[string]string{"INGEST_TOKEN": "s3cret"})
+
+	r := chi.NewRouter()
+	r.Post("/hooks/ingest/{ws_id}", h.ServeHTTP)
+	return r
+}
+
+func postAlert(t *testing.T, h http.Handler, ws, token, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/hooks/ingest/"+ws, strings.NewReader(body))
+	req.Header.Set("X-Token", token)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestIngestHandler(t *testing.T) {
+	t.Run("opens a case, then folds the repeat into it", func(t *testing.T) {
+		policy := &ingestPolicy{}
+		h := newIngestRouter(t, policy)
+
+		rec := postAlert(t, h, "soc", "s3cret", `{"rule":"Impossible travel"}`)
+		gt.Number(t, rec.Code).Equal(http.StatusCreated).Required()
+		var first struct {
+			CaseID int64 `json:"case_id"`
+			Repeat bool  `json:"repeat"`
+		}
+		gt.NoError(t, json.Unmarshal(rec.Body.Bytes(), &first)).Required()
+		gt.Bool(t, first.Repeat).False()
+		gt.Value(t, policy.lastInput.WorkspaceID).Equal("soc")
+		gt.Value(t, policy.lastInput.Alert["rule"]).Equal("Impossible travel")
+
+		rec = postAlert(t, h, "soc", "s3cret", `{"rule":"Impossible travel"}`)
+		gt.Number(t, rec.Code).Equal(http.StatusOK).Required()
+		var second struct {
+			CaseID int64 `json:"case_id"`
+			Repeat bool  `json:"repeat"`
+		}
+		gt.NoError(t, json.Unmarshal(rec.Body.Bytes(), &second)).Required()
+		gt.Bool(t, second.Repeat).True()
+		gt.Value(t, second.CaseID).Equal(first.CaseID)
+	})
+
+	t.Run("denied by the policy", func(t *testing.T) {
+		rec := postAlert(t, newIngestRouter(t, &ingestPolicy{}), "soc", "wrong", `{"rule":"r"}`)
+		gt.Number(t, rec.Code).Equal(http.StatusForbidden)
+		gt.String(t, rec.Body.String()).Contains("bad token")
+	})
+
+	t.Run("undefined policy fails closed", func(t *testing.T) {
+		rec := postAlert(t, newIngestRouter(t, &ingestPolicy{undefined: true}), "soc", "s3cret", `{"rule":"r"}`)
+		gt.Number(t, rec.Code).Equal(http.StatusForbidden)
+	})
+
+	t.Run("workspace without [ingest] is not found", func(t *testing.T) {
+		h := newIngestRouter(t, &ingestPolicy{})
+		gt.Number(t, postAlert(t, h, "plain", "s3cret", `{"rule":"r"}`).Code).Equal(http.StatusNotFound)
+		gt.Number(t, postAlert(t, h, "missing", "s3cret", `{"rule":"r"}`).Code).Equal(http.StatusNotFound)
+	})
+
+	t.Run("unauthorized callers cannot tell which workspaces take alerts", func(t *testing.T) {
+		h := newIngestRouter(t, &ingestPolicy{})
+		enabled := postAlert(t, h, "soc", "wrong", `{"rule":"r"}`)
+		for _, ws := range []string{"plain", "missing"} {
+			rec := postAlert(t, h, ws, "wrong", `{"rule":"r"}`)
+			gt.Number(t, rec.Code).Equal(enabled.Code)
+			gt.String(t, rec.Body.String()).Equal(enabled.Body.String())
+		}
+		gt.Number(t, enabled.Code).Equal(http.StatusForbidden)
+
+		undefined := newIngestRouter(t, &ingestPolicy{undefined: true})
+		gt.Number(t, postAlert(t, undefined, "missing", "s3cret", `{"rule":"r"}`).Code).
+			Equal(postAlert(t, undefined, "soc", "s3cret", `{"rule":"r"}`).Code)
+	})
+
+	t.Run("body that is not a JSON object", func(t *testing.T) {
+		h := newIngestRouter(t, &ingestPolicy{})
+		gt.Number(t, postAlert(t, h, "soc", "s3cret", `[1,2]`).Code).Equal(http.StatusBadRequest)
+		gt.Number(t, postAlert(t, h, "soc", "s3cret", `null`).Code).Equal(http.StatusBadRequest)
+	})
+
+	t.Run("alert that does not fit the mapping", func(t *testing.T) {
+		rec := postAlert(t, newIngestRouter(t, &ingestPolicy{}), "soc", "s3cret", `{"detail":"no rule"}`)
+		gt.Number(t, rec.Code).Equal(http.StatusUnprocessableEntity)
+	})
+}
@@ -3,9 +3,13 @@ package http
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
 	"net/http"
 
 	"github.com/m-mizutani/goerr/v2"
+	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
@@ -20,6 +24,9 @@ const (
 	mcpServerVersion = "1.0.0"
 	// mcpPolicyQuery is the Rego entrypoint evaluated for every tool call.
 	mcpPolicyQuery = "data.auth.mcp"
+	// mcpWritePolicyQuery is the Rego entrypoint a write tool call must also
+	// pass, after mcpPolicyQuery allowed it.
+	mcpWritePolicyQuery = "data.auth.mcp_write"
 )
 
 // MCP tool names. Every tool is prefixed with "hecaton_" so it stays
@@ -30,27 +37,59 @@ const (
 	toolGetCases       = "hecaton_get_cases"
 	toolListActions    = "hecaton_list_actions"
 	toolGetActions     = "hecaton_get_actions"
+
+	toolSearchKnowledge = "hecaton_search_knowledge"
+	toolListTags        = "hecaton_list_tags"
+
+	toolCreateCase      = "hecaton_create_case"
+	toolUpdateCase      = "hecaton_update_case"
+	toolCreateAction    = "hecaton_create_action"
+	toolUpdateAction    = "hecaton_update_action"
+	toolAddActionStep   = "hecaton_add_action_step"
+	toolAppendMemo      = "hecaton_append_memo"
+	toolCreateKnowledge = "hecaton_create_knowledge"
 )
 
 // ErrMCPAuthorizationDenied is returned to the MCP client when the Rego policy
 // denies a tool call (result.allow is false).
 var ErrMCPAuthorizationDenied = goerr.New("authorization denied")
 
-// mcpHandler holds the dependencies the MCP tool handlers need. The MCP
-// endpoint is read-only: it reaches the Case / Action data exclusively through
-// the usecase layer (never the repository) and the workspace metadata through
-// the registry, mirroring the existing controller handlers.
+// readOnlyTool annotates a tool that never changes data. A token the policy
+// marks read_only may call only tools carrying it.
+var readOnlyTool = &mcp.ToolAnnotations{ReadOnlyHint: true}
+
+// additiveTool annotates a write tool that only adds data (a new case, action,
+// step, memo or knowledge entry) and never overwrites what is there. Write
+// tools that update in place carry no annotation, so clients keep treating them
+// as destructive.
+var additiveTool = &mcp.ToolAnnotations{DestructiveHint: new(bool)}
+
+// mcpHandler holds the dependencies the MCP tool handlers need. It reaches the
+// Case / Action / Memo / Knowledge data exclusively through the usecase layer
+// (never the repository) and the workspace metadata through the registry,
+// mirroring the existing controller handlers. The write tools call the same
+// usecase methods the casemulti, memo and knowledge agent tools do, so field
+// validation, access control and change notifications are identical.
 type mcpHandler struct {
-	caseUC   *usecase.CaseUseCase
-	actionUC *usecase.ActionUseCase
-	registry *model.WorkspaceRegistry
-	policy   interfaces.PolicyClient
-	env      map[string]string
+	caseUC      *usecase.CaseUseCase
+	actionUC    *usecase.ActionUseCase
+	stepUC      *usecase.ActionStepUseCase
+	memoUC      *usecase.MemoUseCase
+	knowledgeUC *usecase.KnowledgeUseCase
+	tagUC       *usecase.TagUseCase
+	registry    *model.WorkspaceRegistry
+	policy      interfaces.PolicyClient
+	env         map[string]string
 }
 
 // NewMCPHandler builds the http.Handler that serves the MCP endpoint over
 // Streamable HTTP (mounted at /mcp by the Server). policy is mandatory: every
-// tool call is authorized against data.auth.mcp before any data is read.
+// tool call is authorized against data.auth.mcp before any data is read, and a
+// write tool call against data.auth.mcp_write as well.
+//
+// stepUC, memoUC and knowledgeUC / tagUC are optional: a nil one leaves out
+// the tools it backs (hecaton_add_action_step, hecaton_append_memo, the
+// knowledge tools), so the endpoint degrades to the surface that is wired.
 //
 // The transport runs in Stateless mode so each HTTP request carries its own
 // authorization and the request context (populated by withMCPRequestContext)
@@ -59,6 +98,10 @@ type mcpHandler struct {
 func NewMCPHandler(
 	caseUC *usecase.CaseUseCase,
 	actionUC *usecase.ActionUseCase,
+	stepUC *usecase.ActionStepUseCase,
+	memoUC *usecase.MemoUseCase,
+	knowledgeUC *usecase.KnowledgeUseCase,
+	tagUC *usecase.TagUseCase,
 	registry *model.WorkspaceRegistry,
 	policy interfaces.PolicyClient,
 	env map[string]string,
@@ -70,15 +113,20 @@ func NewMCPHandler(
 		panic("MCP handler requires a non-nil policy client")
 	}
 	h := &mcpHandler{
-		caseUC:   caseUC,
-		actionUC: actionUC,
-		registry: registry,
-		policy:   policy,
-		env:      env,
+		caseUC:      caseUC,
+		actionUC:    actionUC,
+		stepUC:      stepUC,
+		memoUC:      memoUC,
+		knowledgeUC: knowledgeUC,
+		tagUC:       tagUC,
+		registry:    registry,
+		policy:      policy,
+		env:         env,
 	}
 
 	server := mcp.NewServer(&mcp.Implementation{Name: mcpServerName, Version: mcpServerVersion}, nil)
 	h.registerTools(server)
+	server.AddReceivingMiddleware(h.filterToolList)
 
 	streamable := mcp.NewStreamableHTTPHandler(
 		func(*http.Request) *mcp.Server { return server },
@@ -108,12 +156,8 @@ func withMCPRequestContext(next http.Handler) http.Handler {
 	})
 }
 
-// authorize evaluates the Rego policy for one tool call. On allow it returns a
-// context carrying the resolved Slack user (when the policy provided one) as
-// an auth token, so downstream private-case access control can identify the
-// caller. On deny — or on any policy evaluation error — it returns an error
-// that the SDK surfaces to the client as a tool error; no data is read.
-func (h *mcpHandler) authorize(ctx context.Context, toolName, workspaceID string, args map[string]any) (context.Context, error) {
+// evaluate runs the Rego policy for one tool call and returns its result.
+func (h *mcpHandler) evaluate(ctx context.Context, toolName, workspaceID string, args map[string]any) (authz.Result, error) {
 	input := authz.BuildInput(ctx, h.env, &authz.ToolCall{
 		Name:        toolName,
 		WorkspaceID: workspaceID,
@@ -122,31 +166,165 @@ func (h *mcpHandler) authorize(ctx context.Context, toolName, workspaceID string
 
 	var result authz.Result
 	if err := h.policy.Query(ctx, mcpPolicyQuery, input, &result); err != nil {
-		return ctx, goerr.Wrap(err, "MCP authorization policy evaluation failed", goerr.V("tool", toolName))
+		return authz.Result{}, goerr.Wrap(err, "MCP authorization policy evaluation failed", goerr.V("tool", toolName))
+	}
+	return result, nil
+}
+
+// evaluateWrite runs the write rule for one write tool call, with user being
+// the Slack user data.auth.mcp resolved. A bundle that does not define the
+// rule yields a deny: writes are off until the operator opts in.
+func (h *mcpHandler) evaluateWrite(ctx context.Context, toolName, workspaceID string, args map[string]any, user string) (authz.Decision, error) {
+	input := authz.BuildInput(ctx, h.env, &authz.ToolCall{
+		Name:        toolName,
+		WorkspaceID: workspaceID,
+		Args:        args,
+	})
+	input.User = user
+
+	var decision authz.Decision
+	if err := h.policy.Query(ctx, mcpWritePolicyQuery, input, &decision); err != nil {
+		if errors.Is(err, interfaces.ErrPolicyUndefined) {
+			return authz.Decision{}, nil
+		}
+		return authz.Decision{}, goerr.Wrap(err, "MCP write authorization policy evaluation failed", goerr.V("tool", toolName))
+	}
+	return decision, nil
+}
+
+// authorize evaluates the Rego policy for one tool call. On allow it returns a
+// context carrying the resolved Slack user (when the policy provided one) as
+// an auth token, so downstream private-case access control can identify the
+// caller, plus the token's scopes for tools that filter their output by them.
+// On deny — or on any policy evaluation error — it returns an error that the
+// SDK surfaces to the client as a tool error; no data is read.
+//
+// A call the policy allows but whose tool or workspace falls outside the
+// token's scopes is rejected as a JSON-RPC invalid-params error instead, the
+// same way the SDK rejects an unknown tool: the tool is hidden from this
+// token's tools/list, so calling it is a protocol error, not a failed call.
+//
+// A write tool must further pass data.auth.mcp_write, and only acts for a
+// resolved user: the usecases attribute the change to them and the audit log
+// names them, so a token without a user can read but never write.
+func (h *mcpHandler) authorize(ctx context.Context, toolName string, readOnly bool, workspaceID string, args map[string]any) (context.Context, error) {
+	result, err := h.evaluate(ctx, toolName, workspaceID, args)
+	if err != nil {
+		return ctx, err
 	}
 	if !result.Allow {
 		return ctx, goerr.Wrap(ErrMCPAuthorizationDenied, "MCP tool call denied by policy",
 			goerr.V("tool", toolName), goerr.V("workspace_id", workspaceID))
 	}
+	if !result.PermitsTool(toolName, readOnly) {
+		return ctx, &jsonrpc.Error{
+			Code:    jsonrpc.CodeInvalidParams,
+			Message: fmt.Sprintf("tool %q is not permitted for this token", toolName),
+		}
+	}
+	if !result.PermitsWorkspace(workspaceID) {
+		return ctx, &jsonrpc.Error{
+			Code:    jsonrpc.CodeInvalidParams,
+			Message: fmt.Sprintf("workspace %q is not permitted for this token", workspaceID),
+		}
+	}
+	if !readOnly {
+		if result.User == "" {
+			return ctx, goerr.Wrap(ErrMCPAuthorizationDenied, "MCP write tools require the policy to resolve a user",
+				goerr.V("tool", toolName))
+		}
+		decision, err := h.evaluateWrite(ctx, toolName, workspaceID, args, result.User)
+		if err != nil {
+			return ctx, err
+		}
+		if !decision.Allow {
+			msg := "MCP write denied by policy"
+			if decision.Reason != "" {
+				msg += ": " + decision.Reason
+			}
+			return ctx, goerr.Wrap(ErrMCPAuthorizationDenied, msg,
+				goerr.V("tool", toolName), goerr.V("workspace_id", workspaceID))
+		}
+	}
 	if result.User != "" {
 		ctx = auth.ContextWithToken(ctx, &auth.Token{Sub: result.User})
 	}
-	return ctx, nil
+	return withMCPScope(ctx, result), nil
+}
+
+type mcpScopeCtxKey struct{}
+
+func withMCPScope(ctx context.Context, result authz.Result) context.Context {
+	return context.WithValue(ctx, mcpScopeCtxKey{}, result)
+}
+
+// mcpScope returns the scopes of the authorized call. Outside an authorized
+// call it returns the zero Result, whose scopes are unrestricted.
+func mcpScope(ctx context.Context) authz.Result {
+	result, _ := ctx.Value(mcpScopeCtxKey{}).(authz.Result)
+	return result
+}
+
+// filterToolList narrows tools/list to the tools this token may call. Each tool
+// is evaluated by name alone — the policy sees input.tool without workspace_id
+// or args — and kept only when the policy allows it and the scopes admit it; a
+// write tool also needs a resolved user and the write rule's allow. A policy
+// evaluation error hides the tool, as it would refuse the call.
+func (h *mcpHandler) filterToolList(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		res, err := next(ctx, method, req)
+		if err != nil || method != "tools/list" {
+			return res, err
+		}
+		list, ok := res.(*mcp.ListToolsResult)
+		if !ok {
+			return res, nil
+		}
+
+		filtered := *list
+		filtered.Tools = make([]*mcp.Tool, 0, len(list.Tools))
+		for _, tool := range list.Tools {
+			result, err := h.evaluate(ctx, tool.Name, "", nil)
+			if err != nil || !result.Allow || !result.PermitsTool(tool.Name, isReadOnly(tool)) {
+				continue
+			}
+			if !isReadOnly(tool) {
+				if result.User == "" {
+					continue
+				}
+				decision, err := h.evaluateWrite(ctx, tool.Name, "", nil, result.User)
+				if err != nil || !decision.Allow {
+					continue
+				}
+			}
+			filtered.Tools = append(filtered.Tools, tool)
+		}
+		return &filtered, nil
+	}
+}
+
+func isReadOnly(tool *mcp.Tool) bool {
+	return tool.Annotations != nil && tool.Annotations.ReadOnlyHint
 }
 
 // registerTool wires one typed tool onto the server, wrapping its run function
 // with the shared authorization gate. Every data-returning tool goes through
-// here, so no tool can return data without first passing the Rego policy.
-func registerTool[In, Out any](s *mcp.Server, h *mcpHandler, name, description string, run func(context.Context, In) (Out, error)) {
-	mcp.AddTool(s, &mcp.Tool{Name: name, Description: description},
+// here, so no tool can return data without first passing the Rego policy, and
+// every authorized write is audited.
+func registerTool[In, Out any](s *mcp.Server, h *mcpHandler, name, description string, annotations *mcp.ToolAnnotations, run func(context.Context, In) (Out, error)) {
+	tool := &mcp.Tool{Name: name, Description: description, Annotations: annotations}
+	mcp.AddTool(s, tool,
 		func(ctx context.Context, _ *mcp.CallToolRequest, in In) (*mcp.CallToolResult, Out, error) {
 			var zero Out
 			args, workspaceID := toolArgs(in)
-			authCtx, err := h.authorize(ctx, name, workspaceID, args)
+			authCtx, err := h.authorize(ctx, name, isReadOnly(tool), workspaceID, args)
 			if err != nil {
 				return nil, zero, err
 			}
 			out, err := run(authCtx, in)
+			if !isReadOnly(tool) {
+				auditWrite(authCtx, name, workspaceID, args, err)
+			}
 			if err != nil {
 				return nil, zero, err
 			}
@@ -154,6 +332,35 @@ func registerTool[In, Out any](s *mcp.Server, h *mcpHandler, name, description s
 		})
 }
 
+// auditWrite logs one authorized write tool call with the MCP caller's
+// identity — the Slack user the policy resolved — alongside the tool, its
+// arguments and the outcome. The written records carry the same user (as
+// reporter, creator or actor), so the log ties each change to the MCP call
+// that made it.
+func auditWrite(ctx context.Context, toolName, workspaceID string, args map[string]any, err error) {
+	attrs := []any{
+		slog.String("tool", toolName),
+		slog.String("workspace_id", workspaceID),
+		slog.String("user", mcpScope(ctx).User),
+		slog.Any("args", args),
+	}
+	if req := authz.RequestFromContext(ctx); req != nil {
+		attrs = append(attrs, slog.String("user_agent", firstHeader(req.Header, "User-Agent")))
+	}
+	if err != nil {
+		logging.From(ctx).Warn("MCP write tool failed", append(attrs, logging.ErrAttr(err))...)
+		return
+	}
+	logging.From(ctx).Info("MCP write tool called", attrs...)
+}
+
+func firstHeader(header map[string][]string, key string) string {
+	if v := http.Header(header).Values(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
 // toolArgs renders the typed tool input into a generic map for the policy and
 // extracts the workspace_id (empty for tools that take no workspace). The
 // round-trip cannot fail for the concrete input structs used here; on the
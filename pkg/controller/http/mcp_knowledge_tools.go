@@ -0,0 +1,186 @@
+package http
+
+import (
+	"context"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+)
+
+// defaultKnowledgeSearchLimit matches the knowledge agent tool's default.
+const defaultKnowledgeSearchLimit = 10
+
+// registerKnowledgeTools wires the workspace knowledge base tools: searching
+// and listing tags are read-only, creating an entry is a write. None of them
+// is registered unless both the knowledge and the tag usecases are wired.
+func (h *mcpHandler) registerKnowledgeTools(s *mcp.Server) {
+	if h.knowledgeUC == nil || h.tagUC == nil {
+		return
+	}
+	registerTool(s, h, toolSearchKnowledge,
+		"Search a workspace's shared knowledge base. Results are ranked by relevance to the query; optionally filter by tag IDs (AND). Expired entries are skipped unless include_expired is set.",
+		readOnlyTool, h.runSearchKnowledge)
+	registerTool(s, h, toolListTags,
+		"List the tags of a workspace's knowledge base. A knowledge entry must carry at least one existing tag.",
+		readOnlyTool, h.runListTags)
+	registerTool(s, h, toolCreateKnowledge,
+		"Create a shared knowledge entry in a workspace. The claim is Markdown; tag_ids must name existing tags (see hecaton_list_tags).",
+		additiveTool, h.runCreateKnowledge)
+}
+
+type knowledgeDetail struct {
+	ID         string   `json:"id"`
+	Title      string   `json:"title"`
+	Claim      string   `json:"claim"`
+	TagIDs     []string `json:"tag_ids"`
+	CreatorID  string   `json:"creator_id,omitempty"`
+	ValidUntil string   `json:"valid_until,omitempty"`
+	ReviewBy   string   `json:"review_by,omitempty"`
+	Expired    bool     `json:"expired,omitempty"`
+	CreatedAt  string   `json:"created_at"`
+	UpdatedAt  string   `json:"updated_at"`
+}
+
+func toKnowledgeDetail(k *model.Knowledge, now time.Time) knowledgeDetail {
+	tagIDs := make([]string, 0, len(k.TagIDs))
+	for _, id := range k.TagIDs {
+		tagIDs = append(tagIDs, string(id))
+	}
+	d := knowledgeDetail{
+		ID:        string(k.ID),
+		Title:     k.Title,
+		Claim:     k.Claim,
+		TagIDs:    tagIDs,
+		CreatorID: k.CreatorID,
+		Expired:   k.IsExpired(now),
+		CreatedAt: k.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt: k.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+	if k.ValidUntil != nil {
+		d.ValidUntil = k.ValidUntil.UTC().Format(time.RFC3339)
+	}
+	if k.ReviewBy != nil {
+		d.ReviewBy = k.ReviewBy.UTC().Format(time.RFC3339)
+	}
+	return d
+}
+
+func toTagIDs(ids []string) []model.TagID {
+	out := make([]model.TagID, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, model.TagID(id))
+	}
+	return out
+}
+
+// --- search_knowledge ---
+
+type searchKnowledgeInput struct {
+	WorkspaceID    string   `json:"workspace_id" jsonschema:"the workspace ID whose knowledge base to search"`
+	Query          string   `json:"query,omitempty" jsonschema:"natural-language search text; empty lists the newest entries"`
+	TagIDs         []string `json:"tag_ids,omitempty" jsonschema:"optional tag IDs every result must carry"`
+	Limit          int      `json:"limit,omitempty" jsonschema:"maximum number of entries to return (default 10)"`
+	IncludeExpired bool     `json:"include_expired,omitempty" jsonschema:"also return entries past their valid_until date"`
+}
+
+type knowledgeHit struct {
+	knowledgeDetail
+	Score float64 `json:"score"`
+}
+
+type searchKnowledgeOutput struct {
+	Knowledge []knowledgeHit `json:"knowledge"`
+}
+
+func (h *mcpHandler) runSearchKnowledge(ctx context.Context, in searchKnowledgeInput) (searchKnowledgeOutput, error) {
+	if in.WorkspaceID == "" {
+		return searchKnowledgeOutput{}, goerr.New("workspace_id is required")
+	}
+	limit := in.Limit
+	if limit <= 0 {
+		limit = defaultKnowledgeSearchLimit
+	}
+
+	hits, err := h.knowledgeUC.SearchKnowledge(ctx, in.WorkspaceID, usecase.SearchKnowledgeInput{
+		Query:          in.Query,
+		TagIDs:         toTagIDs(in.TagIDs),
+		Limit:          limit,
+		IncludeExpired: in.IncludeExpired,
+	})
+	if err != nil {
+		return searchKnowledgeOutput{}, goerr.Wrap(err, "failed to search knowledge")
+	}
+
+	now := time.Now()
+	out := searchKnowledgeOutput{Knowledge: make([]knowledgeHit, 0, len(hits))}
+	for _, hit := range hits {
+		out.Knowledge = append(out.Knowledge, knowledgeHit{
+			knowledgeDetail: toKnowledgeDetail(hit.Knowledge, now),
+			Score:           hit.Score,
+		})
+	}
+	return out, nil
+}
+
+// --- list_tags ---
+
+type listTagsInput struct {
+	WorkspaceID string `json:"workspace_id" jsonschema:"the workspace ID to list knowledge tags for"`
+}
+
+type tagDetail struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+type listTagsOutput struct {
+	Tags []tagDetail `json:"tags"`
+}
+
+func (h *mcpHandler) runListTags(ctx context.Context, in listTagsInput) (listTagsOutput, error) {
+	if in.WorkspaceID == "" {
+		return listTagsOutput{}, goerr.New("workspace_id is required")
+	}
+	tags, err := h.tagUC.ListTags(ctx, in.WorkspaceID)
+	if err != nil {
+		return listTagsOutput{}, goerr.Wrap(err, "failed to list tags")
+	}
+
+	out := listTagsOutput{Tags: make([]tagDetail, 0, len(tags))}
+	for _, t := range tags {
+		out.Tags = append(out.Tags, tagDetail{ID: string(t.ID), Name: t.Name})
+	}
+	return out, nil
+}
+
+// --- create_knowledge ---
+
+type createKnowledgeInput struct {
+	WorkspaceID string   `json:"workspace_id" jsonschema:"the workspace ID to create the entry in"`
+	Title       string   `json:"title" jsonschema:"a concise one-line title"`
+	Claim       string   `json:"claim" jsonschema:"the knowledge body as Markdown"`
+	TagIDs      []string `json:"tag_ids" jsonschema:"one or more existing tag IDs"`
+}
+
+type createKnowledgeOutput struct {
+	Knowledge knowledgeDetail `json:"knowledge"`
+}
+
+func (h *mcpHandler) runCreateKnowledge(ctx context.Context, in createKnowledgeInput) (createKnowledgeOutput, error) {
+	if _, err := h.workspaceEntry(in.WorkspaceID); err != nil {
+		return createKnowledgeOutput{}, err
+	}
+
+	created, err := h.knowledgeUC.CreateKnowledge(ctx, in.WorkspaceID, usecase.CreateKnowledgeInput{
+		Title:  in.Title,
+		Claim:  in.Claim,
+		TagIDs: toTagIDs(in.TagIDs),
+	})
+	if err != nil {
+		return createKnowledgeOutput{}, goerr.Wrap(err, "failed to create knowledge", goerr.V("workspace_id", in.WorkspaceID))
+	}
+	return createKnowledgeOutput{Knowledge: toKnowledgeDetail(created, time.Now())}, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/authz"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/config"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
 	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
@@ -21,9 +22,11 @@ import (
 
 // fakePolicy is a hand-written PolicyClient that returns a fixed Result and
 // records the last input it was queried with, so the MCP tests can drive
-// allow / deny / user-injection deterministically without a Rego file.
+// allow / deny / user-injection deterministically without a Rego file. write
+// answers data.auth.mcp_write; its zero value denies every write.
 type fakePolicy struct {
 	result    authz.Result
+	write     authz.Decision
 	err       error
 	lastInput authz.Input
 }
@@ -35,8 +38,11 @@ func (f *fakePolicy) Query(_ context.Context, _ string, input, out any) error {
 	if f.err != nil {
 		return f.err
 	}
-	if r, ok := out.(*authz.Result); ok {
+	switch r := out.(type) {
+	case *authz.Result:
 		*r = f.result
+	case *authz.Decision:
+		*r = f.write
 	}
 	return nil
 }
@@ -49,6 +55,7 @@ type mcpTestEnv struct {
 	privateCase *model.Case
 	publicActID int64
 	privActID   int64
+	tagID       model.TagID
 }
 
 func newMCPTestEnv(t *testing.T, policy *fakePolicy) *mcpTestEnv {
@@ -58,10 +65,22 @@ func newMCPTestEnv(t *testing.T, policy *fakePolicy) *mcpTestEnv {
 	registry.Register(&model.WorkspaceEntry{
 		Workspace:       model.Workspace{ID: testWorkspaceID, Name: "Test Workspace", Description: "for MCP tests"},
 		ActionStatusSet: model.DefaultActionStatusSet(),
+		FieldSchema: &config.FieldSchema{Fields: []config.FieldDefinition{
+			{ID: "severity", Name: "Severity", Type: types.FieldTypeSelect, Options: []config.FieldOption{
+				{ID: "high", Name: "High"}, {ID: "low", Name: "Low"},
+			}},
+		}},
+		MemoConfig: &config.MemoConfig{FieldSchema: &config.FieldSchema{Fields: []config.FieldDefinition{
+			{ID: "finding", Name: "Finding", Type: types.FieldTypeText},
+		}}},
 	})
 
 	caseUC := usecase.NewCaseUseCase(repo, registry, nil, nil, "")
 	actionUC := usecase.NewActionUseCase(repo, registry, nil, "", nil)
+	stepUC := usecase.NewActionStepUseCase(repo, nil, nil)
+	memoUC := usecase.NewMemoUseCase(repo, registry)
+	knowledgeUC := usecase.NewKnowledgeUseCase(repo, nil)
+	tagUC := usecase.NewTagUseCase(repo)
 
 	// "UMEMBER" is a member of the private case below; we still expect the
 	// private case + its action to be invisible over MCP.
@@ -85,7 +104,10 @@ func newMCPTestEnv(t *testing.T, policy *fakePolicy) *mcpTestEnv {
 	privAct, err := actionUC.CreateAction(memberCtx, testWorkspaceID, privCreated.ID, "Private Action", "Desc", "", "", types.ActionStatusTodo, nil)
 	gt.NoError(t, err).Required()
 
-	handler := httpctrl.NewMCPHandler(caseUC, actionUC, registry, policy, map[string]string{})
+	tag, err := tagUC.CreateTag(memberCtx, testWorkspaceID, "phishing")
+	gt.NoError(t, err).Required()
+
+	handler := httpctrl.NewMCPHandler(caseUC, actionUC, stepUC, memoUC, knowledgeUC, tagUC, registry, policy, map[string]string{})
 	mux := http.NewServeMux()
 	mux.Handle("/mcp", handler)
 	srv := httptest.NewServer(mux)
@@ -98,22 +120,37 @@ func newMCPTestEnv(t *testing.T, policy *fakePolicy) *mcpTestEnv {
 		privateCase: privCreated,
 		publicActID: pubAct.ID,
 		privActID:   privAct.ID,
+		tagID:       tag.ID,
 	}
 }
 
-func (e *mcpTestEnv) callTool(t *testing.T, name string, args map[string]any) *mcp.CallToolResult {
+func (e *mcpTestEnv) connect(t *testing.T) *mcp.ClientSession {
 	t.Helper()
-	ctx := context.Background()
 	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, nil)
-	session, err := client.Connect(ctx, &mcp.StreamableClientTransport{Endpoint: e.server.URL + "/mcp"}, nil)
+	session, err := client.Connect(context.Background(), &mcp.StreamableClientTransport{Endpoint: e.server.URL + "/mcp"}, nil)
 	gt.NoError(t, err).Required()
 	t.Cleanup(func() { _ = session.Close() })
+	return session
+}
 
-	res, err := session.CallTool(ctx, &mcp.CallToolParams{Name: name, Arguments: args})
+func (e *mcpTestEnv) callTool(t *testing.T, name string, args map[string]any) *mcp.CallToolResult {
+	t.Helper()
+	res, err := e.connect(t).CallTool(context.Background(), &mcp.CallToolParams{Name: name, Arguments: args})
 	gt.NoError(t, err).Required()
 	return res
 }
 
+func (e *mcpTestEnv) listToolNames(t *testing.T) []string {
+	t.Helper()
+	res, err := e.connect(t).ListTools(context.Background(), &mcp.ListToolsParams{})
+	gt.NoError(t, err).Required()
+	names := make([]string, 0, len(res.Tools))
+	for _, tool := range res.Tools {
+		names = append(names, tool.Name)
+	}
+	return names
+}
+
 // decodeStructured re-marshals the tool's structured output and decodes it
 // into v.
 func decodeStructured(t *testing.T, res *mcp.CallToolResult, v any) {
@@ -259,3 +296,64 @@ func TestMCP_PolicyErrorSurfacesAsToolError(t *testing.T) {
 	res := env.callTool(t, "hecaton_list_workspaces", map[string]any{})
 	gt.Bool(t, res.IsError).True()
 }
+
+func TestMCP_ToolAllowListFiltersListAndRejectsCall(t *testing.T) {
+	env := newMCPTestEnv(t, &fakePolicy{result: authz.Result{
+		Allow: true,
+		User:  "UMEMBER",
+		Tools: []string{"hecaton_list_cases", "hecaton_get_cases"},
+	}})
+
+	gt.Value(t, env.listToolNames(t)).Equal([]string{"hecaton_get_cases", "hecaton_list_cases"})
+
+	res := env.callTool(t, "hecaton_list_cases", map[string]any{"workspace_id": testWorkspaceID})
+	gt.Bool(t, res.IsError).False()
+
+	// A tool outside the allow-list is a protocol error, not a tool result.
+	_, err := env.connect(t).CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "hecaton_list_actions",
+		Arguments: map[string]any{"workspace_id": testWorkspaceID},
+	})
+	gt.Error(t, err)
+	gt.String(t, err.Error()).Contains("not permitted")
+}
+
+func TestMCP_DeniedTokenSeesNoTools(t *testing.T) {
+	env := newMCPTestEnv(t, &fakePolicy{result: authz.Result{Allow: false}})
+	gt.Array(t, env.listToolNames(t)).Length(0)
+}
+
+func TestMCP_ReadOnlyTokenKeepsReadingTools(t *testing.T) {
+	env := newMCPTestEnv(t, &fakePolicy{
+		result: authz.Result{Allow: true, User: "UMEMBER", ReadOnly: true},
+		write:  authz.Decision{Allow: true},
+	})
+	gt.Value(t, env.listToolNames(t)).Equal([]string{
+		"hecaton_get_actions", "hecaton_get_cases", "hecaton_list_actions", "hecaton_list_cases",
+		"hecaton_list_tags", "hecaton_list_workspaces", "hecaton_search_knowledge",
+	})
+}
+
+func TestMCP_WorkspaceScope(t *testing.T) {
+	env := newMCPTestEnv(t, &fakePolicy{result: authz.Result{
+		Allow:      true,
+		User:       "UMEMBER",
+		Workspaces: []string{"other"},
+	}})
+
+	res := env.callTool(t, "hecaton_list_workspaces", map[string]any{})
+	gt.Bool(t, res.IsError).False()
+	var out struct {
+		Workspaces []struct {
+			ID string `json:"id"`
+		} `json:"workspaces"`
+	}
+	decodeStructured(t, res, &out)
+	gt.Array(t, out.Workspaces).Length(0)
+
+	_, err := env.connect(t).CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "hecaton_list_cases",
+		Arguments: map[string]any{"workspace_id": testWorkspaceID},
+	})
+	gt.Error(t, err)
+}
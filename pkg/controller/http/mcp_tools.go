@@ -8,28 +8,33 @@ import (
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/config"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
 )
 
-// registerTools wires the read-only MCP tool surface. All tools enforce the
-// project rule that private Cases — and the Actions beneath them — are never
-// exposed via MCP, regardless of channel membership.
+// registerTools wires the MCP tool surface: the read-only tools here, the
+// knowledge tools when knowledge is wired, and the write tools (see
+// registerWriteTools). All tools enforce the project rule that private Cases —
+// and the Actions beneath them — are never exposed via MCP, regardless of
+// channel membership; the write tools refuse to touch them at all.
 func (h *mcpHandler) registerTools(s *mcp.Server) {
 	registerTool(s, h, toolListWorkspaces,
 		"List all workspaces with their configuration details (case mode, status sets, custom field schema).",
-		h.runListWorkspaces)
+		readOnlyTool, h.runListWorkspaces)
 	registerTool(s, h, toolListCases,
 		"List cases in a workspace. Private cases are never returned. Optionally filter by status (DRAFT, OPEN, CLOSED).",
-		h.runListCases)
+		readOnlyTool, h.runListCases)
 	registerTool(s, h, toolGetCases,
 		"Get full details for multiple cases by ID in a workspace. Private cases are silently omitted from the result.",
-		h.runGetCases)
+		readOnlyTool, h.runGetCases)
 	registerTool(s, h, toolListActions,
 		"List actions in a workspace, optionally scoped to a single case. Actions of private cases are never returned.",
-		h.runListActions)
+		readOnlyTool, h.runListActions)
 	registerTool(s, h, toolGetActions,
 		"Get details for multiple actions by ID in a workspace. Actions belonging to private cases are silently omitted.",
-		h.runGetActions)
+		readOnlyTool, h.runGetActions)
+	h.registerKnowledgeTools(s)
+	h.registerWriteTools(s)
 }
 
 // --- list_workspaces ---
@@ -37,9 +42,17 @@ func (h *mcpHandler) registerTools(s *mcp.Server) {
 type listWorkspacesInput struct{}
 
 type fieldDef struct {
+	ID      string        `json:"id"`
+	Name    string        `json:"name"`
+	Type    string        `json:"type"`
+	Options []fieldOption `json:"options,omitempty"`
+}
+
+// fieldOption is one choice of a select / multi-select field; its ID is the
+// value a write tool's field input carries.
+type fieldOption struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
-	Type string `json:"type"`
 }
 
 type workspaceDetail struct {
@@ -52,16 +65,21 @@ type workspaceDetail struct {
 	ActionStatuses []string   `json:"action_statuses"`
 	CaseStatuses   []string   `json:"case_statuses,omitempty"`
 	FieldSchema    []fieldDef `json:"field_schema"`
+	MemoFields     []fieldDef `json:"memo_fields,omitempty"`
 }
 
 type listWorkspacesOutput struct {
 	Workspaces []workspaceDetail `json:"workspaces"`
 }
 
-func (h *mcpHandler) runListWorkspaces(_ context.Context, _ listWorkspacesInput) (listWorkspacesOutput, error) {
+func (h *mcpHandler) runListWorkspaces(ctx context.Context, _ listWorkspacesInput) (listWorkspacesOutput, error) {
+	scope := mcpScope(ctx)
 	entries := h.registry.List()
 	out := listWorkspacesOutput{Workspaces: make([]workspaceDetail, 0, len(entries))}
 	for _, e := range entries {
+		if !scope.PermitsWorkspace(e.Workspace.ID) {
+			continue
+		}
 		wd := workspaceDetail{
 			ID:             e.Workspace.ID,
 			Name:           e.Workspace.Name,
@@ -79,19 +97,32 @@ func (h *mcpHandler) runListWorkspaces(_ context.Context, _ listWorkspacesInput)
 			wd.CaseStatuses = e.CaseStatusSet.IDs()
 		}
 		if e.FieldSchema != nil {
-			for _, f := range e.FieldSchema.Fields {
-				wd.FieldSchema = append(wd.FieldSchema, fieldDef{
-					ID:   f.ID,
-					Name: f.Name,
-					Type: string(f.Type),
-				})
-			}
+			wd.FieldSchema = toFieldDefs(e.FieldSchema.Fields)
+		}
+		if e.MemoConfig.Enabled() {
+			wd.MemoFields = toFieldDefs(e.MemoConfig.FieldSchema.Fields)
 		}
 		out.Workspaces = append(out.Workspaces, wd)
 	}
 	return out, nil
 }
 
+func toFieldDefs(fields []config.FieldDefinition) []fieldDef {
+	defs := make([]fieldDef, 0, len(fields))
+	for _, f := range fields {
+		d := fieldDef{
+			ID:   f.ID,
+			Name: f.Name,
+			Type: string(f.Type),
+		}
+		for _, o := range f.Options {
+			d.Options = append(d.Options, fieldOption{ID: o.ID, Name: o.Name})
+		}
+		defs = append(defs, d)
+	}
+	return defs
+}
+
 // --- shared case / action views ---
 
 type caseSummary struct {
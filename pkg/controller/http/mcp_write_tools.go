@@ -0,0 +1,456 @@
+package http
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/config"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+)
+
+// registerWriteTools wires the tools that create and update Cases, Actions,
+// steps and memos. They run as the Slack user the policy resolved (see
+// authorize), so the usecases enforce that user's access and attribute the
+// change to them. A private Case — and every Action beneath it — is reported
+// as not found, exactly as the read tools omit it.
+func (h *mcpHandler) registerWriteTools(s *mcp.Server) {
+	registerTool(s, h, toolCreateCase,
+		"Create a new case in a workspace. Set custom fields with the ids listed by hecaton_list_workspaces.",
+		additiveTool, h.runCreateCase)
+	registerTool(s, h, toolUpdateCase,
+		"Update a case's title, description, custom fields or status. Omitted values are kept.",
+		nil, h.runUpdateCase)
+	registerTool(s, h, toolCreateAction,
+		"Create a new action under a case.",
+		additiveTool, h.runCreateAction)
+	registerTool(s, h, toolUpdateAction,
+		"Update an action's title, description, assignee, status or due date. Omitted values are kept.",
+		nil, h.runUpdateAction)
+	if h.stepUC != nil {
+		registerTool(s, h, toolAddActionStep,
+			"Add a checklist step to an action.",
+			additiveTool, h.runAddActionStep)
+	}
+	if h.memoUC != nil {
+		registerTool(s, h, toolAppendMemo,
+			"Append a memo to a case. Memo fields are listed as memo_fields by hecaton_list_workspaces.",
+			additiveTool, h.runAppendMemo)
+	}
+}
+
+// fieldInput is one custom field assignment of a write tool, in the shape the
+// casemulti agent tools take.
+type fieldInput struct {
+	FieldID string   `json:"field_id" jsonschema:"the field id from the workspace schema"`
+	Value   string   `json:"value,omitempty" jsonschema:"scalar value: text, number, url, date, single select option id or single user id"`
+	Values  []string `json:"values,omitempty" jsonschema:"multi value: multi-select option ids or user ids"`
+}
+
+// coerceFields validates the field inputs against schema and converts them to
+// stored field values. nil inputs yield nil, which the usecases read as "leave
+// the fields alone".
+func coerceFields(schema *config.FieldSchema, inputs []fieldInput) (map[string]model.FieldValue, error) {
+	if len(inputs) == 0 {
+		return nil, nil
+	}
+	if schema == nil || len(schema.Fields) == 0 {
+		return nil, goerr.New("the workspace defines no fields to set")
+	}
+	fis := make([]model.FieldInput, 0, len(inputs))
+	for _, in := range inputs {
+		fis = append(fis, model.FieldInput{FieldID: in.FieldID, Value: in.Value, Values: in.Values})
+	}
+	values, violations := model.CoerceFieldInputs(schema, fis)
+	if len(violations) > 0 {
+		return nil, goerr.New("invalid field value(s): " + strings.Join(violations, "; "))
+	}
+	return values, nil
+}
+
+func (h *mcpHandler) workspaceEntry(workspaceID string) (*model.WorkspaceEntry, error) {
+	if workspaceID == "" {
+		return nil, goerr.New("workspace_id is required")
+	}
+	entry, err := h.registry.Get(workspaceID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "unknown workspace", goerr.V("workspace_id", workspaceID))
+	}
+	return entry, nil
+}
+
+// loadWritableCase returns the Case a write tool targets. A private Case is
+// reported exactly like a missing one, so a write never reveals that it
+// exists.
+func (h *mcpHandler) loadWritableCase(ctx context.Context, workspaceID string, caseID int64) (*model.Case, error) {
+	cases, err := h.caseUC.GetCases(ctx, workspaceID, []int64{caseID})
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get case", goerr.V("case_id", caseID))
+	}
+	if len(cases) == 0 || cases[0].IsPrivate {
+		return nil, goerr.New("case not found", goerr.V("workspace_id", workspaceID), goerr.V("case_id", caseID))
+	}
+	return cases[0], nil
+}
+
+// loadWritableAction is loadWritableCase for an Action: one under a private
+// Case is reported as not found.
+func (h *mcpHandler) loadWritableAction(ctx context.Context, workspaceID string, actionID int64) (*model.Action, error) {
+	actions, err := h.actionUC.GetActions(ctx, workspaceID, []int64{actionID},
+		interfaces.ActionListOptions{ExcludePrivateCaseActions: true})
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get action", goerr.V("action_id", actionID))
+	}
+	if len(actions) == 0 {
+		return nil, goerr.New("action not found", goerr.V("workspace_id", workspaceID), goerr.V("action_id", actionID))
+	}
+	return actions[0], nil
+}
+
+// mcpActor attributes an Action write to the Slack user the policy resolved;
+// authorize refuses a write tool call without one.
+func mcpActor(ctx context.Context) usecase.ActorRef {
+	return usecase.ActorRef{Kind: usecase.ActorKindSlackUser, ID: mcpScope(ctx).User}
+}
+
+// parseDueDate accepts a calendar date (2006-01-02) or an RFC 3339 instant.
+func parseDueDate(s string) (time.Time, error) {
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, goerr.Wrap(err, "due_date must be YYYY-MM-DD or RFC 3339", goerr.V("due_date", s))
+	}
+	return t.UTC(), nil
+}
+
+// --- create_case ---
+
+type createCaseInput struct {
+	WorkspaceID string       `json:"workspace_id" jsonschema:"the workspace ID to create the case in"`
+	Title       string       `json:"title" jsonschema:"title of the new case"`
+	Description string       `json:"description,omitempty" jsonschema:"description of the new case"`
+	AssigneeIDs []string     `json:"assignee_ids,omitempty" jsonschema:"Slack user IDs to assign (ignored in thread-mode workspaces)"`
+	Fields      []fieldInput `json:"fields,omitempty" jsonschema:"custom field values"`
+}
+
+type caseOutput struct {
+	Case caseDetail `json:"case"`
+}
+
+func (h *mcpHandler) runCreateCase(ctx context.Context, in createCaseInput) (caseOutput, error) {
+	entry, err := h.workspaceEntry(in.WorkspaceID)
+	if err != nil {
+		return caseOutput{}, err
+	}
+	if strings.TrimSpace(in.Title) == "" {
+		return caseOutput{}, goerr.New("title is required")
+	}
+	fields, err := coerceFields(entry.FieldSchema, in.Fields)
+	if err != nil {
+		return caseOutput{}, err
+	}
+
+	// Cases created over MCP are never private: the read tools could not show
+	// them back to the caller.
+	created, err := h.caseUC.CreateCase(ctx, in.WorkspaceID, in.Title, in.Description, in.AssigneeIDs, fields, false, false, "", "")
+	if err != nil {
+		return caseOutput{}, goerr.Wrap(err, "failed to create case", goerr.V("workspace_id", in.WorkspaceID))
+	}
+	return caseOutput{Case: toCaseDetail(created)}, nil
+}
+
+// --- update_case ---
+
+type updateCaseInput struct {
+	WorkspaceID string       `json:"workspace_id" jsonschema:"the workspace ID the case belongs to"`
+	CaseID      int64        `json:"case_id" jsonschema:"the ID of the case to update"`
+	Title       *string      `json:"title,omitempty" jsonschema:"new title"`
+	Description *string      `json:"description,omitempty" jsonschema:"new description"`
+	Fields      []fieldInput `json:"fields,omitempty" jsonschema:"custom field values to set; other fields are kept"`
+	Status      string       `json:"status,omitempty" jsonschema:"new status: OPEN or CLOSED, or for a thread-mode workspace one of its case_statuses"`
+}
+
+func (h *mcpHandler) runUpdateCase(ctx context.Context, in updateCaseInput) (caseOutput, error) {
+	entry, err := h.workspaceEntry(in.WorkspaceID)
+	if err != nil {
+		return caseOutput{}, err
+	}
+	if in.Title == nil && in.Description == nil && len(in.Fields) == 0 && in.Status == "" {
+		return caseOutput{}, goerr.New("at least one of title, description, fields or status is required")
+	}
+	fields, err := coerceFields(entry.FieldSchema, in.Fields)
+	if err != nil {
+		return caseOutput{}, err
+	}
+
+	c, err := h.loadWritableCase(ctx, in.WorkspaceID, in.CaseID)
+	if err != nil {
+		return caseOutput{}, err
+	}
+	if in.Title != nil || in.Description != nil || fields != nil {
+		c, err = h.caseUC.UpdateCase(ctx, in.WorkspaceID, in.CaseID, usecase.CaseUpdate{
+			Title:       in.Title,
+			Description: in.Description,
+			Fields:      fields,
+		})
+		if err != nil {
+			return caseOutput{}, goerr.Wrap(err, "failed to update case", goerr.V("case_id", in.CaseID))
+		}
+	}
+	if in.Status != "" {
+		c, err = h.updateCaseStatus(ctx, in.WorkspaceID, c, in.Status)
+		if err != nil {
+			return caseOutput{}, err
+		}
+	}
+	return caseOutput{Case: toCaseDetail(c)}, nil
+}
+
+// updateCaseStatus moves c to status the way its mode requires: a thread-mode
+// Case moves across the workspace's board statuses, a channel-mode one is
+// closed or reopened. Asking for the status a channel-mode Case already has is
+// a no-op rather than an error.
+func (h *mcpHandler) updateCaseStatus(ctx context.Context, workspaceID string, c *model.Case, status string) (*model.Case, error) {
+	if c.IsThreadBound() {
+		updated, err := h.caseUC.UpdateCaseStatus(ctx, workspaceID, c.ID, status)
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to update case status", goerr.V("case_id", c.ID), goerr.V("status", status))
+		}
+		return updated, nil
+	}
+
+	target, err := types.ParseCaseStatus(status)
+	if err != nil || target == types.CaseStatusDraft {
+		return nil, goerr.New("status must be OPEN or CLOSED", goerr.V("case_id", c.ID), goerr.V("status", status))
+	}
+	if c.Status.Normalize() == target {
+		return c, nil
+	}
+	var updated *model.Case
+	if target == types.CaseStatusClosed {
+		updated, err = h.caseUC.CloseCase(ctx, workspaceID, c.ID)
+	} else {
+		updated, err = h.caseUC.ReopenCase(ctx, workspaceID, c.ID)
+	}
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to update case status", goerr.V("case_id", c.ID), goerr.V("status", status))
+	}
+	return updated, nil
+}
+
+// --- create_action ---
+
+type createActionInput struct {
+	WorkspaceID string  `json:"workspace_id" jsonschema:"the workspace ID the case belongs to"`
+	CaseID      int64   `json:"case_id" jsonschema:"the ID of the case to add the action to"`
+	Title       string  `json:"title" jsonschema:"title of the new action"`
+	Description string  `json:"description,omitempty" jsonschema:"description of the new action"`
+	AssigneeID  string  `json:"assignee_id,omitempty" jsonschema:"Slack user ID of the assignee"`
+	Status      string  `json:"status,omitempty" jsonschema:"one of the workspace's action_statuses; defaults to the first"`
+	DueDate     string  `json:"due_date,omitempty" jsonschema:"due date as YYYY-MM-DD or RFC 3339"`
+	BlockedBy   []int64 `json:"blocked_by,omitempty" jsonschema:"IDs of actions of the same case this one waits on"`
+}
+
+type actionOutput struct {
+	Action actionDetail `json:"action"`
+}
+
+func (h *mcpHandler) runCreateAction(ctx context.Context, in createActionInput) (actionOutput, error) {
+	if _, err := h.workspaceEntry(in.WorkspaceID); err != nil {
+		return actionOutput{}, err
+	}
+	var dueDate *time.Time
+	if in.DueDate != "" {
+		d, err := parseDueDate(in.DueDate)
+		if err != nil {
+			return actionOutput{}, err
+		}
+		dueDate = &d
+	}
+	if _, err := h.loadWritableCase(ctx, in.WorkspaceID, in.CaseID); err != nil {
+		return actionOutput{}, err
+	}
+
+	created, err := h.actionUC.CreateAction(ctx, in.WorkspaceID, in.CaseID, in.Title, in.Description,
+		in.AssigneeID, "", types.ActionStatus(in.Status), dueDate, in.BlockedBy...)
+	if err != nil {
+		return actionOutput{}, goerr.Wrap(err, "failed to create action", goerr.V("case_id", in.CaseID))
+	}
+	return actionOutput{Action: toActionDetail(created)}, nil
+}
+
+// --- update_action ---
+
+type updateActionInput struct {
+	WorkspaceID string  `json:"workspace_id" jsonschema:"the workspace ID the action belongs to"`
+	ActionID    int64   `json:"action_id" jsonschema:"the ID of the action to update"`
+	Title       *string `json:"title,omitempty" jsonschema:"new title"`
+	Description *string `json:"description,omitempty" jsonschema:"new description"`
+	AssigneeID  *string `json:"assignee_id,omitempty" jsonschema:"Slack user ID of the new assignee; empty string unassigns"`
+	Status      string  `json:"status,omitempty" jsonschema:"one of the workspace's action_statuses"`
+	DueDate     *string `json:"due_date,omitempty" jsonschema:"new due date as YYYY-MM-DD or RFC 3339; empty string clears it"`
+}
+
+func (h *mcpHandler) runUpdateAction(ctx context.Context, in updateActionInput) (actionOutput, error) {
+	if _, err := h.workspaceEntry(in.WorkspaceID); err != nil {
+		return actionOutput{}, err
+	}
+	patch := usecase.UpdateActionInput{
+		ID:          in.ActionID,
+		Title:       in.Title,
+		Description: in.Description,
+		Actor:       mcpActor(ctx),
+		SlackSync:   usecase.SlackSyncFull,
+	}
+	if in.AssigneeID != nil {
+		if *in.AssigneeID == "" {
+			patch.ClearAssignee = true
+		} else {
+			patch.AssigneeID = in.AssigneeID
+		}
+	}
+	if in.Status != "" {
+		status := types.ActionStatus(in.Status)
+		patch.Status = &status
+	}
+	if in.DueDate != nil {
+		if *in.DueDate == "" {
+			patch.ClearDueDate = true
+		} else {
+			d, err := parseDueDate(*in.DueDate)
+			if err != nil {
+				return actionOutput{}, err
+			}
+			patch.DueDate = &d
+		}
+	}
+	if patch.Title == nil && patch.Description == nil && in.AssigneeID == nil && patch.Status == nil && in.DueDate == nil {
+		return actionOutput{}, goerr.New("at least one of title, description, assignee_id, status or due_date is required")
+	}
+
+	if _, err := h.loadWritableAction(ctx, in.WorkspaceID, in.ActionID); err != nil {
+		return actionOutput{}, err
+	}
+	updated, err := h.actionUC.UpdateAction(ctx, in.WorkspaceID, patch)
+	if err != nil {
+		return actionOutput{}, goerr.Wrap(err, "failed to update action", goerr.V("action_id", in.ActionID))
+	}
+	return actionOutput{Action: toActionDetail(updated)}, nil
+}
+
+// --- add_action_step ---
+
+type addActionStepInput struct {
+	WorkspaceID string `json:"workspace_id" jsonschema:"the workspace ID the action belongs to"`
+	ActionID    int64  `json:"action_id" jsonschema:"the ID of the action to add the step to"`
+	Title       string `json:"title" jsonschema:"title of the new step"`
+}
+
+type actionStepDetail struct {
+	ID        string `json:"id"`
+	ActionID  int64  `json:"action_id"`
+	Title     string `json:"title"`
+	Done      bool   `json:"done"`
+	CreatedBy string `json:"created_by,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
+type addActionStepOutput struct {
+	Step actionStepDetail `json:"step"`
+}
+
+func (h *mcpHandler) runAddActionStep(ctx context.Context, in addActionStepInput) (addActionStepOutput, error) {
+	if _, err := h.workspaceEntry(in.WorkspaceID); err != nil {
+		return addActionStepOutput{}, err
+	}
+	if _, err := h.loadWritableAction(ctx, in.WorkspaceID, in.ActionID); err != nil {
+		return addActionStepOutput{}, err
+	}
+
+	step, err := h.stepUC.Add(ctx, usecase.AddActionStepInput{
+		WorkspaceID: in.WorkspaceID,
+		ActionID:    in.ActionID,
+		Title:       in.Title,
+		Actor:       mcpActor(ctx),
+	})
+	if err != nil {
+		return addActionStepOutput{}, goerr.Wrap(err, "failed to add action step", goerr.V("action_id", in.ActionID))
+	}
+	return addActionStepOutput{Step: actionStepDetail{
+		ID:        step.ID,
+		ActionID:  step.ActionID,
+		Title:     step.Title,
+		Done:      step.IsDone(),
+		CreatedBy: step.CreatedBy,
+		CreatedAt: step.CreatedAt.UTC().Format(time.RFC3339),
+	}}, nil
+}
+
+// --- append_memo ---
+
+type appendMemoInput struct {
+	WorkspaceID string       `json:"workspace_id" jsonschema:"the workspace ID the case belongs to"`
+	CaseID      int64        `json:"case_id" jsonschema:"the ID of the case to add the memo to"`
+	Title       string       `json:"title" jsonschema:"title of the memo"`
+	Fields      []fieldInput `json:"fields,omitempty" jsonschema:"memo field values, by the workspace's memo_fields ids"`
+}
+
+type memoDetail struct {
+	ID          string       `json:"id"`
+	CaseID      int64        `json:"case_id"`
+	Title       string       `json:"title"`
+	FieldValues []fieldValue `json:"field_values"`
+	CreatorID   string       `json:"creator_id,omitempty"`
+	CreatedAt   string       `json:"created_at"`
+}
+
+type appendMemoOutput struct {
+	Memo memoDetail `json:"memo"`
+}
+
+func (h *mcpHandler) runAppendMemo(ctx context.Context, in appendMemoInput) (appendMemoOutput, error) {
+	entry, err := h.workspaceEntry(in.WorkspaceID)
+	if err != nil {
+		return appendMemoOutput{}, err
+	}
+	if !entry.MemoConfig.Enabled() {
+		return appendMemoOutput{}, goerr.Wrap(usecase.ErrMemoNotEnabled, "memos are not enabled in this workspace",
+			goerr.V("workspace_id", in.WorkspaceID))
+	}
+	fields, err := coerceFields(entry.MemoConfig.FieldSchema, in.Fields)
+	if err != nil {
+		return appendMemoOutput{}, err
+	}
+	if _, err := h.loadWritableCase(ctx, in.WorkspaceID, in.CaseID); err != nil {
+		return appendMemoOutput{}, err
+	}
+
+	memo, err := h.memoUC.CreateMemo(ctx, in.WorkspaceID, usecase.CreateMemoInput{
+		CaseID:      in.CaseID,
+		Title:       in.Title,
+		FieldValues: fields,
+	})
+	if err != nil {
+		return appendMemoOutput{}, goerr.Wrap(err, "failed to append memo", goerr.V("case_id", in.CaseID))
+	}
+
+	fvs := make([]fieldValue, 0, len(memo.FieldValues))
+	for _, fv := range memo.FieldValues {
+		fvs = append(fvs, fieldValue{FieldID: string(fv.FieldID), Type: string(fv.Type), Value: fv.Value})
+	}
+	return appendMemoOutput{Memo: memoDetail{
+		ID:          string(memo.ID),
+		CaseID:      memo.CaseID,
+		Title:       memo.Title,
+		FieldValues: fvs,
+		CreatorID:   memo.CreatorID,
+		CreatedAt:   memo.CreatedAt.UTC().Format(time.RFC3339),
+	}}, nil
+}
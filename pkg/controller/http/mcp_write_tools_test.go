@@ -0,0 +1,300 @@
+package http_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/authz"
+)
+
+func allowWriter() *fakePolicy {
+	return &fakePolicy{
+		result: authz.Result{Allow: true, User: "UMEMBER"},
+		write:  authz.Decision{Allow: true},
+	}
+}
+
+// toolErrorText joins the text content of a tool error result.
+func toolErrorText(t *testing.T, res *mcp.CallToolResult) string {
+	t.Helper()
+	gt.Bool(t, res.IsError).True()
+	var texts []string
+	for _, c := range res.Content {
+		if tc, ok := c.(*mcp.TextContent); ok {
+			texts = append(texts, tc.Text)
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+type caseResult struct {
+	Case struct {
+		ID          int64  `json:"id"`
+		Title       string `json:"title"`
+		Status      string `json:"status"`
+		ReporterID  string `json:"reporter_id"`
+		FieldValues []struct {
+			FieldID string `json:"field_id"`
+			Value   any    `json:"value"`
+		} `json:"field_values"`
+	} `json:"case"`
+}
+
+type actionResult struct {
+	Action struct {
+		ID      int64  `json:"id"`
+		CaseID  int64  `json:"case_id"`
+		Title   string `json:"title"`
+		Status  string `json:"status"`
+		DueDate string `json:"due_date"`
+	} `json:"action"`
+}
+
+func TestMCP_WriteToolsNeedTheWriteRule(t *testing.T) {
+	t.Run("a policy without the write rule keeps the endpoint read-only", func(t *testing.T) {
+		env := newMCPTestEnv(t, allowAsMember())
+		gt.Array(t, env.listToolNames(t)).NotHas("hecaton_create_case").NotHas("hecaton_create_knowledge")
+
+		res := env.callTool(t, "hecaton_create_case", map[string]any{"workspace_id": testWorkspaceID, "title": "New"})
+		gt.String(t, toolErrorText(t, res)).Contains("authorization denied")
+
+		list := env.callTool(t, "hecaton_list_cases", map[string]any{"workspace_id": testWorkspaceID})
+		var out struct {
+			Cases []struct {
+				Title string `json:"title"`
+			} `json:"cases"`
+		}
+		decodeStructured(t, list, &out)
+		gt.Array(t, out.Cases).Length(1)
+	})
+
+	t.Run("the write rule's reason is returned", func(t *testing.T) {
+		policy := allowWriter()
+		policy.write = authz.Decision{Allow: false, Reason: "freeze in effect"}
+		env := newMCPTestEnv(t, policy)
+
+		res := env.callTool(t, "hecaton_create_case", map[string]any{"workspace_id": testWorkspaceID, "title": "New"})
+		gt.String(t, toolErrorText(t, res)).Contains("freeze in effect")
+	})
+
+	t.Run("a token without a user cannot write", func(t *testing.T) {
+		env := newMCPTestEnv(t, &fakePolicy{
+			result: authz.Result{Allow: true},
+			write:  authz.Decision{Allow: true},
+		})
+		gt.Array(t, env.listToolNames(t)).NotHas("hecaton_create_case").Has("hecaton_list_cases")
+
+		res := env.callTool(t, "hecaton_create_case", map[string]any{"workspace_id": testWorkspaceID, "title": "New"})
+		gt.Bool(t, res.IsError).True()
+	})
+
+	t.Run("the write rule sees the tool and the resolved user", func(t *testing.T) {
+		policy := allowWriter()
+		env := newMCPTestEnv(t, policy)
+		gt.Array(t, env.listToolNames(t)).Has("hecaton_create_case").Has("hecaton_append_memo")
+
+		res := env.callTool(t, "hecaton_create_case", map[string]any{"workspace_id": testWorkspaceID, "title": "New"})
+		gt.Bool(t, res.IsError).False()
+		gt.Value(t, policy.lastInput.Tool.Name).Equal("hecaton_create_case")
+		gt.Value(t, policy.lastInput.Tool.WorkspaceID).Equal(testWorkspaceID)
+		gt.Value(t, policy.lastInput.User).Equal("UMEMBER")
+	})
+}
+
+func TestMCP_CreateAndUpdateCase(t *testing.T) {
+	env := newMCPTestEnv(t, allowWriter())
+
+	res := env.callTool(t, "hecaton_create_case", map[string]any{
+		"workspace_id": testWorkspaceID,
+		"title":        "Phishing wave",
+		"fields":       []any{map[string]any{"field_id": "severity", "value": "high"}},
+	})
+	gt.Bool(t, res.IsError).False()
+	var created caseResult
+	decodeStructured(t, res, &created)
+	gt.Value(t, created.Case.Title).Equal("Phishing wave")
+	gt.Value(t, created.Case.Status).Equal("OPEN")
+	gt.Value(t, created.Case.ReporterID).Equal("UMEMBER")
+	gt.Array(t, created.Case.FieldValues).Length(1).Required()
+	gt.Value(t, created.Case.FieldValues[0].Value).Equal("high")
+
+	res = env.callTool(t, "hecaton_update_case", map[string]any{
+		"workspace_id": testWorkspaceID,
+		"case_id":      created.Case.ID,
+		"title":        "Phishing wave (contained)",
+		"status":       "CLOSED",
+	})
+	gt.Bool(t, res.IsError).False()
+	var updated caseResult
+	decodeStructured(t, res, &updated)
+	gt.Value(t, updated.Case.Title).Equal("Phishing wave (contained)")
+	gt.Value(t, updated.Case.Status).Equal("CLOSED")
+
+	t.Run("rejects an unknown select option", func(t *testing.T) {
+		res := env.callTool(t, "hecaton_update_case", map[string]any{
+			"workspace_id": testWorkspaceID,
+			"case_id":      created.Case.ID,
+			"fields":       []any{map[string]any{"field_id": "severity", "value": "critical"}},
+		})
+		gt.Bool(t, res.IsError).True()
+	})
+}
+
+func TestMCP_CreateAndUpdateActionWithSteps(t *testing.T) {
+	env := newMCPTestEnv(t, allowWriter())
+
+	res := env.callTool(t, "hecaton_create_action", map[string]any{
+		"workspace_id": testWorkspaceID,
+		"case_id":      env.publicCase.ID,
+		"title":        "Block sender",
+		"due_date":     "2026-10-20",
+	})
+	gt.Bool(t, res.IsError).False()
+	var created actionResult
+	decodeStructured(t, res, &created)
+	gt.Value(t, created.Action.CaseID).Equal(env.publicCase.ID)
+	gt.Value(t, created.Action.Status).Equal("BACKLOG")
+	gt.String(t, created.Action.DueDate).HasPrefix("2026-10-20")
+
+	res = env.callTool(t, "hecaton_update_action", map[string]any{
+		"workspace_id": testWorkspaceID,
+		"action_id":    created.Action.ID,
+		"status":       "IN_PROGRESS",
+		"due_date":     "",
+	})
+	gt.Bool(t, res.IsError).False()
+	var updated actionResult
+	decodeStructured(t, res, &updated)
+	gt.Value(t, updated.Action.Status).Equal("IN_PROGRESS")
+	gt.Value(t, updated.Action.DueDate).Equal("")
+
+	res = env.callTool(t, "hecaton_add_action_step", map[string]any{
+		"workspace_id": testWorkspaceID,
+		"action_id":    created.Action.ID,
+		"title":        "Ask mail team for the header",
+	})
+	gt.Bool(t, res.IsError).False()
+	var step struct {
+		Step struct {
+			ActionID  int64  `json:"action_id"`
+			Title     string `json:"title"`
+			Done      bool   `json:"done"`
+			CreatedBy string `json:"created_by"`
+		} `json:"step"`
+	}
+	decodeStructured(t, res, &step)
+	gt.Value(t, step.Step.ActionID).Equal(created.Action.ID)
+	gt.Value(t, step.Step.Done).Equal(false)
+	gt.Value(t, step.Step.CreatedBy).Equal("UMEMBER")
+}
+
+func TestMCP_WriteToolsNeverTouchPrivateCases(t *testing.T) {
+	env := newMCPTestEnv(t, allowWriter())
+
+	calls := []struct {
+		tool string
+		args map[string]any
+	}{
+		{"hecaton_update_case", map[string]any{"case_id": env.privateCase.ID, "title": "Leaked"}},
+		{"hecaton_create_action", map[string]any{"case_id": env.privateCase.ID, "title": "Leaked"}},
+		{"hecaton_update_action", map[string]any{"action_id": env.privActID, "title": "Leaked"}},
+		{"hecaton_add_action_step", map[string]any{"action_id": env.privActID, "title": "Leaked"}},
+		{"hecaton_append_memo", map[string]any{"case_id": env.privateCase.ID, "title": "Leaked"}},
+	}
+	for _, c := range calls {
+		t.Run(c.tool, func(t *testing.T) {
+			c.args["workspace_id"] = testWorkspaceID
+			res := env.callTool(t, c.tool, c.args)
+			text := toolErrorText(t, res)
+			gt.String(t, text).Contains("not found")
+			gt.String(t, text).NotContains("Private Case")
+		})
+	}
+}
+
+func TestMCP_AppendMemo(t *testing.T) {
+	env := newMCPTestEnv(t, allowWriter())
+
+	res := env.callTool(t, "hecaton_append_memo", map[string]any{
+		"workspace_id": testWorkspaceID,
+		"case_id":      env.publicCase.ID,
+		"title":        "Sender analysis",
+		"fields":       []any{map[string]any{"field_id": "finding", "value": "same ASN as last week"}},
+	})
+	gt.Bool(t, res.IsError).False()
+	var out struct {
+		Memo struct {
+			CaseID      int64  `json:"case_id"`
+			Title       string `json:"title"`
+			CreatorID   string `json:"creator_id"`
+			FieldValues []struct {
+				FieldID string `json:"field_id"`
+				Value   any    `json:"value"`
+			} `json:"field_values"`
+		} `json:"memo"`
+	}
+	decodeStructured(t, res, &out)
+	gt.Value(t, out.Memo.CaseID).Equal(env.publicCase.ID)
+	gt.Value(t, out.Memo.CreatorID).Equal("UMEMBER")
+	gt.Array(t, out.Memo.FieldValues).Length(1).Required()
+	gt.Value(t, out.Memo.FieldValues[0].Value).Equal("same ASN as last week")
+}
+
+func TestMCP_CreateAndSearchKnowledge(t *testing.T) {
+	env := newMCPTestEnv(t, allowWriter())
+
+	res := env.callTool(t, "hecaton_list_tags", map[string]any{"workspace_id": testWorkspaceID})
+	gt.Bool(t, res.IsError).False()
+	var tags struct {
+		Tags []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"tags"`
+	}
+	decodeStructured(t, res, &tags)
+	gt.Array(t, tags.Tags).Length(1).Required()
+	gt.Value(t, tags.Tags[0].ID).Equal(string(env.tagID))
+
+	res = env.callTool(t, "hecaton_create_knowledge", map[string]any{
+		"workspace_id": testWorkspaceID,
+		"title":        "Phishing kit fingerprint",
+		"claim":        "The kit always posts to `/gate.php`.",
+		"tag_ids":      []any{string(env.tagID)},
+	})
+	gt.Bool(t, res.IsError).False()
+	var created struct {
+		Knowledge struct {
+			ID        string `json:"id"`
+			CreatorID string `json:"creator_id"`
+		} `json:"knowledge"`
+	}
+	decodeStructured(t, res, &created)
+	gt.Value(t, created.Knowledge.CreatorID).Equal("UMEMBER")
+
+	res = env.callTool(t, "hecaton_search_knowledge", map[string]any{
+		"workspace_id": testWorkspaceID,
+		"query":        "gate.php",
+	})
+	gt.Bool(t, res.IsError).False()
+	var found struct {
+		Knowledge []struct {
+			ID string `json:"id"`
+		} `json:"knowledge"`
+	}
+	decodeStructured(t, res, &found)
+	gt.Array(t, found.Knowledge).Length(1).Required()
+	gt.Value(t, found.Knowledge[0].ID).Equal(created.Knowledge.ID)
+
+	t.Run("refuses a tag that does not exist", func(t *testing.T) {
+		res := env.callTool(t, "hecaton_create_knowledge", map[string]any{
+			"workspace_id": testWorkspaceID,
+			"title":        "Unknown tag",
+			"claim":        "x",
+			"tag_ids":      []any{fmt.Sprintf("%s-missing", env.tagID)},
+		})
+		gt.Bool(t, res.IsError).True()
+	})
+}
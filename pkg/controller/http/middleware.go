@@ -2,6 +2,7 @@ package http
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
 )
@@ -28,6 +29,20 @@ func authMiddleware(authUC AuthUseCase) func(http.Handler) http.Handler {
 				return
 			}
 
+			// A personal access token takes precedence over cookies, so a
+			// script never acts as whoever's browser session it runs next to.
+			if bearer, ok := bearerToken(r); ok {
+				token, err := authUC.ValidateAPIToken(r.Context(), bearer)
+				if err != nil {
+					w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+					http.Error(w, `{"errors": [{"message": "Invalid authentication token"}]}`, http.StatusUnauthorized)
+					return
+				}
+				ctx := auth.ContextWithToken(r.Context(), token)
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+
 			// Get tokens from cookies
 			tokenIDCookie, err := r.Cookie("token_id")
 			if err != nil {
@@ -57,3 +72,14 @@ func authMiddleware(authUC AuthUseCase) func(http.Handler) http.Handler {
 		})
 	}
 }
+
+// bearerToken returns the credential of an `Authorization: Bearer` header.
+// The scheme is case-insensitive (RFC 7235 section 2.1).
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, credential, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	credential = strings.TrimSpace(credential)
+	return credential, credential != ""
+}
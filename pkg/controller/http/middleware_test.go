@@ -0,0 +1,70 @@
+package http_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	httpctrl "github.com/secmon-lab/hecatoncheires/pkg/controller/http"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+)
+
+func TestAuthMiddlewareBearerToken(t *testing.T) {
+	// whoami answers with the sub of the request token.
+	whoami := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, err := auth.TokenFromContext(r.Context())
+		gt.NoError(t, err).Required()
+		_, _ = w.Write([]byte(token.Sub))
+	})
+	handler := httpctrl.AuthMiddlewareForTest(&fakeAuthUC{})(whoami)
+
+	serve := func(setup func(r *http.Request)) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+		setup(req)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+	withCookies := func(r *http.Request) {
+		r.AddCookie(&http.Cookie{Name: "token_id", Value: auth.NewTokenID().String()})
+		r.AddCookie(&http.Cookie{Name: "token_secret", Value: "secret"})
+	}
+
+	t.Run("a valid bearer token authenticates as its owner", func(t *testing.T) {
+		rec := serve(func(r *http.Request) { r.Header.Set("Authorization", "Bearer hct_good") })
+		gt.Value(t, rec.Code).Equal(http.StatusOK)
+		gt.String(t, rec.Body.String()).Equal("U_PAT")
+	})
+
+	t.Run("the scheme is case-insensitive", func(t *testing.T) {
+		rec := serve(func(r *http.Request) { r.Header.Set("Authorization", "bearer hct_good") })
+		gt.Value(t, rec.Code).Equal(http.StatusOK)
+	})
+
+	t.Run("an invalid bearer token is refused even with a session cookie", func(t *testing.T) {
+		rec := serve(func(r *http.Request) {
+			withCookies(r)
+			r.Header.Set("Authorization", "Bearer hct_bad")
+		})
+		gt.Value(t, rec.Code).Equal(http.StatusUnauthorized)
+		gt.String(t, rec.Header().Get("WWW-Authenticate")).Contains("invalid_token")
+	})
+
+	t.Run("a bearer token wins over a session cookie", func(t *testing.T) {
+		rec := serve(func(r *http.Request) {
+			withCookies(r)
+			r.Header.Set("Authorization", "Bearer hct_good")
+		})
+		gt.String(t, rec.Body.String()).Equal("U_PAT")
+	})
+
+	t.Run("other schemes fall back to the session cookie", func(t *testing.T) {
+		rec := serve(func(r *http.Request) {
+			withCookies(r)
+			r.Header.Set("Authorization", "Basic dXNlcjpwYXNz")
+		})
+		gt.Value(t, rec.Code).Equal(http.StatusOK)
+		gt.String(t, rec.Body.String()).Equal("U1")
+	})
+}
@@ -32,6 +32,7 @@ type Server struct {
 	tickHookHandler         *TickHookHandler
 	dbCheckHandler          *DBCheckHandler
 	mcpHandler              http.Handler
+	ingestHandler           *IngestHandler
 }
 
 type Options func(*Server)
@@ -107,6 +108,15 @@ func WithMCP(handler http.Handler) Options {
 	}
 }
 
+// WithIngest wires the alert ingestion endpoint at POST
+// /hooks/ingest/{ws_id}. Like the MCP handler it authorizes every request
+// against the Rego policy itself. nil handler leaves the route unregistered.
+func WithIngest(handler *IngestHandler) Options {
+	return func(s *Server) {
+		s.ingestHandler = handler
+	}
+}
+
 func New(gqlHandler http.Handler, opts ...Options) (*Server, error) {
 	r := chi.NewRouter()
 
@@ -146,6 +156,8 @@ func New(gqlHandler http.Handler, opts ...Options) (*Server, error) {
 		r.Route("/api/auth", func(r chi.Router) {
 			r.Get("/login", authLoginHandler(s.authUC))
 			r.Get("/callback", authCallbackHandler(s.authUC))
+			r.Get("/callback/{provider}", authCallbackHandler(s.authUC))
+			r.Get("/providers", authProvidersHandler(s.authUC))
 			r.Post("/logout", authLogoutHandler(s.authUC))
 			r.Get("/me", authMeHandler(s.authUC))
 			r.Get("/user-info", authUserInfoHandler(s.slackService))
@@ -194,6 +206,12 @@ func New(gqlHandler http.Handler, opts ...Options) (*Server, error) {
 		r.Post("/api/validate/db", s.dbCheckHandler.ServeHTTP)
 	}
 
+	// Alert ingestion endpoint. Authorized per request by the Rego policy
+	// inside the handler.
+	if s.ingestHandler != nil {
+		r.Post("/hooks/ingest/{ws_id}", s.ingestHandler.ServeHTTP)
+	}
+
 	// MCP endpoint (Streamable HTTP). The handler embeds its own Rego-based
 	// authorization, so no auth middleware is applied here. Must be registered
 	// before the catch-all SPA route.
@@ -94,6 +94,13 @@ func (m *mockSlackServiceForCommand) OpenView(_ context.Context, _ string, _ gos
 func (m *mockSlackServiceForCommand) UpdateView(_ context.Context, _ goslack.ModalViewRequest, _, _, _ string) error {
 	return nil
 }
+
+func (m *mockSlackServiceForCommand) PublishView(_ context.Context, _ string, _ goslack.HomeTabViewRequest) error {
+	return nil
+}
+func (m *mockSlackServiceForCommand) UnfurlMessage(_ context.Context, _, _ string, _ map[string]goslack.Attachment) error {
+	return nil
+}
 func (m *mockSlackServiceForCommand) ListUserGroups(_ context.Context, _ string) ([]slacksvc.UserGroup, error) {
 	return nil, nil
 }
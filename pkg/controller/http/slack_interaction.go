@@ -78,6 +78,23 @@ func (h *SlackInteractionHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	switch callback.Type {
+	case slack.InteractionTypeBlockSuggestion:
+		h.handleBlockSuggestion(w, r, &callback)
+		return
+
+	case slack.InteractionTypeMessageAction:
+		// Message shortcut. Opening its modal consumes the trigger_id, so it
+		// runs synchronously, like ActionIDDraftEdit below.
+		if callback.CallbackID == usecase.SlackCallbackIDAttachMessage {
+			if err := h.slackUC.HandleAttachMessageShortcut(ctx, &callback); err != nil {
+				errutil.Handle(ctx, goerr.Wrap(err, "failed to open attach message modal"), "failed to open attach message modal")
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
 	// Only handle block_actions (button clicks) below
 	if callback.Type != slack.InteractionTypeBlockActions {
 		w.WriteHeader(http.StatusOK)
@@ -165,6 +182,16 @@ func (h *SlackInteractionHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 				errutil.Handle(ctx, err, "failed to handle draft edit interaction")
 			}
 
+		case usecase.SlackActionIDHomeCreateCase:
+			// "New case" on the App Home tab. Opens the same modal as the
+			// slash command (the workspace picker when the button names no
+			// workspace); consumes the trigger_id, so it runs synchronously
+			// like ActionIDDraftEdit below.
+			if err := h.slackUC.HandleSlashCommand(ctx, cb.TriggerID, cb.User.ID, "", a.Value, cb.Team.ID, ""); err != nil {
+				errutil.Handle(ctx, goerr.Wrap(err, "failed to open case creation from app home",
+					goerr.V("workspace_id", a.Value)), "failed to open case creation from app home")
+			}
+
 		case usecase.ActionIDDraftSelectWS,
 			usecase.ActionIDDraftSubmit,
 			usecase.ActionIDDraftCancel,
@@ -191,6 +218,14 @@ func (h *SlackInteractionHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 				return h.agentUC.HandleThreadCaseQuestionSubmit(ctx, &cb, a)
 			})
 
+		case usecase.ActionIDToolApprovalApprove, usecase.ActionIDToolApprovalDeny:
+			// Approve / Deny on an agent tool approval prompt. Delivering the
+			// decision resumes the suspended run, so it goes to the async tail
+			// after the block_actions ack.
+			async.Dispatch(ctx, func(ctx context.Context) error {
+				return h.agentUC.HandleToolApprovalClick(ctx, &cb, a)
+			})
+
 		case jobuc.ActionIDJobQuestionSubmit:
 			// Submit on an interactive Job's question form. Resumes the
 			// suspended Job run in the async tail (it talks to the LLM and
@@ -294,6 +329,17 @@ func (h *SlackInteractionHandler) handleViewSubmission(w http.ResponseWriter, r
 		})
 		return
 
+	case usecase.SlackCallbackIDAttachMessage:
+		// Close the modal, then attach asynchronously; the outcome is reported
+		// to the user through the shortcut's response_url.
+		w.WriteHeader(http.StatusOK)
+		async.Dispatch(ctx, func(ctx context.Context) error {
+			if err := h.slackUC.HandleAttachMessageSubmit(ctx, h.caseUC, callback); err != nil {
+				return goerr.Wrap(err, "failed to handle attach message submit")
+			}
+			return nil
+		})
+
 	case usecase.SlackCallbackIDEditCase:
 		// Return 200 immediately to close the modal, then process asynchronously
 		w.WriteHeader(http.StatusOK)
@@ -310,6 +356,31 @@ func (h *SlackInteractionHandler) handleViewSubmission(w http.ResponseWriter, r
 	}
 }
 
+// handleBlockSuggestion answers the options request of an external_select
+// menu. Slack waits for the options in the response, so it runs
+// synchronously.
+func (h *SlackInteractionHandler) handleBlockSuggestion(w http.ResponseWriter, r *http.Request, callback *slack.InteractionCallback) {
+	ctx := r.Context()
+
+	var resp *slack.OptionsResponse
+	switch callback.ActionID {
+	case usecase.SlackActionIDAttachCase:
+		options, err := h.slackUC.SuggestAttachCases(ctx, h.caseUC, callback)
+		if err != nil {
+			errutil.Handle(ctx, goerr.Wrap(err, "failed to suggest cases to attach to"), "failed to suggest cases to attach to")
+			options = &slack.OptionsResponse{}
+		}
+		resp = options
+	default:
+		resp = &slack.OptionsResponse{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		errutil.Handle(ctx, goerr.Wrap(err, "failed to encode block suggestion response"), "failed to encode block suggestion response")
+	}
+}
+
 // writeViewSubmissionError writes a view_submission error response that shows errors in the modal
 func writeViewSubmissionError(ctx context.Context, w http.ResponseWriter, blockID string, msg string) {
 	resp := slack.ViewSubmissionResponse{
@@ -711,6 +711,44 @@ func TestSlackInteractionHandler_ViewSubmission(t *testing.T) {
 	})
 }
 
+func TestSlackInteractionHandler_BlockSuggestion(t *testing.T) {
+	repo := memory.New()
+	registry := model.NewWorkspaceRegistry()
+	registry.Register(&model.WorkspaceEntry{
+		Workspace: model.Workspace{ID: testWorkspaceID, Name: "Test"},
+	})
+	actionUC := usecase.NewActionUseCase(repo, nil, nil, "", nil)
+	caseUC := usecase.NewCaseUseCase(repo, registry, nil, nil, "")
+	slackUC := usecase.NewSlackUseCases(repo, registry, nil, nil, &mockSlackServiceForCommand{})
+	handler := newTestSlackHandler(t, repo, registry, actionUC, slackUC, caseUC)
+
+	ctx := auth.ContextWithToken(t.Context(), &auth.Token{Sub: "U001"})
+	c, err := caseUC.CreateCase(ctx, testWorkspaceID, "Phishing wave", "", []string{}, nil, false, false, "", "")
+	gt.NoError(t, err).Required()
+
+	callback := goslack.InteractionCallback{
+		Type:     goslack.InteractionTypeBlockSuggestion,
+		User:     goslack.User{ID: "U001"},
+		ActionID: usecase.SlackActionIDAttachCase,
+		Value:    "phish",
+	}
+	payloadJSON, err := json.Marshal(callback)
+	gt.NoError(t, err).Required()
+
+	form := url.Values{"payload": {string(payloadJSON)}}
+	req := httptest.NewRequest(http.MethodPost, "/hooks/slack/interaction", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+	gt.Value(t, rec.Code).Equal(http.StatusOK)
+
+	var resp goslack.OptionsResponse
+	gt.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp)).Required()
+	gt.A(t, resp.Options).Length(1).Required()
+	gt.Value(t, resp.Options[0].Value).Equal(fmt.Sprintf("%s:%d", testWorkspaceID, c.ID))
+}
+
 func itoa(n int64) string {
 	return fmt.Sprintf("%d", n)
 }
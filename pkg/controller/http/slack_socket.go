@@ -0,0 +1,219 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/async"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/logging"
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/socketmode"
+)
+
+// SlackSocketModeReceiver receives Slack events, interactions and slash
+// commands over a Socket Mode WebSocket instead of the /hooks/slack routes,
+// so a deployment behind NAT needs no public ingress and no signing secret.
+//
+// Each envelope is replayed against the same http.Handler the matching
+// /hooks/slack route serves, as the request Slack would have POSTed, and the
+// handler's response becomes the envelope's ack: a view_submission's
+// response_action and a slash command's reply text come back to Slack exactly
+// as they do over HTTP.
+type SlackSocketModeReceiver struct {
+	client       *socketmode.Client
+	events       http.Handler
+	interactions http.Handler
+	commands     http.Handler
+}
+
+// NewSlackSocketModeReceiver creates a receiver connecting with the app-level
+// token (xapp-...). The handlers are the ones mounted at /hooks/slack/event,
+// /interaction and /command; a nil handler acks its envelopes and drops them.
+// opts configure the underlying Slack API client (tests point it at a local
+// server with slack.OptionAPIURL).
+func NewSlackSocketModeReceiver(appToken string, events, interactions, commands http.Handler, opts ...slack.Option) *SlackSocketModeReceiver {
+	api := slack.New("", append([]slack.Option{slack.OptionAppLevelToken(appToken)}, opts...)...)
+	return &SlackSocketModeReceiver{
+		client:       socketmode.New(api),
+		events:       events,
+		interactions: interactions,
+		commands:     commands,
+	}
+}
+
+// Run connects and serves envelopes until ctx is cancelled, reconnecting
+// whenever Slack asks for it or the connection drops. A failure to open a
+// connection (e.g. an invalid app token) ends Run with that error.
+func (r *SlackSocketModeReceiver) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- r.client.RunContext(ctx)
+	}()
+
+	for {
+		select {
+		case err := <-done:
+			if err == nil || errors.Is(err, context.Canceled) {
+				return nil
+			}
+			return goerr.Wrap(err, "slack socket mode connection failed")
+
+		case evt := <-r.client.Events:
+			r.dispatch(ctx, evt)
+		}
+	}
+}
+
+func (r *SlackSocketModeReceiver) dispatch(ctx context.Context, evt socketmode.Event) {
+	logger := logging.From(ctx)
+	switch evt.Type {
+	case socketmode.EventTypeConnecting:
+		logger.Info("connecting to slack socket mode")
+	case socketmode.EventTypeConnected:
+		logger.Info("connected to slack socket mode")
+	case socketmode.EventTypeConnectionError, socketmode.EventTypeIncomingError,
+		socketmode.EventTypeErrorWriteFailed, socketmode.EventTypeErrorBadMessage:
+		if ctx.Err() != nil {
+			// Errors from tearing the connection down on shutdown.
+			return
+		}
+		errutil.Handle(ctx, goerr.New("slack socket mode error",
+			goerr.V("type", evt.Type), goerr.V("data", evt.Data)), "slack socket mode error")
+		if bad, ok := evt.Data.(*socketmode.ErrorBadMessage); ok {
+			r.ackBadMessage(ctx, bad)
+		}
+
+	case socketmode.EventTypeEventsAPI, socketmode.EventTypeInteractive, socketmode.EventTypeSlashCommand:
+		if evt.Request == nil {
+			return
+		}
+		// Serve each envelope on its own goroutine, as net/http serves each
+		// request: an interaction that opens a modal synchronously must not
+		// hold up the events queued behind it.
+		async.Dispatch(ctx, func(ctx context.Context) error {
+			return r.serve(ctx, evt.Type, evt.Request)
+		})
+	}
+}
+
+// serve replays one envelope against its handler and acks it with the
+// handler's response. The envelope is acked even when the handler rejects it:
+// Slack would otherwise redeliver a payload that will never be accepted.
+func (r *SlackSocketModeReceiver) serve(ctx context.Context, typ socketmode.EventType, req *socketmode.Request) error {
+	handler, httpReq, err := r.toHTTPRequest(ctx, typ, req.Payload)
+	if err != nil {
+		r.ack(ctx, req, nil)
+		return err
+	}
+	if handler == nil {
+		r.ack(ctx, req, nil)
+		return nil
+	}
+
+	res := &socketModeResponse{header: http.Header{}, status: http.StatusOK}
+	handler.ServeHTTP(res, httpReq)
+	if res.status >= http.StatusBadRequest {
+		r.ack(ctx, req, nil)
+		return goerr.New("slack socket mode envelope rejected",
+			goerr.V("type", typ), goerr.V("status", res.status), goerr.V("body", res.body.String()))
+	}
+	r.ack(ctx, req, res.ackPayload())
+	return nil
+}
+
+// toHTTPRequest rebuilds the request Slack POSTs for the envelope: the Events
+// API payload as a JSON body, an interaction as the form field "payload", and
+// a slash command as its form fields.
+func (r *SlackSocketModeReceiver) toHTTPRequest(ctx context.Context, typ socketmode.EventType, payload json.RawMessage) (http.Handler, *http.Request, error) {
+	switch typ {
+	case socketmode.EventTypeEventsAPI:
+		httpReq, err := newSocketModeRequest(ctx, "/hooks/slack/event", "application/json", string(payload))
+		return r.events, httpReq, err
+
+	case socketmode.EventTypeInteractive:
+		form := url.Values{"payload": {string(payload)}}
+		httpReq, err := newSocketModeRequest(ctx, "/hooks/slack/interaction", formContentType, form.Encode())
+		return r.interactions, httpReq, err
+
+	case socketmode.EventTypeSlashCommand:
+		var fields map[string]any
+		if err := json.Unmarshal(payload, &fields); err != nil {
+			return nil, nil, goerr.Wrap(err, "failed to parse slash command payload")
+		}
+		form := url.Values{}
+		for k, v := range fields {
+			if s, ok := v.(string); ok {
+				form.Set(k, s)
+			}
+		}
+		httpReq, err := newSocketModeRequest(ctx, "/hooks/slack/command", formContentType, form.Encode())
+		return r.commands, httpReq, err
+	}
+	return nil, nil, goerr.New("unsupported slack socket mode envelope", goerr.V("type", typ))
+}
+
+const formContentType = "application/x-www-form-urlencoded"
+
+func newSocketModeRequest(ctx context.Context, path, contentType, body string) (*http.Request, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, path, strings.NewReader(body))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to build slack socket mode request", goerr.V("path", path))
+	}
+	httpReq.Header.Set("Content-Type", contentType)
+	return httpReq, nil
+}
+
+// socketModeResponse is the http.ResponseWriter a replayed envelope is
+// served into; what the handler writes becomes the envelope's ack.
+type socketModeResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *socketModeResponse) Header() http.Header         { return w.header }
+func (w *socketModeResponse) Write(b []byte) (int, error) { return w.body.Write(b) }
+func (w *socketModeResponse) WriteHeader(status int)      { w.status = status }
+
+// ackPayload turns the handler's response body into the ack payload: JSON is
+// passed through (a view_submission response_action), plain text becomes a
+// slash command reply, and an empty body acks without a payload.
+func (w *socketModeResponse) ackPayload() any {
+	body := w.body.Bytes()
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+	if strings.HasPrefix(w.header.Get("Content-Type"), "application/json") && json.Valid(body) {
+		return json.RawMessage(body)
+	}
+	return map[string]string{"text": string(body)}
+}
+
+// ackBadMessage acks an envelope the client could not parse, so Slack does
+// not keep redelivering it.
+func (r *SlackSocketModeReceiver) ackBadMessage(ctx context.Context, bad *socketmode.ErrorBadMessage) {
+	var envelope struct {
+		EnvelopeID string `json:"envelope_id"`
+	}
+	if err := json.Unmarshal(bad.Message, &envelope); err != nil || envelope.EnvelopeID == "" {
+		return
+	}
+	r.ack(ctx, &socketmode.Request{EnvelopeID: envelope.EnvelopeID}, nil)
+}
+
+func (r *SlackSocketModeReceiver) ack(ctx context.Context, req *socketmode.Request, payload any) {
+	if err := r.client.AckCtx(ctx, req.EnvelopeID, payload); err != nil {
+		errutil.Handle(ctx, goerr.Wrap(err, "failed to ack slack socket mode envelope",
+			goerr.V("envelope_id", req.EnvelopeID), goerr.V("type", req.Type)), "failed to ack slack socket mode envelope")
+	}
+}
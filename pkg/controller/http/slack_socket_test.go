@@ -0,0 +1,205 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/m-mizutani/gt"
+	httpctrl "github.com/secmon-lab/hecatoncheires/pkg/controller/http"
+	goslack "github.com/slack-go/slack"
+)
+
+// fakeSocketModeServer stands in for Slack: apps.connections.open hands out
+// a WebSocket URL, and the socket sends hello followed by the given
+// envelopes, collecting the acks the receiver sends back.
+type fakeSocketModeServer struct {
+	*httptest.Server
+	envelopes []string
+	acks      chan map[string]json.RawMessage
+}
+
+func newFakeSocketModeServer(t *testing.T, envelopes ...string) *fakeSocketModeServer {
+	t.Helper()
+	f := &fakeSocketModeServer{
+		envelopes: envelopes,
+		acks:      make(chan map[string]json.RawMessage, len(envelopes)),
+	}
+	// The client dials with Origin: https://api.slack.com.
+	upgrader := websocket.Upgrader{CheckOrigin: func(*http.Request) bool { return true }}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/apps.connections.open", func(w http.ResponseWriter, r *http.Request) {
+		gt.Value(t, r.Header.Get("Authorization")).Equal("Bearer xapp-1-test")
+		wsURL := "ws" + strings.TrimPrefix(f.URL, "http") + "/link"
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"ok":true,"url":"`+wsURL+`"}`)
+	})
+	mux.HandleFunc("/link", func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"hello","num_connections":1}`)); err != nil {
+			return
+		}
+		for _, env := range f.envelopes {
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(env)); err != nil {
+				return
+			}
+		}
+		for {
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var ack map[string]json.RawMessage
+			if json.Unmarshal(msg, &ack) == nil {
+				f.acks <- ack
+			}
+		}
+	})
+	f.Server = httptest.NewServer(mux)
+	t.Cleanup(f.Close)
+	return f
+}
+
+// recordingHandler records the requests it serves and replies with a fixed
+// response.
+type recordingHandler struct {
+	mu          sync.Mutex
+	requests    []*http.Request
+	bodies      []string
+	contentType string
+	response    string
+	status      int
+}
+
+func (h *recordingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	h.mu.Lock()
+	h.requests = append(h.requests, r)
+	h.bodies = append(h.bodies, string(body))
+	h.mu.Unlock()
+
+	if h.contentType != "" {
+		w.Header().Set("Content-Type", h.contentType)
+	}
+	if h.status != 0 {
+		w.WriteHeader(h.status)
+	}
+	_, _ = io.WriteString(w, h.response)
+}
+
+func (h *recordingHandler) served() ([]*http.Request, []string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.requests, h.bodies
+}
+
+func runSocketModeReceiver(t *testing.T, srv *fakeSocketModeServer, events, interactions, commands http.Handler) map[string]json.RawMessage {
+	t.Helper()
+	receiver := httpctrl.NewSlackSocketModeReceiver("xapp-1-test", events, interactions, commands,
+		goslack.OptionAPIURL(srv.URL+"/api/"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- receiver.Run(ctx) }()
+	defer func() {
+		cancel()
+		gt.NoError(t, <-done)
+	}()
+
+	acks := map[string]json.RawMessage{}
+	timeout := time.After(5 * time.Second)
+	for len(acks) < len(srv.envelopes) {
+		select {
+		case ack := <-srv.acks:
+			var id string
+			gt.NoError(t, json.Unmarshal(ack["envelope_id"], &id)).Required()
+			acks[id] = ack["payload"]
+		case <-timeout:
+			t.Fatalf("received %d of %d acks", len(acks), len(srv.envelopes))
+		}
+	}
+	return acks
+}
+
+func TestSlackSocketModeReceiver(t *testing.T) {
+	t.Run("replays each envelope against its handler and acks with the response", func(t *testing.T) {
+		srv := newFakeSocketModeServer(t,
+			`{"type":"events_api","envelope_id":"env-event","payload":{"type":"event_callback","team_id":"T1","event":{"type":"app_mention","user":"U1","text":"hi","channel":"C1","ts":"1.1"}}}`,
+			`{"type":"interactive","envelope_id":"env-view","payload":{"type":"view_submission","team":{"id":"T1"},"user":{"id":"U1"},"view":{"callback_id":"hc_create_case"}}}`,
+			`{"type":"slash_commands","envelope_id":"env-command","payload":{"command":"/hc","text":"new","team_id":"T1","user_id":"U1","channel_id":"C1","trigger_id":"trg","is_enterprise_install":"false"}}`,
+		)
+		events := &recordingHandler{}
+		interactions := &recordingHandler{contentType: "application/json", response: `{"response_action":"clear"}`}
+		commands := &recordingHandler{response: "Opening the case form"}
+
+		acks := runSocketModeReceiver(t, srv, events, interactions, commands)
+
+		reqs, bodies := events.served()
+		gt.Array(t, reqs).Length(1).Required()
+		gt.Value(t, reqs[0].URL.Path).Equal("/hooks/slack/event")
+		gt.Value(t, reqs[0].Header.Get("Content-Type")).Equal("application/json")
+		gt.String(t, bodies[0]).Contains(`"app_mention"`)
+		gt.Value(t, len(acks["env-event"])).Equal(0)
+
+		reqs, bodies = interactions.served()
+		gt.Array(t, reqs).Length(1).Required()
+		gt.Value(t, reqs[0].URL.Path).Equal("/hooks/slack/interaction")
+		form, err := url.ParseQuery(bodies[0])
+		gt.NoError(t, err).Required()
+		gt.String(t, form.Get("payload")).Contains(`"view_submission"`)
+		gt.String(t, string(acks["env-view"])).Equal(`{"response_action":"clear"}`)
+
+		reqs, bodies = commands.served()
+		gt.Array(t, reqs).Length(1).Required()
+		gt.Value(t, reqs[0].URL.Path).Equal("/hooks/slack/command")
+		gt.Value(t, reqs[0].Header.Get("Content-Type")).Equal("application/x-www-form-urlencoded")
+		form, err = url.ParseQuery(bodies[0])
+		gt.NoError(t, err).Required()
+		gt.Value(t, form.Get("command")).Equal("/hc")
+		gt.Value(t, form.Get("text")).Equal("new")
+		gt.Value(t, form.Get("trigger_id")).Equal("trg")
+		gt.String(t, string(acks["env-command"])).Equal(`{"text":"Opening the case form"}`)
+	})
+
+	t.Run("acks envelopes that are rejected, unhandled or malformed", func(t *testing.T) {
+		srv := newFakeSocketModeServer(t,
+			`{"type":"interactive","envelope_id":"env-rejected","payload":{"type":"block_actions","team":{"id":"T1"},"user":{"id":"U1"}}}`,
+			`{"type":"slash_commands","envelope_id":"env-unhandled","payload":{"command":"/hc","team_id":"T1","is_enterprise_install":"false"}}`,
+			`{"type":"slash_commands","envelope_id":"env-malformed","payload":{"command":"/hc","is_enterprise_install":null}}`,
+		)
+		interactions := &recordingHandler{status: http.StatusBadRequest, response: "bad payload"}
+
+		acks := runSocketModeReceiver(t, srv, nil, interactions, nil)
+
+		gt.Value(t, len(acks["env-rejected"])).Equal(0)
+		gt.Value(t, len(acks["env-unhandled"])).Equal(0)
+		gt.Value(t, len(acks["env-malformed"])).Equal(0)
+	})
+
+	t.Run("fails when the connection cannot be opened", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = io.WriteString(w, `{"ok":false,"error":"invalid_auth"}`)
+		}))
+		t.Cleanup(srv.Close)
+
+		receiver := httpctrl.NewSlackSocketModeReceiver("xapp-1-bad", nil, nil, nil,
+			goslack.OptionAPIURL(srv.URL+"/api/"))
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		gt.Error(t, receiver.Run(ctx))
+	})
+}
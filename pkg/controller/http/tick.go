@@ -11,9 +11,10 @@ import (
 )
 
 // TickScanner is the narrow surface the HTTP layer needs to fire a
-// scheduled-Job sweep. The runtime implementation lives in
-// pkg/usecase/job.ScheduledScanner; this interface keeps the HTTP layer
-// off the usecase import.
+// tick's sweeps. The runtime implementations live in
+// pkg/usecase/job.ScheduledScanner and the usecase sweeps (knowledge review,
+// action reminders, SLA, webhook retries), combined by pkg/cli; this
+// interface keeps the HTTP layer off the usecase import.
 type TickScanner interface {
 	Scan(ctx context.Context) error
 }
@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
 )
@@ -45,6 +46,16 @@ type ActionListOptions struct {
 	// exposed, not even to members. Defaults to false so existing callers
 	// keep the membership-based behaviour.
 	ExcludePrivateCaseActions bool
+
+	// UpdatedAfter, when non-nil, keeps only actions whose UpdatedAt is
+	// strictly after this instant. nil means unbounded. The incremental export
+	// uses it to read only what changed since its watermark.
+	UpdatedAfter *time.Time
+}
+
+// AllowsUpdatedAt reports whether an action updated at t passes UpdatedAfter.
+func (o ActionListOptions) AllowsUpdatedAt(t time.Time) bool {
+	return o.UpdatedAfter == nil || t.After(*o.UpdatedAfter)
 }
 
 // ActionRepository defines the interface for Action data access
@@ -0,0 +1,24 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+// ActionReminderRepository records which due-date reminders were sent, so the
+// tick sweep sends each one once even when several instances run it. A
+// reminder is identified by the action, its stage and the due date it was
+// computed from: moving the due date arms the reminders again.
+type ActionReminderRepository interface {
+	// Claim atomically records the reminder. It returns claimed=true for the
+	// first caller and claimed=false when the reminder was already claimed.
+	// Like ReactionClaimRepository.Claim it is a create-if-absent keyed by
+	// the reminder's identity, so it is safe across concurrent instances.
+	Claim(ctx context.Context, workspaceID string, actionID int64, kind model.ActionReminderKind, dueDate time.Time) (claimed bool, err error)
+
+	// Release removes a claim so the next sweep retries the reminder. Called
+	// only when sending it failed. A missing claim is not an error.
+	Release(ctx context.Context, workspaceID string, actionID int64, kind model.ActionReminderKind, dueDate time.Time) error
+}
@@ -0,0 +1,27 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+// AlertRepository stores the alerts received on the ingest endpoint. Alerts
+// are scoped to a workspace and never change once stored, except to follow
+// their case into a merge.
+type AlertRepository interface {
+	// Create stores a new alert (Validate then persist).
+	Create(ctx context.Context, a *model.Alert) error
+
+	// ListByCase returns the alerts of one case, oldest first.
+	ListByCase(ctx context.Context, workspaceID string, caseID int64) ([]*model.Alert, error)
+
+	// LatestByFingerprint returns the most recently received alert with the
+	// fingerprint, or (nil, nil) when none was received.
+	LatestByFingerprint(ctx context.Context, workspaceID, fingerprint string) (*model.Alert, error)
+
+	// MoveCase re-parents every alert of fromCaseID to toCaseID, keeping
+	// their IDs. Used when Cases are merged, so a repeat of a merged Case's
+	// alert folds into the surviving Case.
+	MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error
+}
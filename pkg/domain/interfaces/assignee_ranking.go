@@ -11,7 +11,7 @@ import (
 // it needs only Get/Set — no List.
 type AssigneeRankingRepository interface {
 	// Get returns the workspace's stored ranking. Returns the backend's
-	// ErrNotFound (memory.ErrNotFound / firestore.ErrNotFound) when the
+	// ErrNotFound (the shared interfaces.ErrNotFound) when the
 	// workspace has none yet.
 	Get(ctx context.Context, workspaceID string) (*model.AssigneeRanking, error)
 
@@ -29,6 +29,14 @@ type CaseRepository interface {
 	// callers should generally rely on ListDrafts for the draft-author view.
 	List(ctx context.Context, workspaceID string, opts ...ListCaseOption) ([]*model.Case, error)
 
+	// Search returns one page of the cases matching opts, ordered by sort, and
+	// the cursor of the next page ("" after the last page). It applies the same
+	// filters as List — drafts are excluded unless WithStatus asks for them.
+	// cursor is "" for the first page or a cursor a previous Search with the
+	// same sort returned; any other value fails with ErrInvalidCaseCursor.
+	// limit <= 0 returns every match.
+	Search(ctx context.Context, workspaceID string, sort CaseSort, limit int, cursor string, opts ...ListCaseOption) ([]*model.Case, string, error)
+
 	// ListDrafts retrieves all cases in DRAFT status across the workspace.
 	// Drafts are surfaced workspace-wide so any team member can pick up an
 	// in-progress entry; the usecase layer applies private-draft access
@@ -19,4 +19,8 @@ type CaseMessageRepository interface {
 	// Prune deletes messages older than the specified time for a specific case
 	// Returns the number of messages deleted
 	Prune(ctx context.Context, workspaceID string, caseID int64, before time.Time) (int, error)
+
+	// MoveCase re-parents every message of fromCaseID to toCaseID. Used when
+	// Cases are merged.
+	MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error
 }
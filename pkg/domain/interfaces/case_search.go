@@ -0,0 +1,318 @@
+package interfaces
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+)
+
+// ErrInvalidCaseCursor is returned by CaseRepository.Search for a cursor that
+// was not produced by a previous Search with the same sort.
+var ErrInvalidCaseCursor = errors.New("invalid case search cursor")
+
+// CaseFieldFilter matches one custom field value. Every set criterion must
+// hold; a case without a value for FieldID never matches.
+type CaseFieldFilter struct {
+	FieldID types.FieldID
+
+	// OptionIDs matches a select or user value that is one of the IDs, or a
+	// multi-select / multi-user value containing at least one of them.
+	OptionIDs []string
+
+	// DateFrom / DateTo bound a date value to [DateFrom, DateTo). A zero
+	// bound is unbounded.
+	DateFrom time.Time
+	DateTo   time.Time
+
+	// NumberMin / NumberMax bound a number value, both inclusive. nil is
+	// unbounded.
+	NumberMin *float64
+	NumberMax *float64
+}
+
+// Matches reports whether fv satisfies the filter.
+func (f CaseFieldFilter) Matches(fv model.FieldValue) bool {
+	if len(f.OptionIDs) > 0 {
+		found := false
+		for _, s := range fieldStrings(fv.Value) {
+			if containsString(f.OptionIDs, s) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if !f.DateFrom.IsZero() || !f.DateTo.IsZero() {
+		t, ok := fieldDate(fv.Value)
+		if !ok || !(timeRange{from: f.DateFrom, to: f.DateTo}).contains(t) {
+			return false
+		}
+	}
+
+	if f.NumberMin != nil || f.NumberMax != nil {
+		n, ok := fieldNumber(fv.Value)
+		if !ok {
+			return false
+		}
+		if f.NumberMin != nil && n < *f.NumberMin {
+			return false
+		}
+		if f.NumberMax != nil && n > *f.NumberMax {
+			return false
+		}
+	}
+	return true
+}
+
+// fieldDate reads a date value, stored as a time.Time or as an RFC3339 or
+// "YYYY-MM-DD" string (see model.FieldValidator.validateDate).
+func fieldDate(v any) (time.Time, bool) {
+	switch x := v.(type) {
+	case time.Time:
+		return x, true
+	case string:
+		if t, err := time.Parse(time.RFC3339, x); err == nil {
+			return t, true
+		}
+		if t, err := time.Parse(time.DateOnly, x); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// fieldNumber reads a number value in any of the shapes the backends return.
+func fieldNumber(v any) (float64, bool) {
+	switch x := v.(type) {
+	case float64:
+		return x, true
+	case int:
+		return float64(x), true
+	case int64:
+		return float64(x), true
+	case int32:
+		return float64(x), true
+	case json.Number:
+		n, err := x.Float64()
+		return n, err == nil
+	}
+	return 0, false
+}
+
+// CaseSortKey selects the field Search orders by.
+type CaseSortKey string
+
+const (
+	CaseSortCreatedAt CaseSortKey = "CREATED_AT"
+	CaseSortUpdatedAt CaseSortKey = "UPDATED_AT"
+	CaseSortID        CaseSortKey = "ID"
+)
+
+// CaseSort is Search's ordering. The zero value is newest-created first.
+// Ties always break on the case ID, in the same direction.
+type CaseSort struct {
+	Key       CaseSortKey
+	Ascending bool
+}
+
+func (s CaseSort) key() CaseSortKey {
+	if s.Key == "" {
+		return CaseSortCreatedAt
+	}
+	return s.Key
+}
+
+// IsValid reports whether the sort key is known.
+func (s CaseSort) IsValid() bool {
+	switch s.key() {
+	case CaseSortCreatedAt, CaseSortUpdatedAt, CaseSortID:
+		return true
+	}
+	return false
+}
+
+// sortValue is the primary sort value of c under s; the ID tie-breaker is
+// compared separately.
+func (s CaseSort) sortValue(c *model.Case) int64 {
+	switch s.key() {
+	case CaseSortUpdatedAt:
+		return c.UpdatedAt.UnixNano()
+	case CaseSortID:
+		return c.ID
+	default:
+		return c.CreatedAt.UnixNano()
+	}
+}
+
+// before reports whether position (av, aID) sorts before (bv, bID).
+func (s CaseSort) before(av, aID, bv, bID int64) bool {
+	if av == bv {
+		av, bv = aID, bID
+	}
+	if av == bv {
+		return false
+	}
+	return (av < bv) == s.Ascending
+}
+
+// PageCases orders cases by sort and returns the page following cursor,
+// together with the cursor of the next page ("" when this is the last one).
+// limit <= 0 returns every remaining case. The cursor encodes the last row's
+// sort position rather than its index, so rows inserted or removed between
+// calls neither repeat nor skip the rows around them. cases is sorted in
+// place.
+func PageCases(cases []*model.Case, s CaseSort, limit int, cursor string) ([]*model.Case, string, error) {
+	if !s.IsValid() {
+		return nil, "", goerr.New("invalid case sort key", goerr.V("key", s.Key))
+	}
+
+	sort.SliceStable(cases, func(i, j int) bool {
+		return s.before(s.sortValue(cases[i]), cases[i].ID, s.sortValue(cases[j]), cases[j].ID)
+	})
+
+	start := 0
+	if cursor != "" {
+		cv, cID, err := decodeCaseCursor(cursor, s.key())
+		if err != nil {
+			return nil, "", err
+		}
+		start = sort.Search(len(cases), func(i int) bool {
+			return s.before(cv, cID, s.sortValue(cases[i]), cases[i].ID)
+		})
+	}
+
+	rest := cases[start:]
+	if limit <= 0 || len(rest) <= limit {
+		return rest, "", nil
+	}
+	page := rest[:limit]
+	last := page[len(page)-1]
+	return page, encodeCaseCursor(s.key(), s.sortValue(last), last.ID), nil
+}
+
+// CasePosition is where a case sits in a Search order: the value of the sort
+// key as the store holds it, and the case ID that breaks ties.
+type CasePosition struct {
+	Value int64
+	ID    int64
+}
+
+// SortedCase is a case as a store read it in Search order, together with its
+// position in that order.
+type SortedCase struct {
+	Case     *model.Case
+	Position CasePosition
+}
+
+// CaseBatchFunc reads up to n cases in the store's Search order, starting
+// strictly after after (from the beginning when nil).
+type CaseBatchFunc func(after *CasePosition, n int) ([]SortedCase, error)
+
+// caseBatchSize is the smallest read CollectCasePage asks a store for, so a
+// selective filter evaluated in Go does not turn into one round-trip per row.
+const caseBatchSize = 100
+
+// CollectCasePage builds one Search page from a store that orders, bounds and
+// continues the read itself: it reads batches through fetch from the cursor's
+// position, keeps the cases keep accepts, and stops as soon as it holds one
+// case more than limit (or at the end of the data). Only the filters the
+// store cannot express have to be left to keep, and a page never costs more
+// than the rows up to its last match.
+//
+// The next cursor encodes the position the store reported for the page's last
+// case, so the following page resumes exactly where the store's order left
+// off. limit <= 0 reads every match.
+func CollectCasePage(s CaseSort, limit int, cursor string, fetch CaseBatchFunc, keep func(*model.Case) bool) ([]*model.Case, string, error) {
+	if !s.IsValid() {
+		return nil, "", goerr.New("invalid case sort key", goerr.V("key", s.Key))
+	}
+
+	var after *CasePosition
+	if cursor != "" {
+		value, id, err := decodeCaseCursor(cursor, s.key())
+		if err != nil {
+			return nil, "", err
+		}
+		after = &CasePosition{Value: value, ID: id}
+	}
+
+	batch := caseBatchSize
+	if limit >= batch {
+		batch = limit + 1
+	}
+
+	var matched []SortedCase
+	for {
+		rows, err := fetch(after, batch)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, row := range rows {
+			if !keep(row.Case) {
+				continue
+			}
+			matched = append(matched, row)
+			if limit > 0 && len(matched) > limit {
+				page := make([]*model.Case, limit)
+				for i := range page {
+					page[i] = matched[i].Case
+				}
+				last := matched[limit-1].Position
+				return page, encodeCaseCursor(s.key(), last.Value, last.ID), nil
+			}
+		}
+		if len(rows) < batch {
+			break
+		}
+		after = &rows[len(rows)-1].Position
+	}
+
+	page := make([]*model.Case, len(matched))
+	for i, row := range matched {
+		page[i] = row.Case
+	}
+	return page, "", nil
+}
+
+// EffectiveKey is the sort key Search orders by, CaseSortCreatedAt for the
+// zero value.
+func (s CaseSort) EffectiveKey() CaseSortKey {
+	return s.key()
+}
+
+func encodeCaseCursor(key CaseSortKey, value, id int64) string {
+	raw := string(key) + ":" + strconv.FormatInt(value, 10) + ":" + strconv.FormatInt(id, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCaseCursor(cursor string, key CaseSortKey) (int64, int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, 0, goerr.Wrap(ErrInvalidCaseCursor, "cursor is not base64", goerr.V("cursor", cursor))
+	}
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 3 || CaseSortKey(parts[0]) != key {
+		return 0, 0, goerr.Wrap(ErrInvalidCaseCursor, "cursor does not match the sort",
+			goerr.V("cursor", cursor), goerr.V("sort", key))
+	}
+	value, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, goerr.Wrap(ErrInvalidCaseCursor, "malformed cursor value", goerr.V("cursor", cursor))
+	}
+	id, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return 0, 0, goerr.Wrap(ErrInvalidCaseCursor, "malformed cursor id", goerr.V("cursor", cursor))
+	}
+	return value, id, nil
+}
@@ -0,0 +1,188 @@
+package interfaces_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+)
+
+func TestCaseFieldFilter_Matches(t *testing.T) {
+	num := func(f float64) *float64 { return &f }
+
+	t.Run("options match a single value or any element of a list", func(t *testing.T) {
+		f := interfaces.CaseFieldFilter{FieldID: "f", OptionIDs: []string{"a", "b"}}
+		gt.Bool(t, f.Matches(model.FieldValue{Value: "b"})).True()
+		gt.Bool(t, f.Matches(model.FieldValue{Value: "c"})).False()
+		gt.Bool(t, f.Matches(model.FieldValue{Value: []string{"c", "a"}})).True()
+		gt.Bool(t, f.Matches(model.FieldValue{Value: []interface{}{"c"}})).False()
+	})
+
+	t.Run("date range is half-open and accepts both stored shapes", func(t *testing.T) {
+		from := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+		f := interfaces.CaseFieldFilter{FieldID: "f", DateFrom: from, DateTo: from.AddDate(0, 1, 0)}
+		gt.Bool(t, f.Matches(model.FieldValue{Value: "2026-05-01"})).True()
+		gt.Bool(t, f.Matches(model.FieldValue{Value: "2026-06-01T00:00:00Z"})).False()
+		gt.Bool(t, f.Matches(model.FieldValue{Value: from.Add(time.Hour)})).True()
+		gt.Bool(t, f.Matches(model.FieldValue{Value: "not a date"})).False()
+	})
+
+	t.Run("number range is inclusive", func(t *testing.T) {
+		f := interfaces.CaseFieldFilter{FieldID: "f", NumberMin: num(1), NumberMax: num(3)}
+		gt.Bool(t, f.Matches(model.FieldValue{Value: 1.0})).True()
+		gt.Bool(t, f.Matches(model.FieldValue{Value: int64(3)})).True()
+		gt.Bool(t, f.Matches(model.FieldValue{Value: 3.5})).False()
+		gt.Bool(t, f.Matches(model.FieldValue{Value: "2"})).False()
+	})
+}
+
+func TestListCaseConfig_Matches(t *testing.T) {
+	cs := &model.Case{
+		ID:             1,
+		Title:          "Suspicious Login",
+		Status:         types.CaseStatusOpen,
+		ReporterID:     "U1",
+		AssigneeIDs:    []string{"U2"},
+		IsPrivate:      true,
+		ChannelUserIDs: []string{"U1"},
+		FieldValues: map[string]model.FieldValue{
+			"note": {FieldID: "note", Value: "seen from Tor exit"},
+		},
+	}
+
+	gt.Bool(t, interfaces.BuildListCaseConfig().Matches(cs)).True()
+	gt.Bool(t, interfaces.BuildListCaseConfig(interfaces.WithText("LOGIN")).Matches(cs)).True()
+	gt.Bool(t, interfaces.BuildListCaseConfig(interfaces.WithText("tor")).Matches(cs)).True()
+	gt.Bool(t, interfaces.BuildListCaseConfig(interfaces.WithText("vpn")).Matches(cs)).False()
+	gt.Bool(t, interfaces.BuildListCaseConfig(interfaces.WithStatus(types.CaseStatusClosed)).Matches(cs)).False()
+	gt.Bool(t, interfaces.BuildListCaseConfig(interfaces.WithAccessibleBy("U1")).Matches(cs)).True()
+	gt.Bool(t, interfaces.BuildListCaseConfig(interfaces.WithAccessibleBy("U9")).Matches(cs)).False()
+	gt.Bool(t, interfaces.BuildListCaseConfig(interfaces.WithFieldFilter(interfaces.CaseFieldFilter{
+		FieldID: "missing", OptionIDs: []string{"x"},
+	})).Matches(cs)).False()
+
+	draft := &model.Case{ID: 2, Status: types.CaseStatusDraft}
+	gt.Bool(t, interfaces.BuildListCaseConfig().Matches(draft)).False()
+	gt.Bool(t, interfaces.BuildListCaseConfig(interfaces.WithStatus(types.CaseStatusDraft)).Matches(draft)).True()
+}
+
+func TestPageCases(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	// Cases 2 and 3 share a timestamp so the ID tie-break decides their order.
+	newCases := func() []*model.Case {
+		return []*model.Case{
+			{ID: 1, CreatedAt: base},
+			{ID: 2, CreatedAt: base.Add(time.Hour)},
+			{ID: 3, CreatedAt: base.Add(time.Hour)},
+			{ID: 4, CreatedAt: base.Add(2 * time.Hour)},
+		}
+	}
+	ids := func(cases []*model.Case) []int64 {
+		out := []int64{}
+		for _, c := range cases {
+			out = append(out, c.ID)
+		}
+		return out
+	}
+	walk := func(s interfaces.CaseSort) []int64 {
+		var got []int64
+		cursor := ""
+		for {
+			page, next, err := interfaces.PageCases(newCases(), s, 1, cursor)
+			gt.NoError(t, err).Required()
+			got = append(got, ids(page)...)
+			if next == "" {
+				return got
+			}
+			cursor = next
+		}
+	}
+
+	gt.Value(t, walk(interfaces.CaseSort{})).Equal([]int64{4, 3, 2, 1})
+	gt.Value(t, walk(interfaces.CaseSort{Ascending: true})).Equal([]int64{1, 2, 3, 4})
+	gt.Value(t, walk(interfaces.CaseSort{Key: interfaces.CaseSortID})).Equal([]int64{4, 3, 2, 1})
+
+	t.Run("limit <= 0 returns every case", func(t *testing.T) {
+		page, next, err := interfaces.PageCases(newCases(), interfaces.CaseSort{}, 0, "")
+		gt.NoError(t, err).Required()
+		gt.Array(t, page).Length(4)
+		gt.Value(t, next).Equal("")
+	})
+
+	t.Run("invalid cursors are rejected", func(t *testing.T) {
+		_, next, err := interfaces.PageCases(newCases(), interfaces.CaseSort{}, 1, "")
+		gt.NoError(t, err).Required()
+
+		_, _, err = interfaces.PageCases(newCases(), interfaces.CaseSort{Key: interfaces.CaseSortID}, 1, next)
+		gt.Bool(t, errors.Is(err, interfaces.ErrInvalidCaseCursor)).True()
+
+		_, _, err = interfaces.PageCases(newCases(), interfaces.CaseSort{}, 1, "%%%")
+		gt.Bool(t, errors.Is(err, interfaces.ErrInvalidCaseCursor)).True()
+	})
+
+	t.Run("unknown sort key is an error", func(t *testing.T) {
+		_, _, err := interfaces.PageCases(newCases(), interfaces.CaseSort{Key: "TITLE"}, 1, "")
+		gt.Error(t, err)
+	})
+}
+
+func TestCollectCasePage(t *testing.T) {
+	// A store of 250 cases read newest-ID first; even IDs are filtered out in
+	// Go, as a filter the store cannot express would be.
+	var rows []interfaces.SortedCase
+	for id := int64(250); id >= 1; id-- {
+		rows = append(rows, interfaces.SortedCase{
+			Case:     &model.Case{ID: id},
+			Position: interfaces.CasePosition{Value: id, ID: id},
+		})
+	}
+	read := 0
+	fetch := func(after *interfaces.CasePosition, n int) ([]interfaces.SortedCase, error) {
+		start := 0
+		if after != nil {
+			for start < len(rows) && rows[start].Position.ID >= after.ID {
+				start++
+			}
+		}
+		end := min(start+n, len(rows))
+		read += end - start
+		return rows[start:end], nil
+	}
+	odd := func(c *model.Case) bool { return c.ID%2 == 1 }
+	s := interfaces.CaseSort{Key: interfaces.CaseSortID}
+
+	page, next, err := interfaces.CollectCasePage(s, 3, "", fetch, odd)
+	gt.NoError(t, err).Required()
+	gt.Value(t, caseIDs(page)).Equal([]int64{249, 247, 245})
+	gt.Value(t, next).NotEqual("")
+	// One batch covers the page; the rest of the store is never read.
+	gt.Number(t, read).Equal(100)
+
+	page, _, err = interfaces.CollectCasePage(s, 3, next, fetch, odd)
+	gt.NoError(t, err).Required()
+	gt.Value(t, caseIDs(page)).Equal([]int64{243, 241, 239})
+
+	t.Run("limit <= 0 reads every batch", func(t *testing.T) {
+		page, next, err := interfaces.CollectCasePage(s, 0, "", fetch, odd)
+		gt.NoError(t, err).Required()
+		gt.Array(t, page).Length(125)
+		gt.Value(t, next).Equal("")
+	})
+
+	t.Run("a cursor for another sort is rejected", func(t *testing.T) {
+		_, _, err := interfaces.CollectCasePage(interfaces.CaseSort{}, 3, next, fetch, odd)
+		gt.Bool(t, errors.Is(err, interfaces.ErrInvalidCaseCursor)).True()
+	})
+}
+
+func caseIDs(cases []*model.Case) []int64 {
+	out := []int64{}
+	for _, c := range cases {
+		out = append(out, c.ID)
+	}
+	return out
+}
@@ -0,0 +1,9 @@
+package interfaces
+
+import "errors"
+
+// ErrNotFound is the sentinel every repository backend reports a missing
+// record with. Each backend re-exports it as its own ErrNotFound, so callers
+// can test errors.Is(err, interfaces.ErrNotFound) without knowing which
+// backend is configured.
+var ErrNotFound = errors.New("not found")
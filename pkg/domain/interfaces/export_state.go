@@ -0,0 +1,28 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+// ExportStateRepository persists what an incremental export needs between
+// runs: the per-table high-water marks, and tombstones for records that were
+// deleted and so can no longer be found by a "changed since" read.
+type ExportStateRepository interface {
+	// GetWatermark returns the watermark of one exported table, or nil, nil
+	// when the table has never been exported to that sink.
+	GetWatermark(ctx context.Context, workspaceID, sink, namespace, table string) (*model.ExportWatermark, error)
+
+	// PutWatermark writes the watermark wholesale (Validate then persist).
+	PutWatermark(ctx context.Context, w *model.ExportWatermark) error
+
+	// PutTombstone records a deletion. Writing the same (entity, id) again
+	// overwrites the earlier tombstone.
+	PutTombstone(ctx context.Context, t *model.Tombstone) error
+
+	// ListTombstones returns the workspace's tombstones whose DeletedAt is
+	// strictly after since, in unspecified order.
+	ListTombstones(ctx context.Context, workspaceID string, since time.Time) ([]*model.Tombstone, error)
+}
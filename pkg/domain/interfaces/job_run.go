@@ -52,6 +52,12 @@ type JobRunRepository interface {
 	// per-case index that the due-check needs.
 	ListByCase(ctx context.Context, workspaceID string, caseID int64) ([]*model.JobRun, error)
 
+	// ListUpdatedAfter returns every JobRun of the workspace, across all
+	// Cases, whose UpdatedAt is strictly after since. An incremental
+	// export reads the run history that changed through it instead of
+	// listing every Case's runs.
+	ListUpdatedAfter(ctx context.Context, workspaceID string, since time.Time) ([]*model.JobRun, error)
+
 	// TryAcquireLease attempts to take the lock for the given key, valid
 	// until now+leaseDuration. Returns true if the caller now owns the
 	// lease, false if a live lease is held by someone else. The first
@@ -87,6 +93,13 @@ type JobRunRepository interface {
 	// suspendedAt is the caller's clock, used later by the unanswered-run
 	// sweep to expire stale suspensions.
 	Suspend(ctx context.Context, key model.JobRunKey, runID string, suspendedAt time.Time) error
+
+	// MoveCase re-parents the JobRun records of fromCaseID to toCaseID when
+	// Cases are merged. A job that already has a record under toCaseID keeps
+	// it and the moved one is dropped, so the surviving Case's schedule is
+	// left as it was. Callers move the logs and events first, and must not
+	// move a record holding a live lease or a suspension.
+	MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error
 }
 
 // JobRunLogRepository persists one *invocation* of a Job (= one Run)
@@ -132,6 +145,10 @@ type JobRunLogRepository interface {
 	// to limit. limit <= 0 means no limit. Implemented as a single
 	// subcollection scan per call (no cross-Job aggregation here).
 	List(ctx context.Context, key model.JobRunKey, limit int) ([]*model.JobRunLog, error)
+
+	// MoveCase re-parents every log of fromCaseID to toCaseID, keeping
+	// their job and run IDs. Used when Cases are merged.
+	MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error
 }
 
 // JobRunEventRepository persists the per-Run timeline of events
@@ -187,4 +204,14 @@ type JobRunEventRepository interface {
 	// (not doc-ID order — doc IDs are UUIDv7 and may diverge under
 	// clock skew).
 	List(ctx context.Context, key model.JobRunKey, runID string) ([]*model.JobRunEvent, error)
+
+	// ListAfter is List restricted to the events whose Sequence is greater
+	// than afterSequence: the tail a reader that has already seen the run up
+	// to afterSequence is missing.
+	ListAfter(ctx context.Context, key model.JobRunKey, runID string, afterSequence int64) ([]*model.JobRunEvent, error)
+
+	// MoveCase re-parents the events of every run of fromCaseID, together
+	// with each run's Sequence allocator, to toCaseID. Used when Cases are
+	// merged.
+	MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error
 }
@@ -3,9 +3,16 @@ package interfaces
 import (
 	"context"
 
+	"github.com/m-mizutani/goerr/v2"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
 )
 
+// ErrKnowledgeRevisionExists is returned when CreateRevision is called with a
+// revision number the entry already has. Revision numbers are assigned by the
+// usecase as "latest + 1", so this signals a concurrent write that took the
+// number first; the caller re-reads the latest revision and retries.
+var ErrKnowledgeRevisionExists = goerr.New("knowledge revision already exists")
+
 // KnowledgeListOptions controls how List filters knowledge entries.
 type KnowledgeListOptions struct {
 	// TagIDs applies an AND filter: only entries referencing every listed tag id
@@ -34,6 +41,19 @@ type KnowledgeRepository interface {
 	// pointer is the source of truth for every field.
 	Update(ctx context.Context, workspaceID string, knowledge *model.Knowledge) (*model.Knowledge, error)
 
-	// Delete removes a knowledge entry by ID within a workspace.
+	// Delete removes a knowledge entry by ID within a workspace. Its revisions
+	// are kept as an audit trail.
 	Delete(ctx context.Context, workspaceID string, id model.KnowledgeID) error
+
+	// CreateRevision appends an immutable revision to an entry's history. A
+	// revision number that already exists fails with
+	// ErrKnowledgeRevisionExists instead of overwriting it.
+	CreateRevision(ctx context.Context, workspaceID string, revision *model.KnowledgeRevision) error
+
+	// GetRevision retrieves one revision of an entry.
+	GetRevision(ctx context.Context, workspaceID string, id model.KnowledgeID, revision int) (*model.KnowledgeRevision, error)
+
+	// ListRevisions retrieves every revision of an entry sorted by Revision
+	// ascending. An entry without history returns an empty slice.
+	ListRevisions(ctx context.Context, workspaceID string, id model.KnowledgeID) ([]*model.KnowledgeRevision, error)
 }
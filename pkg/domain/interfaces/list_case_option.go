@@ -1,12 +1,41 @@
 package interfaces
 
-import "github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+import (
+	"strings"
+	"time"
 
-// ListCaseOption is a functional option for filtering cases in List
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+)
+
+// ListCaseOption is a functional option for filtering cases in List and Search
 type ListCaseOption func(*listCaseConfig)
 
 type listCaseConfig struct {
-	status *types.CaseStatus
+	status       *types.CaseStatus
+	assigneeID   string
+	reporterID   string
+	fieldFilters []CaseFieldFilter
+	text         string
+	created      timeRange
+	updated      timeRange
+	isTest       *bool
+	accessibleBy *string
+}
+
+// timeRange is a half-open [from, to) interval; a zero bound is unbounded.
+type timeRange struct {
+	from, to time.Time
+}
+
+func (r timeRange) contains(t time.Time) bool {
+	if !r.from.IsZero() && t.Before(r.from) {
+		return false
+	}
+	if !r.to.IsZero() && !t.Before(r.to) {
+		return false
+	}
+	return true
 }
 
 // WithStatus filters cases by status
@@ -16,6 +45,69 @@ func WithStatus(status types.CaseStatus) ListCaseOption {
 	}
 }
 
+// WithAssignee keeps cases assigned to userID
+func WithAssignee(userID string) ListCaseOption {
+	return func(c *listCaseConfig) {
+		c.assigneeID = userID
+	}
+}
+
+// WithReporter keeps cases reported by userID
+func WithReporter(userID string) ListCaseOption {
+	return func(c *listCaseConfig) {
+		c.reporterID = userID
+	}
+}
+
+// WithFieldFilter keeps cases whose custom field value satisfies f. Repeated
+// options are ANDed.
+func WithFieldFilter(f CaseFieldFilter) ListCaseOption {
+	return func(c *listCaseConfig) {
+		c.fieldFilters = append(c.fieldFilters, f)
+	}
+}
+
+// WithText keeps cases whose title, description or a text-like field value
+// contains q, case-insensitively. Surrounding whitespace is ignored; an empty
+// q matches every case.
+func WithText(q string) ListCaseOption {
+	return func(c *listCaseConfig) {
+		c.text = strings.ToLower(strings.TrimSpace(q))
+	}
+}
+
+// WithCreatedBetween keeps cases created in [from, to). A zero bound is
+// unbounded.
+func WithCreatedBetween(from, to time.Time) ListCaseOption {
+	return func(c *listCaseConfig) {
+		c.created = timeRange{from: from, to: to}
+	}
+}
+
+// WithUpdatedBetween keeps cases last updated in [from, to). A zero bound is
+// unbounded.
+func WithUpdatedBetween(from, to time.Time) ListCaseOption {
+	return func(c *listCaseConfig) {
+		c.updated = timeRange{from: from, to: to}
+	}
+}
+
+// WithIsTest keeps only test cases (true) or only production cases (false)
+func WithIsTest(isTest bool) ListCaseOption {
+	return func(c *listCaseConfig) {
+		c.isTest = &isTest
+	}
+}
+
+// WithAccessibleBy drops private cases userID is not a channel member of. The
+// usecase layer adds it whenever a filter looks inside case content, so a
+// match cannot reveal what a restricted private case contains.
+func WithAccessibleBy(userID string) ListCaseOption {
+	return func(c *listCaseConfig) {
+		c.accessibleBy = &userID
+	}
+}
+
 // BuildListCaseConfig builds a listCaseConfig from options
 func BuildListCaseConfig(opts ...ListCaseOption) *listCaseConfig {
 	cfg := &listCaseConfig{}
@@ -29,3 +121,118 @@ func BuildListCaseConfig(opts ...ListCaseOption) *listCaseConfig {
 func (c *listCaseConfig) Status() *types.CaseStatus {
 	return c.status
 }
+
+// AssigneeID returns the assignee filter, or "" if not set
+func (c *listCaseConfig) AssigneeID() string {
+	return c.assigneeID
+}
+
+// ReporterID returns the reporter filter, or "" if not set
+func (c *listCaseConfig) ReporterID() string {
+	return c.reporterID
+}
+
+// IsTest returns the test-case filter, or nil if not set
+func (c *listCaseConfig) IsTest() *bool {
+	return c.isTest
+}
+
+// CreatedBetween returns the created-at bounds; a zero bound is unbounded
+func (c *listCaseConfig) CreatedBetween() (from, to time.Time) {
+	return c.created.from, c.created.to
+}
+
+// UpdatedBetween returns the updated-at bounds; a zero bound is unbounded
+func (c *listCaseConfig) UpdatedBetween() (from, to time.Time) {
+	return c.updated.from, c.updated.to
+}
+
+// Matches reports whether cs passes every configured filter, including the
+// status rule every backend applies: an explicit status must equal the
+// normalized status, and without one drafts are excluded. Backends narrow
+// their read by status and apply Matches to the rest, so all of them agree on
+// what each filter means.
+func (c *listCaseConfig) Matches(cs *model.Case) bool {
+	if c.status != nil {
+		if cs.Status.Normalize() != *c.status {
+			return false
+		}
+	} else if cs.IsDraft() {
+		return false
+	}
+
+	if c.assigneeID != "" && !containsString(cs.AssigneeIDs, c.assigneeID) {
+		return false
+	}
+	if c.reporterID != "" && cs.ReporterID != c.reporterID {
+		return false
+	}
+	if c.isTest != nil && cs.IsTest != *c.isTest {
+		return false
+	}
+	if !c.created.contains(cs.CreatedAt) || !c.updated.contains(cs.UpdatedAt) {
+		return false
+	}
+	if c.accessibleBy != nil && !model.IsCaseAccessible(cs, *c.accessibleBy) {
+		return false
+	}
+	for _, f := range c.fieldFilters {
+		fv, ok := cs.FieldValues[string(f.FieldID)]
+		if !ok || !f.Matches(fv) {
+			return false
+		}
+	}
+	if c.text != "" && !caseContainsText(cs, c.text) {
+		return false
+	}
+	return true
+}
+
+func containsString(values []string, want string) bool {
+	for _, v := range values {
+		if v == want {
+			return true
+		}
+	}
+	return false
+}
+
+// caseContainsText matches the lower-cased q against the title, the
+// description and every string-valued field (text, markdown, URL, select,
+// multi-select …).
+func caseContainsText(cs *model.Case, q string) bool {
+	if strings.Contains(strings.ToLower(cs.Title), q) ||
+		strings.Contains(strings.ToLower(cs.Description), q) {
+		return true
+	}
+	for _, fv := range cs.FieldValues {
+		for _, s := range fieldStrings(fv.Value) {
+			if strings.Contains(strings.ToLower(s), q) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// fieldStrings returns the string elements of a field value, accepting both
+// the []string memory keeps and the []interface{} Firestore and JSON decode
+// to.
+func fieldStrings(v any) []string {
+	switch x := v.(type) {
+	case string:
+		return []string{x}
+	case []string:
+		return x
+	case []interface{}:
+		out := make([]string, 0, len(x))
+		for _, e := range x {
+			if s, ok := e.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
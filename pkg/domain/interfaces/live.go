@@ -0,0 +1,33 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+// LiveEventBus fans change notifications out to the GraphQL subscriptions of
+// this instance.
+type LiveEventBus interface {
+	// Publish delivers ev to the subscribers of its topic, here and — when
+	// the bus has a relay — on every other instance. It never blocks on a
+	// slow subscriber.
+	Publish(ctx context.Context, ev *model.LiveEvent)
+
+	// Subscribe returns a channel receiving the events published on topic
+	// until ctx is done, when the channel is closed. A subscriber that falls
+	// behind loses events rather than holding up the publisher.
+	Subscribe(ctx context.Context, topic string) <-chan *model.LiveEvent
+}
+
+// LiveEventRelay carries live events between instances, so a change written
+// on one instance reaches a subscription held open on another.
+type LiveEventRelay interface {
+	// Send hands ev to every instance, the sender included.
+	Send(ctx context.Context, ev *model.LiveEvent) error
+
+	// Listen calls deliver with each event sent after Listen started, until
+	// ctx is done or the relay fails. Events sent while no listener is open
+	// are missed; the caller decides whether to listen again.
+	Listen(ctx context.Context, deliver func(*model.LiveEvent)) error
+}
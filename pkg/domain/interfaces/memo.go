@@ -51,6 +51,9 @@ type MemoListOptions struct {
 	// this instant (exclusive upper bound). nil means unbounded. The interval
 	// is half-open so consecutive windows never report the same memo twice.
 	CreatedBefore *time.Time
+	// UpdatedAfter, when non-nil, keeps only memos whose UpdatedAt is strictly
+	// after this instant. nil means unbounded.
+	UpdatedAfter *time.Time
 }
 
 // Validate reports whether the options describe a window that can match at all.
@@ -80,6 +83,9 @@ func (o MemoListOptions) Allows(m *model.Memo) bool {
 	if o.CreatedBefore != nil && !m.CreatedAt.Before(*o.CreatedBefore) {
 		return false
 	}
+	if o.UpdatedAfter != nil && !m.UpdatedAt.After(*o.UpdatedAfter) {
+		return false
+	}
 	return true
 }
 
@@ -106,7 +112,19 @@ type MemoRepository interface {
 	// describe a window that can never match.
 	List(ctx context.Context, workspaceID string, caseID int64, opts MemoListOptions) ([]*model.Memo, error)
 
+	// ListUpdatedAfter returns every memo of the workspace, across all Cases
+	// and archived ones included, whose UpdatedAt is strictly after since.
+	// An incremental export reads the memos that changed through it instead
+	// of listing every Case's memos.
+	ListUpdatedAfter(ctx context.Context, workspaceID string, since time.Time) ([]*model.Memo, error)
+
 	// Update persists changes to an existing memo (including archive/unarchive,
 	// expressed by setting/clearing ArchivedAt on the caller's pointer).
 	Update(ctx context.Context, workspaceID string, memo *model.Memo) (*model.Memo, error)
+
+	// MoveCase re-parents every memo of fromCaseID, archived ones included,
+	// to toCaseID, keeping their IDs, and sets their UpdatedAt to movedAt so
+	// an incremental export picks up the new CaseID. Used when Cases are
+	// merged.
+	MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64, movedAt time.Time) error
 }
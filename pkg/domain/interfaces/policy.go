@@ -1,6 +1,10 @@
 package interfaces
 
-import "context"
+import (
+	"context"
+
+	"github.com/m-mizutani/goerr/v2"
+)
 
 // PolicyClient evaluates a Rego query against an input document and decodes
 // the policy's result into out. It mirrors the opaq.Client.Query shape so the
@@ -10,7 +14,14 @@ import "context"
 // query is a fully-qualified Rego reference such as "data.auth.mcp". input is
 // marshalled to the policy's `input` document; out receives the policy's
 // result (typically a struct with an `allow` boolean). An evaluation that
-// produces no result is reported as an error rather than a zero-valued out.
+// produces no result is reported as ErrPolicyUndefined rather than a
+// zero-valued out.
 type PolicyClient interface {
 	Query(ctx context.Context, query string, input, out any) error
 }
+
+// ErrPolicyUndefined is returned by PolicyClient.Query when the policy defines
+// nothing under the queried reference. Callers that treat a surface as opt-in
+// (the policy author simply has not written rules for it) match on it;
+// callers that must fail closed treat it like any other error.
+var ErrPolicyUndefined = goerr.New("policy defines no result for the query")
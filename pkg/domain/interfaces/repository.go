@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
 )
@@ -34,12 +35,28 @@ type Repository interface {
 	UserPreference() UserPreferenceRepository
 	HomeMessage() HomeMessageRepository
 	AssigneeRanking() AssigneeRankingRepository
+	ExportState() ExportStateRepository
+	WebhookDelivery() WebhookDeliveryRepository
+	Alert() AlertRepository
+	ActionReminder() ActionReminderRepository
+	SLABreach() SLABreachRepository
 
 	// Auth methods
 	PutToken(ctx context.Context, token *auth.Token) error
 	GetToken(ctx context.Context, tokenID auth.TokenID) (*auth.Token, error)
 	DeleteToken(ctx context.Context, tokenID auth.TokenID) error
 
+	// Personal access tokens. ListAPITokens returns the tokens owned by sub,
+	// newest first. TouchAPIToken records a use of the token without
+	// rewriting the rest of it, so it cannot resurrect a token revoked
+	// concurrently. Like the session token methods, a missing token is the
+	// backend's ErrNotFound.
+	PutAPIToken(ctx context.Context, token *auth.APIToken) error
+	GetAPIToken(ctx context.Context, tokenID auth.TokenID) (*auth.APIToken, error)
+	ListAPITokens(ctx context.Context, sub string) ([]*auth.APIToken, error)
+	DeleteAPIToken(ctx context.Context, tokenID auth.TokenID) error
+	TouchAPIToken(ctx context.Context, tokenID auth.TokenID, usedAt time.Time) error
+
 	// Close closes the repository and releases any resources
 	Close() error
 }
@@ -0,0 +1,28 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+// SLABreachRepository stores the SLA targets cases missed. A case has at most
+// one breach per kind: the sweep that finds it is recorded first, and later
+// sweeps finding the same breach leave the record as it is.
+type SLABreachRepository interface {
+	// Record stores b and returns recorded=true, or returns false without
+	// writing when a breach of the same case and kind is already stored. It
+	// is a create-if-absent, so concurrent sweeps record a breach once.
+	Record(ctx context.Context, b *model.SLABreach) (recorded bool, err error)
+
+	// ListByCase returns the breaches of one case, earliest due first.
+	ListByCase(ctx context.Context, workspaceID string, caseID int64) ([]*model.SLABreach, error)
+
+	// List returns every breach in the workspace, earliest due first.
+	List(ctx context.Context, workspaceID string) ([]*model.SLABreach, error)
+
+	// MoveCase re-parents the breaches of fromCaseID to toCaseID when Cases
+	// are merged. A kind toCaseID already has a breach of keeps it and the
+	// moved one is dropped, preserving one breach per case and kind.
+	MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error
+}
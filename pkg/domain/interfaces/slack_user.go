@@ -24,6 +24,11 @@ type SlackUserRepository interface {
 	// Returns a map of ID -> SlackUser. Missing users are not included in the map.
 	GetByIDs(ctx context.Context, ids []model.SlackUserID) (map[model.SlackUserID]*model.SlackUser, error)
 
+	// GetByEmail retrieves the Slack user whose email matches, ignoring case.
+	// Returns nil (not an error) when no user has that email. When several
+	// do, the one with the smallest ID is returned.
+	GetByEmail(ctx context.Context, email string) (*model.SlackUser, error)
+
 	// SaveMany saves multiple Slack users (upsert operation)
 	// Handles Firestore batch write limits (500 per batch) internally
 	SaveMany(ctx context.Context, users []*model.SlackUser) error
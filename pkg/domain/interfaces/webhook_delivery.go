@@ -0,0 +1,38 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+// WebhookDeliveryRepository is the durable queue of outbound webhook
+// deliveries, and their history. Deliveries are scoped to a workspace. A
+// missing delivery is the backend's ErrNotFound.
+type WebhookDeliveryRepository interface {
+	// Create stores a new delivery (Validate then persist).
+	Create(ctx context.Context, d *model.WebhookDelivery) error
+
+	// Get returns one delivery.
+	Get(ctx context.Context, workspaceID string, id model.WebhookDeliveryID) (*model.WebhookDelivery, error)
+
+	// Update overwrites a stored delivery, e.g. with the outcome of an
+	// attempt.
+	Update(ctx context.Context, d *model.WebhookDelivery) error
+
+	// Claim atomically starts an attempt of a delivery that IsDue(now),
+	// holding it until leaseUntil (see model.WebhookDelivery.StartAttempt),
+	// and returns the updated delivery. It returns (nil, nil) when the
+	// delivery is not due — already delivered, dead, or claimed by another
+	// instance — so only one attempt runs at a time across instances.
+	Claim(ctx context.Context, workspaceID string, id model.WebhookDeliveryID, now, leaseUntil time.Time) (*model.WebhookDelivery, error)
+
+	// ListDue returns the workspace's deliveries that are IsDue(now), the
+	// longest overdue first, up to limit.
+	ListDue(ctx context.Context, workspaceID string, now time.Time, limit int) ([]*model.WebhookDelivery, error)
+
+	// List returns the workspace's deliveries newest first, up to limit.
+	// A non-empty status keeps only the deliveries in it.
+	List(ctx context.Context, workspaceID string, status model.WebhookDeliveryStatus, limit int) ([]*model.WebhookDelivery, error)
+}
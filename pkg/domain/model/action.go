@@ -23,6 +23,10 @@ type Action struct {
 	ArchivedAt     *time.Time // nil = active; non-nil = archived at the given time
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
+
+	// BlockedBy lists the IDs of the actions of the same case this one waits
+	// on. See ResolveActionBlockers for the invariants a write must keep.
+	BlockedBy []int64
 }
 
 // IsArchived reports whether the action is currently archived.
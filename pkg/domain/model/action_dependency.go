@@ -0,0 +1,106 @@
+package model
+
+import (
+	"github.com/m-mizutani/goerr/v2"
+)
+
+// ErrActionDependency is returned when a write would give an action a blocker
+// it cannot have: itself, an action of another case, an unknown or archived
+// action, or one that already waits on it (a cycle).
+var ErrActionDependency = goerr.New("invalid action dependency")
+
+// ResolveActionBlockers checks blockedBy as the new blocker list of the
+// action actionID and returns it with duplicates dropped, in the given order.
+// siblings are the actions of the action's case, archived ones included; the
+// action itself may be among them. actionID is 0 for an action that is not
+// created yet, which nothing can wait on, so it cannot close a cycle.
+//
+// Links are only ever written between actions of one case. An action moved
+// to another case keeps no blockers, and links still pointing at it from its
+// old case are ignored by OpenActionBlockers rather than rewritten.
+func ResolveActionBlockers(actionID int64, blockedBy []int64, siblings []*Action) ([]int64, error) {
+	if len(blockedBy) == 0 {
+		return nil, nil
+	}
+
+	byID := make(map[int64]*Action, len(siblings))
+	for _, s := range siblings {
+		if s != nil {
+			byID[s.ID] = s
+		}
+	}
+
+	out := make([]int64, 0, len(blockedBy))
+	seen := make(map[int64]struct{}, len(blockedBy))
+	for _, id := range blockedBy {
+		if _, dup := seen[id]; dup {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		if actionID != 0 && id == actionID {
+			return nil, goerr.Wrap(ErrActionDependency, "action cannot block itself",
+				goerr.V("action_id", actionID))
+		}
+		blocker, ok := byID[id]
+		if !ok {
+			return nil, goerr.Wrap(ErrActionDependency, "blocker is not an action of the same case",
+				goerr.V("action_id", actionID), goerr.V("blocker_id", id))
+		}
+		if blocker.IsArchived() {
+			return nil, goerr.Wrap(ErrActionDependency, "blocker is archived",
+				goerr.V("action_id", actionID), goerr.V("blocker_id", id))
+		}
+		out = append(out, id)
+	}
+
+	if actionID == 0 {
+		return out, nil
+	}
+
+	// A cycle exists iff actionID is reachable from one of its new blockers
+	// by following the existing BlockedBy links of its siblings.
+	visited := make(map[int64]struct{}, len(siblings))
+	stack := append([]int64(nil), out...)
+	for len(stack) > 0 {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if id == actionID {
+			return nil, goerr.Wrap(ErrActionDependency, "dependency would form a cycle",
+				goerr.V("action_id", actionID), goerr.V("blocked_by", out))
+		}
+		if _, ok := visited[id]; ok {
+			continue
+		}
+		visited[id] = struct{}{}
+		if s, ok := byID[id]; ok {
+			stack = append(stack, s.BlockedBy...)
+		}
+	}
+	return out, nil
+}
+
+// OpenActionBlockers returns the blockers of a that still hold it up: those
+// found among siblings in a's case that are neither archived nor in a closed
+// status. An action with no open blockers is not blocked, whatever its
+// BlockedBy says.
+func OpenActionBlockers(a *Action, siblings []*Action, statusSet *ActionStatusSet) []*Action {
+	if a == nil || len(a.BlockedBy) == 0 {
+		return nil
+	}
+	byID := make(map[int64]*Action, len(siblings))
+	for _, s := range siblings {
+		if s != nil {
+			byID[s.ID] = s
+		}
+	}
+	var open []*Action
+	for _, id := range a.BlockedBy {
+		b, ok := byID[id]
+		if !ok || b.CaseID != a.CaseID || b.IsArchived() || statusSet.IsClosed(string(b.Status)) {
+			continue
+		}
+		open = append(open, b)
+	}
+	return open
+}
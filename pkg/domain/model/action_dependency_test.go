@@ -0,0 +1,78 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+func TestResolveActionBlockers(t *testing.T) {
+	archived := time.Now()
+	// 1 <- 2 <- 3: 3 waits on 2, which waits on 1.
+	siblings := []*model.Action{
+		{ID: 1, CaseID: 10},
+		{ID: 2, CaseID: 10, BlockedBy: []int64{1}},
+		{ID: 3, CaseID: 10, BlockedBy: []int64{2}},
+		{ID: 4, CaseID: 10, ArchivedAt: &archived},
+	}
+
+	t.Run("empty list clears the blockers", func(t *testing.T) {
+		got, err := model.ResolveActionBlockers(3, nil, siblings)
+		gt.NoError(t, err)
+		gt.Array(t, got).Length(0)
+	})
+
+	t.Run("duplicates are dropped in order", func(t *testing.T) {
+		got, err := model.ResolveActionBlockers(3, []int64{2, 1, 2}, siblings)
+		gt.NoError(t, err).Required()
+		gt.Array(t, got).Equal([]int64{2, 1})
+	})
+
+	t.Run("new action may wait on any sibling", func(t *testing.T) {
+		got, err := model.ResolveActionBlockers(0, []int64{3}, siblings)
+		gt.NoError(t, err).Required()
+		gt.Array(t, got).Equal([]int64{3})
+	})
+
+	for name, tc := range map[string]struct {
+		id        int64
+		blockedBy []int64
+	}{
+		"self":              {id: 1, blockedBy: []int64{1}},
+		"direct cycle":      {id: 2, blockedBy: []int64{1, 3}},
+		"transitive cycle":  {id: 1, blockedBy: []int64{3}},
+		"unknown action":    {id: 3, blockedBy: []int64{99}},
+		"archived action":   {id: 3, blockedBy: []int64{4}},
+		"new action itself": {id: 0, blockedBy: []int64{0}},
+	} {
+		t.Run(name+" is rejected", func(t *testing.T) {
+			_, err := model.ResolveActionBlockers(tc.id, tc.blockedBy, siblings)
+			gt.Error(t, err).Is(model.ErrActionDependency)
+		})
+	}
+}
+
+func TestOpenActionBlockers(t *testing.T) {
+	set := model.DefaultActionStatusSet()
+	archived := time.Now()
+	siblings := []*model.Action{
+		{ID: 1, CaseID: 10, Status: "IN_PROGRESS"},
+		{ID: 2, CaseID: 10, Status: "COMPLETED"},
+		{ID: 3, CaseID: 10, Status: "TODO", ArchivedAt: &archived},
+		{ID: 4, CaseID: 11, Status: "TODO"},
+	}
+
+	t.Run("only open blockers of the same case count", func(t *testing.T) {
+		a := &model.Action{ID: 5, CaseID: 10, BlockedBy: []int64{1, 2, 3, 4, 99}}
+		open := model.OpenActionBlockers(a, siblings, set)
+		gt.Array(t, open).Length(1).Required()
+		gt.Value(t, open[0].ID).Equal(int64(1))
+	})
+
+	t.Run("all blockers closed means not blocked", func(t *testing.T) {
+		a := &model.Action{ID: 5, CaseID: 10, BlockedBy: []int64{2, 3}}
+		gt.Array(t, model.OpenActionBlockers(a, siblings, set)).Length(0)
+	})
+}
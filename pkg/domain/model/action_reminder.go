@@ -0,0 +1,58 @@
+package model
+
+import "time"
+
+// ActionReminderKind is one stage of the due-date reminders an Action goes
+// through. Each stage is sent at most once per due date.
+type ActionReminderKind string
+
+const (
+	// ActionReminderDueSoon is the heads-up sent to the assignee ahead of
+	// the due date.
+	ActionReminderDueSoon ActionReminderKind = "due_soon"
+	// ActionReminderOverdue is posted to the case once the due date passed.
+	ActionReminderOverdue ActionReminderKind = "overdue"
+	// ActionReminderEscalated tells the case assignees the action is still
+	// open after the grace period.
+	ActionReminderEscalated ActionReminderKind = "escalated"
+)
+
+// ActionReminderPolicy is when a workspace reminds people of Action due
+// dates, from the workspace's [reminder] section.
+type ActionReminderPolicy struct {
+	// Before is how long ahead of the deadline the assignee is reminded.
+	// Zero sends no heads-up.
+	Before time.Duration
+	// EscalateAfter is the grace period after the deadline before the case
+	// assignees are told. Zero never escalates.
+	EscalateAfter time.Duration
+}
+
+// ActionDueDeadline returns the moment an action due on due becomes overdue.
+// A due date names a day (the Web UI stores it as that day's 00:00 UTC), so
+// the action is due until that UTC day ends.
+func ActionDueDeadline(due time.Time) time.Time {
+	y, m, d := due.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+}
+
+// DueReminder returns the latest reminder stage a has reached at now, or ""
+// when none is due: it has no due date, or the heads-up window has not
+// opened yet. Only the latest stage is returned, so an action given a due
+// date already in the past is reported overdue without a late heads-up.
+// Whether the action is still open is for the caller to check.
+func (p *ActionReminderPolicy) DueReminder(a *Action, now time.Time) ActionReminderKind {
+	if p == nil || a == nil || a.DueDate == nil {
+		return ""
+	}
+	deadline := ActionDueDeadline(*a.DueDate)
+	switch {
+	case p.EscalateAfter > 0 && !now.Before(deadline.Add(p.EscalateAfter)):
+		return ActionReminderEscalated
+	case !now.Before(deadline):
+		return ActionReminderOverdue
+	case p.Before > 0 && !now.Before(deadline.Add(-p.Before)):
+		return ActionReminderDueSoon
+	}
+	return ""
+}
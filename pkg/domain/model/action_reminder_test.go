@@ -0,0 +1,55 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+func TestActionDueDeadline(t *testing.T) {
+	due := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	gt.Value(t, model.ActionDueDeadline(due)).Equal(time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC))
+
+	// A due date carrying a time of day still names its UTC day.
+	gt.Value(t, model.ActionDueDeadline(due.Add(15*time.Hour))).Equal(time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC))
+}
+
+func TestActionReminderPolicy_DueReminder(t *testing.T) {
+	due := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	deadline := due.AddDate(0, 0, 1)
+	a := &model.Action{ID: 1, DueDate: &due}
+	policy := &model.ActionReminderPolicy{Before: 24 * time.Hour, EscalateAfter: 48 * time.Hour}
+
+	for name, tc := range map[string]struct {
+		now  time.Time
+		want model.ActionReminderKind
+	}{
+		"before the heads-up window": {now: deadline.Add(-25 * time.Hour), want: ""},
+		"heads-up window opens":      {now: deadline.Add(-24 * time.Hour), want: model.ActionReminderDueSoon},
+		"on the due day":             {now: due.Add(9 * time.Hour), want: model.ActionReminderDueSoon},
+		"deadline passed":            {now: deadline, want: model.ActionReminderOverdue},
+		"inside the grace period":    {now: deadline.Add(47 * time.Hour), want: model.ActionReminderOverdue},
+		"grace period over":          {now: deadline.Add(48 * time.Hour), want: model.ActionReminderEscalated},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gt.Value(t, policy.DueReminder(a, tc.now)).Equal(tc.want)
+		})
+	}
+
+	t.Run("no due date", func(t *testing.T) {
+		gt.Value(t, policy.DueReminder(&model.Action{ID: 2}, deadline)).Equal(model.ActionReminderKind(""))
+	})
+
+	t.Run("zero durations disable their stage", func(t *testing.T) {
+		p := &model.ActionReminderPolicy{}
+		gt.Value(t, p.DueReminder(a, deadline.Add(-time.Hour))).Equal(model.ActionReminderKind(""))
+		gt.Value(t, p.DueReminder(a, deadline.Add(720*time.Hour))).Equal(model.ActionReminderOverdue)
+	})
+
+	t.Run("nil policy sends nothing", func(t *testing.T) {
+		var p *model.ActionReminderPolicy
+		gt.Value(t, p.DueReminder(a, deadline)).Equal(model.ActionReminderKind(""))
+	})
+}
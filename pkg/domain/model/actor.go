@@ -1,5 +1,7 @@
 package model
 
+import "context"
+
 // SystemActorID is the sentinel ActorUserID used when a Job's tool invokes
 // a mutation on behalf of the system (no human user). The '@' prefix ensures
 // the value never collides with Slack user IDs (which always start with 'U'
@@ -12,3 +14,28 @@ const SystemActorID = "@system"
 func IsSystemActor(actorUserID string) bool {
 	return actorUserID == SystemActorID
 }
+
+// RunActor identifies the Job run on whose behalf a mutation is performed.
+// JobRunner stamps it on the run context so a usecase can attribute a write
+// to the run (e.g. a knowledge revision) without importing the job package.
+type RunActor struct {
+	JobID string
+	RunID string
+}
+
+type runActorContextKey struct{}
+
+// ContextWithRunActor attaches the RunActor to ctx.
+func ContextWithRunActor(ctx context.Context, actor RunActor) context.Context {
+	return context.WithValue(ctx, runActorContextKey{}, actor)
+}
+
+// RunActorFromContext returns the RunActor stamped by ContextWithRunActor and
+// whether one is present.
+func RunActorFromContext(ctx context.Context) (RunActor, bool) {
+	if ctx == nil {
+		return RunActor{}, false
+	}
+	actor, ok := ctx.Value(runActorContextKey{}).(RunActor)
+	return actor, ok
+}
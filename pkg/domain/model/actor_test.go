@@ -1,6 +1,7 @@
 package model_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/m-mizutani/gt"
@@ -18,3 +19,13 @@ func TestIsSystemActor(t *testing.T) {
 	gt.Bool(t, model.IsSystemActor("W67890")).False()
 	gt.Bool(t, model.IsSystemActor("")).False()
 }
+
+func TestRunActorContext(t *testing.T) {
+	_, ok := model.RunActorFromContext(context.Background())
+	gt.Bool(t, ok).False()
+
+	ctx := model.ContextWithRunActor(context.Background(), model.RunActor{JobID: "triage", RunID: "run-1"})
+	actor, ok := model.RunActorFromContext(ctx)
+	gt.Bool(t, ok).True()
+	gt.Value(t, actor).Equal(model.RunActor{JobID: "triage", RunID: "run-1"})
+}
@@ -0,0 +1,205 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/m-mizutani/goerr/v2"
+)
+
+// IngestMapping maps an alert posted to the workspace's ingest endpoint onto
+// a case. It is loaded from the [ingest] section of the workspace config.
+// Every value is a text/template executed over the alert's JSON body, so
+// `{{ .rule.name }}` reads the rule.name key of the alert.
+type IngestMapping struct {
+	Title       string
+	Description string
+	// Fingerprint identifies repeats of the same alert. Empty uses the
+	// rendered title.
+	Fingerprint string
+	// Fields maps a case field ID to the template of its value. A template
+	// rendering to an empty string leaves the field unset.
+	Fields map[string]string
+	// Reporter is the Slack user ID recorded as the reporter of a case an
+	// alert opens when the ingest policy names no user.
+	Reporter string
+}
+
+// ParseIngestTemplate parses one template of an IngestMapping. A key the
+// alert does not have fails the render rather than printing "<no value>";
+// `get` reads a key that may be absent, e.g. `{{ get .labels "owner" }}`,
+// and renders nothing when it is. The json function renders its argument as
+// JSON, e.g. to copy a nested object into a description.
+func ParseIngestTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Funcs(template.FuncMap{
+		"get": func(m any, key string) any {
+			if obj, ok := m.(map[string]any); ok {
+				if v, ok := obj[key]; ok && v != nil {
+					return v
+				}
+			}
+			return ""
+		},
+		"json": func(v any) (string, error) {
+			raw, err := json.Marshal(v)
+			if err != nil {
+				return "", err
+			}
+			return string(raw), nil
+		},
+	}).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to parse ingest template", goerr.V("template", name))
+	}
+	return tmpl, nil
+}
+
+// ErrAlertMapping is returned when an alert cannot be mapped onto a case: a
+// template fails on the alert, or the title renders empty.
+var ErrAlertMapping = goerr.New("alert does not fit the ingest mapping")
+
+// MappedAlert is an alert rendered through an IngestMapping.
+type MappedAlert struct {
+	Title       string
+	Description string
+	// Fingerprint is the hex SHA-256 of the rendered fingerprint.
+	Fingerprint string
+	// Fields holds the non-empty rendered field values, by field ID.
+	Fields map[string]string
+}
+
+// Map renders alert, the decoded JSON body, through the mapping.
+func (m *IngestMapping) Map(alert map[string]any) (*MappedAlert, error) {
+	title, err := renderIngestTemplate("title", m.Title, alert)
+	if err != nil {
+		return nil, err
+	}
+	if title == "" {
+		return nil, goerr.Wrap(ErrAlertMapping, "title rendered empty")
+	}
+	description, err := renderIngestTemplate("description", m.Description, alert)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprint := title
+	if m.Fingerprint != "" {
+		fingerprint, err = renderIngestTemplate("fingerprint", m.Fingerprint, alert)
+		if err != nil {
+			return nil, err
+		}
+		if fingerprint == "" {
+			return nil, goerr.Wrap(ErrAlertMapping, "fingerprint rendered empty")
+		}
+	}
+
+	fields := make(map[string]string, len(m.Fields))
+	ids := make([]string, 0, len(m.Fields))
+	for id := range m.Fields {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		v, err := renderIngestTemplate("fields."+id, m.Fields[id], alert)
+		if err != nil {
+			return nil, err
+		}
+		if v != "" {
+			fields[id] = v
+		}
+	}
+
+	sum := sha256.Sum256([]byte(fingerprint))
+	return &MappedAlert{
+		Title:       title,
+		Description: description,
+		Fingerprint: hex.EncodeToString(sum[:]),
+		Fields:      fields,
+	}, nil
+}
+
+func renderIngestTemplate(name, text string, alert map[string]any) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+	tmpl, err := ParseIngestTemplate(name, text)
+	if err != nil {
+		return "", err
+	}
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, alert); err != nil {
+		return "", goerr.Wrap(ErrAlertMapping, "failed to render ingest template",
+			goerr.V("template", name), goerr.V("render_error", err.Error()))
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// AlertID identifies an ingested alert. It is a UUID v7, so IDs order like
+// the alerts' arrival.
+type AlertID string
+
+// NewAlertID mints a time-ordered alert ID.
+func NewAlertID() AlertID {
+	return AlertID(uuid.Must(uuid.NewV7()).String())
+}
+
+func (id AlertID) String() string { return string(id) }
+
+// AlertPayloadMaxLen caps the stored copy of an alert's body.
+const AlertPayloadMaxLen = 64 * 1024
+
+// ErrAlertValidation is returned when an Alert fails validation.
+var ErrAlertValidation = goerr.New("alert validation failed")
+
+// Alert is one alert received on the ingest endpoint, kept on the case it
+// opened or was folded into. A case's alerts are its alert timeline: the
+// first opened it, each later one is a repeat of the same fingerprint.
+type Alert struct {
+	ID          AlertID
+	WorkspaceID string
+	CaseID      int64
+	// Fingerprint is MappedAlert.Fingerprint.
+	Fingerprint string
+	Title       string
+	// Payload is the alert's JSON body, truncated to AlertPayloadMaxLen.
+	Payload string
+	// Repeat is set when the alert was folded into an open case instead of
+	// opening one.
+	Repeat     bool
+	ReceivedAt time.Time
+}
+
+// Validate enforces the invariants the repository relies on before every
+// write.
+func (a *Alert) Validate() error {
+	if a == nil {
+		return goerr.Wrap(ErrAlertValidation, "alert is nil")
+	}
+	if a.ID == "" {
+		return goerr.Wrap(ErrAlertValidation, "id is required")
+	}
+	if a.WorkspaceID == "" {
+		return goerr.Wrap(ErrAlertValidation, "workspace ID is required", goerr.V("alert_id", a.ID))
+	}
+	if a.CaseID <= 0 {
+		return goerr.Wrap(ErrAlertValidation, "case ID is required", goerr.V("alert_id", a.ID))
+	}
+	if a.Fingerprint == "" {
+		return goerr.Wrap(ErrAlertValidation, "fingerprint is required", goerr.V("alert_id", a.ID))
+	}
+	return nil
+}
+
+// TruncateAlertPayload caps an alert body for storage at AlertPayloadMaxLen.
+func TruncateAlertPayload(body []byte) string {
+	if len(body) <= AlertPayloadMaxLen {
+		return string(body)
+	}
+	return strings.ToValidUTF8(string(body[:AlertPayloadMaxLen]), "")
+}
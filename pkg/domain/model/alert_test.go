@@ -0,0 +1,113 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+func TestIngestMapping_Map(t *testing.T) {
+	alert := map[string]any{
+		"rule":     map[string]any{"name": "Impossible travel", "id": "R-17"},
+		"severity": "high",
+		"host":     "web-01",
+		"score":    7.5,
+	}
+
+	t.Run("renders every template", func(t *testing.T) {
+		m := &model.IngestMapping{
+			Title:       "{{ .rule.name }} on {{ .host }}",
+			Description: "score: {{ .score }}",
+			Fingerprint: "{{ .rule.id }}/{{ .host }}",
+			Fields: map[string]string{
+				"severity": "{{ .severity }}",
+				"owner":    `{{ get . "owner" }}`,
+			},
+		}
+		got, err := m.Map(alert)
+		gt.NoError(t, err).Required()
+		gt.Value(t, got.Title).Equal("Impossible travel on web-01")
+		gt.Value(t, got.Description).Equal("score: 7.5")
+		gt.Value(t, len(got.Fingerprint)).Equal(64)
+		gt.Value(t, got.Fields["severity"]).Equal("high")
+		_, hasOwner := got.Fields["owner"]
+		gt.Bool(t, hasOwner).False()
+	})
+
+	t.Run("fingerprint is stable and defaults to the title", func(t *testing.T) {
+		byTitle := &model.IngestMapping{Title: "{{ .rule.name }}"}
+		a, err := byTitle.Map(alert)
+		gt.NoError(t, err).Required()
+		b, err := byTitle.Map(map[string]any{"rule": map[string]any{"name": "Impossible travel"}})
+		gt.NoError(t, err).Required()
+		gt.Value(t, a.Fingerprint).Equal(b.Fingerprint)
+
+		byHost := &model.IngestMapping{Title: "{{ .rule.name }}", Fingerprint: "{{ .host }}"}
+		c, err := byHost.Map(alert)
+		gt.NoError(t, err).Required()
+		gt.Value(t, c.Fingerprint).NotEqual(a.Fingerprint)
+	})
+
+	t.Run("json renders nested values", func(t *testing.T) {
+		m := &model.IngestMapping{Title: "t", Description: "{{ json .rule }}"}
+		got, err := m.Map(alert)
+		gt.NoError(t, err).Required()
+		gt.Value(t, got.Description).Equal(`{"id":"R-17","name":"Impossible travel"}`)
+	})
+
+	for name, m := range map[string]*model.IngestMapping{
+		"missing key":       {Title: "{{ .nope }}"},
+		"empty title":       {Title: `{{ get . "nope" }}`},
+		"empty fingerprint": {Title: "t", Fingerprint: `{{ get . "nope" }}`},
+	} {
+		t.Run(name+" is a mapping error", func(t *testing.T) {
+			_, err := m.Map(alert)
+			gt.Error(t, err).Is(model.ErrAlertMapping)
+		})
+	}
+}
+
+func TestParseIngestTemplate(t *testing.T) {
+	_, err := model.ParseIngestTemplate("title", "{{ .rule.name }}")
+	gt.NoError(t, err)
+	_, err = model.ParseIngestTemplate("title", "{{ .rule.name ")
+	gt.Error(t, err)
+}
+
+func TestAlert_Validate(t *testing.T) {
+	valid := func() *model.Alert {
+		return &model.Alert{
+			ID:          model.NewAlertID(),
+			WorkspaceID: "ws",
+			CaseID:      1,
+			Fingerprint: "fp",
+			ReceivedAt:  time.Now(),
+		}
+	}
+	gt.NoError(t, valid().Validate())
+
+	for name, mutate := range map[string]func(a *model.Alert){
+		"no id":          func(a *model.Alert) { a.ID = "" },
+		"no workspace":   func(a *model.Alert) { a.WorkspaceID = "" },
+		"no case":        func(a *model.Alert) { a.CaseID = 0 },
+		"no fingerprint": func(a *model.Alert) { a.Fingerprint = "" },
+	} {
+		t.Run(name, func(t *testing.T) {
+			a := valid()
+			mutate(a)
+			gt.Error(t, a.Validate()).Is(model.ErrAlertValidation)
+		})
+	}
+}
+
+func TestTruncateAlertPayload(t *testing.T) {
+	gt.Value(t, model.TruncateAlertPayload([]byte(`{"a":1}`))).Equal(`{"a":1}`)
+
+	long := strings.Repeat("あ", model.AlertPayloadMaxLen)
+	got := model.TruncateAlertPayload([]byte(long))
+	gt.Bool(t, len(got) <= model.AlertPayloadMaxLen).True()
+	gt.Bool(t, strings.HasPrefix(long, got)).True()
+}
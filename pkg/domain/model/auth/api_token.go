@@ -0,0 +1,148 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+)
+
+// APITokenPrefix starts every personal access token, so a leaked one is
+// recognizable to secret scanners and to the auth middleware.
+const APITokenPrefix = "hct_"
+
+// APITokenLastUsedInterval is how stale LastUsedAt may get before a request
+// with the token records a new value. It keeps a busy script from turning
+// every request into a write.
+const APITokenLastUsedInterval = time.Minute
+
+// APIToken is a personal access token: a long-lived credential a user mints
+// for scripts and dashboards calling the GraphQL API with an
+// `Authorization: Bearer` header. Only a hash of the secret is stored; the
+// plaintext is shown once, when the token is created.
+type APIToken struct {
+	ID         TokenID `json:"id"`
+	SecretHash string  `json:"secret_hash" masq:"secret"`
+	// Name is the owner's label for the token ("grafana", "weekly report").
+	Name string `json:"name"`
+	// Sub, Email, UserName and Provider are the identity of the session that
+	// created the token; a request with the token acts as that user.
+	Sub      string `json:"sub"`
+	Email    string `json:"email"`
+	UserName string `json:"user_name"`
+	Provider string `json:"provider,omitempty"`
+	// Workspaces limits the token to these workspace IDs. Nil admits every
+	// workspace.
+	Workspaces []string `json:"workspaces,omitempty"`
+	// ReadOnly refuses every mutation made with the token.
+	ReadOnly   bool       `json:"read_only,omitempty"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+}
+
+// NewAPIToken mints a token acting as owner. It returns the token to store
+// and the plaintext secret to hand to the user.
+func NewAPIToken(owner *Token, name string, workspaces []string, readOnly bool, ttl time.Duration) (*APIToken, TokenSecret) {
+	now := time.Now()
+	secret := NewTokenSecret()
+	return &APIToken{
+		ID:         NewTokenID(),
+		SecretHash: hashAPITokenSecret(secret),
+		Name:       name,
+		Sub:        owner.Sub,
+		Email:      owner.Email,
+		UserName:   owner.Name,
+		Provider:   owner.Provider,
+		Workspaces: workspaces,
+		ReadOnly:   readOnly,
+		ExpiresAt:  now.Add(ttl),
+		CreatedAt:  now,
+	}, secret
+}
+
+func (x *APIToken) Validate() error {
+	if err := x.ID.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid token ID")
+	}
+	if x.SecretHash == "" {
+		return goerr.New("empty secret hash")
+	}
+	if x.Name == "" {
+		return goerr.New("empty name")
+	}
+	if x.Sub == "" {
+		return goerr.New("empty sub")
+	}
+	if x.ExpiresAt.IsZero() {
+		return goerr.New("empty expires_at")
+	}
+	if x.CreatedAt.IsZero() {
+		return goerr.New("empty created_at")
+	}
+	return nil
+}
+
+func (x *APIToken) IsExpired() bool {
+	return time.Now().After(x.ExpiresAt)
+}
+
+// Verify reports whether secret is the token's secret.
+func (x *APIToken) Verify(secret TokenSecret) bool {
+	return subtle.ConstantTimeCompare([]byte(hashAPITokenSecret(secret)), []byte(x.SecretHash)) == 1
+}
+
+// NeedsLastUsedUpdate reports whether a use at now should be recorded.
+func (x *APIToken) NeedsLastUsedUpdate(now time.Time) bool {
+	return x.LastUsedAt == nil || now.Sub(*x.LastUsedAt) >= APITokenLastUsedInterval
+}
+
+// SessionToken is the request token a call with x authenticates as. It is
+// never stored, so it carries no secret.
+func (x *APIToken) SessionToken() *Token {
+	return &Token{
+		ID:         x.ID,
+		Sub:        x.Sub,
+		Email:      x.Email,
+		Name:       x.UserName,
+		Provider:   x.Provider,
+		ReadOnly:   x.ReadOnly,
+		Workspaces: x.Workspaces,
+		APIToken:   true,
+		ExpiresAt:  x.ExpiresAt,
+		CreatedAt:  x.CreatedAt,
+	}
+}
+
+// FormatAPIToken renders the bearer credential for a token:
+// "hct_<id>_<secret>". The ID is a UUID, which contains no "_", so the first
+// "_" after the prefix separates it from the secret.
+func FormatAPIToken(id TokenID, secret TokenSecret) string {
+	return APITokenPrefix + id.String() + "_" + secret.String()
+}
+
+// ParseAPIToken is the inverse of FormatAPIToken.
+func ParseAPIToken(raw string) (TokenID, TokenSecret, error) {
+	rest, ok := strings.CutPrefix(raw, APITokenPrefix)
+	if !ok {
+		return "", "", goerr.New("not a personal access token")
+	}
+	id, secret, ok := strings.Cut(rest, "_")
+	if !ok || secret == "" {
+		return "", "", goerr.New("malformed personal access token")
+	}
+	if err := TokenID(id).Validate(); err != nil {
+		return "", "", goerr.Wrap(err, "malformed personal access token")
+	}
+	return TokenID(id), TokenSecret(secret), nil
+}
+
+// hashAPITokenSecret hashes a secret for storage. The secret is 256 random
+// bits, so an unsalted fast hash is enough: there is nothing to brute-force.
+func hashAPITokenSecret(secret TokenSecret) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,75 @@
+package auth_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+)
+
+func TestAPIToken(t *testing.T) {
+	owner := auth.NewToken("U1", "u1@example.com", "User One")
+	owner.Provider = "slack"
+
+	t.Run("the bearer credential round-trips and verifies", func(t *testing.T) {
+		token, secret := auth.NewAPIToken(owner, "grafana", nil, false, time.Hour)
+		gt.NoError(t, token.Validate())
+		gt.String(t, token.SecretHash).NotEqual(secret.String())
+
+		raw := auth.FormatAPIToken(token.ID, secret)
+		gt.Bool(t, strings.HasPrefix(raw, auth.APITokenPrefix)).True()
+
+		id, parsed, err := auth.ParseAPIToken(raw)
+		gt.NoError(t, err).Required()
+		gt.Value(t, id).Equal(token.ID)
+		gt.Bool(t, token.Verify(parsed)).True()
+		gt.Bool(t, token.Verify(auth.NewTokenSecret())).False()
+	})
+
+	t.Run("malformed credentials are refused", func(t *testing.T) {
+		for _, raw := range []string{
+			"",
+			"token",
+			auth.NewTokenID().String() + "_secret",
+			auth.APITokenPrefix + auth.NewTokenID().String(),
+			auth.APITokenPrefix + auth.NewTokenID().String() + "_",
+			auth.APITokenPrefix + "not-a-uuid_secret",
+		} {
+			_, _, err := auth.ParseAPIToken(raw)
+			gt.Error(t, err)
+		}
+	})
+
+	t.Run("the session token carries the owner and the scopes", func(t *testing.T) {
+		token, _ := auth.NewAPIToken(owner, "report", []string{"ws-a"}, true, time.Hour)
+		session := token.SessionToken()
+		gt.Value(t, session.Sub).Equal("U1")
+		gt.Value(t, session.Name).Equal("User One")
+		gt.Value(t, session.Provider).Equal("slack")
+		gt.Value(t, session.Workspaces).Equal([]string{"ws-a"})
+		gt.Bool(t, session.ReadOnly).True()
+		gt.Bool(t, session.APIToken).True()
+		gt.Value(t, session.Secret).Equal(auth.TokenSecret(""))
+	})
+
+	t.Run("last use is recorded at most once per interval", func(t *testing.T) {
+		token, _ := auth.NewAPIToken(owner, "script", nil, false, time.Hour)
+		now := time.Now()
+		gt.Bool(t, token.NeedsLastUsedUpdate(now)).True()
+
+		recent := now.Add(-auth.APITokenLastUsedInterval / 2)
+		token.LastUsedAt = &recent
+		gt.Bool(t, token.NeedsLastUsedUpdate(now)).False()
+
+		stale := now.Add(-auth.APITokenLastUsedInterval)
+		token.LastUsedAt = &stale
+		gt.Bool(t, token.NeedsLastUsedUpdate(now)).True()
+	})
+
+	t.Run("expiry", func(t *testing.T) {
+		token, _ := auth.NewAPIToken(owner, "old", nil, false, -time.Second)
+		gt.Bool(t, token.IsExpired()).True()
+	})
+}
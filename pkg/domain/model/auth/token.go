@@ -55,13 +55,30 @@ func NewTokenSecret() TokenSecret {
 }
 
 type Token struct {
-	ID        TokenID     `json:"id"`
-	Secret    TokenSecret `json:"secret" masq:"secret"`
-	Sub       string      `json:"sub"`
-	Email     string      `json:"email"`
-	Name      string      `json:"name"`
-	ExpiresAt time.Time   `json:"expires_at"`
-	CreatedAt time.Time   `json:"created_at"`
+	ID     TokenID     `json:"id"`
+	Secret TokenSecret `json:"secret" masq:"secret"`
+	// Sub is the Slack user ID the session acts as. A session from an
+	// identity provider other than Slack whose user has no Slack account
+	// carries "<provider>:<subject>" instead, which no Slack membership
+	// matches.
+	Sub   string `json:"sub"`
+	Email string `json:"email"`
+	Name  string `json:"name"`
+	// Provider is the identity provider the user signed in with. Empty on
+	// tokens issued before providers were pluggable, which were all Slack.
+	Provider string `json:"provider,omitempty"`
+	// ReadOnly marks a session that may browse but not change anything: its
+	// user has no Slack account, so nothing it wrote could be attributed to
+	// one.
+	ReadOnly bool `json:"read_only,omitempty"`
+	// Workspaces limits the request to these workspace IDs; nil admits every
+	// workspace. Only a personal access token sets it.
+	Workspaces []string `json:"workspaces,omitempty"`
+	// APIToken marks a request authenticated with a personal access token
+	// (see APIToken.SessionToken) rather than a browser session.
+	APIToken  bool      `json:"api_token,omitempty"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 func (x *Token) Validate() error {
@@ -1,5 +1,6 @@
 // Package authz holds the Rego input/output document shapes used to
-// authenticate and authorize MCP requests, plus the context plumbing that
+// authenticate and authorize MCP requests and to authorize GraphQL mutations
+// and agent tool calls (see OperationInput), plus the context plumbing that
 // carries per-request HTTP metadata from the controller middleware down to
 // the point where a tool call is evaluated against the policy.
 //
@@ -10,7 +11,10 @@
 // request while the policy adapter stays transport-agnostic.
 package authz
 
-import "context"
+import (
+	"context"
+	"slices"
+)
 
 // HTTPRequest is the transport-level view of the inbound request exposed to
 // the policy as `input.req`. It deliberately omits the body: the MCP tool
@@ -38,19 +42,49 @@ type ToolCall struct {
 // is tagged `masq:"secret"` so the project logger redacts it; the
 // Authorization header inside Req.Header is redacted separately by the
 // logger's masq.WithFieldName("Authorization") rule.
+//
+// User is set only when the document is evaluated under `data.auth.mcp_write`:
+// it is the Slack user `data.auth.mcp` resolved for the request, so the write
+// rule can grant writes per person without re-deriving the identity.
 type Input struct {
 	Req  *HTTPRequest      `json:"req"`
 	Env  map[string]string `json:"env" masq:"secret"`
 	Tool *ToolCall         `json:"tool,omitempty"`
+	User string            `json:"user,omitempty"`
 }
 
 // Result is the document the policy is expected to produce under
 // `data.auth.mcp`. Allow gates the call; User (optional) is the Slack user ID
 // the request acts as, injected downstream as an auth token so private-case
 // access control can resolve the caller's identity.
+//
+// Tools, Workspaces and ReadOnly narrow an allowed token further. A nil list
+// leaves that dimension unrestricted; a present list — even an empty one —
+// admits only what it names.
 type Result struct {
-	Allow bool   `json:"allow"`
-	User  string `json:"user,omitempty"`
+	Allow      bool     `json:"allow"`
+	User       string   `json:"user,omitempty"`
+	Tools      []string `json:"tools,omitempty"`
+	Workspaces []string `json:"workspaces,omitempty"`
+	ReadOnly   bool     `json:"read_only,omitempty"`
+}
+
+// PermitsTool reports whether the scopes admit the named tool. readOnly is
+// whether the tool only reads; a read-only result admits no other kind.
+func (r Result) PermitsTool(name string, readOnly bool) bool {
+	if r.ReadOnly && !readOnly {
+		return false
+	}
+	return r.Tools == nil || slices.Contains(r.Tools, name)
+}
+
+// PermitsWorkspace reports whether the scopes admit workspaceID. A call that
+// names no workspace is not constrained by Workspaces.
+func (r Result) PermitsWorkspace(workspaceID string) bool {
+	if workspaceID == "" || r.Workspaces == nil {
+		return true
+	}
+	return slices.Contains(r.Workspaces, workspaceID)
 }
 
 type requestCtxKey struct{}
@@ -2,6 +2,7 @@ package authz_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/m-mizutani/gt"
@@ -51,3 +52,45 @@ func TestContextWithRequest_RoundTrip(t *testing.T) {
 	ctx := authz.ContextWithRequest(context.Background(), req)
 	gt.Value(t, authz.RequestFromContext(ctx)).Equal(req)
 }
+
+func TestResult_Scopes(t *testing.T) {
+	decode := func(t *testing.T, doc string) authz.Result {
+		t.Helper()
+		var r authz.Result
+		gt.NoError(t, json.Unmarshal([]byte(doc), &r)).Required()
+		return r
+	}
+
+	t.Run("no scopes admit everything", func(t *testing.T) {
+		r := decode(t, `{"allow": true}`)
+		gt.Bool(t, r.PermitsTool("hecaton_list_cases", true)).True()
+		gt.Bool(t, r.PermitsTool("hecaton_update_case", false)).True()
+		gt.Bool(t, r.PermitsWorkspace("ws1")).True()
+	})
+
+	t.Run("tool allow-list", func(t *testing.T) {
+		r := decode(t, `{"allow": true, "tools": ["hecaton_list_cases"]}`)
+		gt.Bool(t, r.PermitsTool("hecaton_list_cases", true)).True()
+		gt.Bool(t, r.PermitsTool("hecaton_get_cases", true)).False()
+	})
+
+	t.Run("an empty list admits nothing", func(t *testing.T) {
+		r := decode(t, `{"allow": true, "tools": [], "workspaces": []}`)
+		gt.Bool(t, r.PermitsTool("hecaton_list_cases", true)).False()
+		gt.Bool(t, r.PermitsWorkspace("ws1")).False()
+	})
+
+	t.Run("read-only admits only reading tools", func(t *testing.T) {
+		r := decode(t, `{"allow": true, "read_only": true}`)
+		gt.Bool(t, r.PermitsTool("hecaton_list_cases", true)).True()
+		gt.Bool(t, r.PermitsTool("hecaton_update_case", false)).False()
+	})
+
+	t.Run("workspace scope", func(t *testing.T) {
+		r := decode(t, `{"allow": true, "workspaces": ["ws1"]}`)
+		gt.Bool(t, r.PermitsWorkspace("ws1")).True()
+		gt.Bool(t, r.PermitsWorkspace("ws2")).False()
+		// A tool that names no workspace is not constrained.
+		gt.Bool(t, r.PermitsWorkspace("")).True()
+	})
+}
@@ -0,0 +1,81 @@
+package authz
+
+// Rego entrypoints for the surfaces beyond MCP. Each is optional: a policy
+// bundle that defines no rule under the package leaves that surface
+// ungoverned, so an operator can adopt them one at a time.
+const (
+	// MutationQuery is evaluated before every root GraphQL mutation.
+	MutationQuery = "data.auth.graphql"
+	// AgentToolQuery is evaluated before every agent tool call.
+	AgentToolQuery = "data.auth.tool"
+)
+
+// ActorKind says what kind of principal attempts an operation.
+type ActorKind string
+
+const (
+	// ActorUser is a person acting through the web UI or the GraphQL API.
+	ActorUser ActorKind = "user"
+	// ActorAgent is an agent answering a person (a mention, the assist flow):
+	// UserID is the person it acts for.
+	ActorAgent ActorKind = "agent"
+	// ActorJob is an agent Job run. UserID is set only when a person triggered
+	// the run by hand.
+	ActorJob ActorKind = "job"
+)
+
+// Actor is the principal exposed to the policy as `input.actor`.
+type Actor struct {
+	Kind   ActorKind `json:"kind"`
+	UserID string    `json:"user_id,omitempty"`
+	Email  string    `json:"email,omitempty"`
+	JobID  string    `json:"job_id,omitempty"`
+	RunID  string    `json:"run_id,omitempty"`
+}
+
+// OperationInput is the document passed as `input` to MutationQuery and
+// AgentToolQuery. Operation is the GraphQL mutation name ("closeCase") or the
+// agent tool name ("case__close_case"); Args are its arguments as the caller
+// sent them. CaseID is 0 when the operation targets no case. CaseIDs lists
+// every case an operation touches when it spans several (merging cases,
+// archiving actions in bulk, moving an action) and is empty otherwise.
+type OperationInput struct {
+	Operation   string         `json:"operation"`
+	WorkspaceID string         `json:"workspace_id,omitempty"`
+	CaseID      int64          `json:"case_id,omitempty"`
+	CaseIDs     []int64        `json:"case_ids,omitempty"`
+	Actor       Actor          `json:"actor"`
+	Args        map[string]any `json:"args,omitempty"`
+}
+
+// Decision is the document MutationQuery and AgentToolQuery are expected to
+// produce. Reason, when set, is shown to the caller on a deny so a person (or
+// a model) can tell which rule refused it.
+type Decision struct {
+	Allow  bool   `json:"allow"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// IngestQuery is evaluated for every alert posted to a workspace's ingest
+// endpoint. Unlike the entrypoints above it is not optional: an alert is
+// accepted only when the policy allows it.
+const IngestQuery = "data.auth.ingest"
+
+// IngestInput is the document passed as `input` to IngestQuery. Alert is the
+// decoded JSON body, so a policy can route on the sender as well as on its
+// credentials in Req.Header.
+type IngestInput struct {
+	Req         *HTTPRequest      `json:"req"`
+	Env         map[string]string `json:"env" masq:"secret"`
+	WorkspaceID string            `json:"workspace_id"`
+	Alert       map[string]any    `json:"alert"`
+}
+
+// IngestDecision is the document IngestQuery is expected to produce. User,
+// when set, is the Slack user ID recorded as the reporter of a case the
+// alert opens.
+type IngestDecision struct {
+	Allow  bool   `json:"allow"`
+	Reason string `json:"reason,omitempty"`
+	User   string `json:"user,omitempty"`
+}
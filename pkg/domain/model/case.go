@@ -83,6 +83,23 @@ type Case struct {
 	// not invalidate the stored selection).
 	AgentSourceIDs []SourceID
 
+	// SLA holds the timestamps the case's SLA clocks are computed from (see
+	// SLAPolicy.Clocks), maintained as the case is responded to, paused,
+	// closed and reopened.
+	SLA CaseSLA
+
+	// MergedInto is the ID of the Case this one was merged into, 0 when it
+	// was never merged. A merged Case is closed and keeps only its own
+	// record; its actions, memos, messages and job-run history moved to the
+	// surviving Case.
+	MergedInto int64
+
+	// Embedding is the vector embedding of Title + Description, computed at
+	// creation to suggest likely duplicates. It is empty when no embedding
+	// client is configured (fail-open) and is never exposed through the
+	// GraphQL surface or the export.
+	Embedding []float64
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -124,6 +141,12 @@ func (c *Case) Validate() error {
 	if c == nil {
 		return goerr.New("case is nil")
 	}
+	// Guard against a wrong-dimension embedding write, as Knowledge does. An
+	// empty embedding is legitimate (no embedder configured).
+	if len(c.Embedding) > 0 && len(c.Embedding) != EmbeddingDimension {
+		return goerr.New("case embedding dimension mismatch",
+			goerr.V("got", len(c.Embedding)), goerr.V("want", EmbeddingDimension))
+	}
 	if len(c.AgentAdditionalPrompt) > AgentAdditionalPromptMaxLen {
 		return goerr.Wrap(ErrCaseAgentPromptTooLong,
 			"agent additional prompt exceeds maximum length",
@@ -223,6 +246,13 @@ func (c *Case) UnassignUsers(userIDs []string) bool {
 	return true
 }
 
+// CaseDuplicateCandidate is an open Case suggested as a likely duplicate of
+// another because their embeddings are close. Score is the cosine similarity.
+type CaseDuplicateCandidate struct {
+	Case  *Case
+	Score float64
+}
+
 // IsCaseAccessible checks if a user has access to a case.
 // Non-private cases are always accessible.
 // Private cases are accessible only if the userID is in ChannelUserIDs.
@@ -22,3 +22,14 @@ type MyDueAction struct {
 	CaseID        int64
 	CaseTitle     string
 }
+
+// OpenSLABreach is one row of the cross-workspace "SLA breaches" home
+// aggregation: a recorded breach on a case that is still open. CaseTitle is
+// pre-expanded like MyDueAction's.
+type OpenSLABreach struct {
+	WorkspaceID   string
+	WorkspaceName string
+	CaseID        int64
+	CaseTitle     string
+	Breach        *SLABreach
+}
@@ -0,0 +1,87 @@
+package model
+
+import (
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+)
+
+// ErrExportStateValidation is returned when an ExportWatermark or Tombstone
+// fails validation.
+var ErrExportStateValidation = goerr.New("export state validation failed")
+
+// ExportWatermark is the incremental-export high-water mark of one exported
+// table: every row changed at or before HighWater has reached the sink. It is
+// keyed by (WorkspaceID, Sink, Namespace, Table) so the same workspace can be
+// exported to several sinks, each with its own progress.
+type ExportWatermark struct {
+	WorkspaceID string
+	// Sink names the export sink kind ("bigquery", "postgres", ...).
+	Sink      string
+	Namespace string
+	Table     string
+	// HighWater is the instant the run that last wrote this table started
+	// reading. The next incremental run emits rows changed after it.
+	HighWater time.Time
+	// SchemaHash fingerprints the column list the table was written with. A
+	// different hash means the schema changed and the table must be refreshed
+	// in full before it can be merged into again.
+	SchemaHash string
+	UpdatedAt  time.Time
+}
+
+// Validate enforces the identity invariant the repository relies on.
+func (w *ExportWatermark) Validate() error {
+	if w == nil {
+		return goerr.Wrap(ErrExportStateValidation, "export watermark is nil")
+	}
+	if w.WorkspaceID == "" || w.Sink == "" || w.Namespace == "" || w.Table == "" {
+		return goerr.Wrap(ErrExportStateValidation, "export watermark key is incomplete",
+			goerr.V("workspace_id", w.WorkspaceID), goerr.V("sink", w.Sink),
+			goerr.V("namespace", w.Namespace), goerr.V("table", w.Table))
+	}
+	if w.HighWater.IsZero() {
+		return goerr.Wrap(ErrExportStateValidation, "export watermark high water is required")
+	}
+	return nil
+}
+
+// TombstoneEntity names the kind of record a Tombstone stands for.
+type TombstoneEntity string
+
+const (
+	// TombstoneEntityCase marks a deleted Case. Its Actions, Memos and job run
+	// history go with it, so the export removes every row keyed to the case.
+	TombstoneEntityCase TombstoneEntity = "case"
+	// TombstoneEntityCaseJobRuns marks a Case whose job run history moved to
+	// another Case in a merge. Job run and job run log rows are keyed by
+	// case, so the export removes the ones still keyed to this one; the
+	// moved records are re-sent under the Case they went into.
+	TombstoneEntityCaseJobRuns TombstoneEntity = "case_job_runs"
+)
+
+// Tombstone records that a record was permanently deleted, so an incremental
+// export can remove it from the sink: a deleted document cannot be found by
+// a "changed since" query.
+type Tombstone struct {
+	WorkspaceID string
+	Entity      TombstoneEntity
+	// EntityID is the deleted record's ID in its string form.
+	EntityID  string
+	DeletedAt time.Time
+}
+
+// Validate enforces the identity invariant the repository relies on.
+func (t *Tombstone) Validate() error {
+	if t == nil {
+		return goerr.Wrap(ErrExportStateValidation, "tombstone is nil")
+	}
+	if t.WorkspaceID == "" || t.Entity == "" || t.EntityID == "" {
+		return goerr.Wrap(ErrExportStateValidation, "tombstone key is incomplete",
+			goerr.V("workspace_id", t.WorkspaceID), goerr.V("entity", t.Entity), goerr.V("entity_id", t.EntityID))
+	}
+	if t.DeletedAt.IsZero() {
+		return goerr.Wrap(ErrExportStateValidation, "tombstone deleted_at is required")
+	}
+	return nil
+}
@@ -26,6 +26,7 @@ type Case struct {
 	SlackThreadTS  *string          `json:"slackThreadTS,omitempty"`
 	IsThreadBound  bool             `json:"isThreadBound"`
 	BoardStatus    *string          `json:"boardStatus,omitempty"`
+	MergedIntoID   *int             `json:"mergedIntoID,omitempty"`
 	Fields         []*FieldValue    `json:"fields"`
 	Actions        []*Action        `json:"actions"`
 	// AgentAdditionalPrompt is the Markdown text appended to the agent
@@ -60,6 +61,9 @@ type Action struct {
 	ArchivedAt *time.Time `json:"archivedAt,omitempty"`
 	CreatedAt  time.Time  `json:"createdAt"`
 	UpdatedAt  time.Time  `json:"updatedAt"`
+	// BlockedByIDs is the stored blocker list; the blockedBy, blocking and
+	// blocked fields are resolved from it against the case's actions.
+	BlockedByIDs []int `json:"blockedByIDs"`
 }
 
 // Memo is a custom GraphQL model with WorkspaceID for argument-based propagation.
@@ -16,6 +16,26 @@ type SourceConfig interface {
 	IsSourceConfig()
 }
 
+type APIToken struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Workspace IDs the token is limited to; null admits every workspace.
+	WorkspaceIds []string `json:"workspaceIds,omitempty"`
+	// A read-only token cannot run any mutation.
+	ReadOnly  bool      `json:"readOnly"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	CreatedAt time.Time `json:"createdAt"`
+	// When the token was last used, to the minute; null if never.
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	Expired    bool       `json:"expired"`
+}
+
+type ActionChangeEvent struct {
+	Kind     LiveChangeKind `json:"kind"`
+	ActionID int            `json:"actionId"`
+	Action   *Action        `json:"action,omitempty"`
+}
+
 type ActionComment struct {
 	ID        string     `json:"id"`
 	ActionID  int        `json:"actionID"`
@@ -86,6 +106,18 @@ type AddActionStepInput struct {
 	Title    string `json:"title"`
 }
 
+type Alert struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	// Hex SHA-256 of the rendered fingerprint; shared by repeats of one alert.
+	Fingerprint string `json:"fingerprint"`
+	// The alert's JSON body, truncated to 64 KiB.
+	Payload string `json:"payload"`
+	// True when the alert was folded into this already-open case.
+	Repeat     bool      `json:"repeat"`
+	ReceivedAt time.Time `json:"receivedAt"`
+}
+
 type AssistLog struct {
 	ID        string    `json:"id"`
 	CaseID    int       `json:"caseId"`
@@ -102,6 +134,26 @@ type AssistLogConnection struct {
 	HasMore    bool         `json:"hasMore"`
 }
 
+type CaseChangeEvent struct {
+	Kind   LiveChangeKind `json:"kind"`
+	CaseID int            `json:"caseId"`
+	Case   *Case          `json:"case,omitempty"`
+}
+
+type CaseConnection struct {
+	Items      []*Case `json:"items"`
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+type CaseFieldFilterInput struct {
+	FieldID   string     `json:"fieldId"`
+	OptionIds []string   `json:"optionIds,omitempty"`
+	DateFrom  *time.Time `json:"dateFrom,omitempty"`
+	DateTo    *time.Time `json:"dateTo,omitempty"`
+	NumberMin *float64   `json:"numberMin,omitempty"`
+	NumberMax *float64   `json:"numberMax,omitempty"`
+}
+
 type CaseJob struct {
 	ID          string      `json:"id"`
 	WorkspaceID string      `json:"workspaceId"`
@@ -121,12 +173,39 @@ type CaseRef struct {
 	WorkspaceID string           `json:"workspaceId"`
 }
 
+type CaseSearchFilter struct {
+	Status      *types.CaseStatus       `json:"status,omitempty"`
+	AssigneeID  *string                 `json:"assigneeId,omitempty"`
+	ReporterID  *string                 `json:"reporterId,omitempty"`
+	Fields      []*CaseFieldFilterInput `json:"fields,omitempty"`
+	Text        *string                 `json:"text,omitempty"`
+	CreatedFrom *time.Time              `json:"createdFrom,omitempty"`
+	CreatedTo   *time.Time              `json:"createdTo,omitempty"`
+	UpdatedFrom *time.Time              `json:"updatedFrom,omitempty"`
+	UpdatedTo   *time.Time              `json:"updatedTo,omitempty"`
+	IsTest      *bool                   `json:"isTest,omitempty"`
+}
+
+type CaseSortInput struct {
+	Key       CaseSortKey   `json:"key"`
+	Direction SortDirection `json:"direction"`
+}
+
 type ChannelUserConnection struct {
 	Items      []*SlackUser `json:"items"`
 	TotalCount int          `json:"totalCount"`
 	HasMore    bool         `json:"hasMore"`
 }
 
+type CreateAPITokenInput struct {
+	Name string `json:"name"`
+	// Limit the token to these workspaces; omit for every workspace.
+	WorkspaceIds []string `json:"workspaceIds,omitempty"`
+	ReadOnly     *bool    `json:"readOnly,omitempty"`
+	// Lifetime in days, 1 to 365. Defaults to 90.
+	ExpiresInDays *int `json:"expiresInDays,omitempty"`
+}
+
 type CreateActionCommentInput struct {
 	ActionID int    `json:"actionId"`
 	Body     string `json:"body"`
@@ -140,6 +219,7 @@ type CreateActionInput struct {
 	SlackMessageTs *string    `json:"slackMessageTS,omitempty"`
 	Status         *string    `json:"status,omitempty"`
 	DueDate        *time.Time `json:"dueDate,omitempty"`
+	BlockedBy      []int      `json:"blockedBy,omitempty"`
 }
 
 type CreateCaseImportInput struct {
@@ -175,9 +255,11 @@ type CreateGitHubSourceInput struct {
 }
 
 type CreateKnowledgeInput struct {
-	Title  string   `json:"title"`
-	Claim  *string  `json:"claim,omitempty"`
-	TagIds []string `json:"tagIds"`
+	Title      string     `json:"title"`
+	Claim      *string    `json:"claim,omitempty"`
+	TagIds     []string   `json:"tagIds"`
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
+	ReviewBy   *time.Time `json:"reviewBy,omitempty"`
 }
 
 type CreateMemoInput struct {
@@ -209,6 +291,12 @@ type CreateSlackSourceInput struct {
 	Enabled     *bool    `json:"enabled,omitempty"`
 }
 
+type CreatedAPIToken struct {
+	Token *APIToken `json:"token"`
+	// The bearer credential. Shown only once; store it now.
+	Secret string `json:"secret"`
+}
+
 type DeleteActionCommentInput struct {
 	ActionID  int    `json:"actionId"`
 	CommentID string `json:"commentId"`
@@ -219,6 +307,16 @@ type DeleteActionStepInput struct {
 	StepID   string `json:"stepId"`
 }
 
+type DiffLine struct {
+	Op   DiffOp `json:"op"`
+	Text string `json:"text"`
+}
+
+type DuplicateCandidate struct {
+	Case  *Case   `json:"case"`
+	Score float64 `json:"score"`
+}
+
 type EntityLabels struct {
 	Case string `json:"case"`
 }
@@ -408,16 +506,53 @@ type JobSchedule struct {
 
 type JobTrigger struct {
 	CaseEvents []CaseLifecycleEvent `json:"caseEvents"`
+	CaseField  *string              `json:"caseField,omitempty"`
+	CaseTo     []string             `json:"caseTo"`
 	Schedule   *JobSchedule         `json:"schedule,omitempty"`
 }
 
 type Knowledge struct {
-	ID        string    `json:"id"`
-	Title     string    `json:"title"`
-	Claim     string    `json:"claim"`
-	Tags      []*Tag    `json:"tags"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	ID              string     `json:"id"`
+	Title           string     `json:"title"`
+	Claim           string     `json:"claim"`
+	Tags            []*Tag     `json:"tags"`
+	ValidUntil      *time.Time `json:"validUntil,omitempty"`
+	ReviewBy        *time.Time `json:"reviewBy,omitempty"`
+	ReviewFlaggedAt *time.Time `json:"reviewFlaggedAt,omitempty"`
+	Expired         bool       `json:"expired"`
+	ReviewDue       bool       `json:"reviewDue"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
+}
+
+type KnowledgeRevision struct {
+	Revision     int                     `json:"revision"`
+	Action       KnowledgeRevisionAction `json:"action"`
+	Title        string                  `json:"title"`
+	Claim        string                  `json:"claim"`
+	Tags         []*Tag                  `json:"tags"`
+	EditorID     *string                 `json:"editorID,omitempty"`
+	Editor       *SlackUser              `json:"editor,omitempty"`
+	JobID        *string                 `json:"jobID,omitempty"`
+	RunID        *string                 `json:"runID,omitempty"`
+	RestoredFrom *int                    `json:"restoredFrom,omitempty"`
+	CreatedAt    time.Time               `json:"createdAt"`
+}
+
+type KnowledgeRevisionDiff struct {
+	From        *KnowledgeRevision `json:"from"`
+	To          *KnowledgeRevision `json:"to"`
+	Title       []*DiffLine        `json:"title"`
+	Claim       []*DiffLine        `json:"claim"`
+	AddedTags   []*Tag             `json:"addedTags"`
+	RemovedTags []*Tag             `json:"removedTags"`
+}
+
+type KnowledgeSearchHit struct {
+	Knowledge    *Knowledge `json:"knowledge"`
+	Score        float64    `json:"score"`
+	LexicalScore float64    `json:"lexicalScore"`
+	VectorScore  *float64   `json:"vectorScore,omitempty"`
 }
 
 type MemoConfiguration struct {
@@ -475,6 +610,14 @@ type NotionPageValidationResult struct {
 	ErrorMessage *string `json:"errorMessage,omitempty"`
 }
 
+type OpenSLABreach struct {
+	WorkspaceID   string     `json:"workspaceId"`
+	WorkspaceName string     `json:"workspaceName"`
+	CaseID        int        `json:"caseId"`
+	CaseTitle     string     `json:"caseTitle"`
+	Breach        *SLABreach `json:"breach"`
+}
+
 type Query struct {
 }
 
@@ -484,6 +627,23 @@ type RenameActionStepInput struct {
 	Title    string `json:"title"`
 }
 
+type SLABreach struct {
+	Kind          SLAKind   `json:"kind"`
+	TargetSeconds int       `json:"targetSeconds"`
+	DueAt         time.Time `json:"dueAt"`
+	RecordedAt    time.Time `json:"recordedAt"`
+}
+
+type SLAClock struct {
+	Kind           SLAKind    `json:"kind"`
+	TargetSeconds  int        `json:"targetSeconds"`
+	ElapsedSeconds int        `json:"elapsedSeconds"`
+	DueAt          time.Time  `json:"dueAt"`
+	StoppedAt      *time.Time `json:"stoppedAt,omitempty"`
+	Paused         bool       `json:"paused"`
+	Breached       bool       `json:"breached"`
+}
+
 type SetActionStepDoneInput struct {
 	ActionID int    `json:"actionId"`
 	StepID   string `json:"stepId"`
@@ -559,6 +719,9 @@ type SubmitDraftInput struct {
 	IsTest      *bool              `json:"isTest,omitempty"`
 }
 
+type Subscription struct {
+}
+
 type Tag struct {
 	ID        string    `json:"id"`
 	Name      *string   `json:"name,omitempty"`
@@ -583,6 +746,7 @@ type UpdateActionInput struct {
 	DueDate        *time.Time `json:"dueDate,omitempty"`
 	ClearDueDate   *bool      `json:"clearDueDate,omitempty"`
 	ClearAssignee  *bool      `json:"clearAssignee,omitempty"`
+	BlockedBy      []int      `json:"blockedBy,omitempty"`
 }
 
 type UpdateCaseAgentSettingsInput struct {
@@ -613,10 +777,14 @@ type UpdateGitHubSourceInput struct {
 }
 
 type UpdateKnowledgeInput struct {
-	ID     string   `json:"id"`
-	Title  *string  `json:"title,omitempty"`
-	Claim  *string  `json:"claim,omitempty"`
-	TagIds []string `json:"tagIds,omitempty"`
+	ID              string     `json:"id"`
+	Title           *string    `json:"title,omitempty"`
+	Claim           *string    `json:"claim,omitempty"`
+	TagIds          []string   `json:"tagIds,omitempty"`
+	ValidUntil      *time.Time `json:"validUntil,omitempty"`
+	ReviewBy        *time.Time `json:"reviewBy,omitempty"`
+	ClearValidUntil *bool      `json:"clearValidUntil,omitempty"`
+	ClearReviewBy   *bool      `json:"clearReviewBy,omitempty"`
 }
 
 type UpdateMemoInput struct {
@@ -659,6 +827,27 @@ type UpdateSourceInput struct {
 	Enabled     *bool   `json:"enabled,omitempty"`
 }
 
+type WebhookDelivery struct {
+	ID string `json:"id"`
+	// The [[webhook]] entry's id.
+	WebhookID string `json:"webhookId"`
+	// The event type, e.g. case.created.
+	Event  string                `json:"event"`
+	CaseID int                   `json:"caseId"`
+	Status WebhookDeliveryStatus `json:"status"`
+	// The request body sent on every attempt.
+	Payload  string `json:"payload"`
+	Attempts int    `json:"attempts"`
+	// When a PENDING delivery is next attempted; null otherwise.
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
+	LastAttemptAt *time.Time `json:"lastAttemptAt,omitempty"`
+	// The last response's HTTP status; null when no response was received.
+	LastStatusCode *int       `json:"lastStatusCode,omitempty"`
+	LastError      *string    `json:"lastError,omitempty"`
+	DeliveredAt    *time.Time `json:"deliveredAt,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt"`
+}
+
 type Workspace struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
@@ -804,18 +993,28 @@ func (e ActionEventKind) MarshalJSON() ([]byte, error) {
 type CaseLifecycleEvent string
 
 const (
-	CaseLifecycleEventCreated CaseLifecycleEvent = "CREATED"
-	CaseLifecycleEventClosed  CaseLifecycleEvent = "CLOSED"
+	CaseLifecycleEventCreated         CaseLifecycleEvent = "CREATED"
+	CaseLifecycleEventClosed          CaseLifecycleEvent = "CLOSED"
+	CaseLifecycleEventFieldChanged    CaseLifecycleEvent = "FIELD_CHANGED"
+	CaseLifecycleEventStatusChanged   CaseLifecycleEvent = "STATUS_CHANGED"
+	CaseLifecycleEventAssigned        CaseLifecycleEvent = "ASSIGNED"
+	CaseLifecycleEventActionCompleted CaseLifecycleEvent = "ACTION_COMPLETED"
+	CaseLifecycleEventMessageAttached CaseLifecycleEvent = "MESSAGE_ATTACHED"
 )
 
 var AllCaseLifecycleEvent = []CaseLifecycleEvent{
 	CaseLifecycleEventCreated,
 	CaseLifecycleEventClosed,
+	CaseLifecycleEventFieldChanged,
+	CaseLifecycleEventStatusChanged,
+	CaseLifecycleEventAssigned,
+	CaseLifecycleEventActionCompleted,
+	CaseLifecycleEventMessageAttached,
 }
 
 func (e CaseLifecycleEvent) IsValid() bool {
 	switch e {
-	case CaseLifecycleEventCreated, CaseLifecycleEventClosed:
+	case CaseLifecycleEventCreated, CaseLifecycleEventClosed, CaseLifecycleEventFieldChanged, CaseLifecycleEventStatusChanged, CaseLifecycleEventAssigned, CaseLifecycleEventActionCompleted, CaseLifecycleEventMessageAttached:
 		return true
 	}
 	return false
@@ -856,6 +1055,120 @@ func (e CaseLifecycleEvent) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type CaseSortKey string
+
+const (
+	CaseSortKeyCreatedAt CaseSortKey = "CREATED_AT"
+	CaseSortKeyUpdatedAt CaseSortKey = "UPDATED_AT"
+	CaseSortKeyID        CaseSortKey = "ID"
+)
+
+var AllCaseSortKey = []CaseSortKey{
+	CaseSortKeyCreatedAt,
+	CaseSortKeyUpdatedAt,
+	CaseSortKeyID,
+}
+
+func (e CaseSortKey) IsValid() bool {
+	switch e {
+	case CaseSortKeyCreatedAt, CaseSortKeyUpdatedAt, CaseSortKeyID:
+		return true
+	}
+	return false
+}
+
+func (e CaseSortKey) String() string {
+	return string(e)
+}
+
+func (e *CaseSortKey) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CaseSortKey(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CaseSortKey", str)
+	}
+	return nil
+}
+
+func (e CaseSortKey) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CaseSortKey) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CaseSortKey) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type DiffOp string
+
+const (
+	DiffOpEqual  DiffOp = "EQUAL"
+	DiffOpInsert DiffOp = "INSERT"
+	DiffOpDelete DiffOp = "DELETE"
+)
+
+var AllDiffOp = []DiffOp{
+	DiffOpEqual,
+	DiffOpInsert,
+	DiffOpDelete,
+}
+
+func (e DiffOp) IsValid() bool {
+	switch e {
+	case DiffOpEqual, DiffOpInsert, DiffOpDelete:
+		return true
+	}
+	return false
+}
+
+func (e DiffOp) String() string {
+	return string(e)
+}
+
+func (e *DiffOp) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DiffOp(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DiffOp", str)
+	}
+	return nil
+}
+
+func (e DiffOp) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DiffOp) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DiffOp) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type FieldType string
 
 const (
@@ -1273,6 +1586,120 @@ func (e JobStrategy) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type KnowledgeRevisionAction string
+
+const (
+	KnowledgeRevisionActionCreated  KnowledgeRevisionAction = "CREATED"
+	KnowledgeRevisionActionUpdated  KnowledgeRevisionAction = "UPDATED"
+	KnowledgeRevisionActionRestored KnowledgeRevisionAction = "RESTORED"
+)
+
+var AllKnowledgeRevisionAction = []KnowledgeRevisionAction{
+	KnowledgeRevisionActionCreated,
+	KnowledgeRevisionActionUpdated,
+	KnowledgeRevisionActionRestored,
+}
+
+func (e KnowledgeRevisionAction) IsValid() bool {
+	switch e {
+	case KnowledgeRevisionActionCreated, KnowledgeRevisionActionUpdated, KnowledgeRevisionActionRestored:
+		return true
+	}
+	return false
+}
+
+func (e KnowledgeRevisionAction) String() string {
+	return string(e)
+}
+
+func (e *KnowledgeRevisionAction) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = KnowledgeRevisionAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid KnowledgeRevisionAction", str)
+	}
+	return nil
+}
+
+func (e KnowledgeRevisionAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *KnowledgeRevisionAction) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e KnowledgeRevisionAction) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type LiveChangeKind string
+
+const (
+	LiveChangeKindCreated LiveChangeKind = "CREATED"
+	LiveChangeKindUpdated LiveChangeKind = "UPDATED"
+	LiveChangeKindDeleted LiveChangeKind = "DELETED"
+)
+
+var AllLiveChangeKind = []LiveChangeKind{
+	LiveChangeKindCreated,
+	LiveChangeKindUpdated,
+	LiveChangeKindDeleted,
+}
+
+func (e LiveChangeKind) IsValid() bool {
+	switch e {
+	case LiveChangeKindCreated, LiveChangeKindUpdated, LiveChangeKindDeleted:
+		return true
+	}
+	return false
+}
+
+func (e LiveChangeKind) String() string {
+	return string(e)
+}
+
+func (e *LiveChangeKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LiveChangeKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LiveChangeKind", str)
+	}
+	return nil
+}
+
+func (e LiveChangeKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *LiveChangeKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e LiveChangeKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type MemoArchiveFilter string
 
 const (
@@ -1330,6 +1757,116 @@ func (e MemoArchiveFilter) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type SLAKind string
+
+const (
+	SLAKindResponse   SLAKind = "RESPONSE"
+	SLAKindResolution SLAKind = "RESOLUTION"
+)
+
+var AllSLAKind = []SLAKind{
+	SLAKindResponse,
+	SLAKindResolution,
+}
+
+func (e SLAKind) IsValid() bool {
+	switch e {
+	case SLAKindResponse, SLAKindResolution:
+		return true
+	}
+	return false
+}
+
+func (e SLAKind) String() string {
+	return string(e)
+}
+
+func (e *SLAKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SLAKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SLAKind", str)
+	}
+	return nil
+}
+
+func (e SLAKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SLAKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SLAKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "ASC"
+	SortDirectionDesc SortDirection = "DESC"
+)
+
+var AllSortDirection = []SortDirection{
+	SortDirectionAsc,
+	SortDirectionDesc,
+}
+
+func (e SortDirection) IsValid() bool {
+	switch e {
+	case SortDirectionAsc, SortDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortDirection) String() string {
+	return string(e)
+}
+
+func (e *SortDirection) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortDirection", str)
+	}
+	return nil
+}
+
+func (e SortDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SortDirection) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SortDirection) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type SourceType string
 
 const (
@@ -1388,3 +1925,60 @@ func (e SourceType) MarshalJSON() ([]byte, error) {
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "DELIVERED"
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "DEAD"
+)
+
+var AllWebhookDeliveryStatus = []WebhookDeliveryStatus{
+	WebhookDeliveryStatusPending,
+	WebhookDeliveryStatusDelivered,
+	WebhookDeliveryStatusDead,
+}
+
+func (e WebhookDeliveryStatus) IsValid() bool {
+	switch e {
+	case WebhookDeliveryStatusPending, WebhookDeliveryStatusDelivered, WebhookDeliveryStatusDead:
+		return true
+	}
+	return false
+}
+
+func (e WebhookDeliveryStatus) String() string {
+	return string(e)
+}
+
+func (e *WebhookDeliveryStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookDeliveryStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookDeliveryStatus", str)
+	}
+	return nil
+}
+
+func (e WebhookDeliveryStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WebhookDeliveryStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WebhookDeliveryStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
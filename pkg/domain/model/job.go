@@ -21,6 +21,23 @@ const (
 	CaseLifecycleCreated CaseLifecycle = "created"
 	// CaseLifecycleClosed fires when a Case's status transitions to CLOSED.
 	CaseLifecycleClosed CaseLifecycle = "closed"
+	// CaseLifecycleFieldChanged fires when a custom field value of the Case
+	// changes. The event carries the field ID and the before/after values,
+	// so a Job can narrow it with `field` / `to`.
+	CaseLifecycleFieldChanged CaseLifecycle = "field_changed"
+	// CaseLifecycleStatusChanged fires when a thread-mode Case moves to a
+	// different BoardStatus column. `to` narrows it by board status ID.
+	CaseLifecycleStatusChanged CaseLifecycle = "status_changed"
+	// CaseLifecycleAssigned fires when one or more users are newly assigned
+	// to the Case. `to` narrows it by the added Slack user IDs.
+	CaseLifecycleAssigned CaseLifecycle = "assigned"
+	// CaseLifecycleActionCompleted fires when an Action of the Case moves
+	// from an open status into a closed one (see ActionStatusSet.IsClosed).
+	CaseLifecycleActionCompleted CaseLifecycle = "action_completed"
+	// CaseLifecycleMessageAttached fires when a Slack message from another
+	// channel is attached to the Case with the "Attach to case" message
+	// shortcut and the user asked to notify Jobs.
+	CaseLifecycleMessageAttached CaseLifecycle = "message_attached"
 )
 
 // AllCaseLifecycles returns every valid CaseLifecycle for validation and
@@ -29,6 +46,11 @@ func AllCaseLifecycles() []CaseLifecycle {
 	return []CaseLifecycle{
 		CaseLifecycleCreated,
 		CaseLifecycleClosed,
+		CaseLifecycleFieldChanged,
+		CaseLifecycleStatusChanged,
+		CaseLifecycleAssigned,
+		CaseLifecycleActionCompleted,
+		CaseLifecycleMessageAttached,
 	}
 }
 
@@ -36,7 +58,10 @@ func AllCaseLifecycles() []CaseLifecycle {
 // enum members.
 func (l CaseLifecycle) IsValid() bool {
 	switch l {
-	case CaseLifecycleCreated, CaseLifecycleClosed:
+	case CaseLifecycleCreated, CaseLifecycleClosed,
+		CaseLifecycleFieldChanged, CaseLifecycleStatusChanged,
+		CaseLifecycleAssigned, CaseLifecycleActionCompleted,
+		CaseLifecycleMessageAttached:
 		return true
 	default:
 		return false
@@ -46,6 +71,44 @@ func (l CaseLifecycle) IsValid() bool {
 // String returns the string form for prompt rendering / logging.
 func (l CaseLifecycle) String() string { return string(l) }
 
+// carriesTo reports whether events of this lifecycle carry "to" values that
+// the `to` filter can match against. created / closed / action_completed /
+// message_attached have no target value and are never narrowed by `to`.
+func (l CaseLifecycle) carriesTo() bool {
+	switch l {
+	case CaseLifecycleFieldChanged, CaseLifecycleStatusChanged, CaseLifecycleAssigned:
+		return true
+	default:
+		return false
+	}
+}
+
+// CaseChange is the transition a case-domain event describes. Lifecycle is
+// always set; the remaining fields are populated only by the lifecycles that
+// have them:
+//   - field_changed: FieldID, From (previous values), To (new values)
+//   - status_changed: From / To hold the previous and new BoardStatus ID
+//   - assigned: To holds the newly added assignee IDs
+//   - action_completed: ActionID names the completed Action; From / To hold
+//     its previous and new status ID
+//   - message_attached: To holds the permalink of the attached message
+//
+// Multi-valued fields (multi-select, multi-user) are flattened into From / To
+// one element per value; scalar fields produce a single element.
+type CaseChange struct {
+	Lifecycle CaseLifecycle
+	FieldID   string
+	From      []string
+	To        []string
+	ActionID  int64
+}
+
+// CaseLifecycleChange is the CaseChange for a bare lifecycle transition
+// (created / closed) that carries no further detail.
+func CaseLifecycleChange(lc CaseLifecycle) CaseChange {
+	return CaseChange{Lifecycle: lc}
+}
+
 // JobEventDomain enumerates the event domains the dispatcher recognises.
 // Each domain has a distinct filter schema (see CaseEventConfig /
 // ScheduledEventConfig).
@@ -64,27 +127,57 @@ const (
 )
 
 // CaseEventConfig is the listen filter for the `case` event domain. A Job
-// fires when the published Case event's CaseLifecycle is contained in On.
+// fires when the published Case event's CaseLifecycle is contained in On
+// and the event passes the optional Field / To narrowing.
 type CaseEventConfig struct {
 	// On lists the lifecycle events the Job is subscribed to. Always a
 	// normalised, non-empty slice produced by the config loader from the
 	// TOML "on" field. The domain layer never accepts a single-string form
 	// — see config/job.go for the parsing rules.
 	On []CaseLifecycle
+
+	// Field narrows field_changed events to a single custom field ID. Empty
+	// means any field. Other lifecycles in On are not affected by it.
+	Field string
+
+	// To narrows field_changed / status_changed / assigned events to those
+	// whose new value(s) intersect this set (a field option ID, a board
+	// status ID, an assignee's Slack user ID). Empty means any value.
+	// Lifecycles that carry no target value ignore it.
+	To []string
 }
 
-// Matches reports whether the given lifecycle event matches this config's
-// On filter.
+// Matches reports whether the given bare lifecycle event matches this
+// config's On filter. It is shorthand for MatchesChange with no detail.
 func (c *CaseEventConfig) Matches(lc CaseLifecycle) bool {
+	return c.MatchesChange(CaseLifecycleChange(lc))
+}
+
+// MatchesChange reports whether the given change passes this config's On
+// filter and, for the lifecycles they apply to, its Field / To narrowing.
+func (c *CaseEventConfig) MatchesChange(ch CaseChange) bool {
 	if c == nil {
 		return false
 	}
-	return slices.Contains(c.On, lc)
+	if !slices.Contains(c.On, ch.Lifecycle) {
+		return false
+	}
+	if c.Field != "" && ch.Lifecycle == CaseLifecycleFieldChanged && ch.FieldID != c.Field {
+		return false
+	}
+	if len(c.To) > 0 && ch.Lifecycle.carriesTo() {
+		return slices.ContainsFunc(ch.To, func(v string) bool {
+			return slices.Contains(c.To, v)
+		})
+	}
+	return true
 }
 
 // Validate enforces invariants for the case event filter:
 // - On must be non-empty
 // - every value must be a known CaseLifecycle
+// - Field requires field_changed in On
+// - To requires at least one lifecycle in On that carries a target value
 func (c *CaseEventConfig) Validate() error {
 	if c == nil {
 		return goerr.New("case event config is nil")
@@ -104,6 +197,16 @@ func (c *CaseEventConfig) Validate() error {
 		}
 		seen[lc] = struct{}{}
 	}
+	// A filter that can never apply is almost certainly a typo in `on`;
+	// failing loud beats a Job that silently fires on every event.
+	if c.Field != "" && !slices.Contains(c.On, CaseLifecycleFieldChanged) {
+		return goerr.New("events.case.field requires field_changed in on",
+			goerr.V("field", c.Field))
+	}
+	if len(c.To) > 0 && !slices.ContainsFunc(c.On, CaseLifecycle.carriesTo) {
+		return goerr.New("events.case.to requires field_changed, status_changed or assigned in on",
+			goerr.V("to", c.To))
+	}
 	return nil
 }
 
@@ -293,10 +396,16 @@ type Job struct {
 
 // ListensCase reports whether the Job subscribes to the given case lifecycle.
 func (j *Job) ListensCase(lc CaseLifecycle) bool {
+	return j.ListensCaseChange(CaseLifecycleChange(lc))
+}
+
+// ListensCaseChange reports whether the Job subscribes to the given case
+// change, honouring the Field / To narrowing of its case event filter.
+func (j *Job) ListensCaseChange(ch CaseChange) bool {
 	if j == nil || j.Disabled {
 		return false
 	}
-	return j.Events.Case.Matches(lc)
+	return j.Events.Case.MatchesChange(ch)
 }
 
 // ListensScheduled reports whether the Job subscribes to the scheduled domain.
@@ -31,6 +31,35 @@ func (s JobRunStatus) IsValid() bool {
 // String returns the string form for logging.
 func (s JobRunStatus) String() string { return string(s) }
 
+// JobRunLifecycle is a transition of one Job run that is announced outside
+// the run: it started, or it ended in success or failure. Unlike JobRunStage
+// it has no value for a run paused on a question, which is still running as
+// far as anyone outside it is concerned.
+type JobRunLifecycle string
+
+const (
+	JobRunLifecycleStarted   JobRunLifecycle = "started"
+	JobRunLifecycleSucceeded JobRunLifecycle = "succeeded"
+	JobRunLifecycleFailed    JobRunLifecycle = "failed"
+)
+
+// AllJobRunLifecycles returns every valid JobRunLifecycle.
+func AllJobRunLifecycles() []JobRunLifecycle {
+	return []JobRunLifecycle{
+		JobRunLifecycleStarted,
+		JobRunLifecycleSucceeded,
+		JobRunLifecycleFailed,
+	}
+}
+
+// JobRunChange describes a Job run transition. Error is set on a failed run.
+type JobRunChange struct {
+	Lifecycle JobRunLifecycle
+	JobID     string
+	RunID     string
+	Error     string
+}
+
 // JobRunKey identifies a single (workspace, case, job) lock and run-record
 // tuple. The tuple is the lock granularity for both lease acquisition and
 // last-run bookkeeping.
@@ -100,6 +129,12 @@ type JobRun struct {
 	// sweep uses it to expire stale AWAITING_INPUT runs. Zero when not
 	// suspended.
 	SuspendedAt time.Time
+
+	// UpdatedAt is when the record was last written. Repositories stamp it
+	// from their own clock on every write, lease changes included, so an
+	// incremental export can read just the records written after its
+	// watermark. Zero on records written before it existed.
+	UpdatedAt time.Time
 }
 
 // Key returns the composite JobRunKey for this run, reconstructed from
@@ -226,6 +261,15 @@ type JobRunLog struct {
 	// you why this Run happened.
 	EventType      string
 	EventTriggerAt time.Time
+	// EventCaseLifecycle and the fields after it carry the case-domain
+	// trigger's transition and its detail (see CaseChange); they are empty for
+	// other domains. A resumed run rebuilds its Event from them, so the second
+	// turn is prompted with the same trigger reason as the first.
+	EventCaseLifecycle CaseLifecycle
+	EventFieldID       string
+	EventFrom          []string
+	EventTo            []string
+	EventActionID      int64
 
 	// SystemPrompt is held once per Run, here, rather than inside every
 	// LLMRequest event (it doesn't change turn-to-turn within a Run).
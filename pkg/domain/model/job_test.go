@@ -12,6 +12,11 @@ import (
 func TestCaseLifecycle_IsValid(t *testing.T) {
 	gt.Bool(t, model.CaseLifecycleCreated.IsValid()).True()
 	gt.Bool(t, model.CaseLifecycleClosed.IsValid()).True()
+	gt.Bool(t, model.CaseLifecycleFieldChanged.IsValid()).True()
+	gt.Bool(t, model.CaseLifecycleStatusChanged.IsValid()).True()
+	gt.Bool(t, model.CaseLifecycleAssigned.IsValid()).True()
+	gt.Bool(t, model.CaseLifecycleActionCompleted.IsValid()).True()
+	gt.Bool(t, model.CaseLifecycleMessageAttached.IsValid()).True()
 	gt.Bool(t, model.CaseLifecycle("updated").IsValid()).False()
 	gt.Bool(t, model.CaseLifecycle("").IsValid()).False()
 }
@@ -32,6 +37,60 @@ func TestCaseEventConfig_Matches(t *testing.T) {
 	gt.Bool(t, nilCfg.Matches(model.CaseLifecycleCreated)).False()
 }
 
+func TestCaseEventConfig_MatchesChange(t *testing.T) {
+	cfg := &model.CaseEventConfig{
+		On: []model.CaseLifecycle{
+			model.CaseLifecycleFieldChanged,
+			model.CaseLifecycleActionCompleted,
+		},
+		Field: "severity",
+		To:    []string{"critical"},
+	}
+
+	t.Run("field and to both match", func(t *testing.T) {
+		gt.Bool(t, cfg.MatchesChange(model.CaseChange{
+			Lifecycle: model.CaseLifecycleFieldChanged,
+			FieldID:   "severity",
+			From:      []string{"low"},
+			To:        []string{"critical"},
+		})).True()
+	})
+	t.Run("other field is filtered out", func(t *testing.T) {
+		gt.Bool(t, cfg.MatchesChange(model.CaseChange{
+			Lifecycle: model.CaseLifecycleFieldChanged,
+			FieldID:   "owner",
+			To:        []string{"critical"},
+		})).False()
+	})
+	t.Run("other new value is filtered out", func(t *testing.T) {
+		gt.Bool(t, cfg.MatchesChange(model.CaseChange{
+			Lifecycle: model.CaseLifecycleFieldChanged,
+			FieldID:   "severity",
+			To:        []string{"low"},
+		})).False()
+	})
+	t.Run("multi-valued change matches on any element", func(t *testing.T) {
+		gt.Bool(t, cfg.MatchesChange(model.CaseChange{
+			Lifecycle: model.CaseLifecycleFieldChanged,
+			FieldID:   "severity",
+			To:        []string{"low", "critical"},
+		})).True()
+	})
+	t.Run("lifecycle without a target ignores to", func(t *testing.T) {
+		gt.Bool(t, cfg.MatchesChange(model.CaseChange{
+			Lifecycle: model.CaseLifecycleActionCompleted,
+			ActionID:  7,
+			To:        []string{"COMPLETED"},
+		})).True()
+	})
+	t.Run("unsubscribed lifecycle", func(t *testing.T) {
+		gt.Bool(t, cfg.MatchesChange(model.CaseChange{
+			Lifecycle: model.CaseLifecycleAssigned,
+			To:        []string{"critical"},
+		})).False()
+	})
+}
+
 func TestCaseEventConfig_Validate(t *testing.T) {
 	t.Run("ok with one value", func(t *testing.T) {
 		cfg := &model.CaseEventConfig{On: []model.CaseLifecycle{model.CaseLifecycleCreated}}
@@ -57,6 +116,28 @@ func TestCaseEventConfig_Validate(t *testing.T) {
 		}}
 		gt.Error(t, cfg.Validate())
 	})
+	t.Run("field without field_changed", func(t *testing.T) {
+		cfg := &model.CaseEventConfig{
+			On:    []model.CaseLifecycle{model.CaseLifecycleStatusChanged},
+			Field: "severity",
+		}
+		gt.Error(t, cfg.Validate())
+	})
+	t.Run("to without a lifecycle carrying values", func(t *testing.T) {
+		cfg := &model.CaseEventConfig{
+			On: []model.CaseLifecycle{model.CaseLifecycleCreated, model.CaseLifecycleActionCompleted},
+			To: []string{"critical"},
+		}
+		gt.Error(t, cfg.Validate())
+	})
+	t.Run("field and to with field_changed", func(t *testing.T) {
+		cfg := &model.CaseEventConfig{
+			On:    []model.CaseLifecycle{model.CaseLifecycleFieldChanged},
+			Field: "severity",
+			To:    []string{"critical"},
+		}
+		gt.NoError(t, cfg.Validate())
+	})
 	t.Run("nil receiver", func(t *testing.T) {
 		var cfg *model.CaseEventConfig
 		gt.Error(t, cfg.Validate())
@@ -71,8 +71,33 @@ type Knowledge struct {
 	// CreatorID is the Slack user id of the human author. It is empty when the
 	// entry was authored by an agent (system actor).
 	CreatorID string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	// ValidUntil, when set, is the instant the entry stops being true (e.g. an
+	// IoC's shelf life). An expired entry stays readable but is excluded from
+	// agent search unless explicitly requested.
+	ValidUntil *time.Time
+	// ReviewBy, when set, is the date by which the owner should re-check the
+	// entry. It does not hide the entry; it only surfaces it for review.
+	ReviewBy *time.Time
+	// ReviewFlaggedAt is set by the review sweep when it first finds the entry
+	// due (review date reached or expired) and notifies the owner, so each due
+	// date is announced once. Changing ValidUntil or ReviewBy clears it.
+	ReviewFlaggedAt *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// IsExpired reports whether the entry's validity window has closed at now.
+func (k *Knowledge) IsExpired(now time.Time) bool {
+	return k.ValidUntil != nil && !now.Before(*k.ValidUntil)
+}
+
+// IsReviewDue reports whether the entry needs its owner's attention at now:
+// its review date has been reached or it has expired.
+func (k *Knowledge) IsReviewDue(now time.Time) bool {
+	if k.IsExpired(now) {
+		return true
+	}
+	return k.ReviewBy != nil && !now.Before(*k.ReviewBy)
 }
 
 // Validate enforces the structural invariants required before any persistence
@@ -0,0 +1,219 @@
+package model
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+)
+
+// ErrKnowledgeRevisionValidation is returned when a KnowledgeRevision fails its
+// structural invariants.
+var ErrKnowledgeRevisionValidation = goerr.New("knowledge revision validation failed")
+
+// KnowledgeRevisionAction names the write that produced a revision.
+type KnowledgeRevisionAction string
+
+const (
+	// KnowledgeRevisionCreated is the first revision of an entry.
+	KnowledgeRevisionCreated KnowledgeRevisionAction = "created"
+	// KnowledgeRevisionUpdated is an edit through updateKnowledge or the
+	// knowledge__update_knowledge agent tool.
+	KnowledgeRevisionUpdated KnowledgeRevisionAction = "updated"
+	// KnowledgeRevisionRestored is a rollback to an earlier revision;
+	// RestoredFrom names the revision whose content was copied back.
+	KnowledgeRevisionRestored KnowledgeRevisionAction = "restored"
+)
+
+// IsValid reports whether a is one of the known actions.
+func (a KnowledgeRevisionAction) IsValid() bool {
+	switch a {
+	case KnowledgeRevisionCreated, KnowledgeRevisionUpdated, KnowledgeRevisionRestored:
+		return true
+	}
+	return false
+}
+
+// KnowledgeRevision is an immutable snapshot of a Knowledge entry's content as
+// it stood after one write. Revisions are numbered from 1 per entry and are
+// never rewritten: a rollback appends a new revision carrying the old content
+// rather than deleting the newer ones, so the bad edit stays auditable.
+//
+// Only the user-visible content (Title / Claim / TagIDs) is captured. The
+// embedding is derived from it and is regenerated on restore.
+type KnowledgeRevision struct {
+	WorkspaceID string
+	KnowledgeID KnowledgeID
+	Revision    int
+	Action      KnowledgeRevisionAction
+	Title       string
+	Claim       string
+	TagIDs      []TagID
+	// EditorID is the Slack user id of the human who made the change. It is
+	// empty when the change came from an agent.
+	EditorID string
+	// JobID / RunID identify the Job run whose agent made the change. Both are
+	// empty for edits made outside a Job run (Web UI, chat assist).
+	JobID string
+	RunID string
+	// RestoredFrom is the revision whose content a KnowledgeRevisionRestored
+	// revision copied back. Zero for every other action.
+	RestoredFrom int
+	CreatedAt    time.Time
+}
+
+// NewKnowledgeRevision snapshots k's content as revision number revision.
+// Attribution (EditorID / JobID / RunID) is left for the caller to fill in.
+func NewKnowledgeRevision(k *Knowledge, revision int, action KnowledgeRevisionAction, at time.Time) *KnowledgeRevision {
+	return &KnowledgeRevision{
+		WorkspaceID: k.WorkspaceID,
+		KnowledgeID: k.ID,
+		Revision:    revision,
+		Action:      action,
+		Title:       k.Title,
+		Claim:       k.Claim,
+		TagIDs:      slices.Clone(k.TagIDs),
+		CreatedAt:   at,
+	}
+}
+
+// Validate enforces the identity invariants the repository relies on.
+// Repositories MUST call it before every write.
+func (r *KnowledgeRevision) Validate() error {
+	if r == nil {
+		return goerr.Wrap(ErrKnowledgeRevisionValidation, "knowledge revision is nil")
+	}
+	if r.WorkspaceID == "" {
+		return goerr.Wrap(ErrKnowledgeRevisionValidation, "workspace ID is required")
+	}
+	if r.KnowledgeID == "" {
+		return goerr.Wrap(ErrKnowledgeRevisionValidation, "knowledge ID is required")
+	}
+	if r.Revision < 1 {
+		return goerr.Wrap(ErrKnowledgeRevisionValidation, "revision must be positive",
+			goerr.V("revision", r.Revision))
+	}
+	if !r.Action.IsValid() {
+		return goerr.Wrap(ErrKnowledgeRevisionValidation, "unknown revision action",
+			goerr.V("action", r.Action))
+	}
+	if r.Action == KnowledgeRevisionRestored {
+		if r.RestoredFrom < 1 || r.RestoredFrom >= r.Revision {
+			return goerr.Wrap(ErrKnowledgeRevisionValidation, "restored revision must name an earlier revision",
+				goerr.V("revision", r.Revision), goerr.V("restored_from", r.RestoredFrom))
+		}
+	} else if r.RestoredFrom != 0 {
+		return goerr.Wrap(ErrKnowledgeRevisionValidation, "restored_from is only valid on a restored revision",
+			goerr.V("action", r.Action))
+	}
+	if r.CreatedAt.IsZero() {
+		return goerr.Wrap(ErrKnowledgeRevisionValidation, "created_at is required")
+	}
+	return nil
+}
+
+// DiffOp is the kind of a single DiffLine.
+type DiffOp string
+
+const (
+	DiffOpEqual  DiffOp = "equal"
+	DiffOpInsert DiffOp = "insert"
+	DiffOpDelete DiffOp = "delete"
+)
+
+// DiffLine is one line of a line-oriented diff.
+type DiffLine struct {
+	Op   DiffOp
+	Text string
+}
+
+// KnowledgeRevisionDiff compares two revisions of the same entry.
+type KnowledgeRevisionDiff struct {
+	From *KnowledgeRevision
+	To   *KnowledgeRevision
+	// Title and Claim are line diffs from From to To.
+	Title []DiffLine
+	Claim []DiffLine
+	// AddedTagIDs / RemovedTagIDs are the tag references To gained / lost.
+	AddedTagIDs   []TagID
+	RemovedTagIDs []TagID
+}
+
+// DiffKnowledgeRevisions compares from and to.
+func DiffKnowledgeRevisions(from, to *KnowledgeRevision) *KnowledgeRevisionDiff {
+	return &KnowledgeRevisionDiff{
+		From:          from,
+		To:            to,
+		Title:         DiffLines(from.Title, to.Title),
+		Claim:         DiffLines(from.Claim, to.Claim),
+		AddedTagIDs:   tagsMissingFrom(to.TagIDs, from.TagIDs),
+		RemovedTagIDs: tagsMissingFrom(from.TagIDs, to.TagIDs),
+	}
+}
+
+// tagsMissingFrom returns the ids of src that are not in other, in src order.
+func tagsMissingFrom(src, other []TagID) []TagID {
+	out := make([]TagID, 0)
+	for _, id := range src {
+		if !slices.Contains(other, id) {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// DiffLines returns a minimal line diff turning a into b, computed from their
+// longest common subsequence. Within a change, deletions precede insertions.
+// Claims are bounded by MaxClaimLength, so the quadratic table stays small.
+func DiffLines(a, b string) []DiffLine {
+	x, y := splitLines(a), splitLines(b)
+
+	// lcs[i][j] is the LCS length of x[i:] and y[j:].
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	out := make([]DiffLine, 0, max(len(x), len(y)))
+	i, j := 0, 0
+	for i < len(x) && j < len(y) {
+		switch {
+		case x[i] == y[j]:
+			out = append(out, DiffLine{Op: DiffOpEqual, Text: x[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			out = append(out, DiffLine{Op: DiffOpDelete, Text: x[i]})
+			i++
+		default:
+			out = append(out, DiffLine{Op: DiffOpInsert, Text: y[j]})
+			j++
+		}
+	}
+	for ; i < len(x); i++ {
+		out = append(out, DiffLine{Op: DiffOpDelete, Text: x[i]})
+	}
+	for ; j < len(y); j++ {
+		out = append(out, DiffLine{Op: DiffOpInsert, Text: y[j]})
+	}
+	return out
+}
+
+// splitLines splits s on "\n". The empty string has no lines, so adding a
+// first line diffs as a single insertion.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
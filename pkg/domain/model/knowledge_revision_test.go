@@ -0,0 +1,110 @@
+package model_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+func TestKnowledgeRevisionValidate(t *testing.T) {
+	now := time.Now()
+	valid := func() *model.KnowledgeRevision {
+		return model.NewKnowledgeRevision(&model.Knowledge{
+			ID:          model.NewKnowledgeID(),
+			WorkspaceID: "ws-1",
+			Title:       "title",
+			TagIDs:      []model.TagID{model.NewTagID()},
+		}, 2, model.KnowledgeRevisionUpdated, now)
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		gt.NoError(t, valid().Validate())
+	})
+
+	t.Run("valid restore", func(t *testing.T) {
+		r := valid()
+		r.Action = model.KnowledgeRevisionRestored
+		r.RestoredFrom = 1
+		gt.NoError(t, r.Validate())
+	})
+
+	cases := map[string]func(r *model.KnowledgeRevision){
+		"missing workspace":       func(r *model.KnowledgeRevision) { r.WorkspaceID = "" },
+		"missing knowledge":       func(r *model.KnowledgeRevision) { r.KnowledgeID = "" },
+		"zero revision":           func(r *model.KnowledgeRevision) { r.Revision = 0 },
+		"unknown action":          func(r *model.KnowledgeRevision) { r.Action = "edited" },
+		"missing created_at":      func(r *model.KnowledgeRevision) { r.CreatedAt = time.Time{} },
+		"restored_from on update": func(r *model.KnowledgeRevision) { r.RestoredFrom = 1 },
+		"restore without source":  func(r *model.KnowledgeRevision) { r.Action = model.KnowledgeRevisionRestored },
+		"restore from later revision": func(r *model.KnowledgeRevision) {
+			r.Action = model.KnowledgeRevisionRestored
+			r.RestoredFrom = r.Revision
+		},
+	}
+	for name, mutate := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := valid()
+			mutate(r)
+			err := r.Validate()
+			gt.Error(t, err)
+			gt.Bool(t, errors.Is(err, model.ErrKnowledgeRevisionValidation)).True()
+		})
+	}
+
+	t.Run("nil", func(t *testing.T) {
+		var r *model.KnowledgeRevision
+		gt.Error(t, r.Validate())
+	})
+}
+
+func TestNewKnowledgeRevision_copiesTags(t *testing.T) {
+	k := &model.Knowledge{ID: model.NewKnowledgeID(), WorkspaceID: "ws", TagIDs: []model.TagID{"a"}}
+	r := model.NewKnowledgeRevision(k, 1, model.KnowledgeRevisionCreated, time.Now())
+	k.TagIDs[0] = "b"
+	gt.Value(t, r.TagIDs).Equal([]model.TagID{"a"})
+}
+
+func TestDiffLines(t *testing.T) {
+	eq := func(s string) model.DiffLine { return model.DiffLine{Op: model.DiffOpEqual, Text: s} }
+	ins := func(s string) model.DiffLine { return model.DiffLine{Op: model.DiffOpInsert, Text: s} }
+	del := func(s string) model.DiffLine { return model.DiffLine{Op: model.DiffOpDelete, Text: s} }
+
+	t.Run("identical", func(t *testing.T) {
+		gt.Value(t, model.DiffLines("a\nb", "a\nb")).Equal([]model.DiffLine{eq("a"), eq("b")})
+	})
+
+	t.Run("both empty", func(t *testing.T) {
+		gt.Array(t, model.DiffLines("", "")).Length(0)
+	})
+
+	t.Run("from empty", func(t *testing.T) {
+		gt.Value(t, model.DiffLines("", "a")).Equal([]model.DiffLine{ins("a")})
+	})
+
+	t.Run("changed middle line", func(t *testing.T) {
+		gt.Value(t, model.DiffLines("a\nb\nc", "a\nx\nc")).
+			Equal([]model.DiffLine{eq("a"), del("b"), ins("x"), eq("c")})
+	})
+
+	t.Run("appended and removed lines", func(t *testing.T) {
+		gt.Value(t, model.DiffLines("a\nb\nc", "b\nc\nd")).
+			Equal([]model.DiffLine{del("a"), eq("b"), eq("c"), ins("d")})
+	})
+}
+
+func TestDiffKnowledgeRevisions(t *testing.T) {
+	from := &model.KnowledgeRevision{Revision: 1, Title: "t", Claim: "old", TagIDs: []model.TagID{"a", "b"}}
+	to := &model.KnowledgeRevision{Revision: 2, Title: "t", Claim: "new", TagIDs: []model.TagID{"b", "c"}}
+
+	d := model.DiffKnowledgeRevisions(from, to)
+	gt.Value(t, d.Title).Equal([]model.DiffLine{{Op: model.DiffOpEqual, Text: "t"}})
+	gt.Value(t, d.Claim).Equal([]model.DiffLine{
+		{Op: model.DiffOpDelete, Text: "old"},
+		{Op: model.DiffOpInsert, Text: "new"},
+	})
+	gt.Value(t, d.AddedTagIDs).Equal([]model.TagID{"c"})
+	gt.Value(t, d.RemovedTagIDs).Equal([]model.TagID{"a"})
+}
@@ -0,0 +1,159 @@
+package model
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// KnowledgeSearchHit is one ranked result of a knowledge search.
+type KnowledgeSearchHit struct {
+	Knowledge *Knowledge
+	// Score is the final ranking score: the fused lexical / vector score plus
+	// the rerank bonus. Only comparable within one search.
+	Score float64
+	// LexicalScore is the entry's BM25 score normalized to [0, 1] against the
+	// best match of the same search.
+	LexicalScore float64
+	// VectorScore is the cosine similarity of the query and entry embeddings,
+	// clamped to [0, 1]. Nil when either embedding was unavailable.
+	VectorScore *float64
+}
+
+// BM25 parameters. The usual defaults: k1 bounds how much repeated occurrences
+// of a term keep adding, b how strongly long entries are penalized.
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// searchJoiners are the punctuation runes kept inside a token when they sit
+// between letters or digits, so an identifier survives as one term:
+// "db-01.prod.internal", "SEC-1234", "svc_account", "10.0.0.1", "a@b.com".
+const searchJoiners = "-_.:/@"
+
+// TokenizeSearchText splits text into lowercase search terms.
+//
+// Embeddings blur exactly the tokens a security team searches for verbatim —
+// hostnames, ticket IDs, account names — so the tokenizer keeps them whole: a
+// run of letters and digits joined by any of searchJoiners is one term, and its
+// joiner-separated parts are emitted after it as well so "db-01" still matches
+// "db-01.prod.internal". Scripts written without spaces (CJK) are split into
+// overlapping character bigrams.
+func TokenizeSearchText(text string) []string {
+	var terms []string
+	runes := []rune(strings.ToLower(text))
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case isCJK(r):
+			j := i
+			for j < len(runes) && isCJK(runes[j]) {
+				j++
+			}
+			terms = append(terms, cjkBigrams(runes[i:j])...)
+			i = j
+		case isWordRune(r):
+			j := i
+			for j < len(runes) {
+				if isWordRune(runes[j]) {
+					j++
+					continue
+				}
+				// A joiner only continues the token when a word rune follows it.
+				if strings.ContainsRune(searchJoiners, runes[j]) && j+1 < len(runes) && isWordRune(runes[j+1]) {
+					j++
+					continue
+				}
+				break
+			}
+			terms = append(terms, compoundTerms(string(runes[i:j]))...)
+			i = j
+		default:
+			i++
+		}
+	}
+	return terms
+}
+
+func isWordRune(r rune) bool {
+	return (unicode.IsLetter(r) || unicode.IsDigit(r)) && !isCJK(r)
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// compoundTerms returns word followed by its joiner-separated parts, when it
+// has more than one.
+func compoundTerms(word string) []string {
+	parts := strings.FieldsFunc(word, func(r rune) bool { return strings.ContainsRune(searchJoiners, r) })
+	if len(parts) <= 1 {
+		return []string{word}
+	}
+	return append([]string{word}, parts...)
+}
+
+// cjkBigrams returns the overlapping bigrams of run, or run itself when it is a
+// single character.
+func cjkBigrams(run []rune) []string {
+	if len(run) == 1 {
+		return []string{string(run)}
+	}
+	out := make([]string, 0, len(run)-1)
+	for i := 0; i+1 < len(run); i++ {
+		out = append(out, string(run[i:i+2]))
+	}
+	return out
+}
+
+// BM25Scores scores every document in docs against query with Okapi BM25,
+// using docs itself as the corpus for document frequencies and average length.
+// Each document is a list of terms (see TokenizeSearchText); a term listed
+// twice counts twice, which is how a caller weights a field. A repeated query
+// term is scored once.
+func BM25Scores(query []string, docs [][]string) []float64 {
+	scores := make([]float64, len(docs))
+	if len(query) == 0 || len(docs) == 0 {
+		return scores
+	}
+
+	tf := make([]map[string]int, len(docs))
+	df := make(map[string]int)
+	var totalLen int
+	for i, doc := range docs {
+		tf[i] = make(map[string]int, len(doc))
+		for _, term := range doc {
+			tf[i][term]++
+		}
+		for term := range tf[i] {
+			df[term]++
+		}
+		totalLen += len(doc)
+	}
+	avgLen := float64(totalLen) / float64(len(docs))
+	if avgLen == 0 {
+		return scores
+	}
+
+	n := float64(len(docs))
+	seen := make(map[string]bool, len(query))
+	for _, term := range query {
+		if seen[term] || df[term] == 0 {
+			continue
+		}
+		seen[term] = true
+		d := float64(df[term])
+		idf := math.Log(1 + (n-d+0.5)/(d+0.5))
+		for i, doc := range docs {
+			f := float64(tf[i][term])
+			if f == 0 {
+				continue
+			}
+			norm := 1 - bm25B + bm25B*float64(len(doc))/avgLen
+			scores[i] += idf * f * (bm25K1 + 1) / (f + bm25K1*norm)
+		}
+	}
+	return scores
+}
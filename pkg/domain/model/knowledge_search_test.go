@@ -0,0 +1,91 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+func TestTokenizeSearchText(t *testing.T) {
+	cases := map[string]struct {
+		in   string
+		want []string
+	}{
+		"words are lowercased": {
+			in:   "Rotate the GitHub token",
+			want: []string{"rotate", "the", "github", "token"},
+		},
+		"hostname kept whole, then its parts": {
+			in:   "see db-01.prod.internal.",
+			want: []string{"see", "db-01.prod.internal", "db", "01", "prod", "internal"},
+		},
+		"ticket id": {
+			in:   "(SEC-1234)",
+			want: []string{"sec-1234", "sec", "1234"},
+		},
+		"trailing joiner is punctuation": {
+			in:   "done- next",
+			want: []string{"done", "next"},
+		},
+		"CJK bigrams": {
+			in:   "脆弱性診断",
+			want: []string{"脆弱", "弱性", "性診", "診断"},
+		},
+		"single CJK character": {
+			in:   "a 件 b",
+			want: []string{"a", "件", "b"},
+		},
+		"mixed scripts": {
+			in:   "SEC-1234の対応",
+			want: []string{"sec-1234", "sec", "1234", "の対", "対応"},
+		},
+		"empty": {
+			in:   "  ",
+			want: nil,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gt.Value(t, model.TokenizeSearchText(tc.in)).Equal(tc.want)
+		})
+	}
+}
+
+func TestBM25Scores(t *testing.T) {
+	docs := [][]string{
+		{"github", "policy"},
+		{"github", "runner", "sec-1234"},
+		{"npm", "policy"},
+	}
+
+	t.Run("no matching term scores zero", func(t *testing.T) {
+		scores := model.BM25Scores([]string{"jira"}, docs)
+		gt.Value(t, scores).Equal([]float64{0, 0, 0})
+	})
+
+	t.Run("rare term outweighs common term", func(t *testing.T) {
+		scores := model.BM25Scores([]string{"github", "sec-1234"}, docs)
+		gt.Bool(t, scores[1] > scores[0]).True()
+		gt.Number(t, scores[2]).Equal(0)
+	})
+
+	t.Run("repeated query term counts once", func(t *testing.T) {
+		once := model.BM25Scores([]string{"npm"}, docs)
+		twice := model.BM25Scores([]string{"npm", "npm"}, docs)
+		gt.Value(t, twice).Equal(once)
+	})
+
+	t.Run("repeated document term raises the score", func(t *testing.T) {
+		scores := model.BM25Scores([]string{"github"}, [][]string{
+			{"github", "github", "x"},
+			{"github", "y", "z"},
+		})
+		gt.Bool(t, scores[0] > scores[1]).True()
+	})
+
+	t.Run("empty inputs", func(t *testing.T) {
+		gt.Value(t, model.BM25Scores(nil, docs)).Equal([]float64{0, 0, 0})
+		gt.Array(t, model.BM25Scores([]string{"x"}, nil)).Length(0)
+	})
+}
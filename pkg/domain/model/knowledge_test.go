@@ -131,3 +131,31 @@ func TestNormalizeTagIDs(t *testing.T) {
 		gt.Value(t, got[1]).Equal(id1)
 	})
 }
+
+func TestKnowledgeReviewState(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	cases := map[string]struct {
+		k         model.Knowledge
+		expired   bool
+		reviewDue bool
+	}{
+		"no dates":             {model.Knowledge{}, false, false},
+		"valid in the future":  {model.Knowledge{ValidUntil: &future}, false, false},
+		"valid until now":      {model.Knowledge{ValidUntil: &now}, true, true},
+		"expired":              {model.Knowledge{ValidUntil: &past}, true, true},
+		"review in the future": {model.Knowledge{ReviewBy: &future}, false, false},
+		"review reached":       {model.Knowledge{ReviewBy: &past}, false, true},
+		"expired before review date": {
+			model.Knowledge{ValidUntil: &past, ReviewBy: &future}, true, true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gt.Value(t, tc.k.IsExpired(now)).Equal(tc.expired)
+			gt.Value(t, tc.k.IsReviewDue(now)).Equal(tc.reviewDue)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package model
+
+import (
+	"strconv"
+	"time"
+)
+
+// LiveEventKind names the change a LiveEvent announces.
+type LiveEventKind string
+
+const (
+	LiveEventCaseCreated   LiveEventKind = "case_created"
+	LiveEventCaseUpdated   LiveEventKind = "case_updated"
+	LiveEventCaseDeleted   LiveEventKind = "case_deleted"
+	LiveEventActionCreated LiveEventKind = "action_created"
+	LiveEventActionUpdated LiveEventKind = "action_updated"
+	LiveEventActionDeleted LiveEventKind = "action_deleted"
+	LiveEventJobRunEvent   LiveEventKind = "job_run_event"
+)
+
+// LiveEvent is the notification published when a Case, an Action or a
+// JobRunEvent is written, and delivered to the GraphQL subscriptions watching
+// it. It identifies what changed but carries none of its content: a
+// subscriber re-reads the entity through the usecase layer, so the access
+// checks a query would apply also decide what a subscriber sees.
+type LiveEvent struct {
+	Kind        LiveEventKind
+	WorkspaceID string
+	CaseID      int64
+	// ActionID is set on action events.
+	ActionID int64
+	// RunID and Sequence are set on job run events.
+	RunID    string
+	Sequence int64
+	// Origin is the ID of the instance that published the event, so an
+	// instance can skip its own events when they come back through the
+	// cross-instance relay.
+	Origin      string
+	PublishedAt time.Time
+}
+
+// LiveChange is what happened to the entity a LiveEvent names.
+type LiveChange string
+
+const (
+	LiveChangeCreated LiveChange = "created"
+	LiveChangeUpdated LiveChange = "updated"
+	LiveChangeDeleted LiveChange = "deleted"
+)
+
+// Change classifies the event's kind. A job run event is always a creation.
+func (e *LiveEvent) Change() LiveChange {
+	switch e.Kind {
+	case LiveEventCaseUpdated, LiveEventActionUpdated:
+		return LiveChangeUpdated
+	case LiveEventCaseDeleted, LiveEventActionDeleted:
+		return LiveChangeDeleted
+	default:
+		return LiveChangeCreated
+	}
+}
+
+// Topic is the subscription topic the event is delivered on.
+func (e *LiveEvent) Topic() string {
+	switch e.Kind {
+	case LiveEventCaseCreated, LiveEventCaseUpdated, LiveEventCaseDeleted:
+		return CaseLiveTopic(e.WorkspaceID)
+	case LiveEventActionCreated, LiveEventActionUpdated, LiveEventActionDeleted:
+		return ActionLiveTopic(e.WorkspaceID, e.CaseID)
+	case LiveEventJobRunEvent:
+		return JobRunLiveTopic(e.WorkspaceID, e.CaseID, e.RunID)
+	default:
+		return ""
+	}
+}
+
+// WorkspaceTopic is the workspace-wide topic a case or action event is also
+// delivered on, or "" for a job run event.
+func (e *LiveEvent) WorkspaceTopic() string {
+	switch e.Kind {
+	case LiveEventCaseCreated, LiveEventCaseUpdated, LiveEventCaseDeleted,
+		LiveEventActionCreated, LiveEventActionUpdated, LiveEventActionDeleted:
+		return WorkspaceLiveTopic(e.WorkspaceID)
+	default:
+		return ""
+	}
+}
+
+// WorkspaceLiveTopic carries every case and action change in a workspace, for
+// watchers that follow more than one case (the Slack App Home).
+func WorkspaceLiveTopic(workspaceID string) string {
+	return "workspace/" + workspaceID
+}
+
+// CaseLiveTopic carries every case change in a workspace.
+func CaseLiveTopic(workspaceID string) string {
+	return "cases/" + workspaceID
+}
+
+// ActionLiveTopic carries every change to the actions of one case.
+func ActionLiveTopic(workspaceID string, caseID int64) string {
+	return "actions/" + workspaceID + "/" + strconv.FormatInt(caseID, 10)
+}
+
+// JobRunLiveTopic carries the events appended to one job run.
+func JobRunLiveTopic(workspaceID string, caseID int64, runID string) string {
+	return "job_runs/" + workspaceID + "/" + strconv.FormatInt(caseID, 10) + "/" + runID
+}
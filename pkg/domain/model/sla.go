@@ -0,0 +1,252 @@
+package model
+
+import (
+	"slices"
+	"time"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+)
+
+// SLAKind names one of the two clocks an SLA rule can set on a Case.
+type SLAKind string
+
+const (
+	// SLAKindResponse runs from the case opening until its first response:
+	// the first Action in channel mode, the first board status change in
+	// thread mode, or the case closing, whichever comes first.
+	SLAKindResponse SLAKind = "response"
+	// SLAKindResolution runs from the case opening until it is closed.
+	SLAKindResolution SLAKind = "resolution"
+)
+
+// SLAPolicy is a workspace's response and resolution targets, from its [sla]
+// section.
+type SLAPolicy struct {
+	// Rules are tried in order; the first one matching a case applies.
+	Rules []SLARule
+	// PauseStatuses are the board status ids (thread mode) during which the
+	// clocks stop, e.g. while waiting on the reporter.
+	PauseStatuses []string
+}
+
+// SLARule sets the targets for the cases whose select (or multi-select)
+// field FieldID holds the option Value. A zero target sets no clock.
+type SLARule struct {
+	FieldID    string
+	Value      string
+	Response   time.Duration
+	Resolution time.Duration
+}
+
+// CaseSLA holds the timestamps a Case's SLA clocks are computed from. They
+// are kept up to date whether or not the workspace has an [sla] section, so
+// adding one later applies to the cases already open.
+type CaseSLA struct {
+	// StartedAt is when the clocks started: set when a draft is submitted.
+	// Nil means the case was opened when it was created (CreatedAt).
+	StartedAt *time.Time
+	// RespondedAt is the first response; nil until there is one.
+	RespondedAt *time.Time
+	// ResolvedAt is when the case was last closed; cleared on reopen.
+	ResolvedAt *time.Time
+	// Pauses are the intervals the clocks did not run, oldest first: the
+	// time spent in a pause status, and the time a reopened case was closed.
+	// Only the last one can still be open.
+	Pauses []SLAPause
+}
+
+// SLAPause is one interval during which the SLA clocks did not run. To is
+// nil while the pause lasts.
+type SLAPause struct {
+	From time.Time
+	To   *time.Time
+}
+
+// SLAClock is the state of one SLA clock of a case at some instant.
+type SLAClock struct {
+	Kind SLAKind
+	// Target is how long the clock may run.
+	Target time.Duration
+	// Elapsed is how long it has run, pauses excluded.
+	Elapsed time.Duration
+	// DueAt is when the clock reaches its target if it keeps running
+	// without another pause; for a stopped clock, when it would have.
+	DueAt time.Time
+	// StoppedAt is when the clock stopped (response or resolution), nil
+	// while it runs.
+	StoppedAt *time.Time
+	// Paused reports a running clock currently in a pause.
+	Paused bool
+	// Breached reports that Elapsed exceeds Target.
+	Breached bool
+}
+
+// SLABreach records that a case missed one of its SLA targets. A case has at
+// most one breach per kind.
+type SLABreach struct {
+	WorkspaceID string
+	CaseID      int64
+	Kind        SLAKind
+	// FieldID and Value identify the rule that set the target.
+	FieldID string
+	Value   string
+	Target  time.Duration
+	// DueAt is when the target was reached, as the clock stood when the
+	// breach was recorded.
+	DueAt      time.Time
+	RecordedAt time.Time
+}
+
+// SLAStart returns the instant c's SLA clocks started.
+func (c *Case) SLAStart() time.Time {
+	if c.SLA.StartedAt != nil {
+		return *c.SLA.StartedAt
+	}
+	return c.CreatedAt
+}
+
+// MarkSLAResponse records at as c's first response. It reports false, and
+// changes nothing, when a response was already recorded.
+func (c *Case) MarkSLAResponse(at time.Time) bool {
+	if c.SLA.RespondedAt != nil {
+		return false
+	}
+	c.SLA.RespondedAt = &at
+	return true
+}
+
+// TrackSLA updates c's SLA timestamps after its Status or BoardStatus
+// changed at at. Closing records the resolution (and a response, when there
+// was none); reopening turns the closed time into a pause; entering or
+// leaving one of policy's pause statuses opens or closes a pause. policy may
+// be nil, in which case no board status pauses.
+func (c *Case) TrackSLA(at time.Time, policy *SLAPolicy) {
+	if c.IsDraft() {
+		return
+	}
+	if c.Status.Normalize() == types.CaseStatusClosed {
+		c.MarkSLAResponse(at)
+		c.endSLAPause(at)
+		if c.SLA.ResolvedAt == nil {
+			c.SLA.ResolvedAt = &at
+		}
+		return
+	}
+
+	if c.SLA.ResolvedAt != nil {
+		to := at
+		c.SLA.Pauses = append(c.SLA.Pauses, SLAPause{From: *c.SLA.ResolvedAt, To: &to})
+		c.SLA.ResolvedAt = nil
+	}
+	switch paused := policy.IsPauseStatus(c.BoardStatus); {
+	case paused && !c.inSLAPause():
+		c.SLA.Pauses = append(c.SLA.Pauses, SLAPause{From: at})
+	case !paused:
+		c.endSLAPause(at)
+	}
+}
+
+func (c *Case) inSLAPause() bool {
+	n := len(c.SLA.Pauses)
+	return n > 0 && c.SLA.Pauses[n-1].To == nil
+}
+
+func (c *Case) endSLAPause(at time.Time) {
+	if c.inSLAPause() {
+		c.SLA.Pauses[len(c.SLA.Pauses)-1].To = &at
+	}
+}
+
+// slaPausedBetween returns how much of [from, to) c spent in pauses; a pause
+// still open counts as lasting until to.
+func (c *Case) slaPausedBetween(from, to time.Time) time.Duration {
+	var total time.Duration
+	for _, p := range c.SLA.Pauses {
+		start, end := p.From, to
+		if p.To != nil {
+			end = *p.To
+		}
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		if end.After(start) {
+			total += end.Sub(start)
+		}
+	}
+	return total
+}
+
+// IsPauseStatus reports whether the board status id stops the SLA clocks.
+func (p *SLAPolicy) IsPauseStatus(id string) bool {
+	return p != nil && id != "" && slices.Contains(p.PauseStatuses, id)
+}
+
+// RuleFor returns the first rule matching c's field values, or nil.
+func (p *SLAPolicy) RuleFor(c *Case) *SLARule {
+	if p == nil || c == nil {
+		return nil
+	}
+	for i := range p.Rules {
+		r := &p.Rules[i]
+		if fv, ok := c.FieldValues[r.FieldID]; ok && fieldValueHasOption(fv, r.Value) {
+			return r
+		}
+	}
+	return nil
+}
+
+// Clocks returns c's SLA clocks at now, response first. It is empty when no
+// rule matches c, and for drafts, whose clocks have not started.
+func (p *SLAPolicy) Clocks(c *Case, now time.Time) []SLAClock {
+	rule := p.RuleFor(c)
+	if rule == nil || c.IsDraft() {
+		return nil
+	}
+	var clocks []SLAClock
+	if rule.Response > 0 {
+		clocks = append(clocks, c.slaClock(SLAKindResponse, rule.Response, c.SLA.RespondedAt, now))
+	}
+	if rule.Resolution > 0 {
+		clocks = append(clocks, c.slaClock(SLAKindResolution, rule.Resolution, c.SLA.ResolvedAt, now))
+	}
+	return clocks
+}
+
+func (c *Case) slaClock(kind SLAKind, target time.Duration, stoppedAt *time.Time, now time.Time) SLAClock {
+	start, at := c.SLAStart(), now
+	if stoppedAt != nil {
+		at = *stoppedAt
+	}
+	elapsed := max(at.Sub(start)-c.slaPausedBetween(start, at), 0)
+	return SLAClock{
+		Kind:      kind,
+		Target:    target,
+		Elapsed:   elapsed,
+		DueAt:     at.Add(target - elapsed),
+		StoppedAt: stoppedAt,
+		Paused:    stoppedAt == nil && c.inSLAPause(),
+		Breached:  elapsed > target,
+	}
+}
+
+// fieldValueHasOption reports whether a select value is option, or a
+// multi-select value contains it. Multi-select values decoded from storage
+// may come back as []any.
+func fieldValueHasOption(fv FieldValue, option string) bool {
+	switch v := fv.Value.(type) {
+	case string:
+		return v == option
+	case []string:
+		return slices.Contains(v, option)
+	case []any:
+		for _, e := range v {
+			if s, ok := e.(string); ok && s == option {
+				return true
+			}
+		}
+	}
+	return false
+}
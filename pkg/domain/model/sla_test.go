@@ -0,0 +1,147 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+)
+
+func TestSLAPolicy_RuleFor(t *testing.T) {
+	policy := &model.SLAPolicy{Rules: []model.SLARule{
+		{FieldID: "severity", Value: "critical", Response: time.Hour},
+		{FieldID: "tags", Value: "customer", Response: 4 * time.Hour},
+		{FieldID: "severity", Value: "critical", Response: 8 * time.Hour},
+	}}
+	caseWith := func(fields map[string]model.FieldValue) *model.Case {
+		return &model.Case{FieldValues: fields}
+	}
+
+	t.Run("first matching rule wins", func(t *testing.T) {
+		rule := policy.RuleFor(caseWith(map[string]model.FieldValue{
+			"severity": {FieldID: "severity", Type: types.FieldTypeSelect, Value: "critical"},
+			"tags":     {FieldID: "tags", Type: types.FieldTypeMultiSelect, Value: []string{"customer"}},
+		}))
+		gt.Value(t, rule).NotNil().Required()
+		gt.Value(t, rule.Response).Equal(time.Hour)
+	})
+
+	t.Run("multi-select decoded from storage", func(t *testing.T) {
+		rule := policy.RuleFor(caseWith(map[string]model.FieldValue{
+			"tags": {FieldID: "tags", Type: types.FieldTypeMultiSelect, Value: []any{"internal", "customer"}},
+		}))
+		gt.Value(t, rule).NotNil().Required()
+		gt.Value(t, rule.Response).Equal(4 * time.Hour)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		gt.Value(t, policy.RuleFor(caseWith(map[string]model.FieldValue{
+			"severity": {FieldID: "severity", Type: types.FieldTypeSelect, Value: "low"},
+		}))).Nil()
+		gt.Value(t, policy.RuleFor(caseWith(nil))).Nil()
+	})
+
+	t.Run("nil policy", func(t *testing.T) {
+		var p *model.SLAPolicy
+		gt.Value(t, p.RuleFor(caseWith(nil))).Nil()
+	})
+}
+
+func TestSLAPolicy_Clocks(t *testing.T) {
+	opened := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	policy := &model.SLAPolicy{
+		Rules: []model.SLARule{
+			{FieldID: "severity", Value: "critical", Response: time.Hour, Resolution: 24 * time.Hour},
+		},
+		PauseStatuses: []string{"waiting"},
+	}
+	newCase := func() *model.Case {
+		return &model.Case{
+			Status:    types.CaseStatusOpen,
+			CreatedAt: opened,
+			FieldValues: map[string]model.FieldValue{
+				"severity": {FieldID: "severity", Type: types.FieldTypeSelect, Value: "critical"},
+			},
+		}
+	}
+
+	t.Run("running clocks", func(t *testing.T) {
+		clocks := policy.Clocks(newCase(), opened.Add(30*time.Minute))
+		gt.Array(t, clocks).Length(2).Required()
+		gt.Value(t, clocks[0].Kind).Equal(model.SLAKindResponse)
+		gt.Value(t, clocks[0].Elapsed).Equal(30 * time.Minute)
+		gt.Value(t, clocks[0].DueAt).Equal(opened.Add(time.Hour))
+		gt.Bool(t, clocks[0].Breached).False()
+		gt.Value(t, clocks[1].Kind).Equal(model.SLAKindResolution)
+		gt.Value(t, clocks[1].DueAt).Equal(opened.Add(24 * time.Hour))
+	})
+
+	t.Run("late response stops the clock breached", func(t *testing.T) {
+		c := newCase()
+		gt.Bool(t, c.MarkSLAResponse(opened.Add(2*time.Hour))).True()
+		gt.Bool(t, c.MarkSLAResponse(opened.Add(3*time.Hour))).False()
+
+		clocks := policy.Clocks(c, opened.Add(5*time.Hour))
+		gt.Value(t, clocks[0].Elapsed).Equal(2 * time.Hour)
+		gt.Value(t, clocks[0].StoppedAt).NotNil()
+		gt.Bool(t, clocks[0].Breached).True()
+		gt.Bool(t, clocks[1].Breached).False()
+	})
+
+	t.Run("pause status stops the clocks", func(t *testing.T) {
+		c := newCase()
+		c.BoardStatus = "waiting"
+		c.TrackSLA(opened.Add(30*time.Minute), policy)
+
+		clocks := policy.Clocks(c, opened.Add(3*time.Hour))
+		gt.Value(t, clocks[0].Elapsed).Equal(30 * time.Minute)
+		gt.Bool(t, clocks[0].Paused).True()
+		gt.Bool(t, clocks[0].Breached).False()
+		// The due time moves with the pause.
+		gt.Value(t, clocks[0].DueAt).Equal(opened.Add(3*time.Hour + 30*time.Minute))
+
+		c.BoardStatus = "triage"
+		c.TrackSLA(opened.Add(3*time.Hour), policy)
+		clocks = policy.Clocks(c, opened.Add(4*time.Hour))
+		gt.Value(t, clocks[0].Elapsed).Equal(90 * time.Minute)
+		gt.Bool(t, clocks[0].Paused).False()
+		gt.Bool(t, clocks[0].Breached).True()
+	})
+
+	t.Run("closing resolves and responds, reopening resumes", func(t *testing.T) {
+		c := newCase()
+		c.Status = types.CaseStatusClosed
+		c.TrackSLA(opened.Add(45*time.Minute), policy)
+		gt.Value(t, c.SLA.RespondedAt).NotNil()
+		gt.Value(t, c.SLA.ResolvedAt).NotNil()
+
+		clocks := policy.Clocks(c, opened.Add(48*time.Hour))
+		gt.Bool(t, clocks[0].Breached).False()
+		gt.Bool(t, clocks[1].Breached).False()
+
+		// Reopened a day later: the closed day does not count.
+		c.Status = types.CaseStatusOpen
+		c.TrackSLA(opened.Add(24*time.Hour+45*time.Minute), policy)
+		gt.Value(t, c.SLA.ResolvedAt).Nil()
+		clocks = policy.Clocks(c, opened.Add(25*time.Hour+45*time.Minute))
+		gt.Value(t, clocks[1].Elapsed).Equal(105 * time.Minute)
+		gt.Value(t, clocks[1].StoppedAt).Nil()
+	})
+
+	t.Run("submitted draft starts at submission", func(t *testing.T) {
+		c := newCase()
+		submitted := opened.Add(72 * time.Hour)
+		c.SLA.StartedAt = &submitted
+		clocks := policy.Clocks(c, submitted.Add(30*time.Minute))
+		gt.Value(t, clocks[0].Elapsed).Equal(30 * time.Minute)
+	})
+
+	t.Run("no clocks for drafts or unmatched cases", func(t *testing.T) {
+		draft := newCase()
+		draft.Status = types.CaseStatusDraft
+		gt.Array(t, policy.Clocks(draft, opened.Add(time.Hour))).Length(0)
+		gt.Array(t, policy.Clocks(&model.Case{CreatedAt: opened}, opened.Add(time.Hour))).Length(0)
+	})
+}
@@ -0,0 +1,347 @@
+package model
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/m-mizutani/goerr/v2"
+)
+
+// WebhookEventType names an event a workspace webhook can subscribe to. The
+// case events mirror the CaseLifecycle a Job listens on and the job_run
+// events the JobRunLifecycle of a Job run, each prefixed with its domain so
+// the two cannot collide.
+type WebhookEventType string
+
+const (
+	WebhookEventCaseCreated         WebhookEventType = "case.created"
+	WebhookEventCaseClosed          WebhookEventType = "case.closed"
+	WebhookEventCaseFieldChanged    WebhookEventType = "case.field_changed"
+	WebhookEventCaseStatusChanged   WebhookEventType = "case.status_changed"
+	WebhookEventCaseAssigned        WebhookEventType = "case.assigned"
+	WebhookEventCaseActionCompleted WebhookEventType = "case.action_completed"
+	WebhookEventCaseMessageAttached WebhookEventType = "case.message_attached"
+
+	WebhookEventJobRunStarted   WebhookEventType = "job_run.started"
+	WebhookEventJobRunSucceeded WebhookEventType = "job_run.succeeded"
+	WebhookEventJobRunFailed    WebhookEventType = "job_run.failed"
+)
+
+// AllWebhookEventTypes returns every valid WebhookEventType.
+func AllWebhookEventTypes() []WebhookEventType {
+	types := make([]WebhookEventType, 0, len(AllCaseLifecycles())+len(AllJobRunLifecycles()))
+	for _, lc := range AllCaseLifecycles() {
+		types = append(types, WebhookEventTypeOf(lc))
+	}
+	for _, lc := range AllJobRunLifecycles() {
+		types = append(types, WebhookJobRunEventTypeOf(lc))
+	}
+	return types
+}
+
+// WebhookEventTypeOf returns the webhook event announcing lc.
+func WebhookEventTypeOf(lc CaseLifecycle) WebhookEventType {
+	return WebhookEventType("case." + string(lc))
+}
+
+// WebhookJobRunEventTypeOf returns the webhook event announcing a Job run's
+// lc.
+func WebhookJobRunEventTypeOf(lc JobRunLifecycle) WebhookEventType {
+	return WebhookEventType("job_run." + string(lc))
+}
+
+// IsValid reports whether t is a known event type.
+func (t WebhookEventType) IsValid() bool {
+	return slices.Contains(AllWebhookEventTypes(), t)
+}
+
+// Webhook is an outbound webhook of a workspace, loaded from a [[webhook]]
+// entry of the workspace config.
+type Webhook struct {
+	ID  string
+	URL string
+	// Events are the event types delivered to the webhook. Empty delivers
+	// every event.
+	Events []WebhookEventType
+	// Secret signs each request with HMAC-SHA256. Empty sends requests
+	// unsigned.
+	Secret string
+	// Template renders the request body from a WebhookPayload. Empty sends
+	// the payload as JSON.
+	Template string
+}
+
+// Listens reports whether an event of type t is delivered to the webhook.
+func (w *Webhook) Listens(t WebhookEventType) bool {
+	return len(w.Events) == 0 || slices.Contains(w.Events, t)
+}
+
+// ParseWebhookTemplate parses a webhook payload template. Besides the
+// built-in functions a template may call json, which renders its argument as
+// a JSON value, so a string taken from a case lands in a JSON body escaped.
+func ParseWebhookTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("webhook").Funcs(template.FuncMap{
+		"json": func(v any) (string, error) {
+			raw, err := json.Marshal(v)
+			if err != nil {
+				return "", err
+			}
+			return string(raw), nil
+		},
+	}).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to parse webhook template")
+	}
+	return tmpl, nil
+}
+
+// Headers of a webhook request.
+const (
+	WebhookEventHeader     = "X-Hecatoncheires-Event"
+	WebhookDeliveryHeader  = "X-Hecatoncheires-Delivery"
+	WebhookTimestampHeader = "X-Hecatoncheires-Timestamp"
+	// WebhookSignatureHeader carries "sha256=" and WebhookSignature of the
+	// request. It is sent only by a webhook with a secret.
+	WebhookSignatureHeader = "X-Hecatoncheires-Signature"
+)
+
+// WebhookSignature returns the hex HMAC-SHA256, keyed by secret, of the
+// request's timestamp header, a dot and its body. Signing the timestamp
+// lets a receiver refuse a replayed request.
+func WebhookSignature(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookPayload is what a webhook is sent about an event: the request body
+// when the webhook has no template, and the template's data otherwise.
+type WebhookPayload struct {
+	DeliveryID  string           `json:"delivery_id"`
+	Event       WebhookEventType `json:"event"`
+	WorkspaceID string           `json:"workspace_id"`
+	OccurredAt  time.Time        `json:"occurred_at"`
+	ActorUserID string           `json:"actor_user_id,omitempty"`
+	Case        WebhookCase      `json:"case"`
+	// Change is set on the events that carry a field, status, assignee or
+	// action change.
+	Change *WebhookChange `json:"change,omitempty"`
+	// JobRun is set on the job_run events.
+	JobRun *WebhookJobRun `json:"job_run,omitempty"`
+}
+
+// WebhookCase is the case an event is about. The content of a private case
+// is left out: only its ID and IsPrivate are sent.
+type WebhookCase struct {
+	ID          int64    `json:"id"`
+	Title       string   `json:"title,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Status      string   `json:"status,omitempty"`
+	BoardStatus string   `json:"board_status,omitempty"`
+	ReporterID  string   `json:"reporter_id,omitempty"`
+	AssigneeIDs []string `json:"assignee_ids,omitempty"`
+	IsPrivate   bool     `json:"is_private"`
+	IsTest      bool     `json:"is_test,omitempty"`
+	URL         string   `json:"url,omitempty"`
+}
+
+// WebhookChange is the detail of a CaseChange.
+type WebhookChange struct {
+	FieldID  string   `json:"field_id,omitempty"`
+	From     []string `json:"from,omitempty"`
+	To       []string `json:"to,omitempty"`
+	ActionID int64    `json:"action_id,omitempty"`
+}
+
+// WebhookJobRun is the Job run a job_run event is about. Error is set on a
+// failed run of a case that is not private.
+type WebhookJobRun struct {
+	JobID string `json:"job_id"`
+	RunID string `json:"run_id"`
+	Error string `json:"error,omitempty"`
+}
+
+// WebhookDeliveryID identifies a webhook delivery. It is a UUID v7, so IDs
+// order like the deliveries' creation.
+type WebhookDeliveryID string
+
+// NewWebhookDeliveryID mints a time-ordered delivery ID.
+func NewWebhookDeliveryID() WebhookDeliveryID {
+	return WebhookDeliveryID(uuid.Must(uuid.NewV7()).String())
+}
+
+func (id WebhookDeliveryID) String() string { return string(id) }
+
+// WebhookDeliveryStatus is where a delivery stands.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending is waiting for its first or next attempt.
+	WebhookDeliveryPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliveryDelivered was accepted by the receiver.
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	// WebhookDeliveryDead ran out of attempts, or its webhook was removed
+	// from the config. It stays in the dead-letter list until requeued.
+	WebhookDeliveryDead WebhookDeliveryStatus = "dead"
+)
+
+// IsValid reports whether s is a known status.
+func (s WebhookDeliveryStatus) IsValid() bool {
+	switch s {
+	case WebhookDeliveryPending, WebhookDeliveryDelivered, WebhookDeliveryDead:
+		return true
+	}
+	return false
+}
+
+const (
+	// WebhookMaxAttempts is how many times a delivery is attempted before it
+	// is dead-lettered.
+	WebhookMaxAttempts = 8
+	// webhookRetryBaseDelay is the wait after the first failed attempt; each
+	// later failure doubles it, up to webhookRetryMaxDelay.
+	webhookRetryBaseDelay = 30 * time.Second
+	webhookRetryMaxDelay  = time.Hour
+	// webhookLastErrorMaxLen caps the stored error, which may quote a
+	// response body.
+	webhookLastErrorMaxLen = 512
+)
+
+// WebhookRetryDelay returns the wait before the next attempt of a delivery
+// whose attempts so far have all failed.
+func WebhookRetryDelay(attempts int) time.Duration {
+	delay := webhookRetryBaseDelay
+	for i := 1; i < attempts && delay < webhookRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, webhookRetryMaxDelay)
+}
+
+// ErrWebhookDeliveryValidation is returned when a WebhookDelivery fails
+// validation.
+var ErrWebhookDeliveryValidation = goerr.New("webhook delivery validation failed")
+
+// WebhookDelivery is one event queued for one webhook. The payload is
+// rendered when the event is queued, so every attempt sends the same body;
+// the URL and secret are read from the config at each attempt, so fixing a
+// misconfigured webhook also fixes its pending deliveries.
+type WebhookDelivery struct {
+	ID          WebhookDeliveryID
+	WorkspaceID string
+	WebhookID   string
+	Event       WebhookEventType
+	CaseID      int64
+	Payload     string
+	Status      WebhookDeliveryStatus
+	// Attempts counts the attempts started, the one in flight included.
+	Attempts int
+	// NextAttemptAt is when a pending delivery is next due. While an attempt
+	// is in flight it is pushed past the attempt's end, so no other sweep
+	// picks the delivery up.
+	NextAttemptAt  time.Time
+	LastAttemptAt  time.Time
+	LastStatusCode int
+	LastError      string
+	DeliveredAt    time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Validate enforces the invariants the repository relies on before every
+// write.
+func (d *WebhookDelivery) Validate() error {
+	if d == nil {
+		return goerr.Wrap(ErrWebhookDeliveryValidation, "webhook delivery is nil")
+	}
+	if d.ID == "" {
+		return goerr.Wrap(ErrWebhookDeliveryValidation, "id is required")
+	}
+	if d.WorkspaceID == "" {
+		return goerr.Wrap(ErrWebhookDeliveryValidation, "workspace ID is required",
+			goerr.V("delivery_id", d.ID))
+	}
+	if d.WebhookID == "" {
+		return goerr.Wrap(ErrWebhookDeliveryValidation, "webhook ID is required",
+			goerr.V("delivery_id", d.ID))
+	}
+	if !d.Event.IsValid() {
+		return goerr.Wrap(ErrWebhookDeliveryValidation, "unknown event",
+			goerr.V("delivery_id", d.ID), goerr.V("event", d.Event))
+	}
+	if !d.Status.IsValid() {
+		return goerr.Wrap(ErrWebhookDeliveryValidation, "unknown status",
+			goerr.V("delivery_id", d.ID), goerr.V("status", d.Status))
+	}
+	return nil
+}
+
+// IsDue reports whether the delivery is pending and its next attempt is due
+// at now.
+func (d *WebhookDelivery) IsDue(now time.Time) bool {
+	return d.Status == WebhookDeliveryPending && !d.NextAttemptAt.After(now)
+}
+
+// StartAttempt records the start of an attempt, holding the delivery until
+// leaseUntil.
+func (d *WebhookDelivery) StartAttempt(now, leaseUntil time.Time) {
+	d.Attempts++
+	d.LastAttemptAt = now
+	d.NextAttemptAt = leaseUntil
+	d.UpdatedAt = now
+}
+
+// RecordSuccess marks the delivery delivered.
+func (d *WebhookDelivery) RecordSuccess(now time.Time, statusCode int) {
+	d.Status = WebhookDeliveryDelivered
+	d.LastStatusCode = statusCode
+	d.LastError = ""
+	d.DeliveredAt = now
+	d.UpdatedAt = now
+}
+
+// RecordFailure records a failed attempt: the delivery is scheduled for
+// another one, or dead-lettered once it has used WebhookMaxAttempts.
+// statusCode is zero when no response was received.
+func (d *WebhookDelivery) RecordFailure(now time.Time, statusCode int, reason string) {
+	d.LastStatusCode = statusCode
+	d.LastError = truncateWebhookError(reason)
+	d.UpdatedAt = now
+	if d.Attempts >= WebhookMaxAttempts {
+		d.Status = WebhookDeliveryDead
+		return
+	}
+	d.NextAttemptAt = now.Add(WebhookRetryDelay(d.Attempts))
+}
+
+// Kill dead-letters the delivery without another attempt.
+func (d *WebhookDelivery) Kill(now time.Time, reason string) {
+	d.Status = WebhookDeliveryDead
+	d.LastError = truncateWebhookError(reason)
+	d.UpdatedAt = now
+}
+
+// Requeue returns a dead delivery to the queue with a fresh set of attempts,
+// due at now.
+func (d *WebhookDelivery) Requeue(now time.Time) {
+	d.Status = WebhookDeliveryPending
+	d.Attempts = 0
+	d.NextAttemptAt = now
+	d.UpdatedAt = now
+}
+
+func truncateWebhookError(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) <= webhookLastErrorMaxLen {
+		return s
+	}
+	return strings.ToValidUTF8(s[:webhookLastErrorMaxLen], "") + "…"
+}
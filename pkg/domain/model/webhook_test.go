@@ -0,0 +1,127 @@
+package model_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+func TestWebhookEventType(t *testing.T) {
+	gt.Value(t, model.WebhookEventTypeOf(model.CaseLifecycleCreated)).Equal(model.WebhookEventCaseCreated)
+	gt.Value(t, model.WebhookJobRunEventTypeOf(model.JobRunLifecycleFailed)).Equal(model.WebhookEventJobRunFailed)
+	gt.Array(t, model.AllWebhookEventTypes()).Length(len(model.AllCaseLifecycles()) + len(model.AllJobRunLifecycles()))
+	for _, ev := range model.AllWebhookEventTypes() {
+		gt.Bool(t, ev.IsValid()).True()
+	}
+	gt.Bool(t, model.WebhookEventType("created").IsValid()).False()
+}
+
+func TestWebhook_Listens(t *testing.T) {
+	all := &model.Webhook{ID: "all"}
+	gt.Bool(t, all.Listens(model.WebhookEventCaseClosed)).True()
+
+	some := &model.Webhook{ID: "some", Events: []model.WebhookEventType{model.WebhookEventCaseCreated}}
+	gt.Bool(t, some.Listens(model.WebhookEventCaseCreated)).True()
+	gt.Bool(t, some.Listens(model.WebhookEventCaseClosed)).False()
+}
+
+func TestParseWebhookTemplate(t *testing.T) {
+	t.Run("json escapes case text", func(t *testing.T) {
+		tmpl, err := model.ParseWebhookTemplate(`{"text": {{ json .Case.Title }}}`)
+		gt.NoError(t, err).Required()
+
+		var buf bytes.Buffer
+		gt.NoError(t, tmpl.Execute(&buf, &model.WebhookPayload{
+			Case: model.WebhookCase{Title: `say "hi"`},
+		})).Required()
+		gt.Value(t, buf.String()).Equal(`{"text": "say \"hi\""}`)
+	})
+
+	t.Run("rejects a malformed template", func(t *testing.T) {
+		_, err := model.ParseWebhookTemplate(`{{ .Case.Title `)
+		gt.Error(t, err)
+	})
+}
+
+func TestWebhookSignature(t *testing.T) {
+	sig := model.WebhookSignature("secret", "1700000000", []byte(`{"a":1}`))
+	gt.Value(t, len(sig)).Equal(64)
+	gt.Value(t, model.WebhookSignature("secret", "1700000000", []byte(`{"a":1}`))).Equal(sig)
+	gt.Value(t, model.WebhookSignature("secret", "1700000001", []byte(`{"a":1}`))).NotEqual(sig)
+	gt.Value(t, model.WebhookSignature("other", "1700000000", []byte(`{"a":1}`))).NotEqual(sig)
+}
+
+func TestWebhookRetryDelay(t *testing.T) {
+	gt.Value(t, model.WebhookRetryDelay(1)).Equal(30 * time.Second)
+	gt.Value(t, model.WebhookRetryDelay(2)).Equal(time.Minute)
+	gt.Value(t, model.WebhookRetryDelay(3)).Equal(2 * time.Minute)
+	gt.Value(t, model.WebhookRetryDelay(20)).Equal(time.Hour)
+}
+
+func TestWebhookDelivery_Lifecycle(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	newDelivery := func() *model.WebhookDelivery {
+		return &model.WebhookDelivery{
+			ID:            model.NewWebhookDeliveryID(),
+			WorkspaceID:   "ws",
+			WebhookID:     "siem",
+			Event:         model.WebhookEventCaseCreated,
+			Status:        model.WebhookDeliveryPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		d := newDelivery()
+		gt.NoError(t, d.Validate())
+		gt.Bool(t, d.IsDue(now)).True()
+
+		d.StartAttempt(now, now.Add(time.Minute))
+		gt.Value(t, d.Attempts).Equal(1)
+		gt.Bool(t, d.IsDue(now)).False()
+
+		d.RecordSuccess(now, 204)
+		gt.Value(t, d.Status).Equal(model.WebhookDeliveryDelivered)
+		gt.Value(t, d.LastStatusCode).Equal(204)
+		gt.Bool(t, d.IsDue(now.Add(time.Hour))).False()
+	})
+
+	t.Run("failures back off, then dead-letter", func(t *testing.T) {
+		d := newDelivery()
+		d.StartAttempt(now, now.Add(time.Minute))
+		d.RecordFailure(now, 500, "boom")
+		gt.Value(t, d.Status).Equal(model.WebhookDeliveryPending)
+		gt.Value(t, d.LastError).Equal("boom")
+		gt.Bool(t, d.NextAttemptAt.Equal(now.Add(30*time.Second))).True()
+
+		for d.Status == model.WebhookDeliveryPending {
+			d.StartAttempt(now, now.Add(time.Minute))
+			d.RecordFailure(now, 0, "connection refused")
+		}
+		gt.Value(t, d.Status).Equal(model.WebhookDeliveryDead)
+		gt.Value(t, d.Attempts).Equal(model.WebhookMaxAttempts)
+
+		d.Requeue(now)
+		gt.Value(t, d.Status).Equal(model.WebhookDeliveryPending)
+		gt.Value(t, d.Attempts).Equal(0)
+		gt.Bool(t, d.IsDue(now)).True()
+	})
+
+	t.Run("stored error is truncated", func(t *testing.T) {
+		d := newDelivery()
+		d.StartAttempt(now, now.Add(time.Minute))
+		d.RecordFailure(now, 502, strings.Repeat("x", 2000))
+		gt.Bool(t, len(d.LastError) < 600).True()
+	})
+
+	t.Run("validate rejects an unknown event", func(t *testing.T) {
+		d := newDelivery()
+		d.Event = "case.exploded"
+		gt.Error(t, d.Validate())
+	})
+}
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"slices"
+
 	"github.com/m-mizutani/goerr/v2"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/config"
 )
@@ -77,6 +79,44 @@ type WorkspaceEntry struct {
 	// appended to the host-owned base system prompt and cannot relax it. Empty
 	// when unset.
 	WorkspaceAgentPrompt string
+	// ApprovalTools are the agent tool names whose calls wait for a person to
+	// approve them in Slack before they run. Empty gates nothing.
+	ApprovalTools []string
+	// ApprovalApprovers are the Slack users who may answer the approval
+	// prompts of a run that has no case. Empty means nobody may, so such a
+	// run's gated calls are denied.
+	ApprovalApprovers []string
+	// Webhooks are the outbound webhooks case and Job run events are
+	// delivered to.
+	Webhooks []*Webhook
+	// Ingest maps alerts posted to the workspace's ingest endpoint onto
+	// cases. Nil when the workspace accepts no alerts.
+	Ingest *IngestMapping
+	// ActionReminder is when the tick sweep reminds people of Action due
+	// dates. Nil when the workspace sends no reminders.
+	ActionReminder *ActionReminderPolicy
+	// SLA is the response and resolution targets cases are held to. Nil
+	// when the workspace sets none.
+	SLA *SLAPolicy
+}
+
+// Webhook returns the webhook with the given ID, or nil when the workspace
+// has none by that ID.
+func (e *WorkspaceEntry) Webhook(id string) *Webhook {
+	if e == nil {
+		return nil
+	}
+	for _, w := range e.Webhooks {
+		if w.ID == id {
+			return w
+		}
+	}
+	return nil
+}
+
+// RequiresApproval reports whether a call to tool must be approved first.
+func (e *WorkspaceEntry) RequiresApproval(tool string) bool {
+	return e != nil && slices.Contains(e.ApprovalTools, tool)
 }
 
 // IsThreadMode reports whether this workspace uses thread-per-case binding.
@@ -245,6 +245,9 @@ const (
 	MsgUIErrFieldValidationWhat // required/invalid case fields
 	MsgUIErrFieldValidationDetail
 	MsgUIErrFieldValidationFix
+	MsgUIErrCaseClosedWhat // case closed or merged, no longer takes updates
+	MsgUIErrCaseClosedDetail
+	MsgUIErrCaseClosedFix
 	MsgUIErrAgentNoConclusionWhat // planner exhausted budget / gave up
 	MsgUIErrAgentNoConclusionDetail
 	MsgUIErrAgentNoConclusionFix
@@ -271,6 +274,80 @@ const (
 	MsgCaseChangeAssigneeAssigned   // ":bust_in_silhouette: %s assigned %s"
 	MsgCaseChangeAssigneeUnassigned // ":bust_in_silhouette: %s unassigned %s"
 
+	// Knowledge review sweep (Slack DM to the entry's creator)
+	MsgKnowledgeReviewDue // ":hourglass: Knowledge entry %s is due for review"
+	MsgKnowledgeExpired   // ":warning: Knowledge entry %s has expired"
+
+	// Agent tool approval prompt (Slack thread)
+	MsgToolApprovalPrompt   // ":raised_hand: The agent wants to run %s. Approve?"
+	MsgToolApprovalApprove  // "Approve"
+	MsgToolApprovalDeny     // "Deny"
+	MsgToolApprovalApproved // ":white_check_mark: %s approved %s"
+	MsgToolApprovalDenied   // ":no_entry_sign: %s denied %s"
+	MsgToolApprovalStale    // "_(This approval request is no longer active.)_"
+	MsgToolApprovalRefused  // ":no_entry: You cannot decide on %s ..." (ephemeral)
+
+	// Alert ingestion (case channel / thread)
+	MsgAlertRepeated // ":rotating_light: The alert fired again: %s"
+
+	// Action dependencies (action card / thread)
+	MsgActionBlockedBy         // ":no_entry: Blocked by %s"
+	MsgActionUnblocked         // ":unlock: *%s* is no longer blocked: *%s* is done."
+	MsgActionUnblockedArchived // ":unlock: *%s* is no longer blocked: *%s* was archived."
+
+	// Action due-date reminders (assignee DM / action thread)
+	MsgActionDueSoon   // ":alarm_clock: Your action %s in *%s* is due on %s."
+	MsgActionOverdue   // ":warning: %s is overdue: it was due on %s."
+	MsgActionEscalated // ":rotating_light: %s is still not done, though it was due on %s. Please follow up."
+
+	// Case merge and duplicate suggestion (case channel / thread)
+	MsgCaseMergedInto          // ":twisted_rightwards_arrows: %s merged this case into %s. Please continue there."
+	MsgCaseMergedFrom          // ":twisted_rightwards_arrows: %s merged %s into this case."
+	MsgCaseDuplicateCandidates // ":mag: This case looks similar to %s. If it is the same issue, consider merging it."
+
+	// Slack App Home tab
+	MsgHomeNewCase            // "New case"
+	MsgHomeNewCaseIn          // "New case in %s"
+	MsgHomeOpen               // "Open"
+	MsgHomeMyCases            // "My open cases (%d)"
+	MsgHomeNoCases            // "No open cases are assigned to you."
+	MsgHomeMyActions          // "My actions (%d)"
+	MsgHomeNoActions          // "You have no open actions."
+	MsgHomeFavoriteWorkspaces // "Favorite workspaces"
+	MsgHomeStalled            // ":hourglass_flowing_sand: No recent activity"
+	MsgHomeDue                // ":calendar: Due %s"
+	MsgHomeOverdue            // ":warning: Overdue since %s"
+	MsgHomeMore               // "…and %d more"
+
+	// "Attach to case" message shortcut
+	MsgAttachModalTitle          // "Attach to case"
+	MsgAttachModalSubmit         // "Attach"
+	MsgAttachWorkspaceLabel      // "Search in workspace"
+	MsgAttachWorkspaceHint       // "Leave empty to search every workspace."
+	MsgAttachCaseLabel           // "Case"
+	MsgAttachCasePlaceholder     // "Search by title or #number"
+	MsgAttachNoteLabel           // "Note"
+	MsgAttachNotePlaceholder     // "Why this message matters (optional)"
+	MsgAttachOptionsLabel        // "Options"
+	MsgAttachNotifyJobs          // "Notify Jobs and webhooks of the case"
+	MsgCaseMessageAttached       // ":paperclip: %s attached <%s|a message> from <#%s> to this case."
+	MsgCaseMessageAttachedNoLink // ":paperclip: %s attached a message from <#%s> to this case."
+	MsgAttachDone                // ":paperclip: Attached the message to %s."
+
+	// Link unfurling of case and action URLs
+	MsgUnfurlStatus      // "Status"
+	MsgUnfurlBoardStatus // "Board status"
+	MsgUnfurlAssignees   // "Assignees"
+	MsgUnfurlAssignee    // "Assignee"
+	MsgUnfurlDueDate     // "Due date"
+	MsgUnfurlCase        // "Case"
+	MsgUnfurlUnassigned  // "Unassigned"
+	MsgUnfurlCaseOpen    // "Open"
+	MsgUnfurlCaseClosed  // "Closed"
+	MsgUnfurlCaseDraft   // "Draft"
+	MsgUnfurlCaseMerged  // "Merged into #%d"
+	MsgUnfurlRestricted  // ":lock: Private case. Only its members can see the details."
+
 	msgKeyCount // sentinel for validation
 )
 
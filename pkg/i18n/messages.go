@@ -203,6 +203,9 @@ var messagesEN = [msgKeyCount]string{
 	MsgUIErrFieldValidationWhat:     "⚠️ The case is missing required fields",
 	MsgUIErrFieldValidationDetail:   "Field validation failed",
 	MsgUIErrFieldValidationFix:      "Use Edit to fill in the required fields, then submit again",
+	MsgUIErrCaseClosedWhat:          "⚠️ This case no longer accepts updates",
+	MsgUIErrCaseClosedDetail:        "The case is closed or has been merged into another case",
+	MsgUIErrCaseClosedFix:           "Pick an open case, or reopen this one first",
 	MsgUIErrAgentNoConclusionWhat:   "⚠️ I couldn't finish this turn",
 	MsgUIErrAgentNoConclusionDetail: "I couldn't complete the processing before this turn ended",
 	MsgUIErrAgentNoConclusionFix:    "Mention me again with a bit more context. If it keeps happening, contact an admin with the ref below",
@@ -225,6 +228,80 @@ var messagesEN = [msgKeyCount]string{
 	MsgCaseChangeStatus:             ":arrows_counterclockwise: %s changed the case status: %s -> %s",
 	MsgCaseChangeAssigneeAssigned:   ":bust_in_silhouette: %s assigned %s",
 	MsgCaseChangeAssigneeUnassigned: ":bust_in_silhouette: %s unassigned %s",
+
+	// Knowledge review sweep (Slack DM to the entry's creator)
+	MsgKnowledgeReviewDue: ":hourglass: Knowledge entry %s is due for review. Please confirm it is still accurate, then update it or move its review date.",
+	MsgKnowledgeExpired:   ":warning: Knowledge entry %s has expired and is no longer returned to agents. Please update its validity date or delete it.",
+
+	// Agent tool approval prompt (Slack thread)
+	MsgToolApprovalPrompt:   ":raised_hand: The agent wants to run `%s` and is waiting for approval. It will not continue until someone decides.",
+	MsgToolApprovalApprove:  "Approve",
+	MsgToolApprovalDeny:     "Deny",
+	MsgToolApprovalApproved: ":white_check_mark: %s approved `%s`.",
+	MsgToolApprovalDenied:   ":no_entry_sign: %s denied `%s`.",
+	MsgToolApprovalStale:    "_(This approval request is no longer active.)_",
+	MsgToolApprovalRefused:  ":no_entry: You cannot decide on `%s`. It has to be approved or denied by a member or assignee of the case other than the person whose request started the run.",
+
+	// Alert ingestion (case channel / thread)
+	MsgAlertRepeated: ":rotating_light: The alert fired again: %s",
+
+	// Action dependencies (action card / thread)
+	MsgActionBlockedBy:         ":no_entry: Blocked by %s",
+	MsgActionUnblocked:         ":unlock: *%s* is no longer blocked: *%s* is done.",
+	MsgActionUnblockedArchived: ":unlock: *%s* is no longer blocked: *%s* was archived.",
+
+	// Action due-date reminders (assignee DM / action thread)
+	MsgActionDueSoon:   ":alarm_clock: Your action %s in *%s* is due on %s.",
+	MsgActionOverdue:   ":warning: %s is overdue: it was due on %s.",
+	MsgActionEscalated: ":rotating_light: %s is still not done, though it was due on %s. Please follow up.",
+
+	// Case merge and duplicate suggestion (case channel / thread)
+	MsgCaseMergedInto:          ":twisted_rightwards_arrows: %s merged this case into %s. Please continue there.",
+	MsgCaseMergedFrom:          ":twisted_rightwards_arrows: %s merged %s into this case.",
+	MsgCaseDuplicateCandidates: ":mag: This case looks similar to %s. If it is the same issue, consider merging it.",
+
+	// Slack App Home tab
+	MsgHomeNewCase:            "New case",
+	MsgHomeNewCaseIn:          "New case in %s",
+	MsgHomeOpen:               "Open",
+	MsgHomeMyCases:            "My open cases (%d)",
+	MsgHomeNoCases:            "No open cases are assigned to you.",
+	MsgHomeMyActions:          "My actions (%d)",
+	MsgHomeNoActions:          "You have no open actions.",
+	MsgHomeFavoriteWorkspaces: "Favorite workspaces",
+	MsgHomeStalled:            ":hourglass_flowing_sand: No recent activity",
+	MsgHomeDue:                ":calendar: Due %s",
+	MsgHomeOverdue:            ":warning: Overdue since %s",
+	MsgHomeMore:               "…and %d more",
+
+	// "Attach to case" message shortcut
+	MsgAttachModalTitle:          "Attach to case",
+	MsgAttachModalSubmit:         "Attach",
+	MsgAttachWorkspaceLabel:      "Search in workspace",
+	MsgAttachWorkspaceHint:       "Leave empty to search every workspace.",
+	MsgAttachCaseLabel:           "Case",
+	MsgAttachCasePlaceholder:     "Search by title or #number",
+	MsgAttachNoteLabel:           "Note",
+	MsgAttachNotePlaceholder:     "Why this message matters (optional)",
+	MsgAttachOptionsLabel:        "Options",
+	MsgAttachNotifyJobs:          "Notify Jobs and webhooks of the case",
+	MsgCaseMessageAttached:       ":paperclip: %s attached <%s|a message> from <#%s> to this case.",
+	MsgCaseMessageAttachedNoLink: ":paperclip: %s attached a message from <#%s> to this case.",
+	MsgAttachDone:                ":paperclip: Attached the message to %s.",
+
+	// Link unfurling of case and action URLs
+	MsgUnfurlStatus:      "Status",
+	MsgUnfurlBoardStatus: "Board status",
+	MsgUnfurlAssignees:   "Assignees",
+	MsgUnfurlAssignee:    "Assignee",
+	MsgUnfurlDueDate:     "Due date",
+	MsgUnfurlCase:        "Case",
+	MsgUnfurlUnassigned:  "Unassigned",
+	MsgUnfurlCaseOpen:    "Open",
+	MsgUnfurlCaseClosed:  "Closed",
+	MsgUnfurlCaseDraft:   "Draft",
+	MsgUnfurlCaseMerged:  "Merged into #%d",
+	MsgUnfurlRestricted:  ":lock: Private case. Only its members can see the details.",
 }
 
 var messagesJA = [msgKeyCount]string{
@@ -413,6 +490,9 @@ var messagesJA = [msgKeyCount]string{
 	MsgUIErrFieldValidationWhat:     "⚠️ Caseの必須項目が不足しています",
 	MsgUIErrFieldValidationDetail:   "フィールドの検証に失敗しました",
 	MsgUIErrFieldValidationFix:      "Editから必須項目を入力して、もう一度送信してください",
+	MsgUIErrCaseClosedWhat:          "⚠️ このCaseは更新を受け付けていません",
+	MsgUIErrCaseClosedDetail:        "Caseがクローズされたか、別のCaseに統合されています",
+	MsgUIErrCaseClosedFix:           "オープンなCaseを選ぶか、先にこのCaseを再オープンしてください",
 	MsgUIErrAgentNoConclusionWhat:   "⚠️ このターンを完了できませんでした",
 	MsgUIErrAgentNoConclusionDetail: "このターンの処理を最後まで完了できませんでした",
 	MsgUIErrAgentNoConclusionFix:    "もう少し情報を添えて、もう一度メンションしてください。続く場合は下の ref を添えて管理者へご連絡ください",
@@ -435,4 +515,78 @@ var messagesJA = [msgKeyCount]string{
 	MsgCaseChangeStatus:             ":arrows_counterclockwise: %s がケースのステータスを変更しました: %s → %s",
 	MsgCaseChangeAssigneeAssigned:   ":bust_in_silhouette: %s が %s をアサインしました",
 	MsgCaseChangeAssigneeUnassigned: ":bust_in_silhouette: %s が %s のアサインを解除しました",
+
+	// Knowledge review sweep (Slack DM to the entry's creator)
+	MsgKnowledgeReviewDue: ":hourglass: ナレッジ %s のレビュー期日になりました。内容がまだ正しいか確認し、更新するかレビュー期日を延ばしてください。",
+	MsgKnowledgeExpired:   ":warning: ナレッジ %s の有効期限が切れたため、エージェントには返されなくなりました。有効期限を更新するか削除してください。",
+
+	// Agent tool approval prompt (Slack thread)
+	MsgToolApprovalPrompt:   ":raised_hand: エージェントが `%s` を実行しようとしており、承認を待っています。誰かが判断するまで処理は進みません。",
+	MsgToolApprovalApprove:  "承認",
+	MsgToolApprovalDeny:     "却下",
+	MsgToolApprovalApproved: ":white_check_mark: %s が `%s` を承認しました。",
+	MsgToolApprovalDenied:   ":no_entry_sign: %s が `%s` を却下しました。",
+	MsgToolApprovalStale:    "_(この承認リクエストはすでに無効です。)_",
+	MsgToolApprovalRefused:  ":no_entry: `%s` の承認・却下はできません。実行のきっかけとなった依頼者以外の、ケースのメンバーまたは担当者が判断する必要があります。",
+
+	// Alert ingestion (case channel / thread)
+	MsgAlertRepeated: ":rotating_light: アラートが再度発生しました: %s",
+
+	// Action dependencies (action card / thread)
+	MsgActionBlockedBy:         ":no_entry: 待ち: %s",
+	MsgActionUnblocked:         ":unlock: *%[2]s* が完了したため、*%[1]s* に着手できます。",
+	MsgActionUnblockedArchived: ":unlock: *%[2]s* がアーカイブされたため、*%[1]s* に着手できます。",
+
+	// Action due-date reminders (assignee DM / action thread)
+	MsgActionDueSoon:   ":alarm_clock: *%[2]s* のアクション %[1]s の期日は %[3]s です。",
+	MsgActionOverdue:   ":warning: %s は期日 (%s) を過ぎています。",
+	MsgActionEscalated: ":rotating_light: %s は期日 (%s) を過ぎてもまだ完了していません。対応状況を確認してください。",
+
+	// Case merge and duplicate suggestion (case channel / thread)
+	MsgCaseMergedInto:          ":twisted_rightwards_arrows: %s がこのケースを %s に統合しました。以降はそちらで対応してください。",
+	MsgCaseMergedFrom:          ":twisted_rightwards_arrows: %s が %s をこのケースに統合しました。",
+	MsgCaseDuplicateCandidates: ":mag: このケースは %s と似ています。同じ事象であれば統合を検討してください。",
+
+	// Slack App Home tab
+	MsgHomeNewCase:            "ケース作成",
+	MsgHomeNewCaseIn:          "%s でケース作成",
+	MsgHomeOpen:               "開く",
+	MsgHomeMyCases:            "担当中のケース (%d)",
+	MsgHomeNoCases:            "担当中のオープンなケースはありません。",
+	MsgHomeMyActions:          "自分のアクション (%d)",
+	MsgHomeNoActions:          "未完了のアクションはありません。",
+	MsgHomeFavoriteWorkspaces: "お気に入りのワークスペース",
+	MsgHomeStalled:            ":hourglass_flowing_sand: 最近の動きがありません",
+	MsgHomeDue:                ":calendar: 期日 %s",
+	MsgHomeOverdue:            ":warning: %s から期日超過",
+	MsgHomeMore:               "…ほか %d 件",
+
+	// "Attach to case" message shortcut
+	MsgAttachModalTitle:          "ケースに添付",
+	MsgAttachModalSubmit:         "添付",
+	MsgAttachWorkspaceLabel:      "検索するワークスペース",
+	MsgAttachWorkspaceHint:       "空欄のままにすると全ワークスペースから検索します。",
+	MsgAttachCaseLabel:           "ケース",
+	MsgAttachCasePlaceholder:     "タイトルまたは #番号 で検索",
+	MsgAttachNoteLabel:           "メモ",
+	MsgAttachNotePlaceholder:     "このメッセージが関係する理由 (任意)",
+	MsgAttachOptionsLabel:        "オプション",
+	MsgAttachNotifyJobs:          "ケースの Job と Webhook に通知する",
+	MsgCaseMessageAttached:       ":paperclip: %s が <#%[3]s> の<%[2]s|メッセージ>をこのケースに添付しました。",
+	MsgCaseMessageAttachedNoLink: ":paperclip: %s が <#%s> のメッセージをこのケースに添付しました。",
+	MsgAttachDone:                ":paperclip: メッセージを %s に添付しました。",
+
+	// Link unfurling of case and action URLs
+	MsgUnfurlStatus:      "ステータス",
+	MsgUnfurlBoardStatus: "ボードステータス",
+	MsgUnfurlAssignees:   "担当者",
+	MsgUnfurlAssignee:    "担当者",
+	MsgUnfurlDueDate:     "期日",
+	MsgUnfurlCase:        "ケース",
+	MsgUnfurlUnassigned:  "未割り当て",
+	MsgUnfurlCaseOpen:    "オープン",
+	MsgUnfurlCaseClosed:  "クローズ",
+	MsgUnfurlCaseDraft:   "下書き",
+	MsgUnfurlCaseMerged:  "#%d に統合済み",
+	MsgUnfurlRestricted:  ":lock: プライベートケースです。詳細はメンバーのみ閲覧できます。",
 }
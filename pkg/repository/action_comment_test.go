@@ -272,3 +272,13 @@ func TestActionCommentRepository_Firestore(t *testing.T) {
 	t.Parallel()
 	runActionCommentRepositoryTest(t, newFirestoreRepository)
 }
+
+func TestActionCommentRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runActionCommentRepositoryTest(t, newPostgresRepository)
+}
+
+func TestActionCommentRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runActionCommentRepositoryTest(t, newSQLiteRepository)
+}
@@ -176,3 +176,13 @@ func TestActionEventRepository_Firestore(t *testing.T) {
 	t.Parallel()
 	runActionEventRepositoryTest(t, newFirestoreRepository)
 }
+
+func TestActionEventRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runActionEventRepositoryTest(t, newPostgresRepository)
+}
+
+func TestActionEventRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runActionEventRepositoryTest(t, newSQLiteRepository)
+}
@@ -172,3 +172,13 @@ func TestActionMessageRepository_Firestore(t *testing.T) {
 	t.Parallel()
 	runActionMessageRepositoryTest(t, newFirestoreRepository)
 }
+
+func TestActionMessageRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runActionMessageRepositoryTest(t, newPostgresRepository)
+}
+
+func TestActionMessageRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runActionMessageRepositoryTest(t, newSQLiteRepository)
+}
@@ -0,0 +1,113 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+)
+
+func runActionReminderRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.Repository) {
+	t.Helper()
+	ctx := context.Background()
+	due := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	t.Run("first claim wins, second is deduped", func(t *testing.T) {
+		repo := newRepo(t)
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+
+		claimed, err := repo.ActionReminder().Claim(ctx, wsID, 1, model.ActionReminderOverdue, due)
+		gt.NoError(t, err).Required()
+		gt.Bool(t, claimed).True()
+
+		again, err := repo.ActionReminder().Claim(ctx, wsID, 1, model.ActionReminderOverdue, due)
+		gt.NoError(t, err).Required()
+		gt.Bool(t, again).False()
+	})
+
+	t.Run("stage, due date and workspace each claim independently", func(t *testing.T) {
+		repo := newRepo(t)
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+
+		claimed, err := repo.ActionReminder().Claim(ctx, wsID, 1, model.ActionReminderDueSoon, due)
+		gt.NoError(t, err).Required()
+		gt.Bool(t, claimed).True()
+
+		for name, claim := range map[string]func() (bool, error){
+			"other stage": func() (bool, error) {
+				return repo.ActionReminder().Claim(ctx, wsID, 1, model.ActionReminderOverdue, due)
+			},
+			"moved due date": func() (bool, error) {
+				return repo.ActionReminder().Claim(ctx, wsID, 1, model.ActionReminderDueSoon, due.AddDate(0, 0, 1))
+			},
+			"other action": func() (bool, error) {
+				return repo.ActionReminder().Claim(ctx, wsID, 2, model.ActionReminderDueSoon, due)
+			},
+			"other workspace": func() (bool, error) {
+				return repo.ActionReminder().Claim(ctx, wsID+"-2", 1, model.ActionReminderDueSoon, due)
+			},
+		} {
+			ok, err := claim()
+			gt.NoError(t, err).Required()
+			gt.Bool(t, ok).Describef("%s must claim", name).True()
+		}
+	})
+
+	t.Run("release allows a re-claim", func(t *testing.T) {
+		repo := newRepo(t)
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+
+		claimed, err := repo.ActionReminder().Claim(ctx, wsID, 1, model.ActionReminderEscalated, due)
+		gt.NoError(t, err).Required()
+		gt.Bool(t, claimed).True()
+
+		gt.NoError(t, repo.ActionReminder().Release(ctx, wsID, 1, model.ActionReminderEscalated, due)).Required()
+
+		reclaimed, err := repo.ActionReminder().Claim(ctx, wsID, 1, model.ActionReminderEscalated, due)
+		gt.NoError(t, err).Required()
+		gt.Bool(t, reclaimed).True()
+	})
+
+	t.Run("release of a missing claim is not an error", func(t *testing.T) {
+		repo := newRepo(t)
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		gt.NoError(t, repo.ActionReminder().Release(ctx, wsID, 1, model.ActionReminderOverdue, due))
+	})
+
+	t.Run("empty identity is rejected", func(t *testing.T) {
+		repo := newRepo(t)
+		_, err := repo.ActionReminder().Claim(ctx, "", 1, model.ActionReminderOverdue, due)
+		gt.Error(t, err)
+		_, err = repo.ActionReminder().Claim(ctx, "ws", 0, model.ActionReminderOverdue, due)
+		gt.Error(t, err)
+		_, err = repo.ActionReminder().Claim(ctx, "ws", 1, "", due)
+		gt.Error(t, err)
+	})
+}
+
+func TestActionReminderRepository_Memory(t *testing.T) {
+	t.Parallel()
+	runActionReminderRepositoryTest(t, func(t *testing.T) interfaces.Repository {
+		return memory.New()
+	})
+}
+
+func TestActionReminderRepository_Firestore(t *testing.T) {
+	t.Parallel()
+	runActionReminderRepositoryTest(t, newFirestoreRepository)
+}
+
+func TestActionReminderRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runActionReminderRepositoryTest(t, newPostgresRepository)
+}
+
+func TestActionReminderRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runActionReminderRepositoryTest(t, newSQLiteRepository)
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
 	repofirestore "github.com/secmon-lab/hecatoncheires/pkg/repository/firestore"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/postgres"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/sqlite"
 )
 
 func runActionStepRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.Repository, notFoundErr error) {
@@ -208,3 +210,13 @@ func TestActionStepRepository_Firestore(t *testing.T) {
 	t.Parallel()
 	runActionStepRepositoryTest(t, newFirestoreRepository, repofirestore.ErrNotFound)
 }
+
+func TestActionStepRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runActionStepRepositoryTest(t, newPostgresRepository, postgres.ErrNotFound)
+}
+
+func TestActionStepRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runActionStepRepositoryTest(t, newSQLiteRepository, sqlite.ErrNotFound)
+}
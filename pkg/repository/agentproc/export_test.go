@@ -26,3 +26,6 @@ func NewProcessRowForTest(p *agentkit.Process) (agentkit.Process, time.Time) {
 	row := processRow{Process: *p, ClaimAt: claimAtFor(p)}
 	return row.Process, row.ClaimAt
 }
+
+// ClaimKeyForTest exposes the claim_at encoding of the SQL store.
+var ClaimKeyForTest = claimKey
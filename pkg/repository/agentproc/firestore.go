@@ -1,7 +1,8 @@
-// Package agentproc implements agentkit.Repository on Firestore. It is the
-// durable store behind the agent runtime: one document per agentkit Process,
-// with awaits and events as subcollections, plus two guard collections that
-// carry the uniqueness constraints the kernel relies on.
+// Package agentproc implements agentkit.Repository on Firestore (Repository)
+// and on PostgreSQL and SQLite (SQLRepository). It is the durable store behind
+// the agent runtime: one document per agentkit Process, with awaits and events
+// as subcollections, plus two guard collections that carry the uniqueness
+// constraints the kernel relies on.
 //
 // It is a separate package from pkg/repository/firestore because it implements
 // a different contract (agentkit's SPI, not interfaces.Repository) and is
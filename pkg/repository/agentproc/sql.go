@@ -0,0 +1,583 @@
+package agentproc
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"maps"
+	"math"
+	"time"
+
+	"github.com/gollem-dev/agentkit"
+	"github.com/google/uuid"
+	_ "github.com/jackc/pgx/v5/stdlib" // registers the "pgx" database/sql driver
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/sqlite"
+)
+
+// SQLRepository is the agentkit.Repository for the postgres and sqlite
+// backends. It keeps the same shape as the Firestore store — one row per
+// Process carrying the agentkit value whole as JSON, awaits and events beside
+// it, and two guard tables for the uniqueness constraints — in the tables the
+// backend's own migrations create (agent_processes and friends), so it needs
+// no schema step of its own.
+//
+// Both databases take the same queries. What differs is locking: Postgres
+// reads the rows a transaction is about to rewrite FOR UPDATE, while SQLite
+// runs every transaction under the database's write lock (see sqlite.Open),
+// which already serializes the read-check-write.
+type SQLRepository struct {
+	db *sql.DB
+	// lockRows is appended to a read whose rows the transaction rewrites.
+	lockRows string
+	// lockClaim is appended to the claim query, so concurrent claimers each
+	// take a different row instead of queueing on the same one.
+	lockClaim string
+}
+
+var _ agentkit.Repository = (*SQLRepository)(nil)
+
+// NewPostgres opens a Process store on the PostgreSQL database at dsn, the
+// same database the application repository uses. The caller must Close it.
+func NewPostgres(ctx context.Context, dsn string) (*SQLRepository, error) {
+	if dsn == "" {
+		return nil, goerr.New("postgres dsn is required")
+	}
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return nil, goerr.Wrap(err, "open postgres for agent processes")
+	}
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, goerr.Wrap(err, "connect postgres for agent processes")
+	}
+	return &SQLRepository{db: db, lockRows: " FOR UPDATE", lockClaim: " FOR UPDATE SKIP LOCKED"}, nil
+}
+
+// NewSQLite opens a Process store on the SQLite database file at path, the
+// same file the application repository uses. The caller must Close it.
+func NewSQLite(path string) (*SQLRepository, error) {
+	db, err := sqlite.Open(path)
+	if err != nil {
+		return nil, goerr.Wrap(err, "open sqlite for agent processes")
+	}
+	return &SQLRepository{db: db}, nil
+}
+
+// Close releases the database connections.
+func (r *SQLRepository) Close() error {
+	if r == nil {
+		return nil
+	}
+	if err := r.db.Close(); err != nil {
+		return goerr.Wrap(err, "close database for agent processes")
+	}
+	return nil
+}
+
+// claimKey turns a claim instant into the stored claim_at. The sentinels map
+// to the ends of the integer range, and any instant UnixNano cannot represent
+// is clamped to them, so the comparison keeps meaning what it says.
+func claimKey(t time.Time) int64 {
+	switch {
+	case !t.After(claimImmediately) || t.Before(time.Unix(0, math.MinInt64)):
+		return math.MinInt64
+	case !t.Before(claimNever) || t.After(time.Unix(0, math.MaxInt64)):
+		return math.MaxInt64
+	default:
+		return t.UnixNano()
+	}
+}
+
+// storedProcess is a Process row as read inside a transaction.
+type storedProcess struct {
+	process  agentkit.Process
+	eventSeq int64
+}
+
+// dbtx is what both the database and a transaction offer.
+type dbtx interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// readProcess returns the stored row, or nil when there is none.
+func readProcess(ctx context.Context, db dbtx, pid agentkit.ProcessID, suffix string) (*storedProcess, error) {
+	var raw []byte
+	var seq int64
+	err := db.QueryRowContext(ctx,
+		`SELECT data, event_seq FROM agent_processes WHERE id = $1`+suffix, string(pid)).Scan(&raw, &seq)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, goerr.Wrap(err, "get agent process", goerr.V("process", pid))
+	}
+	row := &storedProcess{eventSeq: seq}
+	if err := json.Unmarshal(raw, &row.process); err != nil {
+		return nil, goerr.Wrap(err, "decode agent process", goerr.V("process", pid))
+	}
+	return row, nil
+}
+
+// writeProcess upserts p with its derived claim_at. An insert that finds the
+// id taken changes nothing and reports false.
+func writeProcess(ctx context.Context, tx *sql.Tx, p *agentkit.Process, eventSeq int64, insert bool) (bool, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return false, goerr.Wrap(err, "encode agent process", goerr.V("process", p.ID))
+	}
+	query := `UPDATE agent_processes SET claim_at = $2, event_seq = $3, data = $4 WHERE id = $1`
+	if insert {
+		query = `INSERT INTO agent_processes (id, claim_at, event_seq, data) VALUES ($1, $2, $3, $4)
+			ON CONFLICT (id) DO NOTHING`
+	}
+	res, err := tx.ExecContext(ctx, query, string(p.ID), claimKey(claimAtFor(p)), eventSeq, string(data))
+	if err != nil {
+		return false, goerr.Wrap(err, "write agent process", goerr.V("process", p.ID))
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, goerr.Wrap(err, "write agent process", goerr.V("process", p.ID))
+	}
+	return n > 0, nil
+}
+
+// insertGuard writes a uniqueness row, reporting false when the key is taken.
+func insertGuard(ctx context.Context, tx *sql.Tx, query string, args ...any) (bool, error) {
+	res, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (r *SQLRepository) inTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return goerr.Wrap(err, "begin agent process transaction")
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return goerr.Wrap(err, "commit agent process transaction")
+	}
+	return nil
+}
+
+// GetProcess returns the Process. Absent -> ErrProcessNotFound.
+func (r *SQLRepository) GetProcess(ctx context.Context, pid agentkit.ProcessID) (*agentkit.Process, error) {
+	row, err := readProcess(ctx, r.db, pid, "")
+	if err != nil {
+		return nil, err
+	}
+	if row == nil {
+		return nil, goerr.Wrap(agentkit.ErrProcessNotFound, "no such process", goerr.V("process", pid))
+	}
+	return &row.process, nil
+}
+
+// FindProcessByIdempotencyKey resolves the guard row and reads the Process it
+// names. Absent -> ErrProcessNotFound.
+func (r *SQLRepository) FindProcessByIdempotencyKey(ctx context.Context, key string) (*agentkit.Process, error) {
+	var pid string
+	err := r.db.QueryRowContext(ctx, `SELECT process_id FROM agent_process_keys WHERE idempotency_key = $1`, key).Scan(&pid)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, goerr.Wrap(agentkit.ErrProcessNotFound, "no process for idempotency key")
+	}
+	if err != nil {
+		return nil, goerr.Wrap(err, "get idempotency key guard")
+	}
+	return r.GetProcess(ctx, agentkit.ProcessID(pid))
+}
+
+// FindOpenProcessBySubject resolves the subject guard and reads the Process it
+// names. As in the Firestore store, a terminal Process found through a guard
+// that somehow survived is reported as absent.
+func (r *SQLRepository) FindOpenProcessBySubject(ctx context.Context, subject agentkit.SubjectRef) (*agentkit.Process, error) {
+	var pid string
+	err := r.db.QueryRowContext(ctx,
+		`SELECT process_id FROM agent_process_subjects WHERE kind = $1 AND id = $2`, subject.Kind, subject.ID).Scan(&pid)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, goerr.Wrap(agentkit.ErrProcessNotFound, "no open process for subject",
+			goerr.V("subject_kind", subject.Kind))
+	}
+	if err != nil {
+		return nil, goerr.Wrap(err, "get subject guard", goerr.V("subject_kind", subject.Kind))
+	}
+	proc, err := r.GetProcess(ctx, agentkit.ProcessID(pid))
+	if err != nil {
+		return nil, err
+	}
+	if proc.Status.Terminal() {
+		return nil, goerr.Wrap(agentkit.ErrProcessNotFound, "subject guard names a terminal process",
+			goerr.V("process", proc.ID), goerr.V("status", proc.Status))
+	}
+	return proc, nil
+}
+
+// ClaimNextProcess atomically claims one runnable Process: the first row by
+// claim_at and id whose claim time has come, read and rewritten in one
+// transaction. On Postgres the read skips rows another claimer holds, so
+// concurrent claimers take different rows rather than waiting on one.
+func (r *SQLRepository) ClaimNextProcess(ctx context.Context, workerID string, leaseUntil, now time.Time) (*agentkit.Process, error) {
+	var claimed *agentkit.Process
+	err := r.inTx(ctx, func(tx *sql.Tx) error {
+		var id string
+		var raw []byte
+		var seq int64
+		err := tx.QueryRowContext(ctx, `
+			SELECT id, data, event_seq FROM agent_processes
+			WHERE claim_at <= $1
+			ORDER BY claim_at, id
+			LIMIT 1`+r.lockClaim, claimKey(now)).Scan(&id, &raw, &seq)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return goerr.Wrap(err, "query claimable agent processes")
+		}
+		var stored agentkit.Process
+		if err := json.Unmarshal(raw, &stored); err != nil {
+			return goerr.Wrap(err, "decode claim candidate", goerr.V("process", id))
+		}
+
+		// See Repository.claimOne: a takeover of a running row counts as an
+		// unclean reclaim, and every claim gets a fresh lease token.
+		p := stored
+		p.Status = agentkit.ProcessRunning
+		p.LeaseOwner = workerID
+		p.LeaseToken = uuid.Must(uuid.NewV7()).String()
+		p.LeaseUntil = &leaseUntil
+		p.WakeAt = nil
+		p.UpdatedAt = now
+		p.Rev = stored.Rev + 1
+		if stored.Status == agentkit.ProcessRunning {
+			p.UncleanReclaims = stored.UncleanReclaims + 1
+		}
+
+		if _, err := writeProcess(ctx, tx, &p, seq, false); err != nil {
+			return err
+		}
+		claimed = &p
+		return nil
+	})
+	if err != nil {
+		return nil, goerr.Wrap(err, "claim agent process")
+	}
+	return claimed, nil
+}
+
+// ListAwaits returns all awaits of a Process.
+func (r *SQLRepository) ListAwaits(ctx context.Context, pid agentkit.ProcessID) ([]*agentkit.Await, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT data FROM agent_process_awaits WHERE process_id = $1 ORDER BY await_key`, string(pid))
+	if err != nil {
+		return nil, goerr.Wrap(err, "list agent process awaits", goerr.V("process", pid))
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := []*agentkit.Await{}
+	for rows.Next() {
+		var raw []byte
+		if err := rows.Scan(&raw); err != nil {
+			return nil, goerr.Wrap(err, "list agent process awaits", goerr.V("process", pid))
+		}
+		var aw agentkit.Await
+		if err := json.Unmarshal(raw, &aw); err != nil {
+			return nil, goerr.Wrap(err, "decode await", goerr.V("process", pid))
+		}
+		out = append(out, &aw)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, goerr.Wrap(err, "list agent process awaits", goerr.V("process", pid))
+	}
+	return out, nil
+}
+
+// ListEvents returns a Process's events in append order, which is the stored
+// seq. A cursor the Process has no event for is ErrEventNotFound, for the
+// reason the Firestore store gives.
+func (r *SQLRepository) ListEvents(ctx context.Context, pid agentkit.ProcessID, q agentkit.EventQuery) ([]*agentkit.Event, error) {
+	after := int64(-1)
+	if q.After != "" {
+		err := r.db.QueryRowContext(ctx,
+			`SELECT seq FROM agent_process_events WHERE process_id = $1 AND id = $2`,
+			string(pid), string(q.After)).Scan(&after)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, goerr.Wrap(agentkit.ErrEventNotFound, "no such event for cursor",
+				goerr.V("process", pid), goerr.V("after", q.After))
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "get event cursor", goerr.V("process", pid))
+		}
+	}
+
+	query := `SELECT data FROM agent_process_events WHERE process_id = $1 AND seq > $2 ORDER BY seq`
+	args := []any{string(pid), after}
+	if q.Limit > 0 {
+		query += ` LIMIT $3`
+		args = append(args, q.Limit)
+	}
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, goerr.Wrap(err, "list agent process events", goerr.V("process", pid))
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := []*agentkit.Event{}
+	for rows.Next() {
+		var raw []byte
+		if err := rows.Scan(&raw); err != nil {
+			return nil, goerr.Wrap(err, "list agent process events", goerr.V("process", pid))
+		}
+		var ev agentkit.Event
+		if err := json.Unmarshal(raw, &ev); err != nil {
+			return nil, goerr.Wrap(err, "decode event", goerr.V("process", pid))
+		}
+		out = append(out, &ev)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, goerr.Wrap(err, "list agent process events", goerr.V("process", pid))
+	}
+	return out, nil
+}
+
+// Apply applies the whole ChangeSet in one transaction. It follows the
+// Firestore store step for step — guards, revision checks, event numbering and
+// the event_seq advance for rows it does not rewrite — and a violation of any
+// precondition rolls everything back and returns ErrConflict.
+func (r *SQLRepository) Apply(ctx context.Context, cs agentkit.ChangeSet) error {
+	if err := checkChangeSetUniqueness(cs); err != nil {
+		return err
+	}
+
+	err := r.inTx(ctx, func(tx *sql.Tx) error {
+		// --- read phase: every Process row the ChangeSet checks or writes ---
+		stored := map[agentkit.ProcessID]*storedProcess{}
+		read := func(pid agentkit.ProcessID) error {
+			if _, ok := stored[pid]; ok {
+				return nil
+			}
+			row, err := readProcess(ctx, tx, pid, r.lockRows)
+			if err != nil {
+				return err
+			}
+			stored[pid] = row
+			return nil
+		}
+		for _, g := range cs.Guards {
+			if err := read(g.ProcessID); err != nil {
+				return err
+			}
+		}
+		for _, p := range cs.Processes {
+			if err := read(p.ID); err != nil {
+				return err
+			}
+		}
+		for _, ev := range cs.Events {
+			if err := read(ev.ProcessID); err != nil {
+				return err
+			}
+		}
+
+		// --- precondition phase ---
+		for _, g := range cs.Guards {
+			row := stored[g.ProcessID]
+			if row == nil {
+				return goerr.Wrap(agentkit.ErrConflict, "guarded process does not exist",
+					goerr.V("process", g.ProcessID))
+			}
+			if row.process.Rev != g.Rev {
+				return goerr.Wrap(agentkit.ErrConflict, "guard revision mismatch",
+					goerr.V("process", g.ProcessID),
+					goerr.V("want_rev", g.Rev), goerr.V("stored_rev", row.process.Rev))
+			}
+		}
+		for _, p := range cs.Processes {
+			row := stored[p.ID]
+			if p.Rev == 0 {
+				if row != nil {
+					return goerr.Wrap(agentkit.ErrConflict, "process already exists",
+						goerr.V("process", p.ID))
+				}
+				continue
+			}
+			if row == nil {
+				return goerr.Wrap(agentkit.ErrConflict, "process does not exist",
+					goerr.V("process", p.ID), goerr.V("want_rev", p.Rev))
+			}
+			if row.process.Rev != p.Rev {
+				return goerr.Wrap(agentkit.ErrConflict, "process revision mismatch",
+					goerr.V("process", p.ID),
+					goerr.V("want_rev", p.Rev), goerr.V("stored_rev", row.process.Rev))
+			}
+		}
+
+		seqBase, err := eventSeqBasesOf(cs, stored)
+		if err != nil {
+			return err
+		}
+		seqNext := maps.Clone(seqBase)
+
+		// --- write phase ---
+		written := map[agentkit.ProcessID]struct{}{}
+		for _, p := range cs.Processes {
+			next := *p
+			next.Rev = p.Rev + 1
+			ok, err := writeProcess(ctx, tx, &next, eventSeqAfter(cs, p.ID, seqBase), p.Rev == 0)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				// An insert raced by another transaction between the read and
+				// the write.
+				return goerr.Wrap(agentkit.ErrConflict, "process already exists", goerr.V("process", p.ID))
+			}
+			written[p.ID] = struct{}{}
+
+			if p.Rev == 0 {
+				if p.IdempotencyKey != "" {
+					ok, err := insertGuard(ctx, tx, `
+						INSERT INTO agent_process_keys (idempotency_key, process_id) VALUES ($1, $2)
+						ON CONFLICT (idempotency_key) DO NOTHING`, p.IdempotencyKey, string(p.ID))
+					if err != nil {
+						return goerr.Wrap(err, "insert idempotency guard", goerr.V("process", p.ID))
+					}
+					if !ok {
+						return goerr.Wrap(agentkit.ErrConflict, "idempotency key already taken",
+							goerr.V("process", p.ID))
+					}
+				}
+				if p.Subject != nil {
+					ok, err := insertGuard(ctx, tx, `
+						INSERT INTO agent_process_subjects (kind, id, process_id) VALUES ($1, $2, $3)
+						ON CONFLICT (kind, id) DO NOTHING`, p.Subject.Kind, p.Subject.ID, string(p.ID))
+					if err != nil {
+						return goerr.Wrap(err, "insert subject guard", goerr.V("process", p.ID))
+					}
+					if !ok {
+						return goerr.Wrap(agentkit.ErrConflict, "subject already held",
+							goerr.V("process", p.ID), goerr.V("subject_kind", p.Subject.Kind))
+					}
+				}
+			}
+			// Reaching a terminal state releases the subject, but only the
+			// guard this Process holds. An insert that is already terminal
+			// takes the guard above and gives it back here, so it still
+			// conflicts with an open holder.
+			if p.Subject != nil && p.Status.Terminal() {
+				if _, err := tx.ExecContext(ctx,
+					`DELETE FROM agent_process_subjects WHERE kind = $1 AND id = $2 AND process_id = $3`,
+					p.Subject.Kind, p.Subject.ID, string(p.ID)); err != nil {
+					return goerr.Wrap(err, "delete subject guard", goerr.V("process", p.ID))
+				}
+			}
+		}
+
+		for _, aw := range cs.Awaits {
+			data, err := json.Marshal(aw)
+			if err != nil {
+				return goerr.Wrap(err, "encode await", goerr.V("process", aw.ProcessID))
+			}
+			if _, err := tx.ExecContext(ctx, `
+				INSERT INTO agent_process_awaits (process_id, await_key, data) VALUES ($1, $2, $3)
+				ON CONFLICT (process_id, await_key) DO UPDATE SET data = EXCLUDED.data`,
+				string(aw.ProcessID), string(aw.Key), string(data)); err != nil {
+				return goerr.Wrap(err, "write await",
+					goerr.V("process", aw.ProcessID), goerr.V("await_key", aw.Key))
+			}
+		}
+		for _, ev := range cs.Events {
+			seq := seqNext[ev.ProcessID]
+			seqNext[ev.ProcessID] = seq + 1
+			data, err := json.Marshal(ev)
+			if err != nil {
+				return goerr.Wrap(err, "encode event", goerr.V("process", ev.ProcessID))
+			}
+			// An event is an append: a reused id is a conflict, not a replace.
+			ok, err := insertGuard(ctx, tx, `
+				INSERT INTO agent_process_events (process_id, id, seq, data) VALUES ($1, $2, $3, $4)
+				ON CONFLICT (process_id, id) DO NOTHING`,
+				string(ev.ProcessID), string(ev.ID), seq, string(data))
+			if err != nil {
+				return goerr.Wrap(err, "insert event",
+					goerr.V("process", ev.ProcessID), goerr.V("event", ev.ID))
+			}
+			if !ok {
+				return goerr.Wrap(agentkit.ErrConflict, "event already exists",
+					goerr.V("process", ev.ProcessID), goerr.V("event", ev.ID))
+			}
+		}
+
+		// Rows that only had events appended record how far their numbering
+		// got, without a revision bump (see the Firestore Apply).
+		for pid, next := range seqNext {
+			if _, ok := written[pid]; ok {
+				continue
+			}
+			if next == seqBase[pid] {
+				continue
+			}
+			if _, err := tx.ExecContext(ctx,
+				`UPDATE agent_processes SET event_seq = $2 WHERE id = $1`, string(pid), next); err != nil {
+				return goerr.Wrap(err, "advance event sequence", goerr.V("process", pid))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, agentkit.ErrConflict) {
+			return err
+		}
+		return goerr.Wrap(err, "apply agent process change set")
+	}
+	return nil
+}
+
+// eventSeqBasesOf is Repository.eventSeqBases over rows read in a SQL
+// transaction: the stored event count of every Process the ChangeSet writes
+// or appends to, and a conflict for an event whose Process is neither stored
+// nor inserted here.
+func eventSeqBasesOf(cs agentkit.ChangeSet, stored map[agentkit.ProcessID]*storedProcess) (map[agentkit.ProcessID]int64, error) {
+	inserting := map[agentkit.ProcessID]struct{}{}
+	for _, p := range cs.Processes {
+		if p.Rev == 0 {
+			inserting[p.ID] = struct{}{}
+		}
+	}
+
+	bases := map[agentkit.ProcessID]int64{}
+	for _, p := range cs.Processes {
+		if row := stored[p.ID]; row != nil {
+			bases[p.ID] = row.eventSeq
+		} else {
+			bases[p.ID] = 0
+		}
+	}
+	for _, ev := range cs.Events {
+		if _, seen := bases[ev.ProcessID]; seen {
+			continue
+		}
+		row := stored[ev.ProcessID]
+		if row == nil {
+			if _, ok := inserting[ev.ProcessID]; !ok {
+				return nil, goerr.Wrap(agentkit.ErrConflict, "event for a process that does not exist",
+					goerr.V("process", ev.ProcessID), goerr.V("event", ev.ID))
+			}
+			bases[ev.ProcessID] = 0
+			continue
+		}
+		bases[ev.ProcessID] = row.eventSeq
+	}
+	return bases, nil
+}
@@ -0,0 +1,122 @@
+package agentproc_test
+
+import (
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gollem-dev/agentkit"
+	"github.com/gollem-dev/agentkit/repository/repotest"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/m-mizutani/gt"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/agentproc"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/postgres"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/sqlite"
+)
+
+// newSQLiteRepository builds a SQLRepository on a fresh database file
+// migrated with the sqlite backend's migrations, which create the Process
+// tables.
+func newSQLiteRepository(t *testing.T) *agentproc.SQLRepository {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "hecatoncheires.db")
+	_, err := sqlite.Migrate(context.Background(), path, false)
+	gt.NoError(t, err).Required()
+
+	repo, err := agentproc.NewSQLite(path)
+	gt.NoError(t, err).Required()
+	t.Cleanup(func() {
+		gt.NoError(t, repo.Close())
+	})
+	return repo
+}
+
+// newPostgresRepository builds a SQLRepository on a fresh schema migrated with
+// the postgres backend's migrations and dropped on cleanup, so every repotest
+// factory call starts empty. Like the interfaces.Repository suite it skips
+// when TEST_POSTGRES_DSN is unset.
+func newPostgresRepository(t *testing.T) *agentproc.SQLRepository {
+	t.Helper()
+	ctx := context.Background()
+
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN not set; skipping Postgres agent process test")
+	}
+
+	conn, err := pgx.Connect(ctx, dsn)
+	gt.NoError(t, err).Required()
+	t.Cleanup(func() {
+		gt.NoError(t, conn.Close(ctx))
+	})
+
+	schema := "test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	_, err = conn.Exec(ctx, "CREATE SCHEMA "+schema)
+	gt.NoError(t, err).Required()
+	t.Cleanup(func() {
+		_, err := conn.Exec(ctx, "DROP SCHEMA "+schema+" CASCADE")
+		gt.NoError(t, err)
+	})
+
+	schemaDSN := dsn + " search_path=" + schema
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		sep := "?"
+		if strings.Contains(dsn, "?") {
+			sep = "&"
+		}
+		schemaDSN = dsn + sep + "search_path=" + schema
+	}
+
+	_, err = postgres.Migrate(ctx, schemaDSN, false)
+	gt.NoError(t, err).Required()
+
+	repo, err := agentproc.NewPostgres(ctx, schemaDSN)
+	gt.NoError(t, err).Required()
+	t.Cleanup(func() {
+		gt.NoError(t, repo.Close())
+	})
+	return repo
+}
+
+// TestSQLRepository_SQLite runs agentkit's Repository contract suite against
+// the SQLite store.
+func TestSQLRepository_SQLite(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) agentkit.Repository {
+		return newSQLiteRepository(t)
+	})
+}
+
+// TestSQLRepository_Postgres runs agentkit's Repository contract suite against
+// the PostgreSQL store.
+func TestSQLRepository_Postgres(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) agentkit.Repository {
+		return newPostgresRepository(t)
+	})
+}
+
+func TestNewPostgres(t *testing.T) {
+	t.Run("empty dsn is rejected", func(t *testing.T) {
+		repo, err := agentproc.NewPostgres(context.Background(), "")
+		gt.Value(t, err).NotNil()
+		gt.Value(t, repo).Nil()
+	})
+}
+
+// TestClaimKey pins the stored claim_at. The sentinels must land on the ends
+// of the integer range: the zero time has no UnixNano, and claimNever lies
+// past the last instant UnixNano can represent.
+func TestClaimKey(t *testing.T) {
+	at := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	gt.Number(t, agentproc.ClaimKeyForTest(agentproc.ClaimImmediatelyForTest)).Equal(int64(math.MinInt64))
+	gt.Number(t, agentproc.ClaimKeyForTest(agentproc.ClaimNeverForTest)).Equal(int64(math.MaxInt64))
+	gt.Number(t, agentproc.ClaimKeyForTest(at)).Equal(at.UnixNano())
+	gt.Bool(t, agentproc.ClaimKeyForTest(at) < agentproc.ClaimKeyForTest(at.Add(time.Nanosecond))).True()
+}
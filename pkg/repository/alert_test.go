@@ -0,0 +1,139 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+)
+
+func newTestAlert(wsID string, caseID int64, fingerprint string, at time.Time) *model.Alert {
+	return &model.Alert{
+		ID:          model.NewAlertID(),
+		WorkspaceID: wsID,
+		CaseID:      caseID,
+		Fingerprint: fingerprint,
+		Title:       "Impossible travel",
+		Payload:     `{"rule":"impossible-travel"}`,
+		ReceivedAt:  at,
+	}
+}
+
+func runAlertRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.Repository) {
+	t.Helper()
+
+	t.Run("Create rejects an invalid alert", func(t *testing.T) {
+		repo := newRepo(t)
+		a := newTestAlert("ws", 1, "", time.Now())
+		gt.Error(t, repo.Alert().Create(context.Background(), a))
+	})
+
+	t.Run("ListByCase returns the case's alerts oldest first", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		first := newTestAlert(wsID, 1, "fp", now.Add(-time.Hour))
+		repeat := newTestAlert(wsID, 1, "fp", now)
+		repeat.Repeat = true
+		other := newTestAlert(wsID, 2, "fp-other", now)
+		for _, a := range []*model.Alert{repeat, first, other} {
+			gt.NoError(t, repo.Alert().Create(ctx, a)).Required()
+		}
+
+		got, err := repo.Alert().ListByCase(ctx, wsID, 1)
+		gt.NoError(t, err).Required()
+		gt.Array(t, got).Length(2).Required()
+		gt.Value(t, got[0].ID).Equal(first.ID)
+		gt.Bool(t, got[0].Repeat).False()
+		gt.Value(t, got[1].ID).Equal(repeat.ID)
+		gt.Bool(t, got[1].Repeat).True()
+		gt.Value(t, got[1].Payload).Equal(repeat.Payload)
+		gt.Bool(t, got[1].ReceivedAt.Equal(now)).True()
+
+		none, err := repo.Alert().ListByCase(ctx, wsID, 99)
+		gt.NoError(t, err).Required()
+		gt.Array(t, none).Length(0)
+	})
+
+	t.Run("LatestByFingerprint returns the newest match in the workspace", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		older := newTestAlert(wsID, 1, "fp", now.Add(-time.Hour))
+		newer := newTestAlert(wsID, 2, "fp", now)
+		elsewhere := newTestAlert("other-"+wsID, 3, "fp", now.Add(time.Hour))
+		for _, a := range []*model.Alert{newer, older, elsewhere} {
+			gt.NoError(t, repo.Alert().Create(ctx, a)).Required()
+		}
+
+		got, err := repo.Alert().LatestByFingerprint(ctx, wsID, "fp")
+		gt.NoError(t, err).Required()
+		gt.Value(t, got).NotNil().Required()
+		gt.Value(t, got.ID).Equal(newer.ID)
+		gt.Value(t, got.CaseID).Equal(int64(2))
+
+		missing, err := repo.Alert().LatestByFingerprint(ctx, wsID, "unknown")
+		gt.NoError(t, err).Required()
+		gt.Value(t, missing).Nil()
+	})
+
+	t.Run("MoveCase re-parents the case's alerts", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		moved := newTestAlert(wsID, 1, "fp", now.Add(-time.Hour))
+		stays := newTestAlert(wsID, 2, "fp-other", now)
+		for _, a := range []*model.Alert{moved, stays} {
+			gt.NoError(t, repo.Alert().Create(ctx, a)).Required()
+		}
+
+		gt.NoError(t, repo.Alert().MoveCase(ctx, wsID, 1, 2)).Required()
+
+		left, err := repo.Alert().ListByCase(ctx, wsID, 1)
+		gt.NoError(t, err).Required()
+		gt.Array(t, left).Length(0)
+
+		got, err := repo.Alert().ListByCase(ctx, wsID, 2)
+		gt.NoError(t, err).Required()
+		gt.Array(t, got).Length(2).Required()
+		gt.Value(t, got[0].ID).Equal(moved.ID)
+		gt.Value(t, got[0].CaseID).Equal(int64(2))
+
+		latest, err := repo.Alert().LatestByFingerprint(ctx, wsID, "fp")
+		gt.NoError(t, err).Required()
+		gt.Value(t, latest.CaseID).Equal(int64(2))
+	})
+}
+
+func TestAlertRepository_Memory(t *testing.T) {
+	t.Parallel()
+	runAlertRepositoryTest(t, func(t *testing.T) interfaces.Repository {
+		return memory.New()
+	})
+}
+
+func TestAlertRepository_Firestore(t *testing.T) {
+	t.Parallel()
+	runAlertRepositoryTest(t, newFirestoreRepository)
+}
+
+func TestAlertRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runAlertRepositoryTest(t, newPostgresRepository)
+}
+
+func TestAlertRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runAlertRepositoryTest(t, newSQLiteRepository)
+}
@@ -12,13 +12,15 @@ import (
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/firestore"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/postgres"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/sqlite"
 )
 
 func runAssigneeRankingRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.Repository) {
 	t.Helper()
 
 	isNotFound := func(err error) bool {
-		return errors.Is(err, memory.ErrNotFound) || errors.Is(err, firestore.ErrNotFound)
+		return errors.Is(err, memory.ErrNotFound) || errors.Is(err, firestore.ErrNotFound) || errors.Is(err, postgres.ErrNotFound) || errors.Is(err, sqlite.ErrNotFound)
 	}
 
 	repo := newRepo(t)
@@ -147,3 +149,13 @@ func TestAssigneeRankingRepository_Firestore(t *testing.T) {
 	t.Parallel()
 	runAssigneeRankingRepositoryTest(t, newFirestoreRepository)
 }
+
+func TestAssigneeRankingRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runAssigneeRankingRepositoryTest(t, newPostgresRepository)
+}
+
+func TestAssigneeRankingRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runAssigneeRankingRepositoryTest(t, newSQLiteRepository)
+}
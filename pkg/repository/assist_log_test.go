@@ -238,3 +238,13 @@ func TestFirestoreAssistLogRepository(t *testing.T) {
 	t.Parallel()
 	runAssistLogRepositoryTest(t, newFirestoreRepository)
 }
+
+func TestPostgresAssistLogRepository(t *testing.T) {
+	t.Parallel()
+	runAssistLogRepositoryTest(t, newPostgresRepository)
+}
+
+func TestSQLiteAssistLogRepository(t *testing.T) {
+	t.Parallel()
+	runAssistLogRepositoryTest(t, newSQLiteRepository)
+}
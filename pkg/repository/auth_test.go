@@ -3,6 +3,7 @@ package repository_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -11,6 +12,8 @@ import (
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/firestore"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/postgres"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/sqlite"
 )
 
 func runAuthRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.Repository) {
@@ -48,7 +51,7 @@ func runAuthRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.R
 		gt.Value(t, err).NotNil().Required()
 
 		// Check if it's a NotFound error
-		gt.Bool(t, errors.Is(err, firestore.ErrNotFound) || errors.Is(err, memory.ErrNotFound)).True()
+		gt.Bool(t, errors.Is(err, firestore.ErrNotFound) || errors.Is(err, memory.ErrNotFound) || errors.Is(err, postgres.ErrNotFound) || errors.Is(err, sqlite.ErrNotFound)).True()
 	})
 
 	t.Run("DeleteToken", func(t *testing.T) {
@@ -66,7 +69,7 @@ func runAuthRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.R
 		// Verify it's deleted
 		_, err := repo.GetToken(ctx, token.ID)
 		gt.Value(t, err).NotNil().Required()
-		gt.Bool(t, errors.Is(err, firestore.ErrNotFound) || errors.Is(err, memory.ErrNotFound)).True()
+		gt.Bool(t, errors.Is(err, firestore.ErrNotFound) || errors.Is(err, memory.ErrNotFound) || errors.Is(err, postgres.ErrNotFound) || errors.Is(err, sqlite.ErrNotFound)).True()
 	})
 
 	t.Run("DeleteToken not found", func(t *testing.T) {
@@ -76,7 +79,7 @@ func runAuthRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.R
 		nonExistentID := auth.NewTokenID()
 		err := repo.DeleteToken(ctx, nonExistentID)
 		gt.Value(t, err).NotNil().Required()
-		gt.Bool(t, errors.Is(err, firestore.ErrNotFound) || errors.Is(err, memory.ErrNotFound)).True()
+		gt.Bool(t, errors.Is(err, firestore.ErrNotFound) || errors.Is(err, memory.ErrNotFound) || errors.Is(err, postgres.ErrNotFound) || errors.Is(err, sqlite.ErrNotFound)).True()
 	})
 
 	t.Run("Token validation on Put", func(t *testing.T) {
@@ -97,6 +100,97 @@ func runAuthRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.R
 		err := repo.PutToken(ctx, invalidToken)
 		gt.Value(t, err).NotNil().Required()
 	})
+
+	isNotFound := func(err error) bool {
+		return errors.Is(err, firestore.ErrNotFound) || errors.Is(err, memory.ErrNotFound) || errors.Is(err, postgres.ErrNotFound) || errors.Is(err, sqlite.ErrNotFound)
+	}
+
+	t.Run("PutAPIToken and GetAPIToken", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		owner := auth.NewToken(fmt.Sprintf("U%d", time.Now().UnixNano()), "owner@example.com", "Owner")
+		token, secret := auth.NewAPIToken(owner, "grafana", []string{"ws-a"}, true, time.Hour)
+		gt.NoError(t, repo.PutAPIToken(ctx, token)).Required()
+
+		got, err := repo.GetAPIToken(ctx, token.ID)
+		gt.NoError(t, err).Required()
+		gt.Value(t, got.Name).Equal("grafana")
+		gt.Value(t, got.Sub).Equal(owner.Sub)
+		gt.Value(t, got.UserName).Equal("Owner")
+		gt.Value(t, got.Workspaces).Equal([]string{"ws-a"})
+		gt.Bool(t, got.ReadOnly).True()
+		gt.Value(t, got.LastUsedAt).Nil()
+		gt.Bool(t, got.Verify(secret)).True()
+		gt.Bool(t, got.ExpiresAt.Sub(token.ExpiresAt).Abs() < time.Second).True()
+
+		_, err = repo.GetAPIToken(ctx, auth.NewTokenID())
+		gt.Bool(t, isNotFound(err)).True()
+	})
+
+	t.Run("ListAPITokens returns the owner's tokens newest first", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		owner := auth.NewToken(fmt.Sprintf("U%d", time.Now().UnixNano()), "owner@example.com", "Owner")
+		other := auth.NewToken(fmt.Sprintf("U%d-other", time.Now().UnixNano()), "other@example.com", "Other")
+
+		older, _ := auth.NewAPIToken(owner, "older", nil, false, time.Hour)
+		older.CreatedAt = time.Now().Add(-time.Hour)
+		newer, _ := auth.NewAPIToken(owner, "newer", nil, false, time.Hour)
+		foreign, _ := auth.NewAPIToken(other, "foreign", nil, false, time.Hour)
+		for _, tok := range []*auth.APIToken{older, newer, foreign} {
+			gt.NoError(t, repo.PutAPIToken(ctx, tok)).Required()
+		}
+
+		tokens, err := repo.ListAPITokens(ctx, owner.Sub)
+		gt.NoError(t, err).Required()
+		gt.Array(t, tokens).Length(2).Required()
+		gt.Value(t, tokens[0].ID).Equal(newer.ID)
+		gt.Value(t, tokens[1].ID).Equal(older.ID)
+
+		none, err := repo.ListAPITokens(ctx, "U-nobody")
+		gt.NoError(t, err).Required()
+		gt.Array(t, none).Length(0)
+	})
+
+	t.Run("TouchAPIToken records the last use", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		owner := auth.NewToken(fmt.Sprintf("U%d", time.Now().UnixNano()), "owner@example.com", "Owner")
+		token, _ := auth.NewAPIToken(owner, "script", nil, false, time.Hour)
+		gt.NoError(t, repo.PutAPIToken(ctx, token)).Required()
+
+		usedAt := time.Now().Add(-time.Minute).UTC()
+		gt.NoError(t, repo.TouchAPIToken(ctx, token.ID, usedAt)).Required()
+
+		got, err := repo.GetAPIToken(ctx, token.ID)
+		gt.NoError(t, err).Required()
+		gt.Value(t, got.LastUsedAt).NotNil().Required()
+		gt.Bool(t, got.LastUsedAt.Sub(usedAt).Abs() < time.Second).True()
+		gt.Value(t, got.Name).Equal("script")
+	})
+
+	t.Run("DeleteAPIToken", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		owner := auth.NewToken(fmt.Sprintf("U%d", time.Now().UnixNano()), "owner@example.com", "Owner")
+		token, _ := auth.NewAPIToken(owner, "script", nil, false, time.Hour)
+		gt.NoError(t, repo.PutAPIToken(ctx, token)).Required()
+
+		gt.NoError(t, repo.DeleteAPIToken(ctx, token.ID)).Required()
+		_, err := repo.GetAPIToken(ctx, token.ID)
+		gt.Bool(t, isNotFound(err)).True()
+
+		// A revoked token stays revoked: neither a second delete nor a late
+		// touch brings it back.
+		gt.Bool(t, isNotFound(repo.DeleteAPIToken(ctx, token.ID))).True()
+		gt.Bool(t, isNotFound(repo.TouchAPIToken(ctx, token.ID, time.Now()))).True()
+		_, err = repo.GetAPIToken(ctx, token.ID)
+		gt.Bool(t, isNotFound(err)).True()
+	})
 }
 
 func TestMemoryRepository(t *testing.T) {
@@ -110,3 +204,13 @@ func TestFirestoreRepository(t *testing.T) {
 	t.Parallel()
 	runAuthRepositoryTest(t, newFirestoreRepository)
 }
+
+func TestPostgresRepository(t *testing.T) {
+	t.Parallel()
+	runAuthRepositoryTest(t, newPostgresRepository)
+}
+
+func TestSQLiteRepository(t *testing.T) {
+	t.Parallel()
+	runAuthRepositoryTest(t, newSQLiteRepository)
+}
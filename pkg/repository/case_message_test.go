@@ -224,6 +224,35 @@ func runCaseMessageRepositoryTest(t *testing.T, newRepo func(t *testing.T) inter
 		gt.Array(t, messages).Length(1)
 		gt.Value(t, messages[0].Text()).Equal("updated")
 	})
+
+	t.Run("MoveCase re-parents every message", func(t *testing.T) {
+		repo := newRepo(t)
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		fromCaseID := time.Now().UnixNano()
+		toCaseID := fromCaseID + 1
+
+		now := time.Now().UTC().Truncate(time.Millisecond)
+		for i, caseID := range []int64{fromCaseID, fromCaseID, toCaseID} {
+			msg := slack.NewMessageFromData(
+				fmt.Sprintf("msg-%d-%d", now.UnixNano(), i),
+				"C123", "", "T123", "U001", "alice", fmt.Sprintf("text %d", i), "ev",
+				now.Add(time.Duration(i)*time.Second),
+				nil,
+			)
+			gt.NoError(t, repo.CaseMessage().Put(ctx, wsID, caseID, msg)).Required()
+		}
+
+		gt.NoError(t, repo.CaseMessage().MoveCase(ctx, wsID, fromCaseID, toCaseID)).Required()
+
+		left, _, err := repo.CaseMessage().List(ctx, wsID, fromCaseID, 10, "")
+		gt.NoError(t, err).Required()
+		gt.Array(t, left).Length(0)
+
+		moved, _, err := repo.CaseMessage().List(ctx, wsID, toCaseID, 10, "")
+		gt.NoError(t, err).Required()
+		gt.Array(t, moved).Length(3).Required()
+		gt.Value(t, moved[2].Text()).Equal("text 0")
+	})
 }
 
 func TestCaseMessageRepository_Memory(t *testing.T) {
@@ -237,3 +266,13 @@ func TestCaseMessageRepository_Firestore(t *testing.T) {
 	t.Parallel()
 	runCaseMessageRepositoryTest(t, newFirestoreRepository)
 }
+
+func TestCaseMessageRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runCaseMessageRepositoryTest(t, newPostgresRepository)
+}
+
+func TestCaseMessageRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runCaseMessageRepositoryTest(t, newSQLiteRepository)
+}
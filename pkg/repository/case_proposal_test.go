@@ -12,6 +12,8 @@ import (
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/firestore"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/postgres"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/sqlite"
 )
 
 func runCaseDraftRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.Repository) {
@@ -66,7 +68,7 @@ func runCaseDraftRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfa
 
 		_, err := repo.Get(ctx, model.NewCaseProposalID())
 		gt.Value(t, err).NotNil().Required()
-		gt.Bool(t, errors.Is(err, firestore.ErrNotFound) || errors.Is(err, memory.ErrNotFound)).True()
+		gt.Bool(t, errors.Is(err, firestore.ErrNotFound) || errors.Is(err, memory.ErrNotFound) || errors.Is(err, postgres.ErrNotFound) || errors.Is(err, sqlite.ErrNotFound)).True()
 	})
 
 	t.Run("SetMaterialization sets and overwrites", func(t *testing.T) {
@@ -136,7 +138,7 @@ func runCaseDraftRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfa
 
 		err := repo.SetMaterialization(ctx, model.NewCaseProposalID(), "ws", nil, false)
 		gt.Value(t, err).NotNil().Required()
-		gt.Bool(t, errors.Is(err, firestore.ErrNotFound) || errors.Is(err, memory.ErrNotFound)).True()
+		gt.Bool(t, errors.Is(err, firestore.ErrNotFound) || errors.Is(err, memory.ErrNotFound) || errors.Is(err, postgres.ErrNotFound) || errors.Is(err, sqlite.ErrNotFound)).True()
 	})
 
 	t.Run("Delete", func(t *testing.T) {
@@ -150,11 +152,11 @@ func runCaseDraftRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfa
 
 		_, err := repo.Get(ctx, d.ID)
 		gt.Value(t, err).NotNil().Required()
-		gt.Bool(t, errors.Is(err, firestore.ErrNotFound) || errors.Is(err, memory.ErrNotFound)).True()
+		gt.Bool(t, errors.Is(err, firestore.ErrNotFound) || errors.Is(err, memory.ErrNotFound) || errors.Is(err, postgres.ErrNotFound) || errors.Is(err, sqlite.ErrNotFound)).True()
 
 		err = repo.Delete(ctx, d.ID)
 		gt.Value(t, err).NotNil().Required()
-		gt.Bool(t, errors.Is(err, firestore.ErrNotFound) || errors.Is(err, memory.ErrNotFound)).True()
+		gt.Bool(t, errors.Is(err, firestore.ErrNotFound) || errors.Is(err, memory.ErrNotFound) || errors.Is(err, postgres.ErrNotFound) || errors.Is(err, sqlite.ErrNotFound)).True()
 	})
 
 	t.Run("Expired draft is treated as not found", func(t *testing.T) {
@@ -173,7 +175,7 @@ func runCaseDraftRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfa
 
 		_, err := repo.Get(ctx, d.ID)
 		gt.Value(t, err).NotNil().Required()
-		gt.Bool(t, errors.Is(err, firestore.ErrNotFound) || errors.Is(err, memory.ErrNotFound)).True()
+		gt.Bool(t, errors.Is(err, firestore.ErrNotFound) || errors.Is(err, memory.ErrNotFound) || errors.Is(err, postgres.ErrNotFound) || errors.Is(err, sqlite.ErrNotFound)).True()
 	})
 
 	t.Run("Save with empty ID rejected", func(t *testing.T) {
@@ -197,3 +199,13 @@ func TestFirestoreCaseDraftRepository(t *testing.T) {
 	t.Parallel()
 	runCaseDraftRepositoryTest(t, newFirestoreRepository)
 }
+
+func TestPostgresCaseDraftRepository(t *testing.T) {
+	t.Parallel()
+	runCaseDraftRepositoryTest(t, newPostgresRepository)
+}
+
+func TestSQLiteCaseDraftRepository(t *testing.T) {
+	t.Parallel()
+	runCaseDraftRepositoryTest(t, newSQLiteRepository)
+}
@@ -5,15 +5,21 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/m-mizutani/gt"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/firestore"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/postgres"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/sqlite"
 )
 
 func runCaseRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.Repository) {
@@ -789,6 +795,7 @@ func runCaseRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.R
 
 		created := time.Now().UTC().Truncate(time.Second)
 		threadTS := fmt.Sprintf("%d.000777", time.Now().UnixNano())
+		responded := created.Add(10 * time.Minute)
 		want := &model.Case{
 			ReporterID:            "U-REPORTER",
 			AssigneeIDs:           []string{"U-A1", "U-A2"},
@@ -809,6 +816,13 @@ func runCaseRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.R
 			FieldValues: map[string]model.FieldValue{
 				"severity": {FieldID: "severity", Type: types.FieldTypeSelect, Value: "high"},
 			},
+			SLA: model.CaseSLA{
+				RespondedAt: &responded,
+				Pauses: []model.SLAPause{
+					{From: created.Add(time.Minute), To: &responded},
+					{From: responded.Add(time.Minute)},
+				},
+			},
 		}
 		createdCase, err := repo.Case().Create(ctx, wsID, want)
 		gt.NoError(t, err).Required()
@@ -835,6 +849,15 @@ func runCaseRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.R
 		gt.Value(t, got.RequestKey).Equal(want.RequestKey)
 		gt.Value(t, got.AgentAdditionalPrompt).Equal("extra prompt")
 		gt.Value(t, got.AgentSourceIDs).Equal([]model.SourceID{"src-1"})
+		gt.Value(t, got.SLA.StartedAt).Nil()
+		gt.Value(t, got.SLA.ResolvedAt).Nil()
+		gt.Value(t, got.SLA.RespondedAt).NotNil().Required()
+		gt.Bool(t, got.SLA.RespondedAt.Equal(responded)).True()
+		gt.Array(t, got.SLA.Pauses).Length(2).Required()
+		gt.Bool(t, got.SLA.Pauses[0].From.Equal(created.Add(time.Minute))).True()
+		gt.Value(t, got.SLA.Pauses[0].To).NotNil().Required()
+		gt.Bool(t, got.SLA.Pauses[0].To.Equal(responded)).True()
+		gt.Value(t, got.SLA.Pauses[1].To).Nil()
 		gt.Bool(t, got.CreatedAt.Equal(created)).True()
 		gt.Bool(t, got.UpdatedAt.Equal(created)).True()
 
@@ -1443,7 +1466,7 @@ func runCaseRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.R
 		ctx := context.Background()
 
 		called := false
-		// memory and firestore expose distinct ErrNotFound sentinels, so the
+		// each backend exposes its own ErrNotFound sentinel, so the
 		// shared helper asserts only that the operation fails on a missing case.
 		_, err := repo.Case().Transact(ctx, wsID, 99999, func(c *model.Case) error {
 			called = true
@@ -1452,6 +1475,198 @@ func runCaseRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.R
 		gt.Error(t, err)
 		gt.Bool(t, called).False()
 	})
+
+	t.Run("Search filters by assignee, reporter, field, text and IsTest", func(t *testing.T) {
+		repo := newRepo(t)
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		ctx := context.Background()
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		mk := func(title, reporter string, assignees []string, isTest bool, fields map[string]model.FieldValue) *model.Case {
+			created, err := repo.Case().Create(ctx, wsID, &model.Case{
+				Title:       title,
+				ReporterID:  reporter,
+				AssigneeIDs: assignees,
+				IsTest:      isTest,
+				FieldValues: fields,
+				CreatedAt:   now,
+				UpdatedAt:   now,
+			})
+			gt.NoError(t, err).Required()
+			return created
+		}
+
+		phishing := mk("Phishing mail", "U1", []string{"U2"}, false, map[string]model.FieldValue{
+			"severity": {FieldID: "severity", Type: types.FieldTypeSelect, Value: "high"},
+			"score":    {FieldID: "score", Type: types.FieldTypeNumber, Value: 8.5},
+			"due":      {FieldID: "due", Type: types.FieldTypeDate, Value: "2026-05-10"},
+		})
+		malware := mk("Malware on host", "U2", []string{"U3"}, false, map[string]model.FieldValue{
+			"severity": {FieldID: "severity", Type: types.FieldTypeSelect, Value: "low"},
+			"score":    {FieldID: "score", Type: types.FieldTypeNumber, Value: 2.0},
+			"tags":     {FieldID: "tags", Type: types.FieldTypeMultiSelect, Value: []string{"edr", "PHISHING-kit"}},
+		})
+		drill := mk("Tabletop drill", "U1", nil, true, nil)
+
+		ids := func(cases []*model.Case) []int64 {
+			out := make([]int64, 0, len(cases))
+			for _, c := range cases {
+				out = append(out, c.ID)
+			}
+			return out
+		}
+		search := func(opts ...interfaces.ListCaseOption) []int64 {
+			cases, next, err := repo.Case().Search(ctx, wsID, interfaces.CaseSort{Key: interfaces.CaseSortID, Ascending: true}, 0, "", opts...)
+			gt.NoError(t, err).Required()
+			gt.Value(t, next).Equal("")
+			return ids(cases)
+		}
+
+		gt.Value(t, search()).Equal([]int64{phishing.ID, malware.ID, drill.ID})
+		gt.Value(t, search(interfaces.WithAssignee("U3"))).Equal([]int64{malware.ID})
+		gt.Value(t, search(interfaces.WithReporter("U1"))).Equal([]int64{phishing.ID, drill.ID})
+		gt.Value(t, search(interfaces.WithIsTest(true))).Equal([]int64{drill.ID})
+		gt.Value(t, search(interfaces.WithIsTest(false), interfaces.WithReporter("U1"))).Equal([]int64{phishing.ID})
+
+		// Text matches the title and string field values, case-insensitively.
+		gt.Value(t, search(interfaces.WithText("  phishing "))).Equal([]int64{phishing.ID, malware.ID})
+
+		gt.Value(t, search(interfaces.WithFieldFilter(interfaces.CaseFieldFilter{
+			FieldID: "severity", OptionIDs: []string{"high", "critical"},
+		}))).Equal([]int64{phishing.ID})
+		gt.Value(t, search(interfaces.WithFieldFilter(interfaces.CaseFieldFilter{
+			FieldID: "tags", OptionIDs: []string{"edr"},
+		}))).Equal([]int64{malware.ID})
+
+		minScore := 5.0
+		gt.Value(t, search(interfaces.WithFieldFilter(interfaces.CaseFieldFilter{
+			FieldID: "score", NumberMin: &minScore,
+		}))).Equal([]int64{phishing.ID})
+
+		gt.Value(t, search(interfaces.WithFieldFilter(interfaces.CaseFieldFilter{
+			FieldID:  "due",
+			DateFrom: time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC),
+			DateTo:   time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC),
+		}))).Equal([]int64{phishing.ID})
+	})
+
+	t.Run("Search paginates with a cursor in the requested order", func(t *testing.T) {
+		repo := newRepo(t)
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		ctx := context.Background()
+		base := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+		var want []int64
+		for i := 0; i < 5; i++ {
+			created, err := repo.Case().Create(ctx, wsID, &model.Case{
+				Title:      fmt.Sprintf("case %d", i),
+				ReporterID: "U1",
+				CreatedAt:  base.Add(time.Duration(i) * time.Hour),
+				UpdatedAt:  base.Add(time.Duration(i) * time.Hour),
+			})
+			gt.NoError(t, err).Required()
+			want = append([]int64{created.ID}, want...)
+		}
+
+		var got []int64
+		cursor := ""
+		for page := 0; page < 3; page++ {
+			cases, next, err := repo.Case().Search(ctx, wsID, interfaces.CaseSort{}, 2, cursor)
+			gt.NoError(t, err).Required()
+			for _, c := range cases {
+				got = append(got, c.ID)
+			}
+			cursor = next
+			if cursor == "" {
+				break
+			}
+		}
+		gt.Value(t, cursor).Equal("")
+		gt.Value(t, got).Equal(want)
+
+		// A cursor issued for one sort is rejected by another.
+		_, next, err := repo.Case().Search(ctx, wsID, interfaces.CaseSort{}, 2, "")
+		gt.NoError(t, err).Required()
+		_, _, err = repo.Case().Search(ctx, wsID, interfaces.CaseSort{Key: interfaces.CaseSortUpdatedAt}, 2, next)
+		gt.Bool(t, errors.Is(err, interfaces.ErrInvalidCaseCursor)).True()
+	})
+
+	t.Run("Search pages through ties and cases a Go filter rejects", func(t *testing.T) {
+		repo := newRepo(t)
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		ctx := context.Background()
+		at := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+		// Every case shares one timestamp, so only the ID tie-break orders
+		// them; every other one carries the text the search looks for.
+		want := map[int64]bool{}
+		for i := 0; i < 7; i++ {
+			title := fmt.Sprintf("noise %d", i)
+			if i%2 == 0 {
+				title = fmt.Sprintf("VPN outage %d", i)
+			}
+			created, err := repo.Case().Create(ctx, wsID, &model.Case{
+				Title: title, ReporterID: "U1", CreatedAt: at, UpdatedAt: at,
+			})
+			gt.NoError(t, err).Required()
+			if i%2 == 0 {
+				want[created.ID] = true
+			}
+		}
+
+		got := map[int64]bool{}
+		cursor := ""
+		for page := 0; page < 10; page++ {
+			cases, next, err := repo.Case().Search(ctx, wsID, interfaces.CaseSort{}, 1, cursor, interfaces.WithText("vpn"))
+			gt.NoError(t, err).Required()
+			for _, c := range cases {
+				gt.Bool(t, got[c.ID]).False()
+				got[c.ID] = true
+			}
+			cursor = next
+			if cursor == "" {
+				break
+			}
+		}
+		gt.Value(t, cursor).Equal("")
+		gt.Value(t, got).Equal(want)
+	})
+
+	t.Run("Search keeps excluding drafts and honours the created range", func(t *testing.T) {
+		repo := newRepo(t)
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		ctx := context.Background()
+		base := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+		older, err := repo.Case().Create(ctx, wsID, &model.Case{
+			Title: "older", ReporterID: "U1", CreatedAt: base, UpdatedAt: base,
+		})
+		gt.NoError(t, err).Required()
+		newer, err := repo.Case().Create(ctx, wsID, &model.Case{
+			Title: "newer", ReporterID: "U1", CreatedAt: base.Add(48 * time.Hour), UpdatedAt: base.Add(48 * time.Hour),
+		})
+		gt.NoError(t, err).Required()
+		_, err = repo.Case().Create(ctx, wsID, &model.Case{
+			Title: "draft", ReporterID: "U1", Status: types.CaseStatusDraft, CreatedAt: base, UpdatedAt: base,
+		})
+		gt.NoError(t, err).Required()
+
+		cases, _, err := repo.Case().Search(ctx, wsID, interfaces.CaseSort{}, 0, "")
+		gt.NoError(t, err).Required()
+		gt.Array(t, cases).Length(2)
+
+		cases, _, err = repo.Case().Search(ctx, wsID, interfaces.CaseSort{}, 0, "",
+			interfaces.WithCreatedBetween(base, base.Add(24*time.Hour)))
+		gt.NoError(t, err).Required()
+		gt.Array(t, cases).Length(1)
+		gt.Value(t, cases[0].ID).Equal(older.ID)
+
+		cases, _, err = repo.Case().Search(ctx, wsID, interfaces.CaseSort{}, 0, "",
+			interfaces.WithCreatedBetween(base.Add(24*time.Hour), time.Time{}))
+		gt.NoError(t, err).Required()
+		gt.Array(t, cases).Length(1)
+		gt.Value(t, cases[0].ID).Equal(newer.ID)
+	})
 }
 
 func TestCaseRepository_Memory(t *testing.T) {
@@ -1466,6 +1681,16 @@ func TestCaseRepository_Firestore(t *testing.T) {
 	runCaseRepositoryTest(t, newFirestoreRepository)
 }
 
+func TestCaseRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runCaseRepositoryTest(t, newPostgresRepository)
+}
+
+func TestCaseRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runCaseRepositoryTest(t, newSQLiteRepository)
+}
+
 // newFirestoreRepository constructs a Firestore-backed
 // interfaces.Repository for tests. This is the single place that reads
 // TEST_FIRESTORE_PROJECT_ID / TEST_FIRESTORE_DATABASE_ID — every
@@ -1515,6 +1740,78 @@ func newFirestoreRepository(t *testing.T) interfaces.Repository {
 	return repo
 }
 
+// newPostgresRepository constructs a PostgreSQL-backed
+// interfaces.Repository for tests, on a fresh schema migrated with the
+// embedded migrations and dropped on cleanup, so every call starts empty.
+//
+// TEST_POSTGRES_DSN names the server, as for the pgexport sink tests. Unlike
+// newFirestoreRepository it skips when the variable is unset: there is no
+// emulator to default to, and CI always sets it, so the Postgres runs cannot
+// silently drop out there.
+func newPostgresRepository(t *testing.T) interfaces.Repository {
+	t.Helper()
+	ctx := context.Background()
+
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN not set; skipping Postgres repository test")
+	}
+
+	conn, err := pgx.Connect(ctx, dsn)
+	gt.NoError(t, err).Required()
+	t.Cleanup(func() {
+		gt.NoError(t, conn.Close(ctx))
+	})
+
+	schema := "test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	_, err = conn.Exec(ctx, "CREATE SCHEMA "+schema)
+	gt.NoError(t, err).Required()
+	t.Cleanup(func() {
+		_, err := conn.Exec(ctx, "DROP SCHEMA "+schema+" CASCADE")
+		gt.NoError(t, err)
+	})
+
+	// search_path is passed as a connection parameter, in whichever DSN form
+	// the caller used.
+	schemaDSN := dsn + " search_path=" + schema
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		sep := "?"
+		if strings.Contains(dsn, "?") {
+			sep = "&"
+		}
+		schemaDSN = dsn + sep + "search_path=" + schema
+	}
+
+	_, err = postgres.Migrate(ctx, schemaDSN, false)
+	gt.NoError(t, err).Required()
+
+	repo, err := postgres.New(ctx, schemaDSN)
+	gt.NoError(t, err).Required()
+	t.Cleanup(func() {
+		gt.NoError(t, repo.Close())
+	})
+	return repo
+}
+
+// newSQLiteRepository constructs a SQLite-backed interfaces.Repository for
+// tests, on a fresh database file migrated with the embedded migrations. It
+// needs no server, so unlike newPostgresRepository it never skips.
+func newSQLiteRepository(t *testing.T) interfaces.Repository {
+	t.Helper()
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "hecatoncheires.db")
+	_, err := sqlite.Migrate(ctx, path, false)
+	gt.NoError(t, err).Required()
+
+	repo, err := sqlite.New(ctx, path)
+	gt.NoError(t, err).Required()
+	t.Cleanup(func() {
+		gt.NoError(t, repo.Close())
+	})
+	return repo
+}
+
 // toStringSlice coerces a FieldValue.Value loaded from either backend into
 // a `[]string` for assertion. Memory stores the original `[]string`;
 // Firestore round-trips through `DataTo` and returns `[]interface{}`. Both
@@ -0,0 +1,103 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+)
+
+func runExportStateRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.Repository) {
+	t.Helper()
+
+	t.Run("GetWatermark returns nil before the first put", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+
+		got, err := repo.ExportState().GetWatermark(ctx, wsID, "postgres", "risk", "cases")
+		gt.NoError(t, err).Required()
+		gt.Value(t, got).Nil()
+	})
+
+	t.Run("PutWatermark round-trips and is keyed per sink", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		gt.NoError(t, repo.ExportState().PutWatermark(ctx, &model.ExportWatermark{
+			WorkspaceID: wsID, Sink: "postgres", Namespace: "risk", Table: "cases",
+			HighWater: now, SchemaHash: "abc", UpdatedAt: now,
+		})).Required()
+
+		got, err := repo.ExportState().GetWatermark(ctx, wsID, "postgres", "risk", "cases")
+		gt.NoError(t, err).Required()
+		gt.Value(t, got).NotNil().Required()
+		gt.Bool(t, got.HighWater.Equal(now)).True()
+		gt.Value(t, got.SchemaHash).Equal("abc")
+
+		other, err := repo.ExportState().GetWatermark(ctx, wsID, "bigquery", "risk", "cases")
+		gt.NoError(t, err).Required()
+		gt.Value(t, other).Nil()
+	})
+
+	t.Run("PutWatermark rejects an incomplete key", func(t *testing.T) {
+		repo := newRepo(t)
+		err := repo.ExportState().PutWatermark(context.Background(), &model.ExportWatermark{
+			WorkspaceID: "ws", Namespace: "risk", Table: "cases", HighWater: time.Now(),
+		})
+		gt.Error(t, err)
+	})
+
+	t.Run("ListTombstones returns only deletions after since", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		base := time.Now().UTC().Truncate(time.Millisecond)
+
+		for i, at := range []time.Time{base, base.Add(time.Hour), base.Add(2 * time.Hour)} {
+			gt.NoError(t, repo.ExportState().PutTombstone(ctx, &model.Tombstone{
+				WorkspaceID: wsID, Entity: model.TombstoneEntityCase,
+				EntityID: fmt.Sprintf("%d", i+1), DeletedAt: at,
+			})).Required()
+		}
+
+		got, err := repo.ExportState().ListTombstones(ctx, wsID, base.Add(time.Hour))
+		gt.NoError(t, err).Required()
+		gt.Array(t, got).Length(1).Required()
+		gt.Value(t, got[0].EntityID).Equal("3")
+		gt.Value(t, got[0].Entity).Equal(model.TombstoneEntityCase)
+
+		none, err := repo.ExportState().ListTombstones(ctx, "other-"+wsID, time.Time{})
+		gt.NoError(t, err).Required()
+		gt.Array(t, none).Length(0)
+	})
+}
+
+func TestExportStateRepository_Memory(t *testing.T) {
+	t.Parallel()
+	runExportStateRepositoryTest(t, func(t *testing.T) interfaces.Repository {
+		return memory.New()
+	})
+}
+
+func TestExportStateRepository_Firestore(t *testing.T) {
+	t.Parallel()
+	runExportStateRepositoryTest(t, newFirestoreRepository)
+}
+
+func TestExportStateRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runExportStateRepositoryTest(t, newPostgresRepository)
+}
+
+func TestExportStateRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runExportStateRepositoryTest(t, newSQLiteRepository)
+}
@@ -144,7 +144,13 @@ func (r *actionRepository) GetByIDs(ctx context.Context, workspaceID string, ids
 }
 
 func (r *actionRepository) List(ctx context.Context, workspaceID string, opts interfaces.ActionListOptions) ([]*model.Action, error) {
-	iter := r.actionsCollection(workspaceID).Documents(ctx)
+	query := r.actionsCollection(workspaceID).Query
+	if opts.UpdatedAfter != nil {
+		// A lone range filter uses the automatic single-field index; the
+		// archive scope is still applied in memory below.
+		query = query.Where("UpdatedAt", ">", *opts.UpdatedAfter)
+	}
+	iter := query.Documents(ctx)
 	defer iter.Stop()
 
 	actions := make([]*model.Action, 0)
@@ -162,7 +168,7 @@ func (r *actionRepository) List(ctx context.Context, workspaceID string, opts in
 			return nil, goerr.Wrap(err, "failed to decode action", goerr.V("doc_id", docSnap.Ref.ID))
 		}
 
-		if !opts.ArchiveScope.Allows(a.IsArchived()) {
+		if !opts.ArchiveScope.Allows(a.IsArchived()) || !opts.AllowsUpdatedAt(a.UpdatedAt) {
 			continue
 		}
 
@@ -236,7 +242,7 @@ func (r *actionRepository) GetByCase(ctx context.Context, workspaceID string, ca
 			return nil, goerr.Wrap(err, "failed to decode action", goerr.V("doc_id", docSnap.Ref.ID))
 		}
 
-		if !opts.ArchiveScope.Allows(a.IsArchived()) {
+		if !opts.ArchiveScope.Allows(a.IsArchived()) || !opts.AllowsUpdatedAt(a.UpdatedAt) {
 			continue
 		}
 
@@ -0,0 +1,90 @@
+package firestore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const actionRemindersSubcollection = "action_reminders"
+
+// actionReminder is the persisted claim record. Like reactionClaim, the
+// existence of the document is the claim; the fields are for operational
+// inspection.
+type actionReminder struct {
+	ActionID  int64
+	Kind      string
+	DueDate   time.Time
+	ClaimedAt time.Time
+}
+
+type actionReminderRepository struct {
+	client *firestore.Client
+}
+
+var _ interfaces.ActionReminderRepository = &actionReminderRepository{}
+
+func newActionReminderRepository(client *firestore.Client) *actionReminderRepository {
+	return &actionReminderRepository{client: client}
+}
+
+// actionReminderDocID is slash-free by construction: a number, a kind
+// constant and a Unix timestamp.
+func actionReminderDocID(actionID int64, kind model.ActionReminderKind, dueDate time.Time) string {
+	return fmt.Sprintf("%d_%s_%d", actionID, kind, dueDate.UTC().Unix())
+}
+
+func (r *actionReminderRepository) docRef(workspaceID string, actionID int64, kind model.ActionReminderKind, dueDate time.Time) *firestore.DocumentRef {
+	return r.client.
+		Collection("workspaces").Doc(workspaceID).
+		Collection(actionRemindersSubcollection).Doc(actionReminderDocID(actionID, kind, dueDate))
+}
+
+func (r *actionReminderRepository) Claim(ctx context.Context, workspaceID string, actionID int64, kind model.ActionReminderKind, dueDate time.Time) (bool, error) {
+	if workspaceID == "" || actionID == 0 || kind == "" {
+		return false, goerr.New("workspaceID, actionID and kind are required")
+	}
+	// Create fails with AlreadyExists when the document is present, giving an
+	// atomic first-writer-wins claim across concurrent instances.
+	_, err := r.docRef(workspaceID, actionID, kind, dueDate).Create(ctx, actionReminder{
+		ActionID:  actionID,
+		Kind:      string(kind),
+		DueDate:   dueDate.UTC(),
+		ClaimedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		if status.Code(err) == codes.AlreadyExists {
+			return false, nil
+		}
+		return false, goerr.Wrap(err, "failed to claim action reminder",
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("action_id", actionID),
+			goerr.V("kind", kind),
+		)
+	}
+	return true, nil
+}
+
+func (r *actionReminderRepository) Release(ctx context.Context, workspaceID string, actionID int64, kind model.ActionReminderKind, dueDate time.Time) error {
+	if workspaceID == "" || actionID == 0 || kind == "" {
+		return goerr.New("workspaceID, actionID and kind are required")
+	}
+	if _, err := r.docRef(workspaceID, actionID, kind, dueDate).Delete(ctx); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+		return goerr.Wrap(err, "failed to release action reminder",
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("action_id", actionID),
+			goerr.V("kind", kind),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package firestore
+
+import (
+	"context"
+	"sort"
+
+	"cloud.google.com/go/firestore"
+	"github.com/m-mizutani/goerr/v2"
+	"google.golang.org/api/iterator"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+const alertsCollection = "alerts"
+
+type alertRepository struct {
+	client *firestore.Client
+}
+
+var _ interfaces.AlertRepository = &alertRepository{}
+
+func newAlertRepository(client *firestore.Client) *alertRepository {
+	return &alertRepository{client: client}
+}
+
+// alertsCollection returns the workspace's alert collection.
+// Path: workspaces/{workspaceID}/alerts
+func (r *alertRepository) alertsCollection(workspaceID string) *firestore.CollectionRef {
+	return r.client.Collection("workspaces").Doc(workspaceID).Collection(alertsCollection)
+}
+
+func (r *alertRepository) Create(ctx context.Context, a *model.Alert) error {
+	if err := a.Validate(); err != nil {
+		return goerr.Wrap(err, "alert validation failed before create")
+	}
+	if _, err := r.alertsCollection(a.WorkspaceID).Doc(a.ID.String()).Create(ctx, a); err != nil {
+		return goerr.Wrap(err, "failed to create alert",
+			goerr.V("workspace_id", a.WorkspaceID), goerr.V("alert_id", a.ID))
+	}
+	return nil
+}
+
+// ListByCase filters on CaseID alone and orders in memory: ordering in the
+// query would need a composite index, which this project does not add.
+func (r *alertRepository) ListByCase(ctx context.Context, workspaceID string, caseID int64) ([]*model.Alert, error) {
+	out, err := r.query(ctx, workspaceID, r.alertsCollection(workspaceID).Where("CaseID", "==", caseID))
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].ReceivedAt.Equal(out[j].ReceivedAt) {
+			return out[i].ID < out[j].ID
+		}
+		return out[i].ReceivedAt.Before(out[j].ReceivedAt)
+	})
+	return out, nil
+}
+
+// LatestByFingerprint picks the latest in memory, for the same
+// composite-index reason as ListByCase. A fingerprint repeats only as often
+// as its alert fires.
+func (r *alertRepository) LatestByFingerprint(ctx context.Context, workspaceID, fingerprint string) (*model.Alert, error) {
+	alerts, err := r.query(ctx, workspaceID, r.alertsCollection(workspaceID).Where("Fingerprint", "==", fingerprint))
+	if err != nil {
+		return nil, err
+	}
+	var latest *model.Alert
+	for _, a := range alerts {
+		if latest == nil || a.ReceivedAt.After(latest.ReceivedAt) ||
+			(a.ReceivedAt.Equal(latest.ReceivedAt) && a.ID > latest.ID) {
+			latest = a
+		}
+	}
+	return latest, nil
+}
+
+// MoveCase rewrites CaseID in place: alerts live in one workspace-wide
+// collection, so unlike the Case subcollections nothing has to be copied.
+func (r *alertRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error {
+	iter := r.alertsCollection(workspaceID).Where("CaseID", "==", fromCaseID).Documents(ctx)
+	defer iter.Stop()
+
+	var refs []*firestore.DocumentRef
+	for {
+		snap, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return goerr.Wrap(err, "failed to iterate alerts to move",
+				goerr.V("workspace_id", workspaceID), goerr.V("from_case_id", fromCaseID))
+		}
+		refs = append(refs, snap.Ref)
+	}
+	if len(refs) == 0 {
+		return nil
+	}
+
+	writer := r.client.BulkWriter(ctx)
+	jobs := make([]*firestore.BulkWriterJob, 0, len(refs))
+	for _, ref := range refs {
+		job, err := writer.Update(ref, []firestore.Update{{Path: caseIDField, Value: toCaseID}})
+		if err != nil {
+			writer.End()
+			return goerr.Wrap(err, "failed to queue alert move", goerr.V("path", ref.Path))
+		}
+		jobs = append(jobs, job)
+	}
+	writer.End()
+	for i, job := range jobs {
+		if _, err := job.Results(); err != nil {
+			return goerr.Wrap(err, "failed to move alert", goerr.V("path", refs[i].Path))
+		}
+	}
+	return nil
+}
+
+func (r *alertRepository) query(ctx context.Context, workspaceID string, q firestore.Query) ([]*model.Alert, error) {
+	iter := q.Documents(ctx)
+	defer iter.Stop()
+
+	out := make([]*model.Alert, 0)
+	for {
+		snap, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to iterate alerts", goerr.V("workspace_id", workspaceID))
+		}
+		var a model.Alert
+		if err := snap.DataTo(&a); err != nil {
+			return nil, goerr.Wrap(err, "failed to decode alert", goerr.V("doc_id", snap.Ref.ID))
+		}
+		out = append(out, &a)
+	}
+	return out, nil
+}
@@ -2,9 +2,13 @@ package firestore
 
 import (
 	"context"
+	"sort"
+	"time"
 
+	"cloud.google.com/go/firestore"
 	"github.com/m-mizutani/goerr/v2"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+	"google.golang.org/api/iterator"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -69,3 +73,102 @@ func (r *Firestore) DeleteToken(ctx context.Context, tokenID auth.TokenID) error
 
 	return nil
 }
+
+const apiTokensCollection = "api_tokens"
+
+func (r *Firestore) PutAPIToken(ctx context.Context, token *auth.APIToken) error {
+	if err := token.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid API token")
+	}
+
+	docRef := r.client.Collection(apiTokensCollection).Doc(token.ID.String())
+	if _, err := docRef.Set(ctx, token); err != nil {
+		return goerr.Wrap(err, "failed to put API token to firestore")
+	}
+
+	return nil
+}
+
+func (r *Firestore) GetAPIToken(ctx context.Context, tokenID auth.TokenID) (*auth.APIToken, error) {
+	if err := tokenID.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "invalid token ID")
+	}
+
+	doc, err := r.client.Collection(apiTokensCollection).Doc(tokenID.String()).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, ErrNotFound
+		}
+		return nil, goerr.Wrap(err, "failed to get API token from firestore")
+	}
+
+	var token auth.APIToken
+	if err := doc.DataTo(&token); err != nil {
+		return nil, goerr.Wrap(err, "failed to unmarshal API token")
+	}
+
+	return &token, nil
+}
+
+// ListAPITokens filters on Sub alone and sorts in memory, so it needs no
+// composite index; a user holds a handful of tokens.
+func (r *Firestore) ListAPITokens(ctx context.Context, sub string) ([]*auth.APIToken, error) {
+	iter := r.client.Collection(apiTokensCollection).Where("Sub", "==", sub).Documents(ctx)
+	defer iter.Stop()
+
+	tokens := []*auth.APIToken{}
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to iterate API tokens", goerr.V("sub", sub))
+		}
+
+		var token auth.APIToken
+		if err := doc.DataTo(&token); err != nil {
+			return nil, goerr.Wrap(err, "failed to unmarshal API token", goerr.V("id", doc.Ref.ID))
+		}
+		tokens = append(tokens, &token)
+	}
+
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].CreatedAt.After(tokens[j].CreatedAt)
+	})
+	return tokens, nil
+}
+
+func (r *Firestore) DeleteAPIToken(ctx context.Context, tokenID auth.TokenID) error {
+	if err := tokenID.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid token ID")
+	}
+
+	// Exists() makes a missing document fail instead of silently succeeding.
+	docRef := r.client.Collection(apiTokensCollection).Doc(tokenID.String())
+	if _, err := docRef.Delete(ctx, firestore.Exists); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return ErrNotFound
+		}
+		return goerr.Wrap(err, "failed to delete API token from firestore")
+	}
+
+	return nil
+}
+
+func (r *Firestore) TouchAPIToken(ctx context.Context, tokenID auth.TokenID, usedAt time.Time) error {
+	if err := tokenID.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid token ID")
+	}
+
+	// Update fails on a missing document, so a revoked token stays revoked.
+	docRef := r.client.Collection(apiTokensCollection).Doc(tokenID.String())
+	if _, err := docRef.Update(ctx, []firestore.Update{{Path: "LastUsedAt", Value: usedAt}}); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return ErrNotFound
+		}
+		return goerr.Wrap(err, "failed to touch API token in firestore")
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package firestore
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cloud.google.com/go/firestore"
 	"github.com/m-mizutani/goerr/v2"
@@ -151,7 +152,13 @@ func (r *caseRepository) List(ctx context.Context, workspaceID string, opts ...i
 	cfg := interfaces.BuildListCaseConfig(opts...)
 
 	query := r.casesCollection(workspaceID).Query
-	if statusFilter := cfg.Status(); statusFilter != nil {
+	if updatedFrom, _ := cfg.UpdatedBetween(); !updatedFrom.IsZero() {
+		// A lower bound on UpdatedAt (an incremental export's read of what
+		// changed) is far more selective than Status, and a lone range
+		// filter uses its single-field index; Matches applies the status
+		// rule.
+		query = query.Where("UpdatedAt", ">=", updatedFrom)
+	} else if statusFilter := cfg.Status(); statusFilter != nil {
 		query = query.Where("Status", "==", string(*statusFilter))
 	} else {
 		// Default listings never include drafts. An `in` filter on Status
@@ -185,12 +192,87 @@ func (r *caseRepository) List(ctx context.Context, workspaceID string, opts ...i
 			return nil, goerr.Wrap(err, "failed to decode case", goerr.V("doc_id", docSnap.Ref.ID))
 		}
 
+		// Only one field is filtered in the query: combining two would need
+		// a composite index, which this project avoids.
+		if !cfg.Matches(&c) {
+			continue
+		}
 		cases = append(cases, &c)
 	}
 
 	return cases, nil
 }
 
+// caseSortFields maps each Search key to the document field it orders by.
+var caseSortFields = map[interfaces.CaseSortKey]string{
+	interfaces.CaseSortCreatedAt: "CreatedAt",
+	interfaces.CaseSortUpdatedAt: "UpdatedAt",
+	interfaces.CaseSortID:        "ID",
+}
+
+// Search orders, bounds and continues the read in the query — OrderBy the
+// sort field, StartAfter the cursor's position, Limit — so a page reads only
+// the documents up to its last match. Ties on a timestamp break on the
+// numeric ID field — the string document ID would put case 10 before case 9
+// and disagree with the cursor and the other backends — served by the
+// (timestamp, ID) composite indexes `migrate` registers. Every filter, Status
+// included, runs in Go through the shared matcher: any Where next to the
+// OrderBy would need yet another composite index.
+func (r *caseRepository) Search(ctx context.Context, workspaceID string, sort interfaces.CaseSort, limit int, cursor string, opts ...interfaces.ListCaseOption) ([]*model.Case, string, error) {
+	cfg := interfaces.BuildListCaseConfig(opts...)
+	field, ok := caseSortFields[sort.EffectiveKey()]
+	if !ok {
+		return nil, "", goerr.New("invalid case sort key", goerr.V("key", sort.Key))
+	}
+	dir := firestore.Desc
+	if sort.Ascending {
+		dir = firestore.Asc
+	}
+
+	fetch := func(after *interfaces.CasePosition, n int) ([]interfaces.SortedCase, error) {
+		query := r.casesCollection(workspaceID).OrderBy(field, dir)
+		if field != "ID" {
+			query = query.OrderBy("ID", dir)
+		}
+		switch {
+		case after == nil:
+		case field == "ID":
+			query = query.StartAfter(after.ID)
+		default:
+			query = query.StartAfter(time.Unix(0, after.Value).UTC(), after.ID)
+		}
+
+		iter := query.Limit(n).Documents(ctx)
+		defer iter.Stop()
+
+		var out []interfaces.SortedCase
+		for {
+			docSnap, err := iter.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				return nil, goerr.Wrap(err, "failed to iterate cases")
+			}
+			var c model.Case
+			if err := docSnap.DataTo(&c); err != nil {
+				return nil, goerr.Wrap(err, "failed to decode case", goerr.V("doc_id", docSnap.Ref.ID))
+			}
+			value := c.ID
+			switch field {
+			case "CreatedAt":
+				value = c.CreatedAt.UnixNano()
+			case "UpdatedAt":
+				value = c.UpdatedAt.UnixNano()
+			}
+			out = append(out, interfaces.SortedCase{Case: &c, Position: interfaces.CasePosition{Value: value, ID: c.ID}})
+		}
+		return out, nil
+	}
+
+	return interfaces.CollectCasePage(sort, limit, cursor, fetch, cfg.Matches)
+}
+
 func (r *caseRepository) ListDrafts(ctx context.Context, workspaceID string) ([]*model.Case, error) {
 	// Single-field index on Status only; private-draft access control is
 	// applied by the usecase layer, not by extra Where clauses (which would
@@ -193,3 +193,14 @@ func (r *caseMessageRepository) Prune(ctx context.Context, workspaceID string, c
 
 	return totalDeleted, nil
 }
+
+func (r *caseMessageRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error {
+	if err := moveCaseDocuments(ctx, r.client,
+		r.messagesCollection(workspaceID, fromCaseID), r.messagesCollection(workspaceID, toCaseID), toCaseID, false, nil); err != nil {
+		return goerr.Wrap(err, "failed to move case messages",
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("from_case_id", fromCaseID),
+			goerr.V("to_case_id", toCaseID))
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package firestore
+
+import (
+	"context"
+	"maps"
+
+	"cloud.google.com/go/firestore"
+	"github.com/m-mizutani/goerr/v2"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// caseIDField is the stored name of the CaseID field the Case-scoped models
+// carry alongside their document path.
+const caseIDField = "CaseID"
+
+// moveCaseDocuments moves every document of src into dst under the same ID,
+// rewriting the CaseID field to caseID on the documents that have one, and
+// then deletes the originals. Every copy is written before anything is
+// deleted, so a failure part way leaves documents duplicated, never lost.
+// With keepExisting, a document already present in dst wins and the moved
+// one is dropped. set holds further fields written on every moved document.
+// Subcollections are not followed; callers move them first.
+func moveCaseDocuments(ctx context.Context, client *firestore.Client, src, dst *firestore.CollectionRef, caseID int64, keepExisting bool, set map[string]any) error {
+	iter := src.Documents(ctx)
+	defer iter.Stop()
+
+	var snaps []*firestore.DocumentSnapshot
+	for {
+		snap, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return goerr.Wrap(err, "failed to iterate documents to move", goerr.V("path", src.Path))
+		}
+		snaps = append(snaps, snap)
+	}
+	if len(snaps) == 0 {
+		return nil
+	}
+
+	writer := client.BulkWriter(ctx)
+	jobs := make([]*firestore.BulkWriterJob, 0, len(snaps))
+	for _, snap := range snaps {
+		data := snap.Data()
+		if _, ok := data[caseIDField]; ok {
+			data[caseIDField] = caseID
+		}
+		maps.Copy(data, set)
+		ref := dst.Doc(snap.Ref.ID)
+		var job *firestore.BulkWriterJob
+		var err error
+		if keepExisting {
+			job, err = writer.Create(ref, data)
+		} else {
+			job, err = writer.Set(ref, data)
+		}
+		if err != nil {
+			writer.End()
+			return goerr.Wrap(err, "failed to queue moved document", goerr.V("path", ref.Path))
+		}
+		jobs = append(jobs, job)
+	}
+	writer.End()
+	for i, job := range jobs {
+		if _, err := job.Results(); err != nil {
+			if keepExisting && status.Code(err) == codes.AlreadyExists {
+				continue
+			}
+			return goerr.Wrap(err, "failed to write moved document", goerr.V("path", snaps[i].Ref.Path))
+		}
+	}
+
+	writer = client.BulkWriter(ctx)
+	jobs = jobs[:0]
+	for _, snap := range snaps {
+		job, err := writer.Delete(snap.Ref)
+		if err != nil {
+			writer.End()
+			return goerr.Wrap(err, "failed to queue moved document deletion", goerr.V("path", snap.Ref.Path))
+		}
+		jobs = append(jobs, job)
+	}
+	writer.End()
+	for i, job := range jobs {
+		if _, err := job.Results(); err != nil {
+			return goerr.Wrap(err, "failed to delete moved document", goerr.V("path", snaps[i].Ref.Path))
+		}
+	}
+	return nil
+}
+
+// subcollectionDocIDs lists the IDs of every document under col, including
+// the "missing" ones that exist only as the parent of a subcollection (a job
+// whose logs were written before its JobRun record, for instance).
+func subcollectionDocIDs(ctx context.Context, col *firestore.CollectionRef) ([]string, error) {
+	iter := col.DocumentRefs(ctx)
+	var ids []string
+	for {
+		ref, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to list document refs", goerr.V("path", col.Path))
+		}
+		ids = append(ids, ref.ID)
+	}
+	return ids, nil
+}
@@ -1,7 +1,9 @@
 package firestore
 
-import "errors"
+import "github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
 
 var (
-	ErrNotFound = errors.New("not found")
+	// ErrNotFound is the shared interfaces.ErrNotFound, so a not-found check
+	// written against any backend matches every backend.
+	ErrNotFound = interfaces.ErrNotFound
 )
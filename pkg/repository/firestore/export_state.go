@@ -0,0 +1,103 @@
+package firestore
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type exportStateRepository struct {
+	client *firestore.Client
+}
+
+func newExportStateRepository(client *firestore.Client) *exportStateRepository {
+	return &exportStateRepository{client: client}
+}
+
+// watermarkRef returns one table's watermark document.
+// Path: workspaces/{workspaceID}/exportWatermarks/{sink}:{namespace}:{table}
+// Namespace and table names are restricted to [A-Za-z0-9_] by the export
+// config, so the ':' separator cannot collide.
+func (r *exportStateRepository) watermarkRef(workspaceID, sink, namespace, table string) *firestore.DocumentRef {
+	return r.client.Collection("workspaces").Doc(workspaceID).
+		Collection("exportWatermarks").Doc(sink + ":" + namespace + ":" + table)
+}
+
+// tombstones returns the workspace's tombstone collection.
+// Path: workspaces/{workspaceID}/tombstones/{entity}:{entityID}
+func (r *exportStateRepository) tombstones(workspaceID string) *firestore.CollectionRef {
+	return r.client.Collection("workspaces").Doc(workspaceID).Collection("tombstones")
+}
+
+func (r *exportStateRepository) GetWatermark(ctx context.Context, workspaceID, sink, namespace, table string) (*model.ExportWatermark, error) {
+	docSnap, err := r.watermarkRef(workspaceID, sink, namespace, table).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, goerr.Wrap(err, "failed to get export watermark",
+			goerr.V("workspace_id", workspaceID), goerr.V("sink", sink),
+			goerr.V("namespace", namespace), goerr.V("table", table))
+	}
+
+	var w model.ExportWatermark
+	if err := docSnap.DataTo(&w); err != nil {
+		return nil, goerr.Wrap(err, "failed to decode export watermark", goerr.V("doc_id", docSnap.Ref.ID))
+	}
+	return &w, nil
+}
+
+func (r *exportStateRepository) PutWatermark(ctx context.Context, w *model.ExportWatermark) error {
+	if err := w.Validate(); err != nil {
+		return goerr.Wrap(err, "export watermark validation failed before put")
+	}
+
+	if _, err := r.watermarkRef(w.WorkspaceID, w.Sink, w.Namespace, w.Table).Set(ctx, w); err != nil {
+		return goerr.Wrap(err, "failed to put export watermark",
+			goerr.V("workspace_id", w.WorkspaceID), goerr.V("sink", w.Sink),
+			goerr.V("namespace", w.Namespace), goerr.V("table", w.Table))
+	}
+	return nil
+}
+
+func (r *exportStateRepository) PutTombstone(ctx context.Context, t *model.Tombstone) error {
+	if err := t.Validate(); err != nil {
+		return goerr.Wrap(err, "tombstone validation failed before put")
+	}
+
+	docID := string(t.Entity) + ":" + t.EntityID
+	if _, err := r.tombstones(t.WorkspaceID).Doc(docID).Set(ctx, t); err != nil {
+		return goerr.Wrap(err, "failed to put tombstone",
+			goerr.V("workspace_id", t.WorkspaceID), goerr.V("doc_id", docID))
+	}
+	return nil
+}
+
+func (r *exportStateRepository) ListTombstones(ctx context.Context, workspaceID string, since time.Time) ([]*model.Tombstone, error) {
+	// A single range filter uses the automatic single-field index.
+	iter := r.tombstones(workspaceID).Where("DeletedAt", ">", since).Documents(ctx)
+	defer iter.Stop()
+
+	out := make([]*model.Tombstone, 0)
+	for {
+		docSnap, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to iterate tombstones", goerr.V("workspace_id", workspaceID))
+		}
+		var t model.Tombstone
+		if err := docSnap.DataTo(&t); err != nil {
+			return nil, goerr.Wrap(err, "failed to decode tombstone", goerr.V("doc_id", docSnap.Ref.ID))
+		}
+		out = append(out, &t)
+	}
+	return out, nil
+}
@@ -36,6 +36,11 @@ type Firestore struct {
 	userPreference  *userPreferenceRepository
 	homeMessage     *homeMessageRepository
 	assigneeRanking *assigneeRankingRepository
+	exportState     *exportStateRepository
+	webhookDelivery *webhookDeliveryRepository
+	alert           *alertRepository
+	actionReminder  *actionReminderRepository
+	slaBreach       *slaBreachRepository
 }
 
 var _ interfaces.Repository = &Firestore{}
@@ -83,6 +88,11 @@ func New(ctx context.Context, projectID, databaseID string) (*Firestore, error)
 		userPreference:  newUserPreferenceRepository(client),
 		homeMessage:     newHomeMessageRepository(client),
 		assigneeRanking: newAssigneeRankingRepository(client),
+		exportState:     newExportStateRepository(client),
+		webhookDelivery: newWebhookDeliveryRepository(client),
+		alert:           newAlertRepository(client),
+		actionReminder:  newActionReminderRepository(client),
+		slaBreach:       newSLABreachRepository(client),
 	}
 
 	return f, nil
@@ -192,6 +202,26 @@ func (f *Firestore) AssigneeRanking() interfaces.AssigneeRankingRepository {
 	return f.assigneeRanking
 }
 
+func (f *Firestore) ExportState() interfaces.ExportStateRepository {
+	return f.exportState
+}
+
+func (f *Firestore) WebhookDelivery() interfaces.WebhookDeliveryRepository {
+	return f.webhookDelivery
+}
+
+func (f *Firestore) Alert() interfaces.AlertRepository {
+	return f.alert
+}
+
+func (f *Firestore) ActionReminder() interfaces.ActionReminderRepository {
+	return f.actionReminder
+}
+
+func (f *Firestore) SLABreach() interfaces.SLABreachRepository {
+	return f.slaBreach
+}
+
 func (f *Firestore) Close() error {
 	if f.client != nil {
 		return f.client.Close()
@@ -113,6 +113,43 @@ func (r *jobRunRepository) ListByCase(ctx context.Context, workspaceID string, c
 	return runs, nil
 }
 
+// ListUpdatedAfter queries the jobRuns collection group, which spans every
+// Case of every workspace. The WorkspaceID equality next to the UpdatedAt
+// range is served by the collection-group index registered by migrate. A
+// record written before the model was flattened carries no WorkspaceID, but
+// no UpdatedAt either, and gains both on its next write.
+func (r *jobRunRepository) ListUpdatedAfter(ctx context.Context, workspaceID string, since time.Time) ([]*model.JobRun, error) {
+	if workspaceID == "" {
+		return nil, goerr.New("workspace id is empty")
+	}
+	iter := r.client.CollectionGroup(jobRunsCollection).
+		Where("WorkspaceID", "==", workspaceID).
+		Where("UpdatedAt", ">", since).
+		Documents(ctx)
+	defer iter.Stop()
+
+	var runs []*model.JobRun
+	for {
+		snap, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to iterate updated job runs",
+				goerr.V("workspace_id", workspaceID),
+				goerr.V("since", since))
+		}
+		var run model.JobRun
+		if err := snap.DataTo(&run); err != nil {
+			return nil, goerr.Wrap(err, "failed to decode job run",
+				goerr.V("doc_path", snap.Ref.Path))
+		}
+		run.JobID = snap.Ref.ID
+		runs = append(runs, &run)
+	}
+	return runs, nil
+}
+
 func (r *jobRunRepository) TryAcquireLease(ctx context.Context, key model.JobRunKey, now time.Time, leaseDuration time.Duration) (bool, error) {
 	if err := key.Validate(); err != nil {
 		return false, goerr.Wrap(err, "invalid job run key")
@@ -145,6 +182,7 @@ func (r *jobRunRepository) TryAcquireLease(ctx context.Context, key model.JobRun
 		run.CaseID = key.CaseID
 		run.JobID = key.JobID
 		run.LeaseUntil = now.Add(leaseDuration)
+		run.UpdatedAt = time.Now().UTC()
 		if err := tx.Set(docRef, &run); err != nil {
 			return goerr.Wrap(err, "tx set job run")
 		}
@@ -181,6 +219,7 @@ func (r *jobRunRepository) ReleaseLease(ctx context.Context, key model.JobRunKey
 		run.CaseID = key.CaseID
 		run.JobID = key.JobID
 		run.LeaseUntil = time.Time{}
+		run.UpdatedAt = time.Now().UTC()
 		if err := tx.Set(docRef, &run); err != nil {
 			return goerr.Wrap(err, "tx set job run")
 		}
@@ -222,6 +261,7 @@ func (r *jobRunRepository) RecordRun(ctx context.Context, key model.JobRunKey, s
 		// A terminal run is no longer awaiting input; clear any suspension.
 		run.SuspendedRunID = ""
 		run.SuspendedAt = time.Time{}
+		run.UpdatedAt = time.Now().UTC()
 		if err := tx.Set(docRef, &run); err != nil {
 			return goerr.Wrap(err, "tx set job run")
 		}
@@ -258,6 +298,7 @@ func (r *jobRunRepository) Suspend(ctx context.Context, key model.JobRunKey, run
 		// Release the lease: a human wait can outlast any lease, so the
 		// suspension marker (not the lease) guards against double-starting.
 		run.LeaseUntil = time.Time{}
+		run.UpdatedAt = time.Now().UTC()
 		if err := tx.Set(docRef, &run); err != nil {
 			return goerr.Wrap(err, "tx set job run")
 		}
@@ -265,6 +306,27 @@ func (r *jobRunRepository) Suspend(ctx context.Context, key model.JobRunKey, run
 	})
 }
 
+// caseJobRuns returns the jobRuns subcollection of one Case.
+func caseJobRuns(client *firestore.Client, workspaceID string, caseID int64) *firestore.CollectionRef {
+	return client.
+		Collection("workspaces").Doc(workspaceID).
+		Collection("cases").Doc(fmt.Sprintf("%d", caseID)).
+		Collection(jobRunsCollection)
+}
+
+// MoveCase moves only the JobRun documents; their logs subcollections are
+// moved by JobRunLogRepository.MoveCase, which the caller runs first.
+func (r *jobRunRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error {
+	if err := moveCaseDocuments(ctx, r.client,
+		caseJobRuns(r.client, workspaceID, fromCaseID), caseJobRuns(r.client, workspaceID, toCaseID), toCaseID, true, nil); err != nil {
+		return goerr.Wrap(err, "failed to move job runs",
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("from_case_id", fromCaseID),
+			goerr.V("to_case_id", toCaseID))
+	}
+	return nil
+}
+
 // --- JobRunLog ---------------------------------------------------------
 
 type jobRunLogRepository struct {
@@ -434,6 +496,28 @@ func (r *jobRunLogRepository) List(ctx context.Context, key model.JobRunKey, lim
 	return out, nil
 }
 
+// MoveCase walks the job documents by reference, so the logs of a job whose
+// JobRun document does not exist are moved too.
+func (r *jobRunLogRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error {
+	from := caseJobRuns(r.client, workspaceID, fromCaseID)
+	to := caseJobRuns(r.client, workspaceID, toCaseID)
+	jobIDs, err := subcollectionDocIDs(ctx, from)
+	if err != nil {
+		return goerr.Wrap(err, "failed to list jobs to move", goerr.V("case_id", fromCaseID))
+	}
+	for _, jobID := range jobIDs {
+		if err := moveCaseDocuments(ctx, r.client,
+			from.Doc(jobID).Collection(jobRunLogsCollection), to.Doc(jobID).Collection(jobRunLogsCollection), toCaseID, false, nil); err != nil {
+			return goerr.Wrap(err, "failed to move job run logs",
+				goerr.V("workspace_id", workspaceID),
+				goerr.V("from_case_id", fromCaseID),
+				goerr.V("to_case_id", toCaseID),
+				goerr.V("job_id", jobID))
+		}
+	}
+	return nil
+}
+
 // --- JobRunEvent -------------------------------------------------------
 
 type jobRunEventRepository struct {
@@ -650,6 +734,17 @@ func (r *jobRunEventRepository) LatestLLMResponseSequence(ctx context.Context, k
 }
 
 func (r *jobRunEventRepository) List(ctx context.Context, key model.JobRunKey, runID string) ([]*model.JobRunEvent, error) {
+	return r.list(ctx, key, runID, nil)
+}
+
+func (r *jobRunEventRepository) ListAfter(ctx context.Context, key model.JobRunKey, runID string, afterSequence int64) ([]*model.JobRunEvent, error) {
+	return r.list(ctx, key, runID, &afterSequence)
+}
+
+// list returns the run's events in Sequence order, only those past
+// afterSequence when it is set. The range and the order are on the same
+// field, so the query needs no composite index.
+func (r *jobRunEventRepository) list(ctx context.Context, key model.JobRunKey, runID string, afterSequence *int64) ([]*model.JobRunEvent, error) {
 	if err := key.Validate(); err != nil {
 		return nil, goerr.Wrap(err, "invalid job run key")
 	}
@@ -667,6 +762,9 @@ func (r *jobRunEventRepository) List(ctx context.Context, key model.JobRunKey, r
 		Collection(jobRunLogsCollection).Doc(runID).
 		Collection(jobRunEventsCollection).
 		OrderBy("Sequence", firestore.Asc)
+	if afterSequence != nil {
+		q = q.Where("Sequence", ">", *afterSequence)
+	}
 
 	iter := q.Documents(ctx)
 	defer iter.Stop()
@@ -695,3 +793,36 @@ func (r *jobRunEventRepository) List(ctx context.Context, key model.JobRunKey, r
 	}
 	return out, nil
 }
+
+// MoveCase moves each run's events and its counters subcollection, so an
+// AppendNext after the move continues the run's Sequence.
+func (r *jobRunEventRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error {
+	from := caseJobRuns(r.client, workspaceID, fromCaseID)
+	to := caseJobRuns(r.client, workspaceID, toCaseID)
+	jobIDs, err := subcollectionDocIDs(ctx, from)
+	if err != nil {
+		return goerr.Wrap(err, "failed to list jobs to move", goerr.V("case_id", fromCaseID))
+	}
+	for _, jobID := range jobIDs {
+		runIDs, err := subcollectionDocIDs(ctx, from.Doc(jobID).Collection(jobRunLogsCollection))
+		if err != nil {
+			return goerr.Wrap(err, "failed to list runs to move",
+				goerr.V("case_id", fromCaseID), goerr.V("job_id", jobID))
+		}
+		for _, runID := range runIDs {
+			src := from.Doc(jobID).Collection(jobRunLogsCollection).Doc(runID)
+			dst := to.Doc(jobID).Collection(jobRunLogsCollection).Doc(runID)
+			for _, col := range []string{jobRunEventsCollection, jobRunCountersCollection} {
+				if err := moveCaseDocuments(ctx, r.client, src.Collection(col), dst.Collection(col), toCaseID, false, nil); err != nil {
+					return goerr.Wrap(err, "failed to move job run events",
+						goerr.V("workspace_id", workspaceID),
+						goerr.V("from_case_id", fromCaseID),
+						goerr.V("to_case_id", toCaseID),
+						goerr.V("job_id", jobID),
+						goerr.V("run_id", runID))
+				}
+			}
+		}
+	}
+	return nil
+}
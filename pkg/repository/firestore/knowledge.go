@@ -3,6 +3,7 @@ package firestore
 import (
 	"context"
 	"sort"
+	"strconv"
 
 	"cloud.google.com/go/firestore"
 	"github.com/m-mizutani/goerr/v2"
@@ -29,6 +30,14 @@ func (r *knowledgeRepository) knowledgesCollection(workspaceID string) *firestor
 	return r.client.Collection("workspaces").Doc(workspaceID).Collection("knowledges")
 }
 
+// revisionsCollection returns the subcollection ref for an entry's revisions.
+// Path: workspaces/{workspaceID}/knowledges/{id}/revisions/{revision}. The
+// subcollection outlives a deleted parent document, which keeps the history of
+// a deleted entry as an audit trail.
+func (r *knowledgeRepository) revisionsCollection(workspaceID string, id model.KnowledgeID) *firestore.CollectionRef {
+	return r.knowledgesCollection(workspaceID).Doc(string(id)).Collection("revisions")
+}
+
 // knowledgeHasAllTags reports whether k references every tag id in want (AND).
 func knowledgeHasAllTags(k *model.Knowledge, want []model.TagID) bool {
 	if len(want) == 0 {
@@ -162,3 +171,72 @@ func (r *knowledgeRepository) Delete(ctx context.Context, workspaceID string, id
 
 	return nil
 }
+
+func (r *knowledgeRepository) CreateRevision(ctx context.Context, workspaceID string, revision *model.KnowledgeRevision) error {
+	if err := revision.Validate(); err != nil {
+		return goerr.Wrap(err, "knowledge revision validation failed before create")
+	}
+
+	ref := r.revisionsCollection(workspaceID, revision.KnowledgeID).Doc(strconv.Itoa(revision.Revision))
+	// Create (not Set) so two writers racing for the same number cannot
+	// overwrite each other's revision.
+	if _, err := ref.Create(ctx, revision); err != nil {
+		if status.Code(err) == codes.AlreadyExists {
+			return goerr.Wrap(interfaces.ErrKnowledgeRevisionExists, "knowledge revision already exists",
+				goerr.V("workspace_id", workspaceID),
+				goerr.V("knowledge_id", revision.KnowledgeID),
+				goerr.V("revision", revision.Revision))
+		}
+		return goerr.Wrap(err, "failed to create knowledge revision",
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("knowledge_id", revision.KnowledgeID),
+			goerr.V("revision", revision.Revision))
+	}
+	return nil
+}
+
+func (r *knowledgeRepository) GetRevision(ctx context.Context, workspaceID string, id model.KnowledgeID, revision int) (*model.KnowledgeRevision, error) {
+	docSnap, err := r.revisionsCollection(workspaceID, id).Doc(strconv.Itoa(revision)).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, goerr.Wrap(ErrNotFound, "knowledge revision not found",
+				goerr.V("knowledge_id", id), goerr.V("workspace_id", workspaceID), goerr.V("revision", revision))
+		}
+		return nil, goerr.Wrap(err, "failed to get knowledge revision",
+			goerr.V("knowledge_id", id), goerr.V("workspace_id", workspaceID), goerr.V("revision", revision))
+	}
+
+	var rev model.KnowledgeRevision
+	if err := docSnap.DataTo(&rev); err != nil {
+		return nil, goerr.Wrap(err, "failed to decode knowledge revision", goerr.V("doc_id", docSnap.Ref.ID))
+	}
+	return &rev, nil
+}
+
+func (r *knowledgeRepository) ListRevisions(ctx context.Context, workspaceID string, id model.KnowledgeID) ([]*model.KnowledgeRevision, error) {
+	iter := r.revisionsCollection(workspaceID, id).Documents(ctx)
+	defer iter.Stop()
+
+	items := make([]*model.KnowledgeRevision, 0)
+	for {
+		docSnap, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to iterate knowledge revisions",
+				goerr.V("knowledge_id", id), goerr.V("workspace_id", workspaceID))
+		}
+
+		var rev model.KnowledgeRevision
+		if err := docSnap.DataTo(&rev); err != nil {
+			return nil, goerr.Wrap(err, "failed to decode knowledge revision", goerr.V("doc_id", docSnap.Ref.ID))
+		}
+		items = append(items, &rev)
+	}
+
+	// Document IDs are decimal strings, so their lexical order is not numeric;
+	// sort on the field instead.
+	sort.Slice(items, func(i, j int) bool { return items[i].Revision < items[j].Revision })
+	return items, nil
+}
@@ -0,0 +1,128 @@
+package firestore
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
+)
+
+const liveEventsCollection = "live_events"
+
+// liveEventRetention is how long a relayed event document is kept. Listeners
+// only read events written after they start, so a document is useless within
+// seconds; ExpireAt lets a TTL policy on the collection delete it.
+const liveEventRetention = time.Hour
+
+// LiveEventRelay carries live events between instances through a Firestore
+// collection: each instance writes its events there and keeps a snapshot
+// listener open for the documents the others write.
+//
+// Deploy a TTL policy on the live_events collection's ExpireAt field, or the
+// collection grows without bound.
+type LiveEventRelay struct {
+	client *firestore.Client
+}
+
+var _ interfaces.LiveEventRelay = &LiveEventRelay{}
+
+// NewLiveEventRelay creates a relay with its own Firestore client. The caller
+// must Close it.
+func NewLiveEventRelay(ctx context.Context, projectID, databaseID string) (*LiveEventRelay, error) {
+	var client *firestore.Client
+	var err error
+	if databaseID != "" {
+		client, err = firestore.NewClientWithDatabase(ctx, projectID, databaseID)
+	} else {
+		client, err = firestore.NewClient(ctx, projectID)
+	}
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to create firestore client",
+			goerr.V("projectID", projectID),
+			goerr.V("databaseID", databaseID),
+		)
+	}
+	return &LiveEventRelay{client: client}, nil
+}
+
+// Close releases the relay's Firestore client.
+func (r *LiveEventRelay) Close() error {
+	return r.client.Close()
+}
+
+// liveEventDoc is the stored form of a model.LiveEvent.
+type liveEventDoc struct {
+	Kind        string
+	WorkspaceID string
+	CaseID      int64
+	ActionID    int64
+	RunID       string
+	Sequence    int64
+	Origin      string
+	PublishedAt time.Time
+	ExpireAt    time.Time
+}
+
+func (r *LiveEventRelay) Send(ctx context.Context, ev *model.LiveEvent) error {
+	doc := liveEventDoc{
+		Kind:        string(ev.Kind),
+		WorkspaceID: ev.WorkspaceID,
+		CaseID:      ev.CaseID,
+		ActionID:    ev.ActionID,
+		RunID:       ev.RunID,
+		Sequence:    ev.Sequence,
+		Origin:      ev.Origin,
+		PublishedAt: ev.PublishedAt,
+		ExpireAt:    ev.PublishedAt.Add(liveEventRetention),
+	}
+	if _, _, err := r.client.Collection(liveEventsCollection).Add(ctx, doc); err != nil {
+		return goerr.Wrap(err, "failed to write live event to firestore", goerr.V("kind", ev.Kind))
+	}
+	return nil
+}
+
+// Listen follows the documents published after it starts. PublishedAt is the
+// sender's clock, so an event from an instance whose clock lags this one's
+// by more than the gap between Listen starting and the event being sent is
+// missed; subscriptions only promise best-effort delivery.
+func (r *LiveEventRelay) Listen(ctx context.Context, deliver func(*model.LiveEvent)) error {
+	iter := r.client.Collection(liveEventsCollection).
+		Where("PublishedAt", ">", time.Now()).
+		Snapshots(ctx)
+	defer iter.Stop()
+
+	for {
+		snap, err := iter.Next()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return goerr.Wrap(err, "failed to listen for live events")
+		}
+		for _, change := range snap.Changes {
+			if change.Kind != firestore.DocumentAdded {
+				continue
+			}
+			var doc liveEventDoc
+			if err := change.Doc.DataTo(&doc); err != nil {
+				errutil.Handle(ctx, goerr.Wrap(err, "failed to decode live event",
+					goerr.V("doc_id", change.Doc.Ref.ID)), "failed to decode live event")
+				continue
+			}
+			deliver(&model.LiveEvent{
+				Kind:        model.LiveEventKind(doc.Kind),
+				WorkspaceID: doc.WorkspaceID,
+				CaseID:      doc.CaseID,
+				ActionID:    doc.ActionID,
+				RunID:       doc.RunID,
+				Sequence:    doc.Sequence,
+				Origin:      doc.Origin,
+				PublishedAt: doc.PublishedAt,
+			})
+		}
+	}
+}
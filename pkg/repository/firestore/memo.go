@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"time"
 
 	"cloud.google.com/go/firestore"
 	"github.com/m-mizutani/goerr/v2"
@@ -24,12 +25,16 @@ func newMemoRepository(client *firestore.Client) *memoRepository {
 	}
 }
 
-// memosCollection returns the subcollection ref for memos under a specific case.
+// memosCollection names each Case's memo subcollection, and so the collection
+// group ListUpdatedAfter queries.
+const memosCollection = "memos"
+
+// caseMemos returns the subcollection ref for memos under a specific case.
 // Path: workspaces/{workspaceID}/cases/{caseID}/memos
-func (r *memoRepository) memosCollection(workspaceID string, caseID int64) *firestore.CollectionRef {
+func (r *memoRepository) caseMemos(workspaceID string, caseID int64) *firestore.CollectionRef {
 	return r.client.Collection("workspaces").Doc(workspaceID).
 		Collection("cases").Doc(fmt.Sprintf("%d", caseID)).
-		Collection("memos")
+		Collection(memosCollection)
 }
 
 func (r *memoRepository) Create(ctx context.Context, workspaceID string, memo *model.Memo) (*model.Memo, error) {
@@ -37,7 +42,7 @@ func (r *memoRepository) Create(ctx context.Context, workspaceID string, memo *m
 		return nil, goerr.Wrap(err, "memo validation failed before create")
 	}
 
-	docRef := r.memosCollection(workspaceID, memo.CaseID).Doc(string(memo.ID))
+	docRef := r.caseMemos(workspaceID, memo.CaseID).Doc(string(memo.ID))
 	if _, err := docRef.Set(ctx, memo); err != nil {
 		return nil, goerr.Wrap(err, "failed to create memo",
 			goerr.V("memo_id", memo.ID),
@@ -50,7 +55,7 @@ func (r *memoRepository) Create(ctx context.Context, workspaceID string, memo *m
 }
 
 func (r *memoRepository) Get(ctx context.Context, workspaceID string, caseID int64, id model.MemoID) (*model.Memo, error) {
-	docSnap, err := r.memosCollection(workspaceID, caseID).Doc(string(id)).Get(ctx)
+	docSnap, err := r.caseMemos(workspaceID, caseID).Doc(string(id)).Get(ctx)
 	if err != nil {
 		if status.Code(err) == codes.NotFound {
 			return nil, goerr.Wrap(ErrNotFound, "memo not found",
@@ -80,7 +85,7 @@ func (r *memoRepository) GetByIDs(ctx context.Context, workspaceID string, caseI
 		return result, nil
 	}
 
-	col := r.memosCollection(workspaceID, caseID)
+	col := r.caseMemos(workspaceID, caseID)
 	refs := make([]*firestore.DocumentRef, len(ids))
 	for i, id := range ids {
 		refs[i] = col.Doc(string(id))
@@ -116,7 +121,13 @@ func (r *memoRepository) List(ctx context.Context, workspaceID string, caseID in
 		)
 	}
 
-	iter := r.memosCollection(workspaceID, caseID).Documents(ctx)
+	query := r.caseMemos(workspaceID, caseID).Query
+	if opts.UpdatedAfter != nil {
+		// A lone range filter uses the automatic single-field index, and cuts
+		// an incremental export's reads to the memos that changed.
+		query = query.Where("UpdatedAt", ">", *opts.UpdatedAfter)
+	}
+	iter := query.Documents(ctx)
 	defer iter.Stop()
 
 	memos := make([]*model.Memo, 0)
@@ -137,9 +148,9 @@ func (r *memoRepository) List(ctx context.Context, workspaceID string, caseID in
 			return nil, goerr.Wrap(err, "failed to decode memo", goerr.V("doc_id", docSnap.Ref.ID))
 		}
 
-		// Filtering stays in memory (the query is an unconstrained subcollection
-		// scan) so no Firestore index is involved and both backends share the
-		// single MemoListOptions predicate.
+		// Filtering stays in memory (the query is a subcollection scan with at
+		// most a single-field range) so no composite index is involved and both
+		// backends share the single MemoListOptions predicate.
 		if !opts.Allows(&m) {
 			continue
 		}
@@ -160,12 +171,44 @@ func (r *memoRepository) List(ctx context.Context, workspaceID string, caseID in
 	return memos, nil
 }
 
+// ListUpdatedAfter queries the memos collection group, which spans every
+// Case of every workspace. The WorkspaceID equality next to the UpdatedAt
+// range is served by the collection-group index registered by migrate.
+func (r *memoRepository) ListUpdatedAfter(ctx context.Context, workspaceID string, since time.Time) ([]*model.Memo, error) {
+	iter := r.client.CollectionGroup(memosCollection).
+		Where("WorkspaceID", "==", workspaceID).
+		Where("UpdatedAt", ">", since).
+		Documents(ctx)
+	defer iter.Stop()
+
+	var memos []*model.Memo
+	for {
+		docSnap, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to iterate updated memos",
+				goerr.V("workspace_id", workspaceID),
+				goerr.V("since", since),
+			)
+		}
+
+		var m model.Memo
+		if err := docSnap.DataTo(&m); err != nil {
+			return nil, goerr.Wrap(err, "failed to decode memo", goerr.V("doc_path", docSnap.Ref.Path))
+		}
+		memos = append(memos, &m)
+	}
+	return memos, nil
+}
+
 func (r *memoRepository) Update(ctx context.Context, workspaceID string, memo *model.Memo) (*model.Memo, error) {
 	if err := memo.Validate(); err != nil {
 		return nil, goerr.Wrap(err, "memo validation failed before update")
 	}
 
-	docRef := r.memosCollection(workspaceID, memo.CaseID).Doc(string(memo.ID))
+	docRef := r.caseMemos(workspaceID, memo.CaseID).Doc(string(memo.ID))
 
 	if _, err := docRef.Get(ctx); err != nil {
 		if status.Code(err) == codes.NotFound {
@@ -192,3 +235,16 @@ func (r *memoRepository) Update(ctx context.Context, workspaceID string, memo *m
 
 	return memo, nil
 }
+
+func (r *memoRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64, movedAt time.Time) error {
+	if err := moveCaseDocuments(ctx, r.client,
+		r.caseMemos(workspaceID, fromCaseID), r.caseMemos(workspaceID, toCaseID), toCaseID, false,
+		map[string]any{"UpdatedAt": movedAt}); err != nil {
+		return goerr.Wrap(err, "failed to move memos",
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("from_case_id", fromCaseID),
+			goerr.V("to_case_id", toCaseID),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package firestore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"cloud.google.com/go/firestore"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const slaBreachesSubcollection = "sla_breaches"
+
+type slaBreachRepository struct {
+	client *firestore.Client
+}
+
+var _ interfaces.SLABreachRepository = &slaBreachRepository{}
+
+func newSLABreachRepository(client *firestore.Client) *slaBreachRepository {
+	return &slaBreachRepository{client: client}
+}
+
+func (r *slaBreachRepository) collection(workspaceID string) *firestore.CollectionRef {
+	return r.client.Collection("workspaces").Doc(workspaceID).Collection(slaBreachesSubcollection)
+}
+
+// slaBreachDocID is slash-free by construction: a number and a kind constant.
+func slaBreachDocID(caseID int64, kind model.SLAKind) string {
+	return fmt.Sprintf("%d_%s", caseID, kind)
+}
+
+func (r *slaBreachRepository) Record(ctx context.Context, b *model.SLABreach) (bool, error) {
+	if b == nil || b.WorkspaceID == "" || b.CaseID == 0 || b.Kind == "" {
+		return false, goerr.New("workspaceID, caseID and kind are required")
+	}
+	// Create fails with AlreadyExists when the breach is already stored,
+	// which makes the first recording win across concurrent sweeps.
+	if _, err := r.collection(b.WorkspaceID).Doc(slaBreachDocID(b.CaseID, b.Kind)).Create(ctx, b); err != nil {
+		if status.Code(err) == codes.AlreadyExists {
+			return false, nil
+		}
+		return false, goerr.Wrap(err, "failed to record sla breach",
+			goerr.V("workspace_id", b.WorkspaceID),
+			goerr.V("case_id", b.CaseID),
+			goerr.V("kind", b.Kind),
+		)
+	}
+	return true, nil
+}
+
+// ListByCase filters on CaseID alone and orders in memory, like the alert
+// repository: ordering in the query would need a composite index.
+func (r *slaBreachRepository) ListByCase(ctx context.Context, workspaceID string, caseID int64) ([]*model.SLABreach, error) {
+	return r.query(ctx, workspaceID, r.collection(workspaceID).Where("CaseID", "==", caseID))
+}
+
+func (r *slaBreachRepository) List(ctx context.Context, workspaceID string) ([]*model.SLABreach, error) {
+	return r.query(ctx, workspaceID, r.collection(workspaceID).Query)
+}
+
+// MoveCase re-records each breach under the target's document ID, where
+// Create keeps a breach the target already has, then deletes the originals.
+// Every copy is written before anything is deleted, so a failure part way
+// leaves a breach duplicated, never lost.
+func (r *slaBreachRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error {
+	breaches, err := r.ListByCase(ctx, workspaceID, fromCaseID)
+	if err != nil {
+		return err
+	}
+	for _, b := range breaches {
+		moved := *b
+		moved.CaseID = toCaseID
+		if _, err := r.Record(ctx, &moved); err != nil {
+			return goerr.Wrap(err, "failed to move sla breach",
+				goerr.V("from_case_id", fromCaseID), goerr.V("to_case_id", toCaseID))
+		}
+	}
+	for _, b := range breaches {
+		if _, err := r.collection(workspaceID).Doc(slaBreachDocID(fromCaseID, b.Kind)).Delete(ctx); err != nil {
+			return goerr.Wrap(err, "failed to delete moved sla breach",
+				goerr.V("workspace_id", workspaceID),
+				goerr.V("case_id", fromCaseID),
+				goerr.V("kind", b.Kind))
+		}
+	}
+	return nil
+}
+
+func (r *slaBreachRepository) query(ctx context.Context, workspaceID string, q firestore.Query) ([]*model.SLABreach, error) {
+	iter := q.Documents(ctx)
+	defer iter.Stop()
+
+	out := make([]*model.SLABreach, 0)
+	for {
+		snap, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to iterate sla breaches", goerr.V("workspace_id", workspaceID))
+		}
+		var b model.SLABreach
+		if err := snap.DataTo(&b); err != nil {
+			return nil, goerr.Wrap(err, "failed to decode sla breach", goerr.V("doc_id", snap.Ref.ID))
+		}
+		out = append(out, &b)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if !a.DueAt.Equal(b.DueAt) {
+			return a.DueAt.Before(b.DueAt)
+		}
+		if a.CaseID != b.CaseID {
+			return a.CaseID < b.CaseID
+		}
+		return a.Kind < b.Kind
+	})
+	return out, nil
+}
@@ -2,6 +2,7 @@ package firestore
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/firestore"
@@ -134,6 +135,43 @@ func (r *slackUserRepository) GetByIDs(ctx context.Context, ids []model.SlackUse
 	return result, nil
 }
 
+// GetByEmail retrieves the Slack user with the given email. Firestore has no
+// case-insensitive equality, so the email is matched as given and lowercased —
+// Slack itself stores profile emails lowercased, which the second form covers.
+func (r *slackUserRepository) GetByEmail(ctx context.Context, email string) (*model.SlackUser, error) {
+	if email == "" {
+		return nil, nil
+	}
+
+	forms := []string{email}
+	if lower := strings.ToLower(email); lower != email {
+		forms = append(forms, lower)
+	}
+
+	var found *model.SlackUser
+	iter := r.collection().Where("Email", "in", forms).Documents(ctx)
+	defer iter.Stop()
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to query Slack user by email")
+		}
+
+		var user model.SlackUser
+		if err := doc.DataTo(&user); err != nil {
+			return nil, goerr.Wrap(err, "failed to unmarshal Slack user", goerr.V("docID", doc.Ref.ID))
+		}
+		if found == nil || user.ID < found.ID {
+			found = &user
+		}
+	}
+
+	return found, nil
+}
+
 // SaveMany saves multiple Slack users (upsert operation)
 // Handles Firestore batch write limit of 500 documents by splitting into multiple batches
 func (r *slackUserRepository) SaveMany(ctx context.Context, users []*model.SlackUser) error {
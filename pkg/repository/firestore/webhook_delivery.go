@@ -0,0 +1,197 @@
+package firestore
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/m-mizutani/goerr/v2"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+const webhookDeliveriesCollection = "webhookDeliveries"
+
+type webhookDeliveryRepository struct {
+	client *firestore.Client
+}
+
+var _ interfaces.WebhookDeliveryRepository = &webhookDeliveryRepository{}
+
+func newWebhookDeliveryRepository(client *firestore.Client) *webhookDeliveryRepository {
+	return &webhookDeliveryRepository{client: client}
+}
+
+// deliveriesCollection returns the workspace's delivery collection.
+// Path: workspaces/{workspaceID}/webhookDeliveries
+func (r *webhookDeliveryRepository) deliveriesCollection(workspaceID string) *firestore.CollectionRef {
+	return r.client.Collection("workspaces").Doc(workspaceID).Collection(webhookDeliveriesCollection)
+}
+
+func (r *webhookDeliveryRepository) Create(ctx context.Context, d *model.WebhookDelivery) error {
+	if err := d.Validate(); err != nil {
+		return goerr.Wrap(err, "webhook delivery validation failed before create")
+	}
+	if _, err := r.deliveriesCollection(d.WorkspaceID).Doc(d.ID.String()).Create(ctx, d); err != nil {
+		return goerr.Wrap(err, "failed to create webhook delivery",
+			goerr.V("workspace_id", d.WorkspaceID), goerr.V("delivery_id", d.ID))
+	}
+	return nil
+}
+
+func (r *webhookDeliveryRepository) Get(ctx context.Context, workspaceID string, id model.WebhookDeliveryID) (*model.WebhookDelivery, error) {
+	snap, err := r.deliveriesCollection(workspaceID).Doc(id.String()).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, goerr.Wrap(ErrNotFound, "webhook delivery not found",
+				goerr.V("workspace_id", workspaceID), goerr.V("delivery_id", id))
+		}
+		return nil, goerr.Wrap(err, "failed to get webhook delivery",
+			goerr.V("workspace_id", workspaceID), goerr.V("delivery_id", id))
+	}
+	var d model.WebhookDelivery
+	if err := snap.DataTo(&d); err != nil {
+		return nil, goerr.Wrap(err, "failed to decode webhook delivery", goerr.V("doc_id", snap.Ref.ID))
+	}
+	return &d, nil
+}
+
+func (r *webhookDeliveryRepository) Update(ctx context.Context, d *model.WebhookDelivery) error {
+	if err := d.Validate(); err != nil {
+		return goerr.Wrap(err, "webhook delivery validation failed before update")
+	}
+	ref := r.deliveriesCollection(d.WorkspaceID).Doc(d.ID.String())
+	err := r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		if _, err := tx.Get(ref); err != nil {
+			if status.Code(err) == codes.NotFound {
+				return goerr.Wrap(ErrNotFound, "webhook delivery not found",
+					goerr.V("workspace_id", d.WorkspaceID), goerr.V("delivery_id", d.ID))
+			}
+			return goerr.Wrap(err, "tx get webhook delivery")
+		}
+		return tx.Set(ref, d)
+	})
+	if err != nil {
+		return goerr.Wrap(err, "failed to update webhook delivery",
+			goerr.V("workspace_id", d.WorkspaceID), goerr.V("delivery_id", d.ID))
+	}
+	return nil
+}
+
+func (r *webhookDeliveryRepository) Claim(ctx context.Context, workspaceID string, id model.WebhookDeliveryID, now, leaseUntil time.Time) (*model.WebhookDelivery, error) {
+	ref := r.deliveriesCollection(workspaceID).Doc(id.String())
+	var claimed *model.WebhookDelivery
+	err := r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		claimed = nil
+		snap, err := tx.Get(ref)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return goerr.Wrap(ErrNotFound, "webhook delivery not found",
+					goerr.V("workspace_id", workspaceID), goerr.V("delivery_id", id))
+			}
+			return goerr.Wrap(err, "tx get webhook delivery")
+		}
+		var d model.WebhookDelivery
+		if err := snap.DataTo(&d); err != nil {
+			return goerr.Wrap(err, "decode webhook delivery")
+		}
+		if !d.IsDue(now) {
+			return nil
+		}
+		d.StartAttempt(now, leaseUntil)
+		if err := tx.Set(ref, &d); err != nil {
+			return goerr.Wrap(err, "tx set webhook delivery")
+		}
+		claimed = &d
+		return nil
+	})
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to claim webhook delivery",
+			goerr.V("workspace_id", workspaceID), goerr.V("delivery_id", id))
+	}
+	return claimed, nil
+}
+
+// ListDue filters on Status alone and checks NextAttemptAt in memory:
+// combining the two in the query would need a composite index, which this
+// project does not add. Pending deliveries are few — they leave the status
+// on their first successful attempt.
+func (r *webhookDeliveryRepository) ListDue(ctx context.Context, workspaceID string, now time.Time, limit int) ([]*model.WebhookDelivery, error) {
+	pending, err := r.query(ctx, workspaceID,
+		r.deliveriesCollection(workspaceID).Where("Status", "==", string(model.WebhookDeliveryPending)))
+	if err != nil {
+		return nil, err
+	}
+	due := make([]*model.WebhookDelivery, 0, len(pending))
+	for _, d := range pending {
+		if d.IsDue(now) {
+			due = append(due, d)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		if due[i].NextAttemptAt.Equal(due[j].NextAttemptAt) {
+			return due[i].ID < due[j].ID
+		}
+		return due[i].NextAttemptAt.Before(due[j].NextAttemptAt)
+	})
+	if limit >= 0 && len(due) > limit {
+		due = due[:limit]
+	}
+	return due, nil
+}
+
+// List orders by CreatedAt in the query when listing every status. Filtered
+// by status, it reads the status's deliveries and orders them in memory, for
+// the same composite-index reason as ListDue.
+func (r *webhookDeliveryRepository) List(ctx context.Context, workspaceID string, st model.WebhookDeliveryStatus, limit int) ([]*model.WebhookDelivery, error) {
+	col := r.deliveriesCollection(workspaceID)
+	if st == "" {
+		q := col.OrderBy("CreatedAt", firestore.Desc)
+		if limit >= 0 {
+			q = q.Limit(limit)
+		}
+		return r.query(ctx, workspaceID, q)
+	}
+
+	out, err := r.query(ctx, workspaceID, col.Where("Status", "==", string(st)))
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].CreatedAt.Equal(out[j].CreatedAt) {
+			return out[i].ID > out[j].ID
+		}
+		return out[i].CreatedAt.After(out[j].CreatedAt)
+	})
+	if limit >= 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (r *webhookDeliveryRepository) query(ctx context.Context, workspaceID string, q firestore.Query) ([]*model.WebhookDelivery, error) {
+	iter := q.Documents(ctx)
+	defer iter.Stop()
+
+	out := make([]*model.WebhookDelivery, 0)
+	for {
+		snap, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to iterate webhook deliveries", goerr.V("workspace_id", workspaceID))
+		}
+		var d model.WebhookDelivery
+		if err := snap.DataTo(&d); err != nil {
+			return nil, goerr.Wrap(err, "failed to decode webhook delivery", goerr.V("doc_id", snap.Ref.ID))
+		}
+		out = append(out, &d)
+	}
+	return out, nil
+}
@@ -105,3 +105,13 @@ func TestHomeMessageRepository_Firestore(t *testing.T) {
 	t.Parallel()
 	runHomeMessageRepositoryTest(t, newFirestoreRepository)
 }
+
+func TestHomeMessageRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runHomeMessageRepositoryTest(t, newPostgresRepository)
+}
+
+func TestHomeMessageRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runHomeMessageRepositoryTest(t, newSQLiteRepository)
+}
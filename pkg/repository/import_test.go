@@ -185,7 +185,7 @@ func runImportRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces
 		repo := newRepo(t).Import()
 		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
 
-		// memory and Firestore expose distinct ErrNotFound sentinels, so the
+		// each backend exposes its own ErrNotFound sentinel, so the
 		// shared helper asserts only that a lookup of a missing session fails.
 		_, err := repo.Get(ctx, wsID, model.NewImportSessionID())
 		gt.Error(t, err)
@@ -214,3 +214,13 @@ func TestImportRepository_Firestore(t *testing.T) {
 	t.Parallel()
 	runImportRepositoryTest(t, newFirestoreRepository)
 }
+
+func TestImportRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runImportRepositoryTest(t, newPostgresRepository)
+}
+
+func TestImportRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runImportRepositoryTest(t, newSQLiteRepository)
+}
@@ -294,6 +294,69 @@ func runJobRunRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces
 		gt.Error(t, err)
 		gt.Bool(t, errors.Is(err, interfaces.ErrJobRunNotFound)).False()
 	})
+
+	t.Run("MoveCase keeps the target's own record of a job", func(t *testing.T) {
+		repo := newRepo(t)
+		from := newJobRunKey("ws")
+		to := model.JobRunKey{WorkspaceID: from.WorkspaceID, CaseID: from.CaseID + 1, JobID: from.JobID}
+		other := model.JobRunKey{WorkspaceID: from.WorkspaceID, CaseID: from.CaseID, JobID: "job-B"}
+		now := time.Now().UTC().Truncate(time.Millisecond)
+		gt.NoError(t, repo.JobRun().RecordRun(ctx, from, model.JobRunStatusSuccess, now, "run-from", "trace", "")).Required()
+		gt.NoError(t, repo.JobRun().RecordRun(ctx, other, model.JobRunStatusFailed, now, "run-other", "trace", "boom")).Required()
+		gt.NoError(t, repo.JobRun().RecordRun(ctx, to, model.JobRunStatusSuccess, now, "run-to", "trace", "")).Required()
+
+		gt.NoError(t, repo.JobRun().MoveCase(ctx, from.WorkspaceID, from.CaseID, to.CaseID)).Required()
+
+		left, err := repo.JobRun().ListByCase(ctx, from.WorkspaceID, from.CaseID)
+		gt.NoError(t, err).Required()
+		gt.Array(t, left).Length(0)
+
+		got, err := repo.JobRun().Get(ctx, to)
+		gt.NoError(t, err).Required()
+		gt.String(t, got.LastRunID).Equal("run-to")
+
+		moved, err := repo.JobRun().Get(ctx, model.JobRunKey{WorkspaceID: to.WorkspaceID, CaseID: to.CaseID, JobID: "job-B"})
+		gt.NoError(t, err).Required()
+		gt.Number(t, moved.CaseID).Equal(to.CaseID)
+		gt.String(t, moved.LastRunID).Equal("run-other")
+		gt.String(t, moved.LastError).Equal("boom")
+	})
+
+	// Every write stamps UpdatedAt, a lease change included: the lease is an
+	// exported column, and ListUpdatedAfter is how an incremental export
+	// finds the records that changed without listing every Case.
+	t.Run("ListUpdatedAfter returns the workspace's records written after since", func(t *testing.T) {
+		repo := newRepo(t)
+		idle := newJobRunKey("ws")
+		leased := model.JobRunKey{WorkspaceID: idle.WorkspaceID, CaseID: idle.CaseID + 1, JobID: "job-leased"}
+		recorded := model.JobRunKey{WorkspaceID: idle.WorkspaceID, CaseID: idle.CaseID + 2, JobID: "job-recorded"}
+		elsewhere := model.JobRunKey{WorkspaceID: idle.WorkspaceID + "-other", CaseID: idle.CaseID, JobID: idle.JobID}
+		now := time.Now().UTC()
+
+		gt.NoError(t, repo.JobRun().RecordRun(ctx, idle, model.JobRunStatusSuccess, now, "run-idle", "trace", "")).Required()
+		time.Sleep(10 * time.Millisecond)
+		since := time.Now().UTC()
+		time.Sleep(10 * time.Millisecond)
+
+		acquired, err := repo.JobRun().TryAcquireLease(ctx, leased, now, time.Minute)
+		gt.NoError(t, err).Required()
+		gt.Bool(t, acquired).True()
+		gt.NoError(t, repo.JobRun().RecordRun(ctx, recorded, model.JobRunStatusFailed, now, "run-recorded", "trace", "boom")).Required()
+		gt.NoError(t, repo.JobRun().RecordRun(ctx, elsewhere, model.JobRunStatusSuccess, now, "run-elsewhere", "trace", "")).Required()
+
+		got, err := repo.JobRun().ListUpdatedAfter(ctx, idle.WorkspaceID, since)
+		gt.NoError(t, err).Required()
+		gt.Array(t, got).Length(2).Required()
+		byJob := map[string]*model.JobRun{}
+		for _, r := range got {
+			gt.Bool(t, r.UpdatedAt.After(since)).True()
+			byJob[r.JobID] = r
+		}
+		gt.Number(t, byJob["job-leased"].CaseID).Equal(leased.CaseID)
+		gt.Bool(t, byJob["job-leased"].IsLeased(now)).True()
+		gt.Number(t, byJob["job-recorded"].CaseID).Equal(recorded.CaseID)
+		gt.String(t, byJob["job-recorded"].LastError).Equal("boom")
+	})
 }
 
 func TestJobRunRepository_Memory(t *testing.T) {
@@ -308,6 +371,16 @@ func TestJobRunRepository_Firestore(t *testing.T) {
 	runJobRunRepositoryTest(t, newFirestoreRepository)
 }
 
+func TestJobRunRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runJobRunRepositoryTest(t, newPostgresRepository)
+}
+
+func TestJobRunRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runJobRunRepositoryTest(t, newSQLiteRepository)
+}
+
 func newJobRunKey(prefix string) model.JobRunKey {
 	return model.JobRunKey{
 		WorkspaceID: fmt.Sprintf("%s-%d", prefix, time.Now().UnixNano()),
@@ -628,6 +701,31 @@ func runJobRunLogRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfa
 		}
 		gt.Error(t, repo.JobRunLog().Finish(ctx, log)).Is(interfaces.ErrJobRunLogNotFound)
 	})
+
+	t.Run("MoveCase re-parents the logs", func(t *testing.T) {
+		repo := newRepo(t)
+		from := newJobRunKey("ws")
+		to := model.JobRunKey{WorkspaceID: from.WorkspaceID, CaseID: from.CaseID + 1, JobID: from.JobID}
+		gt.NoError(t, repo.JobRunLog().Create(ctx, &model.JobRunLog{
+			WorkspaceID:  from.WorkspaceID,
+			CaseID:       from.CaseID,
+			JobID:        from.JobID,
+			RunID:        "run-move",
+			TraceID:      "trace-move",
+			Stage:        model.JobRunStageRunning,
+			StartedAt:    time.Now().UTC().Truncate(time.Millisecond),
+			ExecutorKind: "single_loop",
+		})).Required()
+
+		gt.NoError(t, repo.JobRunLog().MoveCase(ctx, from.WorkspaceID, from.CaseID, to.CaseID)).Required()
+
+		_, err := repo.JobRunLog().Get(ctx, from, "run-move")
+		gt.Error(t, err).Is(interfaces.ErrJobRunLogNotFound)
+		got, err := repo.JobRunLog().Get(ctx, to, "run-move")
+		gt.NoError(t, err).Required()
+		gt.Number(t, got.CaseID).Equal(to.CaseID)
+		gt.String(t, got.TraceID).Equal("trace-move")
+	})
 }
 
 func runJobRunEventRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.Repository) {
@@ -672,6 +770,45 @@ func runJobRunEventRepositoryTest(t *testing.T, newRepo func(t *testing.T) inter
 		gt.String(t, got[2].EventID).Equal("ev-alloc-2")
 	})
 
+	// ListAfter is what a live subscriber reads on each notification: only the
+	// tail past the last event it delivered, never the timeline again.
+	t.Run("ListAfter returns only the events past the given sequence", func(t *testing.T) {
+		repo := newRepo(t)
+		key := newJobRunKey("ws")
+		runID := fmt.Sprintf("run-after-%d", time.Now().UnixNano())
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		for i := range 4 {
+			gt.NoError(t, repo.JobRunEvent().AppendNext(ctx, &model.JobRunEvent{
+				WorkspaceID: key.WorkspaceID,
+				CaseID:      key.CaseID,
+				JobID:       key.JobID,
+				RunID:       runID,
+				TraceID:     "trace-after",
+				EventID:     fmt.Sprintf("ev-after-%d", i),
+				OccurredAt:  now.Add(time.Duration(i) * time.Millisecond),
+				Kind:        model.JobRunEventKindLLMResponse,
+				Phase:       "execute",
+				LLMResponse: &model.LLMResponsePayload{Model: "test"},
+			})).Required()
+		}
+
+		got, err := repo.JobRunEvent().ListAfter(ctx, key, runID, 2)
+		gt.NoError(t, err).Required()
+		gt.Array(t, got).Length(2).Required()
+		gt.Value(t, got[0].Sequence).Equal(int64(3))
+		gt.Value(t, got[1].Sequence).Equal(int64(4))
+		gt.String(t, got[0].EventID).Equal("ev-after-2")
+
+		got, err = repo.JobRunEvent().ListAfter(ctx, key, runID, 4)
+		gt.NoError(t, err).Required()
+		gt.Array(t, got).Length(0)
+
+		got, err = repo.JobRunEvent().ListAfter(ctx, key, runID, 0)
+		gt.NoError(t, err).Required()
+		gt.Array(t, got).Length(4)
+	})
+
 	// A run whose events predate the allocator must not have its numbers reissued.
 	// This is the case a suspended run hits when it resumes across the deploy that
 	// introduced the counter: its events exist, its counter does not. Restarting at
@@ -1019,6 +1156,59 @@ func runJobRunEventRepositoryTest(t *testing.T, newRepo func(t *testing.T) inter
 		gt.NoError(t, err).Required()
 		gt.Array(t, got).Length(0)
 	})
+
+	t.Run("MoveCase moves the events and continues their sequence", func(t *testing.T) {
+		repo := newRepo(t)
+		from := newJobRunKey("ws")
+		to := model.JobRunKey{WorkspaceID: from.WorkspaceID, CaseID: from.CaseID + 1, JobID: from.JobID}
+		runID := fmt.Sprintf("run-move-%d", time.Now().UnixNano())
+		now := time.Now().UTC().Truncate(time.Millisecond)
+		newEvent := func(key model.JobRunKey, i int) *model.JobRunEvent {
+			return &model.JobRunEvent{
+				WorkspaceID: key.WorkspaceID,
+				CaseID:      key.CaseID,
+				JobID:       key.JobID,
+				RunID:       runID,
+				TraceID:     "trace-move",
+				EventID:     fmt.Sprintf("ev-move-%d", i),
+				OccurredAt:  now.Add(time.Duration(i) * time.Millisecond),
+				Kind:        model.JobRunEventKindLLMResponse,
+				Phase:       "execute",
+				LLMResponse: &model.LLMResponsePayload{Model: "m"},
+			}
+		}
+		// The events' parent log is what the backends walk to find the run.
+		gt.NoError(t, repo.JobRunLog().Create(ctx, &model.JobRunLog{
+			WorkspaceID:  from.WorkspaceID,
+			CaseID:       from.CaseID,
+			JobID:        from.JobID,
+			RunID:        runID,
+			TraceID:      "trace-move",
+			Stage:        model.JobRunStageRunning,
+			StartedAt:    now,
+			ExecutorKind: "single_loop",
+		})).Required()
+		for i := range 2 {
+			gt.NoError(t, repo.JobRunEvent().AppendNext(ctx, newEvent(from, i))).Required()
+		}
+
+		gt.NoError(t, repo.JobRunEvent().MoveCase(ctx, from.WorkspaceID, from.CaseID, to.CaseID)).Required()
+		gt.NoError(t, repo.JobRunLog().MoveCase(ctx, from.WorkspaceID, from.CaseID, to.CaseID)).Required()
+
+		left, err := repo.JobRunEvent().List(ctx, from, runID)
+		gt.NoError(t, err).Required()
+		gt.Array(t, left).Length(0)
+
+		next := newEvent(to, 2)
+		gt.NoError(t, repo.JobRunEvent().AppendNext(ctx, next)).Required()
+		gt.Value(t, next.Sequence).Equal(int64(3))
+
+		got, err := repo.JobRunEvent().List(ctx, to, runID)
+		gt.NoError(t, err).Required()
+		gt.Array(t, got).Length(3).Required()
+		gt.Number(t, got[0].CaseID).Equal(to.CaseID)
+		gt.String(t, got[0].EventID).Equal("ev-move-0")
+	})
 }
 
 func TestJobRunLogRepository_Memory(t *testing.T) {
@@ -1033,6 +1223,16 @@ func TestJobRunLogRepository_Firestore(t *testing.T) {
 	runJobRunLogRepositoryTest(t, newFirestoreRepository)
 }
 
+func TestJobRunLogRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runJobRunLogRepositoryTest(t, newPostgresRepository)
+}
+
+func TestJobRunLogRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runJobRunLogRepositoryTest(t, newSQLiteRepository)
+}
+
 func TestJobRunEventRepository_Memory(t *testing.T) {
 	t.Parallel()
 	runJobRunEventRepositoryTest(t, func(t *testing.T) interfaces.Repository {
@@ -1044,3 +1244,13 @@ func TestJobRunEventRepository_Firestore(t *testing.T) {
 	t.Parallel()
 	runJobRunEventRepositoryTest(t, newFirestoreRepository)
 }
+
+func TestJobRunEventRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runJobRunEventRepositoryTest(t, newPostgresRepository)
+}
+
+func TestJobRunEventRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runJobRunEventRepositoryTest(t, newSQLiteRepository)
+}
@@ -344,3 +344,13 @@ func TestJobSlotRepository_Firestore(t *testing.T) {
 	t.Parallel()
 	runJobSlotRepositoryTest(t, newFirestoreRepository)
 }
+
+func TestJobSlotRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runJobSlotRepositoryTest(t, newPostgresRepository)
+}
+
+func TestJobSlotRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runJobSlotRepositoryTest(t, newSQLiteRepository)
+}
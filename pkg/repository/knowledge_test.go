@@ -12,13 +12,15 @@ import (
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/firestore"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/postgres"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/sqlite"
 )
 
 func runKnowledgeRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.Repository) {
 	t.Helper()
 
 	isNotFound := func(err error) bool {
-		return errors.Is(err, memory.ErrNotFound) || errors.Is(err, firestore.ErrNotFound)
+		return errors.Is(err, memory.ErrNotFound) || errors.Is(err, firestore.ErrNotFound) || errors.Is(err, postgres.ErrNotFound) || errors.Is(err, sqlite.ErrNotFound)
 	}
 
 	// Construct the repository once and isolate sub-tests with a unique
@@ -196,6 +198,46 @@ func runKnowledgeRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfa
 		gt.Bool(t, isNotFound(err)).True()
 	})
 
+	t.Run("review dates round-trip and clear", func(t *testing.T) {
+		ctx := context.Background()
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		now := time.Now().UTC().Truncate(time.Millisecond)
+		validUntil := now.Add(30 * 24 * time.Hour)
+		reviewBy := now.Add(7 * 24 * time.Hour)
+
+		k := &model.Knowledge{
+			ID:          model.NewKnowledgeID(),
+			WorkspaceID: wsID,
+			Title:       "dated",
+			TagIDs:      []model.TagID{model.NewTagID()},
+			ValidUntil:  &validUntil,
+			ReviewBy:    &reviewBy,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		_, err := repo.Knowledge().Create(ctx, wsID, k)
+		gt.NoError(t, err).Required()
+
+		got, err := repo.Knowledge().Get(ctx, wsID, k.ID)
+		gt.NoError(t, err).Required()
+		gt.Value(t, got.ValidUntil).NotNil().Required()
+		gt.Bool(t, got.ValidUntil.Equal(validUntil)).True()
+		gt.Value(t, got.ReviewBy).NotNil().Required()
+		gt.Bool(t, got.ReviewBy.Equal(reviewBy)).True()
+		gt.Value(t, got.ReviewFlaggedAt).Nil()
+
+		k.ValidUntil = nil
+		k.ReviewFlaggedAt = &now
+		_, err = repo.Knowledge().Update(ctx, wsID, k)
+		gt.NoError(t, err).Required()
+
+		got, err = repo.Knowledge().Get(ctx, wsID, k.ID)
+		gt.NoError(t, err).Required()
+		gt.Value(t, got.ValidUntil).Nil()
+		gt.Value(t, got.ReviewFlaggedAt).NotNil().Required()
+		gt.Bool(t, got.ReviewFlaggedAt.Equal(now)).True()
+	})
+
 	t.Run("Delete removes the entry", func(t *testing.T) {
 		ctx := context.Background()
 		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
@@ -221,6 +263,100 @@ func runKnowledgeRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfa
 		err = repo.Knowledge().Delete(ctx, wsID, id)
 		gt.Bool(t, isNotFound(err)).True()
 	})
+
+	t.Run("CreateRevision, GetRevision and ListRevisions round-trip", func(t *testing.T) {
+		ctx := context.Background()
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		id := model.NewKnowledgeID()
+		now := time.Now().UTC().Truncate(time.Millisecond)
+		tagID := model.NewTagID()
+
+		// Written out of order to prove the list sorts numerically, including
+		// past the single-digit boundary where lexical order would differ.
+		for _, n := range []int{10, 2, 1} {
+			gt.NoError(t, repo.Knowledge().CreateRevision(ctx, wsID, &model.KnowledgeRevision{
+				WorkspaceID: wsID,
+				KnowledgeID: id,
+				Revision:    n,
+				Action:      model.KnowledgeRevisionUpdated,
+				Title:       fmt.Sprintf("title %d", n),
+				Claim:       "claim",
+				TagIDs:      []model.TagID{tagID},
+				JobID:       "triage",
+				RunID:       "run-1",
+				CreatedAt:   now.Add(time.Duration(n) * time.Second),
+			})).Required()
+		}
+		gt.NoError(t, repo.Knowledge().CreateRevision(ctx, wsID, &model.KnowledgeRevision{
+			WorkspaceID:  wsID,
+			KnowledgeID:  id,
+			Revision:     11,
+			Action:       model.KnowledgeRevisionRestored,
+			RestoredFrom: 1,
+			Title:        "title 1",
+			EditorID:     "U-EDITOR",
+			CreatedAt:    now,
+		})).Required()
+
+		got, err := repo.Knowledge().GetRevision(ctx, wsID, id, 2)
+		gt.NoError(t, err).Required()
+		gt.Value(t, got.KnowledgeID).Equal(id)
+		gt.String(t, got.Title).Equal("title 2")
+		gt.String(t, got.JobID).Equal("triage")
+		gt.String(t, got.RunID).Equal("run-1")
+		gt.Value(t, got.Action).Equal(model.KnowledgeRevisionUpdated)
+		gt.Value(t, got.TagIDs).Equal([]model.TagID{tagID})
+		gt.Bool(t, got.CreatedAt.Equal(now.Add(2*time.Second))).True()
+
+		restored, err := repo.Knowledge().GetRevision(ctx, wsID, id, 11)
+		gt.NoError(t, err).Required()
+		gt.Number(t, restored.RestoredFrom).Equal(1)
+		gt.String(t, restored.EditorID).Equal("U-EDITOR")
+
+		list, err := repo.Knowledge().ListRevisions(ctx, wsID, id)
+		gt.NoError(t, err).Required()
+		gt.Array(t, list).Length(4).Required()
+		gt.Number(t, list[0].Revision).Equal(1)
+		gt.Number(t, list[1].Revision).Equal(2)
+		gt.Number(t, list[2].Revision).Equal(10)
+		gt.Number(t, list[3].Revision).Equal(11)
+	})
+
+	t.Run("CreateRevision rejects a duplicate number", func(t *testing.T) {
+		ctx := context.Background()
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		rev := &model.KnowledgeRevision{
+			WorkspaceID: wsID,
+			KnowledgeID: model.NewKnowledgeID(),
+			Revision:    1,
+			Action:      model.KnowledgeRevisionCreated,
+			Title:       "first",
+			CreatedAt:   time.Now().UTC(),
+		}
+		gt.NoError(t, repo.Knowledge().CreateRevision(ctx, wsID, rev)).Required()
+
+		dup := *rev
+		dup.Title = "second"
+		err := repo.Knowledge().CreateRevision(ctx, wsID, &dup)
+		gt.Bool(t, errors.Is(err, interfaces.ErrKnowledgeRevisionExists)).True()
+
+		got, err := repo.Knowledge().GetRevision(ctx, wsID, rev.KnowledgeID, 1)
+		gt.NoError(t, err).Required()
+		gt.String(t, got.Title).Equal("first")
+	})
+
+	t.Run("revision lookups on an entry without history", func(t *testing.T) {
+		ctx := context.Background()
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		id := model.NewKnowledgeID()
+
+		_, err := repo.Knowledge().GetRevision(ctx, wsID, id, 1)
+		gt.Bool(t, isNotFound(err)).True()
+
+		list, err := repo.Knowledge().ListRevisions(ctx, wsID, id)
+		gt.NoError(t, err).Required()
+		gt.Array(t, list).Length(0)
+	})
 }
 
 func TestKnowledgeRepository_Memory(t *testing.T) {
@@ -234,3 +370,13 @@ func TestKnowledgeRepository_Firestore(t *testing.T) {
 	t.Parallel()
 	runKnowledgeRepositoryTest(t, newFirestoreRepository)
 }
+
+func TestKnowledgeRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runKnowledgeRepositoryTest(t, newPostgresRepository)
+}
+
+func TestKnowledgeRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runKnowledgeRepositoryTest(t, newSQLiteRepository)
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/firestore"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/postgres"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/sqlite"
 )
 
 func runMemoRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.Repository) {
@@ -138,10 +140,10 @@ func runMemoRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.R
 		_, err = repo.Memo().Get(ctx, wsID, c.ID, missing)
 		gt.Value(t, err).NotNil()
 
-		// Verify the error wraps a not-found sentinel from either backend.
-		// Both memory.ErrNotFound and firestore.ErrNotFound have the same
-		// message "not found" — we check via errors.Is against each package.
-		isNotFound := errors.Is(err, memory.ErrNotFound) || errors.Is(err, firestore.ErrNotFound)
+		// Verify the error wraps a not-found sentinel from any backend.
+		// memory, firestore and postgres ErrNotFound share the message
+		// "not found" — we check via errors.Is against each package.
+		isNotFound := errors.Is(err, memory.ErrNotFound) || errors.Is(err, firestore.ErrNotFound) || errors.Is(err, postgres.ErrNotFound) || errors.Is(err, sqlite.ErrNotFound)
 		gt.Bool(t, isNotFound).True()
 	})
 
@@ -278,6 +280,93 @@ func runMemoRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.R
 		gt.Value(t, all[2].ID).Equal(m3.ID)
 	})
 
+	t.Run("List honours UpdatedAfter", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		c, err := repo.Case().Create(ctx, wsID, &model.Case{
+			ReporterID: "U-REPORTER",
+			Title:      "Case for List updated-after test",
+			CreatedAt:  time.Now().UTC(),
+			UpdatedAt:  time.Now().UTC(),
+		})
+		gt.NoError(t, err).Required()
+
+		base := time.Now().UTC().Truncate(time.Millisecond)
+		mark := base.Add(time.Hour)
+		for _, at := range []time.Time{base, mark} {
+			_, err := repo.Memo().Create(ctx, wsID, &model.Memo{
+				ID: model.NewMemoID(), WorkspaceID: wsID, CaseID: c.ID,
+				Title: "unchanged", CreatedAt: base, UpdatedAt: at,
+			})
+			gt.NoError(t, err).Required()
+		}
+		edited, err := repo.Memo().Create(ctx, wsID, &model.Memo{
+			ID: model.NewMemoID(), WorkspaceID: wsID, CaseID: c.ID,
+			Title: "edited", CreatedAt: base, UpdatedAt: mark.Add(time.Minute),
+		})
+		gt.NoError(t, err).Required()
+
+		got, err := repo.Memo().List(ctx, wsID, c.ID, interfaces.MemoListOptions{UpdatedAfter: &mark})
+		gt.NoError(t, err).Required()
+		gt.Array(t, got).Length(1).Required()
+		gt.Value(t, got[0].ID).Equal(edited.ID)
+	})
+
+	t.Run("ListUpdatedAfter spans the workspace's cases", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		var caseIDs []int64
+		for range 2 {
+			c, err := repo.Case().Create(ctx, wsID, &model.Case{
+				ReporterID: "U-REPORTER",
+				Title:      "Case for ListUpdatedAfter test",
+				CreatedAt:  time.Now().UTC(),
+				UpdatedAt:  time.Now().UTC(),
+			})
+			gt.NoError(t, err).Required()
+			caseIDs = append(caseIDs, c.ID)
+		}
+
+		base := time.Now().UTC().Truncate(time.Millisecond)
+		mark := base.Add(time.Hour)
+		_, err := repo.Memo().Create(ctx, wsID, &model.Memo{
+			ID: model.NewMemoID(), WorkspaceID: wsID, CaseID: caseIDs[0],
+			Title: "unchanged", CreatedAt: base, UpdatedAt: mark,
+		})
+		gt.NoError(t, err).Required()
+		archivedAt := mark.Add(time.Minute)
+		archived, err := repo.Memo().Create(ctx, wsID, &model.Memo{
+			ID: model.NewMemoID(), WorkspaceID: wsID, CaseID: caseIDs[0],
+			Title: "archived", CreatedAt: base, UpdatedAt: archivedAt, ArchivedAt: &archivedAt,
+		})
+		gt.NoError(t, err).Required()
+		edited, err := repo.Memo().Create(ctx, wsID, &model.Memo{
+			ID: model.NewMemoID(), WorkspaceID: wsID, CaseID: caseIDs[1],
+			Title: "edited", CreatedAt: base, UpdatedAt: mark.Add(time.Nanosecond),
+		})
+		gt.NoError(t, err).Required()
+		otherWS := wsID + "-other"
+		_, err = repo.Memo().Create(ctx, otherWS, &model.Memo{
+			ID: model.NewMemoID(), WorkspaceID: otherWS, CaseID: caseIDs[1],
+			Title: "other workspace", CreatedAt: base, UpdatedAt: mark.Add(time.Minute),
+		})
+		gt.NoError(t, err).Required()
+
+		got, err := repo.Memo().ListUpdatedAfter(ctx, wsID, mark)
+		gt.NoError(t, err).Required()
+		gt.Array(t, got).Length(2).Required()
+		ids := map[model.MemoID]int64{}
+		for _, m := range got {
+			ids[m.ID] = m.CaseID
+		}
+		gt.Value(t, ids[archived.ID]).Equal(caseIDs[0])
+		gt.Value(t, ids[edited.ID]).Equal(caseIDs[1])
+	})
+
 	t.Run("List filters by the created-at window", func(t *testing.T) {
 		repo := newRepo(t)
 		ctx := context.Background()
@@ -486,7 +575,7 @@ func runMemoRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.R
 
 		_, err = repo.Memo().Update(ctx, wsID, ghost)
 		gt.Value(t, err).NotNil()
-		isNotFound := errors.Is(err, memory.ErrNotFound) || errors.Is(err, firestore.ErrNotFound)
+		isNotFound := errors.Is(err, memory.ErrNotFound) || errors.Is(err, firestore.ErrNotFound) || errors.Is(err, postgres.ErrNotFound) || errors.Is(err, sqlite.ErrNotFound)
 		gt.Bool(t, isNotFound).True()
 	})
 
@@ -522,6 +611,61 @@ func runMemoRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.R
 		gt.Value(t, got.ID).Equal(created.ID)
 		gt.Value(t, got.ArchivedAt).NotNil()
 	})
+
+	t.Run("MoveCase re-parents active and archived memos", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		newCase := func(title string) *model.Case {
+			c, err := repo.Case().Create(ctx, wsID, &model.Case{
+				ReporterID: "U-REPORTER",
+				Title:      title,
+				CreatedAt:  time.Now().UTC(),
+				UpdatedAt:  time.Now().UTC(),
+			})
+			gt.NoError(t, err).Required()
+			return c
+		}
+		from, to := newCase("Duplicate"), newCase("Survivor")
+
+		now := time.Now().UTC()
+		archivedAt := now
+		active := &model.Memo{ID: model.NewMemoID(), WorkspaceID: wsID, CaseID: from.ID, Title: "active", CreatedAt: now, UpdatedAt: now}
+		archived := &model.Memo{ID: model.NewMemoID(), WorkspaceID: wsID, CaseID: from.ID, Title: "archived", ArchivedAt: &archivedAt, CreatedAt: now, UpdatedAt: now}
+		kept := &model.Memo{ID: model.NewMemoID(), WorkspaceID: wsID, CaseID: to.ID, Title: "kept", CreatedAt: now, UpdatedAt: now}
+		for _, m := range []*model.Memo{active, archived, kept} {
+			_, err := repo.Memo().Create(ctx, wsID, m)
+			gt.NoError(t, err).Required()
+		}
+
+		movedAt := now.Add(time.Minute).Truncate(time.Millisecond)
+		gt.NoError(t, repo.Memo().MoveCase(ctx, wsID, from.ID, to.ID, movedAt)).Required()
+
+		left, err := repo.Memo().List(ctx, wsID, from.ID, interfaces.MemoListOptions{ArchiveScope: interfaces.MemoArchiveScopeAll})
+		gt.NoError(t, err).Required()
+		gt.Array(t, left).Length(0)
+
+		moved, err := repo.Memo().List(ctx, wsID, to.ID, interfaces.MemoListOptions{ArchiveScope: interfaces.MemoArchiveScopeAll})
+		gt.NoError(t, err).Required()
+		gt.Array(t, moved).Length(3)
+
+		got, err := repo.Memo().Get(ctx, wsID, to.ID, archived.ID)
+		gt.NoError(t, err).Required()
+		gt.Value(t, got.CaseID).Equal(to.ID)
+		gt.Value(t, got.Title).Equal("archived")
+		gt.Value(t, got.ArchivedAt).NotNil()
+		gt.Bool(t, got.UpdatedAt.Equal(movedAt)).True()
+
+		// The move must be visible to a "changed since" read, which is how an
+		// incremental export finds rows to re-send.
+		changed, err := repo.Memo().List(ctx, wsID, to.ID, interfaces.MemoListOptions{
+			ArchiveScope: interfaces.MemoArchiveScopeAll,
+			UpdatedAfter: &now,
+		})
+		gt.NoError(t, err).Required()
+		gt.Array(t, changed).Length(2)
+	})
 }
 
 func TestMemoRepository_Memory(t *testing.T) {
@@ -535,3 +679,13 @@ func TestMemoRepository_Firestore(t *testing.T) {
 	t.Parallel()
 	runMemoRepositoryTest(t, newFirestoreRepository)
 }
+
+func TestMemoRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runMemoRepositoryTest(t, newPostgresRepository)
+}
+
+func TestMemoRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runMemoRepositoryTest(t, newSQLiteRepository)
+}
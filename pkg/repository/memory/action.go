@@ -52,6 +52,9 @@ func copyAction(a *model.Action) *model.Action {
 		t := *a.ArchivedAt
 		copied.ArchivedAt = &t
 	}
+	if a.BlockedBy != nil {
+		copied.BlockedBy = append([]int64(nil), a.BlockedBy...)
+	}
 	return copied
 }
 
@@ -120,7 +123,7 @@ func (r *actionRepository) List(ctx context.Context, workspaceID string, opts in
 
 	actions := make([]*model.Action, 0, len(ws))
 	for _, action := range ws {
-		if !opts.ArchiveScope.Allows(action.IsArchived()) {
+		if !opts.ArchiveScope.Allows(action.IsArchived()) || !opts.AllowsUpdatedAt(action.UpdatedAt) {
 			continue
 		}
 		actions = append(actions, copyAction(action))
@@ -182,7 +185,7 @@ func (r *actionRepository) GetByCase(ctx context.Context, workspaceID string, ca
 		if action.CaseID != caseID {
 			continue
 		}
-		if !opts.ArchiveScope.Allows(action.IsArchived()) {
+		if !opts.ArchiveScope.Allows(action.IsArchived()) || !opts.AllowsUpdatedAt(action.UpdatedAt) {
 			continue
 		}
 		actions = append(actions, copyAction(action))
@@ -239,7 +242,7 @@ func (r *actionRepository) GetByCases(ctx context.Context, workspaceID string, c
 		if !caseIDMap[action.CaseID] {
 			continue
 		}
-		if !opts.ArchiveScope.Allows(action.IsArchived()) {
+		if !opts.ArchiveScope.Allows(action.IsArchived()) || !opts.AllowsUpdatedAt(action.UpdatedAt) {
 			continue
 		}
 		result[action.CaseID] = append(result[action.CaseID], copyAction(action))
@@ -0,0 +1,55 @@
+package memory
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+type actionReminderRepository struct {
+	mu     sync.Mutex
+	claims map[string]struct{}
+}
+
+var _ interfaces.ActionReminderRepository = &actionReminderRepository{}
+
+func newActionReminderRepository() *actionReminderRepository {
+	return &actionReminderRepository{claims: make(map[string]struct{})}
+}
+
+// actionReminderKey joins the identity with NUL separators, like
+// reactionClaimKey. The due date is keyed by its UTC instant.
+func actionReminderKey(workspaceID string, actionID int64, kind model.ActionReminderKind, dueDate time.Time) string {
+	return workspaceID + "\x00" + strconv.FormatInt(actionID, 10) + "\x00" + string(kind) + "\x00" +
+		strconv.FormatInt(dueDate.UTC().UnixNano(), 10)
+}
+
+func (r *actionReminderRepository) Claim(_ context.Context, workspaceID string, actionID int64, kind model.ActionReminderKind, dueDate time.Time) (bool, error) {
+	if workspaceID == "" || actionID == 0 || kind == "" {
+		return false, goerr.New("workspaceID, actionID and kind are required")
+	}
+	key := actionReminderKey(workspaceID, actionID, kind, dueDate)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.claims[key]; ok {
+		return false, nil
+	}
+	r.claims[key] = struct{}{}
+	return true, nil
+}
+
+func (r *actionReminderRepository) Release(_ context.Context, workspaceID string, actionID int64, kind model.ActionReminderKind, dueDate time.Time) error {
+	if workspaceID == "" || actionID == 0 || kind == "" {
+		return goerr.New("workspaceID, actionID and kind are required")
+	}
+	key := actionReminderKey(workspaceID, actionID, kind, dueDate)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.claims, key)
+	return nil
+}
@@ -0,0 +1,101 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+// alertRepository keeps ingested alerts per workspace.
+type alertRepository struct {
+	mu   sync.RWMutex
+	data map[string]map[model.AlertID]*model.Alert // workspaceID -> id -> alert
+}
+
+func newAlertRepository() *alertRepository {
+	return &alertRepository{
+		data: make(map[string]map[model.AlertID]*model.Alert),
+	}
+}
+
+func copyAlert(a *model.Alert) *model.Alert {
+	copied := *a
+	return &copied
+}
+
+func (r *alertRepository) Create(ctx context.Context, a *model.Alert) error {
+	if err := a.Validate(); err != nil {
+		return goerr.Wrap(err, "alert validation failed before create")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ws, ok := r.data[a.WorkspaceID]
+	if !ok {
+		ws = make(map[model.AlertID]*model.Alert)
+		r.data[a.WorkspaceID] = ws
+	}
+	ws[a.ID] = copyAlert(a)
+	return nil
+}
+
+func (r *alertRepository) ListByCase(ctx context.Context, workspaceID string, caseID int64) ([]*model.Alert, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make([]*model.Alert, 0)
+	for _, a := range r.data[workspaceID] {
+		if a.CaseID == caseID {
+			out = append(out, copyAlert(a))
+		}
+	}
+	sortAlertsByArrival(out)
+	return out, nil
+}
+
+func (r *alertRepository) LatestByFingerprint(ctx context.Context, workspaceID, fingerprint string) (*model.Alert, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var latest *model.Alert
+	for _, a := range r.data[workspaceID] {
+		if a.Fingerprint != fingerprint {
+			continue
+		}
+		if latest == nil || a.ReceivedAt.After(latest.ReceivedAt) ||
+			(a.ReceivedAt.Equal(latest.ReceivedAt) && a.ID > latest.ID) {
+			latest = a
+		}
+	}
+	if latest == nil {
+		return nil, nil
+	}
+	return copyAlert(latest), nil
+}
+
+func (r *alertRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, a := range r.data[workspaceID] {
+		if a.CaseID == fromCaseID {
+			a.CaseID = toCaseID
+		}
+	}
+	return nil
+}
+
+// sortAlertsByArrival orders alerts oldest first; the time-ordered ID breaks
+// ties.
+func sortAlertsByArrival(alerts []*model.Alert) {
+	sort.Slice(alerts, func(i, j int) bool {
+		if alerts[i].ReceivedAt.Equal(alerts[j].ReceivedAt) {
+			return alerts[i].ID < alerts[j].ID
+		}
+		return alerts[i].ReceivedAt.Before(alerts[j].ReceivedAt)
+	})
+}
@@ -2,20 +2,24 @@ package memory
 
 import (
 	"context"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/m-mizutani/goerr/v2"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
 )
 
 type tokenStore struct {
-	mu     sync.RWMutex
-	tokens map[auth.TokenID]*auth.Token
+	mu        sync.RWMutex
+	tokens    map[auth.TokenID]*auth.Token
+	apiTokens map[auth.TokenID]*auth.APIToken
 }
 
 func newTokenStore() *tokenStore {
 	return &tokenStore{
-		tokens: make(map[auth.TokenID]*auth.Token),
+		tokens:    make(map[auth.TokenID]*auth.Token),
+		apiTokens: make(map[auth.TokenID]*auth.APIToken),
 	}
 }
 
@@ -62,3 +66,94 @@ func (r *Repository) DeleteToken(ctx context.Context, tokenID auth.TokenID) erro
 	delete(r.tokens.tokens, tokenID)
 	return nil
 }
+
+// copyAPIToken returns a copy sharing nothing mutable with token, so a
+// caller editing what Get returned cannot change the stored token.
+func copyAPIToken(token *auth.APIToken) *auth.APIToken {
+	c := *token
+	if token.Workspaces != nil {
+		c.Workspaces = append([]string{}, token.Workspaces...)
+	}
+	if token.LastUsedAt != nil {
+		t := *token.LastUsedAt
+		c.LastUsedAt = &t
+	}
+	return &c
+}
+
+func (r *Repository) PutAPIToken(ctx context.Context, token *auth.APIToken) error {
+	if err := token.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid API token")
+	}
+
+	r.tokens.mu.Lock()
+	defer r.tokens.mu.Unlock()
+
+	r.tokens.apiTokens[token.ID] = copyAPIToken(token)
+	return nil
+}
+
+func (r *Repository) GetAPIToken(ctx context.Context, tokenID auth.TokenID) (*auth.APIToken, error) {
+	if err := tokenID.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "invalid token ID")
+	}
+
+	r.tokens.mu.RLock()
+	defer r.tokens.mu.RUnlock()
+
+	token, ok := r.tokens.apiTokens[tokenID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return copyAPIToken(token), nil
+}
+
+func (r *Repository) ListAPITokens(ctx context.Context, sub string) ([]*auth.APIToken, error) {
+	r.tokens.mu.RLock()
+	defer r.tokens.mu.RUnlock()
+
+	result := []*auth.APIToken{}
+	for _, token := range r.tokens.apiTokens {
+		if token.Sub == sub {
+			result = append(result, copyAPIToken(token))
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.After(result[j].CreatedAt)
+	})
+	return result, nil
+}
+
+func (r *Repository) DeleteAPIToken(ctx context.Context, tokenID auth.TokenID) error {
+	if err := tokenID.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid token ID")
+	}
+
+	r.tokens.mu.Lock()
+	defer r.tokens.mu.Unlock()
+
+	if _, ok := r.tokens.apiTokens[tokenID]; !ok {
+		return ErrNotFound
+	}
+
+	delete(r.tokens.apiTokens, tokenID)
+	return nil
+}
+
+func (r *Repository) TouchAPIToken(ctx context.Context, tokenID auth.TokenID, usedAt time.Time) error {
+	if err := tokenID.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid token ID")
+	}
+
+	r.tokens.mu.Lock()
+	defer r.tokens.mu.Unlock()
+
+	token, ok := r.tokens.apiTokens[tokenID]
+	if !ok {
+		return ErrNotFound
+	}
+
+	token.LastUsedAt = &usedAt
+	return nil
+}
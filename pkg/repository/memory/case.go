@@ -69,6 +69,12 @@ func copyCase(c *model.Case) *model.Case {
 	channelUserIDs := make([]string, len(c.ChannelUserIDs))
 	copy(channelUserIDs, c.ChannelUserIDs)
 
+	var embedding []float64
+	if c.Embedding != nil {
+		embedding = make([]float64, len(c.Embedding))
+		copy(embedding, c.Embedding)
+	}
+
 	var agentSourceIDs []model.SourceID
 	if c.AgentSourceIDs != nil {
 		agentSourceIDs = make([]model.SourceID, len(c.AgentSourceIDs))
@@ -92,11 +98,31 @@ func copyCase(c *model.Case) *model.Case {
 		RequestKey:            c.RequestKey,
 		AgentAdditionalPrompt: c.AgentAdditionalPrompt,
 		AgentSourceIDs:        agentSourceIDs,
+		SLA:                   copyCaseSLA(c.SLA),
+		MergedInto:            c.MergedInto,
+		Embedding:             embedding,
 		CreatedAt:             c.CreatedAt,
 		UpdatedAt:             c.UpdatedAt,
 	}
 }
 
+// copyCaseSLA deep-copies the SLA timestamps so a caller mutating a pause
+// interval cannot reach the stored case.
+func copyCaseSLA(s model.CaseSLA) model.CaseSLA {
+	out := model.CaseSLA{
+		StartedAt:   copyTimePtr(s.StartedAt),
+		RespondedAt: copyTimePtr(s.RespondedAt),
+		ResolvedAt:  copyTimePtr(s.ResolvedAt),
+	}
+	if s.Pauses != nil {
+		out.Pauses = make([]model.SLAPause, len(s.Pauses))
+		for i, p := range s.Pauses {
+			out.Pauses[i] = model.SLAPause{From: p.From, To: copyTimePtr(p.To)}
+		}
+	}
+	return out
+}
+
 func (r *caseRepository) Create(ctx context.Context, workspaceID string, c *model.Case) (*model.Case, error) {
 	// Validate at the persistence boundary so a usecase / handler bug
 	// that forgets to inject the reporter (e.g. Slack interactivity
@@ -169,15 +195,11 @@ func (r *caseRepository) List(ctx context.Context, workspaceID string, opts ...i
 
 	cases := make([]*model.Case, 0, len(ws))
 	for _, c := range ws {
-		// Apply status filter. When no filter is set, exclude drafts so the
-		// default listing never leaks unsubmitted entries; callers that want
-		// drafts must go through ListDrafts (author-scoped) or pass
-		// WithStatus(CaseStatusDraft) explicitly.
-		if statusFilter := cfg.Status(); statusFilter != nil {
-			if c.Status.Normalize() != *statusFilter {
-				continue
-			}
-		} else if c.IsDraft() {
+		// Matches applies the status filter too. When none is set it excludes
+		// drafts so the default listing never leaks unsubmitted entries;
+		// callers that want drafts must go through ListDrafts (author-scoped)
+		// or pass WithStatus(CaseStatusDraft) explicitly.
+		if !cfg.Matches(c) {
 			continue
 		}
 		cases = append(cases, copyCase(c))
@@ -186,6 +208,14 @@ func (r *caseRepository) List(ctx context.Context, workspaceID string, opts ...i
 	return cases, nil
 }
 
+func (r *caseRepository) Search(ctx context.Context, workspaceID string, sort interfaces.CaseSort, limit int, cursor string, opts ...interfaces.ListCaseOption) ([]*model.Case, string, error) {
+	cases, err := r.List(ctx, workspaceID, opts...)
+	if err != nil {
+		return nil, "", err
+	}
+	return interfaces.PageCases(cases, sort, limit, cursor)
+}
+
 func (r *caseRepository) ListDrafts(ctx context.Context, workspaceID string) ([]*model.Case, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
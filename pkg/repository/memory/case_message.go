@@ -137,3 +137,14 @@ func (r *caseMessageRepository) Prune(_ context.Context, workspaceID string, cas
 	r.messages[key] = remaining
 	return deleted, nil
 }
+
+func (r *caseMessageRepository) MoveCase(_ context.Context, workspaceID string, fromCaseID, toCaseID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	from := caseMessageKey(workspaceID, fromCaseID)
+	to := caseMessageKey(workspaceID, toCaseID)
+	r.messages[to] = append(r.messages[to], r.messages[from]...)
+	delete(r.messages, from)
+	return nil
+}
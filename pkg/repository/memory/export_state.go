@@ -0,0 +1,91 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+type exportWatermarkKey struct {
+	workspaceID, sink, namespace, table string
+}
+
+type tombstoneKey struct {
+	entity   model.TombstoneEntity
+	entityID string
+}
+
+// exportStateRepository keeps export watermarks and tombstones in memory.
+type exportStateRepository struct {
+	mu         sync.RWMutex
+	watermarks map[exportWatermarkKey]*model.ExportWatermark
+	tombstones map[string]map[tombstoneKey]*model.Tombstone
+}
+
+func newExportStateRepository() *exportStateRepository {
+	return &exportStateRepository{
+		watermarks: make(map[exportWatermarkKey]*model.ExportWatermark),
+		tombstones: make(map[string]map[tombstoneKey]*model.Tombstone),
+	}
+}
+
+func (r *exportStateRepository) GetWatermark(ctx context.Context, workspaceID, sink, namespace, table string) (*model.ExportWatermark, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	w, ok := r.watermarks[exportWatermarkKey{workspaceID, sink, namespace, table}]
+	if !ok {
+		return nil, nil
+	}
+	copied := *w
+	return &copied, nil
+}
+
+func (r *exportStateRepository) PutWatermark(ctx context.Context, w *model.ExportWatermark) error {
+	if err := w.Validate(); err != nil {
+		return goerr.Wrap(err, "export watermark validation failed before put")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	copied := *w
+	r.watermarks[exportWatermarkKey{w.WorkspaceID, w.Sink, w.Namespace, w.Table}] = &copied
+	return nil
+}
+
+func (r *exportStateRepository) PutTombstone(ctx context.Context, t *model.Tombstone) error {
+	if err := t.Validate(); err != nil {
+		return goerr.Wrap(err, "tombstone validation failed before put")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ws, ok := r.tombstones[t.WorkspaceID]
+	if !ok {
+		ws = make(map[tombstoneKey]*model.Tombstone)
+		r.tombstones[t.WorkspaceID] = ws
+	}
+	copied := *t
+	ws[tombstoneKey{t.Entity, t.EntityID}] = &copied
+	return nil
+}
+
+func (r *exportStateRepository) ListTombstones(ctx context.Context, workspaceID string, since time.Time) ([]*model.Tombstone, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make([]*model.Tombstone, 0)
+	for _, t := range r.tombstones[workspaceID] {
+		if !t.DeletedAt.After(since) {
+			continue
+		}
+		copied := *t
+		out = append(out, &copied)
+	}
+	return out, nil
+}
@@ -67,6 +67,22 @@ func (r *jobRunRepository) ListByCase(ctx context.Context, workspaceID string, c
 	return out, nil
 }
 
+func (r *jobRunRepository) ListUpdatedAfter(ctx context.Context, workspaceID string, since time.Time) ([]*model.JobRun, error) {
+	if workspaceID == "" {
+		return nil, goerr.New("workspace id is empty")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*model.JobRun
+	for k, v := range r.runs {
+		if k.WorkspaceID != workspaceID || !v.UpdatedAt.After(since) {
+			continue
+		}
+		out = append(out, copyJobRun(v))
+	}
+	return out, nil
+}
+
 func (r *jobRunRepository) TryAcquireLease(ctx context.Context, key model.JobRunKey, now time.Time, leaseDuration time.Duration) (bool, error) {
 	if err := key.Validate(); err != nil {
 		return false, goerr.Wrap(err, "invalid job run key")
@@ -88,6 +104,7 @@ func (r *jobRunRepository) TryAcquireLease(ctx context.Context, key model.JobRun
 		existing = &model.JobRun{WorkspaceID: key.WorkspaceID, CaseID: key.CaseID, JobID: key.JobID}
 	}
 	existing.LeaseUntil = now.Add(leaseDuration)
+	existing.UpdatedAt = time.Now().UTC()
 	r.runs[key] = existing
 	return true, nil
 }
@@ -103,6 +120,7 @@ func (r *jobRunRepository) ReleaseLease(ctx context.Context, key model.JobRunKey
 		return nil
 	}
 	existing.LeaseUntil = time.Time{}
+	existing.UpdatedAt = time.Now().UTC()
 	r.runs[key] = existing
 	return nil
 }
@@ -130,6 +148,7 @@ func (r *jobRunRepository) RecordRun(ctx context.Context, key model.JobRunKey, s
 	// A terminal run is no longer awaiting input; clear any suspension.
 	existing.SuspendedRunID = ""
 	existing.SuspendedAt = time.Time{}
+	existing.UpdatedAt = time.Now().UTC()
 	r.runs[key] = existing
 	return nil
 }
@@ -152,10 +171,29 @@ func (r *jobRunRepository) Suspend(ctx context.Context, key model.JobRunKey, run
 	// Release the lease: a human wait can outlast any lease, so the
 	// suspension marker (not the lease) guards against double-starting.
 	existing.LeaseUntil = time.Time{}
+	existing.UpdatedAt = time.Now().UTC()
 	r.runs[key] = existing
 	return nil
 }
 
+func (r *jobRunRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for k, v := range r.runs {
+		if k.WorkspaceID != workspaceID || k.CaseID != fromCaseID {
+			continue
+		}
+		delete(r.runs, k)
+		to := model.JobRunKey{WorkspaceID: workspaceID, CaseID: toCaseID, JobID: k.JobID}
+		if _, ok := r.runs[to]; ok {
+			continue
+		}
+		v.CaseID = toCaseID
+		r.runs[to] = v
+	}
+	return nil
+}
+
 // jobRunLogKey identifies a single JobRunLog inside the memory store.
 type jobRunLogKey struct {
 	K     model.JobRunKey
@@ -324,6 +362,21 @@ func (r *jobRunLogRepository) List(ctx context.Context, key model.JobRunKey, lim
 	return out, nil
 }
 
+func (r *jobRunLogRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for k, v := range r.logs {
+		if k.K.WorkspaceID != workspaceID || k.K.CaseID != fromCaseID {
+			continue
+		}
+		delete(r.logs, k)
+		k.K.CaseID = toCaseID
+		v.CaseID = toCaseID
+		r.logs[k] = v
+	}
+	return nil
+}
+
 // jobRunEventKey identifies a single JobRunEvent inside the memory
 // store. The map key mirrors the Firestore doc key — (Run, EventID) —
 // so collisions surface in the same way across both backends.
@@ -482,6 +535,16 @@ func (r *jobRunEventRepository) LatestLLMResponseSequence(_ context.Context, key
 }
 
 func (r *jobRunEventRepository) List(ctx context.Context, key model.JobRunKey, runID string) ([]*model.JobRunEvent, error) {
+	return r.list(key, runID, nil)
+}
+
+func (r *jobRunEventRepository) ListAfter(ctx context.Context, key model.JobRunKey, runID string, afterSequence int64) ([]*model.JobRunEvent, error) {
+	return r.list(key, runID, &afterSequence)
+}
+
+// list returns the run's events in Sequence order, only those past
+// afterSequence when it is set.
+func (r *jobRunEventRepository) list(key model.JobRunKey, runID string, afterSequence *int64) ([]*model.JobRunEvent, error) {
 	if err := key.Validate(); err != nil {
 		return nil, goerr.Wrap(err, "invalid job run key")
 	}
@@ -495,6 +558,9 @@ func (r *jobRunEventRepository) List(ctx context.Context, key model.JobRunKey, r
 		if k.K != key || k.RunID != runID {
 			continue
 		}
+		if afterSequence != nil && v.Sequence <= *afterSequence {
+			continue
+		}
 		out = append(out, copyJobRunEvent(v))
 	}
 	sort.SliceStable(out, func(i, j int) bool {
@@ -502,3 +568,26 @@ func (r *jobRunEventRepository) List(ctx context.Context, key model.JobRunKey, r
 	})
 	return out, nil
 }
+
+func (r *jobRunEventRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for k, v := range r.events {
+		if k.K.WorkspaceID != workspaceID || k.K.CaseID != fromCaseID {
+			continue
+		}
+		delete(r.events, k)
+		k.K.CaseID = toCaseID
+		v.CaseID = toCaseID
+		r.events[k] = v
+	}
+	for k, seq := range r.eventSeq {
+		if k.K.WorkspaceID != workspaceID || k.K.CaseID != fromCaseID {
+			continue
+		}
+		delete(r.eventSeq, k)
+		k.K.CaseID = toCaseID
+		r.eventSeq[k] = seq
+	}
+	return nil
+}
@@ -4,21 +4,25 @@ import (
 	"context"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/m-mizutani/goerr/v2"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
 )
 
-// knowledgeRepository stores knowledge entries indexed by workspaceID -> id.
+// knowledgeRepository stores knowledge entries indexed by workspaceID -> id,
+// and their revisions indexed by workspaceID -> id -> revision number.
 type knowledgeRepository struct {
-	mu   sync.RWMutex
-	data map[string]map[model.KnowledgeID]*model.Knowledge
+	mu        sync.RWMutex
+	data      map[string]map[model.KnowledgeID]*model.Knowledge
+	revisions map[string]map[model.KnowledgeID]map[int]*model.KnowledgeRevision
 }
 
 func newKnowledgeRepository() *knowledgeRepository {
 	return &knowledgeRepository{
-		data: make(map[string]map[model.KnowledgeID]*model.Knowledge),
+		data:      make(map[string]map[model.KnowledgeID]*model.Knowledge),
+		revisions: make(map[string]map[model.KnowledgeID]map[int]*model.KnowledgeRevision),
 	}
 }
 
@@ -48,9 +52,29 @@ func copyKnowledge(k *model.Knowledge) *model.Knowledge {
 		copied.Embedding = make([]float64, len(k.Embedding))
 		copy(copied.Embedding, k.Embedding)
 	}
+	copied.ValidUntil = copyTimePtr(k.ValidUntil)
+	copied.ReviewBy = copyTimePtr(k.ReviewBy)
+	copied.ReviewFlaggedAt = copyTimePtr(k.ReviewFlaggedAt)
 	return copied
 }
 
+func copyTimePtr(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	v := *t
+	return &v
+}
+
+func copyKnowledgeRevision(r *model.KnowledgeRevision) *model.KnowledgeRevision {
+	copied := *r
+	if r.TagIDs != nil {
+		copied.TagIDs = make([]model.TagID, len(r.TagIDs))
+		copy(copied.TagIDs, r.TagIDs)
+	}
+	return &copied
+}
+
 // knowledgeHasAllTags reports whether k references every tag id in want (AND).
 func knowledgeHasAllTags(k *model.Knowledge, want []model.TagID) bool {
 	if len(want) == 0 {
@@ -167,3 +191,56 @@ func (r *knowledgeRepository) Delete(ctx context.Context, workspaceID string, id
 	delete(ws, id)
 	return nil
 }
+
+func (r *knowledgeRepository) CreateRevision(ctx context.Context, workspaceID string, revision *model.KnowledgeRevision) error {
+	if err := revision.Validate(); err != nil {
+		return goerr.Wrap(err, "knowledge revision validation failed before create")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ws, ok := r.revisions[workspaceID]
+	if !ok {
+		ws = make(map[model.KnowledgeID]map[int]*model.KnowledgeRevision)
+		r.revisions[workspaceID] = ws
+	}
+	history, ok := ws[revision.KnowledgeID]
+	if !ok {
+		history = make(map[int]*model.KnowledgeRevision)
+		ws[revision.KnowledgeID] = history
+	}
+	if _, exists := history[revision.Revision]; exists {
+		return goerr.Wrap(interfaces.ErrKnowledgeRevisionExists, "knowledge revision already exists",
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("knowledge_id", revision.KnowledgeID),
+			goerr.V("revision", revision.Revision))
+	}
+	history[revision.Revision] = copyKnowledgeRevision(revision)
+	return nil
+}
+
+func (r *knowledgeRepository) GetRevision(ctx context.Context, workspaceID string, id model.KnowledgeID, revision int) (*model.KnowledgeRevision, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rev, ok := r.revisions[workspaceID][id][revision]
+	if !ok {
+		return nil, goerr.Wrap(ErrNotFound, "knowledge revision not found",
+			goerr.V("knowledge_id", id), goerr.V("workspace_id", workspaceID), goerr.V("revision", revision))
+	}
+	return copyKnowledgeRevision(rev), nil
+}
+
+func (r *knowledgeRepository) ListRevisions(ctx context.Context, workspaceID string, id model.KnowledgeID) ([]*model.KnowledgeRevision, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	history := r.revisions[workspaceID][id]
+	items := make([]*model.KnowledgeRevision, 0, len(history))
+	for _, rev := range history {
+		items = append(items, copyKnowledgeRevision(rev))
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Revision < items[j].Revision })
+	return items, nil
+}
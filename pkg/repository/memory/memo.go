@@ -4,6 +4,7 @@ import (
 	"context"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/m-mizutani/goerr/v2"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
@@ -164,6 +165,27 @@ func (r *memoRepository) List(ctx context.Context, workspaceID string, caseID in
 	return memos, nil
 }
 
+func (r *memoRepository) ListUpdatedAfter(ctx context.Context, workspaceID string, since time.Time) ([]*model.Memo, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var memos []*model.Memo
+	for _, cases := range r.memos[workspaceID] {
+		for _, m := range cases {
+			if m.UpdatedAt.After(since) {
+				memos = append(memos, copyMemo(m))
+			}
+		}
+	}
+	sort.Slice(memos, func(i, j int) bool {
+		if memos[i].UpdatedAt.Equal(memos[j].UpdatedAt) {
+			return memos[i].ID < memos[j].ID
+		}
+		return memos[i].UpdatedAt.Before(memos[j].UpdatedAt)
+	})
+	return memos, nil
+}
+
 func (r *memoRepository) Update(ctx context.Context, workspaceID string, memo *model.Memo) (*model.Memo, error) {
 	if err := memo.Validate(); err != nil {
 		return nil, goerr.Wrap(err, "memo validation failed before update")
@@ -200,3 +222,21 @@ func (r *memoRepository) Update(ctx context.Context, workspaceID string, memo *m
 	r.memos[workspaceID][memo.CaseID][memo.ID] = stored
 	return copyMemo(stored), nil
 }
+
+func (r *memoRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64, movedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ws, ok := r.memos[workspaceID]
+	if !ok || len(ws[fromCaseID]) == 0 {
+		return nil
+	}
+	r.ensureCase(workspaceID, toCaseID)
+	for id, m := range ws[fromCaseID] {
+		m.CaseID = toCaseID
+		m.UpdatedAt = movedAt
+		ws[toCaseID][id] = m
+	}
+	delete(ws, fromCaseID)
+	return nil
+}
@@ -35,6 +35,11 @@ type Memory struct {
 	userPreference  *userPreferenceRepository
 	homeMessage     *homeMessageRepository
 	assigneeRanking *assigneeRankingRepository
+	exportState     *exportStateRepository
+	webhookDelivery *webhookDeliveryRepository
+	alert           *alertRepository
+	actionReminder  *actionReminderRepository
+	slaBreach       *slaBreachRepository
 }
 
 var _ interfaces.Repository = &Memory{}
@@ -68,6 +73,11 @@ func New() *Memory {
 		userPreference:  newUserPreferenceRepository(),
 		homeMessage:     newHomeMessageRepository(),
 		assigneeRanking: newAssigneeRankingRepository(),
+		exportState:     newExportStateRepository(),
+		webhookDelivery: newWebhookDeliveryRepository(),
+		alert:           newAlertRepository(),
+		actionReminder:  newActionReminderRepository(),
+		slaBreach:       newSLABreachRepository(),
 	}
 }
 
@@ -175,6 +185,26 @@ func (m *Memory) AssigneeRanking() interfaces.AssigneeRankingRepository {
 	return m.assigneeRanking
 }
 
+func (m *Memory) ExportState() interfaces.ExportStateRepository {
+	return m.exportState
+}
+
+func (m *Memory) WebhookDelivery() interfaces.WebhookDeliveryRepository {
+	return m.webhookDelivery
+}
+
+func (m *Memory) Alert() interfaces.AlertRepository {
+	return m.alert
+}
+
+func (m *Memory) ActionReminder() interfaces.ActionReminderRepository {
+	return m.actionReminder
+}
+
+func (m *Memory) SLABreach() interfaces.SLABreachRepository {
+	return m.slaBreach
+}
+
 func (m *Memory) Close() error {
 	// No resources to clean up for in-memory repository
 	return nil
@@ -0,0 +1,103 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+type slaBreachRepository struct {
+	mu       sync.Mutex
+	breaches map[string]map[string]*model.SLABreach // workspaceID -> "<caseID>\x00<kind>" -> breach
+}
+
+var _ interfaces.SLABreachRepository = &slaBreachRepository{}
+
+func newSLABreachRepository() *slaBreachRepository {
+	return &slaBreachRepository{breaches: make(map[string]map[string]*model.SLABreach)}
+}
+
+func slaBreachKey(caseID int64, kind model.SLAKind) string {
+	return strconv.FormatInt(caseID, 10) + "\x00" + string(kind)
+}
+
+func (r *slaBreachRepository) Record(_ context.Context, b *model.SLABreach) (bool, error) {
+	if b == nil || b.WorkspaceID == "" || b.CaseID == 0 || b.Kind == "" {
+		return false, goerr.New("workspaceID, caseID and kind are required")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ws, ok := r.breaches[b.WorkspaceID]
+	if !ok {
+		ws = make(map[string]*model.SLABreach)
+		r.breaches[b.WorkspaceID] = ws
+	}
+	key := slaBreachKey(b.CaseID, b.Kind)
+	if _, ok := ws[key]; ok {
+		return false, nil
+	}
+	cp := *b
+	ws[key] = &cp
+	return true, nil
+}
+
+func (r *slaBreachRepository) ListByCase(_ context.Context, workspaceID string, caseID int64) ([]*model.SLABreach, error) {
+	return r.list(workspaceID, func(b *model.SLABreach) bool { return b.CaseID == caseID }), nil
+}
+
+func (r *slaBreachRepository) List(_ context.Context, workspaceID string) ([]*model.SLABreach, error) {
+	return r.list(workspaceID, func(*model.SLABreach) bool { return true }), nil
+}
+
+func (r *slaBreachRepository) MoveCase(_ context.Context, workspaceID string, fromCaseID, toCaseID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ws := r.breaches[workspaceID]
+	for key, b := range ws {
+		if b.CaseID != fromCaseID {
+			continue
+		}
+		delete(ws, key)
+		to := slaBreachKey(toCaseID, b.Kind)
+		if _, ok := ws[to]; ok {
+			continue
+		}
+		b.CaseID = toCaseID
+		ws[to] = b
+	}
+	return nil
+}
+
+func (r *slaBreachRepository) list(workspaceID string, keep func(*model.SLABreach) bool) []*model.SLABreach {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]*model.SLABreach, 0)
+	for _, b := range r.breaches[workspaceID] {
+		if keep(b) {
+			cp := *b
+			out = append(out, &cp)
+		}
+	}
+	sortSLABreaches(out)
+	return out
+}
+
+// sortSLABreaches orders breaches earliest due first, then by case and kind
+// so the order is stable.
+func sortSLABreaches(breaches []*model.SLABreach) {
+	sort.Slice(breaches, func(i, j int) bool {
+		a, b := breaches[i], breaches[j]
+		if !a.DueAt.Equal(b.DueAt) {
+			return a.DueAt.Before(b.DueAt)
+		}
+		if a.CaseID != b.CaseID {
+			return a.CaseID < b.CaseID
+		}
+		return a.Kind < b.Kind
+	})
+}
@@ -2,6 +2,7 @@ package memory
 
 import (
 	"context"
+	"strings"
 	"sync"
 	"time"
 
@@ -74,6 +75,29 @@ func (r *slackUserRepository) GetByIDs(ctx context.Context, ids []model.SlackUse
 	return result, nil
 }
 
+// GetByEmail retrieves the Slack user with the given email, ignoring case
+func (r *slackUserRepository) GetByEmail(ctx context.Context, email string) (*model.SlackUser, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var found *model.SlackUser
+	for _, user := range r.users {
+		if email == "" || !strings.EqualFold(user.Email, email) {
+			continue
+		}
+		if found == nil || user.ID < found.ID {
+			found = user
+		}
+	}
+	if found == nil {
+		return nil, nil
+	}
+
+	// Return a deep copy to prevent external modifications
+	userCopy := *found
+	return &userCopy, nil
+}
+
 // SaveMany saves multiple Slack users (upsert operation)
 func (r *slackUserRepository) SaveMany(ctx context.Context, users []*model.SlackUser) error {
 	// Validate all entries before writing any, so a single invalid record does
@@ -0,0 +1,136 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+// webhookDeliveryRepository keeps webhook deliveries per workspace. Claim
+// runs under the write lock, which is what makes it atomic here.
+type webhookDeliveryRepository struct {
+	mu   sync.RWMutex
+	data map[string]map[model.WebhookDeliveryID]*model.WebhookDelivery // workspaceID -> id -> delivery
+}
+
+func newWebhookDeliveryRepository() *webhookDeliveryRepository {
+	return &webhookDeliveryRepository{
+		data: make(map[string]map[model.WebhookDeliveryID]*model.WebhookDelivery),
+	}
+}
+
+func copyWebhookDelivery(d *model.WebhookDelivery) *model.WebhookDelivery {
+	copied := *d
+	return &copied
+}
+
+func (r *webhookDeliveryRepository) Create(ctx context.Context, d *model.WebhookDelivery) error {
+	if err := d.Validate(); err != nil {
+		return goerr.Wrap(err, "webhook delivery validation failed before create")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ws, ok := r.data[d.WorkspaceID]
+	if !ok {
+		ws = make(map[model.WebhookDeliveryID]*model.WebhookDelivery)
+		r.data[d.WorkspaceID] = ws
+	}
+	ws[d.ID] = copyWebhookDelivery(d)
+	return nil
+}
+
+func (r *webhookDeliveryRepository) Get(ctx context.Context, workspaceID string, id model.WebhookDeliveryID) (*model.WebhookDelivery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	d, ok := r.data[workspaceID][id]
+	if !ok {
+		return nil, goerr.Wrap(ErrNotFound, "webhook delivery not found",
+			goerr.V("workspace_id", workspaceID), goerr.V("delivery_id", id))
+	}
+	return copyWebhookDelivery(d), nil
+}
+
+func (r *webhookDeliveryRepository) Update(ctx context.Context, d *model.WebhookDelivery) error {
+	if err := d.Validate(); err != nil {
+		return goerr.Wrap(err, "webhook delivery validation failed before update")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.data[d.WorkspaceID][d.ID]; !ok {
+		return goerr.Wrap(ErrNotFound, "webhook delivery not found",
+			goerr.V("workspace_id", d.WorkspaceID), goerr.V("delivery_id", d.ID))
+	}
+	r.data[d.WorkspaceID][d.ID] = copyWebhookDelivery(d)
+	return nil
+}
+
+func (r *webhookDeliveryRepository) Claim(ctx context.Context, workspaceID string, id model.WebhookDeliveryID, now, leaseUntil time.Time) (*model.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	d, ok := r.data[workspaceID][id]
+	if !ok {
+		return nil, goerr.Wrap(ErrNotFound, "webhook delivery not found",
+			goerr.V("workspace_id", workspaceID), goerr.V("delivery_id", id))
+	}
+	if !d.IsDue(now) {
+		return nil, nil
+	}
+	d.StartAttempt(now, leaseUntil)
+	return copyWebhookDelivery(d), nil
+}
+
+func (r *webhookDeliveryRepository) ListDue(ctx context.Context, workspaceID string, now time.Time, limit int) ([]*model.WebhookDelivery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	due := make([]*model.WebhookDelivery, 0)
+	for _, d := range r.data[workspaceID] {
+		if d.IsDue(now) {
+			due = append(due, copyWebhookDelivery(d))
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		if due[i].NextAttemptAt.Equal(due[j].NextAttemptAt) {
+			return due[i].ID < due[j].ID
+		}
+		return due[i].NextAttemptAt.Before(due[j].NextAttemptAt)
+	})
+	if limit >= 0 && len(due) > limit {
+		due = due[:limit]
+	}
+	return due, nil
+}
+
+func (r *webhookDeliveryRepository) List(ctx context.Context, workspaceID string, status model.WebhookDeliveryStatus, limit int) ([]*model.WebhookDelivery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make([]*model.WebhookDelivery, 0)
+	for _, d := range r.data[workspaceID] {
+		if status == "" || d.Status == status {
+			out = append(out, copyWebhookDelivery(d))
+		}
+	}
+	// Newest first, tie-broken by ID (UUID v7 is lexicographically
+	// time-ordered), matching the other backends.
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].CreatedAt.Equal(out[j].CreatedAt) {
+			return out[i].ID > out[j].ID
+		}
+		return out[i].CreatedAt.After(out[j].CreatedAt)
+	})
+	if limit >= 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
@@ -204,3 +204,13 @@ func TestNotificationSlotRepository_Firestore(t *testing.T) {
 	t.Parallel()
 	runNotificationSlotRepositoryTest(t, newFirestoreRepository)
 }
+
+func TestNotificationSlotRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runNotificationSlotRepositoryTest(t, newPostgresRepository)
+}
+
+func TestNotificationSlotRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runNotificationSlotRepositoryTest(t, newSQLiteRepository)
+}
@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/sqldb"
+)
+
+// dialect is PostgreSQL's sqldb.Dialect. Timestamps and lists bind natively
+// (timestamptz and arrays), the data column is jsonb, and a transaction takes
+// row locks and transaction-scoped advisory locks.
+type dialect struct{}
+
+var _ sqldb.Dialect = dialect{}
+
+func (dialect) Time(t time.Time) any {
+	return t
+}
+
+func (dialect) ParseTime(v any) (time.Time, error) {
+	t, ok := v.(time.Time)
+	if !ok {
+		return time.Time{}, goerr.New("timestamp column is not a time", goerr.V("value", v))
+	}
+	return t, nil
+}
+
+func (dialect) List(values any) (any, error) {
+	return values, nil
+}
+
+func (dialect) In(expr, param string) string {
+	return expr + " = ANY(" + param + ")"
+}
+
+func (dialect) ContainsAll(column, param string) string {
+	return column + " @> " + param
+}
+
+func (dialect) HasElement(key, param string) string {
+	return "data->'" + key + "' ? " + param
+}
+
+func (dialect) SetJSON(doc, key, param string) string {
+	return "jsonb_set(" + doc + ", '{" + key + "}', " + param + "::jsonb)"
+}
+
+func (dialect) LockRows() string {
+	return " FOR UPDATE"
+}
+
+func (dialect) Lock(ctx context.Context, tx *sql.Tx, key string) error {
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`, key); err != nil {
+		return goerr.Wrap(err, "failed to take advisory lock", goerr.V("key", key))
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+package postgres
+
+import "github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+
+var (
+	// ErrNotFound is the shared interfaces.ErrNotFound, so a not-found check
+	// written against any backend matches every backend.
+	ErrNotFound = interfaces.ErrNotFound
+)
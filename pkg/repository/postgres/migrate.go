@@ -0,0 +1,168 @@
+package postgres
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/m-mizutani/goerr/v2"
+)
+
+// migrationsFS holds the schema migrations, applied in file-name order. A
+// file is named NNNN_description.sql; NNNN is its version. Migrations are
+// append-only: once released, a file is never edited — a schema change is a
+// new file.
+//
+//go:embed migrations/*.sql
+var migrationsFS embed.FS
+
+// migrationLockKey is the advisory lock two `migrate` runs against the same
+// database serialize on.
+const migrationLockKey = "hecatoncheires.schema_migrations"
+
+// pgUndefinedTable is the SQLSTATE of a query against a missing table.
+const pgUndefinedTable = "42P01"
+
+// Migration is one embedded schema migration.
+type Migration struct {
+	Version int
+	Name    string
+	sql     string
+}
+
+func loadMigrations() ([]Migration, error) {
+	entries, err := fs.ReadDir(migrationsFS, "migrations")
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to read embedded migrations")
+	}
+
+	migrations := make([]Migration, 0, len(entries))
+	for _, e := range entries {
+		name := e.Name()
+		prefix, _, ok := strings.Cut(name, "_")
+		version, err := strconv.Atoi(prefix)
+		if !ok || err != nil || version <= 0 {
+			return nil, goerr.New("migration file name must start with a positive version",
+				goerr.V("file", name))
+		}
+		body, err := fs.ReadFile(migrationsFS, path.Join("migrations", name))
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to read migration", goerr.V("file", name))
+		}
+		migrations = append(migrations, Migration{Version: version, Name: name, sql: string(body)})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	for i := 1; i < len(migrations); i++ {
+		if migrations[i].Version == migrations[i-1].Version {
+			return nil, goerr.New("duplicate migration version",
+				goerr.V("version", migrations[i].Version))
+		}
+	}
+	return migrations, nil
+}
+
+// latestVersion is the schema version this build expects.
+func latestVersion() (int, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return 0, err
+	}
+	if len(migrations) == 0 {
+		return 0, nil
+	}
+	return migrations[len(migrations)-1].Version, nil
+}
+
+// Migrate brings the database at dsn up to the schema this build expects and
+// returns the migrations it applied (or, with dryRun, would apply). Each
+// migration runs in its own transaction together with its schema_migrations
+// row, so a failure leaves the schema at the last migration that succeeded.
+// Concurrent runs serialize on an advisory lock.
+func Migrate(ctx context.Context, dsn string, dryRun bool) ([]Migration, error) {
+	if dsn == "" {
+		return nil, goerr.New("postgres DSN is required")
+	}
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		// The DSN may embed a password, so it is deliberately not attached.
+		return nil, goerr.Wrap(err, "failed to connect to postgres")
+	}
+	defer func() { _ = conn.Close(context.WithoutCancel(ctx)) }()
+
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_lock(hashtextextended($1, 0))`, migrationLockKey); err != nil {
+		return nil, goerr.Wrap(err, "failed to take migration lock")
+	}
+	defer func() {
+		_, _ = conn.Exec(context.WithoutCancel(ctx), `SELECT pg_advisory_unlock(hashtextextended($1, 0))`, migrationLockKey)
+	}()
+
+	if _, err := conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version    INTEGER     NOT NULL PRIMARY KEY,
+			name       TEXT        NOT NULL,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+		)`); err != nil {
+		return nil, goerr.Wrap(err, "failed to create schema_migrations table")
+	}
+
+	current, err := currentVersion(conn.QueryRow(ctx, currentVersionQuery))
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, m := range migrations {
+		if m.Version > current {
+			pending = append(pending, m)
+		}
+	}
+	if dryRun {
+		return pending, nil
+	}
+
+	for _, m := range pending {
+		err := pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+			if _, err := tx.Exec(ctx, m.sql); err != nil {
+				return goerr.Wrap(err, "failed to apply migration")
+			}
+			if _, err := tx.Exec(ctx, `INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`, m.Version, m.Name); err != nil {
+				return goerr.Wrap(err, "failed to record migration")
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, goerr.Wrap(err, "migration failed", goerr.V("migration", m.Name))
+		}
+	}
+	return pending, nil
+}
+
+// currentVersionQuery reads the highest applied migration.
+const currentVersionQuery = `SELECT COALESCE(MAX(version), 0) FROM schema_migrations`
+
+// currentVersion scans the result of currentVersionQuery, run on a pgx
+// connection or through database/sql: 0 on a fresh database.
+func currentVersion(row interface{ Scan(dest ...any) error }) (int, error) {
+	var version int
+	if err := row.Scan(&version); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUndefinedTable {
+			return 0, nil
+		}
+		return 0, goerr.Wrap(err, "failed to read schema version")
+	}
+	return version, nil
+}
@@ -0,0 +1,271 @@
+-- Every entity is stored as its JSON-encoded domain model in `data`. The
+-- columns beside it are copies of the fields the repository looks rows up,
+-- filters or orders by; they are written from the model on every save and
+-- never read back into it.
+
+CREATE TABLE id_counters (
+    kind         TEXT   NOT NULL,
+    workspace_id TEXT   NOT NULL,
+    value        BIGINT NOT NULL,
+    PRIMARY KEY (kind, workspace_id)
+);
+
+CREATE TABLE cases (
+    workspace_id     TEXT   NOT NULL,
+    id               BIGINT NOT NULL,
+    status           TEXT   NOT NULL,
+    slack_channel_id TEXT   NOT NULL,
+    slack_thread_ts  TEXT   NOT NULL,
+    request_key      TEXT   NOT NULL,
+    data             JSONB  NOT NULL,
+    PRIMARY KEY (workspace_id, id)
+);
+CREATE INDEX cases_slack_channel_idx ON cases (workspace_id, slack_channel_id);
+CREATE INDEX cases_request_key_idx ON cases (workspace_id, request_key);
+
+CREATE TABLE actions (
+    workspace_id     TEXT   NOT NULL,
+    id               BIGINT NOT NULL,
+    case_id          BIGINT NOT NULL,
+    slack_message_ts TEXT   NOT NULL,
+    data             JSONB  NOT NULL,
+    PRIMARY KEY (workspace_id, id)
+);
+CREATE INDEX actions_case_idx ON actions (workspace_id, case_id);
+CREATE INDEX actions_slack_message_idx ON actions (workspace_id, slack_message_ts);
+
+CREATE TABLE memos (
+    workspace_id TEXT        NOT NULL,
+    case_id      BIGINT      NOT NULL,
+    id           TEXT        NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL,
+    data         JSONB       NOT NULL,
+    PRIMARY KEY (workspace_id, case_id, id)
+);
+
+CREATE TABLE knowledges (
+    workspace_id TEXT        NOT NULL,
+    id           TEXT        NOT NULL,
+    tag_ids      TEXT[]      NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL,
+    data         JSONB       NOT NULL,
+    PRIMARY KEY (workspace_id, id)
+);
+
+CREATE TABLE knowledge_revisions (
+    workspace_id TEXT    NOT NULL,
+    knowledge_id TEXT    NOT NULL,
+    revision     INTEGER NOT NULL,
+    data         JSONB   NOT NULL,
+    PRIMARY KEY (workspace_id, knowledge_id, revision)
+);
+
+CREATE TABLE tags (
+    workspace_id TEXT        NOT NULL,
+    id           TEXT        NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL,
+    data         JSONB       NOT NULL,
+    PRIMARY KEY (workspace_id, id)
+);
+
+CREATE TABLE sources (
+    workspace_id TEXT  NOT NULL,
+    id           TEXT  NOT NULL,
+    data         JSONB NOT NULL,
+    PRIMARY KEY (workspace_id, id)
+);
+
+CREATE TABLE slack_messages (
+    channel_id TEXT        NOT NULL,
+    id         TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    data       JSONB       NOT NULL,
+    PRIMARY KEY (channel_id, id)
+);
+CREATE INDEX slack_messages_created_idx ON slack_messages (channel_id, created_at);
+
+CREATE TABLE slack_users (
+    id   TEXT  NOT NULL PRIMARY KEY,
+    data JSONB NOT NULL
+);
+
+-- A single row (id = 1) holding the Slack user refresh metadata.
+CREATE TABLE slack_user_metadata (
+    id   INTEGER NOT NULL PRIMARY KEY,
+    data JSONB   NOT NULL
+);
+
+CREATE TABLE case_messages (
+    workspace_id TEXT        NOT NULL,
+    case_id      BIGINT      NOT NULL,
+    id           TEXT        NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL,
+    data         JSONB       NOT NULL,
+    PRIMARY KEY (workspace_id, case_id, id)
+);
+CREATE INDEX case_messages_created_idx ON case_messages (workspace_id, case_id, created_at);
+
+CREATE TABLE action_messages (
+    workspace_id TEXT        NOT NULL,
+    action_id    BIGINT      NOT NULL,
+    id           TEXT        NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL,
+    data         JSONB       NOT NULL,
+    PRIMARY KEY (workspace_id, action_id, id)
+);
+
+CREATE TABLE action_events (
+    workspace_id TEXT        NOT NULL,
+    action_id    BIGINT      NOT NULL,
+    id           TEXT        NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL,
+    data         JSONB       NOT NULL,
+    PRIMARY KEY (workspace_id, action_id, id)
+);
+
+CREATE TABLE action_steps (
+    workspace_id TEXT        NOT NULL,
+    action_id    BIGINT      NOT NULL,
+    id           TEXT        NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL,
+    data         JSONB       NOT NULL,
+    PRIMARY KEY (workspace_id, action_id, id)
+);
+
+CREATE TABLE action_comments (
+    workspace_id TEXT        NOT NULL,
+    action_id    BIGINT      NOT NULL,
+    id           TEXT        NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL,
+    data         JSONB       NOT NULL,
+    PRIMARY KEY (workspace_id, action_id, id)
+);
+
+CREATE TABLE assist_logs (
+    workspace_id TEXT        NOT NULL,
+    case_id      BIGINT      NOT NULL,
+    id           TEXT        NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL,
+    data         JSONB       NOT NULL,
+    PRIMARY KEY (workspace_id, case_id, id)
+);
+
+CREATE TABLE case_proposals (
+    id         TEXT        NOT NULL PRIMARY KEY,
+    expires_at TIMESTAMPTZ NOT NULL,
+    data       JSONB       NOT NULL
+);
+
+CREATE TABLE sessions (
+    channel_id TEXT  NOT NULL,
+    thread_ts  TEXT  NOT NULL,
+    data       JSONB NOT NULL,
+    PRIMARY KEY (channel_id, thread_ts)
+);
+
+CREATE TABLE notification_slots (
+    channel_id TEXT        NOT NULL PRIMARY KEY,
+    expires_at TIMESTAMPTZ NOT NULL,
+    data       JSONB       NOT NULL
+);
+
+CREATE TABLE job_runs (
+    workspace_id TEXT   NOT NULL,
+    case_id      BIGINT NOT NULL,
+    job_id       TEXT   NOT NULL,
+    data         JSONB  NOT NULL,
+    PRIMARY KEY (workspace_id, case_id, job_id)
+);
+
+CREATE TABLE job_run_logs (
+    workspace_id TEXT        NOT NULL,
+    case_id      BIGINT      NOT NULL,
+    job_id       TEXT        NOT NULL,
+    run_id       TEXT        NOT NULL,
+    started_at   TIMESTAMPTZ NOT NULL,
+    data         JSONB       NOT NULL,
+    PRIMARY KEY (workspace_id, case_id, job_id, run_id)
+);
+
+CREATE TABLE job_run_events (
+    workspace_id TEXT   NOT NULL,
+    case_id      BIGINT NOT NULL,
+    job_id       TEXT   NOT NULL,
+    run_id       TEXT   NOT NULL,
+    event_id     TEXT   NOT NULL,
+    sequence     BIGINT NOT NULL,
+    kind         TEXT   NOT NULL,
+    data         JSONB  NOT NULL,
+    PRIMARY KEY (workspace_id, case_id, job_id, run_id, event_id)
+);
+
+-- The per-run high-water mark AppendNext allocates the next sequence from.
+CREATE TABLE job_run_event_sequences (
+    workspace_id TEXT   NOT NULL,
+    case_id      BIGINT NOT NULL,
+    job_id       TEXT   NOT NULL,
+    run_id       TEXT   NOT NULL,
+    value        BIGINT NOT NULL,
+    PRIMARY KEY (workspace_id, case_id, job_id, run_id)
+);
+
+CREATE TABLE job_slots (
+    idx  INTEGER NOT NULL PRIMARY KEY,
+    data JSONB   NOT NULL
+);
+
+CREATE TABLE import_sessions (
+    workspace_id TEXT  NOT NULL,
+    id           TEXT  NOT NULL,
+    data         JSONB NOT NULL,
+    PRIMARY KEY (workspace_id, id)
+);
+
+CREATE TABLE reaction_claims (
+    workspace_id      TEXT        NOT NULL,
+    source_channel_id TEXT        NOT NULL,
+    source_message_ts TEXT        NOT NULL,
+    claimed_at        TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (workspace_id, source_channel_id, source_message_ts)
+);
+
+CREATE TABLE user_preferences (
+    user_id TEXT  NOT NULL PRIMARY KEY,
+    data    JSONB NOT NULL
+);
+
+CREATE TABLE home_messages (
+    user_id    TEXT        NOT NULL,
+    id         TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    data       JSONB       NOT NULL,
+    PRIMARY KEY (user_id, id)
+);
+
+CREATE TABLE assignee_rankings (
+    workspace_id TEXT  NOT NULL PRIMARY KEY,
+    data         JSONB NOT NULL
+);
+
+CREATE TABLE export_watermarks (
+    workspace_id TEXT  NOT NULL,
+    sink         TEXT  NOT NULL,
+    namespace    TEXT  NOT NULL,
+    table_name   TEXT  NOT NULL,
+    data         JSONB NOT NULL,
+    PRIMARY KEY (workspace_id, sink, namespace, table_name)
+);
+
+CREATE TABLE export_tombstones (
+    workspace_id TEXT        NOT NULL,
+    entity       TEXT        NOT NULL,
+    entity_id    TEXT        NOT NULL,
+    deleted_at   TIMESTAMPTZ NOT NULL,
+    data         JSONB       NOT NULL,
+    PRIMARY KEY (workspace_id, entity, entity_id)
+);
+
+CREATE TABLE auth_tokens (
+    id   TEXT  NOT NULL PRIMARY KEY,
+    data JSONB NOT NULL
+);
@@ -0,0 +1,14 @@
+-- Outbound webhook deliveries: the retry queue (status = 'pending', by
+-- next_attempt_at) and the delivery history (by created_at).
+
+CREATE TABLE webhook_deliveries (
+    workspace_id    TEXT        NOT NULL,
+    id              TEXT        NOT NULL,
+    status          TEXT        NOT NULL,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL,
+    data            JSONB       NOT NULL,
+    PRIMARY KEY (workspace_id, id)
+);
+CREATE INDEX webhook_deliveries_due_idx ON webhook_deliveries (workspace_id, status, next_attempt_at);
+CREATE INDEX webhook_deliveries_created_idx ON webhook_deliveries (workspace_id, created_at DESC, id DESC);
@@ -0,0 +1,14 @@
+-- Alerts received on the ingest endpoint: a case's alert timeline (by
+-- case_id) and the deduplication lookup (latest by fingerprint).
+
+CREATE TABLE alerts (
+    workspace_id TEXT        NOT NULL,
+    id           TEXT        NOT NULL,
+    case_id      BIGINT      NOT NULL,
+    fingerprint  TEXT        NOT NULL,
+    received_at  TIMESTAMPTZ NOT NULL,
+    data         JSONB       NOT NULL,
+    PRIMARY KEY (workspace_id, id)
+);
+CREATE INDEX alerts_case_idx ON alerts (workspace_id, case_id, received_at);
+CREATE INDEX alerts_fingerprint_idx ON alerts (workspace_id, fingerprint, received_at DESC);
@@ -0,0 +1,23 @@
+-- Columns case search orders, bounds and continues by, so a page reads only
+-- the rows up to its last match instead of the whole workspace.
+
+ALTER TABLE cases
+    ADD COLUMN created_at  TIMESTAMPTZ,
+    ADD COLUMN updated_at  TIMESTAMPTZ,
+    ADD COLUMN reporter_id TEXT,
+    ADD COLUMN is_test     BOOLEAN;
+
+UPDATE cases SET
+    created_at  = (data->>'CreatedAt')::timestamptz,
+    updated_at  = (data->>'UpdatedAt')::timestamptz,
+    reporter_id = COALESCE(data->>'ReporterID', ''),
+    is_test     = COALESCE((data->>'IsTest')::boolean, false);
+
+ALTER TABLE cases
+    ALTER COLUMN created_at  SET NOT NULL,
+    ALTER COLUMN updated_at  SET NOT NULL,
+    ALTER COLUMN reporter_id SET NOT NULL,
+    ALTER COLUMN is_test     SET NOT NULL;
+
+CREATE INDEX cases_created_idx ON cases (workspace_id, created_at, id);
+CREATE INDEX cases_updated_idx ON cases (workspace_id, updated_at, id);
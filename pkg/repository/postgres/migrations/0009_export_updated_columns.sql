@@ -0,0 +1,22 @@
+-- Columns an incremental export reads memos and job runs changed after its
+-- watermark by, so it need not list every case's memos and run history.
+--
+-- Job runs written before the model carried UpdatedAt take their last run
+-- or, while suspended, their suspension time: the instants an export
+-- selected them by until now.
+
+ALTER TABLE memos ADD COLUMN updated_at TIMESTAMPTZ;
+ALTER TABLE job_runs ADD COLUMN updated_at TIMESTAMPTZ;
+
+UPDATE memos SET updated_at = (data->>'UpdatedAt')::timestamptz;
+
+UPDATE job_runs SET updated_at = CASE
+    WHEN COALESCE(data->>'SuspendedRunID', '') <> '' THEN (data->>'SuspendedAt')::timestamptz
+    ELSE (data->>'LastRunAt')::timestamptz
+END;
+
+ALTER TABLE memos ALTER COLUMN updated_at SET NOT NULL;
+ALTER TABLE job_runs ALTER COLUMN updated_at SET NOT NULL;
+
+CREATE INDEX memos_updated_idx ON memos (workspace_id, updated_at);
+CREATE INDEX job_runs_updated_idx ON job_runs (workspace_id, updated_at);
@@ -0,0 +1,55 @@
+// Package postgres is the PostgreSQL backend of interfaces.Repository: the
+// repository itself is sqldb's, run through the pgx database/sql driver with
+// this package's dialect and schema (see migrations/).
+//
+// The schema is created and upgraded by Migrate (`hecatoncheires migrate
+// --repository-backend=postgres`); New refuses to start against a database
+// whose schema is behind this build.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	_ "github.com/jackc/pgx/v5/stdlib" // registers the "pgx" database/sql driver
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/sqldb"
+)
+
+// New connects to the database at dsn (a libpq URL or keyword/value string)
+// and checks that its schema is up to date.
+func New(ctx context.Context, dsn string) (*sqldb.Repository, error) {
+	if dsn == "" {
+		return nil, goerr.New("postgres DSN is required")
+	}
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		// The DSN may embed a password, so it is deliberately not attached.
+		return nil, goerr.Wrap(err, "failed to open postgres")
+	}
+	if err := checkSchema(ctx, db); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return sqldb.New(db, dialect{}), nil
+}
+
+// checkSchema refuses a database whose schema is older (or newer) than the
+// migrations embedded in this build, so a forgotten `migrate` fails at startup
+// rather than at the first query against a missing column.
+func checkSchema(ctx context.Context, db *sql.DB) error {
+	want, err := latestVersion()
+	if err != nil {
+		return err
+	}
+	got, err := currentVersion(db.QueryRowContext(ctx, currentVersionQuery))
+	if err != nil {
+		return goerr.Wrap(err, "failed to check postgres schema version")
+	}
+	if got != want {
+		return goerr.New("postgres schema version does not match this build; run `hecatoncheires migrate --repository-backend=postgres`",
+			goerr.V("schema_version", got),
+			goerr.V("expected_version", want))
+	}
+	return nil
+}
@@ -109,3 +109,13 @@ func TestReactionClaimRepository_Firestore(t *testing.T) {
 	t.Parallel()
 	runReactionClaimRepositoryTest(t, newFirestoreRepository)
 }
+
+func TestReactionClaimRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runReactionClaimRepositoryTest(t, newPostgresRepository)
+}
+
+func TestReactionClaimRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runReactionClaimRepositoryTest(t, newSQLiteRepository)
+}
@@ -424,3 +424,13 @@ func TestSessionRepository_Firestore(t *testing.T) {
 	t.Parallel()
 	runSessionRepositoryTest(t, newFirestoreRepository)
 }
+
+func TestSessionRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runSessionRepositoryTest(t, newPostgresRepository)
+}
+
+func TestSessionRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runSessionRepositoryTest(t, newSQLiteRepository)
+}
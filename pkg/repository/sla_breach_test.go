@@ -0,0 +1,146 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+)
+
+func runSLABreachRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.Repository) {
+	t.Helper()
+	ctx := context.Background()
+	due := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	breach := func(wsID string, caseID int64, kind model.SLAKind, dueAt time.Time) *model.SLABreach {
+		return &model.SLABreach{
+			WorkspaceID: wsID,
+			CaseID:      caseID,
+			Kind:        kind,
+			FieldID:     "severity",
+			Value:       "critical",
+			Target:      time.Hour,
+			DueAt:       dueAt,
+			RecordedAt:  dueAt.Add(time.Minute),
+		}
+	}
+
+	t.Run("first record wins, second is deduped", func(t *testing.T) {
+		repo := newRepo(t)
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+
+		recorded, err := repo.SLABreach().Record(ctx, breach(wsID, 1, model.SLAKindResponse, due))
+		gt.NoError(t, err).Required()
+		gt.Bool(t, recorded).True()
+
+		again, err := repo.SLABreach().Record(ctx, breach(wsID, 1, model.SLAKindResponse, due.Add(time.Hour)))
+		gt.NoError(t, err).Required()
+		gt.Bool(t, again).False()
+
+		got, err := repo.SLABreach().ListByCase(ctx, wsID, 1)
+		gt.NoError(t, err).Required()
+		gt.Array(t, got).Length(1).Required()
+		gt.True(t, got[0].DueAt.Equal(due))
+		gt.Value(t, got[0].Target).Equal(time.Hour)
+		gt.Value(t, got[0].FieldID).Equal("severity")
+		gt.Value(t, got[0].Value).Equal("critical")
+	})
+
+	t.Run("lists are scoped and ordered by due time", func(t *testing.T) {
+		repo := newRepo(t)
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+
+		for _, b := range []*model.SLABreach{
+			breach(wsID, 1, model.SLAKindResolution, due.Add(24*time.Hour)),
+			breach(wsID, 1, model.SLAKindResponse, due),
+			breach(wsID, 2, model.SLAKindResponse, due.Add(time.Hour)),
+			breach(wsID+"-other", 1, model.SLAKindResponse, due),
+		} {
+			recorded, err := repo.SLABreach().Record(ctx, b)
+			gt.NoError(t, err).Required()
+			gt.Bool(t, recorded).True()
+		}
+
+		byCase, err := repo.SLABreach().ListByCase(ctx, wsID, 1)
+		gt.NoError(t, err).Required()
+		gt.Array(t, byCase).Length(2).Required()
+		gt.Value(t, byCase[0].Kind).Equal(model.SLAKindResponse)
+		gt.Value(t, byCase[1].Kind).Equal(model.SLAKindResolution)
+
+		all, err := repo.SLABreach().List(ctx, wsID)
+		gt.NoError(t, err).Required()
+		gt.Array(t, all).Length(3).Required()
+		gt.Value(t, all[0].CaseID).Equal(int64(1))
+		gt.Value(t, all[1].CaseID).Equal(int64(2))
+		gt.Value(t, all[2].Kind).Equal(model.SLAKindResolution)
+
+		none, err := repo.SLABreach().ListByCase(ctx, wsID, 99)
+		gt.NoError(t, err).Required()
+		gt.Array(t, none).Length(0)
+	})
+
+	t.Run("MoveCase keeps the target's own breach of a kind", func(t *testing.T) {
+		repo := newRepo(t)
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+
+		for _, b := range []*model.SLABreach{
+			breach(wsID, 1, model.SLAKindResponse, due),
+			breach(wsID, 1, model.SLAKindResolution, due.Add(24*time.Hour)),
+			breach(wsID, 2, model.SLAKindResponse, due.Add(time.Hour)),
+		} {
+			_, err := repo.SLABreach().Record(ctx, b)
+			gt.NoError(t, err).Required()
+		}
+
+		gt.NoError(t, repo.SLABreach().MoveCase(ctx, wsID, 1, 2)).Required()
+
+		left, err := repo.SLABreach().ListByCase(ctx, wsID, 1)
+		gt.NoError(t, err).Required()
+		gt.Array(t, left).Length(0)
+
+		got, err := repo.SLABreach().ListByCase(ctx, wsID, 2)
+		gt.NoError(t, err).Required()
+		gt.Array(t, got).Length(2).Required()
+		gt.Value(t, got[0].Kind).Equal(model.SLAKindResponse)
+		gt.Bool(t, got[0].DueAt.Equal(due.Add(time.Hour))).True()
+		gt.Value(t, got[1].Kind).Equal(model.SLAKindResolution)
+		gt.Value(t, got[1].CaseID).Equal(int64(2))
+	})
+
+	t.Run("empty identity is rejected", func(t *testing.T) {
+		repo := newRepo(t)
+		_, err := repo.SLABreach().Record(ctx, breach("", 1, model.SLAKindResponse, due))
+		gt.Error(t, err)
+		_, err = repo.SLABreach().Record(ctx, breach("ws", 0, model.SLAKindResponse, due))
+		gt.Error(t, err)
+		_, err = repo.SLABreach().Record(ctx, breach("ws", 1, "", due))
+		gt.Error(t, err)
+	})
+}
+
+func TestSLABreachRepository_Memory(t *testing.T) {
+	t.Parallel()
+	runSLABreachRepositoryTest(t, func(t *testing.T) interfaces.Repository {
+		return memory.New()
+	})
+}
+
+func TestSLABreachRepository_Firestore(t *testing.T) {
+	t.Parallel()
+	runSLABreachRepositoryTest(t, newFirestoreRepository)
+}
+
+func TestSLABreachRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runSLABreachRepositoryTest(t, newPostgresRepository)
+}
+
+func TestSLABreachRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runSLABreachRepositoryTest(t, newSQLiteRepository)
+}
@@ -419,3 +419,13 @@ func TestFirestoreSlackRepository(t *testing.T) {
 	t.Parallel()
 	runSlackRepositoryTest(t, newFirestoreRepository)
 }
+
+func TestPostgresSlackRepository(t *testing.T) {
+	t.Parallel()
+	runSlackRepositoryTest(t, newPostgresRepository)
+}
+
+func TestSQLiteSlackRepository(t *testing.T) {
+	t.Parallel()
+	runSlackRepositoryTest(t, newSQLiteRepository)
+}
@@ -3,6 +3,7 @@ package repository_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -380,6 +381,27 @@ func runSlackUserRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfa
 		gt.Bool(t, ok).False()
 	})
 
+	t.Run("GetByEmail matches regardless of case", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+		now := time.Now()
+
+		id := model.SlackUserID(fmt.Sprintf("U%d_mail", now.UnixNano()))
+		email := fmt.Sprintf("mail-%d@example.com", now.UnixNano())
+		gt.NoError(t, repo.SlackUser().SaveMany(ctx, []*model.SlackUser{
+			{ID: id, Name: "mail", RealName: "Mail User", Email: email, UpdatedAt: now},
+		})).Required()
+
+		got, err := repo.SlackUser().GetByEmail(ctx, strings.ToUpper(email))
+		gt.NoError(t, err).Required()
+		gt.Value(t, got).NotNil().Required()
+		gt.Value(t, got.ID).Equal(id)
+
+		missing, err := repo.SlackUser().GetByEmail(ctx, fmt.Sprintf("nobody-%d@example.com", now.UnixNano()))
+		gt.NoError(t, err).Required()
+		gt.Value(t, missing).Nil()
+	})
+
 	t.Run("DeleteAll removes all users", func(t *testing.T) {
 		repo := newRepo(t)
 		ctx := context.Background()
@@ -557,3 +579,11 @@ func TestMemorySlackUserRepository(t *testing.T) {
 func TestFirestoreSlackUserRepository(t *testing.T) {
 	runSlackUserRepositoryTest(t, newFirestoreRepository)
 }
+
+func TestPostgresSlackUserRepository(t *testing.T) {
+	runSlackUserRepositoryTest(t, newPostgresRepository)
+}
+
+func TestSQLiteSlackUserRepository(t *testing.T) {
+	runSlackUserRepositoryTest(t, newSQLiteRepository)
+}
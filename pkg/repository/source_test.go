@@ -12,6 +12,8 @@ import (
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/firestore"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/postgres"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/sqlite"
 )
 
 func runSourceRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.Repository) {
@@ -123,7 +125,7 @@ func runSourceRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces
 
 		_, err := repo.Source().Get(ctx, wsID, "non-existent-id")
 		gt.Value(t, err).NotNil()
-		gt.Bool(t, errors.Is(err, memory.ErrNotFound) || errors.Is(err, firestore.ErrNotFound)).True()
+		gt.Bool(t, errors.Is(err, memory.ErrNotFound) || errors.Is(err, firestore.ErrNotFound) || errors.Is(err, postgres.ErrNotFound) || errors.Is(err, sqlite.ErrNotFound)).True()
 	})
 
 	t.Run("List returns all sources", func(t *testing.T) {
@@ -228,7 +230,7 @@ func runSourceRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces
 			Enabled:     true,
 		}, nil))
 		gt.Value(t, err).NotNil()
-		gt.Bool(t, errors.Is(err, memory.ErrNotFound) || errors.Is(err, firestore.ErrNotFound)).True()
+		gt.Bool(t, errors.Is(err, memory.ErrNotFound) || errors.Is(err, firestore.ErrNotFound) || errors.Is(err, postgres.ErrNotFound) || errors.Is(err, sqlite.ErrNotFound)).True()
 	})
 
 	t.Run("Delete removes existing source", func(t *testing.T) {
@@ -247,7 +249,7 @@ func runSourceRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces
 
 		_, err = repo.Source().Get(ctx, wsID, created.ID)
 		gt.Value(t, err).NotNil()
-		gt.Bool(t, errors.Is(err, memory.ErrNotFound) || errors.Is(err, firestore.ErrNotFound)).True()
+		gt.Bool(t, errors.Is(err, memory.ErrNotFound) || errors.Is(err, firestore.ErrNotFound) || errors.Is(err, postgres.ErrNotFound) || errors.Is(err, sqlite.ErrNotFound)).True()
 	})
 
 	t.Run("Delete returns error for non-existent source", func(t *testing.T) {
@@ -256,7 +258,7 @@ func runSourceRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces
 
 		err := repo.Source().Delete(ctx, wsID, "non-existent-id")
 		gt.Value(t, err).NotNil()
-		gt.Bool(t, errors.Is(err, memory.ErrNotFound) || errors.Is(err, firestore.ErrNotFound)).True()
+		gt.Bool(t, errors.Is(err, memory.ErrNotFound) || errors.Is(err, firestore.ErrNotFound) || errors.Is(err, postgres.ErrNotFound) || errors.Is(err, sqlite.ErrNotFound)).True()
 	})
 
 	t.Run("Source without NotionDBConfig works", func(t *testing.T) {
@@ -653,3 +655,13 @@ func TestFirestoreSourceRepository(t *testing.T) {
 	t.Parallel()
 	runSourceRepositoryTest(t, newFirestoreRepository)
 }
+
+func TestPostgresSourceRepository(t *testing.T) {
+	t.Parallel()
+	runSourceRepositoryTest(t, newPostgresRepository)
+}
+
+func TestSQLiteSourceRepository(t *testing.T) {
+	t.Parallel()
+	runSourceRepositoryTest(t, newSQLiteRepository)
+}
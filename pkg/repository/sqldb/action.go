@@ -0,0 +1,184 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+type actionRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.ActionRepository = &actionRepository{}
+
+func newActionRepository(db *sql.DB, dialect Dialect) *actionRepository {
+	return &actionRepository{db: db, dialect: dialect}
+}
+
+// filterActions applies the archive scope and UpdatedAfter in Go, against
+// the model's own timestamps, so the boundary is exact rather than rounded
+// to the microsecond precision of a Postgres timestamptz column.
+func filterActions(actions []*model.Action, opts interfaces.ActionListOptions) []*model.Action {
+	out := make([]*model.Action, 0, len(actions))
+	for _, a := range actions {
+		if !opts.ArchiveScope.Allows(a.IsArchived()) || !opts.AllowsUpdatedAt(a.UpdatedAt) {
+			continue
+		}
+		out = append(out, a)
+	}
+	return out
+}
+
+func (r *actionRepository) Create(ctx context.Context, workspaceID string, action *model.Action) (*model.Action, error) {
+	if err := action.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "action validation failed before create")
+	}
+
+	id, err := nextID(ctx, r.db, "action", workspaceID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get next ID")
+	}
+	action.ID = id
+
+	data, err := encode(action)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := exec(ctx, r.db, `
+		INSERT INTO actions (workspace_id, id, case_id, slack_message_ts, data) VALUES ($1, $2, $3, $4, $5)`,
+		workspaceID, action.ID, action.CaseID, action.SlackMessageTS, data); err != nil {
+		return nil, goerr.Wrap(err, "failed to create action", goerr.V("id", action.ID))
+	}
+	return action, nil
+}
+
+func (r *actionRepository) Get(ctx context.Context, workspaceID string, id int64) (*model.Action, error) {
+	a, err := getDoc[model.Action](ctx, r.db,
+		`SELECT data FROM actions WHERE workspace_id = $1 AND id = $2`, workspaceID, id)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get action", goerr.V("id", id))
+	}
+	if a == nil {
+		return nil, goerr.Wrap(ErrNotFound, "action not found", goerr.V("id", id))
+	}
+	return a, nil
+}
+
+func (r *actionRepository) GetByIDs(ctx context.Context, workspaceID string, ids []int64) (map[int64]*model.Action, error) {
+	result := make(map[int64]*model.Action, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	list, err := r.dialect.List(ids)
+	if err != nil {
+		return nil, err
+	}
+	actions, err := listDocs[model.Action](ctx, r.db,
+		`SELECT data FROM actions WHERE workspace_id = $1 AND `+r.dialect.In("id", "$2"), workspaceID, list)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to batch get actions", goerr.V("ids", ids))
+	}
+	for _, a := range actions {
+		result[a.ID] = a
+	}
+	return result, nil
+}
+
+func (r *actionRepository) List(ctx context.Context, workspaceID string, opts interfaces.ActionListOptions) ([]*model.Action, error) {
+	actions, err := listDocs[model.Action](ctx, r.db,
+		`SELECT data FROM actions WHERE workspace_id = $1 ORDER BY id`, workspaceID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list actions")
+	}
+	return filterActions(actions, opts), nil
+}
+
+func (r *actionRepository) Update(ctx context.Context, workspaceID string, action *model.Action) (*model.Action, error) {
+	if err := action.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "action validation failed before update")
+	}
+
+	data, err := encode(action)
+	if err != nil {
+		return nil, err
+	}
+	n, err := exec(ctx, r.db, `
+		UPDATE actions SET case_id = $3, slack_message_ts = $4, data = $5
+		WHERE workspace_id = $1 AND id = $2`,
+		workspaceID, action.ID, action.CaseID, action.SlackMessageTS, data)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to update action", goerr.V("id", action.ID))
+	}
+	if n == 0 {
+		return nil, goerr.Wrap(ErrNotFound, "action not found", goerr.V("id", action.ID))
+	}
+	return action, nil
+}
+
+func (r *actionRepository) Delete(ctx context.Context, workspaceID string, id int64) error {
+	n, err := exec(ctx, r.db, `DELETE FROM actions WHERE workspace_id = $1 AND id = $2`, workspaceID, id)
+	if err != nil {
+		return goerr.Wrap(err, "failed to delete action", goerr.V("id", id))
+	}
+	if n == 0 {
+		return goerr.Wrap(ErrNotFound, "action not found", goerr.V("id", id))
+	}
+	return nil
+}
+
+func (r *actionRepository) GetByCase(ctx context.Context, workspaceID string, caseID int64, opts interfaces.ActionListOptions) ([]*model.Action, error) {
+	actions, err := listDocs[model.Action](ctx, r.db,
+		`SELECT data FROM actions WHERE workspace_id = $1 AND case_id = $2 ORDER BY id`, workspaceID, caseID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get actions by case", goerr.V("case_id", caseID))
+	}
+	return filterActions(actions, opts), nil
+}
+
+func (r *actionRepository) GetByCases(ctx context.Context, workspaceID string, caseIDs []int64, opts interfaces.ActionListOptions) (map[int64][]*model.Action, error) {
+	result := make(map[int64][]*model.Action, len(caseIDs))
+	for _, caseID := range caseIDs {
+		result[caseID] = make([]*model.Action, 0)
+	}
+	if len(caseIDs) == 0 {
+		return result, nil
+	}
+
+	list, err := r.dialect.List(caseIDs)
+	if err != nil {
+		return nil, err
+	}
+	actions, err := listDocs[model.Action](ctx, r.db,
+		`SELECT data FROM actions WHERE workspace_id = $1 AND `+r.dialect.In("case_id", "$2")+` ORDER BY id`,
+		workspaceID, list)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get actions by cases", goerr.V("case_ids", caseIDs))
+	}
+	for _, a := range filterActions(actions, opts) {
+		result[a.CaseID] = append(result[a.CaseID], a)
+	}
+	return result, nil
+}
+
+func (r *actionRepository) GetBySlackMessageTS(ctx context.Context, workspaceID string, ts string) (*model.Action, error) {
+	if ts == "" {
+		return nil, goerr.Wrap(ErrNotFound, "slack message ts is empty")
+	}
+
+	a, err := getDoc[model.Action](ctx, r.db,
+		`SELECT data FROM actions WHERE workspace_id = $1 AND slack_message_ts = $2 ORDER BY id LIMIT 1`,
+		workspaceID, ts)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get action by slack message ts", goerr.V("slack_message_ts", ts))
+	}
+	if a == nil {
+		return nil, goerr.Wrap(ErrNotFound, "action not found", goerr.V("slack_message_ts", ts))
+	}
+	return a, nil
+}
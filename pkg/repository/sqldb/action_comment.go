@@ -0,0 +1,132 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+type actionCommentRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.ActionCommentRepository = &actionCommentRepository{}
+
+func newActionCommentRepository(db *sql.DB, dialect Dialect) *actionCommentRepository {
+	return &actionCommentRepository{db: db, dialect: dialect}
+}
+
+func validateActionCommentForWrite(actionID int64, comment *model.ActionComment) error {
+	if err := comment.Validate(); err != nil {
+		return goerr.Wrap(err, "action comment validation failed before write")
+	}
+	if comment.ActionID != actionID {
+		return goerr.Wrap(model.ErrActionCommentValidation, "action comment ActionID does not match parameter",
+			goerr.V("param", actionID), goerr.V("comment", comment.ActionID))
+	}
+	return nil
+}
+
+func (r *actionCommentRepository) Create(ctx context.Context, workspaceID string, actionID int64, comment *model.ActionComment) error {
+	if err := validateActionCommentForWrite(actionID, comment); err != nil {
+		return err
+	}
+
+	data, err := encode(comment)
+	if err != nil {
+		return err
+	}
+	n, err := exec(ctx, r.db, `
+		INSERT INTO action_comments (workspace_id, action_id, id, created_at, data) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT DO NOTHING`,
+		workspaceID, actionID, comment.ID, r.dialect.Time(comment.CreatedAt), data)
+	if err != nil {
+		return goerr.Wrap(err, "failed to create action comment",
+			goerr.V("action_id", actionID), goerr.V("comment_id", comment.ID))
+	}
+	if n == 0 {
+		return goerr.Wrap(interfaces.ErrActionCommentExists, "action comment already exists",
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("action_id", actionID),
+			goerr.V("comment_id", comment.ID))
+	}
+	return nil
+}
+
+func (r *actionCommentRepository) Update(ctx context.Context, workspaceID string, actionID int64, comment *model.ActionComment) error {
+	if err := validateActionCommentForWrite(actionID, comment); err != nil {
+		return err
+	}
+
+	data, err := encode(comment)
+	if err != nil {
+		return err
+	}
+	n, err := exec(ctx, r.db, `
+		UPDATE action_comments SET created_at = $4, data = $5
+		WHERE workspace_id = $1 AND action_id = $2 AND id = $3`,
+		workspaceID, actionID, comment.ID, r.dialect.Time(comment.CreatedAt), data)
+	if err != nil {
+		return goerr.Wrap(err, "failed to update action comment",
+			goerr.V("action_id", actionID), goerr.V("comment_id", comment.ID))
+	}
+	if n == 0 {
+		return goerr.Wrap(interfaces.ErrActionCommentNotFound, "action comment not found",
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("action_id", actionID),
+			goerr.V("comment_id", comment.ID))
+	}
+	return nil
+}
+
+func (r *actionCommentRepository) Get(ctx context.Context, workspaceID string, actionID int64, commentID string) (*model.ActionComment, error) {
+	c, err := getDoc[model.ActionComment](ctx, r.db,
+		`SELECT data FROM action_comments WHERE workspace_id = $1 AND action_id = $2 AND id = $3`,
+		workspaceID, actionID, commentID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get action comment",
+			goerr.V("action_id", actionID), goerr.V("comment_id", commentID))
+	}
+	if c == nil {
+		return nil, goerr.Wrap(interfaces.ErrActionCommentNotFound, "action comment not found",
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("action_id", actionID),
+			goerr.V("comment_id", commentID))
+	}
+	return c, nil
+}
+
+// List returns the action's comments newest first. An unknown cursor yields
+// an empty page.
+func (r *actionCommentRepository) List(ctx context.Context, workspaceID string, actionID int64, limit int, cursor string) ([]*model.ActionComment, string, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+
+	page, hasMore, err := pageDesc[model.ActionComment](ctx, r.db, "action_comments",
+		`workspace_id = $1 AND action_id = $2`, []any{workspaceID, actionID}, limit, cursor, true)
+	if err != nil {
+		return nil, "", goerr.Wrap(err, "failed to list action comments", goerr.V("action_id", actionID))
+	}
+
+	var nextCursor string
+	if hasMore && len(page) > 0 {
+		nextCursor = page[len(page)-1].ID
+	}
+	return page, nextCursor, nil
+}
+
+// Delete is idempotent: deleting a missing comment is not an error.
+func (r *actionCommentRepository) Delete(ctx context.Context, workspaceID string, actionID int64, commentID string) error {
+	if _, err := exec(ctx, r.db,
+		`DELETE FROM action_comments WHERE workspace_id = $1 AND action_id = $2 AND id = $3`,
+		workspaceID, actionID, commentID); err != nil {
+		return goerr.Wrap(err, "failed to delete action comment",
+			goerr.V("action_id", actionID), goerr.V("comment_id", commentID))
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+type actionEventRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.ActionEventRepository = &actionEventRepository{}
+
+func newActionEventRepository(db *sql.DB, dialect Dialect) *actionEventRepository {
+	return &actionEventRepository{db: db, dialect: dialect}
+}
+
+func (r *actionEventRepository) Put(ctx context.Context, workspaceID string, actionID int64, event *model.ActionEvent) error {
+	if err := event.Validate(); err != nil {
+		return goerr.Wrap(err, "action event validation failed before put")
+	}
+	if event.ActionID != actionID {
+		return goerr.Wrap(model.ErrActionEventValidation, "action event ActionID does not match parameter",
+			goerr.V("param", actionID), goerr.V("event", event.ActionID))
+	}
+
+	data, err := encode(event)
+	if err != nil {
+		return err
+	}
+	if _, err := exec(ctx, r.db, `
+		INSERT INTO action_events (workspace_id, action_id, id, created_at, data) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (workspace_id, action_id, id) DO UPDATE SET created_at = EXCLUDED.created_at, data = EXCLUDED.data`,
+		workspaceID, actionID, event.ID, r.dialect.Time(event.CreatedAt), data); err != nil {
+		return goerr.Wrap(err, "failed to put action event",
+			goerr.V("action_id", actionID), goerr.V("event_id", event.ID))
+	}
+	return nil
+}
+
+// List returns the action's events newest first. An unknown cursor yields an
+// empty page.
+func (r *actionEventRepository) List(ctx context.Context, workspaceID string, actionID int64, limit int, cursor string) ([]*model.ActionEvent, string, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+
+	page, hasMore, err := pageDesc[model.ActionEvent](ctx, r.db, "action_events",
+		`workspace_id = $1 AND action_id = $2`, []any{workspaceID, actionID}, limit, cursor, true)
+	if err != nil {
+		return nil, "", goerr.Wrap(err, "failed to list action events", goerr.V("action_id", actionID))
+	}
+
+	var nextCursor string
+	if hasMore && len(page) > 0 {
+		nextCursor = page[len(page)-1].ID
+	}
+	return page, nextCursor, nil
+}
@@ -0,0 +1,52 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/slack"
+)
+
+type actionMessageRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.ActionMessageRepository = &actionMessageRepository{}
+
+func newActionMessageRepository(db *sql.DB, dialect Dialect) *actionMessageRepository {
+	return &actionMessageRepository{db: db, dialect: dialect}
+}
+
+func (r *actionMessageRepository) Put(ctx context.Context, workspaceID string, actionID int64, msg *slack.Message) error {
+	data, err := encode(toSlackMessage(msg))
+	if err != nil {
+		return err
+	}
+	if _, err := exec(ctx, r.db, `
+		INSERT INTO action_messages (workspace_id, action_id, id, created_at, data) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (workspace_id, action_id, id) DO UPDATE SET created_at = EXCLUDED.created_at, data = EXCLUDED.data`,
+		workspaceID, actionID, msg.ID(), r.dialect.Time(msg.CreatedAt()), data); err != nil {
+		return goerr.Wrap(err, "failed to put action message",
+			goerr.V("action_id", actionID), goerr.V("message_id", msg.ID()))
+	}
+	return nil
+}
+
+// List returns the action's messages newest first. An unknown cursor starts
+// from the first page.
+func (r *actionMessageRepository) List(ctx context.Context, workspaceID string, actionID int64, limit int, cursor string) ([]*slack.Message, string, error) {
+	if limit <= 0 {
+		limit = defaultMessagePageSize
+	}
+
+	page, hasMore, err := pageDesc[slackMessage](ctx, r.db, "action_messages",
+		`workspace_id = $1 AND action_id = $2`, []any{workspaceID, actionID}, limit, cursor, false)
+	if err != nil {
+		return nil, "", goerr.Wrap(err, "failed to list action messages", goerr.V("action_id", actionID))
+	}
+	msgs, next := messagePage(page, hasMore)
+	return msgs, next, nil
+}
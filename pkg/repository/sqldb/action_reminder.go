@@ -0,0 +1,54 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+type actionReminderRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.ActionReminderRepository = &actionReminderRepository{}
+
+func newActionReminderRepository(db *sql.DB, dialect Dialect) *actionReminderRepository {
+	return &actionReminderRepository{db: db, dialect: dialect}
+}
+
+// Claim relies on the primary key: exactly one concurrent insert of a
+// reminder succeeds, and only that caller sees true.
+func (r *actionReminderRepository) Claim(ctx context.Context, workspaceID string, actionID int64, kind model.ActionReminderKind, dueDate time.Time) (bool, error) {
+	if workspaceID == "" || actionID == 0 || kind == "" {
+		return false, goerr.New("workspaceID, actionID and kind are required")
+	}
+	n, err := exec(ctx, r.db, `
+		INSERT INTO action_reminders (workspace_id, action_id, kind, due_date, claimed_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT DO NOTHING`,
+		workspaceID, actionID, string(kind), r.dialect.Time(dueDate), r.dialect.Time(time.Now()))
+	if err != nil {
+		return false, goerr.Wrap(err, "failed to claim action reminder",
+			goerr.V("action_id", actionID), goerr.V("kind", kind))
+	}
+	return n == 1, nil
+}
+
+func (r *actionReminderRepository) Release(ctx context.Context, workspaceID string, actionID int64, kind model.ActionReminderKind, dueDate time.Time) error {
+	if workspaceID == "" || actionID == 0 || kind == "" {
+		return goerr.New("workspaceID, actionID and kind are required")
+	}
+	if _, err := exec(ctx, r.db, `
+		DELETE FROM action_reminders
+		WHERE workspace_id = $1 AND action_id = $2 AND kind = $3 AND due_date = $4`,
+		workspaceID, actionID, string(kind), r.dialect.Time(dueDate)); err != nil {
+		return goerr.Wrap(err, "failed to release action reminder",
+			goerr.V("action_id", actionID), goerr.V("kind", kind))
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+type actionStepRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.ActionStepRepository = &actionStepRepository{}
+
+func newActionStepRepository(db *sql.DB, dialect Dialect) *actionStepRepository {
+	return &actionStepRepository{db: db, dialect: dialect}
+}
+
+func (r *actionStepRepository) Put(ctx context.Context, workspaceID string, step *model.ActionStep) error {
+	if err := step.Validate(); err != nil {
+		return goerr.Wrap(err, "action step validation failed before put")
+	}
+
+	data, err := encode(step)
+	if err != nil {
+		return err
+	}
+	if _, err := exec(ctx, r.db, `
+		INSERT INTO action_steps (workspace_id, action_id, id, created_at, data) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (workspace_id, action_id, id) DO UPDATE SET created_at = EXCLUDED.created_at, data = EXCLUDED.data`,
+		workspaceID, step.ActionID, step.ID, r.dialect.Time(step.CreatedAt), data); err != nil {
+		return goerr.Wrap(err, "failed to put action step",
+			goerr.V("action_id", step.ActionID), goerr.V("step_id", step.ID))
+	}
+	return nil
+}
+
+func (r *actionStepRepository) Get(ctx context.Context, workspaceID string, actionID int64, stepID string) (*model.ActionStep, error) {
+	s, err := getDoc[model.ActionStep](ctx, r.db,
+		`SELECT data FROM action_steps WHERE workspace_id = $1 AND action_id = $2 AND id = $3`,
+		workspaceID, actionID, stepID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get action step",
+			goerr.V("action_id", actionID), goerr.V("step_id", stepID))
+	}
+	if s == nil {
+		return nil, goerr.Wrap(ErrNotFound, "action step not found",
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("action_id", actionID),
+			goerr.V("step_id", stepID))
+	}
+	return s, nil
+}
+
+// List returns the action's steps oldest first.
+func (r *actionStepRepository) List(ctx context.Context, workspaceID string, actionID int64) ([]*model.ActionStep, error) {
+	steps, err := listDocs[model.ActionStep](ctx, r.db,
+		`SELECT data FROM action_steps WHERE workspace_id = $1 AND action_id = $2 ORDER BY created_at, id`,
+		workspaceID, actionID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list action steps", goerr.V("action_id", actionID))
+	}
+	return steps, nil
+}
+
+// Delete is idempotent: deleting a missing step is not an error.
+func (r *actionStepRepository) Delete(ctx context.Context, workspaceID string, actionID int64, stepID string) error {
+	if _, err := exec(ctx, r.db,
+		`DELETE FROM action_steps WHERE workspace_id = $1 AND action_id = $2 AND id = $3`,
+		workspaceID, actionID, stepID); err != nil {
+		return goerr.Wrap(err, "failed to delete action step",
+			goerr.V("action_id", actionID), goerr.V("step_id", stepID))
+	}
+	return nil
+}
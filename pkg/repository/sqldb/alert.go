@@ -0,0 +1,67 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+// alertRepository stores ingested alerts. case_id and fingerprint are kept
+// in columns beside the document for the two lookups.
+type alertRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.AlertRepository = &alertRepository{}
+
+func newAlertRepository(db *sql.DB, dialect Dialect) *alertRepository {
+	return &alertRepository{db: db, dialect: dialect}
+}
+
+func (r *alertRepository) Create(ctx context.Context, a *model.Alert) error {
+	if err := a.Validate(); err != nil {
+		return goerr.Wrap(err, "alert validation failed before create")
+	}
+	data, err := encode(a)
+	if err != nil {
+		return err
+	}
+	if _, err := exec(ctx, r.db, `
+		INSERT INTO alerts (workspace_id, id, case_id, fingerprint, received_at, data)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		a.WorkspaceID, a.ID.String(), a.CaseID, a.Fingerprint, r.dialect.Time(a.ReceivedAt), data); err != nil {
+		return goerr.Wrap(err, "failed to create alert",
+			goerr.V("workspace_id", a.WorkspaceID), goerr.V("alert_id", a.ID))
+	}
+	return nil
+}
+
+func (r *alertRepository) ListByCase(ctx context.Context, workspaceID string, caseID int64) ([]*model.Alert, error) {
+	out, err := listDocs[model.Alert](ctx, r.db, `
+		SELECT data FROM alerts WHERE workspace_id = $1 AND case_id = $2
+		ORDER BY received_at, id`, workspaceID, caseID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list alerts",
+			goerr.V("workspace_id", workspaceID), goerr.V("case_id", caseID))
+	}
+	return out, nil
+}
+
+func (r *alertRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error {
+	return moveCaseRows(ctx, r.db, r.dialect, "alerts", workspaceID, fromCaseID, toCaseID, true)
+}
+
+func (r *alertRepository) LatestByFingerprint(ctx context.Context, workspaceID, fingerprint string) (*model.Alert, error) {
+	a, err := getDoc[model.Alert](ctx, r.db, `
+		SELECT data FROM alerts WHERE workspace_id = $1 AND fingerprint = $2
+		ORDER BY received_at DESC, id DESC LIMIT 1`, workspaceID, fingerprint)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get latest alert",
+			goerr.V("workspace_id", workspaceID), goerr.V("fingerprint", fingerprint))
+	}
+	return a, nil
+}
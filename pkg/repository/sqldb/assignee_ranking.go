@@ -0,0 +1,51 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+// assigneeRankingRepository stores one ranking document per workspace ID.
+type assigneeRankingRepository struct {
+	db *sql.DB
+}
+
+var _ interfaces.AssigneeRankingRepository = &assigneeRankingRepository{}
+
+func newAssigneeRankingRepository(db *sql.DB) *assigneeRankingRepository {
+	return &assigneeRankingRepository{db: db}
+}
+
+func (r *assigneeRankingRepository) Get(ctx context.Context, workspaceID string) (*model.AssigneeRanking, error) {
+	ranking, err := getDoc[model.AssigneeRanking](ctx, r.db,
+		`SELECT data FROM assignee_rankings WHERE workspace_id = $1`, workspaceID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get assignee ranking", goerr.V("workspace_id", workspaceID))
+	}
+	if ranking == nil {
+		return nil, goerr.Wrap(ErrNotFound, "assignee ranking not found", goerr.V("workspace_id", workspaceID))
+	}
+	return ranking, nil
+}
+
+func (r *assigneeRankingRepository) Set(ctx context.Context, ranking *model.AssigneeRanking) error {
+	if err := ranking.Validate(); err != nil {
+		return goerr.Wrap(err, "assignee ranking validation failed before set")
+	}
+
+	data, err := encode(ranking)
+	if err != nil {
+		return err
+	}
+	if _, err := exec(ctx, r.db, `
+		INSERT INTO assignee_rankings (workspace_id, data) VALUES ($1, $2)
+		ON CONFLICT (workspace_id) DO UPDATE SET data = EXCLUDED.data`,
+		ranking.WorkspaceID, data); err != nil {
+		return goerr.Wrap(err, "failed to set assignee ranking", goerr.V("workspace_id", ranking.WorkspaceID))
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+type assistLogRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.AssistLogRepository = &assistLogRepository{}
+
+func newAssistLogRepository(db *sql.DB, dialect Dialect) *assistLogRepository {
+	return &assistLogRepository{db: db, dialect: dialect}
+}
+
+func (r *assistLogRepository) Create(ctx context.Context, workspaceID string, caseID int64, log *model.AssistLog) (*model.AssistLog, error) {
+	if log == nil {
+		return nil, goerr.Wrap(model.ErrAssistLogValidation, "assist log is nil")
+	}
+
+	created := *log
+	if created.ID == "" {
+		created.ID = model.NewAssistLogID()
+	}
+	created.CaseID = caseID
+	if err := created.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "assist log validation failed before create")
+	}
+
+	data, err := encode(&created)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := exec(ctx, r.db, `
+		INSERT INTO assist_logs (workspace_id, case_id, id, created_at, data) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (workspace_id, case_id, id) DO UPDATE SET created_at = EXCLUDED.created_at, data = EXCLUDED.data`,
+		workspaceID, caseID, string(created.ID), r.dialect.Time(created.CreatedAt), data); err != nil {
+		return nil, goerr.Wrap(err, "failed to create assist log",
+			goerr.V("case_id", caseID), goerr.V("id", created.ID))
+	}
+	return &created, nil
+}
+
+// List returns one page of the case's assist logs, newest first, with the
+// total number of logs the case has.
+func (r *assistLogRepository) List(ctx context.Context, workspaceID string, caseID int64, limit, offset int) ([]*model.AssistLog, int, error) {
+	var total int
+	if err := r.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM assist_logs WHERE workspace_id = $1 AND case_id = $2`,
+		workspaceID, caseID).Scan(&total); err != nil {
+		return nil, 0, goerr.Wrap(err, "failed to count assist logs", goerr.V("case_id", caseID))
+	}
+	if offset >= total || limit <= 0 {
+		return []*model.AssistLog{}, total, nil
+	}
+
+	logs, err := listDocs[model.AssistLog](ctx, r.db, `
+		SELECT data FROM assist_logs WHERE workspace_id = $1 AND case_id = $2
+		ORDER BY created_at DESC, id DESC LIMIT $3 OFFSET $4`,
+		workspaceID, caseID, limit, offset)
+	if err != nil {
+		return nil, 0, goerr.Wrap(err, "failed to list assist logs", goerr.V("case_id", caseID))
+	}
+	return logs, total, nil
+}
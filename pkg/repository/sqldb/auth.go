@@ -0,0 +1,137 @@
+package sqldb
+
+import (
+	"context"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+)
+
+func (p *Repository) PutToken(ctx context.Context, token *auth.Token) error {
+	if err := token.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid token")
+	}
+
+	data, err := encode(token)
+	if err != nil {
+		return err
+	}
+	if _, err := exec(ctx, p.db, `
+		INSERT INTO auth_tokens (id, data) VALUES ($1, $2)
+		ON CONFLICT (id) DO UPDATE SET data = EXCLUDED.data`,
+		token.ID.String(), data); err != nil {
+		return goerr.Wrap(err, "failed to put token")
+	}
+	return nil
+}
+
+func (p *Repository) GetToken(ctx context.Context, tokenID auth.TokenID) (*auth.Token, error) {
+	if err := tokenID.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "invalid token ID")
+	}
+
+	token, err := getDoc[auth.Token](ctx, p.db, `SELECT data FROM auth_tokens WHERE id = $1`, tokenID.String())
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get token")
+	}
+	if token == nil {
+		return nil, ErrNotFound
+	}
+	return token, nil
+}
+
+func (p *Repository) DeleteToken(ctx context.Context, tokenID auth.TokenID) error {
+	if err := tokenID.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid token ID")
+	}
+
+	n, err := exec(ctx, p.db, `DELETE FROM auth_tokens WHERE id = $1`, tokenID.String())
+	if err != nil {
+		return goerr.Wrap(err, "failed to delete token")
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (p *Repository) PutAPIToken(ctx context.Context, token *auth.APIToken) error {
+	if err := token.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid API token")
+	}
+
+	data, err := encode(token)
+	if err != nil {
+		return err
+	}
+	if _, err := exec(ctx, p.db, `
+		INSERT INTO api_tokens (id, sub, created_at, data) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (id) DO UPDATE SET sub = EXCLUDED.sub, created_at = EXCLUDED.created_at, data = EXCLUDED.data`,
+		token.ID.String(), token.Sub, p.dialect.Time(token.CreatedAt), data); err != nil {
+		return goerr.Wrap(err, "failed to put API token")
+	}
+	return nil
+}
+
+func (p *Repository) GetAPIToken(ctx context.Context, tokenID auth.TokenID) (*auth.APIToken, error) {
+	if err := tokenID.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "invalid token ID")
+	}
+
+	token, err := getDoc[auth.APIToken](ctx, p.db, `SELECT data FROM api_tokens WHERE id = $1`, tokenID.String())
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get API token")
+	}
+	if token == nil {
+		return nil, ErrNotFound
+	}
+	return token, nil
+}
+
+func (p *Repository) ListAPITokens(ctx context.Context, sub string) ([]*auth.APIToken, error) {
+	tokens, err := listDocs[auth.APIToken](ctx, p.db,
+		`SELECT data FROM api_tokens WHERE sub = $1 ORDER BY created_at DESC, id DESC`, sub)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list API tokens", goerr.V("sub", sub))
+	}
+	return tokens, nil
+}
+
+func (p *Repository) DeleteAPIToken(ctx context.Context, tokenID auth.TokenID) error {
+	if err := tokenID.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid token ID")
+	}
+
+	n, err := exec(ctx, p.db, `DELETE FROM api_tokens WHERE id = $1`, tokenID.String())
+	if err != nil {
+		return goerr.Wrap(err, "failed to delete API token")
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (p *Repository) TouchAPIToken(ctx context.Context, tokenID auth.TokenID, usedAt time.Time) error {
+	if err := tokenID.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid token ID")
+	}
+
+	// Encoded the way encode renders the field, so the document still
+	// decodes into the model.
+	raw, err := encode(usedAt)
+	if err != nil {
+		return err
+	}
+	n, err := exec(ctx, p.db,
+		`UPDATE api_tokens SET data = `+p.dialect.SetJSON("data", "last_used_at", "$2")+` WHERE id = $1`,
+		tokenID.String(), raw)
+	if err != nil {
+		return goerr.Wrap(err, "failed to touch API token")
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
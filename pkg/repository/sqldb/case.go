@@ -0,0 +1,391 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+)
+
+type caseRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.CaseRepository = &caseRepository{}
+
+func newCaseRepository(db *sql.DB, dialect Dialect) *caseRepository {
+	return &caseRepository{db: db, dialect: dialect}
+}
+
+// put upserts c. The status column holds the normalized status, so legacy
+// empty statuses list as OPEN exactly as they do on the other backends.
+func (r *caseRepository) put(ctx context.Context, db dbtx, workspaceID string, c *model.Case) error {
+	data, err := encode(c)
+	if err != nil {
+		return err
+	}
+	_, err = exec(ctx, db, `
+		INSERT INTO cases (workspace_id, id, status, slack_channel_id, slack_thread_ts, request_key,
+			created_at, updated_at, reporter_id, is_test, data)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (workspace_id, id) DO UPDATE SET
+			status = EXCLUDED.status,
+			slack_channel_id = EXCLUDED.slack_channel_id,
+			slack_thread_ts = EXCLUDED.slack_thread_ts,
+			request_key = EXCLUDED.request_key,
+			created_at = EXCLUDED.created_at,
+			updated_at = EXCLUDED.updated_at,
+			reporter_id = EXCLUDED.reporter_id,
+			is_test = EXCLUDED.is_test,
+			data = EXCLUDED.data`,
+		workspaceID, c.ID, string(c.Status.Normalize()), c.SlackChannelID, c.SlackThreadTS, c.RequestKey,
+		r.dialect.Time(c.CreatedAt), r.dialect.Time(c.UpdatedAt), c.ReporterID, c.IsTest, data)
+	if err != nil {
+		return goerr.Wrap(err, "failed to write case", goerr.V("id", c.ID))
+	}
+	return nil
+}
+
+func (r *caseRepository) Create(ctx context.Context, workspaceID string, c *model.Case) (*model.Case, error) {
+	// Validate at the persistence boundary, as every backend does; the ID is
+	// assigned onto the caller's struct and the model is persisted verbatim.
+	if err := c.ValidateNew(); err != nil {
+		return nil, goerr.Wrap(err, "case validation failed before create")
+	}
+
+	id, err := nextID(ctx, r.db, "case", workspaceID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get next ID")
+	}
+	c.ID = id
+
+	if err := r.put(ctx, r.db, workspaceID, c); err != nil {
+		return nil, goerr.Wrap(err, "failed to create case", goerr.V("id", c.ID))
+	}
+	return c, nil
+}
+
+func (r *caseRepository) Get(ctx context.Context, workspaceID string, id int64) (*model.Case, error) {
+	c, err := getDoc[model.Case](ctx, r.db,
+		`SELECT data FROM cases WHERE workspace_id = $1 AND id = $2`, workspaceID, id)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get case", goerr.V("id", id))
+	}
+	if c == nil {
+		return nil, goerr.Wrap(ErrNotFound, "case not found", goerr.V("id", id))
+	}
+	return c, nil
+}
+
+func (r *caseRepository) GetByIDs(ctx context.Context, workspaceID string, ids []int64) (map[int64]*model.Case, error) {
+	result := make(map[int64]*model.Case, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	list, err := r.dialect.List(ids)
+	if err != nil {
+		return nil, err
+	}
+	cases, err := listDocs[model.Case](ctx, r.db,
+		`SELECT data FROM cases WHERE workspace_id = $1 AND `+r.dialect.In("id", "$2"), workspaceID, list)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to batch get cases", goerr.V("ids", ids))
+	}
+	for _, c := range cases {
+		result[c.ID] = c
+	}
+	return result, nil
+}
+
+func (r *caseRepository) List(ctx context.Context, workspaceID string, opts ...interfaces.ListCaseOption) ([]*model.Case, error) {
+	cfg := interfaces.BuildListCaseConfig(opts...)
+
+	// Default listings never include drafts; those are reached through
+	// ListDrafts or an explicit WithStatus(CaseStatusDraft).
+	query := `SELECT data FROM cases WHERE workspace_id = $1 AND status <> $2 ORDER BY id`
+	arg := string(types.CaseStatusDraft)
+	if statusFilter := cfg.Status(); statusFilter != nil {
+		query = `SELECT data FROM cases WHERE workspace_id = $1 AND status = $2 ORDER BY id`
+		arg = string(*statusFilter)
+	}
+
+	cases, err := listDocs[model.Case](ctx, r.db, query, workspaceID, arg)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list cases")
+	}
+
+	// The remaining filters run in Go through the shared matcher, so every
+	// backend agrees on what each one means.
+	out := make([]*model.Case, 0, len(cases))
+	for _, c := range cases {
+		if cfg.Matches(c) {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+// caseSortColumns maps each Search key to the column it orders by.
+var caseSortColumns = map[interfaces.CaseSortKey]string{
+	interfaces.CaseSortCreatedAt: "created_at",
+	interfaces.CaseSortUpdatedAt: "updated_at",
+	interfaces.CaseSortID:        "id",
+}
+
+// Search orders, bounds and continues the read in SQL — ORDER BY the sort
+// column and id, a keyset WHERE from the cursor, LIMIT — and pushes down every
+// filter that has a column. Custom fields, text and accessibility stay with
+// the shared matcher, which also re-checks the pushed-down filters so every
+// backend agrees on what each one means.
+func (r *caseRepository) Search(ctx context.Context, workspaceID string, sort interfaces.CaseSort, limit int, cursor string, opts ...interfaces.ListCaseOption) ([]*model.Case, string, error) {
+	cfg := interfaces.BuildListCaseConfig(opts...)
+	col, ok := caseSortColumns[sort.EffectiveKey()]
+	if !ok {
+		return nil, "", goerr.New("invalid case sort key", goerr.V("key", sort.Key))
+	}
+	dir, cmp := "DESC", "<"
+	if sort.Ascending {
+		dir, cmp = "ASC", ">"
+	}
+
+	var where []string
+	var scopeArgs []any
+	add := func(cond string, v any) {
+		scopeArgs = append(scopeArgs, v)
+		where = append(where, fmt.Sprintf(cond, len(scopeArgs)))
+	}
+	add("workspace_id = $%d", workspaceID)
+	if statusFilter := cfg.Status(); statusFilter != nil {
+		add("status = $%d", string(*statusFilter))
+	} else {
+		add("status <> $%d", string(types.CaseStatusDraft))
+	}
+	if v := cfg.ReporterID(); v != "" {
+		add("reporter_id = $%d", v)
+	}
+	if v := cfg.AssigneeID(); v != "" {
+		add(r.dialect.HasElement("AssigneeIDs", "$%d"), v)
+	}
+	if v := cfg.IsTest(); v != nil {
+		add("is_test = $%d", *v)
+	}
+	bound := func(column string, from, to time.Time) {
+		if !from.IsZero() {
+			add(column+" >= $%d", r.dialect.Time(from))
+		}
+		if !to.IsZero() {
+			add(column+" < $%d", r.dialect.Time(to))
+		}
+	}
+	createdFrom, createdTo := cfg.CreatedBetween()
+	bound("created_at", createdFrom, createdTo)
+	updatedFrom, updatedTo := cfg.UpdatedBetween()
+	bound("updated_at", updatedFrom, updatedTo)
+	scope := strings.Join(where, " AND ")
+
+	fetch := func(after *interfaces.CasePosition, n int) ([]interfaces.SortedCase, error) {
+		cond, args := scope, append([]any{}, scopeArgs...)
+		if after != nil {
+			if col == "id" {
+				cond += fmt.Sprintf(" AND id %s $%d", cmp, len(args)+1)
+				args = append(args, after.ID)
+			} else {
+				cond += fmt.Sprintf(" AND (%s, id) %s ($%d, $%d)", col, cmp, len(args)+1, len(args)+2)
+				args = append(args, r.dialect.Time(time.Unix(0, after.Value)), after.ID)
+			}
+		}
+		args = append(args, n)
+		query := fmt.Sprintf(`SELECT data, %s FROM cases WHERE %s ORDER BY %s %s, id %s LIMIT $%d`,
+			col, cond, col, dir, dir, len(args))
+
+		rows, err := r.db.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to search cases")
+		}
+		defer func() { _ = rows.Close() }()
+
+		var out []interfaces.SortedCase
+		for rows.Next() {
+			var raw []byte
+			var at any
+			var id int64
+			pos := any(&at)
+			if col == "id" {
+				pos = &id
+			}
+			if err := rows.Scan(&raw, pos); err != nil {
+				return nil, goerr.Wrap(err, "failed to read searched cases")
+			}
+			var c model.Case
+			if err := decode(raw, &c); err != nil {
+				return nil, err
+			}
+			// The position comes from the column, not the decoded model: it is
+			// the value the keyset compares.
+			value := id
+			if col != "id" {
+				t, err := r.dialect.ParseTime(at)
+				if err != nil {
+					return nil, err
+				}
+				value = t.UnixNano()
+			}
+			out = append(out, interfaces.SortedCase{Case: &c, Position: interfaces.CasePosition{Value: value, ID: c.ID}})
+		}
+		if err := rows.Err(); err != nil {
+			return nil, goerr.Wrap(err, "failed to read searched cases")
+		}
+		return out, nil
+	}
+
+	return interfaces.CollectCasePage(sort, limit, cursor, fetch, cfg.Matches)
+}
+
+func (r *caseRepository) ListDrafts(ctx context.Context, workspaceID string) ([]*model.Case, error) {
+	cases, err := listDocs[model.Case](ctx, r.db,
+		`SELECT data FROM cases WHERE workspace_id = $1 AND status = $2 ORDER BY id`,
+		workspaceID, string(types.CaseStatusDraft))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list draft cases")
+	}
+	return cases, nil
+}
+
+func (r *caseRepository) Update(ctx context.Context, workspaceID string, c *model.Case) (*model.Case, error) {
+	if err := c.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "case validation failed before update")
+	}
+
+	data, err := encode(c)
+	if err != nil {
+		return nil, err
+	}
+	n, err := exec(ctx, r.db, `
+		UPDATE cases SET status = $3, slack_channel_id = $4, slack_thread_ts = $5, request_key = $6,
+			created_at = $7, updated_at = $8, reporter_id = $9, is_test = $10, data = $11
+		WHERE workspace_id = $1 AND id = $2`,
+		workspaceID, c.ID, string(c.Status.Normalize()), c.SlackChannelID, c.SlackThreadTS, c.RequestKey,
+		r.dialect.Time(c.CreatedAt), r.dialect.Time(c.UpdatedAt), c.ReporterID, c.IsTest, data)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to update case", goerr.V("id", c.ID))
+	}
+	if n == 0 {
+		return nil, goerr.Wrap(ErrNotFound, "case not found", goerr.V("id", c.ID))
+	}
+	return c, nil
+}
+
+// Transact locks the case for the whole read-modify-write, so fn observes
+// exactly the state the write is applied to and concurrent Transact calls on
+// the same case run one after another. fn is called once; it must not call
+// back into the repository, since it runs while the lock is held.
+func (r *caseRepository) Transact(ctx context.Context, workspaceID string, id int64, fn func(*model.Case) error) (*model.Case, error) {
+	var updated *model.Case
+	err := inTx(ctx, r.db, func(tx *sql.Tx) error {
+		c, err := getDoc[model.Case](ctx, tx,
+			`SELECT data FROM cases WHERE workspace_id = $1 AND id = $2`+r.dialect.LockRows(), workspaceID, id)
+		if err != nil {
+			return goerr.Wrap(err, "failed to get case in transaction", goerr.V("id", id))
+		}
+		if c == nil {
+			return goerr.Wrap(ErrNotFound, "case not found", goerr.V("id", id))
+		}
+		if err := fn(c); err != nil {
+			return err
+		}
+		if err := c.Validate(); err != nil {
+			return goerr.Wrap(err, "case validation failed before transactional write", goerr.V("id", id))
+		}
+		if err := r.put(ctx, tx, workspaceID, c); err != nil {
+			return err
+		}
+		updated = c
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func (r *caseRepository) Delete(ctx context.Context, workspaceID string, id int64) error {
+	n, err := exec(ctx, r.db, `DELETE FROM cases WHERE workspace_id = $1 AND id = $2`, workspaceID, id)
+	if err != nil {
+		return goerr.Wrap(err, "failed to delete case", goerr.V("id", id))
+	}
+	if n == 0 {
+		return goerr.Wrap(ErrNotFound, "case not found", goerr.V("id", id))
+	}
+	return nil
+}
+
+func (r *caseRepository) GetBySlackChannelID(ctx context.Context, workspaceID string, channelID string) (*model.Case, error) {
+	c, err := getDoc[model.Case](ctx, r.db,
+		`SELECT data FROM cases WHERE workspace_id = $1 AND slack_channel_id = $2 ORDER BY id LIMIT 1`,
+		workspaceID, channelID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get case by slack channel", goerr.V("channel_id", channelID))
+	}
+	return c, nil
+}
+
+func (r *caseRepository) GetBySlackThread(ctx context.Context, workspaceID string, channelID string, threadTS string) (*model.Case, error) {
+	if channelID == "" || threadTS == "" {
+		return nil, nil
+	}
+
+	c, err := getDoc[model.Case](ctx, r.db,
+		`SELECT data FROM cases WHERE workspace_id = $1 AND slack_channel_id = $2 AND slack_thread_ts = $3 ORDER BY id LIMIT 1`,
+		workspaceID, channelID, threadTS)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get case by slack thread",
+			goerr.V("channel_id", channelID), goerr.V("thread_ts", threadTS))
+	}
+	return c, nil
+}
+
+func (r *caseRepository) GetByRequestKey(ctx context.Context, workspaceID string, key string) (*model.Case, error) {
+	c, err := getDoc[model.Case](ctx, r.db,
+		`SELECT data FROM cases WHERE workspace_id = $1 AND request_key = $2 ORDER BY id LIMIT 1`,
+		workspaceID, key)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get case by request key", goerr.V("request_key", key))
+	}
+	return c, nil
+}
+
+// ScanAll hands every stored case to fn in ascending ID order, one row at a
+// time, so a large workspace is never held in memory at once.
+func (r *caseRepository) ScanAll(ctx context.Context, workspaceID string, fn func(*model.Case) error) error {
+	rows, err := r.db.QueryContext(ctx, `SELECT data FROM cases WHERE workspace_id = $1 ORDER BY id`, workspaceID)
+	if err != nil {
+		return goerr.Wrap(err, "failed to scan cases")
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var raw []byte
+		if err := rows.Scan(&raw); err != nil {
+			return goerr.Wrap(err, "failed to read case row")
+		}
+		var c model.Case
+		if err := decode(raw, &c); err != nil {
+			return err
+		}
+		if err := fn(&c); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return goerr.Wrap(err, "failed to scan cases")
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/slack"
+)
+
+type caseMessageRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.CaseMessageRepository = &caseMessageRepository{}
+
+func newCaseMessageRepository(db *sql.DB, dialect Dialect) *caseMessageRepository {
+	return &caseMessageRepository{db: db, dialect: dialect}
+}
+
+func (r *caseMessageRepository) Put(ctx context.Context, workspaceID string, caseID int64, msg *slack.Message) error {
+	data, err := encode(toSlackMessage(msg))
+	if err != nil {
+		return err
+	}
+	if _, err := exec(ctx, r.db, `
+		INSERT INTO case_messages (workspace_id, case_id, id, created_at, data) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (workspace_id, case_id, id) DO UPDATE SET created_at = EXCLUDED.created_at, data = EXCLUDED.data`,
+		workspaceID, caseID, msg.ID(), r.dialect.Time(msg.CreatedAt()), data); err != nil {
+		return goerr.Wrap(err, "failed to put case message",
+			goerr.V("case_id", caseID), goerr.V("message_id", msg.ID()))
+	}
+	return nil
+}
+
+// List returns the case's messages newest first. An unknown cursor starts
+// from the first page.
+func (r *caseMessageRepository) List(ctx context.Context, workspaceID string, caseID int64, limit int, cursor string) ([]*slack.Message, string, error) {
+	if limit <= 0 {
+		limit = defaultMessagePageSize
+	}
+
+	page, hasMore, err := pageDesc[slackMessage](ctx, r.db, "case_messages",
+		`workspace_id = $1 AND case_id = $2`, []any{workspaceID, caseID}, limit, cursor, false)
+	if err != nil {
+		return nil, "", goerr.Wrap(err, "failed to list case messages", goerr.V("case_id", caseID))
+	}
+	msgs, next := messagePage(page, hasMore)
+	return msgs, next, nil
+}
+
+func (r *caseMessageRepository) Prune(ctx context.Context, workspaceID string, caseID int64, before time.Time) (int, error) {
+	n, err := exec(ctx, r.db,
+		`DELETE FROM case_messages WHERE workspace_id = $1 AND case_id = $2 AND created_at < $3`,
+		workspaceID, caseID, r.dialect.Time(before))
+	if err != nil {
+		return 0, goerr.Wrap(err, "failed to prune case messages", goerr.V("case_id", caseID))
+	}
+	return int(n), nil
+}
+
+func (r *caseMessageRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error {
+	return moveCaseRows(ctx, r.db, r.dialect, "case_messages", workspaceID, fromCaseID, toCaseID, false)
+}
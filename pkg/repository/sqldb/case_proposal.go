@@ -0,0 +1,96 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+type caseProposalRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.CaseProposalRepository = &caseProposalRepository{}
+
+func newCaseProposalRepository(db *sql.DB, dialect Dialect) *caseProposalRepository {
+	return &caseProposalRepository{db: db, dialect: dialect}
+}
+
+func (r *caseProposalRepository) put(ctx context.Context, db dbtx, proposal *model.CaseProposal) error {
+	data, err := encode(proposal)
+	if err != nil {
+		return err
+	}
+	if _, err := exec(ctx, db, `
+		INSERT INTO case_proposals (id, expires_at, data) VALUES ($1, $2, $3)
+		ON CONFLICT (id) DO UPDATE SET expires_at = EXCLUDED.expires_at, data = EXCLUDED.data`,
+		string(proposal.ID), r.dialect.Time(proposal.ExpiresAt), data); err != nil {
+		return goerr.Wrap(err, "failed to save case proposal", goerr.V("proposalID", proposal.ID))
+	}
+	return nil
+}
+
+func (r *caseProposalRepository) Save(ctx context.Context, proposal *model.CaseProposal) error {
+	if proposal == nil {
+		return goerr.New("proposal is nil")
+	}
+	if proposal.ID == "" {
+		return goerr.New("proposal ID is empty")
+	}
+	return r.put(ctx, r.db, proposal)
+}
+
+// Get treats an expired proposal as missing. Expired rows are not deleted
+// here; they are simply never returned again.
+func (r *caseProposalRepository) Get(ctx context.Context, id model.CaseProposalID) (*model.CaseProposal, error) {
+	p, err := getDoc[model.CaseProposal](ctx, r.db, `SELECT data FROM case_proposals WHERE id = $1`, string(id))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get case proposal", goerr.V("proposalID", id))
+	}
+	if p == nil {
+		return nil, goerr.Wrap(ErrNotFound, "case proposal not found", goerr.V("proposalID", id))
+	}
+	if p.IsExpired(time.Now().UTC()) {
+		return nil, goerr.Wrap(ErrNotFound, "case proposal expired", goerr.V("proposalID", id))
+	}
+	return p, nil
+}
+
+func (r *caseProposalRepository) SetMaterialization(
+	ctx context.Context,
+	id model.CaseProposalID,
+	workspaceID string,
+	m *model.WorkspaceMaterialization,
+	inProgress bool,
+) error {
+	return inTx(ctx, r.db, func(tx *sql.Tx) error {
+		p, err := getDoc[model.CaseProposal](ctx, tx,
+			`SELECT data FROM case_proposals WHERE id = $1`+r.dialect.LockRows(), string(id))
+		if err != nil {
+			return goerr.Wrap(err, "failed to get case proposal", goerr.V("proposalID", id))
+		}
+		if p == nil {
+			return goerr.Wrap(ErrNotFound, "case proposal not found", goerr.V("proposalID", id))
+		}
+		p.SelectedWorkspaceID = workspaceID
+		p.Materialization = m
+		p.InferenceInProgress = inProgress
+		return r.put(ctx, tx, p)
+	})
+}
+
+func (r *caseProposalRepository) Delete(ctx context.Context, id model.CaseProposalID) error {
+	n, err := exec(ctx, r.db, `DELETE FROM case_proposals WHERE id = $1`, string(id))
+	if err != nil {
+		return goerr.Wrap(err, "failed to delete case proposal", goerr.V("proposalID", id))
+	}
+	if n == 0 {
+		return goerr.Wrap(ErrNotFound, "case proposal not found", goerr.V("proposalID", id))
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/m-mizutani/goerr/v2"
+)
+
+// dbtx is what both the database and a transaction offer, so a helper can
+// run inside or outside a transaction.
+type dbtx interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// encode renders a domain model as the JSON stored in a `data` column. It is
+// bound as text, which both databases parse as JSON: SQLite would read a blob
+// handed to its JSON functions as its binary JSONB format instead.
+func encode(v any) (string, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return "", goerr.Wrap(err, "failed to encode document")
+	}
+	return string(raw), nil
+}
+
+// decode is the inverse of encode.
+func decode(raw []byte, v any) error {
+	if err := json.Unmarshal(raw, v); err != nil {
+		return goerr.Wrap(err, "failed to decode document")
+	}
+	return nil
+}
+
+// exec runs a statement and returns the number of rows it changed.
+func exec(ctx context.Context, db dbtx, query string, args ...any) (int64, error) {
+	res, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, goerr.Wrap(err, "failed to read affected rows")
+	}
+	return n, nil
+}
+
+// getDoc runs a query selecting a single `data` column and decodes it. A
+// missing row is (nil, nil) so each repository can map it to its own
+// not-found error.
+func getDoc[T any](ctx context.Context, db dbtx, query string, args ...any) (*T, error) {
+	var raw []byte
+	if err := db.QueryRowContext(ctx, query, args...).Scan(&raw); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, goerr.Wrap(err, "failed to query document")
+	}
+	var v T
+	if err := decode(raw, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// listDocs runs a query selecting a single `data` column and decodes every
+// row, in the order the query returns them. It never returns a nil slice.
+func listDocs[T any](ctx context.Context, db dbtx, query string, args ...any) ([]*T, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to query documents")
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := []*T{}
+	for rows.Next() {
+		var raw []byte
+		if err := rows.Scan(&raw); err != nil {
+			return nil, goerr.Wrap(err, "failed to read documents")
+		}
+		var v T
+		if err := decode(raw, &v); err != nil {
+			return nil, err
+		}
+		out = append(out, &v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, goerr.Wrap(err, "failed to read documents")
+	}
+	return out, nil
+}
+
+// moveCaseRows re-parents the rows of a Case-scoped table from one Case to
+// another, rewriting the CaseID the stored document carries as well. Tables
+// whose documents have no CaseID pass rewriteData false.
+func moveCaseRows(ctx context.Context, db dbtx, dialect Dialect, table, workspaceID string, fromCaseID, toCaseID int64, rewriteData bool) error {
+	set := `case_id = $3`
+	args := []any{workspaceID, fromCaseID, toCaseID}
+	if rewriteData {
+		caseID, err := encode(toCaseID)
+		if err != nil {
+			return err
+		}
+		set += `, data = ` + dialect.SetJSON("data", "CaseID", "$4")
+		args = append(args, caseID)
+	}
+	if _, err := exec(ctx, db, `UPDATE `+table+` SET `+set+` WHERE workspace_id = $1 AND case_id = $2`, args...); err != nil {
+		return goerr.Wrap(err, "failed to move case rows",
+			goerr.V("table", table),
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("from_case_id", fromCaseID),
+			goerr.V("to_case_id", toCaseID))
+	}
+	return nil
+}
+
+// inTx runs fn in a transaction, committing when it returns nil. What keeps
+// a concurrent writer out of a read-modify-write in fn is the dialect's:
+// Postgres takes row and advisory locks (Dialect.LockRows, Dialect.Lock),
+// while SQLite opens every transaction holding the database's write lock.
+func inTx(ctx context.Context, db *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return goerr.Wrap(err, "failed to begin transaction")
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return goerr.Wrap(err, "failed to commit transaction")
+	}
+	return nil
+}
+
+// nextID allocates the next auto-increment ID of kind in a workspace. The
+// upsert is atomic, so concurrent callers never receive the same ID.
+func nextID(ctx context.Context, db dbtx, kind, workspaceID string) (int64, error) {
+	var id int64
+	err := db.QueryRowContext(ctx, `
+		INSERT INTO id_counters (kind, workspace_id, value) VALUES ($1, $2, 1)
+		ON CONFLICT (kind, workspace_id) DO UPDATE SET value = id_counters.value + 1
+		RETURNING value`, kind, workspaceID).Scan(&id)
+	if err != nil {
+		return 0, goerr.Wrap(err, "failed to allocate ID",
+			goerr.V("kind", kind), goerr.V("workspace_id", workspaceID))
+	}
+	return id, nil
+}
+
+// pageDesc returns one page of the rows of table matching scope (a WHERE
+// fragment over scopeArgs, which use $1..$n), newest first by created_at with
+// id breaking ties. cursor is the id of the last row of the previous page.
+// An unknown cursor starts from the first page, or yields an empty page when
+// emptyOnUnknownCursor is set — the two behaviours the list APIs already
+// document. hasMore reports whether rows remain after the page.
+func pageDesc[T any](ctx context.Context, db dbtx, table, scope string, scopeArgs []any, limit int, cursor string, emptyOnUnknownCursor bool) (page []*T, hasMore bool, err error) {
+	args := append([]any{}, scopeArgs...)
+	where := scope
+
+	if cursor != "" {
+		// The cursor row's created_at is bound back as scanned, in whatever
+		// form the dialect stores it.
+		var createdAt any
+		err := db.QueryRowContext(ctx,
+			fmt.Sprintf(`SELECT created_at FROM %s WHERE %s AND id = $%d`, table, scope, len(args)+1),
+			append(args, cursor)...).Scan(&createdAt)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			if emptyOnUnknownCursor {
+				return []*T{}, false, nil
+			}
+		case err != nil:
+			return nil, false, goerr.Wrap(err, "failed to resolve page cursor",
+				goerr.V("table", table), goerr.V("cursor", cursor))
+		default:
+			where += fmt.Sprintf(` AND (created_at, id) < ($%d, $%d)`, len(args)+1, len(args)+2)
+			args = append(args, createdAt, cursor)
+		}
+	}
+
+	args = append(args, limit+1)
+	page, err = listDocs[T](ctx, db,
+		fmt.Sprintf(`SELECT data FROM %s WHERE %s ORDER BY created_at DESC, id DESC LIMIT $%d`, table, where, len(args)),
+		args...)
+	if err != nil {
+		return nil, false, goerr.Wrap(err, "failed to list page", goerr.V("table", table))
+	}
+	if len(page) > limit {
+		return page[:limit], true, nil
+	}
+	return page, false, nil
+}
@@ -0,0 +1,46 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Dialect is what differs between the databases the repository runs on. Both
+// drivers take $n placeholders and most statements verbatim, so a hook exists
+// only where the two SQL dialects part ways: how a timestamp key column is
+// encoded, how a list is bound and tested for membership, how a field of the
+// JSON `data` document is rewritten, and how a transaction keeps a concurrent
+// writer out.
+type Dialect interface {
+	// Time renders t for a timestamp key column. Every time a query binds
+	// goes through it.
+	Time(t time.Time) any
+	// ParseTime is the inverse of Time, for a timestamp key column scanned
+	// into an any.
+	ParseTime(v any) (time.Time, error)
+
+	// List renders values, a slice, for a parameter In or ContainsAll tests
+	// against, or for a list key column.
+	List(values any) (any, error)
+	// In renders the condition that expr is an element of the List bound at
+	// param.
+	In(expr, param string) string
+	// ContainsAll renders the condition that the list key column holds every
+	// element of the List bound at param.
+	ContainsAll(column, param string) string
+	// HasElement renders the condition that the array at key of the `data`
+	// document holds the value bound at param.
+	HasElement(key, param string) string
+
+	// SetJSON renders doc with its top-level key replaced by the JSON text
+	// bound at param.
+	SetJSON(doc, key, param string) string
+
+	// LockRows is appended to a read whose rows the transaction rewrites.
+	LockRows() string
+	// Lock serializes the transactions taking the same key until tx ends. A
+	// row lock cannot guard a row that does not exist yet, which is exactly
+	// the case for a first lease, slot or session claim; Lock can.
+	Lock(ctx context.Context, tx *sql.Tx, key string) error
+}
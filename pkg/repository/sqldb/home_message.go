@@ -0,0 +1,56 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+// homeMessageRepository stores generated home messages per user.
+type homeMessageRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.HomeMessageRepository = &homeMessageRepository{}
+
+func newHomeMessageRepository(db *sql.DB, dialect Dialect) *homeMessageRepository {
+	return &homeMessageRepository{db: db, dialect: dialect}
+}
+
+func (r *homeMessageRepository) Add(ctx context.Context, msg *model.HomeMessage) error {
+	if err := msg.Validate(); err != nil {
+		return goerr.Wrap(err, "home message validation failed before add")
+	}
+
+	data, err := encode(msg)
+	if err != nil {
+		return err
+	}
+	if _, err := exec(ctx, r.db, `
+		INSERT INTO home_messages (user_id, id, created_at, data) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, id) DO UPDATE SET created_at = EXCLUDED.created_at, data = EXCLUDED.data`,
+		msg.UserID, string(msg.ID), r.dialect.Time(msg.CreatedAt), data); err != nil {
+		return goerr.Wrap(err, "failed to add home message", goerr.V("user_id", msg.UserID))
+	}
+	return nil
+}
+
+// ListRecent returns newest first, tie-broken by ID (UUID v7 is
+// lexicographically time-ordered). A negative limit returns everything.
+func (r *homeMessageRepository) ListRecent(ctx context.Context, userID string, limit int) ([]*model.HomeMessage, error) {
+	query := `SELECT data FROM home_messages WHERE user_id = $1 ORDER BY created_at DESC, id DESC`
+	args := []any{userID}
+	if limit >= 0 {
+		query += ` LIMIT $2`
+		args = append(args, limit)
+	}
+	msgs, err := listDocs[model.HomeMessage](ctx, r.db, query, args...)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list home messages", goerr.V("user_id", userID))
+	}
+	return msgs, nil
+}
@@ -0,0 +1,97 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+type importRepository struct {
+	db *sql.DB
+}
+
+var _ interfaces.ImportRepository = &importRepository{}
+
+func newImportRepository(db *sql.DB) *importRepository {
+	return &importRepository{db: db}
+}
+
+func validateImportSession(workspaceID string, s *model.ImportSession) error {
+	if err := s.Validate(); err != nil {
+		return goerr.Wrap(err, "import session validation failed",
+			goerr.V("workspace_id", workspaceID))
+	}
+	if s.WorkspaceID != workspaceID {
+		return goerr.New("workspaceID mismatch",
+			goerr.V("argument", workspaceID),
+			goerr.V("session", s.WorkspaceID))
+	}
+	return nil
+}
+
+func (r *importRepository) Create(ctx context.Context, workspaceID string, s *model.ImportSession) (*model.ImportSession, error) {
+	if err := validateImportSession(workspaceID, s); err != nil {
+		return nil, err
+	}
+
+	data, err := encode(s)
+	if err != nil {
+		return nil, err
+	}
+	n, err := exec(ctx, r.db, `
+		INSERT INTO import_sessions (workspace_id, id, data) VALUES ($1, $2, $3)
+		ON CONFLICT (workspace_id, id) DO NOTHING`,
+		workspaceID, string(s.ID), data)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to create import session",
+			goerr.V("workspace_id", workspaceID), goerr.V("import_id", s.ID))
+	}
+	if n == 0 {
+		return nil, goerr.New("import session already exists",
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("import_id", s.ID))
+	}
+	return s, nil
+}
+
+func (r *importRepository) Update(ctx context.Context, workspaceID string, s *model.ImportSession) (*model.ImportSession, error) {
+	if err := validateImportSession(workspaceID, s); err != nil {
+		return nil, err
+	}
+
+	data, err := encode(s)
+	if err != nil {
+		return nil, err
+	}
+	n, err := exec(ctx, r.db,
+		`UPDATE import_sessions SET data = $3 WHERE workspace_id = $1 AND id = $2`,
+		workspaceID, string(s.ID), data)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to update import session",
+			goerr.V("workspace_id", workspaceID), goerr.V("import_id", s.ID))
+	}
+	if n == 0 {
+		return nil, goerr.Wrap(ErrNotFound, "import session not found",
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("import_id", s.ID))
+	}
+	return s, nil
+}
+
+func (r *importRepository) Get(ctx context.Context, workspaceID string, id model.ImportSessionID) (*model.ImportSession, error) {
+	s, err := getDoc[model.ImportSession](ctx, r.db,
+		`SELECT data FROM import_sessions WHERE workspace_id = $1 AND id = $2`, workspaceID, string(id))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get import session",
+			goerr.V("workspace_id", workspaceID), goerr.V("import_id", id))
+	}
+	if s == nil {
+		return nil, goerr.Wrap(ErrNotFound, "import session not found",
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("import_id", id))
+	}
+	return s, nil
+}
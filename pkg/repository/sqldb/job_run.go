@@ -0,0 +1,535 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+type jobRunRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.JobRunRepository = &jobRunRepository{}
+
+func newJobRunRepository(db *sql.DB, dialect Dialect) *jobRunRepository {
+	return &jobRunRepository{db: db, dialect: dialect}
+}
+
+func jobRunLockKey(key model.JobRunKey) string {
+	return fmt.Sprintf("job_run/%s/%d/%s", key.WorkspaceID, key.CaseID, key.JobID)
+}
+
+func getJobRun(ctx context.Context, db dbtx, key model.JobRunKey) (*model.JobRun, error) {
+	run, err := getDoc[model.JobRun](ctx, db,
+		`SELECT data FROM job_runs WHERE workspace_id = $1 AND case_id = $2 AND job_id = $3`,
+		key.WorkspaceID, key.CaseID, key.JobID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get job run",
+			goerr.V("workspace_id", key.WorkspaceID),
+			goerr.V("case_id", key.CaseID),
+			goerr.V("job_id", key.JobID))
+	}
+	return run, nil
+}
+
+// put writes run, stamping its UpdatedAt.
+func (r *jobRunRepository) put(ctx context.Context, db dbtx, key model.JobRunKey, run *model.JobRun) error {
+	run.UpdatedAt = time.Now().UTC()
+	data, err := encode(run)
+	if err != nil {
+		return err
+	}
+	if _, err := exec(ctx, db, `
+		INSERT INTO job_runs (workspace_id, case_id, job_id, updated_at, data) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (workspace_id, case_id, job_id) DO UPDATE SET updated_at = EXCLUDED.updated_at, data = EXCLUDED.data`,
+		key.WorkspaceID, key.CaseID, key.JobID, r.dialect.Time(run.UpdatedAt), data); err != nil {
+		return goerr.Wrap(err, "failed to put job run",
+			goerr.V("workspace_id", key.WorkspaceID),
+			goerr.V("case_id", key.CaseID),
+			goerr.V("job_id", key.JobID))
+	}
+	return nil
+}
+
+// update applies fn to the run at key under the key's lock, creating the run
+// first when it does not exist yet.
+func (r *jobRunRepository) update(ctx context.Context, key model.JobRunKey, fn func(*model.JobRun)) error {
+	return inTx(ctx, r.db, func(tx *sql.Tx) error {
+		if err := r.dialect.Lock(ctx, tx, jobRunLockKey(key)); err != nil {
+			return err
+		}
+		run, err := getJobRun(ctx, tx, key)
+		if err != nil {
+			return err
+		}
+		if run == nil {
+			run = &model.JobRun{WorkspaceID: key.WorkspaceID, CaseID: key.CaseID, JobID: key.JobID}
+		}
+		fn(run)
+		return r.put(ctx, tx, key, run)
+	})
+}
+
+func (r *jobRunRepository) Get(ctx context.Context, key model.JobRunKey) (*model.JobRun, error) {
+	if err := key.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "invalid job run key")
+	}
+	run, err := getJobRun(ctx, r.db, key)
+	if err != nil {
+		return nil, err
+	}
+	if run == nil {
+		return nil, goerr.Wrap(interfaces.ErrJobRunNotFound, "job run not found",
+			goerr.V("workspace_id", key.WorkspaceID),
+			goerr.V("case_id", key.CaseID),
+			goerr.V("job_id", key.JobID))
+	}
+	return run, nil
+}
+
+func (r *jobRunRepository) ListByCase(ctx context.Context, workspaceID string, caseID int64) ([]*model.JobRun, error) {
+	if workspaceID == "" {
+		return nil, goerr.New("workspace id is empty")
+	}
+	if caseID == 0 {
+		return nil, goerr.New("case id is zero")
+	}
+	runs, err := listDocs[model.JobRun](ctx, r.db,
+		`SELECT data FROM job_runs WHERE workspace_id = $1 AND case_id = $2 ORDER BY job_id`,
+		workspaceID, caseID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list job runs",
+			goerr.V("workspace_id", workspaceID), goerr.V("case_id", caseID))
+	}
+	return runs, nil
+}
+
+// ListUpdatedAfter narrows the scan by the column and compares exactly on the
+// model timestamp, as ListTombstones does.
+func (r *jobRunRepository) ListUpdatedAfter(ctx context.Context, workspaceID string, since time.Time) ([]*model.JobRun, error) {
+	if workspaceID == "" {
+		return nil, goerr.New("workspace id is empty")
+	}
+	runs, err := listDocs[model.JobRun](ctx, r.db, `
+		SELECT data FROM job_runs WHERE workspace_id = $1 AND updated_at >= $2
+		ORDER BY updated_at, case_id, job_id`,
+		workspaceID, r.dialect.Time(since.Truncate(time.Microsecond)))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list updated job runs",
+			goerr.V("workspace_id", workspaceID), goerr.V("since", since))
+	}
+
+	out := make([]*model.JobRun, 0, len(runs))
+	for _, run := range runs {
+		if run.UpdatedAt.After(since) {
+			out = append(out, run)
+		}
+	}
+	return out, nil
+}
+
+func (r *jobRunRepository) TryAcquireLease(ctx context.Context, key model.JobRunKey, now time.Time, leaseDuration time.Duration) (bool, error) {
+	if err := key.Validate(); err != nil {
+		return false, goerr.Wrap(err, "invalid job run key")
+	}
+	if leaseDuration <= 0 {
+		return false, goerr.New("lease duration must be positive",
+			goerr.V("lease_duration", leaseDuration))
+	}
+
+	acquired := false
+	err := inTx(ctx, r.db, func(tx *sql.Tx) error {
+		if err := r.dialect.Lock(ctx, tx, jobRunLockKey(key)); err != nil {
+			return err
+		}
+		run, err := getJobRun(ctx, tx, key)
+		if err != nil {
+			return err
+		}
+		if run != nil && run.IsLeased(now) {
+			return nil
+		}
+		if run == nil {
+			run = &model.JobRun{WorkspaceID: key.WorkspaceID, CaseID: key.CaseID, JobID: key.JobID}
+		}
+		run.LeaseUntil = now.Add(leaseDuration)
+		if err := r.put(ctx, tx, key, run); err != nil {
+			return err
+		}
+		acquired = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return acquired, nil
+}
+
+func (r *jobRunRepository) ReleaseLease(ctx context.Context, key model.JobRunKey) error {
+	if err := key.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid job run key")
+	}
+	return inTx(ctx, r.db, func(tx *sql.Tx) error {
+		if err := r.dialect.Lock(ctx, tx, jobRunLockKey(key)); err != nil {
+			return err
+		}
+		run, err := getJobRun(ctx, tx, key)
+		if err != nil || run == nil {
+			return err
+		}
+		run.LeaseUntil = time.Time{}
+		return r.put(ctx, tx, key, run)
+	})
+}
+
+func (r *jobRunRepository) RecordRun(ctx context.Context, key model.JobRunKey, status model.JobRunStatus, lastRunAt time.Time, runID, traceID, errMsg string) error {
+	if err := key.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid job run key")
+	}
+	if !status.IsValid() {
+		return goerr.New("invalid job run status",
+			goerr.V("status", string(status)))
+	}
+	return r.update(ctx, key, func(run *model.JobRun) {
+		run.LastRunAt = lastRunAt
+		run.LastStatus = status
+		run.LastError = errMsg
+		run.LastRunID = runID
+		run.LastTraceID = traceID
+		run.LeaseUntil = time.Time{}
+		// A terminal run is no longer awaiting input; clear any suspension.
+		run.SuspendedRunID = ""
+		run.SuspendedAt = time.Time{}
+	})
+}
+
+func (r *jobRunRepository) Suspend(ctx context.Context, key model.JobRunKey, runID string, suspendedAt time.Time) error {
+	if err := key.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid job run key")
+	}
+	if runID == "" {
+		return goerr.New("run id is empty")
+	}
+	return r.update(ctx, key, func(run *model.JobRun) {
+		run.SuspendedRunID = runID
+		run.SuspendedAt = suspendedAt
+		// Release the lease: a human wait can outlast any lease, so the
+		// suspension marker (not the lease) guards against double-starting.
+		run.LeaseUntil = time.Time{}
+	})
+}
+
+// MoveCase copies the records the target does not have yet and drops the
+// rest, in one transaction.
+func (r *jobRunRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error {
+	caseID, err := encode(toCaseID)
+	if err != nil {
+		return err
+	}
+	return inTx(ctx, r.db, func(tx *sql.Tx) error {
+		if _, err := exec(ctx, tx, `
+			INSERT INTO job_runs (workspace_id, case_id, job_id, updated_at, data)
+			SELECT workspace_id, $3, job_id, updated_at, `+r.dialect.SetJSON("data", "CaseID", "$4")+`
+			FROM job_runs WHERE workspace_id = $1 AND case_id = $2
+			ON CONFLICT (workspace_id, case_id, job_id) DO NOTHING`,
+			workspaceID, fromCaseID, toCaseID, caseID); err != nil {
+			return goerr.Wrap(err, "failed to copy job runs",
+				goerr.V("workspace_id", workspaceID),
+				goerr.V("from_case_id", fromCaseID),
+				goerr.V("to_case_id", toCaseID))
+		}
+		if _, err := exec(ctx, tx, `DELETE FROM job_runs WHERE workspace_id = $1 AND case_id = $2`,
+			workspaceID, fromCaseID); err != nil {
+			return goerr.Wrap(err, "failed to delete moved job runs",
+				goerr.V("workspace_id", workspaceID),
+				goerr.V("from_case_id", fromCaseID))
+		}
+		return nil
+	})
+}
+
+type jobRunLogRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.JobRunLogRepository = &jobRunLogRepository{}
+
+func newJobRunLogRepository(db *sql.DB, dialect Dialect) *jobRunLogRepository {
+	return &jobRunLogRepository{db: db, dialect: dialect}
+}
+
+func (r *jobRunLogRepository) Create(ctx context.Context, log *model.JobRunLog) error {
+	if err := log.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid job run log")
+	}
+	data, err := encode(log)
+	if err != nil {
+		return err
+	}
+	n, err := exec(ctx, r.db, `
+		INSERT INTO job_run_logs (workspace_id, case_id, job_id, run_id, started_at, data)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (workspace_id, case_id, job_id, run_id) DO NOTHING`,
+		log.WorkspaceID, log.CaseID, log.JobID, log.RunID, r.dialect.Time(log.StartedAt), data)
+	if err != nil {
+		return goerr.Wrap(err, "failed to create job run log", goerr.V("run_id", log.RunID))
+	}
+	if n == 0 {
+		return goerr.Wrap(interfaces.ErrJobRunLogExists, "job run log already exists",
+			goerr.V("workspace_id", log.WorkspaceID),
+			goerr.V("case_id", log.CaseID),
+			goerr.V("job_id", log.JobID),
+			goerr.V("run_id", log.RunID))
+	}
+	return nil
+}
+
+// replace overwrites an existing log; op names the caller in the not-found
+// error.
+func (r *jobRunLogRepository) replace(ctx context.Context, log *model.JobRunLog, op string) error {
+	data, err := encode(log)
+	if err != nil {
+		return err
+	}
+	n, err := exec(ctx, r.db, `
+		UPDATE job_run_logs SET started_at = $5, data = $6
+		WHERE workspace_id = $1 AND case_id = $2 AND job_id = $3 AND run_id = $4`,
+		log.WorkspaceID, log.CaseID, log.JobID, log.RunID, r.dialect.Time(log.StartedAt), data)
+	if err != nil {
+		return goerr.Wrap(err, "failed to update job run log", goerr.V("run_id", log.RunID))
+	}
+	if n == 0 {
+		return goerr.Wrap(interfaces.ErrJobRunLogNotFound, "job run log not found for "+op,
+			goerr.V("run_id", log.RunID))
+	}
+	return nil
+}
+
+func (r *jobRunLogRepository) Finish(ctx context.Context, log *model.JobRunLog) error {
+	if err := log.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid job run log")
+	}
+	if log.Stage == model.JobRunStageRunning {
+		return goerr.New("Finish must transition out of RUNNING")
+	}
+	return r.replace(ctx, log, "Finish")
+}
+
+func (r *jobRunLogRepository) Suspend(ctx context.Context, log *model.JobRunLog) error {
+	if err := log.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid job run log")
+	}
+	if log.Stage != model.JobRunStageAwaitingInput {
+		return goerr.New("Suspend requires stage AWAITING_INPUT",
+			goerr.V("stage", string(log.Stage)))
+	}
+	return r.replace(ctx, log, "Suspend")
+}
+
+func (r *jobRunLogRepository) Resume(ctx context.Context, log *model.JobRunLog) error {
+	if err := log.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid job run log")
+	}
+	if log.Stage != model.JobRunStageRunning {
+		return goerr.New("Resume requires stage RUNNING",
+			goerr.V("stage", string(log.Stage)))
+	}
+	return r.replace(ctx, log, "Resume")
+}
+
+func (r *jobRunLogRepository) Get(ctx context.Context, key model.JobRunKey, runID string) (*model.JobRunLog, error) {
+	if err := key.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "invalid job run key")
+	}
+	if runID == "" {
+		return nil, goerr.New("run id is empty")
+	}
+	log, err := getDoc[model.JobRunLog](ctx, r.db, `
+		SELECT data FROM job_run_logs
+		WHERE workspace_id = $1 AND case_id = $2 AND job_id = $3 AND run_id = $4`,
+		key.WorkspaceID, key.CaseID, key.JobID, runID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get job run log", goerr.V("run_id", runID))
+	}
+	if log == nil {
+		return nil, goerr.Wrap(interfaces.ErrJobRunLogNotFound, "job run log not found",
+			goerr.V("workspace_id", key.WorkspaceID),
+			goerr.V("case_id", key.CaseID),
+			goerr.V("job_id", key.JobID),
+			goerr.V("run_id", runID))
+	}
+	return log, nil
+}
+
+func (r *jobRunLogRepository) List(ctx context.Context, key model.JobRunKey, limit int) ([]*model.JobRunLog, error) {
+	if err := key.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "invalid job run key")
+	}
+	query := `
+		SELECT data FROM job_run_logs
+		WHERE workspace_id = $1 AND case_id = $2 AND job_id = $3
+		ORDER BY started_at DESC, run_id`
+	args := []any{key.WorkspaceID, key.CaseID, key.JobID}
+	if limit > 0 {
+		query += ` LIMIT $4`
+		args = append(args, limit)
+	}
+	logs, err := listDocs[model.JobRunLog](ctx, r.db, query, args...)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list job run logs")
+	}
+	return logs, nil
+}
+
+func (r *jobRunLogRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error {
+	return moveCaseRows(ctx, r.db, r.dialect, "job_run_logs", workspaceID, fromCaseID, toCaseID, true)
+}
+
+type jobRunEventRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.JobRunEventRepository = &jobRunEventRepository{}
+
+func newJobRunEventRepository(db *sql.DB, dialect Dialect) *jobRunEventRepository {
+	return &jobRunEventRepository{db: db, dialect: dialect}
+}
+
+// insert writes ev and raises the run's sequence high-water mark to at least
+// ev.Sequence, so a caller-assigned Sequence is never re-issued by AppendNext.
+func (r *jobRunEventRepository) insert(ctx context.Context, tx *sql.Tx, ev *model.JobRunEvent) error {
+	data, err := encode(ev)
+	if err != nil {
+		return err
+	}
+	n, err := exec(ctx, tx, `
+		INSERT INTO job_run_events (workspace_id, case_id, job_id, run_id, event_id, sequence, kind, data)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (workspace_id, case_id, job_id, run_id, event_id) DO NOTHING`,
+		ev.WorkspaceID, ev.CaseID, ev.JobID, ev.RunID, ev.EventID, ev.Sequence, string(ev.Kind), data)
+	if err != nil {
+		return goerr.Wrap(err, "failed to append job run event", goerr.V("event_id", ev.EventID))
+	}
+	if n == 0 {
+		return goerr.Wrap(interfaces.ErrJobRunEventExists, "job run event already exists",
+			goerr.V("run_id", ev.RunID),
+			goerr.V("event_id", ev.EventID))
+	}
+	if _, err := exec(ctx, tx, `
+		INSERT INTO job_run_event_sequences (workspace_id, case_id, job_id, run_id, value)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (workspace_id, case_id, job_id, run_id)
+		DO UPDATE SET value = CASE WHEN EXCLUDED.value > job_run_event_sequences.value
+			THEN EXCLUDED.value ELSE job_run_event_sequences.value END`,
+		ev.WorkspaceID, ev.CaseID, ev.JobID, ev.RunID, ev.Sequence); err != nil {
+		return goerr.Wrap(err, "failed to update job run event sequence", goerr.V("run_id", ev.RunID))
+	}
+	return nil
+}
+
+func (r *jobRunEventRepository) Append(ctx context.Context, ev *model.JobRunEvent) error {
+	if err := ev.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid job run event")
+	}
+	return inTx(ctx, r.db, func(tx *sql.Tx) error {
+		return r.insert(ctx, tx, ev)
+	})
+}
+
+// AppendNext allocates the Sequence in the same transaction that writes the
+// event, serialized per run, so no other appender can observe or reuse the
+// number in between.
+func (r *jobRunEventRepository) AppendNext(ctx context.Context, ev *model.JobRunEvent) error {
+	if ev == nil {
+		return goerr.New("job run event is nil")
+	}
+	return inTx(ctx, r.db, func(tx *sql.Tx) error {
+		lock := fmt.Sprintf("job_run_event/%s/%d/%s/%s", ev.WorkspaceID, ev.CaseID, ev.JobID, ev.RunID)
+		if err := r.dialect.Lock(ctx, tx, lock); err != nil {
+			return err
+		}
+		var current int64
+		if err := tx.QueryRowContext(ctx, `
+			SELECT COALESCE(MAX(value), 0) FROM job_run_event_sequences
+			WHERE workspace_id = $1 AND case_id = $2 AND job_id = $3 AND run_id = $4`,
+			ev.WorkspaceID, ev.CaseID, ev.JobID, ev.RunID).Scan(&current); err != nil {
+			return goerr.Wrap(err, "failed to read job run event sequence", goerr.V("run_id", ev.RunID))
+		}
+		ev.Sequence = current + 1
+
+		if err := ev.Validate(); err != nil {
+			return goerr.Wrap(err, "invalid job run event")
+		}
+		return r.insert(ctx, tx, ev)
+	})
+}
+
+// LatestLLMResponseSequence returns the highest Sequence among the run's
+// LLM_RESPONSE events, or 0 when it has none.
+func (r *jobRunEventRepository) LatestLLMResponseSequence(ctx context.Context, key model.JobRunKey, runID string) (int64, error) {
+	if err := key.Validate(); err != nil {
+		return 0, goerr.Wrap(err, "invalid job run key")
+	}
+	if runID == "" {
+		return 0, goerr.New("run id is empty")
+	}
+	var latest int64
+	if err := r.db.QueryRowContext(ctx, `
+		SELECT COALESCE(MAX(sequence), 0) FROM job_run_events
+		WHERE workspace_id = $1 AND case_id = $2 AND job_id = $3 AND run_id = $4 AND kind = $5`,
+		key.WorkspaceID, key.CaseID, key.JobID, runID, string(model.JobRunEventKindLLMResponse)).Scan(&latest); err != nil {
+		return 0, goerr.Wrap(err, "failed to get latest LLM response sequence", goerr.V("run_id", runID))
+	}
+	return latest, nil
+}
+
+func (r *jobRunEventRepository) List(ctx context.Context, key model.JobRunKey, runID string) ([]*model.JobRunEvent, error) {
+	return r.list(ctx, key, runID, nil)
+}
+
+func (r *jobRunEventRepository) ListAfter(ctx context.Context, key model.JobRunKey, runID string, afterSequence int64) ([]*model.JobRunEvent, error) {
+	return r.list(ctx, key, runID, &afterSequence)
+}
+
+// list returns the run's events in Sequence order, only those past
+// afterSequence when it is set.
+func (r *jobRunEventRepository) list(ctx context.Context, key model.JobRunKey, runID string, afterSequence *int64) ([]*model.JobRunEvent, error) {
+	if err := key.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "invalid job run key")
+	}
+	if runID == "" {
+		return nil, goerr.New("run id is empty")
+	}
+	query := `SELECT data FROM job_run_events
+		WHERE workspace_id = $1 AND case_id = $2 AND job_id = $3 AND run_id = $4`
+	args := []any{key.WorkspaceID, key.CaseID, key.JobID, runID}
+	if afterSequence != nil {
+		query += ` AND sequence > $5`
+		args = append(args, *afterSequence)
+	}
+	events, err := listDocs[model.JobRunEvent](ctx, r.db, query+` ORDER BY sequence, event_id`, args...)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list job run events", goerr.V("run_id", runID))
+	}
+	return events, nil
+}
+
+// MoveCase moves the events and the runs' Sequence allocators together, so an
+// AppendNext after the move continues the run's Sequence.
+func (r *jobRunEventRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error {
+	return inTx(ctx, r.db, func(tx *sql.Tx) error {
+		if err := moveCaseRows(ctx, tx, r.dialect, "job_run_events", workspaceID, fromCaseID, toCaseID, true); err != nil {
+			return err
+		}
+		return moveCaseRows(ctx, tx, r.dialect, "job_run_event_sequences", workspaceID, fromCaseID, toCaseID, false)
+	})
+}
@@ -0,0 +1,128 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+// jobSlotRepository is the execution-slot store. Each slot transition runs in
+// a transaction holding the slot's lock (see Dialect.Lock), so two instances
+// racing for one index cannot both win.
+type jobSlotRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.JobSlotRepository = &jobSlotRepository{}
+
+func newJobSlotRepository(db *sql.DB, dialect Dialect) *jobSlotRepository {
+	return &jobSlotRepository{db: db, dialect: dialect}
+}
+
+func jobSlotLockKey(index int) string {
+	return "job_slot/" + strconv.Itoa(index)
+}
+
+func getJobSlot(ctx context.Context, db dbtx, index int) (*model.JobSlot, error) {
+	slot, err := getDoc[model.JobSlot](ctx, db, `SELECT data FROM job_slots WHERE idx = $1`, index)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get job slot", goerr.V("index", index))
+	}
+	return slot, nil
+}
+
+func putJobSlot(ctx context.Context, db dbtx, slot *model.JobSlot) error {
+	data, err := encode(slot)
+	if err != nil {
+		return err
+	}
+	if _, err := exec(ctx, db, `
+		INSERT INTO job_slots (idx, data) VALUES ($1, $2)
+		ON CONFLICT (idx) DO UPDATE SET data = EXCLUDED.data`,
+		slot.Index, data); err != nil {
+		return goerr.Wrap(err, "failed to put job slot", goerr.V("index", slot.Index))
+	}
+	return nil
+}
+
+func (r *jobSlotRepository) List(ctx context.Context) ([]*model.JobSlot, error) {
+	slots, err := listDocs[model.JobSlot](ctx, r.db, `SELECT data FROM job_slots ORDER BY idx`)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list job slots")
+	}
+	return slots, nil
+}
+
+func (r *jobSlotRepository) TryAcquire(ctx context.Context, slot *model.JobSlot, now time.Time) (bool, error) {
+	if err := slot.Validate(); err != nil {
+		return false, goerr.Wrap(err, "job slot validation failed before acquire")
+	}
+
+	acquired := false
+	err := inTx(ctx, r.db, func(tx *sql.Tx) error {
+		if err := r.dialect.Lock(ctx, tx, jobSlotLockKey(slot.Index)); err != nil {
+			return err
+		}
+		existing, err := getJobSlot(ctx, tx, slot.Index)
+		if err != nil {
+			return err
+		}
+		if existing != nil && existing.IsHeld(now) {
+			return nil
+		}
+		if err := putJobSlot(ctx, tx, slot); err != nil {
+			return err
+		}
+		acquired = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return acquired, nil
+}
+
+func (r *jobSlotRepository) Renew(ctx context.Context, index int, holderID string, expiresAt time.Time) error {
+	if holderID == "" {
+		return goerr.New("holder id is empty")
+	}
+	return inTx(ctx, r.db, func(tx *sql.Tx) error {
+		if err := r.dialect.Lock(ctx, tx, jobSlotLockKey(index)); err != nil {
+			return err
+		}
+		existing, err := getJobSlot(ctx, tx, index)
+		if err != nil {
+			return err
+		}
+		if existing == nil || existing.HolderID != holderID {
+			return goerr.Wrap(interfaces.ErrJobSlotNotHeld, "cannot renew job slot",
+				goerr.V("index", index), goerr.V("holder_id", holderID))
+		}
+		existing.Index = index
+		existing.ExpiresAt = expiresAt
+		if err := existing.Validate(); err != nil {
+			return goerr.Wrap(err, "job slot validation failed before renew",
+				goerr.V("index", index), goerr.V("expires_at", expiresAt))
+		}
+		return putJobSlot(ctx, tx, existing)
+	})
+}
+
+func (r *jobSlotRepository) Release(ctx context.Context, index int, holderID string) error {
+	if holderID == "" {
+		return goerr.New("holder id is empty")
+	}
+	// Already free, or taken over after an expiry — never evict the current
+	// holder.
+	if _, err := exec(ctx, r.db,
+		`DELETE FROM job_slots WHERE idx = $1 AND data->>'HolderID' = $2`, index, holderID); err != nil {
+		return goerr.Wrap(err, "failed to release job slot", goerr.V("index", index))
+	}
+	return nil
+}
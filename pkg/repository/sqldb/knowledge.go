@@ -0,0 +1,180 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+type knowledgeRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.KnowledgeRepository = &knowledgeRepository{}
+
+func newKnowledgeRepository(db *sql.DB, dialect Dialect) *knowledgeRepository {
+	return &knowledgeRepository{db: db, dialect: dialect}
+}
+
+// tagIDList renders ids for the tag_ids list column, or for a parameter
+// tested against it.
+func (r *knowledgeRepository) tagIDList(ids []model.TagID) (any, error) {
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		out[i] = string(id)
+	}
+	return r.dialect.List(out)
+}
+
+func (r *knowledgeRepository) Create(ctx context.Context, workspaceID string, knowledge *model.Knowledge) (*model.Knowledge, error) {
+	if err := knowledge.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "knowledge validation failed before create")
+	}
+
+	data, err := encode(knowledge)
+	if err != nil {
+		return nil, err
+	}
+	tagIDs, err := r.tagIDList(knowledge.TagIDs)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := exec(ctx, r.db, `
+		INSERT INTO knowledges (workspace_id, id, tag_ids, created_at, data) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (workspace_id, id) DO UPDATE SET
+			tag_ids = EXCLUDED.tag_ids, created_at = EXCLUDED.created_at, data = EXCLUDED.data`,
+		workspaceID, string(knowledge.ID), tagIDs, r.dialect.Time(knowledge.CreatedAt), data); err != nil {
+		return nil, goerr.Wrap(err, "failed to create knowledge",
+			goerr.V("knowledge_id", knowledge.ID), goerr.V("workspace_id", workspaceID))
+	}
+	return knowledge, nil
+}
+
+func (r *knowledgeRepository) Get(ctx context.Context, workspaceID string, id model.KnowledgeID) (*model.Knowledge, error) {
+	k, err := getDoc[model.Knowledge](ctx, r.db,
+		`SELECT data FROM knowledges WHERE workspace_id = $1 AND id = $2`, workspaceID, string(id))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get knowledge",
+			goerr.V("knowledge_id", id), goerr.V("workspace_id", workspaceID))
+	}
+	if k == nil {
+		return nil, goerr.Wrap(ErrNotFound, "knowledge not found",
+			goerr.V("knowledge_id", id), goerr.V("workspace_id", workspaceID))
+	}
+	return k, nil
+}
+
+// List returns the workspace's knowledge oldest first (ID breaks ties),
+// keeping only entries that carry every tag in opts.TagIDs.
+func (r *knowledgeRepository) List(ctx context.Context, workspaceID string, opts interfaces.KnowledgeListOptions) ([]*model.Knowledge, error) {
+	want, err := r.tagIDList(opts.TagIDs)
+	if err != nil {
+		return nil, err
+	}
+	items, err := listDocs[model.Knowledge](ctx, r.db, `
+		SELECT data FROM knowledges WHERE workspace_id = $1 AND `+r.dialect.ContainsAll("tag_ids", "$2")+`
+		ORDER BY created_at, id`,
+		workspaceID, want)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list knowledge", goerr.V("workspace_id", workspaceID))
+	}
+	return items, nil
+}
+
+func (r *knowledgeRepository) Update(ctx context.Context, workspaceID string, knowledge *model.Knowledge) (*model.Knowledge, error) {
+	if err := knowledge.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "knowledge validation failed before update")
+	}
+
+	data, err := encode(knowledge)
+	if err != nil {
+		return nil, err
+	}
+	tagIDs, err := r.tagIDList(knowledge.TagIDs)
+	if err != nil {
+		return nil, err
+	}
+	n, err := exec(ctx, r.db, `
+		UPDATE knowledges SET tag_ids = $3, created_at = $4, data = $5 WHERE workspace_id = $1 AND id = $2`,
+		workspaceID, string(knowledge.ID), tagIDs, r.dialect.Time(knowledge.CreatedAt), data)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to update knowledge",
+			goerr.V("knowledge_id", knowledge.ID), goerr.V("workspace_id", workspaceID))
+	}
+	if n == 0 {
+		return nil, goerr.Wrap(ErrNotFound, "knowledge not found",
+			goerr.V("knowledge_id", knowledge.ID), goerr.V("workspace_id", workspaceID))
+	}
+	return knowledge, nil
+}
+
+// Delete removes the knowledge entry. Its revision history is kept, as on the
+// other backends.
+func (r *knowledgeRepository) Delete(ctx context.Context, workspaceID string, id model.KnowledgeID) error {
+	n, err := exec(ctx, r.db, `DELETE FROM knowledges WHERE workspace_id = $1 AND id = $2`, workspaceID, string(id))
+	if err != nil {
+		return goerr.Wrap(err, "failed to delete knowledge",
+			goerr.V("knowledge_id", id), goerr.V("workspace_id", workspaceID))
+	}
+	if n == 0 {
+		return goerr.Wrap(ErrNotFound, "knowledge not found",
+			goerr.V("knowledge_id", id), goerr.V("workspace_id", workspaceID))
+	}
+	return nil
+}
+
+func (r *knowledgeRepository) CreateRevision(ctx context.Context, workspaceID string, revision *model.KnowledgeRevision) error {
+	if err := revision.Validate(); err != nil {
+		return goerr.Wrap(err, "knowledge revision validation failed before create")
+	}
+
+	data, err := encode(revision)
+	if err != nil {
+		return err
+	}
+	n, err := exec(ctx, r.db, `
+		INSERT INTO knowledge_revisions (workspace_id, knowledge_id, revision, data) VALUES ($1, $2, $3, $4)
+		ON CONFLICT DO NOTHING`,
+		workspaceID, string(revision.KnowledgeID), revision.Revision, data)
+	if err != nil {
+		return goerr.Wrap(err, "failed to create knowledge revision",
+			goerr.V("knowledge_id", revision.KnowledgeID), goerr.V("revision", revision.Revision))
+	}
+	if n == 0 {
+		return goerr.Wrap(interfaces.ErrKnowledgeRevisionExists, "knowledge revision already exists",
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("knowledge_id", revision.KnowledgeID),
+			goerr.V("revision", revision.Revision))
+	}
+	return nil
+}
+
+func (r *knowledgeRepository) GetRevision(ctx context.Context, workspaceID string, id model.KnowledgeID, revision int) (*model.KnowledgeRevision, error) {
+	rev, err := getDoc[model.KnowledgeRevision](ctx, r.db,
+		`SELECT data FROM knowledge_revisions WHERE workspace_id = $1 AND knowledge_id = $2 AND revision = $3`,
+		workspaceID, string(id), revision)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get knowledge revision",
+			goerr.V("knowledge_id", id), goerr.V("workspace_id", workspaceID), goerr.V("revision", revision))
+	}
+	if rev == nil {
+		return nil, goerr.Wrap(ErrNotFound, "knowledge revision not found",
+			goerr.V("knowledge_id", id), goerr.V("workspace_id", workspaceID), goerr.V("revision", revision))
+	}
+	return rev, nil
+}
+
+func (r *knowledgeRepository) ListRevisions(ctx context.Context, workspaceID string, id model.KnowledgeID) ([]*model.KnowledgeRevision, error) {
+	revs, err := listDocs[model.KnowledgeRevision](ctx, r.db,
+		`SELECT data FROM knowledge_revisions WHERE workspace_id = $1 AND knowledge_id = $2 ORDER BY revision`,
+		workspaceID, string(id))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list knowledge revisions",
+			goerr.V("knowledge_id", id), goerr.V("workspace_id", workspaceID))
+	}
+	return revs, nil
+}
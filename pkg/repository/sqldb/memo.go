@@ -0,0 +1,203 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+type memoRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.MemoRepository = &memoRepository{}
+
+func newMemoRepository(db *sql.DB, dialect Dialect) *memoRepository {
+	return &memoRepository{db: db, dialect: dialect}
+}
+
+// Create upserts: the caller assigns the memo ID, and re-creating an existing
+// memo overwrites it, matching the other backends.
+func (r *memoRepository) Create(ctx context.Context, workspaceID string, memo *model.Memo) (*model.Memo, error) {
+	if err := memo.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "memo validation failed before create")
+	}
+
+	data, err := encode(memo)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := exec(ctx, r.db, `
+		INSERT INTO memos (workspace_id, case_id, id, created_at, updated_at, data) VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (workspace_id, case_id, id) DO UPDATE SET
+			created_at = EXCLUDED.created_at, updated_at = EXCLUDED.updated_at, data = EXCLUDED.data`,
+		workspaceID, memo.CaseID, string(memo.ID), r.dialect.Time(memo.CreatedAt), r.dialect.Time(memo.UpdatedAt), data); err != nil {
+		return nil, goerr.Wrap(err, "failed to create memo",
+			goerr.V("memo_id", memo.ID),
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("case_id", memo.CaseID),
+		)
+	}
+	return memo, nil
+}
+
+func (r *memoRepository) Get(ctx context.Context, workspaceID string, caseID int64, id model.MemoID) (*model.Memo, error) {
+	m, err := getDoc[model.Memo](ctx, r.db,
+		`SELECT data FROM memos WHERE workspace_id = $1 AND case_id = $2 AND id = $3`,
+		workspaceID, caseID, string(id))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get memo",
+			goerr.V("memo_id", id),
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("case_id", caseID),
+		)
+	}
+	if m == nil {
+		return nil, goerr.Wrap(ErrNotFound, "memo not found",
+			goerr.V("memo_id", id),
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("case_id", caseID),
+		)
+	}
+	return m, nil
+}
+
+func (r *memoRepository) GetByIDs(ctx context.Context, workspaceID string, caseID int64, ids []model.MemoID) (map[model.MemoID]*model.Memo, error) {
+	result := make(map[model.MemoID]*model.Memo, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = string(id)
+	}
+	list, err := r.dialect.List(keys)
+	if err != nil {
+		return nil, err
+	}
+	memos, err := listDocs[model.Memo](ctx, r.db,
+		`SELECT data FROM memos WHERE workspace_id = $1 AND case_id = $2 AND `+r.dialect.In("id", "$3"),
+		workspaceID, caseID, list)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to batch get memos",
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("case_id", caseID),
+		)
+	}
+	for _, m := range memos {
+		result[m.ID] = m
+	}
+	return result, nil
+}
+
+// List returns the case's memos oldest first (ID breaks ties). The filters in
+// opts are applied to the decoded models so their boundaries are exactly
+// those of MemoListOptions.Allows.
+func (r *memoRepository) List(ctx context.Context, workspaceID string, caseID int64, opts interfaces.MemoListOptions) ([]*model.Memo, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "invalid memo list options",
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("case_id", caseID),
+		)
+	}
+
+	memos, err := listDocs[model.Memo](ctx, r.db,
+		`SELECT data FROM memos WHERE workspace_id = $1 AND case_id = $2 ORDER BY created_at, id`,
+		workspaceID, caseID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list memos",
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("case_id", caseID),
+		)
+	}
+
+	out := make([]*model.Memo, 0, len(memos))
+	for _, m := range memos {
+		if opts.Allows(m) {
+			out = append(out, m)
+		}
+	}
+	return out, nil
+}
+
+// ListUpdatedAfter narrows the scan by the column and compares exactly on the
+// model timestamp, as ListTombstones does.
+func (r *memoRepository) ListUpdatedAfter(ctx context.Context, workspaceID string, since time.Time) ([]*model.Memo, error) {
+	memos, err := listDocs[model.Memo](ctx, r.db,
+		`SELECT data FROM memos WHERE workspace_id = $1 AND updated_at >= $2 ORDER BY updated_at, id`,
+		workspaceID, r.dialect.Time(since.Truncate(time.Microsecond)))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list updated memos",
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("since", since),
+		)
+	}
+
+	out := make([]*model.Memo, 0, len(memos))
+	for _, m := range memos {
+		if m.UpdatedAt.After(since) {
+			out = append(out, m)
+		}
+	}
+	return out, nil
+}
+
+func (r *memoRepository) Update(ctx context.Context, workspaceID string, memo *model.Memo) (*model.Memo, error) {
+	if err := memo.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "memo validation failed before update")
+	}
+
+	data, err := encode(memo)
+	if err != nil {
+		return nil, err
+	}
+	n, err := exec(ctx, r.db, `
+		UPDATE memos SET created_at = $4, updated_at = $5, data = $6
+		WHERE workspace_id = $1 AND case_id = $2 AND id = $3`,
+		workspaceID, memo.CaseID, string(memo.ID), r.dialect.Time(memo.CreatedAt), r.dialect.Time(memo.UpdatedAt), data)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to update memo",
+			goerr.V("memo_id", memo.ID),
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("case_id", memo.CaseID),
+		)
+	}
+	if n == 0 {
+		return nil, goerr.Wrap(ErrNotFound, "memo not found",
+			goerr.V("memo_id", memo.ID),
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("case_id", memo.CaseID),
+		)
+	}
+	return memo, nil
+}
+
+// MoveCase stamps UpdatedAt through encode, so the moved documents decode
+// exactly like freshly written ones.
+func (r *memoRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64, movedAt time.Time) error {
+	caseID, err := encode(toCaseID)
+	if err != nil {
+		return err
+	}
+	updatedAt, err := encode(movedAt)
+	if err != nil {
+		return err
+	}
+	data := r.dialect.SetJSON(r.dialect.SetJSON("data", "CaseID", "$4"), "UpdatedAt", "$5")
+	if _, err := exec(ctx, r.db, `
+		UPDATE memos SET case_id = $3, updated_at = $6, data = `+data+`
+		WHERE workspace_id = $1 AND case_id = $2`,
+		workspaceID, fromCaseID, toCaseID, caseID, updatedAt, r.dialect.Time(movedAt)); err != nil {
+		return goerr.Wrap(err, "failed to move memos",
+			goerr.V("workspace_id", workspaceID),
+			goerr.V("from_case_id", fromCaseID),
+			goerr.V("to_case_id", toCaseID))
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+type notificationSlotRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.NotificationSlotRepository = &notificationSlotRepository{}
+
+func newNotificationSlotRepository(db *sql.DB, dialect Dialect) *notificationSlotRepository {
+	return &notificationSlotRepository{db: db, dialect: dialect}
+}
+
+// GetActive returns the channel's slot while it is open (ExpiresAt after
+// now), nil otherwise.
+func (r *notificationSlotRepository) GetActive(ctx context.Context, channelID string, now time.Time) (*model.NotificationSlot, error) {
+	if channelID == "" {
+		return nil, goerr.New("channelID is required")
+	}
+
+	slot, err := getDoc[model.NotificationSlot](ctx, r.db,
+		`SELECT data FROM notification_slots WHERE channel_id = $1`, channelID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get notification slot", goerr.V("channel_id", channelID))
+	}
+	if slot == nil || !slot.ExpiresAt.After(now) {
+		return nil, nil
+	}
+	return slot, nil
+}
+
+func (r *notificationSlotRepository) Save(ctx context.Context, slot *model.NotificationSlot) error {
+	if err := slot.Validate(); err != nil {
+		return goerr.Wrap(err, "notification slot validation failed before save")
+	}
+
+	data, err := encode(slot)
+	if err != nil {
+		return err
+	}
+	if _, err := exec(ctx, r.db, `
+		INSERT INTO notification_slots (channel_id, expires_at, data) VALUES ($1, $2, $3)
+		ON CONFLICT (channel_id) DO UPDATE SET expires_at = EXCLUDED.expires_at, data = EXCLUDED.data`,
+		slot.ChannelID, r.dialect.Time(slot.ExpiresAt), data); err != nil {
+		return goerr.Wrap(err, "failed to save notification slot", goerr.V("channel_id", slot.ChannelID))
+	}
+	return nil
+}
+
+func (r *notificationSlotRepository) Delete(ctx context.Context, channelID string) error {
+	if channelID == "" {
+		return goerr.New("channelID is required")
+	}
+	if _, err := exec(ctx, r.db, `DELETE FROM notification_slots WHERE channel_id = $1`, channelID); err != nil {
+		return goerr.Wrap(err, "failed to delete notification slot", goerr.V("channel_id", channelID))
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+)
+
+type reactionClaimRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.ReactionClaimRepository = &reactionClaimRepository{}
+
+func newReactionClaimRepository(db *sql.DB, dialect Dialect) *reactionClaimRepository {
+	return &reactionClaimRepository{db: db, dialect: dialect}
+}
+
+// Claim relies on the primary key: exactly one concurrent insert of a triple
+// succeeds, and only that caller sees true.
+func (r *reactionClaimRepository) Claim(ctx context.Context, workspaceID, sourceChannelID, sourceMessageTS string) (bool, error) {
+	if workspaceID == "" || sourceChannelID == "" || sourceMessageTS == "" {
+		return false, goerr.New("workspaceID, sourceChannelID and sourceMessageTS are required")
+	}
+	n, err := exec(ctx, r.db, `
+		INSERT INTO reaction_claims (workspace_id, source_channel_id, source_message_ts, claimed_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT DO NOTHING`,
+		workspaceID, sourceChannelID, sourceMessageTS, r.dialect.Time(time.Now()))
+	if err != nil {
+		return false, goerr.Wrap(err, "failed to claim reaction",
+			goerr.V("source_channel_id", sourceChannelID), goerr.V("source_message_ts", sourceMessageTS))
+	}
+	return n == 1, nil
+}
+
+func (r *reactionClaimRepository) Release(ctx context.Context, workspaceID, sourceChannelID, sourceMessageTS string) error {
+	if workspaceID == "" || sourceChannelID == "" || sourceMessageTS == "" {
+		return goerr.New("workspaceID, sourceChannelID and sourceMessageTS are required")
+	}
+	if _, err := exec(ctx, r.db, `
+		DELETE FROM reaction_claims
+		WHERE workspace_id = $1 AND source_channel_id = $2 AND source_message_ts = $3`,
+		workspaceID, sourceChannelID, sourceMessageTS); err != nil {
+		return goerr.Wrap(err, "failed to release reaction claim",
+			goerr.V("source_channel_id", sourceChannelID), goerr.V("source_message_ts", sourceMessageTS))
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+type sessionRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.SessionRepository = &sessionRepository{}
+
+func newSessionRepository(db *sql.DB, dialect Dialect) *sessionRepository {
+	return &sessionRepository{db: db, dialect: dialect}
+}
+
+func putSession(ctx context.Context, db dbtx, s *model.Session) error {
+	data, err := encode(s)
+	if err != nil {
+		return err
+	}
+	if _, err := exec(ctx, db, `
+		INSERT INTO sessions (channel_id, thread_ts, data) VALUES ($1, $2, $3)
+		ON CONFLICT (channel_id, thread_ts) DO UPDATE SET data = EXCLUDED.data`,
+		s.ChannelID, s.ThreadTS, data); err != nil {
+		return goerr.Wrap(err, "failed to put session",
+			goerr.V("channel_id", s.ChannelID), goerr.V("thread_ts", s.ThreadTS))
+	}
+	return nil
+}
+
+// update applies fn to the stored session under a row lock. A missing session
+// is a no-op, as is fn returning false.
+func (r *sessionRepository) update(ctx context.Context, channelID, threadTS string, fn func(*model.Session) bool) error {
+	return inTx(ctx, r.db, func(tx *sql.Tx) error {
+		cur, err := getDoc[model.Session](ctx, tx,
+			`SELECT data FROM sessions WHERE channel_id = $1 AND thread_ts = $2`+r.dialect.LockRows(), channelID, threadTS)
+		if err != nil {
+			return goerr.Wrap(err, "failed to get session",
+				goerr.V("channel_id", channelID), goerr.V("thread_ts", threadTS))
+		}
+		if cur == nil || !fn(cur) {
+			return nil
+		}
+		cur.UpdatedAt = time.Now().UTC()
+		return putSession(ctx, tx, cur)
+	})
+}
+
+func (r *sessionRepository) GetByThread(ctx context.Context, channelID, threadTS string) (*model.Session, error) {
+	if channelID == "" || threadTS == "" {
+		return nil, goerr.New("channelID and threadTS are required",
+			goerr.V("channel_id", channelID),
+			goerr.V("thread_ts", threadTS),
+		)
+	}
+
+	s, err := getDoc[model.Session](ctx, r.db,
+		`SELECT data FROM sessions WHERE channel_id = $1 AND thread_ts = $2`, channelID, threadTS)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get session",
+			goerr.V("channel_id", channelID), goerr.V("thread_ts", threadTS))
+	}
+	return s, nil
+}
+
+func (r *sessionRepository) Put(ctx context.Context, s *model.Session) error {
+	if err := s.Validate(); err != nil {
+		return goerr.Wrap(err, "session validation failed before put")
+	}
+	return putSession(ctx, r.db, s)
+}
+
+func (r *sessionRepository) Claim(ctx context.Context, channelID, threadTS string, newSessionFn func() *model.Session) (*model.Session, error) {
+	if channelID == "" || threadTS == "" {
+		return nil, goerr.New("channelID and threadTS are required",
+			goerr.V("channel_id", channelID),
+			goerr.V("thread_ts", threadTS),
+		)
+	}
+	if newSessionFn == nil {
+		return nil, goerr.New("newSessionFn is required")
+	}
+
+	var claimed *model.Session
+	err := inTx(ctx, r.db, func(tx *sql.Tx) error {
+		if err := r.dialect.Lock(ctx, tx, "session/"+channelID+"/"+threadTS); err != nil {
+			return err
+		}
+		existing, err := getDoc[model.Session](ctx, tx,
+			`SELECT data FROM sessions WHERE channel_id = $1 AND thread_ts = $2`, channelID, threadTS)
+		if err != nil {
+			return goerr.Wrap(err, "failed to get session",
+				goerr.V("channel_id", channelID), goerr.V("thread_ts", threadTS))
+		}
+		if existing != nil {
+			claimed = existing
+			return nil
+		}
+
+		fresh := newSessionFn()
+		if fresh == nil {
+			return goerr.New("newSessionFn returned nil")
+		}
+		fresh.ChannelID = channelID
+		fresh.ThreadTS = threadTS
+		now := time.Now().UTC()
+		if fresh.CreatedAt.IsZero() {
+			fresh.CreatedAt = now
+		}
+		fresh.UpdatedAt = now
+		if err := fresh.Validate(); err != nil {
+			return goerr.Wrap(err, "session validation failed before claim")
+		}
+		if err := putSession(ctx, tx, fresh); err != nil {
+			return err
+		}
+		claimed = fresh
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
+
+func (r *sessionRepository) AdvanceLastMention(ctx context.Context, channelID, threadTS, mentionTS string) error {
+	if channelID == "" || threadTS == "" || mentionTS == "" {
+		return goerr.New("channelID, threadTS and mentionTS are required",
+			goerr.V("channel_id", channelID),
+			goerr.V("thread_ts", threadTS),
+			goerr.V("mention_ts", mentionTS),
+		)
+	}
+	return r.update(ctx, channelID, threadTS, func(s *model.Session) bool {
+		// Slack timestamps are fixed-width "<seconds>.<microseconds>", so
+		// string ordering is chronological ordering.
+		if s.LastMentionTS >= mentionTS {
+			return false
+		}
+		s.LastMentionTS = mentionTS
+		return true
+	})
+}
+
+func (r *sessionRepository) AssociateProposal(ctx context.Context, channelID, threadTS string, proposalID model.CaseProposalID) error {
+	if channelID == "" || threadTS == "" || proposalID == "" {
+		return goerr.New("channelID, threadTS and proposalID are required",
+			goerr.V("channel_id", channelID),
+			goerr.V("thread_ts", threadTS),
+			goerr.V("proposal_id", string(proposalID)),
+		)
+	}
+	return r.update(ctx, channelID, threadTS, func(s *model.Session) bool {
+		s.ProposalID = proposalID
+		return true
+	})
+}
+
+func (r *sessionRepository) StampLastAction(ctx context.Context, channelID, threadTS string, ended model.SessionEndReason) error {
+	if channelID == "" || threadTS == "" || ended == "" {
+		return goerr.New("channelID, threadTS and ended are required",
+			goerr.V("channel_id", channelID),
+			goerr.V("thread_ts", threadTS),
+			goerr.V("ended", string(ended)),
+		)
+	}
+	return r.update(ctx, channelID, threadTS, func(s *model.Session) bool {
+		s.LastAction = ended
+		return true
+	})
+}
+
+func (r *sessionRepository) BindCase(ctx context.Context, channelID, threadTS string, caseID int64) error {
+	if channelID == "" || threadTS == "" || caseID == 0 {
+		return goerr.New("channelID, threadTS and caseID are required",
+			goerr.V("channel_id", channelID),
+			goerr.V("thread_ts", threadTS),
+			goerr.V("case_id", caseID),
+		)
+	}
+	return r.update(ctx, channelID, threadTS, func(s *model.Session) bool {
+		s.CaseID = caseID
+		s.PendingQuestion = nil
+		return true
+	})
+}
+
+func (r *sessionRepository) SetPendingQuestion(ctx context.Context, channelID, threadTS string, q *model.PendingQuestion) error {
+	if channelID == "" || threadTS == "" {
+		return goerr.New("channelID and threadTS are required",
+			goerr.V("channel_id", channelID),
+			goerr.V("thread_ts", threadTS),
+		)
+	}
+	return r.update(ctx, channelID, threadTS, func(s *model.Session) bool {
+		s.PendingQuestion = q
+		return true
+	})
+}
@@ -0,0 +1,95 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+// slaBreachRepository stores recorded SLA breaches. case_id, kind and due_at
+// are kept in columns beside the document for the key and the ordering.
+type slaBreachRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.SLABreachRepository = &slaBreachRepository{}
+
+func newSLABreachRepository(db *sql.DB, dialect Dialect) *slaBreachRepository {
+	return &slaBreachRepository{db: db, dialect: dialect}
+}
+
+// Record relies on the primary key: exactly one concurrent insert of a
+// breach succeeds, and only that caller sees true.
+func (r *slaBreachRepository) Record(ctx context.Context, b *model.SLABreach) (bool, error) {
+	if b == nil || b.WorkspaceID == "" || b.CaseID == 0 || b.Kind == "" {
+		return false, goerr.New("workspaceID, caseID and kind are required")
+	}
+	data, err := encode(b)
+	if err != nil {
+		return false, err
+	}
+	n, err := exec(ctx, r.db, `
+		INSERT INTO sla_breaches (workspace_id, case_id, kind, due_at, data)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT DO NOTHING`,
+		b.WorkspaceID, b.CaseID, string(b.Kind), r.dialect.Time(b.DueAt), data)
+	if err != nil {
+		return false, goerr.Wrap(err, "failed to record sla breach",
+			goerr.V("case_id", b.CaseID), goerr.V("kind", b.Kind))
+	}
+	return n == 1, nil
+}
+
+func (r *slaBreachRepository) ListByCase(ctx context.Context, workspaceID string, caseID int64) ([]*model.SLABreach, error) {
+	out, err := listDocs[model.SLABreach](ctx, r.db, `
+		SELECT data FROM sla_breaches WHERE workspace_id = $1 AND case_id = $2
+		ORDER BY due_at, case_id, kind`, workspaceID, caseID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list sla breaches",
+			goerr.V("workspace_id", workspaceID), goerr.V("case_id", caseID))
+	}
+	return out, nil
+}
+
+func (r *slaBreachRepository) List(ctx context.Context, workspaceID string) ([]*model.SLABreach, error) {
+	out, err := listDocs[model.SLABreach](ctx, r.db, `
+		SELECT data FROM sla_breaches WHERE workspace_id = $1
+		ORDER BY due_at, case_id, kind`, workspaceID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list sla breaches", goerr.V("workspace_id", workspaceID))
+	}
+	return out, nil
+}
+
+// MoveCase copies the breaches the target does not have yet and drops the
+// rest, in one transaction.
+func (r *slaBreachRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error {
+	caseID, err := encode(toCaseID)
+	if err != nil {
+		return err
+	}
+	return inTx(ctx, r.db, func(tx *sql.Tx) error {
+		if _, err := exec(ctx, tx, `
+			INSERT INTO sla_breaches (workspace_id, case_id, kind, due_at, data)
+			SELECT workspace_id, $3, kind, due_at, `+r.dialect.SetJSON("data", "CaseID", "$4")+`
+			FROM sla_breaches WHERE workspace_id = $1 AND case_id = $2
+			ON CONFLICT (workspace_id, case_id, kind) DO NOTHING`,
+			workspaceID, fromCaseID, toCaseID, caseID); err != nil {
+			return goerr.Wrap(err, "failed to copy sla breaches",
+				goerr.V("workspace_id", workspaceID),
+				goerr.V("from_case_id", fromCaseID),
+				goerr.V("to_case_id", toCaseID))
+		}
+		if _, err := exec(ctx, tx, `DELETE FROM sla_breaches WHERE workspace_id = $1 AND case_id = $2`,
+			workspaceID, fromCaseID); err != nil {
+			return goerr.Wrap(err, "failed to delete moved sla breaches",
+				goerr.V("workspace_id", workspaceID),
+				goerr.V("from_case_id", fromCaseID))
+		}
+		return nil
+	})
+}
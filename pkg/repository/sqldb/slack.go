@@ -0,0 +1,167 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/slack"
+)
+
+// defaultMessagePageSize is the page size of the message list APIs when the
+// caller passes no positive limit.
+const defaultMessagePageSize = 100
+
+// slackMessage is the stored form of slack.Message, whose fields are
+// unexported and so invisible to encoding/json. The slack, case and action
+// message tables all store it.
+type slackMessage struct {
+	ID        string
+	ChannelID string
+	ThreadTS  string
+	TeamID    string
+	UserID    string
+	UserName  string
+	Text      string
+	EventTS   string
+	Files     []slackFile
+	CreatedAt time.Time
+}
+
+// slackFile is the stored form of slack.File.
+type slackFile struct {
+	ID         string
+	Name       string
+	Mimetype   string
+	Filetype   string
+	Size       int
+	URLPrivate string
+	Permalink  string
+	ThumbURL   string
+}
+
+func toSlackMessage(msg *slack.Message) *slackMessage {
+	files := make([]slackFile, 0, len(msg.Files()))
+	for _, f := range msg.Files() {
+		files = append(files, slackFile{
+			ID:         f.ID(),
+			Name:       f.Name(),
+			Mimetype:   f.Mimetype(),
+			Filetype:   f.Filetype(),
+			Size:       f.Size(),
+			URLPrivate: f.URLPrivate(),
+			Permalink:  f.Permalink(),
+			ThumbURL:   f.ThumbURL(),
+		})
+	}
+	return &slackMessage{
+		ID:        msg.ID(),
+		ChannelID: msg.ChannelID(),
+		ThreadTS:  msg.ThreadTS(),
+		TeamID:    msg.TeamID(),
+		UserID:    msg.UserID(),
+		UserName:  msg.UserName(),
+		Text:      msg.Text(),
+		EventTS:   msg.EventTS(),
+		Files:     files,
+		CreatedAt: msg.CreatedAt(),
+	}
+}
+
+func (m *slackMessage) toDomain() *slack.Message {
+	var files []slack.File
+	if len(m.Files) > 0 {
+		files = make([]slack.File, 0, len(m.Files))
+		for _, f := range m.Files {
+			files = append(files, slack.NewFileFromData(
+				f.ID, f.Name, f.Mimetype, f.Filetype, f.Size, f.URLPrivate, f.Permalink, f.ThumbURL,
+			))
+		}
+	}
+	return slack.NewMessageFromData(
+		m.ID, m.ChannelID, m.ThreadTS, m.TeamID, m.UserID, m.UserName, m.Text, m.EventTS, m.CreatedAt, files,
+	)
+}
+
+// messagePage converts one pageDesc result. The next cursor is the ID of the
+// page's last message, and only set when more messages follow.
+func messagePage(page []*slackMessage, hasMore bool) ([]*slack.Message, string) {
+	out := make([]*slack.Message, 0, len(page))
+	for _, m := range page {
+		out = append(out, m.toDomain())
+	}
+	var nextCursor string
+	if hasMore && len(out) > 0 {
+		nextCursor = out[len(out)-1].ID()
+	}
+	return out, nextCursor
+}
+
+type slackRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.SlackRepository = &slackRepository{}
+
+func newSlackRepository(db *sql.DB, dialect Dialect) *slackRepository {
+	return &slackRepository{db: db, dialect: dialect}
+}
+
+func (r *slackRepository) PutMessage(ctx context.Context, msg *slack.Message) error {
+	if msg == nil {
+		return goerr.New("message is nil")
+	}
+
+	data, err := encode(toSlackMessage(msg))
+	if err != nil {
+		return err
+	}
+	if _, err := exec(ctx, r.db, `
+		INSERT INTO slack_messages (channel_id, id, created_at, data) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (channel_id, id) DO UPDATE SET created_at = EXCLUDED.created_at, data = EXCLUDED.data`,
+		msg.ChannelID(), msg.ID(), r.dialect.Time(msg.CreatedAt()), data); err != nil {
+		return goerr.Wrap(err, "failed to put slack message",
+			goerr.V("channel_id", msg.ChannelID()), goerr.V("message_id", msg.ID()))
+	}
+	return nil
+}
+
+// ListMessages returns the channel's messages with start <= CreatedAt < end,
+// newest first. An unknown cursor starts from the first page.
+func (r *slackRepository) ListMessages(ctx context.Context, channelID string, start, end time.Time, limit int, cursor string) ([]*slack.Message, string, error) {
+	if channelID == "" {
+		return nil, "", goerr.New("channelID is required")
+	}
+	if limit <= 0 {
+		limit = defaultMessagePageSize
+	}
+
+	page, hasMore, err := pageDesc[slackMessage](ctx, r.db, "slack_messages",
+		`channel_id = $1 AND created_at >= $2 AND created_at < $3`, []any{channelID, r.dialect.Time(start), r.dialect.Time(end)},
+		limit, cursor, false)
+	if err != nil {
+		return nil, "", goerr.Wrap(err, "failed to list slack messages", goerr.V("channel_id", channelID))
+	}
+	msgs, next := messagePage(page, hasMore)
+	return msgs, next, nil
+}
+
+// PruneMessages deletes messages created before the cutoff, in one channel or,
+// with an empty channelID, in every channel.
+func (r *slackRepository) PruneMessages(ctx context.Context, channelID string, before time.Time) (int, error) {
+	query := `DELETE FROM slack_messages WHERE channel_id = $1 AND created_at < $2`
+	args := []any{channelID, r.dialect.Time(before)}
+	if channelID == "" {
+		query = `DELETE FROM slack_messages WHERE created_at < $1`
+		args = []any{r.dialect.Time(before)}
+	}
+
+	n, err := exec(ctx, r.db, query, args...)
+	if err != nil {
+		return 0, goerr.Wrap(err, "failed to prune slack messages", goerr.V("channel_id", channelID))
+	}
+	return int(n), nil
+}
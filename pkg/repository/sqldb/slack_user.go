@@ -0,0 +1,136 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+type slackUserRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.SlackUserRepository = &slackUserRepository{}
+
+func newSlackUserRepository(db *sql.DB, dialect Dialect) *slackUserRepository {
+	return &slackUserRepository{db: db, dialect: dialect}
+}
+
+func (r *slackUserRepository) GetAll(ctx context.Context) ([]*model.SlackUser, error) {
+	users, err := listDocs[model.SlackUser](ctx, r.db, `SELECT data FROM slack_users ORDER BY id`)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list slack users")
+	}
+	return users, nil
+}
+
+func (r *slackUserRepository) GetByID(ctx context.Context, id model.SlackUserID) (*model.SlackUser, error) {
+	user, err := getDoc[model.SlackUser](ctx, r.db, `SELECT data FROM slack_users WHERE id = $1`, string(id))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get slack user", goerr.V("id", id))
+	}
+	if user == nil {
+		return nil, goerr.Wrap(ErrNotFound, "slack user not found", goerr.V("id", id))
+	}
+	return user, nil
+}
+
+func (r *slackUserRepository) GetByIDs(ctx context.Context, ids []model.SlackUserID) (map[model.SlackUserID]*model.SlackUser, error) {
+	result := make(map[model.SlackUserID]*model.SlackUser, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = string(id)
+	}
+	list, err := r.dialect.List(keys)
+	if err != nil {
+		return nil, err
+	}
+	users, err := listDocs[model.SlackUser](ctx, r.db, `SELECT data FROM slack_users WHERE `+r.dialect.In("id", "$1"), list)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to batch get slack users")
+	}
+	for _, u := range users {
+		result[u.ID] = u
+	}
+	return result, nil
+}
+
+func (r *slackUserRepository) GetByEmail(ctx context.Context, email string) (*model.SlackUser, error) {
+	if email == "" {
+		return nil, nil
+	}
+	user, err := getDoc[model.SlackUser](ctx, r.db,
+		`SELECT data FROM slack_users WHERE lower(data->>'Email') = lower($1) ORDER BY id LIMIT 1`, email)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get slack user by email")
+	}
+	return user, nil
+}
+
+// SaveMany upserts every user in one transaction: either the whole refresh
+// lands or none of it does.
+func (r *slackUserRepository) SaveMany(ctx context.Context, users []*model.SlackUser) error {
+	for _, user := range users {
+		if err := user.Validate(); err != nil {
+			return goerr.Wrap(err, "slack user validation failed before save")
+		}
+	}
+	if len(users) == 0 {
+		return nil
+	}
+
+	return inTx(ctx, r.db, func(tx *sql.Tx) error {
+		for _, user := range users {
+			data, err := encode(user)
+			if err != nil {
+				return err
+			}
+			if _, err := exec(ctx, tx, `
+				INSERT INTO slack_users (id, data) VALUES ($1, $2)
+				ON CONFLICT (id) DO UPDATE SET data = EXCLUDED.data`, string(user.ID), data); err != nil {
+				return goerr.Wrap(err, "failed to save slack users", goerr.V("count", len(users)))
+			}
+		}
+		return nil
+	})
+}
+
+func (r *slackUserRepository) DeleteAll(ctx context.Context) error {
+	if _, err := exec(ctx, r.db, `DELETE FROM slack_users`); err != nil {
+		return goerr.Wrap(err, "failed to delete slack users")
+	}
+	return nil
+}
+
+// GetMetadata returns zero-valued metadata until the first SaveMetadata.
+func (r *slackUserRepository) GetMetadata(ctx context.Context) (*model.SlackUserMetadata, error) {
+	metadata, err := getDoc[model.SlackUserMetadata](ctx, r.db, `SELECT data FROM slack_user_metadata WHERE id = 1`)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get slack user metadata")
+	}
+	if metadata == nil {
+		return &model.SlackUserMetadata{}, nil
+	}
+	return metadata, nil
+}
+
+func (r *slackUserRepository) SaveMetadata(ctx context.Context, metadata *model.SlackUserMetadata) error {
+	data, err := encode(metadata)
+	if err != nil {
+		return err
+	}
+	if _, err := exec(ctx, r.db, `
+		INSERT INTO slack_user_metadata (id, data) VALUES (1, $1)
+		ON CONFLICT (id) DO UPDATE SET data = EXCLUDED.data`, data); err != nil {
+		return goerr.Wrap(err, "failed to save slack user metadata")
+	}
+	return nil
+}
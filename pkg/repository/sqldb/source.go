@@ -0,0 +1,95 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+type sourceRepository struct {
+	db *sql.DB
+}
+
+var _ interfaces.SourceRepository = &sourceRepository{}
+
+func newSourceRepository(db *sql.DB) *sourceRepository {
+	return &sourceRepository{db: db}
+}
+
+func (r *sourceRepository) Create(ctx context.Context, workspaceID string, source *model.Source) (*model.Source, error) {
+	if err := source.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "source validation failed before create")
+	}
+
+	created := *source
+	if created.ID == "" {
+		created.ID = model.NewSourceID()
+	}
+
+	data, err := encode(&created)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := exec(ctx, r.db, `
+		INSERT INTO sources (workspace_id, id, data) VALUES ($1, $2, $3)
+		ON CONFLICT (workspace_id, id) DO UPDATE SET data = EXCLUDED.data`,
+		workspaceID, string(created.ID), data); err != nil {
+		return nil, goerr.Wrap(err, "failed to create source", goerr.V("id", created.ID))
+	}
+	return &created, nil
+}
+
+func (r *sourceRepository) Get(ctx context.Context, workspaceID string, id model.SourceID) (*model.Source, error) {
+	s, err := getDoc[model.Source](ctx, r.db,
+		`SELECT data FROM sources WHERE workspace_id = $1 AND id = $2`, workspaceID, string(id))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get source", goerr.V("id", id))
+	}
+	if s == nil {
+		return nil, goerr.Wrap(ErrNotFound, "source not found", goerr.V("id", id))
+	}
+	return s, nil
+}
+
+func (r *sourceRepository) List(ctx context.Context, workspaceID string) ([]*model.Source, error) {
+	sources, err := listDocs[model.Source](ctx, r.db,
+		`SELECT data FROM sources WHERE workspace_id = $1 ORDER BY id`, workspaceID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list sources")
+	}
+	return sources, nil
+}
+
+func (r *sourceRepository) Update(ctx context.Context, workspaceID string, source *model.Source) (*model.Source, error) {
+	if err := source.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "source validation failed before update")
+	}
+
+	data, err := encode(source)
+	if err != nil {
+		return nil, err
+	}
+	n, err := exec(ctx, r.db, `UPDATE sources SET data = $3 WHERE workspace_id = $1 AND id = $2`,
+		workspaceID, string(source.ID), data)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to update source", goerr.V("id", source.ID))
+	}
+	if n == 0 {
+		return nil, goerr.Wrap(ErrNotFound, "source not found", goerr.V("id", source.ID))
+	}
+	return source, nil
+}
+
+func (r *sourceRepository) Delete(ctx context.Context, workspaceID string, id model.SourceID) error {
+	n, err := exec(ctx, r.db, `DELETE FROM sources WHERE workspace_id = $1 AND id = $2`, workspaceID, string(id))
+	if err != nil {
+		return goerr.Wrap(err, "failed to delete source", goerr.V("id", id))
+	}
+	if n == 0 {
+		return goerr.Wrap(ErrNotFound, "source not found", goerr.V("id", id))
+	}
+	return nil
+}
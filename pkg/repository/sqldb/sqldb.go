@@ -0,0 +1,232 @@
+// Package sqldb is the interfaces.Repository shared by the PostgreSQL and
+// SQLite backends, written once against database/sql.
+//
+// Each entity lives in its own table as its JSON-encoded domain model, next to
+// the handful of key columns it is looked up, filtered and ordered by (see
+// each backend's migrations/). Storing the model whole keeps the repository
+// from silently dropping a field added to a model later, the same guarantee
+// the Firestore backend gets from persisting structs verbatim.
+//
+// The backend packages own the driver, the schema migrations and the Dialect,
+// the few hooks where the two SQL dialects differ.
+package sqldb
+
+import (
+	"database/sql"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+)
+
+type Repository struct {
+	db              *sql.DB
+	dialect         Dialect
+	caseRepo        *caseRepository
+	action          *actionRepository
+	memo            *memoRepository
+	knowledge       *knowledgeRepository
+	tag             *tagRepository
+	slack           *slackRepository
+	slackUser       *slackUserRepository
+	source          *sourceRepository
+	caseMessage     *caseMessageRepository
+	actionMessage   *actionMessageRepository
+	actionEvent     *actionEventRepository
+	actionStep      *actionStepRepository
+	actionComment   *actionCommentRepository
+	assistLog       *assistLogRepository
+	caseProposal    *caseProposalRepository
+	session         *sessionRepository
+	notifySlot      *notificationSlotRepository
+	jobRun          *jobRunRepository
+	jobRunLog       *jobRunLogRepository
+	jobRunEvent     *jobRunEventRepository
+	jobSlot         *jobSlotRepository
+	importRepo      *importRepository
+	reactionClaim   *reactionClaimRepository
+	userPreference  *userPreferenceRepository
+	homeMessage     *homeMessageRepository
+	assigneeRanking *assigneeRankingRepository
+	exportState     *exportStateRepository
+	webhookDelivery *webhookDeliveryRepository
+	alert           *alertRepository
+	actionReminder  *actionReminderRepository
+	slaBreach       *slaBreachRepository
+}
+
+var _ interfaces.Repository = &Repository{}
+
+// ErrNotFound is the shared interfaces.ErrNotFound, so a not-found check
+// written against any backend matches every backend.
+var ErrNotFound = interfaces.ErrNotFound
+
+// New returns the repository over db, a database whose schema the backend has
+// already checked, speaking dialect. Close closes db.
+func New(db *sql.DB, dialect Dialect) *Repository {
+	return &Repository{
+		db:              db,
+		dialect:         dialect,
+		caseRepo:        newCaseRepository(db, dialect),
+		action:          newActionRepository(db, dialect),
+		memo:            newMemoRepository(db, dialect),
+		knowledge:       newKnowledgeRepository(db, dialect),
+		tag:             newTagRepository(db, dialect),
+		slack:           newSlackRepository(db, dialect),
+		slackUser:       newSlackUserRepository(db, dialect),
+		source:          newSourceRepository(db),
+		caseMessage:     newCaseMessageRepository(db, dialect),
+		actionMessage:   newActionMessageRepository(db, dialect),
+		actionEvent:     newActionEventRepository(db, dialect),
+		actionStep:      newActionStepRepository(db, dialect),
+		actionComment:   newActionCommentRepository(db, dialect),
+		assistLog:       newAssistLogRepository(db, dialect),
+		caseProposal:    newCaseProposalRepository(db, dialect),
+		session:         newSessionRepository(db, dialect),
+		notifySlot:      newNotificationSlotRepository(db, dialect),
+		jobRun:          newJobRunRepository(db, dialect),
+		jobRunLog:       newJobRunLogRepository(db, dialect),
+		jobRunEvent:     newJobRunEventRepository(db, dialect),
+		jobSlot:         newJobSlotRepository(db, dialect),
+		importRepo:      newImportRepository(db),
+		reactionClaim:   newReactionClaimRepository(db, dialect),
+		userPreference:  newUserPreferenceRepository(db),
+		homeMessage:     newHomeMessageRepository(db, dialect),
+		assigneeRanking: newAssigneeRankingRepository(db),
+		exportState:     newExportStateRepository(db, dialect),
+		webhookDelivery: newWebhookDeliveryRepository(db, dialect),
+		alert:           newAlertRepository(db, dialect),
+		actionReminder:  newActionReminderRepository(db, dialect),
+		slaBreach:       newSLABreachRepository(db, dialect),
+	}
+}
+
+func (p *Repository) Case() interfaces.CaseRepository {
+	return p.caseRepo
+}
+
+func (p *Repository) Action() interfaces.ActionRepository {
+	return p.action
+}
+
+func (p *Repository) Memo() interfaces.MemoRepository {
+	return p.memo
+}
+
+func (p *Repository) Knowledge() interfaces.KnowledgeRepository {
+	return p.knowledge
+}
+
+func (p *Repository) Tag() interfaces.TagRepository {
+	return p.tag
+}
+
+func (p *Repository) Slack() interfaces.SlackRepository {
+	return p.slack
+}
+
+func (p *Repository) SlackUser() interfaces.SlackUserRepository {
+	return p.slackUser
+}
+
+func (p *Repository) Source() interfaces.SourceRepository {
+	return p.source
+}
+
+func (p *Repository) CaseMessage() interfaces.CaseMessageRepository {
+	return p.caseMessage
+}
+
+func (p *Repository) ActionMessage() interfaces.ActionMessageRepository {
+	return p.actionMessage
+}
+
+func (p *Repository) ActionEvent() interfaces.ActionEventRepository {
+	return p.actionEvent
+}
+
+func (p *Repository) ActionStep() interfaces.ActionStepRepository {
+	return p.actionStep
+}
+
+func (p *Repository) ActionComment() interfaces.ActionCommentRepository {
+	return p.actionComment
+}
+
+func (p *Repository) AssistLog() interfaces.AssistLogRepository {
+	return p.assistLog
+}
+
+func (p *Repository) CaseProposal() interfaces.CaseProposalRepository {
+	return p.caseProposal
+}
+
+func (p *Repository) Session() interfaces.SessionRepository {
+	return p.session
+}
+
+func (p *Repository) NotificationSlot() interfaces.NotificationSlotRepository {
+	return p.notifySlot
+}
+
+func (p *Repository) JobRun() interfaces.JobRunRepository {
+	return p.jobRun
+}
+
+func (p *Repository) JobRunLog() interfaces.JobRunLogRepository {
+	return p.jobRunLog
+}
+
+func (p *Repository) JobRunEvent() interfaces.JobRunEventRepository {
+	return p.jobRunEvent
+}
+
+func (p *Repository) JobSlot() interfaces.JobSlotRepository {
+	return p.jobSlot
+}
+
+func (p *Repository) Import() interfaces.ImportRepository {
+	return p.importRepo
+}
+
+func (p *Repository) ReactionClaim() interfaces.ReactionClaimRepository {
+	return p.reactionClaim
+}
+
+func (p *Repository) UserPreference() interfaces.UserPreferenceRepository {
+	return p.userPreference
+}
+
+func (p *Repository) HomeMessage() interfaces.HomeMessageRepository {
+	return p.homeMessage
+}
+
+func (p *Repository) AssigneeRanking() interfaces.AssigneeRankingRepository {
+	return p.assigneeRanking
+}
+
+func (p *Repository) ExportState() interfaces.ExportStateRepository {
+	return p.exportState
+}
+
+func (p *Repository) WebhookDelivery() interfaces.WebhookDeliveryRepository {
+	return p.webhookDelivery
+}
+
+func (p *Repository) Alert() interfaces.AlertRepository {
+	return p.alert
+}
+
+func (p *Repository) ActionReminder() interfaces.ActionReminderRepository {
+	return p.actionReminder
+}
+
+func (p *Repository) SLABreach() interfaces.SLABreachRepository {
+	return p.slaBreach
+}
+
+func (p *Repository) Close() error {
+	if err := p.db.Close(); err != nil {
+		return goerr.Wrap(err, "failed to close database")
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+type tagRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.TagRepository = &tagRepository{}
+
+func newTagRepository(db *sql.DB, dialect Dialect) *tagRepository {
+	return &tagRepository{db: db, dialect: dialect}
+}
+
+func (r *tagRepository) Create(ctx context.Context, workspaceID string, tag *model.Tag) (*model.Tag, error) {
+	if err := tag.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "tag validation failed before create")
+	}
+
+	data, err := encode(tag)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := exec(ctx, r.db, `
+		INSERT INTO tags (workspace_id, id, created_at, data) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (workspace_id, id) DO UPDATE SET created_at = EXCLUDED.created_at, data = EXCLUDED.data`,
+		workspaceID, string(tag.ID), r.dialect.Time(tag.CreatedAt), data); err != nil {
+		return nil, goerr.Wrap(err, "failed to create tag",
+			goerr.V("tag_id", tag.ID), goerr.V("workspace_id", workspaceID))
+	}
+	return tag, nil
+}
+
+func (r *tagRepository) Get(ctx context.Context, workspaceID string, id model.TagID) (*model.Tag, error) {
+	t, err := getDoc[model.Tag](ctx, r.db,
+		`SELECT data FROM tags WHERE workspace_id = $1 AND id = $2`, workspaceID, string(id))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get tag",
+			goerr.V("tag_id", id), goerr.V("workspace_id", workspaceID))
+	}
+	if t == nil {
+		return nil, goerr.Wrap(ErrNotFound, "tag not found",
+			goerr.V("tag_id", id), goerr.V("workspace_id", workspaceID))
+	}
+	return t, nil
+}
+
+func (r *tagRepository) List(ctx context.Context, workspaceID string) ([]*model.Tag, error) {
+	tags, err := listDocs[model.Tag](ctx, r.db,
+		`SELECT data FROM tags WHERE workspace_id = $1 ORDER BY created_at, id`, workspaceID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list tags", goerr.V("workspace_id", workspaceID))
+	}
+	return tags, nil
+}
+
+func (r *tagRepository) Update(ctx context.Context, workspaceID string, tag *model.Tag) (*model.Tag, error) {
+	if err := tag.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "tag validation failed before update")
+	}
+
+	data, err := encode(tag)
+	if err != nil {
+		return nil, err
+	}
+	n, err := exec(ctx, r.db, `
+		UPDATE tags SET created_at = $3, data = $4 WHERE workspace_id = $1 AND id = $2`,
+		workspaceID, string(tag.ID), r.dialect.Time(tag.CreatedAt), data)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to update tag",
+			goerr.V("tag_id", tag.ID), goerr.V("workspace_id", workspaceID))
+	}
+	if n == 0 {
+		return nil, goerr.Wrap(ErrNotFound, "tag not found",
+			goerr.V("tag_id", tag.ID), goerr.V("workspace_id", workspaceID))
+	}
+	return tag, nil
+}
+
+func (r *tagRepository) Delete(ctx context.Context, workspaceID string, id model.TagID) error {
+	n, err := exec(ctx, r.db, `DELETE FROM tags WHERE workspace_id = $1 AND id = $2`, workspaceID, string(id))
+	if err != nil {
+		return goerr.Wrap(err, "failed to delete tag",
+			goerr.V("tag_id", id), goerr.V("workspace_id", workspaceID))
+	}
+	if n == 0 {
+		return goerr.Wrap(ErrNotFound, "tag not found",
+			goerr.V("tag_id", id), goerr.V("workspace_id", workspaceID))
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+// userPreferenceRepository stores one preference document per user ID.
+type userPreferenceRepository struct {
+	db *sql.DB
+}
+
+var _ interfaces.UserPreferenceRepository = &userPreferenceRepository{}
+
+func newUserPreferenceRepository(db *sql.DB) *userPreferenceRepository {
+	return &userPreferenceRepository{db: db}
+}
+
+func (r *userPreferenceRepository) Get(ctx context.Context, userID string) (*model.UserPreference, error) {
+	p, err := getDoc[model.UserPreference](ctx, r.db,
+		`SELECT data FROM user_preferences WHERE user_id = $1`, userID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get user preference", goerr.V("user_id", userID))
+	}
+	if p == nil {
+		return nil, goerr.Wrap(ErrNotFound, "user preference not found", goerr.V("user_id", userID))
+	}
+	return p, nil
+}
+
+func (r *userPreferenceRepository) Set(ctx context.Context, pref *model.UserPreference) error {
+	if err := pref.Validate(); err != nil {
+		return goerr.Wrap(err, "user preference validation failed before set")
+	}
+
+	data, err := encode(pref)
+	if err != nil {
+		return err
+	}
+	if _, err := exec(ctx, r.db, `
+		INSERT INTO user_preferences (user_id, data) VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET data = EXCLUDED.data`,
+		pref.UserID, data); err != nil {
+		return goerr.Wrap(err, "failed to set user preference", goerr.V("user_id", pref.UserID))
+	}
+	return nil
+}
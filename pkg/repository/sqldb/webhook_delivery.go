@@ -0,0 +1,162 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+// webhookDeliveryRepository is the outbound webhook queue. status and
+// next_attempt_at are kept in columns beside the document so the due scan is
+// an index range.
+type webhookDeliveryRepository struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+var _ interfaces.WebhookDeliveryRepository = &webhookDeliveryRepository{}
+
+func newWebhookDeliveryRepository(db *sql.DB, dialect Dialect) *webhookDeliveryRepository {
+	return &webhookDeliveryRepository{db: db, dialect: dialect}
+}
+
+func (r *webhookDeliveryRepository) Create(ctx context.Context, d *model.WebhookDelivery) error {
+	if err := d.Validate(); err != nil {
+		return goerr.Wrap(err, "webhook delivery validation failed before create")
+	}
+	data, err := encode(d)
+	if err != nil {
+		return err
+	}
+	if _, err := exec(ctx, r.db, `
+		INSERT INTO webhook_deliveries (workspace_id, id, status, next_attempt_at, created_at, data)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		d.WorkspaceID, d.ID.String(), string(d.Status), r.dialect.Time(d.NextAttemptAt), r.dialect.Time(d.CreatedAt), data); err != nil {
+		return goerr.Wrap(err, "failed to create webhook delivery",
+			goerr.V("workspace_id", d.WorkspaceID), goerr.V("delivery_id", d.ID))
+	}
+	return nil
+}
+
+func (r *webhookDeliveryRepository) Get(ctx context.Context, workspaceID string, id model.WebhookDeliveryID) (*model.WebhookDelivery, error) {
+	d, err := r.get(ctx, r.db, workspaceID, id, false)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil {
+		return nil, goerr.Wrap(ErrNotFound, "webhook delivery not found",
+			goerr.V("workspace_id", workspaceID), goerr.V("delivery_id", id))
+	}
+	return d, nil
+}
+
+func (r *webhookDeliveryRepository) get(ctx context.Context, db dbtx, workspaceID string, id model.WebhookDeliveryID, forUpdate bool) (*model.WebhookDelivery, error) {
+	query := `SELECT data FROM webhook_deliveries WHERE workspace_id = $1 AND id = $2`
+	if forUpdate {
+		query += r.dialect.LockRows()
+	}
+	d, err := getDoc[model.WebhookDelivery](ctx, db, query, workspaceID, id.String())
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get webhook delivery",
+			goerr.V("workspace_id", workspaceID), goerr.V("delivery_id", id))
+	}
+	return d, nil
+}
+
+func (r *webhookDeliveryRepository) put(ctx context.Context, db dbtx, d *model.WebhookDelivery) (bool, error) {
+	data, err := encode(d)
+	if err != nil {
+		return false, err
+	}
+	n, err := exec(ctx, db, `
+		UPDATE webhook_deliveries SET status = $3, next_attempt_at = $4, data = $5
+		WHERE workspace_id = $1 AND id = $2`,
+		d.WorkspaceID, d.ID.String(), string(d.Status), r.dialect.Time(d.NextAttemptAt), data)
+	if err != nil {
+		return false, goerr.Wrap(err, "failed to update webhook delivery",
+			goerr.V("workspace_id", d.WorkspaceID), goerr.V("delivery_id", d.ID))
+	}
+	return n > 0, nil
+}
+
+func (r *webhookDeliveryRepository) Update(ctx context.Context, d *model.WebhookDelivery) error {
+	if err := d.Validate(); err != nil {
+		return goerr.Wrap(err, "webhook delivery validation failed before update")
+	}
+	found, err := r.put(ctx, r.db, d)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return goerr.Wrap(ErrNotFound, "webhook delivery not found",
+			goerr.V("workspace_id", d.WorkspaceID), goerr.V("delivery_id", d.ID))
+	}
+	return nil
+}
+
+func (r *webhookDeliveryRepository) Claim(ctx context.Context, workspaceID string, id model.WebhookDeliveryID, now, leaseUntil time.Time) (*model.WebhookDelivery, error) {
+	var claimed *model.WebhookDelivery
+	err := inTx(ctx, r.db, func(tx *sql.Tx) error {
+		d, err := r.get(ctx, tx, workspaceID, id, true)
+		if err != nil {
+			return err
+		}
+		if d == nil {
+			return goerr.Wrap(ErrNotFound, "webhook delivery not found",
+				goerr.V("workspace_id", workspaceID), goerr.V("delivery_id", id))
+		}
+		if !d.IsDue(now) {
+			return nil
+		}
+		d.StartAttempt(now, leaseUntil)
+		if _, err := r.put(ctx, tx, d); err != nil {
+			return err
+		}
+		claimed = d
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
+
+func (r *webhookDeliveryRepository) ListDue(ctx context.Context, workspaceID string, now time.Time, limit int) ([]*model.WebhookDelivery, error) {
+	query := `SELECT data FROM webhook_deliveries
+		WHERE workspace_id = $1 AND status = $2 AND next_attempt_at <= $3
+		ORDER BY next_attempt_at, id`
+	args := []any{workspaceID, string(model.WebhookDeliveryPending), r.dialect.Time(now)}
+	if limit >= 0 {
+		query += ` LIMIT $4`
+		args = append(args, limit)
+	}
+	due, err := listDocs[model.WebhookDelivery](ctx, r.db, query, args...)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list due webhook deliveries", goerr.V("workspace_id", workspaceID))
+	}
+	return due, nil
+}
+
+func (r *webhookDeliveryRepository) List(ctx context.Context, workspaceID string, status model.WebhookDeliveryStatus, limit int) ([]*model.WebhookDelivery, error) {
+	query := `SELECT data FROM webhook_deliveries WHERE workspace_id = $1`
+	args := []any{workspaceID}
+	if status != "" {
+		query += ` AND status = $2`
+		args = append(args, string(status))
+	}
+	query += ` ORDER BY created_at DESC, id DESC`
+	if limit >= 0 {
+		args = append(args, limit)
+		query += ` LIMIT $` + strconv.Itoa(len(args))
+	}
+	out, err := listDocs[model.WebhookDelivery](ctx, r.db, query, args...)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list webhook deliveries", goerr.V("workspace_id", workspaceID))
+	}
+	return out, nil
+}
@@ -0,0 +1,78 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/sqldb"
+)
+
+// timeLayout renders a key column timestamp: UTC with a fixed number of
+// fractional digits, so the text sorts and compares in time order.
+const timeLayout = "2006-01-02T15:04:05.000000000Z"
+
+// timestamp renders t for a timestamp key column. The driver's own rendering
+// of time.Time does not sort.
+func timestamp(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
+
+// dialect is SQLite's sqldb.Dialect. Lists are bound as JSON arrays and
+// unpacked with json_each, SQLite's stand-in for an array parameter. Every
+// transaction already holds the database's write lock (see Open), so there
+// is nothing more to lock.
+type dialect struct{}
+
+var _ sqldb.Dialect = dialect{}
+
+func (dialect) Time(t time.Time) any {
+	return timestamp(t)
+}
+
+func (dialect) ParseTime(v any) (time.Time, error) {
+	s, ok := v.(string)
+	if !ok {
+		return time.Time{}, goerr.New("timestamp column is not text", goerr.V("value", v))
+	}
+	t, err := time.Parse(timeLayout, s)
+	if err != nil {
+		return time.Time{}, goerr.Wrap(err, "failed to parse timestamp column", goerr.V("value", s))
+	}
+	return t, nil
+}
+
+func (dialect) List(values any) (any, error) {
+	raw, err := json.Marshal(values)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to encode list parameter")
+	}
+	return string(raw), nil
+}
+
+func (dialect) In(expr, param string) string {
+	return expr + " IN (SELECT value FROM json_each(" + param + "))"
+}
+
+func (dialect) ContainsAll(column, param string) string {
+	return "NOT EXISTS (SELECT 1 FROM json_each(" + param + ") AS want" +
+		" WHERE want.value NOT IN (SELECT value FROM json_each(" + column + ")))"
+}
+
+func (dialect) HasElement(key, param string) string {
+	return "EXISTS (SELECT 1 FROM json_each(data, '$." + key + "') WHERE value = " + param + ")"
+}
+
+func (dialect) SetJSON(doc, key, param string) string {
+	return "json_set(" + doc + ", '$." + key + "', json(" + param + "))"
+}
+
+func (dialect) LockRows() string {
+	return ""
+}
+
+func (dialect) Lock(context.Context, *sql.Tx, string) error {
+	return nil
+}
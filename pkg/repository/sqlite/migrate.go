@@ -0,0 +1,185 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+)
+
+// migrationsFS holds the schema migrations, applied in file-name order. A
+// file is named NNNN_description.sql; NNNN is its version. Migrations are
+// append-only: once released, a file is never edited — a schema change is a
+// new file.
+//
+//go:embed migrations/*.sql
+var migrationsFS embed.FS
+
+// Migration is one embedded schema migration.
+type Migration struct {
+	Version int
+	Name    string
+	sql     string
+}
+
+func loadMigrations() ([]Migration, error) {
+	entries, err := fs.ReadDir(migrationsFS, "migrations")
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to read embedded migrations")
+	}
+
+	migrations := make([]Migration, 0, len(entries))
+	for _, e := range entries {
+		name := e.Name()
+		prefix, _, ok := strings.Cut(name, "_")
+		version, err := strconv.Atoi(prefix)
+		if !ok || err != nil || version <= 0 {
+			return nil, goerr.New("migration file name must start with a positive version",
+				goerr.V("file", name))
+		}
+		body, err := fs.ReadFile(migrationsFS, path.Join("migrations", name))
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to read migration", goerr.V("file", name))
+		}
+		migrations = append(migrations, Migration{Version: version, Name: name, sql: string(body)})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	for i := 1; i < len(migrations); i++ {
+		if migrations[i].Version == migrations[i-1].Version {
+			return nil, goerr.New("duplicate migration version",
+				goerr.V("version", migrations[i].Version))
+		}
+	}
+	return migrations, nil
+}
+
+// latestVersion is the schema version this build expects.
+func latestVersion() (int, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return 0, err
+	}
+	if len(migrations) == 0 {
+		return 0, nil
+	}
+	return migrations[len(migrations)-1].Version, nil
+}
+
+// Migrate brings the database file up to the schema this build expects,
+// creating the file when it does not exist, and returns the
+// migrations it applied (or, with dryRun, would apply). Each migration runs in
+// its own transaction together with its schema_migrations row, so a failure
+// leaves the schema at the last migration that succeeded. Concurrent runs
+// serialize on the database's write lock; a run that finds a migration
+// already applied by another skips it.
+func Migrate(ctx context.Context, file string, dryRun bool) ([]Migration, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = db.Close() }()
+
+	if _, err := db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version    INTEGER NOT NULL PRIMARY KEY,
+			name       TEXT    NOT NULL,
+			applied_at TEXT    NOT NULL
+		)`); err != nil {
+		return nil, goerr.Wrap(err, "failed to create schema_migrations table")
+	}
+
+	current, err := currentVersion(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, m := range migrations {
+		if m.Version > current {
+			pending = append(pending, m)
+		}
+	}
+	if dryRun {
+		return pending, nil
+	}
+
+	applied := make([]Migration, 0, len(pending))
+	for _, m := range pending {
+		done, err := applyMigration(ctx, db, m)
+		if err != nil {
+			return nil, goerr.Wrap(err, "migration failed", goerr.V("migration", m.Name))
+		}
+		if done {
+			applied = append(applied, m)
+		}
+	}
+	return applied, nil
+}
+
+// applyMigration runs m in a transaction together with its schema_migrations
+// row. It reports false when another run applied m first.
+func applyMigration(ctx context.Context, db *sql.DB, m Migration) (bool, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, goerr.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// The version is read again under the write lock: another run may have
+	// applied this migration since the list was built.
+	v, err := currentVersion(ctx, tx)
+	if err != nil {
+		return false, err
+	}
+	if v >= m.Version {
+		return false, nil
+	}
+	if _, err := tx.ExecContext(ctx, m.sql); err != nil {
+		return false, goerr.Wrap(err, "failed to apply migration")
+	}
+	if _, err := tx.ExecContext(ctx, `INSERT INTO schema_migrations (version, name, applied_at) VALUES ($1, $2, $3)`,
+		m.Version, m.Name, timestamp(time.Now())); err != nil {
+		return false, goerr.Wrap(err, "failed to record migration")
+	}
+	if err := tx.Commit(); err != nil {
+		return false, goerr.Wrap(err, "failed to commit transaction")
+	}
+	return true, nil
+}
+
+// queryRower is what both the database and a transaction offer to read the
+// schema version.
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// currentVersion is the highest applied migration, 0 on a fresh database.
+func currentVersion(ctx context.Context, db queryRower) (int, error) {
+	var exists int
+	if err := db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'schema_migrations'`).Scan(&exists); err != nil {
+		return 0, goerr.Wrap(err, "failed to read schema version")
+	}
+	if exists == 0 {
+		return 0, nil
+	}
+
+	var version int
+	if err := db.QueryRowContext(ctx, `SELECT COALESCE(MAX(version), 0) FROM schema_migrations`).Scan(&version); err != nil {
+		return 0, goerr.Wrap(err, "failed to read schema version")
+	}
+	return version, nil
+}
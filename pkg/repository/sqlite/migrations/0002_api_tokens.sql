@@ -0,0 +1,9 @@
+-- Personal access tokens, listed by their owner's sub.
+
+CREATE TABLE api_tokens (
+    id         TEXT NOT NULL PRIMARY KEY,
+    sub        TEXT NOT NULL,
+    created_at TEXT NOT NULL,
+    data       TEXT NOT NULL
+);
+CREATE INDEX api_tokens_sub_idx ON api_tokens (sub, created_at DESC);
@@ -0,0 +1,11 @@
+-- Due-date reminders the tick sweep has sent: one row per action, stage and
+-- due date, so each reminder is sent once.
+
+CREATE TABLE action_reminders (
+    workspace_id TEXT    NOT NULL,
+    action_id    INTEGER NOT NULL,
+    kind         TEXT    NOT NULL,
+    due_date     TEXT    NOT NULL,
+    claimed_at   TEXT    NOT NULL,
+    PRIMARY KEY (workspace_id, action_id, kind, due_date)
+);
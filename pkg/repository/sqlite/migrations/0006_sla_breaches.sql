@@ -0,0 +1,11 @@
+-- SLA targets cases missed: at most one row per case and kind, recorded by
+-- the tick sweep.
+
+CREATE TABLE sla_breaches (
+    workspace_id TEXT    NOT NULL,
+    case_id      INTEGER NOT NULL,
+    kind         TEXT    NOT NULL,
+    due_at       TEXT    NOT NULL,
+    data         TEXT    NOT NULL,
+    PRIMARY KEY (workspace_id, case_id, kind)
+);
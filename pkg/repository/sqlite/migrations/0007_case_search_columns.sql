@@ -0,0 +1,32 @@
+-- Columns case search orders, bounds and continues by, so a page reads only
+-- the rows up to its last match instead of the whole workspace.
+--
+-- SQLite adds a NOT NULL column only with a default; the defaults are never
+-- used, since every save writes the columns. The backfill renders the model's
+-- RFC 3339 timestamps in the fixed-width UTC layout of timestamp in db.go:
+-- strftime converts the seconds to UTC and the fraction is padded to nine
+-- digits.
+
+ALTER TABLE cases ADD COLUMN created_at  TEXT    NOT NULL DEFAULT '';
+ALTER TABLE cases ADD COLUMN updated_at  TEXT    NOT NULL DEFAULT '';
+ALTER TABLE cases ADD COLUMN reporter_id TEXT    NOT NULL DEFAULT '';
+ALTER TABLE cases ADD COLUMN is_test     INTEGER NOT NULL DEFAULT 0;
+
+UPDATE cases SET
+    created_at  = strftime('%Y-%m-%dT%H:%M:%S', data->>'CreatedAt') || '.' ||
+        substr(CASE
+            WHEN substr(data->>'CreatedAt', 20, 1) <> '.' THEN ''
+            WHEN data->>'CreatedAt' LIKE '%Z' THEN substr(data->>'CreatedAt', 21, length(data->>'CreatedAt') - 21)
+            ELSE substr(data->>'CreatedAt', 21, length(data->>'CreatedAt') - 26)
+        END || '000000000', 1, 9) || 'Z',
+    updated_at  = strftime('%Y-%m-%dT%H:%M:%S', data->>'UpdatedAt') || '.' ||
+        substr(CASE
+            WHEN substr(data->>'UpdatedAt', 20, 1) <> '.' THEN ''
+            WHEN data->>'UpdatedAt' LIKE '%Z' THEN substr(data->>'UpdatedAt', 21, length(data->>'UpdatedAt') - 21)
+            ELSE substr(data->>'UpdatedAt', 21, length(data->>'UpdatedAt') - 26)
+        END || '000000000', 1, 9) || 'Z',
+    reporter_id = COALESCE(data->>'ReporterID', ''),
+    is_test     = COALESCE(data->>'IsTest', 0);
+
+CREATE INDEX cases_created_idx ON cases (workspace_id, created_at, id);
+CREATE INDEX cases_updated_idx ON cases (workspace_id, updated_at, id);
@@ -0,0 +1,47 @@
+-- agentkit Process store (pkg/repository/agentproc): one row per agent
+-- Process, with its awaits and events, plus the two guard tables that carry
+-- the uniqueness constraints the kernel relies on.
+--
+-- claim_at is the instant the Process becomes claimable, in Unix nanoseconds;
+-- the claim query is a range over it. event_seq is how many events the
+-- Process has appended, the source of each event's seq.
+
+CREATE TABLE agent_processes (
+    id        TEXT    NOT NULL PRIMARY KEY,
+    claim_at  INTEGER NOT NULL,
+    event_seq INTEGER NOT NULL,
+    data      TEXT    NOT NULL
+);
+CREATE INDEX agent_processes_claim_idx ON agent_processes (claim_at, id);
+
+CREATE TABLE agent_process_awaits (
+    process_id TEXT NOT NULL,
+    await_key  TEXT NOT NULL,
+    data       TEXT NOT NULL,
+    PRIMARY KEY (process_id, await_key)
+);
+
+CREATE TABLE agent_process_events (
+    process_id TEXT    NOT NULL,
+    id         TEXT    NOT NULL,
+    seq        INTEGER NOT NULL,
+    data       TEXT    NOT NULL,
+    PRIMARY KEY (process_id, id)
+);
+CREATE INDEX agent_process_events_seq_idx ON agent_process_events (process_id, seq);
+
+-- Idempotency keys are never released: a key keeps deduplicating after its
+-- Process ends.
+CREATE TABLE agent_process_keys (
+    idempotency_key TEXT NOT NULL PRIMARY KEY,
+    process_id      TEXT NOT NULL
+);
+
+-- At most one open Process per subject; the row is deleted by the commit that
+-- makes the Process terminal.
+CREATE TABLE agent_process_subjects (
+    kind       TEXT NOT NULL,
+    id         TEXT NOT NULL,
+    process_id TEXT NOT NULL,
+    PRIMARY KEY (kind, id)
+);
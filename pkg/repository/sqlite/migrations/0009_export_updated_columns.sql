@@ -0,0 +1,38 @@
+-- Columns an incremental export reads memos and job runs changed after its
+-- watermark by, so it need not list every case's memos and run history.
+--
+-- The backfill renders the model's RFC 3339 timestamps in the fixed-width UTC
+-- layout, as 0007 does. Job runs written before the model carried UpdatedAt
+-- take their last run or, while suspended, their suspension time: the
+-- instants an export selected them by until now.
+
+ALTER TABLE memos ADD COLUMN updated_at TEXT NOT NULL DEFAULT '';
+ALTER TABLE job_runs ADD COLUMN updated_at TEXT NOT NULL DEFAULT '';
+
+UPDATE memos SET
+    updated_at = strftime('%Y-%m-%dT%H:%M:%S', data->>'UpdatedAt') || '.' ||
+        substr(CASE
+            WHEN substr(data->>'UpdatedAt', 20, 1) <> '.' THEN ''
+            WHEN data->>'UpdatedAt' LIKE '%Z' THEN substr(data->>'UpdatedAt', 21, length(data->>'UpdatedAt') - 21)
+            ELSE substr(data->>'UpdatedAt', 21, length(data->>'UpdatedAt') - 26)
+        END || '000000000', 1, 9) || 'Z';
+
+UPDATE job_runs SET
+    updated_at = strftime('%Y-%m-%dT%H:%M:%S', data->>'LastRunAt') || '.' ||
+        substr(CASE
+            WHEN substr(data->>'LastRunAt', 20, 1) <> '.' THEN ''
+            WHEN data->>'LastRunAt' LIKE '%Z' THEN substr(data->>'LastRunAt', 21, length(data->>'LastRunAt') - 21)
+            ELSE substr(data->>'LastRunAt', 21, length(data->>'LastRunAt') - 26)
+        END || '000000000', 1, 9) || 'Z';
+
+UPDATE job_runs SET
+    updated_at = strftime('%Y-%m-%dT%H:%M:%S', data->>'SuspendedAt') || '.' ||
+        substr(CASE
+            WHEN substr(data->>'SuspendedAt', 20, 1) <> '.' THEN ''
+            WHEN data->>'SuspendedAt' LIKE '%Z' THEN substr(data->>'SuspendedAt', 21, length(data->>'SuspendedAt') - 21)
+            ELSE substr(data->>'SuspendedAt', 21, length(data->>'SuspendedAt') - 26)
+        END || '000000000', 1, 9) || 'Z'
+WHERE COALESCE(data->>'SuspendedRunID', '') <> '';
+
+CREATE INDEX memos_updated_idx ON memos (workspace_id, updated_at);
+CREATE INDEX job_runs_updated_idx ON job_runs (workspace_id, updated_at);
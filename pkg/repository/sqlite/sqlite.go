@@ -0,0 +1,82 @@
+// Package sqlite is the SQLite backend of interfaces.Repository, for a single
+// instance that keeps its data in one local file: the repository itself is
+// sqldb's, run with this package's dialect and schema (see migrations/).
+// Timestamps in key columns are UTC text of fixed width, so they compare and
+// sort in time order.
+//
+// The driver is modernc.org/sqlite, which needs no cgo. The schema is created
+// and upgraded by Migrate (`hecatoncheires migrate
+// --repository-backend=sqlite`); New refuses to start against a database
+// whose schema is behind this build.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/sqldb"
+	_ "modernc.org/sqlite" // registers the "sqlite" database/sql driver
+)
+
+// Open opens the database file at path with the settings every connection
+// needs: a busy timeout, so a writer waits for the lock instead of failing;
+// WAL, so readers do not block the writer; and BEGIN IMMEDIATE for every
+// transaction, so a transaction takes the write lock before it reads (see
+// dialect). The agent process store (pkg/repository/agentproc) opens the same
+// file through it, so both sides lock the same way.
+func Open(path string) (*sql.DB, error) {
+	if path == "" {
+		return nil, goerr.New("sqlite database path is required")
+	}
+	q := url.Values{}
+	q.Add("_pragma", "busy_timeout(10000)")
+	q.Add("_pragma", "journal_mode(WAL)")
+	q.Add("_pragma", "foreign_keys(1)")
+	q.Set("_txlock", "immediate")
+	db, err := sql.Open("sqlite", "file:"+path+"?"+q.Encode())
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to open sqlite database", goerr.V("path", path))
+	}
+	if err := db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, goerr.Wrap(err, "failed to open sqlite database", goerr.V("path", path))
+	}
+	return db, nil
+}
+
+// New opens the database file at path and checks that its schema is up to
+// date.
+func New(ctx context.Context, path string) (*sqldb.Repository, error) {
+	db, err := Open(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkSchema(ctx, db); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return sqldb.New(db, dialect{}), nil
+}
+
+// checkSchema refuses a database whose schema is older (or newer) than the
+// migrations embedded in this build, so a forgotten `migrate` fails at startup
+// rather than at the first query against a missing column.
+func checkSchema(ctx context.Context, db *sql.DB) error {
+	want, err := latestVersion()
+	if err != nil {
+		return err
+	}
+	got, err := currentVersion(ctx, db)
+	if err != nil {
+		return goerr.Wrap(err, "failed to check sqlite schema version")
+	}
+	if got != want {
+		return goerr.New("sqlite schema version does not match this build; run `hecatoncheires migrate --repository-backend=sqlite`",
+			goerr.V("schema_version", got),
+			goerr.V("expected_version", want))
+	}
+	return nil
+}
@@ -12,13 +12,15 @@ import (
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/firestore"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/postgres"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/sqlite"
 )
 
 func runTagRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.Repository) {
 	t.Helper()
 
 	isNotFound := func(err error) bool {
-		return errors.Is(err, memory.ErrNotFound) || errors.Is(err, firestore.ErrNotFound)
+		return errors.Is(err, memory.ErrNotFound) || errors.Is(err, firestore.ErrNotFound) || errors.Is(err, postgres.ErrNotFound) || errors.Is(err, sqlite.ErrNotFound)
 	}
 
 	repo := newRepo(t)
@@ -212,3 +214,13 @@ func TestTagRepository_Firestore(t *testing.T) {
 	t.Parallel()
 	runTagRepositoryTest(t, newFirestoreRepository)
 }
+
+func TestTagRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runTagRepositoryTest(t, newPostgresRepository)
+}
+
+func TestTagRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runTagRepositoryTest(t, newSQLiteRepository)
+}
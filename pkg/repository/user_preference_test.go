@@ -12,13 +12,15 @@ import (
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/firestore"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/postgres"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/sqlite"
 )
 
 func runUserPreferenceRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.Repository) {
 	t.Helper()
 
 	isNotFound := func(err error) bool {
-		return errors.Is(err, memory.ErrNotFound) || errors.Is(err, firestore.ErrNotFound)
+		return errors.Is(err, memory.ErrNotFound) || errors.Is(err, firestore.ErrNotFound) || errors.Is(err, postgres.ErrNotFound) || errors.Is(err, sqlite.ErrNotFound)
 	}
 
 	repo := newRepo(t)
@@ -117,3 +119,13 @@ func TestUserPreferenceRepository_Firestore(t *testing.T) {
 	t.Parallel()
 	runUserPreferenceRepositoryTest(t, newFirestoreRepository)
 }
+
+func TestUserPreferenceRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runUserPreferenceRepositoryTest(t, newPostgresRepository)
+}
+
+func TestUserPreferenceRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runUserPreferenceRepositoryTest(t, newSQLiteRepository)
+}
@@ -0,0 +1,200 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+)
+
+func newTestWebhookDelivery(wsID string, at time.Time) *model.WebhookDelivery {
+	return &model.WebhookDelivery{
+		ID:            model.NewWebhookDeliveryID(),
+		WorkspaceID:   wsID,
+		WebhookID:     "siem",
+		Event:         model.WebhookEventCaseCreated,
+		CaseID:        1,
+		Payload:       `{"event":"case.created"}`,
+		Status:        model.WebhookDeliveryPending,
+		NextAttemptAt: at,
+		CreatedAt:     at,
+		UpdatedAt:     at,
+	}
+}
+
+func runWebhookDeliveryRepositoryTest(t *testing.T, newRepo func(t *testing.T) interfaces.Repository) {
+	t.Helper()
+
+	t.Run("Create and Get round-trip", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		d := newTestWebhookDelivery(wsID, now)
+		gt.NoError(t, repo.WebhookDelivery().Create(ctx, d)).Required()
+
+		got, err := repo.WebhookDelivery().Get(ctx, wsID, d.ID)
+		gt.NoError(t, err).Required()
+		gt.Value(t, got.WebhookID).Equal("siem")
+		gt.Value(t, got.Event).Equal(model.WebhookEventCaseCreated)
+		gt.Value(t, got.Payload).Equal(d.Payload)
+		gt.Value(t, got.Status).Equal(model.WebhookDeliveryPending)
+		gt.Bool(t, got.NextAttemptAt.Equal(now)).True()
+
+		_, err = repo.WebhookDelivery().Get(ctx, "other-"+wsID, d.ID)
+		gt.Error(t, err)
+	})
+
+	t.Run("Create rejects an invalid delivery", func(t *testing.T) {
+		repo := newRepo(t)
+		d := newTestWebhookDelivery("ws", time.Now())
+		d.Event = "case.exploded"
+		gt.Error(t, repo.WebhookDelivery().Create(context.Background(), d))
+	})
+
+	t.Run("Update of a missing delivery fails", func(t *testing.T) {
+		repo := newRepo(t)
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		gt.Error(t, repo.WebhookDelivery().Update(context.Background(), newTestWebhookDelivery(wsID, time.Now())))
+	})
+
+	t.Run("Claim starts an attempt only on a due delivery", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		d := newTestWebhookDelivery(wsID, now)
+		gt.NoError(t, repo.WebhookDelivery().Create(ctx, d)).Required()
+
+		claimed, err := repo.WebhookDelivery().Claim(ctx, wsID, d.ID, now, now.Add(time.Minute))
+		gt.NoError(t, err).Required()
+		gt.Value(t, claimed).NotNil().Required()
+		gt.Value(t, claimed.Attempts).Equal(1)
+		gt.Bool(t, claimed.NextAttemptAt.Equal(now.Add(time.Minute))).True()
+
+		// Leased: not due again until the lease runs out.
+		again, err := repo.WebhookDelivery().Claim(ctx, wsID, d.ID, now, now.Add(time.Minute))
+		gt.NoError(t, err).Required()
+		gt.Value(t, again).Nil()
+
+		_, err = repo.WebhookDelivery().Claim(ctx, wsID, model.NewWebhookDeliveryID(), now, now.Add(time.Minute))
+		gt.Error(t, err)
+	})
+
+	t.Run("concurrent Claims start exactly one attempt", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		d := newTestWebhookDelivery(wsID, now)
+		gt.NoError(t, repo.WebhookDelivery().Create(ctx, d)).Required()
+
+		var (
+			wg      sync.WaitGroup
+			mu      sync.Mutex
+			claimed int
+		)
+		for range 5 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				got, err := repo.WebhookDelivery().Claim(ctx, wsID, d.ID, now, now.Add(time.Minute))
+				if err == nil && got != nil {
+					mu.Lock()
+					claimed++
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+		gt.Value(t, claimed).Equal(1)
+	})
+
+	t.Run("ListDue returns pending deliveries whose attempt is due, soonest first", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		later := newTestWebhookDelivery(wsID, now.Add(-time.Minute))
+		sooner := newTestWebhookDelivery(wsID, now.Add(-time.Hour))
+		future := newTestWebhookDelivery(wsID, now.Add(time.Hour))
+		dead := newTestWebhookDelivery(wsID, now.Add(-time.Hour))
+		dead.Status = model.WebhookDeliveryDead
+		for _, d := range []*model.WebhookDelivery{later, sooner, future, dead} {
+			gt.NoError(t, repo.WebhookDelivery().Create(ctx, d)).Required()
+		}
+
+		due, err := repo.WebhookDelivery().ListDue(ctx, wsID, now, 10)
+		gt.NoError(t, err).Required()
+		gt.Array(t, due).Length(2).Required()
+		gt.Value(t, due[0].ID).Equal(sooner.ID)
+		gt.Value(t, due[1].ID).Equal(later.ID)
+
+		limited, err := repo.WebhookDelivery().ListDue(ctx, wsID, now, 1)
+		gt.NoError(t, err).Required()
+		gt.Array(t, limited).Length(1)
+	})
+
+	t.Run("List returns newest first, optionally by status", func(t *testing.T) {
+		repo := newRepo(t)
+		ctx := context.Background()
+		wsID := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		older := newTestWebhookDelivery(wsID, now.Add(-time.Hour))
+		newer := newTestWebhookDelivery(wsID, now)
+		dead := newTestWebhookDelivery(wsID, now.Add(-30*time.Minute))
+		dead.Status = model.WebhookDeliveryDead
+		for _, d := range []*model.WebhookDelivery{older, newer, dead} {
+			gt.NoError(t, repo.WebhookDelivery().Create(ctx, d)).Required()
+		}
+
+		all, err := repo.WebhookDelivery().List(ctx, wsID, "", 10)
+		gt.NoError(t, err).Required()
+		gt.Array(t, all).Length(3).Required()
+		gt.Value(t, all[0].ID).Equal(newer.ID)
+		gt.Value(t, all[1].ID).Equal(dead.ID)
+		gt.Value(t, all[2].ID).Equal(older.ID)
+
+		deadOnly, err := repo.WebhookDelivery().List(ctx, wsID, model.WebhookDeliveryDead, 10)
+		gt.NoError(t, err).Required()
+		gt.Array(t, deadOnly).Length(1).Required()
+		gt.Value(t, deadOnly[0].ID).Equal(dead.ID)
+
+		limited, err := repo.WebhookDelivery().List(ctx, wsID, "", 2)
+		gt.NoError(t, err).Required()
+		gt.Array(t, limited).Length(2)
+	})
+}
+
+func TestWebhookDeliveryRepository_Memory(t *testing.T) {
+	t.Parallel()
+	runWebhookDeliveryRepositoryTest(t, func(t *testing.T) interfaces.Repository {
+		return memory.New()
+	})
+}
+
+func TestWebhookDeliveryRepository_Firestore(t *testing.T) {
+	t.Parallel()
+	runWebhookDeliveryRepositoryTest(t, newFirestoreRepository)
+}
+
+func TestWebhookDeliveryRepository_Postgres(t *testing.T) {
+	t.Parallel()
+	runWebhookDeliveryRepositoryTest(t, newPostgresRepository)
+}
+
+func TestWebhookDeliveryRepository_SQLite(t *testing.T) {
+	t.Parallel()
+	runWebhookDeliveryRepositoryTest(t, newSQLiteRepository)
+}
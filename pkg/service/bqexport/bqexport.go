@@ -17,6 +17,12 @@
 // the desired schema after every run: a column no longer produced disappears,
 // and a column whose type or mode changed is replaced rather than rejected.
 // The Storage-Write mechanics follow secmon-lab/swarm's pkg/infra/bq.
+//
+// The sink is also an export.Merger. MergeTable stages the delta's rows the
+// same way, then applies the deletes and replaces the keyed rows in one
+// multi-statement transaction. Unlike WriteTable it does read the
+// destination's schema, and it refuses to merge into a table whose columns
+// differ from the delta's.
 package bqexport
 
 import (
@@ -26,6 +32,7 @@ import (
 	"fmt"
 	"io"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -97,7 +104,10 @@ type Sink struct {
 	location string
 }
 
-var _ export.Sink = (*Sink)(nil)
+var (
+	_ export.Sink   = (*Sink)(nil)
+	_ export.Merger = (*Sink)(nil)
+)
 
 // New creates a Sink with both a BigQuery client (metadata / swap) and a
 // managedwriter client (Storage Write appends). project is required; location is
@@ -138,14 +148,90 @@ func (s *Sink) Close() error {
 // it. The destination is only touched by the final swap, so a failure anywhere
 // before it leaves the previous snapshot intact.
 func (s *Sink) WriteTable(ctx context.Context, dataset string, t *export.Table) error {
+	if err := validateTable(dataset, t); err != nil {
+		return err
+	}
+	location, err := s.ensureDataset(ctx, dataset)
+	if err != nil {
+		return err
+	}
+	desired := toBQSchema(t.Columns)
+
+	staging, err := s.createStagingTable(ctx, dataset, t.Name, desired)
+	if err != nil {
+		return err
+	}
+	defer s.dropStagingTable(ctx, dataset, staging)
+
+	if len(t.Rows) > 0 {
+		if err := s.appendRows(ctx, dataset, staging, desired, t.Columns, t.Rows); err != nil {
+			return err
+		}
+	}
+	// An empty staging table still goes through the swap: a refresh that
+	// produced no rows must leave the destination empty, not stale.
+	return s.swapTable(ctx, dataset, t.Name, staging, location, t.Columns)
+}
+
+// MergeTable applies an incremental delta to dataset.table: the rows are
+// written into a staging table, then one transaction runs the delete
+// predicates, removes the destination rows whose key matches a staged row and
+// inserts the staged rows. The destination must already exist with exactly
+// the delta's columns; otherwise nothing is changed and the error asks for a
+// full export.
+func (s *Sink) MergeTable(ctx context.Context, dataset string, d *export.Delta) error {
+	t := d.Table
+	if err := validateTable(dataset, t); err != nil {
+		return err
+	}
+	location, err := s.ensureDataset(ctx, dataset)
+	if err != nil {
+		return err
+	}
+	md, err := s.bq.DatasetInProject(s.project, dataset).Table(t.Name).Metadata(ctx)
+	if err != nil {
+		if isHTTPStatus(err, 404) {
+			return goerr.New("export table does not exist; run a full export",
+				goerr.V("dataset", dataset), goerr.V("table", t.Name))
+		}
+		return goerr.Wrap(err, "failed to get export table metadata",
+			goerr.V("dataset", dataset), goerr.V("table", t.Name))
+	}
+	if !matchesSchema(md.Schema, t.Columns) {
+		return goerr.New("export table schema changed; run a full export",
+			goerr.V("dataset", dataset), goerr.V("table", t.Name))
+	}
+
+	desired := toBQSchema(t.Columns)
+	staging, err := s.createStagingTable(ctx, dataset, t.Name, desired)
+	if err != nil {
+		return err
+	}
+	defer s.dropStagingTable(ctx, dataset, staging)
+
+	script, params, err := mergeScript(s.project, dataset, t.Name, staging, t.Columns, d)
+	if err != nil {
+		return goerr.Wrap(err, "invalid export delta",
+			goerr.V("dataset", dataset), goerr.V("table", t.Name))
+	}
+	if len(t.Rows) > 0 {
+		if err := s.appendRows(ctx, dataset, staging, desired, t.Columns, t.Rows); err != nil {
+			return err
+		}
+	}
+	return s.runStatement(ctx, "table merge", script, params, location, dataset, t.Name, staging)
+}
+
+// validateTable checks every identifier WriteTable and MergeTable interpolate
+// into SQL. A table with no columns is rejected rather than sent as invalid
+// DDL.
+func validateTable(dataset string, t *export.Table) error {
 	if !safeIdentPattern.MatchString(dataset) {
 		return goerr.New("invalid dataset name for export", goerr.V("dataset", dataset))
 	}
 	if !safeIdentPattern.MatchString(t.Name) {
 		return goerr.New("invalid table name for export", goerr.V("table", t.Name))
 	}
-	// Column names reach the swap statement, so they are validated here too.
-	// A table with no columns is rejected rather than sent as invalid DDL.
 	if len(t.Columns) == 0 {
 		return goerr.New("export table has no columns",
 			goerr.V("dataset", dataset), goerr.V("table", t.Name))
@@ -156,26 +242,94 @@ func (s *Sink) WriteTable(ctx context.Context, dataset string, t *export.Table)
 				goerr.V("dataset", dataset), goerr.V("table", t.Name), goerr.V("column", c.Name))
 		}
 	}
-	location, err := s.ensureDataset(ctx, dataset)
-	if err != nil {
-		return err
+	return nil
+}
+
+// matchesSchema reports whether an existing table has exactly the columns
+// toBQSchema would give it — same names, order, types and modes — so a delta
+// can be merged into it. The API may spell a type by its GoogleSQL or its
+// legacy name, so both are accepted.
+func matchesSchema(existing bq.Schema, cols []export.Column) bool {
+	if len(existing) != len(cols) {
+		return false
+	}
+	for i, want := range toBQSchema(cols) {
+		e := existing[i]
+		if e.Name != want.Name || legacyTypeName(e.Type) != want.Type ||
+			e.Repeated != want.Repeated || e.Required != want.Required {
+			return false
+		}
 	}
-	desired := toBQSchema(t.Columns)
+	return true
+}
 
-	staging, err := s.createStagingTable(ctx, dataset, t.Name, desired)
-	if err != nil {
-		return err
+// legacyTypeName maps a GoogleSQL type name to the legacy name bq.FieldType
+// constants use; legacy names pass through.
+func legacyTypeName(ft bq.FieldType) bq.FieldType {
+	switch ft {
+	case "INT64":
+		return bq.IntegerFieldType
+	case "FLOAT64":
+		return bq.FloatFieldType
+	case "BOOL":
+		return bq.BooleanFieldType
+	default:
+		return ft
 	}
-	defer s.dropStagingTable(ctx, dataset, staging)
+}
 
-	if len(t.Rows) > 0 {
-		if err := s.appendRows(ctx, dataset, staging, desired, t.Columns, t.Rows); err != nil {
-			return err
+// mergeScript renders the multi-statement transaction that applies a delta
+// staged in staging to dest. Delete predicate values travel as query
+// parameters; each predicate column must be a scalar column of the table, and
+// each key column a column of the table.
+func mergeScript(project, dataset, dest, staging string, cols []export.Column, d *export.Delta) (string, []bq.QueryParameter, error) {
+	if len(d.Key) == 0 {
+		return "", nil, goerr.New("merge key is required")
+	}
+	for _, k := range d.Key {
+		if !slices.ContainsFunc(cols, func(c export.Column) bool { return c.Name == k && !c.Repeated }) {
+			return "", nil, goerr.New("merge key is not a scalar column of the table", goerr.V("column", k))
 		}
 	}
-	// An empty staging table still goes through the swap: a refresh that
-	// produced no rows must leave the destination empty, not stale.
-	return s.swapTable(ctx, dataset, t.Name, staging, location, t.Columns)
+	destRef := fmt.Sprintf("`%s.%s.%s`", project, dataset, dest)
+	stagingRef := fmt.Sprintf("`%s.%s.%s`", project, dataset, staging)
+
+	var params []bq.QueryParameter
+	stmts := []string{"BEGIN TRANSACTION"}
+	for _, pred := range d.Deletes {
+		if len(pred) == 0 {
+			return "", nil, goerr.New("delete predicate is empty")
+		}
+		names := make([]string, 0, len(pred))
+		for name := range pred {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		conds := make([]string, 0, len(names))
+		for _, name := range names {
+			if !slices.ContainsFunc(cols, func(c export.Column) bool { return c.Name == name && !c.Repeated }) {
+				return "", nil, goerr.New("delete predicate column is not a scalar column of the table",
+					goerr.V("column", name))
+			}
+			param := fmt.Sprintf("p%d", len(params))
+			params = append(params, bq.QueryParameter{Name: param, Value: pred[name]})
+			conds = append(conds, fmt.Sprintf("`%s` = @%s", name, param))
+		}
+		stmts = append(stmts, fmt.Sprintf("DELETE FROM %s WHERE %s", destRef, strings.Join(conds, " AND ")))
+	}
+
+	keyConds := make([]string, 0, len(d.Key))
+	for _, k := range d.Key {
+		keyConds = append(keyConds, fmt.Sprintf("d.`%s` = m.`%s`", k, k))
+	}
+	stmts = append(stmts,
+		fmt.Sprintf("DELETE FROM %s AS d WHERE EXISTS (SELECT 1 FROM %s AS m WHERE %s)",
+			destRef, stagingRef, strings.Join(keyConds, " AND ")),
+		fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s",
+			destRef, ddlSelectList(cols), ddlSelectList(cols), stagingRef),
+		"COMMIT TRANSACTION",
+	)
+	return strings.Join(stmts, ";\n") + ";", params, nil
 }
 
 // ddlColumnList renders the destination's column declarations for the swap
@@ -300,46 +454,54 @@ func (s *Sink) createStagingTable(ctx context.Context, dataset, table string, de
 func (s *Sink) swapTable(ctx context.Context, dataset, dest, staging, location string, columns []export.Column) error {
 	// Every identifier was validated before we got here: dataset, dest and the
 	// column names at WriteTable's boundary, staging in createStagingTable.
-	q := s.bq.Query(fmt.Sprintf(
+	sql := fmt.Sprintf(
 		"CREATE OR REPLACE TABLE `%s.%s.%s` (%s) AS SELECT %s FROM `%s.%s.%s`",
 		s.project, dataset, dest, ddlColumnList(columns), ddlSelectList(columns),
-		s.project, dataset, staging))
+		s.project, dataset, staging)
+	return s.runStatement(ctx, "table swap", sql, nil, location, dataset, dest, staging)
+}
+
+// runStatement runs one query job that writes dest from staging (the swap or
+// the merge script, named by op) and waits for it.
+func (s *Sink) runStatement(ctx context.Context, op, sql string, params []bq.QueryParameter, location, dataset, dest, staging string) error {
+	q := s.bq.Query(sql)
+	q.Parameters = params
 	if location != "" {
 		q.Location = location
 	}
 	job, err := q.Run(ctx)
 	if err != nil {
-		return goerr.Wrap(err, "failed to start table swap",
+		return goerr.Wrap(err, "failed to start "+op,
 			goerr.V("dataset", dataset), goerr.V("table", dest), goerr.V("staging", staging))
 	}
 	status, err := job.Wait(ctx)
 	if err != nil {
 		// Wait gives up when the caller's context ends but never cancels the
-		// job, so an unattended swap can still land minutes later — after this
+		// job, so an unattended job can still land minutes later — after this
 		// run reported failure, and possibly on top of a newer snapshot written
 		// by a re-run. Ask BigQuery to stop it.
-		s.cancelSwapJob(ctx, job, dataset, dest, staging)
-		return goerr.Wrap(err, "failed to wait for table swap; the swap may or may not have applied",
+		s.cancelJob(ctx, op, job, dataset, dest, staging)
+		return goerr.Wrap(err, "failed to wait for "+op+"; it may or may not have applied",
 			goerr.V("dataset", dataset), goerr.V("table", dest), goerr.V("staging", staging))
 	}
 	if err := status.Err(); err != nil {
-		return goerr.Wrap(err, "table swap job failed",
+		return goerr.Wrap(err, op+" job failed",
 			goerr.V("dataset", dataset), goerr.V("table", dest), goerr.V("staging", staging))
 	}
 	return nil
 }
 
-// cancelSwapJob asks BigQuery to stop a swap this process can no longer
-// observe. It is best-effort by nature: Jobs.cancel is asynchronous and a swap
-// that already committed cannot be undone, so the caller still has to report
-// the outcome as unknown rather than as "the destination was left alone".
-func (s *Sink) cancelSwapJob(ctx context.Context, job *bq.Job, dataset, dest, staging string) {
+// cancelJob asks BigQuery to stop a job this process can no longer observe.
+// It is best-effort by nature: Jobs.cancel is asynchronous and a job that
+// already committed cannot be undone, so the caller still has to report the
+// outcome as unknown rather than as "the destination was left alone".
+func (s *Sink) cancelJob(ctx context.Context, op string, job *bq.Job, dataset, dest, staging string) {
 	cctx, cancel := detachedContext(ctx)
 	defer cancel()
 	if err := job.Cancel(cctx); err != nil {
-		errutil.Handle(ctx, goerr.Wrap(err, "failed to cancel table swap job",
+		errutil.Handle(ctx, goerr.Wrap(err, "failed to cancel "+op+" job",
 			goerr.V("dataset", dataset), goerr.V("table", dest), goerr.V("staging", staging)),
-			"cancel export table swap")
+			"cancel export "+op)
 	}
 }
 
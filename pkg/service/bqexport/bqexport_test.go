@@ -90,6 +90,81 @@ func TestDDLColumnList(t *testing.T) {
 		"`id`, `title`, `score`, `flag`, `at`, `labels`")
 }
 
+func TestMatchesSchema(t *testing.T) {
+	cols := []export.Column{
+		{Name: "id", Type: export.TypeInt},
+		{Name: "at", Type: export.TypeTimestamp, Nullable: true},
+		{Name: "labels", Type: export.TypeString, Repeated: true},
+	}
+	existing := bq.Schema{
+		{Name: "id", Type: bq.IntegerFieldType, Required: true},
+		{Name: "at", Type: bq.TimestampFieldType},
+		{Name: "labels", Type: bq.StringFieldType, Repeated: true},
+	}
+	gt.Bool(t, bqexport.MatchesSchemaForTest(existing, cols)).True()
+
+	t.Run("GoogleSQL type names are accepted", func(t *testing.T) {
+		named := bq.Schema{
+			{Name: "id", Type: "INT64", Required: true},
+			existing[1], existing[2],
+		}
+		gt.Bool(t, bqexport.MatchesSchemaForTest(named, cols)).True()
+	})
+	t.Run("added column", func(t *testing.T) {
+		more := append(append([]export.Column{}, cols...), export.Column{Name: "x", Type: export.TypeBool, Nullable: true})
+		gt.Bool(t, bqexport.MatchesSchemaForTest(existing, more)).False()
+	})
+	t.Run("mode changed", func(t *testing.T) {
+		changed := bq.Schema{{Name: "id", Type: bq.IntegerFieldType}, existing[1], existing[2]}
+		gt.Bool(t, bqexport.MatchesSchemaForTest(changed, cols)).False()
+	})
+	t.Run("type changed", func(t *testing.T) {
+		changed := bq.Schema{existing[0], {Name: "at", Type: bq.StringFieldType}, existing[2]}
+		gt.Bool(t, bqexport.MatchesSchemaForTest(changed, cols)).False()
+	})
+}
+
+func TestMergeScript(t *testing.T) {
+	cols := []export.Column{
+		{Name: "case_id", Type: export.TypeInt},
+		{Name: "job_id", Type: export.TypeString},
+		{Name: "labels", Type: export.TypeString, Repeated: true},
+	}
+	script, params, err := bqexport.MergeScriptForTest("proj", "ds", "job_runs", "job_runs_stg_1", cols, &export.Delta{
+		Key:     []string{"case_id", "job_id"},
+		Deletes: []map[string]any{{"case_id": int64(7)}, {"job_id": "x", "case_id": int64(8)}},
+	})
+	gt.NoError(t, err).Required()
+	gt.String(t, script).Equal("BEGIN TRANSACTION;\n" +
+		"DELETE FROM `proj.ds.job_runs` WHERE `case_id` = @p0;\n" +
+		"DELETE FROM `proj.ds.job_runs` WHERE `case_id` = @p1 AND `job_id` = @p2;\n" +
+		"DELETE FROM `proj.ds.job_runs` AS d WHERE EXISTS (SELECT 1 FROM `proj.ds.job_runs_stg_1` AS m " +
+		"WHERE d.`case_id` = m.`case_id` AND d.`job_id` = m.`job_id`);\n" +
+		"INSERT INTO `proj.ds.job_runs` (`case_id`, `job_id`, `labels`) " +
+		"SELECT `case_id`, `job_id`, `labels` FROM `proj.ds.job_runs_stg_1`;\n" +
+		"COMMIT TRANSACTION;")
+	gt.Value(t, params).Equal([]bq.QueryParameter{
+		{Name: "p0", Value: int64(7)},
+		{Name: "p1", Value: int64(8)},
+		{Name: "p2", Value: "x"},
+	})
+
+	t.Run("array key is rejected", func(t *testing.T) {
+		_, _, err := bqexport.MergeScriptForTest("proj", "ds", "t", "s", cols, &export.Delta{Key: []string{"labels"}})
+		gt.Error(t, err)
+	})
+	t.Run("unknown delete column is rejected", func(t *testing.T) {
+		_, _, err := bqexport.MergeScriptForTest("proj", "ds", "t", "s", cols, &export.Delta{
+			Key: []string{"case_id"}, Deletes: []map[string]any{{"nope": 1}},
+		})
+		gt.Error(t, err)
+	})
+	t.Run("missing key is rejected", func(t *testing.T) {
+		_, _, err := bqexport.MergeScriptForTest("proj", "ds", "t", "s", cols, &export.Delta{})
+		gt.Error(t, err)
+	})
+}
+
 func TestEncodeRow(t *testing.T) {
 	schema := bqexport.ToBQSchemaForTest([]export.Column{
 		{Name: "id", Type: export.TypeInt},
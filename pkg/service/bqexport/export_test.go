@@ -12,6 +12,8 @@ var (
 	StagingTableNameForTest = stagingTableName
 	DDLColumnListForTest    = ddlColumnList
 	DDLSelectListForTest    = ddlSelectList
+	MatchesSchemaForTest    = matchesSchema
+	MergeScriptForTest      = mergeScript
 )
 
 // AppendMaxRequestBytesForTest lets the live test size its payload so it
@@ -0,0 +1,9 @@
+package fileexport
+
+// Test seams: expose the NDJSON row encoder so the column order can be pinned
+// without going through a file, and the row group bound so a test can cross it.
+
+var (
+	EncodeNDJSONRowForTest     = encodeNDJSONRow
+	ParquetRowGroupRowsForTest = parquetRowGroupRows
+)
@@ -0,0 +1,235 @@
+// Package fileexport is the local-file implementation of export.Sink. Each
+// WriteTable writes one file per table — <root>/<namespace>/<table>.parquet or
+// .ndjson — as a full refresh: the file is written under a temporary name in
+// the same directory and renamed over the previous one, so a reader never sees
+// a half-written table and a failed write leaves the previous file in place.
+//
+// In tarball mode the same layout is staged in a temporary directory and packed
+// into a single .tar.gz when the sink is closed, which is what an offline
+// backup wants: one artefact per run, replaced atomically. A failed run calls
+// Abort instead, which discards the staging directory and keeps the previous
+// archive.
+package fileexport
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase/export"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/safe"
+)
+
+// Format selects the file encoding.
+type Format string
+
+const (
+	FormatParquet Format = "parquet"
+	FormatNDJSON  Format = "ndjson"
+)
+
+// safeIdentPattern bounds namespace and table names: they become path elements,
+// so anything that could climb out of the root ("..", separators) is refused.
+var safeIdentPattern = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// Sink writes export tables to local files. It is not safe for concurrent
+// WriteTable calls on the same table; the Exporter writes serially.
+type Sink struct {
+	format Format
+	// root is where tables are written: the configured directory, or a staging
+	// directory in tarball mode.
+	root string
+	// tarball is the destination archive; empty in directory mode.
+	tarball string
+	// finished is set once Close or Abort has consumed the staging directory.
+	finished bool
+}
+
+var (
+	_ export.Sink    = (*Sink)(nil)
+	_ export.Aborter = (*Sink)(nil)
+)
+
+// New creates a Sink writing into dir, which is created when absent.
+func New(dir string, format Format) (*Sink, error) {
+	if err := validateFormat(format); err != nil {
+		return nil, err
+	}
+	if dir == "" {
+		return nil, goerr.New("export directory is required")
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, goerr.Wrap(err, "failed to create export directory", goerr.V("dir", dir))
+	}
+	return &Sink{format: format, root: dir}, nil
+}
+
+// NewTarball creates a Sink that packs every table written into a gzipped tar
+// at path when Close is called. Nothing is written to path before then.
+func NewTarball(path string, format Format) (*Sink, error) {
+	if err := validateFormat(format); err != nil {
+		return nil, err
+	}
+	if path == "" {
+		return nil, goerr.New("export tarball path is required")
+	}
+	staging, err := os.MkdirTemp("", "hecatoncheires-export-")
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to create export staging directory")
+	}
+	return &Sink{format: format, root: staging, tarball: path}, nil
+}
+
+func validateFormat(format Format) error {
+	switch format {
+	case FormatParquet, FormatNDJSON:
+		return nil
+	default:
+		return goerr.New("unsupported export file format", goerr.V("format", format))
+	}
+}
+
+// Close finishes the run. In tarball mode it packs the staged tables into the
+// archive (atomically replacing any previous one) and removes the staging
+// directory; in directory mode it is a no-op. Close after Abort is a no-op.
+func (s *Sink) Close() error {
+	if s.tarball == "" || s.finished {
+		return nil
+	}
+	s.finished = true
+	defer func() { _ = os.RemoveAll(s.root) }()
+	return writeAtomically(s.tarball, func(w io.Writer) error {
+		return packTarGz(w, s.root)
+	})
+}
+
+// Abort ends a failed run. In tarball mode the staged tables are discarded
+// without packing, so the previous archive stays as the latest good backup. In
+// directory mode every table was already renamed into place as it was written,
+// so there is nothing to hold back.
+func (s *Sink) Abort() error {
+	if s.tarball == "" || s.finished {
+		return nil
+	}
+	s.finished = true
+	if err := os.RemoveAll(s.root); err != nil {
+		return goerr.Wrap(err, "failed to remove export staging directory", goerr.V("dir", s.root))
+	}
+	return nil
+}
+
+// WriteTable fully refreshes <namespace>/<table> in the sink's format.
+func (s *Sink) WriteTable(ctx context.Context, namespace string, t *export.Table) error {
+	if s.finished {
+		return goerr.New("export sink is already closed")
+	}
+	if !safeIdentPattern.MatchString(namespace) {
+		return goerr.New("invalid namespace for export", goerr.V("namespace", namespace))
+	}
+	if !safeIdentPattern.MatchString(t.Name) {
+		return goerr.New("invalid table name for export", goerr.V("table", t.Name))
+	}
+	if len(t.Columns) == 0 {
+		return goerr.New("export table has no columns",
+			goerr.V("namespace", namespace), goerr.V("table", t.Name))
+	}
+
+	dir := filepath.Join(s.root, namespace)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return goerr.Wrap(err, "failed to create namespace directory", goerr.V("dir", dir))
+	}
+	path := filepath.Join(dir, t.Name+"."+string(s.format))
+
+	encode := writeParquet
+	if s.format == FormatNDJSON {
+		encode = writeNDJSON
+	}
+	if err := writeAtomically(path, func(w io.Writer) error { return encode(w, t) }); err != nil {
+		return goerr.Wrap(err, "failed to write export table",
+			goerr.V("namespace", namespace), goerr.V("table", t.Name))
+	}
+	return nil
+}
+
+// writeAtomically writes through fn into a temporary file next to path and
+// renames it into place only when fn and the close both succeed.
+func writeAtomically(path string, fn func(io.Writer) error) error {
+	tmp := path + ".tmp-" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	// #nosec G304 - path is derived from operator configuration and validated names
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o640)
+	if err != nil {
+		return goerr.Wrap(err, "failed to create temporary file", goerr.V("path", tmp))
+	}
+	if err := fn(f); err != nil {
+		_ = f.Close()
+		_ = os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		_ = os.Remove(tmp)
+		return goerr.Wrap(err, "failed to close temporary file", goerr.V("path", tmp))
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return goerr.Wrap(err, "failed to move file into place", goerr.V("path", path))
+	}
+	return nil
+}
+
+// packTarGz writes every regular file under root into a gzipped tar, with
+// paths relative to root.
+func packTarGz(w io.Writer, root string) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	walkErr := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		// #nosec G304 - path comes from walking the sink's own staging directory
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer safe.Close(context.Background(), f)
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if walkErr != nil {
+		return goerr.Wrap(walkErr, "failed to pack export tarball")
+	}
+	if err := tw.Close(); err != nil {
+		return goerr.Wrap(err, "failed to finish tar stream")
+	}
+	if err := gz.Close(); err != nil {
+		return goerr.Wrap(err, "failed to finish gzip stream")
+	}
+	return nil
+}
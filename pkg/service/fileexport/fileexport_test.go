@@ -0,0 +1,308 @@
+package fileexport_test
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/schema"
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/service/fileexport"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase/export"
+)
+
+func sampleTable() *export.Table {
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	return &export.Table{
+		Name: "cases",
+		Columns: []export.Column{
+			{Name: "id", Type: export.TypeInt},
+			{Name: "title", Type: export.TypeString, Nullable: true},
+			{Name: "score", Type: export.TypeFloat, Nullable: true},
+			{Name: "is_private", Type: export.TypeBool, Nullable: true},
+			{Name: "assignee_ids", Type: export.TypeString, Repeated: true},
+			{Name: "created_at", Type: export.TypeTimestamp, Nullable: true},
+		},
+		Rows: []map[string]any{
+			{"id": int64(1), "title": "first", "score": 1.5, "is_private": true,
+				"assignee_ids": []string{"U1", "U2"}, "created_at": created},
+			{"id": int64(2), "assignee_ids": []string(nil), "created_at": time.Time{}},
+		},
+	}
+}
+
+func TestSink_NDJSON(t *testing.T) {
+	dir := t.TempDir()
+	sink, err := fileexport.New(dir, fileexport.FormatNDJSON)
+	gt.NoError(t, err).Required()
+	gt.NoError(t, sink.WriteTable(context.Background(), "risk", sampleTable())).Required()
+	gt.NoError(t, sink.Close())
+
+	f, err := os.Open(filepath.Join(dir, "risk", "cases.ndjson"))
+	gt.NoError(t, err).Required()
+	defer f.Close()
+
+	var rows []map[string]any
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		var row map[string]any
+		gt.NoError(t, json.Unmarshal(sc.Bytes(), &row)).Required()
+		rows = append(rows, row)
+	}
+	gt.Array(t, rows).Length(2).Required()
+	gt.Value(t, rows[0]["title"]).Equal("first")
+	gt.Value(t, rows[0]["created_at"]).Equal("2026-01-02T03:04:05Z")
+	gt.Value(t, rows[0]["assignee_ids"]).Equal([]any{"U1", "U2"})
+	// Every column is present; NULLs are null, a nil array is [].
+	gt.Value(t, rows[1]["title"]).Nil()
+	gt.Value(t, rows[1]["created_at"]).Nil()
+	gt.Value(t, rows[1]["assignee_ids"]).Equal([]any{})
+}
+
+func TestEncodeNDJSONRow_KeepsColumnOrder(t *testing.T) {
+	cols := []export.Column{
+		{Name: "z", Type: export.TypeString},
+		{Name: "a", Type: export.TypeInt},
+	}
+	line, err := fileexport.EncodeNDJSONRowForTest(cols, map[string]any{"a": int64(1), "z": "x"})
+	gt.NoError(t, err).Required()
+	gt.String(t, string(line)).Equal("{\"z\":\"x\",\"a\":1}\n")
+}
+
+func TestSink_Parquet(t *testing.T) {
+	dir := t.TempDir()
+	sink, err := fileexport.New(dir, fileexport.FormatParquet)
+	gt.NoError(t, err).Required()
+	gt.NoError(t, sink.WriteTable(context.Background(), "risk", sampleTable())).Required()
+
+	f := readParquet(t, filepath.Join(dir, "risk", "cases.parquet"))
+	gt.Number(t, f.numRows).Equal(2)
+	gt.Number(t, f.rowGroups).Equal(1)
+
+	// Schema: repetition follows Required / Nullable / Repeated, strings are
+	// annotated STRING and timestamps TIMESTAMP(MICROS, UTC).
+	repetition := func(name string) parquet.Repetition {
+		return f.schema[name].SchemaNode().RepetitionType()
+	}
+	gt.Value(t, f.schema["id"].PhysicalType()).Equal(parquet.Types.Int64)
+	gt.Value(t, repetition("id")).Equal(parquet.Repetitions.Required)
+	gt.Value(t, repetition("title")).Equal(parquet.Repetitions.Optional)
+	gt.Bool(t, f.schema["title"].LogicalType().Equals(schema.StringLogicalType{})).True()
+	gt.Value(t, f.schema["score"].PhysicalType()).Equal(parquet.Types.Double)
+	gt.Value(t, f.schema["is_private"].PhysicalType()).Equal(parquet.Types.Boolean)
+	gt.Value(t, repetition("assignee_ids")).Equal(parquet.Repetitions.Repeated)
+	gt.Bool(t, f.schema["created_at"].LogicalType().
+		Equals(schema.NewTimestampLogicalType(true, schema.TimeUnitMicros))).True()
+
+	// Values, with NULLs for absent / zero cells and an empty list for a nil array.
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	gt.Value(t, f.columns["id"]).Equal([]any{int64(1), int64(2)})
+	gt.Value(t, f.columns["title"]).Equal([]any{"first", nil})
+	gt.Value(t, f.columns["score"]).Equal([]any{1.5, nil})
+	gt.Value(t, f.columns["is_private"]).Equal([]any{true, nil})
+	gt.Value(t, f.columns["assignee_ids"]).Equal([]any{[]any{"U1", "U2"}, []any{}})
+	gt.Value(t, f.columns["created_at"]).Equal([]any{created.UnixMicro(), nil})
+}
+
+func TestSink_ParquetBoolsAcrossBytes(t *testing.T) {
+	// More than eight booleans exercises the bit-packing across bytes.
+	tbl := &export.Table{
+		Name:    "flags",
+		Columns: []export.Column{{Name: "flag", Type: export.TypeBool}},
+	}
+	var want []any
+	for i := 0; i < 11; i++ {
+		v := i%3 == 0
+		tbl.Rows = append(tbl.Rows, map[string]any{"flag": v})
+		want = append(want, v)
+	}
+	dir := t.TempDir()
+	sink, err := fileexport.New(dir, fileexport.FormatParquet)
+	gt.NoError(t, err).Required()
+	gt.NoError(t, sink.WriteTable(context.Background(), "ns", tbl)).Required()
+
+	f := readParquet(t, filepath.Join(dir, "ns", "flags.parquet"))
+	gt.Value(t, f.columns["flag"]).Equal(want)
+}
+
+func TestSink_ParquetSpansPages(t *testing.T) {
+	// Bodies well over the 1 MiB data page size split the body and labels
+	// chunks into several pages, with NULLs and lists straddling the cuts.
+	tbl := &export.Table{
+		Name: "notes",
+		Columns: []export.Column{
+			{Name: "id", Type: export.TypeInt},
+			{Name: "body", Type: export.TypeString, Nullable: true},
+			{Name: "labels", Type: export.TypeString, Repeated: true},
+		},
+	}
+	var wantBody, wantLabels []any
+	for i := 0; i < 6000; i++ {
+		row := map[string]any{"id": int64(i)}
+		body := any(nil)
+		if i%5 != 0 {
+			body = fmt.Sprintf("note %04d %s", i, strings.Repeat("x", 500))
+			row["body"] = body
+		}
+		labels := []any{}
+		for j := 0; j < i%3; j++ {
+			labels = append(labels, fmt.Sprintf("l%d %s", j, strings.Repeat("y", 400)))
+		}
+		row["labels"] = labels
+		tbl.Rows = append(tbl.Rows, row)
+		wantBody = append(wantBody, body)
+		wantLabels = append(wantLabels, labels)
+	}
+
+	dir := t.TempDir()
+	sink, err := fileexport.New(dir, fileexport.FormatParquet)
+	gt.NoError(t, err).Required()
+	gt.NoError(t, sink.WriteTable(context.Background(), "ns", tbl)).Required()
+
+	f := readParquet(t, filepath.Join(dir, "ns", "notes.parquet"))
+	gt.Number(t, f.numRows).Equal(6000)
+	gt.Number(t, f.pages["body"]).Greater(1)
+	gt.Number(t, f.pages["labels"]).Greater(1)
+	gt.Array(t, f.columns["id"]).Length(6000)
+	gt.Value(t, f.columns["body"]).Equal(wantBody)
+	gt.Value(t, f.columns["labels"]).Equal(wantLabels)
+}
+
+func TestSink_ParquetSpansRowGroups(t *testing.T) {
+	n := fileexport.ParquetRowGroupRowsForTest + 10
+	tbl := &export.Table{
+		Name:    "ids",
+		Columns: []export.Column{{Name: "id", Type: export.TypeInt}},
+	}
+	want := make([]any, n)
+	for i := range want {
+		tbl.Rows = append(tbl.Rows, map[string]any{"id": int64(i)})
+		want[i] = int64(i)
+	}
+
+	dir := t.TempDir()
+	sink, err := fileexport.New(dir, fileexport.FormatParquet)
+	gt.NoError(t, err).Required()
+	gt.NoError(t, sink.WriteTable(context.Background(), "ns", tbl)).Required()
+
+	f := readParquet(t, filepath.Join(dir, "ns", "ids.parquet"))
+	gt.Number(t, f.numRows).Equal(int64(n))
+	gt.Number(t, f.rowGroups).Equal(2)
+	gt.Value(t, f.columns["id"]).Equal(want)
+}
+
+func TestSink_ParquetEmptyTable(t *testing.T) {
+	dir := t.TempDir()
+	sink, err := fileexport.New(dir, fileexport.FormatParquet)
+	gt.NoError(t, err).Required()
+	tbl := sampleTable()
+	tbl.Rows = nil
+	gt.NoError(t, sink.WriteTable(context.Background(), "risk", tbl)).Required()
+
+	f := readParquet(t, filepath.Join(dir, "risk", "cases.parquet"))
+	gt.Number(t, f.numRows).Equal(0)
+	gt.Number(t, len(f.schema)).Equal(len(tbl.Columns))
+}
+
+func TestSink_ParquetRejectsMissingRequiredValue(t *testing.T) {
+	sink, err := fileexport.New(t.TempDir(), fileexport.FormatParquet)
+	gt.NoError(t, err).Required()
+	tbl := sampleTable()
+	delete(tbl.Rows[0], "id")
+	gt.Error(t, sink.WriteTable(context.Background(), "risk", tbl))
+}
+
+func TestSink_Tarball(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "export.tar.gz")
+	sink, err := fileexport.NewTarball(path, fileexport.FormatNDJSON)
+	gt.NoError(t, err).Required()
+	gt.NoError(t, sink.WriteTable(context.Background(), "risk", sampleTable())).Required()
+
+	// Nothing is published before Close.
+	_, statErr := os.Stat(path)
+	gt.Bool(t, os.IsNotExist(statErr)).True()
+	gt.NoError(t, sink.Close()).Required()
+
+	gt.Array(t, tarballEntries(t, path)).Equal([]string{"risk/cases.ndjson"})
+}
+
+func TestSink_TarballAbortKeepsPreviousArchive(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "export.tar.gz")
+	first, err := fileexport.NewTarball(path, fileexport.FormatNDJSON)
+	gt.NoError(t, err).Required()
+	gt.NoError(t, first.WriteTable(context.Background(), "risk", sampleTable())).Required()
+	gt.NoError(t, first.Close()).Required()
+	before, err := os.ReadFile(path)
+	gt.NoError(t, err).Required()
+
+	// A failed run wrote some tables, then the caller aborts.
+	second, err := fileexport.NewTarball(path, fileexport.FormatNDJSON)
+	gt.NoError(t, err).Required()
+	tbl := sampleTable()
+	tbl.Name = "actions"
+	gt.NoError(t, second.WriteTable(context.Background(), "risk", tbl)).Required()
+	gt.NoError(t, second.Abort()).Required()
+
+	after, err := os.ReadFile(path)
+	gt.NoError(t, err).Required()
+	gt.Bool(t, bytes.Equal(before, after)).True()
+
+	// The sink is spent: Close publishes nothing and WriteTable is refused.
+	gt.NoError(t, second.Close())
+	after, err = os.ReadFile(path)
+	gt.NoError(t, err).Required()
+	gt.Bool(t, bytes.Equal(before, after)).True()
+	gt.Error(t, second.WriteTable(context.Background(), "risk", sampleTable()))
+}
+
+func TestSink_DirectoryAbortIsNoop(t *testing.T) {
+	dir := t.TempDir()
+	sink, err := fileexport.New(dir, fileexport.FormatNDJSON)
+	gt.NoError(t, err).Required()
+	gt.NoError(t, sink.WriteTable(context.Background(), "risk", sampleTable())).Required()
+	gt.NoError(t, sink.Abort())
+
+	_, err = os.Stat(filepath.Join(dir, "risk", "cases.ndjson"))
+	gt.NoError(t, err)
+}
+
+func tarballEntries(t *testing.T, path string) []string {
+	t.Helper()
+	archive, err := os.Open(path)
+	gt.NoError(t, err).Required()
+	defer archive.Close()
+	gz, err := gzip.NewReader(archive)
+	gt.NoError(t, err).Required()
+	tr := tar.NewReader(gz)
+	var names []string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		gt.NoError(t, err).Required()
+		names = append(names, hdr.Name)
+	}
+	return names
+}
+
+func TestSink_RejectsUnsafeNames(t *testing.T) {
+	sink, err := fileexport.New(t.TempDir(), fileexport.FormatNDJSON)
+	gt.NoError(t, err).Required()
+	gt.Error(t, sink.WriteTable(context.Background(), "../escape", sampleTable()))
+
+	tbl := sampleTable()
+	tbl.Name = "a/b"
+	gt.Error(t, sink.WriteTable(context.Background(), "risk", tbl))
+}
@@ -0,0 +1,98 @@
+package fileexport
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase/export"
+)
+
+// writeNDJSON encodes t as newline-delimited JSON: one object per row with
+// every column present, in column order. NULL cells are JSON null, array cells
+// are always arrays, and timestamps are RFC3339 with nanoseconds in UTC so a
+// consumer can sort them lexically.
+func writeNDJSON(w io.Writer, t *export.Table) error {
+	bw := bufio.NewWriter(w)
+	for i, row := range t.Rows {
+		line, err := encodeNDJSONRow(t.Columns, row)
+		if err != nil {
+			return goerr.Wrap(err, "failed to encode ndjson row", goerr.V("row_index", i))
+		}
+		if _, err := bw.Write(line); err != nil {
+			return goerr.Wrap(err, "failed to write ndjson row")
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		return goerr.Wrap(err, "failed to flush ndjson")
+	}
+	return nil
+}
+
+// encodeNDJSONRow renders one row, newline included. It is built by hand
+// rather than by marshalling the map so the key order follows the table's
+// columns instead of Go's sorted map keys.
+func encodeNDJSONRow(cols []export.Column, row map[string]any) ([]byte, error) {
+	out := []byte{'{'}
+	for i, c := range cols {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		key, err := json.Marshal(c.Name)
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to encode column name", goerr.V("column", c.Name))
+		}
+		out = append(out, key...)
+		out = append(out, ':')
+
+		v, err := ndjsonValue(c, row[c.Name])
+		if err != nil {
+			return nil, goerr.Wrap(err, "invalid cell", goerr.V("column", c.Name))
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to encode cell", goerr.V("column", c.Name))
+		}
+		out = append(out, b...)
+	}
+	return append(out, '}', '\n'), nil
+}
+
+// ndjsonValue normalises one cell for JSON.
+func ndjsonValue(c export.Column, v any) (any, error) {
+	if c.Repeated {
+		elems, err := sliceElements(v)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]any, 0, len(elems))
+		for _, e := range elems {
+			ev, err := ndjsonScalar(c.Type, e)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, ev)
+		}
+		return out, nil
+	}
+	if isNull(c.Type, v) {
+		return nil, nil
+	}
+	return ndjsonScalar(c.Type, v)
+}
+
+func ndjsonScalar(ct export.ColumnType, v any) (any, error) {
+	if ct != export.TypeTimestamp {
+		return v, nil
+	}
+	t, ok := toTime(v)
+	if !ok {
+		return nil, goerr.New("unexpected timestamp value type", goerr.V("go_type", typeName(v)))
+	}
+	if t.IsZero() {
+		return nil, nil
+	}
+	return t.UTC().Format(time.RFC3339Nano), nil
+}
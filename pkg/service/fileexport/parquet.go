@@ -0,0 +1,246 @@
+package fileexport
+
+import (
+	"io"
+
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/compress"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/schema"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase/export"
+)
+
+// Parquet files are written with arrow-go's low-level file writer from an
+// explicit schema: uncompressed, PLAIN-encoded column chunks, which the
+// writer splits into data pages of parquet.DefaultDataPageSize (1 MiB), and a
+// row group per parquetRowGroupRows rows. The rows are turned into column
+// values and levels here rather than through Arrow records, because an export
+// table is only known at runtime and its NULL and array cells follow the
+// export conventions in values.go.
+//
+// Column mapping:
+//   - STRING    → BYTE_ARRAY, annotated STRING (UTF8)
+//   - INT64     → INT64
+//   - FLOAT64   → DOUBLE
+//   - BOOL      → BOOLEAN
+//   - TIMESTAMP → INT64 microseconds, annotated TIMESTAMP(UTC, MICROS)
+//
+// A Repeated column is a bare `repeated` primitive, which the format defines
+// as a required list of required elements; a Nullable column is `optional`;
+// anything else is `required`.
+
+// parquetRowGroupRows bounds a row group. The table is already in memory, so
+// the bound only keeps each row group small enough for a reader to take in
+// one piece.
+const parquetRowGroupRows = 64 * 1024
+
+// writeParquet encodes t as one Parquet file into w.
+func writeParquet(w io.Writer, t *export.Table) error {
+	root, err := parquetSchema(t)
+	if err != nil {
+		return err
+	}
+	props := parquet.NewWriterProperties(
+		parquet.WithCompression(compress.Codecs.Uncompressed),
+		parquet.WithDictionaryDefault(false),
+		parquet.WithEncoding(parquet.Encodings.Plain),
+	)
+	// The writer closes a sink that is an io.Closer; the caller owns w, so
+	// only its Write is handed over.
+	pw := file.NewParquetWriter(struct{ io.Writer }{w}, root, file.WithWriterProps(props))
+
+	for start := 0; start < len(t.Rows); start += parquetRowGroupRows {
+		end := min(start+parquetRowGroupRows, len(t.Rows))
+		if err := writeRowGroup(pw, t.Columns, t.Rows[start:end], start); err != nil {
+			return err
+		}
+	}
+	if err := pw.Close(); err != nil {
+		return goerr.Wrap(err, "failed to finish parquet file")
+	}
+	return nil
+}
+
+// parquetSchema builds the flat schema of t: a root group and one leaf per
+// column.
+func parquetSchema(t *export.Table) (*schema.GroupNode, error) {
+	fields := make(schema.FieldList, 0, len(t.Columns))
+	for _, c := range t.Columns {
+		rep := parquet.Repetitions.Required
+		switch {
+		case c.Repeated:
+			rep = parquet.Repetitions.Repeated
+		case c.Nullable:
+			rep = parquet.Repetitions.Optional
+		}
+
+		var logical schema.LogicalType = schema.NoLogicalType{}
+		switch c.Type {
+		case export.TypeString:
+			logical = schema.StringLogicalType{}
+		case export.TypeTimestamp:
+			logical = schema.NewTimestampLogicalType(true, schema.TimeUnitMicros)
+		}
+
+		node, err := schema.NewPrimitiveNodeLogical(c.Name, rep, logical, physicalType(c.Type), -1, -1)
+		if err != nil {
+			return nil, goerr.Wrap(err, "invalid parquet column", goerr.V("column", c.Name))
+		}
+		fields = append(fields, node)
+	}
+	root, err := schema.NewGroupNode("schema", parquet.Repetitions.Required, fields, -1)
+	if err != nil {
+		return nil, goerr.Wrap(err, "invalid parquet schema", goerr.V("table", t.Name))
+	}
+	return root, nil
+}
+
+func physicalType(ct export.ColumnType) parquet.Type {
+	switch ct {
+	case export.TypeInt, export.TypeTimestamp:
+		return parquet.Types.Int64
+	case export.TypeFloat:
+		return parquet.Types.Double
+	case export.TypeBool:
+		return parquet.Types.Boolean
+	default:
+		return parquet.Types.ByteArray
+	}
+}
+
+// writeRowGroup writes rows as one row group, a column at a time. offset is
+// the index of rows[0] in the table, for error reports.
+func writeRowGroup(pw *file.Writer, columns []export.Column, rows []map[string]any, offset int) error {
+	rgw := pw.AppendRowGroup()
+	for _, c := range columns {
+		col := parquetColumn{column: c}
+		for i, row := range rows {
+			if err := col.appendCell(row[c.Name]); err != nil {
+				return goerr.Wrap(err, "invalid parquet cell",
+					goerr.V("column", c.Name), goerr.V("row_index", offset+i))
+			}
+		}
+
+		cw, err := rgw.NextColumn()
+		if err != nil {
+			return goerr.Wrap(err, "failed to start parquet column", goerr.V("column", c.Name))
+		}
+		if err := col.write(cw); err != nil {
+			return goerr.Wrap(err, "failed to write parquet column", goerr.V("column", c.Name))
+		}
+	}
+	if err := rgw.Close(); err != nil {
+		return goerr.Wrap(err, "failed to finish parquet row group")
+	}
+	return nil
+}
+
+// parquetColumn collects one column chunk: the non-NULL values in the slice
+// of the column's physical type, and a definition and repetition level per
+// entry. A NULL cell and an empty list each take one entry with no value.
+type parquetColumn struct {
+	column export.Column
+
+	ints    []int64
+	floats  []float64
+	bools   []bool
+	strings []parquet.ByteArray
+	def     []int16
+	rep     []int16
+}
+
+// appendCell appends the entries of one cell.
+func (col *parquetColumn) appendCell(v any) error {
+	ct := col.column.Type
+	switch {
+	case col.column.Repeated:
+		elems, err := sliceElements(v)
+		if err != nil {
+			return err
+		}
+		if len(elems) == 0 {
+			col.def = append(col.def, 0)
+			col.rep = append(col.rep, 0)
+			return nil
+		}
+		for j, e := range elems {
+			if err := col.appendValue(ct, e); err != nil {
+				return goerr.Wrap(err, "invalid array element", goerr.V("element_index", j))
+			}
+			col.def = append(col.def, 1)
+			col.rep = append(col.rep, min(int16(j), 1))
+		}
+	case col.column.Nullable:
+		if isNull(ct, v) {
+			col.def = append(col.def, 0)
+			return nil
+		}
+		if err := col.appendValue(ct, v); err != nil {
+			return err
+		}
+		col.def = append(col.def, 1)
+	default:
+		if isNull(ct, v) {
+			return goerr.New("required column has no value")
+		}
+		return col.appendValue(ct, v)
+	}
+	return nil
+}
+
+// appendValue converts one non-NULL cell to the column's physical type.
+func (col *parquetColumn) appendValue(ct export.ColumnType, v any) error {
+	switch ct {
+	case export.TypeInt:
+		n, ok := toInt64(v)
+		if !ok {
+			return goerr.New("unexpected integer value type", goerr.V("go_type", typeName(v)))
+		}
+		col.ints = append(col.ints, n)
+	case export.TypeFloat:
+		f, ok := toFloat64(v)
+		if !ok {
+			return goerr.New("unexpected float value type", goerr.V("go_type", typeName(v)))
+		}
+		col.floats = append(col.floats, f)
+	case export.TypeTimestamp:
+		ts, ok := toTime(v)
+		if !ok {
+			return goerr.New("unexpected timestamp value type", goerr.V("go_type", typeName(v)))
+		}
+		col.ints = append(col.ints, ts.UnixMicro())
+	case export.TypeBool:
+		b, ok := v.(bool)
+		if !ok {
+			return goerr.New("unexpected bool value type", goerr.V("go_type", typeName(v)))
+		}
+		col.bools = append(col.bools, b)
+	default:
+		s, ok := v.(string)
+		if !ok {
+			return goerr.New("unexpected string value type", goerr.V("go_type", typeName(v)))
+		}
+		col.strings = append(col.strings, parquet.ByteArray(s))
+	}
+	return nil
+}
+
+// write hands the collected chunk to the column writer, which encodes it and
+// cuts the data pages.
+func (col *parquetColumn) write(cw file.ColumnChunkWriter) error {
+	var err error
+	switch w := cw.(type) {
+	case *file.Int64ColumnChunkWriter:
+		_, err = w.WriteBatch(col.ints, col.def, col.rep)
+	case *file.Float64ColumnChunkWriter:
+		_, err = w.WriteBatch(col.floats, col.def, col.rep)
+	case *file.BooleanColumnChunkWriter:
+		_, err = w.WriteBatch(col.bools, col.def, col.rep)
+	case *file.ByteArrayColumnChunkWriter:
+		_, err = w.WriteBatch(col.strings, col.def, col.rep)
+	default:
+		return goerr.New("unexpected parquet column writer", goerr.V("go_type", typeName(cw)))
+	}
+	return err
+}
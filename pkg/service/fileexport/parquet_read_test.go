@@ -0,0 +1,120 @@
+package fileexport_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/compress"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/schema"
+	"github.com/m-mizutani/gt"
+)
+
+// parquetFile is what readParquet decodes: the row and row group counts, each
+// column's schema and number of data pages, and its cells re-assembled into
+// rows (nil for NULL, []any for a repeated column).
+type parquetFile struct {
+	numRows   int64
+	rowGroups int
+	schema    map[string]*schema.Column
+	pages     map[string]int
+	columns   map[string][]any
+}
+
+// readParquet reads a file written by the sink back with arrow-go's reader,
+// walking every column chunk's pages and values, so the assertions below check
+// the bytes on disk rather than what the writer was handed.
+func readParquet(t *testing.T, path string) parquetFile {
+	t.Helper()
+	r, err := file.OpenParquetFile(path, false)
+	gt.NoError(t, err).Required()
+	defer r.Close()
+
+	sch := r.MetaData().Schema
+	out := parquetFile{
+		numRows:   r.NumRows(),
+		rowGroups: r.NumRowGroups(),
+		schema:    map[string]*schema.Column{},
+		pages:     map[string]int{},
+		columns:   map[string][]any{},
+	}
+	for i := 0; i < sch.NumColumns(); i++ {
+		out.schema[sch.Column(i).Name()] = sch.Column(i)
+	}
+
+	for i := 0; i < r.NumRowGroups(); i++ {
+		rg := r.RowGroup(i)
+		for j := 0; j < sch.NumColumns(); j++ {
+			col := sch.Column(j)
+			md, err := rg.MetaData().ColumnChunk(j)
+			gt.NoError(t, err).Required()
+			gt.Value(t, md.Compression()).Equal(compress.Codecs.Uncompressed)
+
+			pr, err := rg.GetColumnPageReader(j)
+			gt.NoError(t, err).Required()
+			for pr.Next() {
+				page := pr.Page()
+				gt.Value(t, page.Type()).Equal(file.PageTypeDataPage)
+				gt.Value(t, parquet.Encoding(page.Encoding())).Equal(parquet.Encodings.Plain)
+				out.pages[col.Name()]++
+			}
+			gt.NoError(t, pr.Err()).Required()
+
+			cr, err := rg.Column(j)
+			gt.NoError(t, err).Required()
+			n := md.NumValues()
+			def := make([]int16, n)
+			rep := make([]int16, n)
+			var values []any
+			switch cr := cr.(type) {
+			case *file.Int64ColumnChunkReader:
+				values = readValues(t, cr.ReadBatch, n, def, rep, func(v int64) any { return v })
+			case *file.Float64ColumnChunkReader:
+				values = readValues(t, cr.ReadBatch, n, def, rep, func(v float64) any { return v })
+			case *file.BooleanColumnChunkReader:
+				values = readValues(t, cr.ReadBatch, n, def, rep, func(v bool) any { return v })
+			case *file.ByteArrayColumnChunkReader:
+				values = readValues(t, cr.ReadBatch, n, def, rep, func(v parquet.ByteArray) any { return string(v) })
+			default:
+				t.Fatalf("unexpected column reader %T", cr)
+			}
+
+			var rows []any
+			vi := 0
+			for k := range def {
+				var v any
+				if def[k] == col.MaxDefinitionLevel() {
+					v = values[vi]
+					vi++
+				}
+				if col.MaxRepetitionLevel() == 0 {
+					rows = append(rows, v)
+					continue
+				}
+				if rep[k] == 0 {
+					rows = append(rows, []any{})
+				}
+				if v != nil {
+					rows[len(rows)-1] = append(rows[len(rows)-1].([]any), v)
+				}
+			}
+			out.columns[col.Name()] = append(out.columns[col.Name()], rows...)
+		}
+	}
+	return out
+}
+
+// readValues reads the n levels of a column chunk into def and rep and returns
+// its non-NULL values.
+func readValues[T any](t *testing.T, read func(int64, []T, []int16, []int16) (int64, int, error), n int64, def, rep []int16, conv func(T) any) []any {
+	t.Helper()
+	buf := make([]T, n)
+	total, count, err := read(n, buf, def, rep)
+	gt.NoError(t, err).Required()
+	gt.Number(t, total).Equal(n)
+	out := make([]any, count)
+	for i, v := range buf[:count] {
+		out[i] = conv(v)
+	}
+	return out
+}
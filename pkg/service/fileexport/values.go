@@ -0,0 +1,87 @@
+package fileexport
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase/export"
+)
+
+// isNull reports whether a cell is NULL: absent, nil, a nil pointer, or a zero
+// timestamp (the exporter uses time.Time{} for "never set").
+func isNull(ct export.ColumnType, v any) bool {
+	if v == nil {
+		return true
+	}
+	if ct == export.TypeTimestamp {
+		t, ok := toTime(v)
+		return ok && t.IsZero()
+	}
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Pointer && rv.IsNil()
+}
+
+// sliceElements returns the elements of an array cell. NULL is an empty array.
+func sliceElements(v any) ([]any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if a, ok := v.([]any); ok {
+		return a, nil
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		return nil, goerr.New("array column holds a non-slice value", goerr.V("go_type", typeName(v)))
+	}
+	out := make([]any, rv.Len())
+	for i := range out {
+		out[i] = rv.Index(i).Interface()
+	}
+	return out, nil
+}
+
+func toInt64(v any) (int64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return n, true
+	case int:
+		return int64(n), true
+	case int32:
+		return int64(n), true
+	default:
+		return 0, false
+	}
+}
+
+func toFloat64(v any) (float64, bool) {
+	switch f := v.(type) {
+	case float64:
+		return f, true
+	case float32:
+		return float64(f), true
+	case int64:
+		return float64(f), true
+	case int:
+		return float64(f), true
+	default:
+		return 0, false
+	}
+}
+
+func toTime(v any) (time.Time, bool) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, true
+	case *time.Time:
+		if t == nil {
+			return time.Time{}, true
+		}
+		return *t, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+func typeName(v any) string { return fmt.Sprintf("%T", v) }
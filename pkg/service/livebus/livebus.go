@@ -0,0 +1,185 @@
+// Package livebus is the in-process fan-out behind the GraphQL subscriptions.
+// Writes publish a model.LiveEvent to the Bus; each open subscription holds a
+// Bus subscription on the topic it watches. With a relay attached, events
+// also travel to and from the other instances serving the same data.
+package livebus
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/async"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
+)
+
+// subscriberBuffer is how many undelivered events a subscription may queue
+// before further events are dropped for it.
+const subscriberBuffer = 64
+
+// Defaults for how long Run waits before re-opening a relay listener that
+// failed: the wait doubles after each failure in a row, up to the cap.
+const (
+	defaultRelayRetryMin = time.Second
+	defaultRelayRetryMax = time.Minute
+)
+
+// Bus is an interfaces.LiveEventBus.
+type Bus struct {
+	origin string
+	relay  interfaces.LiveEventRelay
+
+	retryMin time.Duration
+	retryMax time.Duration
+
+	mu   sync.RWMutex
+	subs map[string]map[chan *model.LiveEvent]struct{}
+}
+
+var _ interfaces.LiveEventBus = &Bus{}
+
+// Option configures a Bus.
+type Option func(*Bus)
+
+// WithRelay carries the bus's events to the other instances through relay.
+// Without one the bus only reaches subscriptions on this instance.
+func WithRelay(relay interfaces.LiveEventRelay) Option {
+	return func(b *Bus) {
+		b.relay = relay
+	}
+}
+
+// WithRelayRetry sets how long Run waits before re-opening a failed relay
+// listener: first after the first failure, doubling up to limit.
+func WithRelayRetry(first, limit time.Duration) Option {
+	return func(b *Bus) {
+		b.retryMin = first
+		b.retryMax = limit
+	}
+}
+
+// New creates a Bus with a fresh instance ID.
+func New(opts ...Option) *Bus {
+	b := &Bus{
+		origin:   uuid.NewString(),
+		subs:     map[string]map[chan *model.LiveEvent]struct{}{},
+		retryMin: defaultRelayRetryMin,
+		retryMax: defaultRelayRetryMax,
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+// Publish delivers ev to this instance's subscribers and, in the background,
+// sends it through the relay.
+func (b *Bus) Publish(ctx context.Context, ev *model.LiveEvent) {
+	ev.Origin = b.origin
+	if ev.PublishedAt.IsZero() {
+		ev.PublishedAt = time.Now()
+	}
+	b.deliver(ev)
+
+	if b.relay != nil {
+		async.Dispatch(ctx, func(ctx context.Context) error {
+			if err := b.relay.Send(ctx, ev); err != nil {
+				return goerr.Wrap(err, "failed to relay live event",
+					goerr.V("kind", ev.Kind), goerr.V("workspace_id", ev.WorkspaceID))
+			}
+			return nil
+		})
+	}
+}
+
+// Subscribe implements interfaces.LiveEventBus.
+func (b *Bus) Subscribe(ctx context.Context, topic string) <-chan *model.LiveEvent {
+	ch := make(chan *model.LiveEvent, subscriberBuffer)
+
+	b.mu.Lock()
+	if b.subs[topic] == nil {
+		b.subs[topic] = map[chan *model.LiveEvent]struct{}{}
+	}
+	b.subs[topic][ch] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.subs[topic], ch)
+		if len(b.subs[topic]) == 0 {
+			delete(b.subs, topic)
+		}
+		// Closed under the lock deliver holds, so no send can race it.
+		close(ch)
+		b.mu.Unlock()
+	}()
+
+	return ch
+}
+
+// Run delivers the events other instances send through the relay until ctx
+// is done. It returns at once when the bus has no relay.
+//
+// A listener that fails is reported and opened again after a backoff, so one
+// relay error does not silently end the fan-out from other instances for the
+// rest of the process. A listener that ran for longer than the backoff cap
+// counts as recovered, and the next failure waits the minimum again.
+func (b *Bus) Run(ctx context.Context) error {
+	if b.relay == nil {
+		return nil
+	}
+
+	wait := b.retryMin
+	for {
+		started := time.Now()
+		err := b.relay.Listen(ctx, func(ev *model.LiveEvent) {
+			// Our own events were delivered when they were published.
+			if ev.Origin == b.origin {
+				return
+			}
+			b.deliver(ev)
+		})
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err == nil {
+			err = goerr.New("live event relay listener ended")
+		}
+		if time.Since(started) > b.retryMax {
+			wait = b.retryMin
+		}
+		errutil.Handle(ctx, goerr.Wrap(err, "live event relay stopped; retrying",
+			goerr.V("retry_in", wait.String())), "live event relay stopped; retrying")
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+		}
+		wait = min(wait*2, b.retryMax)
+	}
+}
+
+func (b *Bus) deliver(ev *model.LiveEvent) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, topic := range []string{ev.Topic(), ev.WorkspaceTopic()} {
+		if topic == "" {
+			continue
+		}
+		for ch := range b.subs[topic] {
+			select {
+			case ch <- ev:
+			default:
+				// A subscriber this far behind re-reads the entity on its next
+				// event anyway; dropping keeps one stalled client from holding
+				// up every writer.
+			}
+		}
+	}
+}
@@ -0,0 +1,319 @@
+package livebus_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/service/livebus"
+)
+
+// fakeRelay hands every sent event to the active listener, the way the
+// Firestore relay echoes an instance's own writes back to it.
+type fakeRelay struct {
+	mu      sync.Mutex
+	deliver func(*model.LiveEvent)
+	ready   chan struct{}
+}
+
+func newFakeRelay() *fakeRelay {
+	return &fakeRelay{ready: make(chan struct{})}
+}
+
+func (r *fakeRelay) Send(_ context.Context, ev *model.LiveEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.deliver != nil {
+		r.deliver(ev)
+	}
+	return nil
+}
+
+func (r *fakeRelay) Listen(ctx context.Context, deliver func(*model.LiveEvent)) error {
+	r.mu.Lock()
+	r.deliver = deliver
+	r.mu.Unlock()
+	close(r.ready)
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// flakyRelay fails its first Listen at once, the way a Firestore listener
+// can drop, and behaves like fakeRelay from the second one on.
+type flakyRelay struct {
+	*fakeRelay
+	mu      sync.Mutex
+	listens int
+}
+
+func (r *flakyRelay) Listen(ctx context.Context, deliver func(*model.LiveEvent)) error {
+	r.mu.Lock()
+	r.listens++
+	first := r.listens == 1
+	r.mu.Unlock()
+	if first {
+		return errors.New("listener dropped")
+	}
+	return r.fakeRelay.Listen(ctx, deliver)
+}
+
+func receive(t *testing.T, ch <-chan *model.LiveEvent) *model.LiveEvent {
+	t.Helper()
+	select {
+	case ev := <-ch:
+		return ev
+	case <-time.After(time.Second):
+		t.Fatal("no live event received")
+		return nil
+	}
+}
+
+func noEvent(t *testing.T, ch <-chan *model.LiveEvent) {
+	t.Helper()
+	select {
+	case ev := <-ch:
+		t.Fatalf("unexpected live event: %+v", ev)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestBus(t *testing.T) {
+	t.Run("delivers events to the subscribers of their topic", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		bus := livebus.New()
+
+		wsA := bus.Subscribe(ctx, model.CaseLiveTopic("ws-a"))
+		wsB := bus.Subscribe(ctx, model.CaseLiveTopic("ws-b"))
+		bus.Publish(ctx, &model.LiveEvent{Kind: model.LiveEventCaseCreated, WorkspaceID: "ws-a", CaseID: 1})
+
+		ev := receive(t, wsA)
+		gt.Value(t, ev.CaseID).Equal(int64(1))
+		gt.String(t, ev.Origin).NotEqual("")
+		gt.Bool(t, ev.PublishedAt.IsZero()).False()
+		noEvent(t, wsB)
+	})
+
+	t.Run("also delivers case and action events on the workspace topic", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		bus := livebus.New()
+
+		ws := bus.Subscribe(ctx, model.WorkspaceLiveTopic("ws-a"))
+		actions := bus.Subscribe(ctx, model.ActionLiveTopic("ws-a", 1))
+		bus.Publish(ctx, &model.LiveEvent{Kind: model.LiveEventCaseUpdated, WorkspaceID: "ws-a", CaseID: 1})
+		bus.Publish(ctx, &model.LiveEvent{Kind: model.LiveEventActionCreated, WorkspaceID: "ws-a", CaseID: 1, ActionID: 7})
+		bus.Publish(ctx, &model.LiveEvent{Kind: model.LiveEventJobRunEvent, WorkspaceID: "ws-a", CaseID: 1, RunID: "r1"})
+
+		gt.Value(t, receive(t, ws).Kind).Equal(model.LiveEventCaseUpdated)
+		gt.Value(t, receive(t, ws).ActionID).Equal(int64(7))
+		noEvent(t, ws)
+		gt.Value(t, receive(t, actions).ActionID).Equal(int64(7))
+	})
+
+	t.Run("closes a subscription when its context ends", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		bus := livebus.New()
+		ch := bus.Subscribe(ctx, model.CaseLiveTopic("ws-a"))
+		cancel()
+
+		select {
+		case _, ok := <-ch:
+			gt.Bool(t, ok).False()
+		case <-time.After(time.Second):
+			t.Fatal("subscription was not closed")
+		}
+		// Publishing to a topic nobody watches any more is harmless.
+		bus.Publish(context.Background(), &model.LiveEvent{Kind: model.LiveEventCaseCreated, WorkspaceID: "ws-a", CaseID: 1})
+	})
+
+	t.Run("delivers relayed events from other instances once", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		relay := newFakeRelay()
+		bus := livebus.New(livebus.WithRelay(relay))
+		go func() { _ = bus.Run(ctx) }()
+		<-relay.ready
+
+		ch := bus.Subscribe(ctx, model.CaseLiveTopic("ws-a"))
+
+		// Another instance's event arrives through the relay.
+		gt.NoError(t, relay.Send(ctx, &model.LiveEvent{
+			Kind: model.LiveEventCaseUpdated, WorkspaceID: "ws-a", CaseID: 2, Origin: "other",
+		}))
+		gt.Value(t, receive(t, ch).CaseID).Equal(int64(2))
+
+		// Our own event is delivered when published, and skipped when the
+		// relay echoes it back.
+		bus.Publish(ctx, &model.LiveEvent{Kind: model.LiveEventCaseUpdated, WorkspaceID: "ws-a", CaseID: 3})
+		gt.Value(t, receive(t, ch).CaseID).Equal(int64(3))
+		noEvent(t, ch)
+	})
+
+	t.Run("listens again after the relay listener fails", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		relay := &flakyRelay{fakeRelay: newFakeRelay()}
+		bus := livebus.New(livebus.WithRelay(relay), livebus.WithRelayRetry(time.Millisecond, 10*time.Millisecond))
+		done := make(chan error, 1)
+		go func() { done <- bus.Run(ctx) }()
+
+		select {
+		case <-relay.ready:
+		case err := <-done:
+			t.Fatalf("Run returned after the listener failed: %v", err)
+		case <-time.After(time.Second):
+			t.Fatal("relay listener was not opened again")
+		}
+
+		ch := bus.Subscribe(ctx, model.CaseLiveTopic("ws-a"))
+		gt.NoError(t, relay.Send(ctx, &model.LiveEvent{
+			Kind: model.LiveEventCaseUpdated, WorkspaceID: "ws-a", CaseID: 4, Origin: "other",
+		}))
+		gt.Value(t, receive(t, ch).CaseID).Equal(int64(4))
+
+		cancel()
+		select {
+		case err := <-done:
+			gt.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("Run did not return after ctx was done")
+		}
+	})
+}
+
+func TestPublishingRepository(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bus := livebus.New()
+	repo := livebus.PublishingRepository(memory.New(), bus)
+	const ws = "ws-live"
+
+	cases := bus.Subscribe(ctx, model.CaseLiveTopic(ws))
+	now := time.Now().UTC()
+	c, err := repo.Case().Create(ctx, ws, &model.Case{
+		Title: "live", ReporterID: "U1", Status: types.CaseStatusOpen, CreatedAt: now, UpdatedAt: now,
+	})
+	gt.NoError(t, err).Required()
+	ev := receive(t, cases)
+	gt.Value(t, ev.Kind).Equal(model.LiveEventCaseCreated)
+	gt.Value(t, ev.CaseID).Equal(c.ID)
+
+	_, err = repo.Case().Transact(ctx, ws, c.ID, func(c *model.Case) error {
+		c.Title = "renamed"
+		return nil
+	})
+	gt.NoError(t, err).Required()
+	gt.Value(t, receive(t, cases).Kind).Equal(model.LiveEventCaseUpdated)
+
+	actions := bus.Subscribe(ctx, model.ActionLiveTopic(ws, c.ID))
+	a, err := repo.Action().Create(ctx, ws, &model.Action{
+		CaseID: c.ID, Title: "contain", Status: types.ActionStatusTodo, CreatedAt: now, UpdatedAt: now,
+	})
+	gt.NoError(t, err).Required()
+	ev = receive(t, actions)
+	gt.Value(t, ev.Kind).Equal(model.LiveEventActionCreated)
+	gt.Value(t, ev.ActionID).Equal(a.ID)
+
+	// Delete only has the action ID; the event still reaches the case's topic.
+	gt.NoError(t, repo.Action().Delete(ctx, ws, a.ID)).Required()
+	ev = receive(t, actions)
+	gt.Value(t, ev.Kind).Equal(model.LiveEventActionDeleted)
+	gt.Value(t, ev.ActionID).Equal(a.ID)
+
+	runEvents := bus.Subscribe(ctx, model.JobRunLiveTopic(ws, c.ID, "run-1"))
+	jev := &model.JobRunEvent{
+		WorkspaceID: ws, CaseID: c.ID, JobID: "job-1", RunID: "run-1", TraceID: "trace-1",
+		EventID: "ev-1", OccurredAt: now, Kind: model.JobRunEventKindLLMResponse, Phase: "execute",
+		LLMResponse: &model.LLMResponsePayload{Model: "test"},
+	}
+	gt.NoError(t, repo.JobRunEvent().AppendNext(ctx, jev)).Required()
+	ev = receive(t, runEvents)
+	gt.Value(t, ev.Kind).Equal(model.LiveEventJobRunEvent)
+	gt.Value(t, ev.Sequence).Equal(jev.Sequence)
+
+	gt.NoError(t, repo.Case().Delete(ctx, ws, c.ID)).Required()
+	gt.Value(t, receive(t, cases).Kind).Equal(model.LiveEventCaseDeleted)
+
+	// A failed write publishes nothing.
+	_, err = repo.Case().Update(ctx, ws, &model.Case{ID: 9999, Title: "missing", ReporterID: "U1"})
+	gt.Error(t, err)
+	noEvent(t, cases)
+}
+
+// A merge moves records with Action().Update and the MoveCase methods; the
+// watchers of both cases must hear about it.
+func TestPublishingRepository_Move(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bus := livebus.New()
+	repo := livebus.PublishingRepository(memory.New(), bus)
+	const ws = "ws-live-move"
+
+	now := time.Now().UTC()
+	newCase := func(title string) *model.Case {
+		c, err := repo.Case().Create(ctx, ws, &model.Case{
+			Title: title, ReporterID: "U1", Status: types.CaseStatusOpen, CreatedAt: now, UpdatedAt: now,
+		})
+		gt.NoError(t, err).Required()
+		return c
+	}
+	from, to := newCase("source"), newCase("target")
+	a, err := repo.Action().Create(ctx, ws, &model.Action{
+		CaseID: from.ID, Title: "contain", Status: types.ActionStatusTodo, CreatedAt: now, UpdatedAt: now,
+	})
+	gt.NoError(t, err).Required()
+
+	t.Run("an action moved to another case leaves the old case's topic", func(t *testing.T) {
+		fromActions := bus.Subscribe(ctx, model.ActionLiveTopic(ws, from.ID))
+		toActions := bus.Subscribe(ctx, model.ActionLiveTopic(ws, to.ID))
+
+		a.CaseID = to.ID
+		_, err := repo.Action().Update(ctx, ws, a)
+		gt.NoError(t, err).Required()
+
+		ev := receive(t, fromActions)
+		gt.Value(t, ev.Kind).Equal(model.LiveEventActionDeleted)
+		gt.Value(t, ev.ActionID).Equal(a.ID)
+		ev = receive(t, toActions)
+		gt.Value(t, ev.Kind).Equal(model.LiveEventActionUpdated)
+		gt.Value(t, ev.ActionID).Equal(a.ID)
+
+		// An update in place stays on its case's topic alone.
+		a.Title = "contain sender"
+		_, err = repo.Action().Update(ctx, ws, a)
+		gt.NoError(t, err).Required()
+		gt.Value(t, receive(t, toActions).Kind).Equal(model.LiveEventActionUpdated)
+		noEvent(t, fromActions)
+	})
+
+	t.Run("moving case records updates both cases", func(t *testing.T) {
+		moves := map[string]func() error{
+			"memos": func() error { return repo.Memo().MoveCase(ctx, ws, from.ID, to.ID, now) },
+			"messages": func() error {
+				return repo.CaseMessage().MoveCase(ctx, ws, from.ID, to.ID)
+			},
+			"job runs":     func() error { return repo.JobRun().MoveCase(ctx, ws, from.ID, to.ID) },
+			"alerts":       func() error { return repo.Alert().MoveCase(ctx, ws, from.ID, to.ID) },
+			"sla breaches": func() error { return repo.SLABreach().MoveCase(ctx, ws, from.ID, to.ID) },
+		}
+		for name, move := range moves {
+			t.Run(name, func(t *testing.T) {
+				cases := bus.Subscribe(ctx, model.CaseLiveTopic(ws))
+				gt.NoError(t, move()).Required()
+				for _, id := range []int64{from.ID, to.ID} {
+					ev := receive(t, cases)
+					gt.Value(t, ev.Kind).Equal(model.LiveEventCaseUpdated)
+					gt.Value(t, ev.CaseID).Equal(id)
+				}
+			})
+		}
+	})
+}
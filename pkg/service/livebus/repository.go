@@ -0,0 +1,282 @@
+package livebus
+
+import (
+	"context"
+	"time"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+// PublishingRepository wraps repo so every successful Case, Action and
+// JobRunEvent write publishes a live event on bus. Publishing from the
+// repository rather than from each usecase keeps the many write paths — the
+// web UI, Slack handlers, agent tools and the Job runtime, which appends
+// JobRunEvents below the usecase layer entirely — from each having to
+// remember it.
+//
+// A merge also moves the records a Case page shows — memos, messages, job
+// runs, alerts and SLA breaches — with MoveCase. Those have no live event of
+// their own, so each move publishes a Case update for both Cases, and the
+// pages watching either re-read it.
+func PublishingRepository(repo interfaces.Repository, bus interfaces.LiveEventBus) interfaces.Repository {
+	return &publishingRepository{
+		Repository: repo,
+		caseRepo:   &publishingCaseRepository{CaseRepository: repo.Case(), bus: bus},
+		action:     &publishingActionRepository{ActionRepository: repo.Action(), bus: bus},
+		jobRunEvent: &publishingJobRunEventRepository{
+			JobRunEventRepository: repo.JobRunEvent(),
+			bus:                   bus,
+		},
+		memo:        &publishingMemoRepository{MemoRepository: repo.Memo(), bus: bus},
+		caseMessage: &publishingCaseMessageRepository{CaseMessageRepository: repo.CaseMessage(), bus: bus},
+		jobRun:      &publishingJobRunRepository{JobRunRepository: repo.JobRun(), bus: bus},
+		alert:       &publishingAlertRepository{AlertRepository: repo.Alert(), bus: bus},
+		slaBreach:   &publishingSLABreachRepository{SLABreachRepository: repo.SLABreach(), bus: bus},
+	}
+}
+
+type publishingRepository struct {
+	interfaces.Repository
+	caseRepo    *publishingCaseRepository
+	action      *publishingActionRepository
+	jobRunEvent *publishingJobRunEventRepository
+	memo        *publishingMemoRepository
+	caseMessage *publishingCaseMessageRepository
+	jobRun      *publishingJobRunRepository
+	alert       *publishingAlertRepository
+	slaBreach   *publishingSLABreachRepository
+}
+
+func (r *publishingRepository) Case() interfaces.CaseRepository {
+	return r.caseRepo
+}
+
+func (r *publishingRepository) Action() interfaces.ActionRepository {
+	return r.action
+}
+
+func (r *publishingRepository) JobRunEvent() interfaces.JobRunEventRepository {
+	return r.jobRunEvent
+}
+
+func (r *publishingRepository) Memo() interfaces.MemoRepository {
+	return r.memo
+}
+
+func (r *publishingRepository) CaseMessage() interfaces.CaseMessageRepository {
+	return r.caseMessage
+}
+
+func (r *publishingRepository) JobRun() interfaces.JobRunRepository {
+	return r.jobRun
+}
+
+func (r *publishingRepository) Alert() interfaces.AlertRepository {
+	return r.alert
+}
+
+func (r *publishingRepository) SLABreach() interfaces.SLABreachRepository {
+	return r.slaBreach
+}
+
+// publishMove announces that records moved from one Case to another as an
+// update of both.
+func publishMove(ctx context.Context, bus interfaces.LiveEventBus, workspaceID string, fromCaseID, toCaseID int64) {
+	for _, id := range []int64{fromCaseID, toCaseID} {
+		bus.Publish(ctx, &model.LiveEvent{Kind: model.LiveEventCaseUpdated, WorkspaceID: workspaceID, CaseID: id})
+	}
+}
+
+type publishingCaseRepository struct {
+	interfaces.CaseRepository
+	bus interfaces.LiveEventBus
+}
+
+func (r *publishingCaseRepository) publish(ctx context.Context, kind model.LiveEventKind, workspaceID string, caseID int64) {
+	r.bus.Publish(ctx, &model.LiveEvent{Kind: kind, WorkspaceID: workspaceID, CaseID: caseID})
+}
+
+func (r *publishingCaseRepository) Create(ctx context.Context, workspaceID string, c *model.Case) (*model.Case, error) {
+	created, err := r.CaseRepository.Create(ctx, workspaceID, c)
+	if err != nil {
+		return nil, err
+	}
+	r.publish(ctx, model.LiveEventCaseCreated, workspaceID, created.ID)
+	return created, nil
+}
+
+func (r *publishingCaseRepository) Update(ctx context.Context, workspaceID string, c *model.Case) (*model.Case, error) {
+	updated, err := r.CaseRepository.Update(ctx, workspaceID, c)
+	if err != nil {
+		return nil, err
+	}
+	r.publish(ctx, model.LiveEventCaseUpdated, workspaceID, updated.ID)
+	return updated, nil
+}
+
+func (r *publishingCaseRepository) Transact(ctx context.Context, workspaceID string, id int64, fn func(*model.Case) error) (*model.Case, error) {
+	updated, err := r.CaseRepository.Transact(ctx, workspaceID, id, fn)
+	if err != nil {
+		return nil, err
+	}
+	r.publish(ctx, model.LiveEventCaseUpdated, workspaceID, id)
+	return updated, nil
+}
+
+func (r *publishingCaseRepository) Delete(ctx context.Context, workspaceID string, id int64) error {
+	if err := r.CaseRepository.Delete(ctx, workspaceID, id); err != nil {
+		return err
+	}
+	r.publish(ctx, model.LiveEventCaseDeleted, workspaceID, id)
+	return nil
+}
+
+type publishingActionRepository struct {
+	interfaces.ActionRepository
+	bus interfaces.LiveEventBus
+}
+
+func (r *publishingActionRepository) publish(ctx context.Context, kind model.LiveEventKind, workspaceID string, action *model.Action) {
+	r.bus.Publish(ctx, &model.LiveEvent{
+		Kind:        kind,
+		WorkspaceID: workspaceID,
+		CaseID:      action.CaseID,
+		ActionID:    action.ID,
+	})
+}
+
+func (r *publishingActionRepository) Create(ctx context.Context, workspaceID string, action *model.Action) (*model.Action, error) {
+	created, err := r.ActionRepository.Create(ctx, workspaceID, action)
+	if err != nil {
+		return nil, err
+	}
+	r.publish(ctx, model.LiveEventActionCreated, workspaceID, created)
+	return created, nil
+}
+
+// Update reads the action first, like Delete: an action moved to another case
+// leaves the old case's topic, which only the stored action names. It gets a
+// deletion there, so the old case's watchers drop it.
+func (r *publishingActionRepository) Update(ctx context.Context, workspaceID string, action *model.Action) (*model.Action, error) {
+	before, getErr := r.ActionRepository.Get(ctx, workspaceID, action.ID)
+	updated, err := r.ActionRepository.Update(ctx, workspaceID, action)
+	if err != nil {
+		return nil, err
+	}
+	if getErr == nil && before != nil && before.CaseID != updated.CaseID {
+		r.publish(ctx, model.LiveEventActionDeleted, workspaceID, before)
+	}
+	r.publish(ctx, model.LiveEventActionUpdated, workspaceID, updated)
+	return updated, nil
+}
+
+// Delete reads the action first: the event is routed by case, and only the
+// stored action knows which case it belonged to. An action that cannot be
+// read is deleted without an event.
+func (r *publishingActionRepository) Delete(ctx context.Context, workspaceID string, id int64) error {
+	action, getErr := r.ActionRepository.Get(ctx, workspaceID, id)
+	if err := r.ActionRepository.Delete(ctx, workspaceID, id); err != nil {
+		return err
+	}
+	if getErr == nil && action != nil {
+		r.publish(ctx, model.LiveEventActionDeleted, workspaceID, action)
+	}
+	return nil
+}
+
+type publishingJobRunEventRepository struct {
+	interfaces.JobRunEventRepository
+	bus interfaces.LiveEventBus
+}
+
+func (r *publishingJobRunEventRepository) publish(ctx context.Context, ev *model.JobRunEvent) {
+	r.bus.Publish(ctx, &model.LiveEvent{
+		Kind:        model.LiveEventJobRunEvent,
+		WorkspaceID: ev.WorkspaceID,
+		CaseID:      ev.CaseID,
+		RunID:       ev.RunID,
+		Sequence:    ev.Sequence,
+	})
+}
+
+func (r *publishingJobRunEventRepository) Append(ctx context.Context, ev *model.JobRunEvent) error {
+	if err := r.JobRunEventRepository.Append(ctx, ev); err != nil {
+		return err
+	}
+	r.publish(ctx, ev)
+	return nil
+}
+
+func (r *publishingJobRunEventRepository) AppendNext(ctx context.Context, ev *model.JobRunEvent) error {
+	if err := r.JobRunEventRepository.AppendNext(ctx, ev); err != nil {
+		return err
+	}
+	r.publish(ctx, ev)
+	return nil
+}
+
+type publishingMemoRepository struct {
+	interfaces.MemoRepository
+	bus interfaces.LiveEventBus
+}
+
+func (r *publishingMemoRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64, movedAt time.Time) error {
+	if err := r.MemoRepository.MoveCase(ctx, workspaceID, fromCaseID, toCaseID, movedAt); err != nil {
+		return err
+	}
+	publishMove(ctx, r.bus, workspaceID, fromCaseID, toCaseID)
+	return nil
+}
+
+type publishingCaseMessageRepository struct {
+	interfaces.CaseMessageRepository
+	bus interfaces.LiveEventBus
+}
+
+func (r *publishingCaseMessageRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error {
+	if err := r.CaseMessageRepository.MoveCase(ctx, workspaceID, fromCaseID, toCaseID); err != nil {
+		return err
+	}
+	publishMove(ctx, r.bus, workspaceID, fromCaseID, toCaseID)
+	return nil
+}
+
+type publishingJobRunRepository struct {
+	interfaces.JobRunRepository
+	bus interfaces.LiveEventBus
+}
+
+func (r *publishingJobRunRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error {
+	if err := r.JobRunRepository.MoveCase(ctx, workspaceID, fromCaseID, toCaseID); err != nil {
+		return err
+	}
+	publishMove(ctx, r.bus, workspaceID, fromCaseID, toCaseID)
+	return nil
+}
+
+type publishingAlertRepository struct {
+	interfaces.AlertRepository
+	bus interfaces.LiveEventBus
+}
+
+func (r *publishingAlertRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error {
+	if err := r.AlertRepository.MoveCase(ctx, workspaceID, fromCaseID, toCaseID); err != nil {
+		return err
+	}
+	publishMove(ctx, r.bus, workspaceID, fromCaseID, toCaseID)
+	return nil
+}
+
+type publishingSLABreachRepository struct {
+	interfaces.SLABreachRepository
+	bus interfaces.LiveEventBus
+}
+
+func (r *publishingSLABreachRepository) MoveCase(ctx context.Context, workspaceID string, fromCaseID, toCaseID int64) error {
+	if err := r.SLABreachRepository.MoveCase(ctx, workspaceID, fromCaseID, toCaseID); err != nil {
+		return err
+	}
+	publishMove(ctx, r.bus, workspaceID, fromCaseID, toCaseID)
+	return nil
+}
@@ -0,0 +1,23 @@
+package pgexport
+
+import "github.com/secmon-lab/hecatoncheires/pkg/usecase/export"
+
+// Test seams: expose the pure, connection-free helpers so the DDL and value
+// mapping can be unit-tested without a live database.
+
+var (
+	DDLColumnListForTest    = ddlColumnList
+	EncodeValueForTest      = encodeValue
+	StagingTableNameForTest = stagingTableName
+	MatchesCatalogForTest   = matchesCatalog
+	UpsertSQLForTest        = upsertSQL
+)
+
+// DeleteSQLForTest renders a delete predicate as its SQL and arguments.
+func DeleteSQLForTest(schema, table string, cols []export.Column, pred map[string]any) (string, []any, error) {
+	stmt, err := deleteSQL(schema, table, cols, pred)
+	return stmt.sql, stmt.args, err
+}
+
+// CatalogColumnForTest is a destination column as read from the catalog.
+type CatalogColumnForTest = catalogColumn
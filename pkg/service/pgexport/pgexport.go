@@ -0,0 +1,572 @@
+// Package pgexport is the PostgreSQL implementation of export.Sink. Each
+// export namespace is a Postgres schema and each WriteTable is a full refresh
+// of <schema>.<table>, done in a single transaction. When the destination
+// already has exactly the desired columns it is truncated and the rows are
+// COPYed straight into it, so views and grants on it survive. Otherwise (first
+// run, or the schema changed) the rows are COPYed into a uniquely-named staging
+// table created from the desired schema, the destination is dropped, and the
+// staging table is renamed into its place.
+//
+// Postgres DDL is transactional, so readers keep seeing the previous snapshot
+// until the commit and a failure at any step rolls back to it — there is no
+// orphaned staging table to clean up afterwards. Either way the destination's
+// schema is exactly the desired schema after every run; an existing table is
+// compared with it but never migrated.
+//
+// The sink is also an export.Merger: MergeTable applies an incremental delta to
+// a table an earlier WriteTable created, in one transaction — the delete
+// predicates run first, then the rows are COPYed into a temporary table and
+// replace the destination rows with the same key.
+package pgexport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase/export"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
+)
+
+// maxIdentLength is Postgres's NAMEDATALEN-1. Longer identifiers are silently
+// truncated by the server, which could make two names collide, so they are
+// refused instead.
+const maxIdentLength = 63
+
+// stagingSuffixLength is the number of random hex characters in a staging
+// table name. It is shorter than a full uuid so table names keep room under
+// maxIdentLength.
+const stagingSuffixLength = 16
+
+// pgDependentObjectsStillExist is the SQLSTATE of a DROP refused because a view
+// (or another object) depends on the table.
+const pgDependentObjectsStillExist = "2BP01"
+
+// safeIdentPattern bounds schema, table and column names before they are
+// interpolated into SQL. They are quoted as well, but the sink does not trust
+// its caller (the config layer validates too).
+var safeIdentPattern = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// Sink writes export tables to PostgreSQL over a single connection. The
+// Exporter writes tables serially, so a pool would buy nothing.
+type Sink struct {
+	conn *pgx.Conn
+}
+
+var (
+	_ export.Sink   = (*Sink)(nil)
+	_ export.Merger = (*Sink)(nil)
+)
+
+// New connects to the database at dsn (a libpq URL or keyword/value string).
+func New(ctx context.Context, dsn string) (*Sink, error) {
+	if dsn == "" {
+		return nil, goerr.New("postgres DSN is required")
+	}
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		// The DSN may embed a password, so it is deliberately not attached.
+		return nil, goerr.Wrap(err, "failed to connect to postgres")
+	}
+	return &Sink{conn: conn}, nil
+}
+
+// Close closes the connection.
+func (s *Sink) Close() error {
+	return s.conn.Close(context.Background())
+}
+
+// WriteTable fully refreshes schema.table. The destination is replaced only at
+// commit, so a failure anywhere leaves the previous snapshot intact.
+func (s *Sink) WriteTable(ctx context.Context, schema string, t *export.Table) error {
+	if err := validateTable(schema, t); err != nil {
+		return err
+	}
+	staging := stagingTableName(t.Name)
+	if err := validateIdent(staging); err != nil {
+		return goerr.Wrap(err, "generated an invalid staging table name",
+			goerr.V("schema", schema), goerr.V("table", t.Name), goerr.V("staging", staging))
+	}
+
+	rows, err := copyRows(t.Columns, t.Rows)
+	if err != nil {
+		return goerr.Wrap(err, "failed to encode export rows",
+			goerr.V("schema", schema), goerr.V("table", t.Name))
+	}
+
+	tx, err := s.conn.Begin(ctx)
+	if err != nil {
+		return goerr.Wrap(err, "failed to begin export transaction")
+	}
+	defer func() {
+		// A no-op after a successful commit.
+		if err := tx.Rollback(context.WithoutCancel(ctx)); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			errutil.Handle(ctx, goerr.Wrap(err, "failed to roll back export transaction",
+				goerr.V("schema", schema), goerr.V("table", t.Name)), "roll back export table refresh")
+		}
+	}()
+
+	// Every identifier was validated above.
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`CREATE SCHEMA IF NOT EXISTS %s`, quoteIdent(schema))); err != nil {
+		return goerr.Wrap(err, "failed to create export schema", goerr.V("schema", schema))
+	}
+
+	existing, err := existingColumns(ctx, tx, schema, t.Name)
+	if err != nil {
+		return err
+	}
+	if matchesCatalog(existing, t.Columns) {
+		err = refreshInPlace(ctx, tx, schema, t, rows)
+	} else {
+		err = replaceViaStaging(ctx, tx, schema, staging, t, rows)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return goerr.Wrap(err, "failed to commit export table refresh",
+			goerr.V("schema", schema), goerr.V("table", t.Name))
+	}
+	return nil
+}
+
+// MergeTable applies an incremental delta to schema.table: it runs the delete
+// predicates, then replaces every destination row whose key matches an incoming
+// row. The destination must already have exactly the delta's columns (written
+// by a full refresh); otherwise nothing is changed and the error asks for a
+// full export.
+func (s *Sink) MergeTable(ctx context.Context, schema string, d *export.Delta) error {
+	t := d.Table
+	if err := validateTable(schema, t); err != nil {
+		return err
+	}
+	if len(d.Key) == 0 {
+		return goerr.New("merge key is required", goerr.V("schema", schema), goerr.V("table", t.Name))
+	}
+	for _, k := range d.Key {
+		if !hasColumn(t.Columns, k) {
+			return goerr.New("merge key is not a column of the table",
+				goerr.V("schema", schema), goerr.V("table", t.Name), goerr.V("column", k))
+		}
+	}
+	deletes := make([]deleteStatement, 0, len(d.Deletes))
+	for _, pred := range d.Deletes {
+		stmt, err := deleteSQL(schema, t.Name, t.Columns, pred)
+		if err != nil {
+			return goerr.Wrap(err, "invalid delete predicate",
+				goerr.V("schema", schema), goerr.V("table", t.Name))
+		}
+		deletes = append(deletes, stmt)
+	}
+	tmp := stagingTableName(t.Name)
+	if err := validateIdent(tmp); err != nil {
+		return goerr.Wrap(err, "generated an invalid staging table name",
+			goerr.V("schema", schema), goerr.V("table", t.Name), goerr.V("staging", tmp))
+	}
+
+	rows, err := copyRows(t.Columns, t.Rows)
+	if err != nil {
+		return goerr.Wrap(err, "failed to encode export rows",
+			goerr.V("schema", schema), goerr.V("table", t.Name))
+	}
+
+	tx, err := s.conn.Begin(ctx)
+	if err != nil {
+		return goerr.Wrap(err, "failed to begin export transaction")
+	}
+	defer func() {
+		// A no-op after a successful commit.
+		if err := tx.Rollback(context.WithoutCancel(ctx)); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			errutil.Handle(ctx, goerr.Wrap(err, "failed to roll back export transaction",
+				goerr.V("schema", schema), goerr.V("table", t.Name)), "roll back export table merge")
+		}
+	}()
+
+	existing, err := existingColumns(ctx, tx, schema, t.Name)
+	if err != nil {
+		return err
+	}
+	if !matchesCatalog(existing, t.Columns) {
+		return goerr.New("export table is missing or its schema changed; run a full export",
+			goerr.V("schema", schema), goerr.V("table", t.Name))
+	}
+
+	for _, del := range deletes {
+		if _, err := tx.Exec(ctx, del.sql, del.args...); err != nil {
+			return goerr.Wrap(err, "failed to delete rows from export table",
+				goerr.V("schema", schema), goerr.V("table", t.Name))
+		}
+	}
+
+	if len(rows) > 0 {
+		// The temporary table lives in pg_temp and is dropped at commit. It is
+		// created LIKE the destination so INSERT ... SELECT * lines up.
+		create := fmt.Sprintf(`CREATE TEMP TABLE %s (LIKE %s.%s) ON COMMIT DROP`,
+			quoteIdent(tmp), quoteIdent(schema), quoteIdent(t.Name))
+		if _, err := tx.Exec(ctx, create); err != nil {
+			return goerr.Wrap(err, "failed to prepare merge table",
+				goerr.V("schema", schema), goerr.V("table", t.Name), goerr.V("staging", tmp))
+		}
+		if err := copyInto(ctx, tx, "pg_temp", tmp, t.Columns, rows); err != nil {
+			return goerr.Wrap(err, "failed to copy rows into merge table",
+				goerr.V("schema", schema), goerr.V("table", t.Name), goerr.V("staging", tmp))
+		}
+		for _, q := range upsertSQL(schema, t.Name, tmp, d.Key) {
+			if _, err := tx.Exec(ctx, q); err != nil {
+				return goerr.Wrap(err, "failed to merge rows into export table",
+					goerr.V("schema", schema), goerr.V("table", t.Name))
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return goerr.Wrap(err, "failed to commit export table merge",
+			goerr.V("schema", schema), goerr.V("table", t.Name))
+	}
+	return nil
+}
+
+// validateTable checks every identifier WriteTable and MergeTable interpolate
+// into SQL.
+func validateTable(schema string, t *export.Table) error {
+	if err := validateIdent(schema); err != nil {
+		return goerr.Wrap(err, "invalid schema name for export", goerr.V("schema", schema))
+	}
+	if err := validateIdent(t.Name); err != nil {
+		return goerr.Wrap(err, "invalid table name for export", goerr.V("table", t.Name))
+	}
+	if len(t.Columns) == 0 {
+		return goerr.New("export table has no columns",
+			goerr.V("schema", schema), goerr.V("table", t.Name))
+	}
+	for _, c := range t.Columns {
+		if err := validateIdent(c.Name); err != nil {
+			return goerr.Wrap(err, "invalid column name for export",
+				goerr.V("schema", schema), goerr.V("table", t.Name), goerr.V("column", c.Name))
+		}
+	}
+	return nil
+}
+
+// deleteStatement is one parameterized DELETE of a merge.
+type deleteStatement struct {
+	sql  string
+	args []any
+}
+
+// deleteSQL renders one delete predicate (column = value, ANDed) as a
+// parameterized DELETE. Columns are sorted so the statement is deterministic,
+// and each must be a non-array column of the table.
+func deleteSQL(schema, table string, cols []export.Column, pred map[string]any) (deleteStatement, error) {
+	if len(pred) == 0 {
+		return deleteStatement{}, goerr.New("delete predicate is empty")
+	}
+	names := make([]string, 0, len(pred))
+	for name := range pred {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	conds := make([]string, 0, len(names))
+	args := make([]any, 0, len(names))
+	for i, name := range names {
+		idx := slices.IndexFunc(cols, func(c export.Column) bool { return c.Name == name })
+		if idx < 0 || cols[idx].Repeated {
+			return deleteStatement{}, goerr.New("delete predicate column is not a scalar column of the table",
+				goerr.V("column", name))
+		}
+		v, err := encodeValue(cols[idx], pred[name])
+		if err != nil {
+			return deleteStatement{}, goerr.Wrap(err, "invalid delete predicate value", goerr.V("column", name))
+		}
+		conds = append(conds, fmt.Sprintf("%s = $%d", quoteIdent(name), i+1))
+		args = append(args, v)
+	}
+	return deleteStatement{
+		sql:  fmt.Sprintf(`DELETE FROM %s.%s WHERE %s`, quoteIdent(schema), quoteIdent(table), strings.Join(conds, " AND ")),
+		args: args,
+	}, nil
+}
+
+// upsertSQL renders the two statements that replace the destination rows
+// keyed like the rows in the temporary table.
+func upsertSQL(schema, table, tmp string, key []string) []string {
+	conds := make([]string, 0, len(key))
+	for _, k := range key {
+		conds = append(conds, fmt.Sprintf("d.%s = m.%s", quoteIdent(k), quoteIdent(k)))
+	}
+	return []string{
+		fmt.Sprintf(`DELETE FROM %s.%s AS d USING pg_temp.%s AS m WHERE %s`,
+			quoteIdent(schema), quoteIdent(table), quoteIdent(tmp), strings.Join(conds, " AND ")),
+		fmt.Sprintf(`INSERT INTO %s.%s SELECT * FROM pg_temp.%s`,
+			quoteIdent(schema), quoteIdent(table), quoteIdent(tmp)),
+	}
+}
+
+func hasColumn(cols []export.Column, name string) bool {
+	return slices.ContainsFunc(cols, func(c export.Column) bool { return c.Name == name })
+}
+
+// refreshInPlace empties the destination and COPYs the rows into it. Used when
+// its columns already match, so objects depending on it (views, grants) are
+// kept. An empty row set still truncates: a refresh that produced no rows must
+// leave the destination empty, not stale.
+func refreshInPlace(ctx context.Context, tx pgx.Tx, schema string, t *export.Table, rows [][]any) error {
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`TRUNCATE TABLE %s.%s`, quoteIdent(schema), quoteIdent(t.Name))); err != nil {
+		return goerr.Wrap(err, "failed to truncate export table",
+			goerr.V("schema", schema), goerr.V("table", t.Name))
+	}
+	if err := copyInto(ctx, tx, schema, t.Name, t.Columns, rows); err != nil {
+		return goerr.Wrap(err, "failed to copy rows into export table",
+			goerr.V("schema", schema), goerr.V("table", t.Name))
+	}
+	return nil
+}
+
+// replaceViaStaging builds the table under a staging name, drops the
+// destination and renames the staging table into its place. Used on the first
+// run and when the schema changed; a dependent view makes the drop fail, and
+// the error says so rather than cascading into objects the sink does not own.
+func replaceViaStaging(ctx context.Context, tx pgx.Tx, schema, staging string, t *export.Table, rows [][]any) error {
+	create := fmt.Sprintf(`CREATE TABLE %s.%s (%s)`, quoteIdent(schema), quoteIdent(staging), ddlColumnList(t.Columns))
+	if _, err := tx.Exec(ctx, create); err != nil {
+		return goerr.Wrap(err, "failed to prepare staging table",
+			goerr.V("schema", schema), goerr.V("table", t.Name), goerr.V("staging", staging))
+	}
+	if err := copyInto(ctx, tx, schema, staging, t.Columns, rows); err != nil {
+		return goerr.Wrap(err, "failed to copy rows into staging table",
+			goerr.V("schema", schema), goerr.V("table", t.Name), goerr.V("staging", staging))
+	}
+
+	// An empty staging table still goes through the swap: a refresh that
+	// produced no rows must leave the destination empty, not stale.
+	swap := []string{
+		fmt.Sprintf(`DROP TABLE IF EXISTS %s.%s`, quoteIdent(schema), quoteIdent(t.Name)),
+		fmt.Sprintf(`ALTER TABLE %s.%s RENAME TO %s`, quoteIdent(schema), quoteIdent(staging), quoteIdent(t.Name)),
+	}
+	for _, q := range swap {
+		if _, err := tx.Exec(ctx, q); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == pgDependentObjectsStillExist {
+				return goerr.Wrap(err, "export table schema changed and other objects depend on it; drop them and recreate them after the run",
+					goerr.V("schema", schema), goerr.V("table", t.Name), goerr.V("detail", pgErr.Detail))
+			}
+			return goerr.Wrap(err, "failed to swap export table",
+				goerr.V("schema", schema), goerr.V("table", t.Name), goerr.V("staging", staging))
+		}
+	}
+	return nil
+}
+
+func copyInto(ctx context.Context, tx pgx.Tx, schema, table string, cols []export.Column, rows [][]any) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	names := make([]string, len(cols))
+	for i, c := range cols {
+		names[i] = c.Name
+	}
+	_, err := tx.CopyFrom(ctx, pgx.Identifier{schema, table}, names, pgx.CopyFromRows(rows))
+	return err
+}
+
+// catalogColumn is one column of an existing table as the catalog reports it.
+type catalogColumn struct {
+	Name    string
+	Type    string // format_type() output, e.g. "timestamp with time zone", "text[]"
+	NotNull bool
+}
+
+// existingColumns returns the destination's columns in order, or nil when it
+// does not exist (or is not a plain table).
+func existingColumns(ctx context.Context, tx pgx.Tx, schema, table string) ([]catalogColumn, error) {
+	rows, err := tx.Query(ctx, `
+SELECT a.attname, format_type(a.atttypid, a.atttypmod), a.attnotnull
+FROM pg_attribute a
+JOIN pg_class c ON c.oid = a.attrelid
+JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = $1 AND c.relname = $2 AND c.relkind = 'r'
+  AND a.attnum > 0 AND NOT a.attisdropped
+ORDER BY a.attnum`, schema, table)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to inspect export table",
+			goerr.V("schema", schema), goerr.V("table", table))
+	}
+	cols, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (catalogColumn, error) {
+		var c catalogColumn
+		err := row.Scan(&c.Name, &c.Type, &c.NotNull)
+		return c, err
+	})
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to read export table columns",
+			goerr.V("schema", schema), goerr.V("table", table))
+	}
+	return cols, nil
+}
+
+// matchesCatalog reports whether an existing table has exactly the columns
+// ddlColumnList would create — same names, order, types and nullability — so
+// it can be refreshed in place.
+func matchesCatalog(existing []catalogColumn, cols []export.Column) bool {
+	if len(existing) != len(cols) {
+		return false
+	}
+	for i, c := range cols {
+		want := catalogTypeName(c.Type)
+		if c.Repeated {
+			want += "[]"
+		}
+		e := existing[i]
+		if e.Name != c.Name || e.Type != want || e.NotNull != (c.Repeated || !c.Nullable) {
+			return false
+		}
+	}
+	return true
+}
+
+// catalogTypeName is ddlTypeName as format_type() spells it.
+func catalogTypeName(ct export.ColumnType) string {
+	if ct == export.TypeTimestamp {
+		return "timestamp with time zone"
+	}
+	return ddlTypeName(ct)
+}
+
+func validateIdent(name string) error {
+	if !safeIdentPattern.MatchString(name) {
+		return goerr.New("identifier must match [A-Za-z0-9_]+")
+	}
+	if len(name) > maxIdentLength {
+		return goerr.New("identifier is longer than postgres allows", goerr.V("max", maxIdentLength))
+	}
+	return nil
+}
+
+// quoteIdent double-quotes an already-validated identifier so the case the
+// export chose is preserved rather than folded to lower case.
+func quoteIdent(name string) string {
+	return `"` + name + `"`
+}
+
+// stagingTableName derives the throwaway table name for one WriteTable call.
+func stagingTableName(table string) string {
+	return table + "_stg_" + strings.ReplaceAll(uuid.NewString(), "-", "")[:stagingSuffixLength]
+}
+
+// ddlColumnList renders the table's column declarations. Array columns are
+// NOT NULL: a row with no elements holds an empty array, matching BigQuery's
+// REPEATED mode.
+func ddlColumnList(cols []export.Column) string {
+	parts := make([]string, 0, len(cols))
+	for _, c := range cols {
+		switch {
+		case c.Repeated:
+			parts = append(parts, fmt.Sprintf("%s %s[] NOT NULL", quoteIdent(c.Name), ddlTypeName(c.Type)))
+		case c.Nullable:
+			parts = append(parts, fmt.Sprintf("%s %s", quoteIdent(c.Name), ddlTypeName(c.Type)))
+		default:
+			parts = append(parts, fmt.Sprintf("%s %s NOT NULL", quoteIdent(c.Name), ddlTypeName(c.Type)))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ddlTypeName maps a logical column type to its Postgres type name.
+func ddlTypeName(ct export.ColumnType) string {
+	switch ct {
+	case export.TypeInt:
+		return "bigint"
+	case export.TypeFloat:
+		return "double precision"
+	case export.TypeBool:
+		return "boolean"
+	case export.TypeTimestamp:
+		return "timestamptz"
+	default:
+		return "text"
+	}
+}
+
+// copyRows lays the rows out in column order for COPY.
+func copyRows(cols []export.Column, rows []map[string]any) ([][]any, error) {
+	out := make([][]any, 0, len(rows))
+	for i, row := range rows {
+		vals := make([]any, len(cols))
+		for j, c := range cols {
+			v, err := encodeValue(c, row[c.Name])
+			if err != nil {
+				return nil, goerr.Wrap(err, "invalid cell", goerr.V("row_index", i), goerr.V("column", c.Name))
+			}
+			vals[j] = v
+		}
+		out = append(out, vals)
+	}
+	return out, nil
+}
+
+// encodeValue converts a natural Go value to one pgx can COPY into the
+// column's type: a zero or nil timestamp becomes NULL and a nil array becomes
+// an empty one.
+func encodeValue(c export.Column, v any) (any, error) {
+	if c.Repeated {
+		if v == nil {
+			return reflect.MakeSlice(reflect.SliceOf(goType(c.Type)), 0, 0).Interface(), nil
+		}
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Slice {
+			return nil, goerr.New("array column holds a non-slice value", goerr.V("go_type", fmt.Sprintf("%T", v)))
+		}
+		if rv.IsNil() {
+			return reflect.MakeSlice(rv.Type(), 0, 0).Interface(), nil
+		}
+		return v, nil
+	}
+	if v == nil {
+		return nil, nil
+	}
+	if c.Type == export.TypeTimestamp {
+		switch t := v.(type) {
+		case time.Time:
+			if t.IsZero() {
+				return nil, nil
+			}
+			return t, nil
+		case *time.Time:
+			if t == nil || t.IsZero() {
+				return nil, nil
+			}
+			return *t, nil
+		default:
+			return nil, goerr.New("unexpected timestamp value type",
+				goerr.V("go_type", fmt.Sprintf("%T", v)))
+		}
+	}
+	return v, nil
+}
+
+// goType is the Go element type pgx encodes for a logical column type; it
+// types the empty array substituted for a nil one.
+func goType(ct export.ColumnType) reflect.Type {
+	switch ct {
+	case export.TypeInt:
+		return reflect.TypeFor[int64]()
+	case export.TypeFloat:
+		return reflect.TypeFor[float64]()
+	case export.TypeBool:
+		return reflect.TypeFor[bool]()
+	case export.TypeTimestamp:
+		return reflect.TypeFor[time.Time]()
+	default:
+		return reflect.TypeFor[string]()
+	}
+}
@@ -0,0 +1,321 @@
+package pgexport_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/service/pgexport"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase/export"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/safe"
+)
+
+func TestDDLColumnList(t *testing.T) {
+	got := pgexport.DDLColumnListForTest([]export.Column{
+		{Name: "id", Type: export.TypeInt},
+		{Name: "title", Type: export.TypeString, Nullable: true},
+		{Name: "score", Type: export.TypeFloat, Nullable: true},
+		{Name: "flag", Type: export.TypeBool, Nullable: true},
+		{Name: "at", Type: export.TypeTimestamp, Nullable: true},
+		{Name: "labels", Type: export.TypeString, Repeated: true},
+	})
+	gt.String(t, got).Equal(`"id" bigint NOT NULL, "title" text, "score" double precision, ` +
+		`"flag" boolean, "at" timestamptz, "labels" text[] NOT NULL`)
+}
+
+func TestMatchesCatalog(t *testing.T) {
+	cols := []export.Column{
+		{Name: "id", Type: export.TypeInt},
+		{Name: "at", Type: export.TypeTimestamp, Nullable: true},
+		{Name: "labels", Type: export.TypeString, Repeated: true},
+	}
+	existing := []pgexport.CatalogColumnForTest{
+		{Name: "id", Type: "bigint", NotNull: true},
+		{Name: "at", Type: "timestamp with time zone"},
+		{Name: "labels", Type: "text[]", NotNull: true},
+	}
+	gt.Bool(t, pgexport.MatchesCatalogForTest(existing, cols)).True()
+
+	t.Run("missing table", func(t *testing.T) {
+		gt.Bool(t, pgexport.MatchesCatalogForTest(nil, cols)).False()
+	})
+	t.Run("added column", func(t *testing.T) {
+		more := append(append([]export.Column{}, cols...), export.Column{Name: "x", Type: export.TypeBool, Nullable: true})
+		gt.Bool(t, pgexport.MatchesCatalogForTest(existing, more)).False()
+	})
+	t.Run("reordered columns", func(t *testing.T) {
+		swapped := []pgexport.CatalogColumnForTest{existing[1], existing[0], existing[2]}
+		gt.Bool(t, pgexport.MatchesCatalogForTest(swapped, cols)).False()
+	})
+	t.Run("nullability changed", func(t *testing.T) {
+		changed := append([]pgexport.CatalogColumnForTest{}, existing...)
+		changed[1].NotNull = true
+		gt.Bool(t, pgexport.MatchesCatalogForTest(changed, cols)).False()
+	})
+	t.Run("type changed", func(t *testing.T) {
+		changed := append([]pgexport.CatalogColumnForTest{}, existing...)
+		changed[0].Type = "integer"
+		gt.Bool(t, pgexport.MatchesCatalogForTest(changed, cols)).False()
+	})
+}
+
+func TestStagingTableName(t *testing.T) {
+	first := pgexport.StagingTableNameForTest("job_run_events")
+	second := pgexport.StagingTableNameForTest("job_run_events")
+
+	gt.Bool(t, strings.HasPrefix(first, "job_run_events_stg_")).True()
+	gt.Value(t, first).NotEqual(second)
+	gt.Number(t, len(strings.TrimPrefix(first, "job_run_events_stg_"))).Equal(16)
+}
+
+func TestEncodeValue(t *testing.T) {
+	ts := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("zero timestamp is NULL", func(t *testing.T) {
+		v, err := pgexport.EncodeValueForTest(export.Column{Type: export.TypeTimestamp, Nullable: true}, time.Time{})
+		gt.NoError(t, err)
+		gt.Value(t, v).Nil()
+	})
+
+	t.Run("timestamp pointer is dereferenced", func(t *testing.T) {
+		v, err := pgexport.EncodeValueForTest(export.Column{Type: export.TypeTimestamp, Nullable: true}, &ts)
+		gt.NoError(t, err)
+		gt.Value(t, v).Equal(any(ts))
+	})
+
+	t.Run("nil array becomes an empty array", func(t *testing.T) {
+		v, err := pgexport.EncodeValueForTest(export.Column{Type: export.TypeString, Repeated: true}, nil)
+		gt.NoError(t, err)
+		gt.Value(t, v).Equal(any([]string{}))
+
+		v, err = pgexport.EncodeValueForTest(export.Column{Type: export.TypeString, Repeated: true}, []string(nil))
+		gt.NoError(t, err)
+		gt.Value(t, v).Equal(any([]string{}))
+	})
+
+	t.Run("array column rejects a scalar", func(t *testing.T) {
+		_, err := pgexport.EncodeValueForTest(export.Column{Type: export.TypeString, Repeated: true}, "x")
+		gt.Error(t, err)
+	})
+
+	t.Run("unexpected timestamp type is rejected", func(t *testing.T) {
+		_, err := pgexport.EncodeValueForTest(export.Column{Type: export.TypeTimestamp}, "2026-01-02")
+		gt.Error(t, err)
+	})
+}
+
+func TestSink_LivePostgres(t *testing.T) {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN not set; skipping live Postgres sink test")
+	}
+	ctx := context.Background()
+	sink, err := pgexport.New(ctx, dsn)
+	gt.NoError(t, err).Required()
+	defer safe.Close(ctx, sink)
+
+	schema := fmt.Sprintf("hecaton_test_%d", time.Now().UnixNano())
+	conn, err := pgx.Connect(ctx, dsn)
+	gt.NoError(t, err).Required()
+	defer func() {
+		_, _ = conn.Exec(ctx, `DROP SCHEMA IF EXISTS "`+schema+`" CASCADE`)
+		_ = conn.Close(ctx)
+	}()
+
+	table := &export.Table{
+		Name: "cases",
+		Columns: []export.Column{
+			{Name: "id", Type: export.TypeInt},
+			{Name: "title", Type: export.TypeString, Nullable: true},
+			{Name: "assignee_ids", Type: export.TypeString, Repeated: true},
+			{Name: "created_at", Type: export.TypeTimestamp, Nullable: true},
+		},
+		Rows: []map[string]any{
+			{"id": int64(1), "title": "first", "assignee_ids": []string{"U1"}, "created_at": time.Now()},
+			{"id": int64(2), "assignee_ids": []string(nil), "created_at": time.Time{}},
+		},
+	}
+	gt.NoError(t, sink.WriteTable(ctx, schema, table)).Required()
+
+	var count int
+	gt.NoError(t, conn.QueryRow(ctx, `SELECT count(*) FROM "`+schema+`"."cases"`).Scan(&count))
+	gt.Number(t, count).Equal(2)
+
+	// A second run fully replaces the first, including when it has no rows.
+	table.Rows = nil
+	gt.NoError(t, sink.WriteTable(ctx, schema, table)).Required()
+	gt.NoError(t, conn.QueryRow(ctx, `SELECT count(*) FROM "`+schema+`"."cases"`).Scan(&count))
+	gt.Number(t, count).Equal(0)
+
+	// No staging table is left behind.
+	var staging int
+	gt.NoError(t, conn.QueryRow(ctx,
+		`SELECT count(*) FROM information_schema.tables WHERE table_schema = $1 AND table_name LIKE '%\_stg\_%'`,
+		schema).Scan(&staging))
+	gt.Number(t, staging).Equal(0)
+}
+
+func TestSink_LivePostgresKeepsDependentView(t *testing.T) {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN not set; skipping live Postgres sink test")
+	}
+	ctx := context.Background()
+	sink, err := pgexport.New(ctx, dsn)
+	gt.NoError(t, err).Required()
+	defer safe.Close(ctx, sink)
+
+	schema := fmt.Sprintf("hecaton_test_%d", time.Now().UnixNano())
+	conn, err := pgx.Connect(ctx, dsn)
+	gt.NoError(t, err).Required()
+	defer func() {
+		_, _ = conn.Exec(ctx, `DROP SCHEMA IF EXISTS "`+schema+`" CASCADE`)
+		_ = conn.Close(ctx)
+	}()
+
+	table := &export.Table{
+		Name: "cases",
+		Columns: []export.Column{
+			{Name: "id", Type: export.TypeInt},
+			{Name: "title", Type: export.TypeString, Nullable: true},
+			{Name: "created_at", Type: export.TypeTimestamp, Nullable: true},
+		},
+		Rows: []map[string]any{{"id": int64(1), "title": "first", "created_at": time.Now()}},
+	}
+	gt.NoError(t, sink.WriteTable(ctx, schema, table)).Required()
+
+	_, err = conn.Exec(ctx, `CREATE VIEW "`+schema+`"."open_cases" AS SELECT id, title FROM "`+schema+`"."cases"`)
+	gt.NoError(t, err).Required()
+	viewCount := func() int {
+		var n int
+		gt.NoError(t, conn.QueryRow(ctx, `SELECT count(*) FROM "`+schema+`"."open_cases"`).Scan(&n)).Required()
+		return n
+	}
+
+	// Same schema: refreshed in place, the view survives and sees the new rows.
+	table.Rows = append(table.Rows, map[string]any{"id": int64(2), "title": "second", "created_at": time.Now()})
+	gt.NoError(t, sink.WriteTable(ctx, schema, table)).Required()
+	gt.Number(t, viewCount()).Equal(2)
+
+	// Schema change: the swap refuses to drop a table a view depends on, and
+	// the whole refresh rolls back.
+	changed := *table
+	changed.Columns = append(append([]export.Column{}, table.Columns...),
+		export.Column{Name: "status", Type: export.TypeString, Nullable: true})
+	changed.Rows = nil
+	gt.Error(t, sink.WriteTable(ctx, schema, &changed))
+	gt.Number(t, viewCount()).Equal(2)
+
+	// Once the view is dropped the new schema goes through.
+	_, err = conn.Exec(ctx, `DROP VIEW "`+schema+`"."open_cases"`)
+	gt.NoError(t, err).Required()
+	gt.NoError(t, sink.WriteTable(ctx, schema, &changed)).Required()
+	var cols int
+	gt.NoError(t, conn.QueryRow(ctx,
+		`SELECT count(*) FROM information_schema.columns WHERE table_schema = $1 AND table_name = 'cases'`,
+		schema).Scan(&cols))
+	gt.Number(t, cols).Equal(4)
+}
+
+func TestDeleteSQL(t *testing.T) {
+	cols := []export.Column{
+		{Name: "case_id", Type: export.TypeInt},
+		{Name: "job_id", Type: export.TypeString},
+		{Name: "labels", Type: export.TypeString, Repeated: true},
+	}
+	sql, args, err := pgexport.DeleteSQLForTest("ws", "job_runs", cols, map[string]any{"job_id": "triage", "case_id": int64(7)})
+	gt.NoError(t, err).Required()
+	gt.String(t, sql).Equal(`DELETE FROM "ws"."job_runs" WHERE "case_id" = $1 AND "job_id" = $2`)
+	gt.Value(t, args).Equal([]any{int64(7), "triage"})
+
+	t.Run("unknown column is rejected", func(t *testing.T) {
+		_, _, err := pgexport.DeleteSQLForTest("ws", "job_runs", cols, map[string]any{"nope": 1})
+		gt.Error(t, err)
+	})
+	t.Run("array column is rejected", func(t *testing.T) {
+		_, _, err := pgexport.DeleteSQLForTest("ws", "job_runs", cols, map[string]any{"labels": []string{"x"}})
+		gt.Error(t, err)
+	})
+	t.Run("empty predicate is rejected", func(t *testing.T) {
+		_, _, err := pgexport.DeleteSQLForTest("ws", "job_runs", cols, map[string]any{})
+		gt.Error(t, err)
+	})
+}
+
+func TestUpsertSQL(t *testing.T) {
+	got := pgexport.UpsertSQLForTest("ws", "job_runs", "job_runs_stg_1", []string{"case_id", "job_id"})
+	gt.Value(t, got).Equal([]string{
+		`DELETE FROM "ws"."job_runs" AS d USING pg_temp."job_runs_stg_1" AS m WHERE d."case_id" = m."case_id" AND d."job_id" = m."job_id"`,
+		`INSERT INTO "ws"."job_runs" SELECT * FROM pg_temp."job_runs_stg_1"`,
+	})
+}
+
+func TestSink_LivePostgresMerge(t *testing.T) {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN not set; skipping live Postgres sink test")
+	}
+	ctx := context.Background()
+	sink, err := pgexport.New(ctx, dsn)
+	gt.NoError(t, err).Required()
+	defer safe.Close(ctx, sink)
+
+	schema := fmt.Sprintf("hecaton_test_%d", time.Now().UnixNano())
+	conn, err := pgx.Connect(ctx, dsn)
+	gt.NoError(t, err).Required()
+	defer func() {
+		_, _ = conn.Exec(ctx, `DROP SCHEMA IF EXISTS "`+schema+`" CASCADE`)
+		_ = conn.Close(ctx)
+	}()
+
+	cols := []export.Column{
+		{Name: "id", Type: export.TypeInt},
+		{Name: "case_id", Type: export.TypeInt},
+		{Name: "title", Type: export.TypeString, Nullable: true},
+	}
+	delta := &export.Delta{
+		Table: &export.Table{Name: "actions", Columns: cols},
+		Key:   []string{"id"},
+	}
+
+	// Merging before any full refresh is refused.
+	gt.Error(t, sink.MergeTable(ctx, schema, delta))
+
+	gt.NoError(t, sink.WriteTable(ctx, schema, &export.Table{
+		Name: "actions", Columns: cols,
+		Rows: []map[string]any{
+			{"id": int64(1), "case_id": int64(10), "title": "keep"},
+			{"id": int64(2), "case_id": int64(10), "title": "old"},
+			{"id": int64(3), "case_id": int64(20), "title": "deleted case"},
+		},
+	})).Required()
+
+	delta.Table.Rows = []map[string]any{
+		{"id": int64(2), "case_id": int64(10), "title": "new"},
+		{"id": int64(4), "case_id": int64(10), "title": "added"},
+	}
+	delta.Deletes = []map[string]any{{"case_id": int64(20)}}
+	gt.NoError(t, sink.MergeTable(ctx, schema, delta)).Required()
+
+	rows, err := conn.Query(ctx, `SELECT id, title FROM "`+schema+`"."actions" ORDER BY id`)
+	gt.NoError(t, err).Required()
+	got, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (string, error) {
+		var id int64
+		var title string
+		err := row.Scan(&id, &title)
+		return fmt.Sprintf("%d:%s", id, title), err
+	})
+	gt.NoError(t, err).Required()
+	gt.Value(t, got).Equal([]string{"1:keep", "2:new", "4:added"})
+
+	// A delta whose columns no longer match the table is refused untouched.
+	changed := *delta
+	changed.Table = &export.Table{Name: "actions", Columns: append(append([]export.Column{}, cols...),
+		export.Column{Name: "status", Type: export.TypeString, Nullable: true})}
+	gt.Error(t, sink.MergeTable(ctx, schema, &changed))
+}
@@ -668,6 +668,25 @@ func (c *client) UpdateView(ctx context.Context, view slack.ModalViewRequest, ex
 	return nil
 }
 
+// PublishView publishes the App Home tab for userID (views.publish).
+func (c *client) PublishView(ctx context.Context, userID string, view slack.HomeTabViewRequest) error {
+	_, err := c.api.PublishViewContext(ctx, slack.PublishViewContextRequest{UserID: userID, View: view})
+	if err != nil {
+		return wrapSlackViewError(err, "failed to publish Slack home view", "")
+	}
+	return nil
+}
+
+// UnfurlMessage attaches link previews to a posted message (chat.unfurl).
+func (c *client) UnfurlMessage(ctx context.Context, channelID, messageTS string, unfurls map[string]slack.Attachment) error {
+	if _, _, _, err := c.api.UnfurlMessageContext(ctx, channelID, messageTS, unfurls); err != nil {
+		return goerr.Wrap(err, "failed to unfurl Slack message",
+			goerr.V("channel_id", channelID),
+			goerr.V("message_ts", messageTS))
+	}
+	return nil
+}
+
 // wrapSlackViewError wraps a views.* failure with the structured detail
 // Slack returns in response_metadata. The default goerr.Wrap path only
 // captures the top-level error code (e.g. "invalid_arguments"), so by the
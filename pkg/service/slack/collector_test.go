@@ -119,6 +119,13 @@ func (f *fakeSlackService) OpenView(context.Context, string, goslack.ModalViewRe
 func (f *fakeSlackService) UpdateView(context.Context, goslack.ModalViewRequest, string, string, string) error {
 	panic("unexpected UpdateView")
 }
+
+func (f *fakeSlackService) PublishView(_ context.Context, _ string, _ goslack.HomeTabViewRequest) error {
+	return nil
+}
+func (f *fakeSlackService) UnfurlMessage(_ context.Context, _, _ string, _ map[string]goslack.Attachment) error {
+	return nil
+}
 func (f *fakeSlackService) ListUserGroups(context.Context, string) ([]slacksvc.UserGroup, error) {
 	panic("unexpected ListUserGroups")
 }
@@ -120,6 +120,15 @@ type Service interface {
 	// fresh trigger_id.
 	UpdateView(ctx context.Context, view slack.ModalViewRequest, externalID, hash, viewID string) error
 
+	// PublishView publishes the App Home tab view for the given user,
+	// replacing whatever the user's Home tab showed before.
+	PublishView(ctx context.Context, userID string, view slack.HomeTabViewRequest) error
+
+	// UnfurlMessage attaches previews to the links of a posted message
+	// (chat.unfurl). unfurls is keyed by the URL exactly as it appeared in
+	// the link_shared event.
+	UnfurlMessage(ctx context.Context, channelID, messageTS string, unfurls map[string]slack.Attachment) error
+
 	// ListUserGroups retrieves all user groups in the workspace.
 	// If teamID is non-empty, only groups in that workspace are returned (for org-level apps).
 	// If teamID is empty, behaves the same as before (single-workspace mode).
@@ -175,6 +175,14 @@ func (m *mockSlackService) UpdateView(_ context.Context, _ goslack.ModalViewRequ
 	return nil
 }
 
+func (m *mockSlackService) PublishView(_ context.Context, _ string, _ goslack.HomeTabViewRequest) error {
+	return nil
+}
+
+func (m *mockSlackService) UnfurlMessage(_ context.Context, _, _ string, _ map[string]goslack.Attachment) error {
+	return nil
+}
+
 func (m *mockSlackService) ListUserGroups(ctx context.Context, teamID string) ([]slack.UserGroup, error) {
 	return nil, nil
 }
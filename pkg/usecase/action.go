@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 	"strings"
 	"time"
 
@@ -110,6 +111,10 @@ type UpdateActionInput struct {
 	ClearDueDate   bool
 	ClearAssignee  bool
 	SlackMessageTS *string
+	// BlockedBy, when non-nil, replaces the action's blockers; an empty slice
+	// clears them. Moving the action to another case without it clears them
+	// too, since blockers must be actions of the same case.
+	BlockedBy *[]int64
 
 	SlackSync SlackSyncMode
 	Actor     ActorRef
@@ -124,12 +129,21 @@ type UpdateActionInput struct {
 	RejectNonHumanAssignee bool
 }
 
+// ActionEventPublisher is the narrow surface of pkg/usecase/job.UseCase that
+// ActionUseCase calls into when an Action transition should reach the parent
+// Case's Jobs. Defined here for the same import-cycle reason as
+// CaseEventPublisher.
+type ActionEventPublisher interface {
+	PublishCaseChange(ctx context.Context, workspaceID string, c *model.Case, change model.CaseChange, actorUserID string)
+}
+
 type ActionUseCase struct {
-	repo         interfaces.Repository
-	registry     *model.WorkspaceRegistry
-	slackService slack.Service
-	baseURL      string
-	slotCoord    *notificationSlotCoordinator
+	repo           interfaces.Repository
+	registry       *model.WorkspaceRegistry
+	slackService   slack.Service
+	baseURL        string
+	slotCoord      *notificationSlotCoordinator
+	eventPublisher ActionEventPublisher
 }
 
 // NewActionUseCase constructs the ActionUseCase. slotCoord may be nil; when
@@ -145,6 +159,44 @@ func NewActionUseCase(repo interfaces.Repository, registry *model.WorkspaceRegis
 	}
 }
 
+// SetEventPublisher wires the job event publisher. Called once at startup
+// next to CaseUseCase.SetEventPublisher; nil disables Action-driven Jobs.
+func (uc *ActionUseCase) SetEventPublisher(p ActionEventPublisher) {
+	uc.eventPublisher = p
+}
+
+// publishActionCompleted publishes action_completed against the Action's
+// parent Case when the update moved it from an open status into a closed
+// one. Re-saving an already-closed Action, or moving between two closed
+// statuses, is not a completion and stays silent.
+func (uc *ActionUseCase) publishActionCompleted(ctx context.Context, workspaceID string, before, after *model.Action, parentCase *model.Case, actor ActorRef) {
+	if uc.eventPublisher == nil || before == nil || after == nil {
+		return
+	}
+	set := uc.statusSet(workspaceID)
+	if set.IsClosed(string(before.Status)) || !set.IsClosed(string(after.Status)) {
+		return
+	}
+	// A reparenting update completes the Action under its new Case.
+	if parentCase == nil || parentCase.ID != after.CaseID {
+		c, err := uc.repo.Case().Get(ctx, workspaceID, after.CaseID)
+		if err != nil {
+			errutil.Handle(ctx, goerr.Wrap(err, "failed to load case for action_completed event",
+				goerr.V(CaseIDKey, after.CaseID), goerr.V(ActionIDKey, after.ID)),
+				"skip action_completed event")
+			return
+		}
+		parentCase = c
+	}
+	actorID, _ := actorForAccess(ctx, actor)
+	uc.eventPublisher.PublishCaseChange(ctx, workspaceID, parentCase, model.CaseChange{
+		Lifecycle: model.CaseLifecycleActionCompleted,
+		ActionID:  after.ID,
+		From:      []string{string(before.Status)},
+		To:        []string{string(after.Status)},
+	}, actorID)
+}
+
 // statusSet returns the configured ActionStatusSet for the given workspace,
 // falling back to the default set when the workspace is unknown or has no
 // custom configuration.
@@ -179,7 +231,29 @@ func ensureCaseAcceptsActions(c *model.Case) error {
 	return nil
 }
 
-func (uc *ActionUseCase) CreateAction(ctx context.Context, workspaceID string, caseID int64, title, description string, assigneeID string, slackMessageTS string, status types.ActionStatus, dueDate *time.Time) (*model.Action, error) {
+// resolveBlockers checks blockedBy as the blocker list of action actionID
+// (0 while it is being created) in caseID; see model.ResolveActionBlockers.
+func (uc *ActionUseCase) resolveBlockers(ctx context.Context, workspaceID string, caseID, actionID int64, blockedBy []int64) ([]int64, error) {
+	if len(blockedBy) == 0 {
+		return nil, nil
+	}
+	siblings, err := uc.repo.Action().GetByCase(ctx, workspaceID, caseID, interfaces.ActionListOptions{
+		ArchiveScope: interfaces.ActionArchiveScopeAll,
+	})
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list actions of case", goerr.V(CaseIDKey, caseID))
+	}
+	resolved, err := model.ResolveActionBlockers(actionID, blockedBy, siblings)
+	if err != nil {
+		return nil, goerr.Wrap(err, "invalid blockers", goerr.V(CaseIDKey, caseID))
+	}
+	return resolved, nil
+}
+
+// CreateAction creates an action under caseID. blockedBy optionally names
+// actions of the same case the new one waits on; they are checked before
+// anything is written.
+func (uc *ActionUseCase) CreateAction(ctx context.Context, workspaceID string, caseID int64, title, description string, assigneeID string, slackMessageTS string, status types.ActionStatus, dueDate *time.Time, blockedBy ...int64) (*model.Action, error) {
 	if title == "" {
 		return nil, goerr.New("action title is required")
 	}
@@ -208,6 +282,11 @@ func (uc *ActionUseCase) CreateAction(ctx context.Context, workspaceID string, c
 			goerr.V("workspace_id", workspaceID))
 	}
 
+	blockedBy, err = uc.resolveBlockers(ctx, workspaceID, caseID, 0, blockedBy)
+	if err != nil {
+		return nil, err
+	}
+
 	now := time.Now().UTC()
 	action := &model.Action{
 		CaseID:         caseID,
@@ -219,6 +298,7 @@ func (uc *ActionUseCase) CreateAction(ctx context.Context, workspaceID string, c
 		DueDate:        dueDate,
 		CreatedAt:      now,
 		UpdatedAt:      now,
+		BlockedBy:      blockedBy,
 	}
 
 	created, err := uc.repo.Action().Create(ctx, workspaceID, action)
@@ -244,6 +324,17 @@ func (uc *ActionUseCase) CreateAction(ctx context.Context, workspaceID string, c
 		errutil.Handle(ctx, err, "failed to record action created event")
 	}
 
+	// The first Action is the case's first response for its SLA clock.
+	if caseModel.SLA.RespondedAt == nil {
+		if _, err := uc.repo.Case().Transact(ctx, workspaceID, caseID, func(c *model.Case) error {
+			c.MarkSLAResponse(now)
+			return nil
+		}); err != nil {
+			errutil.Handle(ctx, goerr.Wrap(err, "failed to record case response",
+				goerr.V(CaseIDKey, caseID)), "failed to record case response")
+		}
+	}
+
 	if uc.slackService != nil && caseModel.SlackChannelID != "" {
 		posted, postErr := uc.postSlackMessageForAction(ctx, workspaceID, created, caseModel)
 		if postErr != nil {
@@ -447,6 +538,17 @@ func (uc *ActionUseCase) UpdateAction(ctx context.Context, workspaceID string, i
 	} else if in.DueDate != nil {
 		action.DueDate = in.DueDate
 	}
+	if in.BlockedBy != nil || action.CaseID != existing.CaseID {
+		var blockedBy []int64
+		if in.BlockedBy != nil {
+			blockedBy = *in.BlockedBy
+		}
+		resolved, err := uc.resolveBlockers(ctx, workspaceID, action.CaseID, action.ID, blockedBy)
+		if err != nil {
+			return nil, err
+		}
+		action.BlockedBy = resolved
+	}
 
 	action.UpdatedAt = time.Now().UTC()
 	updated, err := uc.repo.Action().Update(ctx, workspaceID, action)
@@ -458,14 +560,17 @@ func (uc *ActionUseCase) UpdateAction(ctx context.Context, workspaceID string, i
 	// in the WebUI reads ActionEvent records as the source of truth for
 	// "what changed when, by whom".
 	uc.recordActionEvents(ctx, workspaceID, existing, updated, in.Actor)
+	uc.publishActionCompleted(ctx, workspaceID, existing, updated, parentCase, in.Actor)
 
 	switch in.SlackSync {
 	case SlackSyncSkip:
 		// no Slack side effects
 	case SlackSyncMessageOnly:
 		uc.refreshSlackMessage(ctx, workspaceID, updated)
+		uc.syncDependents(ctx, workspaceID, existing, updated, false)
 	case SlackSyncFull:
 		uc.refreshSlackMessage(ctx, workspaceID, updated)
+		uc.syncDependents(ctx, workspaceID, existing, updated, true)
 		// Slack thread also gets a human-readable context-block summary so
 		// channel watchers see the change without opening the WebUI. The
 		// ingest path drops these on the floor (HandleSlackMessage skips
@@ -492,6 +597,7 @@ func (uc *ActionUseCase) ArchiveAction(ctx context.Context, workspaceID string,
 		return nil, goerr.Wrap(ErrActionAlreadyArchived, "action is already archived", goerr.V(ActionIDKey, id))
 	}
 
+	before := *existing
 	now := time.Now().UTC()
 	existing.ArchivedAt = &now
 	existing.UpdatedAt = now
@@ -502,6 +608,7 @@ func (uc *ActionUseCase) ArchiveAction(ctx context.Context, workspaceID string,
 
 	uc.recordArchiveEvent(ctx, workspaceID, updated, types.ActionEventArchived, actor)
 	uc.notifyArchiveOnSlack(ctx, workspaceID, updated, parentCase, types.ActionEventArchived, actor)
+	uc.syncDependents(ctx, workspaceID, &before, updated, true)
 	return updated, nil
 }
 
@@ -560,6 +667,7 @@ func (uc *ActionUseCase) UnarchiveAction(ctx context.Context, workspaceID string
 		return nil, goerr.Wrap(ErrActionNotArchived, "action is not archived", goerr.V(ActionIDKey, id))
 	}
 
+	before := *existing
 	existing.ArchivedAt = nil
 	existing.UpdatedAt = time.Now().UTC()
 	updated, err := uc.repo.Action().Update(ctx, workspaceID, existing)
@@ -569,6 +677,7 @@ func (uc *ActionUseCase) UnarchiveAction(ctx context.Context, workspaceID string
 
 	uc.recordArchiveEvent(ctx, workspaceID, updated, types.ActionEventUnarchived, actor)
 	uc.notifyArchiveOnSlack(ctx, workspaceID, updated, parentCase, types.ActionEventUnarchived, actor)
+	uc.syncDependents(ctx, workspaceID, &before, updated, true)
 	return updated, nil
 }
 
@@ -878,6 +987,99 @@ func (uc *ActionUseCase) postActionChangeNotification(ctx context.Context, works
 	}
 }
 
+// syncDependents keeps the actions waiting on after in step with it when
+// after stopped or started blocking them: moved between an open and a closed
+// status, or was archived or unarchived, which model.OpenActionBlockers
+// treats like closing and reopening. Their Slack cards are refreshed so the
+// "Blocked by" line follows, and when notify is set and after left a
+// dependent with no open blocker, the dependent's thread is told it can
+// start. Best-effort, like the other Slack side effects of UpdateAction.
+func (uc *ActionUseCase) syncDependents(ctx context.Context, workspaceID string, before, after *model.Action, notify bool) {
+	if uc.slackService == nil {
+		return
+	}
+	set := uc.statusSet(workspaceID)
+	settled := func(a *model.Action) bool { return a.IsArchived() || set.IsClosed(string(a.Status)) }
+	closed := settled(after)
+	if settled(before) == closed {
+		return
+	}
+
+	siblings, err := uc.repo.Action().GetByCase(ctx, workspaceID, after.CaseID, interfaces.ActionListOptions{})
+	if err != nil {
+		errutil.Handle(ctx, goerr.Wrap(err, "failed to list dependents of action",
+			goerr.V(ActionIDKey, after.ID)), "failed to list dependents of action")
+		return
+	}
+	for _, d := range siblings {
+		if !slices.Contains(d.BlockedBy, after.ID) {
+			continue
+		}
+		uc.refreshSlackMessage(ctx, workspaceID, d)
+		if notify && closed && len(model.OpenActionBlockers(d, siblings, set)) == 0 {
+			uc.postUnblockedNotification(ctx, workspaceID, d, after)
+		}
+	}
+}
+
+// postUnblockedNotification tells the assignee of dependent that its last
+// open blocker is done or archived: a reply in the dependent's thread, or a
+// post in the case channel when its card was never posted.
+func (uc *ActionUseCase) postUnblockedNotification(ctx context.Context, workspaceID string, dependent, blocker *model.Action) {
+	caseModel, err := uc.repo.Case().Get(ctx, workspaceID, dependent.CaseID)
+	if err != nil {
+		errutil.Handle(ctx, err, "failed to get case for unblocked notification")
+		return
+	}
+	if caseModel.SlackChannelID == "" {
+		return
+	}
+
+	msg := i18n.MsgActionUnblocked
+	if blocker.IsArchived() {
+		msg = i18n.MsgActionUnblockedArchived
+	}
+	body := i18n.T(ctx, msg,
+		slackTextEscaper.Replace(dependent.Title), slackTextEscaper.Replace(blocker.Title))
+	if dependent.AssigneeID != "" {
+		body = mentionUser(dependent.AssigneeID) + " " + body
+	}
+	blocks := []goslack.Block{
+		goslack.NewContextBlock("",
+			goslack.NewTextBlockObject(goslack.MarkdownType, body, false, false),
+		),
+	}
+
+	if dependent.SlackMessageTS != "" {
+		_, err = uc.slackService.PostThreadMessage(ctx, caseModel.SlackChannelID, dependent.SlackMessageTS, blocks, body)
+	} else {
+		_, err = uc.slackService.PostMessage(ctx, caseModel.SlackChannelID, blocks, body)
+	}
+	if err != nil {
+		errutil.Handle(ctx, goerr.Wrap(err, "failed to post unblocked notification",
+			goerr.V(ActionIDKey, dependent.ID)), "failed to post unblocked notification")
+	}
+}
+
+// openBlockers returns the blockers still holding action up, for its card.
+// A lookup failure is logged and shows the action as unblocked.
+func (uc *ActionUseCase) openBlockers(ctx context.Context, workspaceID string, action *model.Action) []*model.Action {
+	if len(action.BlockedBy) == 0 {
+		return nil
+	}
+	found, err := uc.repo.Action().GetByIDs(ctx, workspaceID, action.BlockedBy)
+	if err != nil {
+		errutil.Handle(ctx, goerr.Wrap(err, "failed to get blockers of action",
+			goerr.V(ActionIDKey, action.ID)), "failed to get blockers of action")
+		return nil
+	}
+	blockers := make([]*model.Action, 0, len(found))
+	for _, b := range found {
+		blockers = append(blockers, b)
+	}
+	return model.OpenActionBlockers(action, blockers, uc.statusSet(workspaceID))
+}
+
 func renderActor(ctx context.Context, actor ActorRef) string {
 	if actor.Kind == ActorKindSlackUser && actor.ID != "" {
 		return mentionUser(actor.ID)
@@ -968,9 +1170,10 @@ func buildActionCommentWebURL(baseURL, workspaceID string, caseID, actionID int6
 //   - attachment.color: status-derived hex (resolved via
 //     ActionStatusDefinition.SlackColor); the side-bar gives status a glance-
 //     level read.
-//   - attachment.blocks: optional description Section, then one Actions block
-//     carrying the status_select and assignee_select. Both selects share a
-//     single Actions block; their block_id encodes (workspaceID, actionID).
+//   - attachment.blocks: optional description Section, an optional Context
+//     line naming the open blockers, then one Actions block carrying the
+//     status_select and assignee_select. Both selects share a single Actions
+//     block; their block_id encodes (workspaceID, actionID).
 func (uc *ActionUseCase) buildActionMessagePayload(ctx context.Context, workspaceID string, action *model.Action, actionURL string) (string, goslack.Attachment) {
 	statusSet := uc.statusSet(workspaceID)
 	// `&`, `<`, `>` are mrkdwn control characters and must be HTML-escaped
@@ -992,6 +1195,16 @@ func (uc *ActionUseCase) buildActionMessagePayload(ctx context.Context, workspac
 			nil, nil,
 		))
 	}
+	if blockers := uc.openBlockers(ctx, workspaceID, action); len(blockers) > 0 {
+		names := make([]string, 0, len(blockers))
+		for _, b := range blockers {
+			names = append(names, "*"+slackTextEscaper.Replace(b.Title)+"*")
+		}
+		attBlocks = append(attBlocks, goslack.NewContextBlock("",
+			goslack.NewTextBlockObject(goslack.MarkdownType,
+				i18n.T(ctx, i18n.MsgActionBlockedBy, strings.Join(names, ", ")), false, false),
+		))
+	}
 	statusSelect := buildStatusSelect(ctx, workspaceID, action, statusSet)
 	assigneeSelect := buildAssigneeSelect(ctx, action)
 	attBlocks = append(attBlocks, goslack.NewActionBlock(
@@ -0,0 +1,195 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+	"github.com/secmon-lab/hecatoncheires/pkg/i18n"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	slacksvc "github.com/secmon-lab/hecatoncheires/pkg/service/slack"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+	goslack "github.com/slack-go/slack"
+)
+
+// dependencySlackMock records every thread reply and card refresh, since one
+// UpdateAction can post to several threads.
+type dependencySlackMock struct {
+	actionTestSlackMock
+	threadPosts []string // "threadTS|text"
+	cardUpdates map[string]goslack.Attachment
+	cards       int
+}
+
+func (m *dependencySlackMock) PostThreadMessage(_ context.Context, _ string, threadTS string, _ []goslack.Block, text string, _ ...slacksvc.PostThreadOption) (string, error) {
+	m.threadPosts = append(m.threadPosts, threadTS+"|"+text)
+	return "reply-ts", nil
+}
+
+func (m *dependencySlackMock) PostMessageWithAttachment(_ context.Context, _ string, _ string, _ goslack.Attachment) (string, error) {
+	m.cards++
+	return fmt.Sprintf("card-%d", m.cards), nil
+}
+
+func (m *dependencySlackMock) UpdateMessageWithAttachment(_ context.Context, _ string, timestamp string, _ string, attachment goslack.Attachment) error {
+	m.cardUpdates[timestamp] = attachment
+	return nil
+}
+
+func attachmentText(att goslack.Attachment) string {
+	var out string
+	for _, b := range att.Blocks.BlockSet {
+		if ctxBlock, ok := b.(*goslack.ContextBlock); ok {
+			for _, e := range ctxBlock.ContextElements.Elements {
+				if txt, ok := e.(*goslack.TextBlockObject); ok {
+					out += txt.Text
+				}
+			}
+		}
+	}
+	return out
+}
+
+func TestActionUseCase_Dependencies(t *testing.T) {
+	i18n.Init(i18n.LangEN)
+	setup := func(t *testing.T) (context.Context, *usecase.CaseUseCase, *usecase.ActionUseCase, *dependencySlackMock, int64) {
+		t.Helper()
+		repo := memory.New()
+		mock := &dependencySlackMock{
+			actionTestSlackMock: actionTestSlackMock{
+				mockSlackService: mockSlackService{
+					createChannelFn: func(_ context.Context, caseID int64, _ string, _ string) (string, error) {
+						return fmt.Sprintf("C%d", caseID), nil
+					},
+				},
+			},
+			cardUpdates: map[string]goslack.Attachment{},
+		}
+		ctx := auth.ContextWithToken(context.Background(), &auth.Token{Sub: "UTESTUSER"})
+		caseUC := usecase.NewCaseUseCase(repo, nil, mock, nil, "")
+		actionUC := usecase.NewActionUseCase(repo, nil, mock, "", nil)
+		c, err := caseUC.CreateCase(ctx, testWorkspaceID, "Incident", "", []string{}, nil, false, false, "", "")
+		gt.NoError(t, err).Required()
+		return ctx, caseUC, actionUC, mock, c.ID
+	}
+
+	t.Run("blockers are checked on create", func(t *testing.T) {
+		ctx, _, actionUC, _, caseID := setup(t)
+		_, err := actionUC.CreateAction(ctx, testWorkspaceID, caseID, "Mitigate", "", "", "", "", nil, 999)
+		gt.Error(t, err).Is(model.ErrActionDependency)
+
+		actions, err := actionUC.GetActionsByCase(ctx, testWorkspaceID, caseID, interfaces.ActionListOptions{})
+		gt.NoError(t, err).Required()
+		gt.Array(t, actions).Length(0)
+	})
+
+	t.Run("cycle is rejected on update", func(t *testing.T) {
+		ctx, _, actionUC, _, caseID := setup(t)
+		investigate, err := actionUC.CreateAction(ctx, testWorkspaceID, caseID, "Investigate", "", "", "", "", nil)
+		gt.NoError(t, err).Required()
+		mitigate, err := actionUC.CreateAction(ctx, testWorkspaceID, caseID, "Mitigate", "", "", "", "", nil, investigate.ID)
+		gt.NoError(t, err).Required()
+		gt.Array(t, mitigate.BlockedBy).Equal([]int64{investigate.ID})
+
+		_, err = actionUC.UpdateAction(ctx, testWorkspaceID, usecase.UpdateActionInput{
+			ID:        investigate.ID,
+			BlockedBy: &[]int64{mitigate.ID},
+			SlackSync: usecase.SlackSyncSkip,
+		})
+		gt.Error(t, err).Is(model.ErrActionDependency)
+
+		cleared, err := actionUC.UpdateAction(ctx, testWorkspaceID, usecase.UpdateActionInput{
+			ID:        mitigate.ID,
+			BlockedBy: &[]int64{},
+			SlackSync: usecase.SlackSyncSkip,
+		})
+		gt.NoError(t, err).Required()
+		gt.Array(t, cleared.BlockedBy).Length(0)
+	})
+
+	t.Run("completing the last blocker notifies the dependent", func(t *testing.T) {
+		ctx, _, actionUC, mock, caseID := setup(t)
+		investigate, err := actionUC.CreateAction(ctx, testWorkspaceID, caseID, "Investigate", "", "", "", "", nil)
+		gt.NoError(t, err).Required()
+		contain, err := actionUC.CreateAction(ctx, testWorkspaceID, caseID, "Contain", "", "", "", "", nil)
+		gt.NoError(t, err).Required()
+		mitigate, err := actionUC.CreateAction(ctx, testWorkspaceID, caseID, "Mitigate", "", "U001", "", "", nil, investigate.ID, contain.ID)
+		gt.NoError(t, err).Required()
+		gt.String(t, mitigate.SlackMessageTS).NotEqual("").Required()
+
+		completed := types.ActionStatusCompleted
+		_, err = actionUC.UpdateAction(ctx, testWorkspaceID, usecase.UpdateActionInput{
+			ID:        investigate.ID,
+			Status:    &completed,
+			SlackSync: usecase.SlackSyncFull,
+		})
+		gt.NoError(t, err).Required()
+
+		// Still blocked by Contain: the card follows, no one is pinged.
+		gt.String(t, attachmentText(mock.cardUpdates[mitigate.SlackMessageTS])).Contains("Blocked by *Contain*")
+		for _, p := range mock.threadPosts {
+			gt.String(t, p).NotContains(":unlock:")
+		}
+
+		_, err = actionUC.UpdateAction(ctx, testWorkspaceID, usecase.UpdateActionInput{
+			ID:        contain.ID,
+			Status:    &completed,
+			SlackSync: usecase.SlackSyncFull,
+		})
+		gt.NoError(t, err).Required()
+
+		gt.String(t, attachmentText(mock.cardUpdates[mitigate.SlackMessageTS])).NotContains("Blocked by")
+		gt.Array(t, mock.threadPosts).Any(func(p string) bool {
+			return p == mitigate.SlackMessageTS+"|<@U001> :unlock: *Mitigate* is no longer blocked: *Contain* is done."
+		})
+	})
+
+	t.Run("archiving the last blocker notifies the dependent and unarchiving blocks it again", func(t *testing.T) {
+		ctx, _, actionUC, mock, caseID := setup(t)
+		investigate, err := actionUC.CreateAction(ctx, testWorkspaceID, caseID, "Investigate", "", "", "", "", nil)
+		gt.NoError(t, err).Required()
+		mitigate, err := actionUC.CreateAction(ctx, testWorkspaceID, caseID, "Mitigate", "", "U001", "", "", nil, investigate.ID)
+		gt.NoError(t, err).Required()
+		gt.String(t, mitigate.SlackMessageTS).NotEqual("").Required()
+		system := usecase.ActorRef{Kind: usecase.ActorKindSystem}
+
+		_, err = actionUC.ArchiveAction(ctx, testWorkspaceID, investigate.ID, system)
+		gt.NoError(t, err).Required()
+		gt.String(t, attachmentText(mock.cardUpdates[mitigate.SlackMessageTS])).NotContains("Blocked by")
+		gt.Array(t, mock.threadPosts).Any(func(p string) bool {
+			return p == mitigate.SlackMessageTS+"|<@U001> :unlock: *Mitigate* is no longer blocked: *Investigate* was archived."
+		})
+
+		mock.threadPosts = nil
+		_, err = actionUC.UnarchiveAction(ctx, testWorkspaceID, investigate.ID, system)
+		gt.NoError(t, err).Required()
+		gt.String(t, attachmentText(mock.cardUpdates[mitigate.SlackMessageTS])).Contains("Blocked by *Investigate*")
+		for _, p := range mock.threadPosts {
+			gt.String(t, p).NotContains(":unlock:")
+		}
+	})
+
+	t.Run("moving an action to another case drops its blockers", func(t *testing.T) {
+		ctx, caseUC, actionUC, _, caseID := setup(t)
+		investigate, err := actionUC.CreateAction(ctx, testWorkspaceID, caseID, "Investigate", "", "", "", "", nil)
+		gt.NoError(t, err).Required()
+		mitigate, err := actionUC.CreateAction(ctx, testWorkspaceID, caseID, "Mitigate", "", "", "", "", nil, investigate.ID)
+		gt.NoError(t, err).Required()
+		other, err := caseUC.CreateCase(ctx, testWorkspaceID, "Follow-up", "", []string{}, nil, false, false, "", "")
+		gt.NoError(t, err).Required()
+
+		moved, err := actionUC.UpdateAction(ctx, testWorkspaceID, usecase.UpdateActionInput{
+			ID:        mitigate.ID,
+			CaseID:    &other.ID,
+			SlackSync: usecase.SlackSyncSkip,
+		})
+		gt.NoError(t, err).Required()
+		gt.Value(t, moved.CaseID).Equal(other.ID)
+		gt.Array(t, moved.BlockedBy).Length(0)
+	})
+}
@@ -0,0 +1,234 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	goslack "github.com/slack-go/slack"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+	"github.com/secmon-lab/hecatoncheires/pkg/i18n"
+	"github.com/secmon-lab/hecatoncheires/pkg/service/slack"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/logging"
+)
+
+// ActionReminderUseCase runs the due-date reminder sweep over the open
+// Actions of every workspace with a [reminder] policy: a DM to the assignee
+// ahead of the due date, a post to the case once the action is overdue, and
+// a ping to the case assignees after the grace period. Like the knowledge
+// review sweep it is driven by `hecatoncheires tick` / `POST /hooks/tick`;
+// each reminder is claimed in the repository before it is sent, so it goes
+// out once however many instances run the sweep.
+type ActionReminderUseCase struct {
+	repo         interfaces.Repository
+	registry     *model.WorkspaceRegistry
+	slackService slack.Service
+	slotCoord    *notificationSlotCoordinator
+	baseURL      string
+}
+
+// NewActionReminderUseCase constructs an ActionReminderUseCase. slackService
+// may be nil, in which case the sweep does nothing. slotCoord may be nil;
+// overdue posts are then broadcast from the action thread to the channel.
+func NewActionReminderUseCase(repo interfaces.Repository, registry *model.WorkspaceRegistry, slackService slack.Service, baseURL string, slotCoord *notificationSlotCoordinator) *ActionReminderUseCase {
+	return &ActionReminderUseCase{
+		repo:         repo,
+		registry:     registry,
+		slackService: slackService,
+		slotCoord:    slotCoord,
+		baseURL:      baseURL,
+	}
+}
+
+// Sweep sends the reminders that became due since the previous sweep. Only
+// the latest stage an action has reached is considered, and archived or
+// closed actions and actions of closed cases are skipped. A failure listing
+// or claiming stops the sweep; a reminder that fails to send is released
+// so the next sweep retries it.
+func (uc *ActionReminderUseCase) Sweep(ctx context.Context) error {
+	return uc.sweep(ctx, time.Now().UTC())
+}
+
+func (uc *ActionReminderUseCase) sweep(ctx context.Context, now time.Time) error {
+	if uc.registry == nil {
+		return goerr.New("action reminder sweep has no registry")
+	}
+	if uc.slackService == nil {
+		return nil
+	}
+
+	for _, ws := range uc.registry.List() {
+		if ws == nil || ws.ActionReminder == nil || ws.IsThreadMode() {
+			continue
+		}
+		if err := uc.sweepWorkspace(ctx, ws, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (uc *ActionReminderUseCase) sweepWorkspace(ctx context.Context, ws *model.WorkspaceEntry, now time.Time) error {
+	workspaceID := ws.Workspace.ID
+	cases, err := uc.repo.Case().List(ctx, workspaceID, interfaces.WithStatus(types.CaseStatusOpen))
+	if err != nil {
+		return goerr.Wrap(err, "list open cases for reminder sweep", goerr.V("workspace_id", workspaceID))
+	}
+	if len(cases) == 0 {
+		return nil
+	}
+	caseByID := make(map[int64]*model.Case, len(cases))
+	caseIDs := make([]int64, 0, len(cases))
+	for _, c := range cases {
+		caseByID[c.ID] = c
+		caseIDs = append(caseIDs, c.ID)
+	}
+
+	actionsByCase, err := uc.repo.Action().GetByCases(ctx, workspaceID, caseIDs, interfaces.ActionListOptions{})
+	if err != nil {
+		return goerr.Wrap(err, "list actions for reminder sweep", goerr.V("workspace_id", workspaceID))
+	}
+
+	statusSet := ws.ActionStatusSet
+	if statusSet == nil {
+		statusSet = model.DefaultActionStatusSet()
+	}
+
+	sent := 0
+	for caseID, actions := range actionsByCase {
+		c := caseByID[caseID]
+		for _, a := range actions {
+			if a.IsArchived() || statusSet.IsClosed(string(a.Status)) {
+				continue
+			}
+			kind := ws.ActionReminder.DueReminder(a, now)
+			if kind == "" {
+				continue
+			}
+			claimed, err := uc.repo.ActionReminder().Claim(ctx, workspaceID, a.ID, kind, *a.DueDate)
+			if err != nil {
+				return goerr.Wrap(err, "claim action reminder",
+					goerr.V("workspace_id", workspaceID), goerr.V(ActionIDKey, a.ID))
+			}
+			if !claimed {
+				continue
+			}
+
+			if err := uc.send(ctx, workspaceID, kind, c, a); err != nil {
+				errutil.Handle(ctx, goerr.Wrap(err, "send action reminder",
+					goerr.V("workspace_id", workspaceID),
+					goerr.V(ActionIDKey, a.ID),
+					goerr.V("kind", kind)), "action reminder: send")
+				if err := uc.repo.ActionReminder().Release(ctx, workspaceID, a.ID, kind, *a.DueDate); err != nil {
+					errutil.Handle(ctx, err, "action reminder: release")
+				}
+				continue
+			}
+			sent++
+		}
+	}
+
+	logging.From(ctx).Info("action reminder sweep completed",
+		slog.String("workspace_id", workspaceID),
+		slog.Int("cases", len(cases)),
+		slog.Int("sent", sent))
+	return nil
+}
+
+// send delivers one reminder. Having nobody to tell (no assignee for the
+// heads-up, no channel for the case) is not an error: the claim stands and
+// the reminder is not retried.
+func (uc *ActionReminderUseCase) send(ctx context.Context, workspaceID string, kind model.ActionReminderKind, c *model.Case, a *model.Action) error {
+	due := a.DueDate.UTC().Format(time.DateOnly)
+	label := uc.actionLabel(workspaceID, a)
+
+	switch kind {
+	case model.ActionReminderDueSoon:
+		if a.AssigneeID == "" {
+			return nil
+		}
+		body := i18n.T(ctx, i18n.MsgActionDueSoon, label, slackTextEscaper.Replace(c.Title), due)
+		// chat.postMessage to a user ID delivers to the app's DM with that user.
+		if _, err := uc.slackService.PostMessage(ctx, a.AssigneeID, nil, body); err != nil {
+			return goerr.Wrap(err, "failed to DM action assignee", goerr.V("user_id", a.AssigneeID))
+		}
+		return nil
+
+	case model.ActionReminderOverdue:
+		body := i18n.T(ctx, i18n.MsgActionOverdue, label, due)
+		return uc.postToCase(ctx, c, a, mentionAll(a.AssigneeID), body)
+
+	case model.ActionReminderEscalated:
+		body := i18n.T(ctx, i18n.MsgActionEscalated, label, due)
+		return uc.postToCase(ctx, c, a, mentionAll(c.AssigneeIDs...), body)
+	}
+	return goerr.New("unknown action reminder kind", goerr.V("kind", kind))
+}
+
+// postToCase posts body, prefixed with mentions, where the case follows the
+// action: a reply in the action's thread, surfaced in the channel through
+// the notification slot when one is configured or as a broadcast reply
+// otherwise. An action whose card was never posted gets a channel message.
+func (uc *ActionReminderUseCase) postToCase(ctx context.Context, c *model.Case, a *model.Action, mentions, body string) error {
+	if c.SlackChannelID == "" {
+		return nil
+	}
+	text := body
+	if mentions != "" {
+		text = mentions + " " + body
+	}
+	blocks := []goslack.Block{
+		goslack.NewContextBlock("", goslack.NewTextBlockObject(goslack.MarkdownType, text, false, false)),
+	}
+
+	if a.SlackMessageTS == "" {
+		if _, err := uc.slackService.PostMessage(ctx, c.SlackChannelID, blocks, text); err != nil {
+			return goerr.Wrap(err, "failed to post action reminder to case channel")
+		}
+		return nil
+	}
+
+	aggregate := uc.slotCoord.enabled()
+	var opts []slack.PostThreadOption
+	if !aggregate {
+		opts = append(opts, slack.WithBroadcastToChannel())
+	}
+	if _, err := uc.slackService.PostThreadMessage(ctx, c.SlackChannelID, a.SlackMessageTS, blocks, text, opts...); err != nil {
+		return goerr.Wrap(err, "failed to post action reminder to action thread")
+	}
+	if aggregate {
+		uc.slotCoord.enqueueChannelLine(ctx, c.SlackChannelID, slotEntry{
+			ActionMessageTS: a.SlackMessageTS,
+			ActionTitle:     a.Title,
+			Body:            body,
+		})
+	}
+	return nil
+}
+
+// actionLabel renders the action's title in bold, linked to the Web UI when
+// a base URL is configured.
+func (uc *ActionReminderUseCase) actionLabel(workspaceID string, a *model.Action) string {
+	if url := buildActionWebURL(uc.baseURL, workspaceID, a.CaseID, a.ID); url != "" {
+		return fmt.Sprintf("*<%s|%s>*", url, slackTextEscaper.Replace(a.Title))
+	}
+	return "*" + slackTextEscaper.Replace(a.Title) + "*"
+}
+
+// mentionAll renders a mention for each non-empty Slack user ID.
+func mentionAll(userIDs ...string) string {
+	mentions := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		if id != "" {
+			mentions = append(mentions, mentionUser(id))
+		}
+	}
+	return strings.Join(mentions, " ")
+}
@@ -0,0 +1,138 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+	"github.com/secmon-lab/hecatoncheires/pkg/i18n"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	slacksvc "github.com/secmon-lab/hecatoncheires/pkg/service/slack"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+	goslack "github.com/slack-go/slack"
+)
+
+// reminderSlackFake records channel posts ("channel|text") and thread replies
+// ("threadTS|text"). postErr fails every channel post while set.
+type reminderSlackFake struct {
+	mockSlackService
+	posts       []string
+	threadPosts []string
+	postErr     error
+}
+
+func (m *reminderSlackFake) PostMessage(_ context.Context, channelID string, _ []goslack.Block, text string) (string, error) {
+	if m.postErr != nil {
+		return "", m.postErr
+	}
+	m.posts = append(m.posts, channelID+"|"+text)
+	return "1700000000.000001", nil
+}
+
+func (m *reminderSlackFake) PostThreadMessage(_ context.Context, _ string, threadTS string, _ []goslack.Block, text string, _ ...slacksvc.PostThreadOption) (string, error) {
+	m.threadPosts = append(m.threadPosts, threadTS+"|"+text)
+	return "1700000000.000002", nil
+}
+
+func TestActionReminderUseCase_Sweep(t *testing.T) {
+	i18n.Init(i18n.LangEN)
+	ctx := context.Background()
+	due := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	deadline := due.AddDate(0, 0, 1)
+
+	setup := func(t *testing.T) (*usecase.ActionReminderUseCase, *reminderSlackFake, *memory.Memory, string, *model.Action) {
+		t.Helper()
+		repo := memory.New()
+		ws := newWS()
+		registry := model.NewWorkspaceRegistry()
+		registry.Register(&model.WorkspaceEntry{
+			Workspace:      model.Workspace{ID: ws, Name: "Test"},
+			ActionReminder: &model.ActionReminderPolicy{Before: 24 * time.Hour, EscalateAfter: 48 * time.Hour},
+		})
+		c, err := repo.Case().Create(ctx, ws, &model.Case{
+			Title:          "Phishing",
+			Status:         types.CaseStatusOpen,
+			SlackChannelID: "C-CASE",
+			AssigneeIDs:    []string{"U-LEAD"},
+		})
+		gt.NoError(t, err).Required()
+		a, err := repo.Action().Create(ctx, ws, &model.Action{
+			CaseID:         c.ID,
+			Title:          "Reset passwords",
+			AssigneeID:     "U-OWNER",
+			SlackMessageTS: "card-1",
+			Status:         types.ActionStatusTodo,
+			DueDate:        &due,
+		})
+		gt.NoError(t, err).Required()
+
+		slackSvc := &reminderSlackFake{}
+		return usecase.NewActionReminderUseCase(repo, registry, slackSvc, "", nil), slackSvc, repo, ws, a
+	}
+
+	t.Run("each stage is sent once", func(t *testing.T) {
+		uc, slackSvc, _, _, _ := setup(t)
+
+		gt.NoError(t, uc.SweepAtForTest(ctx, deadline.Add(-48*time.Hour))).Required()
+		gt.Array(t, slackSvc.posts).Length(0)
+
+		dueSoon := deadline.Add(-12 * time.Hour)
+		gt.NoError(t, uc.SweepAtForTest(ctx, dueSoon)).Required()
+		gt.NoError(t, uc.SweepAtForTest(ctx, dueSoon.Add(time.Hour))).Required()
+		gt.Array(t, slackSvc.posts).Length(1).Required()
+		gt.String(t, slackSvc.posts[0]).Equal("U-OWNER|:alarm_clock: Your action *Reset passwords* in *Phishing* is due on 2026-03-10.")
+
+		gt.NoError(t, uc.SweepAtForTest(ctx, deadline.Add(time.Hour))).Required()
+		gt.NoError(t, uc.SweepAtForTest(ctx, deadline.Add(2*time.Hour))).Required()
+		gt.Array(t, slackSvc.threadPosts).Length(1).Required()
+		gt.String(t, slackSvc.threadPosts[0]).Equal("card-1|<@U-OWNER> :warning: *Reset passwords* is overdue: it was due on 2026-03-10.")
+
+		gt.NoError(t, uc.SweepAtForTest(ctx, deadline.Add(49*time.Hour))).Required()
+		gt.NoError(t, uc.SweepAtForTest(ctx, deadline.Add(50*time.Hour))).Required()
+		gt.Array(t, slackSvc.threadPosts).Length(2).Required()
+		gt.String(t, slackSvc.threadPosts[1]).Contains("<@U-LEAD> :rotating_light: *Reset passwords* is still not done")
+	})
+
+	t.Run("a moved due date arms the reminders again", func(t *testing.T) {
+		uc, slackSvc, repo, ws, a := setup(t)
+
+		gt.NoError(t, uc.SweepAtForTest(ctx, deadline.Add(time.Hour))).Required()
+		gt.Array(t, slackSvc.threadPosts).Length(1)
+
+		later := due.AddDate(0, 0, 7)
+		a.DueDate = &later
+		_, err := repo.Action().Update(ctx, ws, a)
+		gt.NoError(t, err).Required()
+
+		gt.NoError(t, uc.SweepAtForTest(ctx, later.AddDate(0, 0, 1).Add(time.Hour))).Required()
+		gt.Array(t, slackSvc.threadPosts).Length(2)
+	})
+
+	t.Run("closed actions get no reminder", func(t *testing.T) {
+		uc, slackSvc, repo, ws, a := setup(t)
+		a.Status = types.ActionStatusCompleted
+		_, err := repo.Action().Update(ctx, ws, a)
+		gt.NoError(t, err).Required()
+
+		gt.NoError(t, uc.SweepAtForTest(ctx, deadline.Add(49*time.Hour))).Required()
+		gt.Array(t, slackSvc.posts).Length(0)
+		gt.Array(t, slackSvc.threadPosts).Length(0)
+	})
+
+	t.Run("a failed send is retried on the next sweep", func(t *testing.T) {
+		uc, slackSvc, _, _, _ := setup(t)
+		dueSoon := deadline.Add(-12 * time.Hour)
+
+		slackSvc.postErr = errors.New("channel_not_found")
+		gt.NoError(t, uc.SweepAtForTest(ctx, dueSoon)).Required()
+		gt.Array(t, slackSvc.posts).Length(0)
+
+		slackSvc.postErr = nil
+		gt.NoError(t, uc.SweepAtForTest(ctx, dueSoon)).Required()
+		gt.Array(t, slackSvc.posts).Length(1)
+	})
+}
@@ -148,6 +148,47 @@ func TestActionUseCase_UpdateAction(t *testing.T) {
 		gt.Value(t, updated.CaseID).Equal(c.ID)
 	})
 
+	t.Run("completing an action publishes action_completed on its case", func(t *testing.T) {
+		repo := memory.New()
+		caseUC := usecase.NewCaseUseCase(repo, nil, nil, nil, "")
+		actionUC := usecase.NewActionUseCase(repo, nil, nil, "", nil)
+		pub := &recordingCaseEventPublisher{}
+		actionUC.SetEventPublisher(pub)
+		ctx := auth.ContextWithToken(context.Background(), &auth.Token{Sub: "UTESTUSER"})
+
+		c, err := caseUC.CreateCase(ctx, testWorkspaceID, "Test Case", "", []string{}, nil, false, false, "", "")
+		gt.NoError(t, err).Required()
+		created, err := actionUC.CreateAction(ctx, testWorkspaceID, c.ID, "Investigate", "", "", "", types.ActionStatusTodo, nil)
+		gt.NoError(t, err).Required()
+
+		inProgress := types.ActionStatusInProgress
+		_, err = actionUC.UpdateAction(ctx, testWorkspaceID, usecase.UpdateActionInput{
+			ID: created.ID, Status: &inProgress, SlackSync: usecase.SlackSyncSkip,
+		})
+		gt.NoError(t, err).Required()
+		gt.Array(t, pub.events).Length(0)
+
+		completed := types.ActionStatusCompleted
+		_, err = actionUC.UpdateAction(ctx, testWorkspaceID, usecase.UpdateActionInput{
+			ID: created.ID, Status: &completed, SlackSync: usecase.SlackSyncSkip,
+		})
+		gt.NoError(t, err).Required()
+		gt.Array(t, pub.events).Length(1).Required()
+		ev := pub.events[0]
+		gt.Value(t, ev.lifecycle).Equal(model.CaseLifecycleActionCompleted)
+		gt.Value(t, ev.caseID).Equal(c.ID)
+		gt.Value(t, ev.change.ActionID).Equal(created.ID)
+		gt.Array(t, ev.change.To).Equal([]string{string(types.ActionStatusCompleted)})
+		gt.String(t, ev.actor).Equal("UTESTUSER")
+
+		// Re-saving a closed action is not another completion.
+		_, err = actionUC.UpdateAction(ctx, testWorkspaceID, usecase.UpdateActionInput{
+			ID: created.ID, Status: &completed, SlackSync: usecase.SlackSyncSkip,
+		})
+		gt.NoError(t, err).Required()
+		gt.Array(t, pub.events).Length(1)
+	})
+
 	t.Run("update action caseID", func(t *testing.T) {
 		repo := memory.New()
 		caseUC := usecase.NewCaseUseCase(repo, nil, nil, nil, "")
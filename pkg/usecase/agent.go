@@ -48,6 +48,10 @@ type AgentUseCase struct {
 	// RegisterAgents, which runs only when a Kernel is being built.
 	durableWorkspaceAgent *wsagent.Durable
 	durableThreadcase     *threadcase.Durable
+
+	// kernel is what an approval click is delivered through. Filled by
+	// BindAgentKernel; until then the approval buttons stand down.
+	kernel *agentkit.Kernel
 }
 
 // AgentDeps groups the dependencies AgentUseCase needs. Required fields are
@@ -195,6 +199,7 @@ func (uc *AgentUseCase) BindAgentKernel(k *agentkit.Kernel, probe *agentkernel.T
 	}
 	uc.durableWorkspaceAgent.Bind(k, probe)
 	uc.durableThreadcase.Bind(k, probe)
+	uc.kernel = k
 }
 
 // HandleAgentMention processes an app_mention event and responds with an AI agent
@@ -38,6 +38,10 @@ const (
 	// a host that asked to wait in-band (Input.SuspendOnQuestion); every other host
 	// ends the turn on a question instead.
 	phaseAnswer = "answer"
+	// phasePlannerApproval makes a planner tool call once a person has decided on
+	// it. It is reached only from phasePlannerTool, through a suspend on
+	// ApprovalKey.
+	phasePlannerApproval = "planner_approval"
 )
 
 // Model roles. A host binds them to a specific model through the Kernel; an
@@ -277,6 +281,11 @@ type state struct {
 	// AnswerKey is the await a suspended run is waiting on. It is per-round so a
 	// follow-up question opens a fresh await rather than reusing a closed one.
 	AnswerKey agentkit.AwaitKey `json:"answer_key,omitempty"`
+	// ApprovalKey is the await a planner tool call gated on human approval is
+	// parked on. Approvals counts the awaits opened so far, so each gets a fresh
+	// key.
+	ApprovalKey agentkit.AwaitKey `json:"approval_key,omitempty"`
+	Approvals   int               `json:"approvals,omitempty"`
 }
 
 // taskRef ties a planned task to the child Process running it. The plan fields
@@ -371,6 +380,8 @@ func (s *strategy[T]) Step(ctx context.Context, sys agentkit.Syscalls, st state)
 		return s.stepPlannerTool(ctx, sys, st)
 	case phaseAnswer:
 		return s.stepAnswer(ctx, sys, st)
+	case phasePlannerApproval:
+		return s.stepPlannerApproval(ctx, sys, st)
 	default:
 		return st, agentkit.Decision[Output[T]]{}, goerr.New("planexec: unknown phase",
 			goerr.V("phase", st.Phase))
@@ -529,40 +540,89 @@ func (s *strategy[T]) divertToTools(st state, res *agentkit.GenerateResult, from
 // call is still answered. A refusal from the budget is the one error that must not
 // be swallowed — continuing past it would spend beyond a ceiling its owner
 // declared closed.
+//
+// A call the operator gated on human approval suspends the run instead, and
+// stepPlannerApproval makes it once a person has decided.
 func (s *strategy[T]) stepPlannerTool(ctx context.Context, sys agentkit.Syscalls, st state) (state, agentkit.Decision[Output[T]], error) {
 	if len(st.PendingCalls) == 0 {
 		st.Phase = st.AfterTool
 		return st, agentkit.Continue[Output[T]](), nil
 	}
 
-	call := st.PendingCalls[0]
+	if st.PendingCalls[0] == nil {
+		st.PendingCalls = st.PendingCalls[1:]
+		return s.afterPlannerTool(st), agentkit.Continue[Output[T]](), nil
+	}
+	call := toolargs.Coerce(sys.Tools(), *st.PendingCalls[0])
+
+	// A gated call stays at the head of PendingCalls across the suspend, exactly
+	// as in react.stepTool, so the resuming transition makes the call the person
+	// was shown.
+	key := agentkit.AwaitKey(fmt.Sprintf("approval:%d", st.Approvals+1))
+	ctx, payload, suspend := react.RequestApproval(ctx, sys, call, key)
+	if suspend {
+		st.Approvals++
+		st.ApprovalKey = key
+		st.Phase = phasePlannerApproval
+		return st, agentkit.Suspend[Output[T]](agentkit.Question(key, payload)), nil
+	}
+
 	st.PendingCalls = st.PendingCalls[1:]
-	if call != nil {
-		if _, err := sys.Session().CallTool(ctx, toolargs.Coerce(sys.Tools(), *call)); err != nil {
-			if errors.Is(err, agentkit.ErrLimitExceeded) {
-				return st, agentkit.Decision[Output[T]]{}, goerr.Wrap(err,
-					"planexec: planner tool call refused by the budget",
-					goerr.V("tool", call.Name))
-			}
-			// Reported as well as fed back: the planner needs the failure to react
-			// to, and an operator needs it to tell a broken tool from a model that
-			// chose not to use its result.
-			errutil.Handle(ctx, goerr.Wrap(err, "planexec: planner tool call",
-				goerr.V("tool", call.Name), goerr.V("call_id", call.ID)),
-				"planexec: planner tool call")
-		}
-		// Answered either way: a failed call is recorded in the conversation as an
-		// error result, which is what the planner reacts to on its next call.
-		st.ToolsAnswered = true
+	return s.callPlannerTool(ctx, sys, st, call)
+}
+
+// stepPlannerApproval makes the planner tool call a person has decided on; the
+// kernel's approval middleware lets it through or answers it with the denial.
+func (s *strategy[T]) stepPlannerApproval(ctx context.Context, sys agentkit.Syscalls, st state) (state, agentkit.Decision[Output[T]], error) {
+	if len(st.PendingCalls) == 0 || st.PendingCalls[0] == nil {
+		return st, agentkit.Decision[Output[T]]{}, goerr.New("planexec: no planner tool call is waiting for approval",
+			goerr.V("key", string(st.ApprovalKey)))
+	}
+	call := toolargs.Coerce(sys.Tools(), *st.PendingCalls[0])
+
+	ctx, err := react.ApprovalContext(ctx, sys, call, st.ApprovalKey)
+	if err != nil {
+		return st, agentkit.Decision[Output[T]]{}, goerr.Wrap(err, "planexec: resume from approval",
+			goerr.V("tool", call.Name))
 	}
+	st.ApprovalKey = ""
+	st.PendingCalls = st.PendingCalls[1:]
+	return s.callPlannerTool(ctx, sys, st, call)
+}
 
-	// A planner that has spent its allowance is told so in the SYSTEM prompt of the
-	// call this returns to (see plannerPrompt), not here: that call continues from
-	// the results and sends no user turn that could carry the instruction.
+// callPlannerTool makes call, which the caller has already taken off
+// PendingCalls, and answers it in the conversation.
+func (s *strategy[T]) callPlannerTool(ctx context.Context, sys agentkit.Syscalls, st state, call gollem.FunctionCall) (state, agentkit.Decision[Output[T]], error) {
+	if _, err := sys.Session().CallTool(ctx, call); err != nil {
+		if errors.Is(err, agentkit.ErrLimitExceeded) {
+			return st, agentkit.Decision[Output[T]]{}, goerr.Wrap(err,
+				"planexec: planner tool call refused by the budget",
+				goerr.V("tool", call.Name))
+		}
+		// Reported as well as fed back: the planner needs the failure to react
+		// to, and an operator needs it to tell a broken tool from a model that
+		// chose not to use its result.
+		errutil.Handle(ctx, goerr.Wrap(err, "planexec: planner tool call",
+			goerr.V("tool", call.Name), goerr.V("call_id", call.ID)),
+			"planexec: planner tool call")
+	}
+	// Answered either way: a failed call is recorded in the conversation as an
+	// error result, which is what the planner reacts to on its next call.
+	st.ToolsAnswered = true
+	return s.afterPlannerTool(st), agentkit.Continue[Output[T]](), nil
+}
+
+// afterPlannerTool picks the phase that follows one planner tool call.
+//
+// A planner that has spent its allowance is told so in the SYSTEM prompt of the
+// call this returns to (see plannerPrompt), not here: that call continues from
+// the results and sends no user turn that could carry the instruction.
+func (s *strategy[T]) afterPlannerTool(st state) state {
+	st.Phase = phasePlannerTool
 	if len(st.PendingCalls) == 0 {
 		st.Phase = st.AfterTool
 	}
-	return st, agentkit.Continue[Output[T]](), nil
+	return st
 }
 
 // stepCollect folds the finished children into observations. It makes no LLM
@@ -319,7 +319,7 @@ func (stubMemoMutator) ArchiveMemo(context.Context, string, int64, model.MemoID)
 // stubKnowledgeAccessor / stubKnowledgeMutator are no-op knowledge backends.
 type stubKnowledgeAccessor struct{}
 
-func (stubKnowledgeAccessor) SearchKnowledge(context.Context, string, string, []model.TagID, int) ([]*model.Knowledge, error) {
+func (stubKnowledgeAccessor) SearchKnowledge(context.Context, string, string, []model.TagID, int, bool) ([]*model.KnowledgeSearchHit, error) {
 	return nil, nil
 }
 
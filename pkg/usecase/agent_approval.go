@@ -0,0 +1,298 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gollem-dev/agentkit"
+	"github.com/m-mizutani/goerr/v2"
+	goslack "github.com/slack-go/slack"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/agent/interaction"
+	agentkernel "github.com/secmon-lab/hecatoncheires/pkg/agent/kernel"
+	"github.com/secmon-lab/hecatoncheires/pkg/agent/runtrace"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/i18n"
+	"github.com/secmon-lab/hecatoncheires/pkg/service/slack"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
+)
+
+// Action IDs of the Approve / Deny buttons on an agent tool approval prompt.
+const (
+	ActionIDToolApprovalApprove = "agent_tool_approve"
+	ActionIDToolApprovalDeny    = "agent_tool_deny"
+)
+
+// toolApprovalArgsMaxBytes bounds the rendered arguments on the prompt. A
+// section block takes at most 3000 characters, and the arguments share it with
+// the code fence.
+const toolApprovalArgsMaxBytes = 2500
+
+// toolApprovals is the kernel's human-approval gate: which tools the workspace
+// gated ([approval] tools), and the Slack prompt that asks about one call.
+type toolApprovals struct {
+	repo     interfaces.Repository
+	registry *model.WorkspaceRegistry
+	slack    slack.Service
+}
+
+// newToolApprovals returns the gate the kernel enforces. It is built even
+// without Slack: a workspace that gated a tool must not have it run unattended
+// just because nobody can be asked, so the prompt then fails to deliver and the
+// call is denied.
+func newToolApprovals(repo interfaces.Repository, registry *model.WorkspaceRegistry, svc slack.Service) agentkernel.ToolApprovals {
+	if registry == nil {
+		return nil
+	}
+	return &toolApprovals{repo: repo, registry: registry, slack: svc}
+}
+
+func (a *toolApprovals) RequiresApproval(workspaceID, tool string) bool {
+	entry, err := a.registry.Get(workspaceID)
+	if err != nil {
+		return false
+	}
+	return entry.RequiresApproval(tool)
+}
+
+// RequestApproval posts the Approve / Deny prompt for one call.
+//
+// It goes to the thread the person who started the run is watching. A run with
+// no thread of its own — a Job — falls back to its case's thread, resolved now
+// rather than at spawn because the case may have been rethreaded since.
+//
+// A run with no case is only asked about when the workspace names approvers
+// ([approval] approvers): without them nobody could answer the prompt, so the
+// call is refused instead.
+func (a *toolApprovals) RequestApproval(ctx context.Context, sc agentkernel.Scope,
+	ticket interaction.ApprovalTicket, req interaction.ApprovalRequest,
+) error {
+	if a.slack == nil {
+		return goerr.New("slack is not configured; nobody can approve the tool call",
+			goerr.V("tool", req.Tool))
+	}
+	if sc.CaseID == 0 {
+		entry, err := a.registry.Get(sc.WorkspaceID)
+		if err != nil || len(entry.ApprovalApprovers) == 0 {
+			return goerr.New("the run has no case and the workspace names no approvers; nobody can approve the tool call",
+				goerr.V("tool", req.Tool), goerr.V("workspace_id", sc.WorkspaceID))
+		}
+	}
+
+	channelID, threadTS := sc.UITarget()
+	if channelID == "" && sc.CaseID != 0 {
+		c, err := a.repo.Case().Get(ctx, sc.WorkspaceID, sc.CaseID)
+		if err != nil {
+			return goerr.Wrap(err, "load the case to post the approval prompt",
+				goerr.V("case_id", sc.CaseID))
+		}
+		channelID, threadTS = c.SlackChannelID, c.SlackThreadTS
+	}
+	if channelID == "" {
+		return goerr.New("the run has no Slack thread to ask for approval in",
+			goerr.V("tool", req.Tool), goerr.V("process", ticket.ProcessID))
+	}
+
+	blocks, fallback := buildToolApprovalBlocks(ctx, toolApprovalPrompt{
+		Ticket:      ticket,
+		Tool:        req.Tool,
+		WorkspaceID: sc.WorkspaceID,
+		CaseID:      sc.CaseID,
+		RequesterID: sc.ActorUserID,
+	}, req)
+	var err error
+	if threadTS == "" {
+		_, err = a.slack.PostMessage(ctx, channelID, blocks, fallback)
+	} else {
+		_, err = a.slack.PostThreadMessage(ctx, channelID, threadTS, blocks, fallback)
+	}
+	if err != nil {
+		return goerr.Wrap(err, "post the approval prompt",
+			goerr.V("channel_id", channelID), goerr.V("tool", req.Tool))
+	}
+	return nil
+}
+
+// toolApprovalPrompt is what an approval prompt's buttons carry: the await to
+// answer, the tool, and who may answer it — the run's workspace and case, and
+// the user whose request started the run.
+type toolApprovalPrompt struct {
+	Ticket      interaction.ApprovalTicket
+	Tool        string
+	WorkspaceID string
+	CaseID      int64
+	RequesterID string
+}
+
+// buildToolApprovalBlocks renders the prompt: what the agent wants to run, the
+// exact arguments, and the two buttons. The buttons carry the prompt, which is
+// everything the click handler needs.
+func buildToolApprovalBlocks(ctx context.Context, prompt toolApprovalPrompt,
+	req interaction.ApprovalRequest,
+) ([]goslack.Block, string) {
+	text := i18n.T(ctx, i18n.MsgToolApprovalPrompt, req.Tool)
+	blocks := []goslack.Block{
+		goslack.NewSectionBlock(goslack.NewTextBlockObject(goslack.MarkdownType, text, false, false), nil, nil),
+	}
+	if len(req.Arguments) > 0 {
+		args, err := json.MarshalIndent(req.Arguments, "", "  ")
+		if err != nil {
+			args = []byte(err.Error())
+		}
+		body := "```" + runtrace.Truncate(string(args), toolApprovalArgsMaxBytes) + "```"
+		blocks = append(blocks, goslack.NewSectionBlock(
+			goslack.NewTextBlockObject(goslack.MarkdownType, body, false, false), nil, nil))
+	}
+
+	value := encodeToolApprovalValue(prompt)
+	approve := goslack.NewButtonBlockElement(ActionIDToolApprovalApprove, value,
+		goslack.NewTextBlockObject(goslack.PlainTextType, i18n.T(ctx, i18n.MsgToolApprovalApprove), false, false))
+	approve.Style = goslack.StylePrimary
+	deny := goslack.NewButtonBlockElement(ActionIDToolApprovalDeny, value,
+		goslack.NewTextBlockObject(goslack.PlainTextType, i18n.T(ctx, i18n.MsgToolApprovalDeny), false, false))
+	deny.Style = goslack.StyleDanger
+	blocks = append(blocks, goslack.NewActionBlock("agent_tool_approval", approve, deny))
+	return blocks, text
+}
+
+// toolApprovalValueSep joins the button value's parts. None of a process id,
+// an await key, a tool name, a workspace id or a Slack user id contains it.
+const toolApprovalValueSep = "|"
+
+func encodeToolApprovalValue(p toolApprovalPrompt) string {
+	return strings.Join([]string{
+		p.Ticket.ProcessID, p.Ticket.AwaitKey, p.Tool,
+		p.WorkspaceID, strconv.FormatInt(p.CaseID, 10), p.RequesterID,
+	}, toolApprovalValueSep)
+}
+
+func parseToolApprovalValue(v string) (toolApprovalPrompt, bool) {
+	parts := strings.Split(v, toolApprovalValueSep)
+	if len(parts) != 6 || parts[0] == "" || parts[1] == "" {
+		return toolApprovalPrompt{}, false
+	}
+	caseID, err := strconv.ParseInt(parts[4], 10, 64)
+	if err != nil {
+		return toolApprovalPrompt{}, false
+	}
+	return toolApprovalPrompt{
+		Ticket:      interaction.ApprovalTicket{ProcessID: parts[0], AwaitKey: parts[1]},
+		Tool:        parts[2],
+		WorkspaceID: parts[3],
+		CaseID:      caseID,
+		RequesterID: parts[5],
+	}, true
+}
+
+// mayDecideToolApproval reports whether userID may answer the prompt. The user
+// whose request started the run never may: the gate exists so a second person
+// looks at the call. For a run on a case, the decider must also belong to it —
+// an assignee, a member of its channel, or the reporter of a case that has no
+// channel yet — so a channel member outside a private case cannot release it.
+// A run with no case has no membership to check, so only the workspace's
+// [approval] approvers may decide, and nobody when it names none.
+func (uc *AgentUseCase) mayDecideToolApproval(ctx context.Context, p toolApprovalPrompt, userID string) (bool, error) {
+	if userID == "" || userID == p.RequesterID {
+		return false, nil
+	}
+	if p.CaseID == 0 {
+		entry, err := uc.deps.Registry.Get(p.WorkspaceID)
+		if err != nil {
+			return false, nil
+		}
+		return slices.Contains(entry.ApprovalApprovers, userID), nil
+	}
+	c, err := uc.deps.Repo.Case().Get(ctx, p.WorkspaceID, p.CaseID)
+	if err != nil {
+		return false, goerr.Wrap(err, "load the case to check the approver",
+			goerr.V("workspace_id", p.WorkspaceID), goerr.V(CaseIDKey, p.CaseID))
+	}
+	return c.ReporterID == userID ||
+		slices.Contains(c.AssigneeIDs, userID) ||
+		slices.Contains(c.ChannelUserIDs, userID), nil
+}
+
+// HandleToolApprovalClick delivers a click on an approval prompt to the
+// suspended run and replaces the buttons with who decided.
+//
+// Only a user mayDecideToolApproval accepts can decide. Anyone else is told so
+// in an ephemeral message, and the prompt stays as it is for someone who can.
+//
+// The decision is delivered first and the message updated only once it landed,
+// so the thread never says "approved" for a call that will not run. A prompt
+// whose await is already closed — answered by someone else, or the run ended —
+// is marked no longer active instead.
+func (uc *AgentUseCase) HandleToolApprovalClick(ctx context.Context, callback *goslack.InteractionCallback, action *goslack.BlockAction) error {
+	if callback == nil || action == nil {
+		return goerr.New("nil callback or action")
+	}
+	ctx = contextWithSlackUserLang(ctx, uc.deps.SlackService, callback.User.ID)
+
+	prompt, ok := parseToolApprovalValue(action.Value)
+	if !ok {
+		return goerr.New("malformed tool approval value", goerr.V("value", action.Value))
+	}
+	ticket, tool := prompt.Ticket, prompt.Tool
+
+	allowed, err := uc.mayDecideToolApproval(ctx, prompt, callback.User.ID)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		uc.refuseToolApprovalClick(ctx, callback.Channel.ID, callback.User.ID, tool)
+		return nil
+	}
+	if uc.kernel == nil {
+		return nil
+	}
+
+	approved := action.ActionID == ActionIDToolApprovalApprove
+	raw, err := interaction.Approval{Approved: approved, UserID: callback.User.ID}.Encode()
+	if err != nil {
+		return err
+	}
+
+	channelID, messageTS := callback.Channel.ID, callback.Message.Timestamp
+	if err := uc.kernel.Respond(ctx, agentkit.ProcessID(ticket.ProcessID),
+		agentkit.AwaitKey(ticket.AwaitKey), raw); err != nil {
+		uc.updateToolApprovalPrompt(ctx, channelID, messageTS, i18n.T(ctx, i18n.MsgToolApprovalStale))
+		return goerr.Wrap(err, "deliver the tool approval decision",
+			goerr.V("process", ticket.ProcessID), goerr.V("key", ticket.AwaitKey))
+	}
+
+	msg := i18n.MsgToolApprovalDenied
+	if approved {
+		msg = i18n.MsgToolApprovalApproved
+	}
+	uc.updateToolApprovalPrompt(ctx, channelID, messageTS, i18n.T(ctx, msg, mentionUser(callback.User.ID), tool))
+	return nil
+}
+
+// refuseToolApprovalClick tells userID, and only them, that they cannot decide
+// on the prompt. Best effort: nothing was delivered either way.
+func (uc *AgentUseCase) refuseToolApprovalClick(ctx context.Context, channelID, userID, tool string) {
+	if uc.deps.SlackService == nil || channelID == "" {
+		return
+	}
+	if err := uc.deps.SlackService.PostEphemeral(ctx, channelID, userID,
+		i18n.T(ctx, i18n.MsgToolApprovalRefused, tool)); err != nil {
+		errutil.Handle(ctx, err, "post tool approval refusal")
+	}
+}
+
+// updateToolApprovalPrompt replaces the prompt with text, removing the buttons
+// so the same await is not clicked again. Best effort: the decision has already
+// been delivered or refused.
+func (uc *AgentUseCase) updateToolApprovalPrompt(ctx context.Context, channelID, messageTS, text string) {
+	if uc.deps.SlackService == nil || channelID == "" || messageTS == "" {
+		return
+	}
+	block := goslack.NewSectionBlock(goslack.NewTextBlockObject(goslack.MarkdownType, text, false, false), nil, nil)
+	if err := uc.deps.SlackService.UpdateMessage(ctx, channelID, messageTS, []goslack.Block{block}, text); err != nil {
+		errutil.Handle(ctx, err, "update tool approval prompt")
+	}
+}
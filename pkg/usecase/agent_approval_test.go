@@ -0,0 +1,186 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	goslack "github.com/slack-go/slack"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/agent/interaction"
+	agentkernel "github.com/secmon-lab/hecatoncheires/pkg/agent/kernel"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+)
+
+func TestToolApprovals(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.New()
+	registry := model.NewWorkspaceRegistry()
+	registry.Register(&model.WorkspaceEntry{
+		Workspace:     model.Workspace{ID: "ws", Name: "WS"},
+		ApprovalTools: []string{"case__close_case"},
+	})
+	slackSvc := &commentSlackFake{}
+	approvals := usecase.NewToolApprovalsForTest(repo, registry, slackSvc)
+
+	t.Run("only the configured tools in the workspace are gated", func(t *testing.T) {
+		gt.Bool(t, approvals.RequiresApproval("ws", "case__close_case")).True()
+		gt.Bool(t, approvals.RequiresApproval("ws", "case__update_case")).False()
+		gt.Bool(t, approvals.RequiresApproval("unknown", "case__close_case")).False()
+	})
+
+	t.Run("a run without a thread asks in its case's thread", func(t *testing.T) {
+		created, err := repo.Case().Create(ctx, "ws", &model.Case{
+			Title: "c", SlackChannelID: "C-CASE", SlackThreadTS: "1700000000.000100",
+		})
+		gt.NoError(t, err).Required()
+
+		ticket := interaction.ApprovalTicket{ProcessID: "proc-1", AwaitKey: "approval:1"}
+		err = approvals.RequestApproval(ctx,
+			agentkernel.Scope{WorkspaceID: "ws", CaseID: created.ID, JobID: "triage", ActorUserID: "U-REQ"},
+			ticket, interaction.ApprovalRequest{Tool: "case__close_case", Arguments: map[string]any{"reason": "done"}})
+		gt.NoError(t, err).Required()
+
+		gt.Array(t, slackSvc.threadCalls).Length(1).Required()
+		call := slackSvc.threadCalls[0]
+		gt.Value(t, call.channelID).Equal("C-CASE")
+		gt.Value(t, call.threadTS).Equal("1700000000.000100")
+
+		var actionIDs []string
+		for _, btn := range approvalButtons(call.blocks) {
+			actionIDs = append(actionIDs, btn.ActionID)
+			prompt, ok := usecase.ParseToolApprovalValueForTest(btn.Value)
+			gt.Bool(t, ok).True()
+			gt.Value(t, prompt.Ticket).Equal(ticket)
+			gt.Value(t, prompt.Tool).Equal("case__close_case")
+			gt.Value(t, prompt.WorkspaceID).Equal("ws")
+			gt.Value(t, prompt.CaseID).Equal(created.ID)
+			gt.Value(t, prompt.RequesterID).Equal("U-REQ")
+		}
+		gt.Array(t, actionIDs).Equal([]string{usecase.ActionIDToolApprovalApprove, usecase.ActionIDToolApprovalDeny})
+	})
+
+	t.Run("a run with nowhere to ask fails", func(t *testing.T) {
+		err := approvals.RequestApproval(ctx, agentkernel.Scope{WorkspaceID: "ws"},
+			interaction.ApprovalTicket{ProcessID: "p", AwaitKey: "k"},
+			interaction.ApprovalRequest{Tool: "case__close_case"})
+		gt.Error(t, err)
+	})
+
+	t.Run("a run without a case is not asked about when the workspace names no approvers", func(t *testing.T) {
+		prompts := &commentSlackFake{}
+		noApprovers := usecase.NewToolApprovalsForTest(repo, registry, prompts)
+		err := noApprovers.RequestApproval(ctx,
+			agentkernel.Scope{WorkspaceID: "ws", ChannelID: "C-WS", ThreadTS: "1.2", ActorUserID: "U-REQ"},
+			interaction.ApprovalTicket{ProcessID: "p", AwaitKey: "k"},
+			interaction.ApprovalRequest{Tool: "case__close_case"})
+		gt.Error(t, err)
+		gt.Array(t, prompts.threadCalls).Length(0)
+	})
+
+	t.Run("without Slack nobody can be asked", func(t *testing.T) {
+		noSlack := usecase.NewToolApprovalsForTest(repo, registry, nil)
+		gt.Bool(t, noSlack.RequiresApproval("ws", "case__close_case")).True()
+		err := noSlack.RequestApproval(ctx, agentkernel.Scope{WorkspaceID: "ws", ChannelID: "C", ThreadTS: "1.2"},
+			interaction.ApprovalTicket{ProcessID: "p", AwaitKey: "k"},
+			interaction.ApprovalRequest{Tool: "case__close_case"})
+		gt.Error(t, err)
+	})
+}
+
+// approvalButtons returns the Approve / Deny buttons of a posted prompt.
+func approvalButtons(blocks []goslack.Block) []*goslack.ButtonBlockElement {
+	var buttons []*goslack.ButtonBlockElement
+	for _, b := range blocks {
+		if ab, ok := b.(*goslack.ActionBlock); ok {
+			for _, el := range ab.Elements.ElementSet {
+				if btn, ok := el.(*goslack.ButtonBlockElement); ok {
+					buttons = append(buttons, btn)
+				}
+			}
+		}
+	}
+	return buttons
+}
+
+func TestAgentUseCase_HandleToolApprovalClick(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.New()
+	registry := model.NewWorkspaceRegistry()
+	registry.Register(&model.WorkspaceEntry{
+		Workspace:         model.Workspace{ID: "ws", Name: "WS"},
+		ApprovalTools:     []string{"case__close_case"},
+		ApprovalApprovers: []string{"U-APPROVER", "U-REQ"},
+	})
+	private, err := repo.Case().Create(ctx, "ws", &model.Case{
+		Title: "private", ReporterID: "U-REPORTER", AssigneeIDs: []string{"U-ASSIGNEE"},
+		IsPrivate: true, ChannelUserIDs: []string{"U-REQ", "U-MEMBER"},
+		SlackChannelID: "C-CASE", SlackThreadTS: "1700000000.000100",
+	})
+	gt.NoError(t, err).Required()
+
+	// click asks on behalf of U-REQ's run on the private case, then clicks
+	// Approve as userID. The test wires no kernel, so an allowed click is
+	// accepted without anything to deliver it to.
+	click := func(t *testing.T, caseID int64, userID string) *mockSlackService {
+		t.Helper()
+		prompts := &commentSlackFake{}
+		approvals := usecase.NewToolApprovalsForTest(repo, registry, prompts)
+		gt.NoError(t, approvals.RequestApproval(ctx,
+			agentkernel.Scope{WorkspaceID: "ws", CaseID: caseID, ChannelID: "C-CASE", ThreadTS: "1700000000.000100", ActorUserID: "U-REQ"},
+			interaction.ApprovalTicket{ProcessID: "proc-1", AwaitKey: "approval:1"},
+			interaction.ApprovalRequest{Tool: "case__close_case"})).Required()
+		gt.Array(t, prompts.threadCalls).Length(1).Required()
+		buttons := approvalButtons(prompts.threadCalls[0].blocks)
+		gt.Array(t, buttons).Length(2).Required()
+
+		slackSvc := &mockSlackService{}
+		uc := usecase.NewAgentUseCase(usecase.AgentDeps{Repo: repo, Registry: registry, SlackService: slackSvc})
+		callback := &goslack.InteractionCallback{
+			User:    goslack.User{ID: userID},
+			Channel: goslack.Channel{GroupConversation: goslack.GroupConversation{Conversation: goslack.Conversation{ID: "C-CASE"}}},
+			Message: goslack.Message{Msg: goslack.Msg{Timestamp: "1700000000.000200"}},
+		}
+		gt.NoError(t, uc.HandleToolApprovalClick(ctx, callback, &goslack.BlockAction{
+			ActionID: buttons[0].ActionID, Value: buttons[0].Value,
+		})).Required()
+		return slackSvc
+	}
+
+	t.Run("the requester cannot approve their own run's call", func(t *testing.T) {
+		slackSvc := click(t, private.ID, "U-REQ")
+		gt.Value(t, slackSvc.ephemeralChannelID).Equal("C-CASE")
+		gt.Value(t, slackSvc.ephemeralUserID).Equal("U-REQ")
+		gt.String(t, slackSvc.ephemeralText).Contains("case__close_case")
+		gt.Array(t, slackSvc.updatedTexts).Length(0)
+	})
+
+	t.Run("a channel member outside a private case cannot approve", func(t *testing.T) {
+		slackSvc := click(t, private.ID, "U-OUTSIDER")
+		gt.Value(t, slackSvc.ephemeralUserID).Equal("U-OUTSIDER")
+		gt.Array(t, slackSvc.updatedTexts).Length(0)
+	})
+
+	t.Run("the requester cannot approve a run without a case either", func(t *testing.T) {
+		slackSvc := click(t, 0, "U-REQ")
+		gt.Value(t, slackSvc.ephemeralUserID).Equal("U-REQ")
+	})
+
+	t.Run("without a case only the workspace's approvers may decide", func(t *testing.T) {
+		slackSvc := click(t, 0, "U-MEMBER")
+		gt.Value(t, slackSvc.ephemeralUserID).Equal("U-MEMBER")
+		gt.Array(t, slackSvc.updatedTexts).Length(0)
+
+		slackSvc = click(t, 0, "U-APPROVER")
+		gt.Value(t, slackSvc.ephemeralUserID).Equal("")
+	})
+
+	t.Run("members, assignees and the reporter other than the requester may decide", func(t *testing.T) {
+		for _, userID := range []string{"U-MEMBER", "U-ASSIGNEE", "U-REPORTER"} {
+			slackSvc := click(t, private.ID, userID)
+			gt.Value(t, slackSvc.ephemeralUserID).Equal("")
+		}
+	})
+}
@@ -141,6 +141,14 @@ func (m *agentTestSlackService) UpdateView(_ context.Context, _ goslack.ModalVie
 	return nil
 }
 
+func (m *agentTestSlackService) PublishView(_ context.Context, _ string, _ goslack.HomeTabViewRequest) error {
+	return nil
+}
+
+func (m *agentTestSlackService) UnfurlMessage(_ context.Context, _, _ string, _ map[string]goslack.Attachment) error {
+	return nil
+}
+
 func (m *agentTestSlackService) PostEphemeral(_ context.Context, _ string, _ string, _ string) error {
 	return nil
 }
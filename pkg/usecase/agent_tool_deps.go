@@ -44,9 +44,21 @@ func (uc *UseCases) AgentToolDeps() agentkernel.ToolDeps {
 		MemoUC:            NewMemoToolAdapter(uc.Memo),
 		KnowledgeAccessor: NewKnowledgeToolAccessor(uc.Knowledge, uc.Tag),
 		KnowledgeMutator:  NewKnowledgeToolMutator(uc.Knowledge, uc.Tag),
+		Authorizer:        toolAuthorizer(uc.Authorizer),
+		Approvals:         newToolApprovals(uc.repo, uc.workspaceRegistry, uc.slackService),
 	}
 }
 
+// toolAuthorizer returns a as the kernel's ToolAuthorizer, or a nil interface
+// when no policy is configured, so the kernel skips the middleware instead of
+// calling through a typed nil on every tool call.
+func toolAuthorizer(a *PolicyAuthorizer) agentkernel.ToolAuthorizer {
+	if a == nil {
+		return nil
+	}
+	return a
+}
+
 // NewSlackPoster returns the narrow posting surface the channel-pinned Slack
 // tool is built from, or a nil interface when Slack is not configured.
 //
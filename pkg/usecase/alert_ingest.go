@@ -0,0 +1,250 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	goslack "github.com/slack-go/slack"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/config"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+	"github.com/secmon-lab/hecatoncheires/pkg/i18n"
+	"github.com/secmon-lab/hecatoncheires/pkg/service/slack"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
+)
+
+// AlertIngestUseCase turns alerts posted to a workspace's ingest endpoint
+// into cases. An alert whose fingerprint matches the latest alert of a case
+// that is still open is folded into that case instead of opening another.
+type AlertIngestUseCase struct {
+	repo         interfaces.Repository
+	registry     *model.WorkspaceRegistry
+	caseUC       *CaseUseCase
+	slackService slack.Service
+}
+
+// NewAlertIngestUseCase builds the use case. caseUC opens new cases, so they
+// go through the same validation, Slack activation and events as any other.
+func NewAlertIngestUseCase(repo interfaces.Repository, registry *model.WorkspaceRegistry, caseUC *CaseUseCase, slackService slack.Service) *AlertIngestUseCase {
+	return &AlertIngestUseCase{
+		repo:         repo,
+		registry:     registry,
+		caseUC:       caseUC,
+		slackService: slackService,
+	}
+}
+
+// AlertIngestResult reports what an ingested alert did.
+type AlertIngestResult struct {
+	Alert *model.Alert
+	Case  *model.Case
+	// Repeat is set when the alert was folded into an open case.
+	Repeat bool
+}
+
+// Ingest maps alert, the decoded body, through the workspace's [ingest]
+// section and either opens a case or folds the alert into the open case its
+// fingerprint already points at. raw is the body as received; it is kept on
+// the stored Alert. The reporter of a new case is taken from the auth token
+// in ctx, falling back to the mapping's Reporter.
+//
+// Deduplication is best-effort: two copies of a new alert arriving together
+// share a request key, so they open one case, but the fingerprint lookup
+// itself is not transactional.
+func (uc *AlertIngestUseCase) Ingest(ctx context.Context, workspaceID string, alert map[string]any, raw []byte) (*AlertIngestResult, error) {
+	entry, err := uc.registry.Get(workspaceID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to resolve workspace", goerr.V("workspace_id", workspaceID))
+	}
+	if entry.Ingest == nil {
+		return nil, goerr.Wrap(ErrIngestNotConfigured, "alert posted to a workspace without [ingest]",
+			goerr.V("workspace_id", workspaceID))
+	}
+
+	mapped, err := entry.Ingest.Map(alert)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to map alert", goerr.V("workspace_id", workspaceID))
+	}
+
+	prev, err := uc.repo.Alert().LatestByFingerprint(ctx, workspaceID, mapped.Fingerprint)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to look up previous alert",
+			goerr.V("workspace_id", workspaceID), goerr.V("fingerprint", mapped.Fingerprint))
+	}
+
+	if prev != nil {
+		open, err := uc.openCase(ctx, workspaceID, prev.CaseID)
+		if err != nil {
+			return nil, err
+		}
+		if open != nil {
+			stored, err := uc.record(ctx, workspaceID, open.ID, mapped, raw, true)
+			if err != nil {
+				return nil, err
+			}
+			uc.notifyRepeat(ctx, open, mapped.Title)
+			return &AlertIngestResult{Alert: stored, Case: open, Repeat: true}, nil
+		}
+	}
+
+	if _, err := auth.TokenFromContext(ctx); err != nil && entry.Ingest.Reporter != "" {
+		ctx = auth.ContextWithToken(ctx, &auth.Token{Sub: entry.Ingest.Reporter})
+	}
+
+	fieldValues, err := ingestFieldValues(entry.FieldSchema, mapped.Fields)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to convert alert fields", goerr.V("workspace_id", workspaceID))
+	}
+
+	// The key names the alert this one follows, so a redelivered alert reuses
+	// the case it opened while a recurrence after the case closed opens a new
+	// one.
+	after := "new"
+	if prev != nil {
+		after = prev.ID.String()
+	}
+	requestKey := "alert:" + mapped.Fingerprint + ":" + after
+
+	created, err := uc.caseUC.CreateCase(ctx, workspaceID, mapped.Title, mapped.Description, nil, fieldValues, false, false, "", requestKey)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to create case from alert", goerr.V("workspace_id", workspaceID))
+	}
+
+	stored, err := uc.record(ctx, workspaceID, created.ID, mapped, raw, false)
+	if err != nil {
+		return nil, err
+	}
+	return &AlertIngestResult{Alert: stored, Case: created}, nil
+}
+
+// ListByCase returns the alerts of a case, oldest first.
+func (uc *AlertIngestUseCase) ListByCase(ctx context.Context, workspaceID string, caseID int64) ([]*model.Alert, error) {
+	alerts, err := uc.repo.Alert().ListByCase(ctx, workspaceID, caseID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list alerts",
+			goerr.V("workspace_id", workspaceID), goerr.V(CaseIDKey, caseID))
+	}
+	return alerts, nil
+}
+
+// maxMergeHops bounds how many MergedInto links openCase follows. A merge
+// target is never itself merged at the time of the merge, so a chain only
+// forms when the survivor is merged again later; the bound guards against a
+// corrupt cycle.
+const maxMergeHops = 8
+
+// openCase returns the case an earlier alert opened if it still exists and
+// is open, or nil. A case merged into another stands for the case it went
+// into, so a repeat folds into the survivor: merges move the alerts along,
+// but one stored before its case was merged may still point at the source.
+func (uc *AlertIngestUseCase) openCase(ctx context.Context, workspaceID string, caseID int64) (*model.Case, error) {
+	for range maxMergeHops {
+		c, err := uc.repo.Case().Get(ctx, workspaceID, caseID)
+		if err != nil {
+			if isRepoNotFound(err) {
+				return nil, nil
+			}
+			return nil, goerr.Wrap(err, "failed to get case of previous alert",
+				goerr.V("workspace_id", workspaceID), goerr.V(CaseIDKey, caseID))
+		}
+		if c.MergedInto != 0 {
+			caseID = c.MergedInto
+			continue
+		}
+		if c.Status != types.CaseStatusOpen {
+			return nil, nil
+		}
+		return c, nil
+	}
+	return nil, nil
+}
+
+func (uc *AlertIngestUseCase) record(ctx context.Context, workspaceID string, caseID int64, mapped *model.MappedAlert, raw []byte, repeat bool) (*model.Alert, error) {
+	a := &model.Alert{
+		ID:          model.NewAlertID(),
+		WorkspaceID: workspaceID,
+		CaseID:      caseID,
+		Fingerprint: mapped.Fingerprint,
+		Title:       mapped.Title,
+		Payload:     model.TruncateAlertPayload(raw),
+		Repeat:      repeat,
+		ReceivedAt:  time.Now().UTC(),
+	}
+	if err := uc.repo.Alert().Create(ctx, a); err != nil {
+		return nil, goerr.Wrap(err, "failed to store alert",
+			goerr.V("workspace_id", workspaceID), goerr.V(CaseIDKey, caseID))
+	}
+	return a, nil
+}
+
+// notifyRepeat tells the case's Slack conversation that its alert fired
+// again: the case thread in thread mode, the case channel otherwise. Failure
+// is logged and does not fail the ingest; the alert is already on the case.
+func (uc *AlertIngestUseCase) notifyRepeat(ctx context.Context, c *model.Case, title string) {
+	if uc.slackService == nil || c.SlackChannelID == "" {
+		return
+	}
+	body := i18n.T(ctx, i18n.MsgAlertRepeated, title)
+	blocks := []goslack.Block{
+		goslack.NewContextBlock("", goslack.NewTextBlockObject(goslack.MarkdownType, body, false, false)),
+	}
+	var err error
+	if c.IsThreadBound() {
+		_, err = uc.slackService.PostThreadMessage(ctx, c.SlackChannelID, c.SlackThreadTS, blocks, body)
+	} else {
+		_, err = uc.slackService.PostMessage(ctx, c.SlackChannelID, blocks, body)
+	}
+	if err != nil {
+		errutil.Handle(ctx, goerr.Wrap(err, "failed to post repeated alert notice",
+			goerr.V(CaseIDKey, c.ID)), "failed to post repeated alert notice")
+	}
+}
+
+// ingestFieldValues converts the rendered field strings to the value shape
+// each field's type stores: a number for number fields, a comma-separated
+// list for multi-value fields, the string itself otherwise. Field IDs were
+// checked against the schema when the config was loaded.
+func ingestFieldValues(schema *config.FieldSchema, rendered map[string]string) (map[string]model.FieldValue, error) {
+	if len(rendered) == 0 || schema == nil {
+		return nil, nil
+	}
+	out := make(map[string]model.FieldValue, len(rendered))
+	for _, def := range schema.Fields {
+		raw, ok := rendered[def.ID]
+		if !ok {
+			continue
+		}
+		var value any
+		switch def.Type {
+		case types.FieldTypeNumber:
+			n, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				return nil, goerr.Wrap(model.ErrAlertMapping, "number field rendered a non-number",
+					goerr.V("field_id", def.ID), goerr.V("value", raw))
+			}
+			value = n
+		case types.FieldTypeMultiSelect, types.FieldTypeMultiUser, types.FieldTypeMultiCaseRef:
+			var items []string
+			for item := range strings.SplitSeq(raw, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					items = append(items, item)
+				}
+			}
+			value = items
+		default:
+			value = raw
+		}
+		out[def.ID] = model.FieldValue{
+			FieldID: types.FieldID(def.ID),
+			Type:    def.Type,
+			Value:   value,
+		}
+	}
+	return out, nil
+}
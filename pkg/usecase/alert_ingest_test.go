@@ -0,0 +1,213 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/config"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/service/slack"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+)
+
+func setupAlertIngest(t *testing.T, slackSvc slack.Service) (*usecase.AlertIngestUseCase, *memory.Memory, string) {
+	t.Helper()
+	ws := newWS()
+	repo := memory.New()
+	registry := model.NewWorkspaceRegistry()
+	registry.Register(&model.WorkspaceEntry{
+		Workspace: model.Workspace{ID: ws, Name: "SOC"},
+		FieldSchema: &config.FieldSchema{Fields: []config.FieldDefinition{
+			{ID: "severity", Name: "Severity", Type: types.FieldTypeSelect, Options: []config.FieldOption{
+				{ID: "high", Name: "High"}, {ID: "low", Name: "Low"},
+			}},
+			{ID: "score", Name: "Score", Type: types.FieldTypeNumber},
+			{ID: "hosts", Name: "Hosts", Type: types.FieldTypeMultiSelect, Options: []config.FieldOption{
+				{ID: "web-01", Name: "web-01"}, {ID: "web-02", Name: "web-02"},
+			}},
+		}},
+		Ingest: &model.IngestMapping{
+			Title:       "{{ .rule }}",
+			Description: "{{ .detail }}",
+			Fingerprint: "{{ .rule }}",
+			Fields: map[string]string{
+				"severity": "{{ .severity }}",
+				"score":    `{{ get . "score" }}`,
+				"hosts":    `{{ get . "hosts" }}`,
+			},
+			Reporter: "UALERTBOT",
+		},
+	})
+	caseUC := usecase.NewCaseUseCase(repo, registry, nil, nil, "")
+	return usecase.NewAlertIngestUseCase(repo, registry, caseUC, slackSvc), repo, ws
+}
+
+func ingestAlert(t *testing.T, uc *usecase.AlertIngestUseCase, ws string, alert map[string]any) (*usecase.AlertIngestResult, error) {
+	t.Helper()
+	raw, err := json.Marshal(alert)
+	gt.NoError(t, err).Required()
+	return uc.Ingest(context.Background(), ws, alert, raw)
+}
+
+func TestAlertIngestUseCase_OpensCase(t *testing.T) {
+	uc, repo, ws := setupAlertIngest(t, nil)
+
+	res, err := ingestAlert(t, uc, ws, map[string]any{
+		"rule":     "Impossible travel",
+		"detail":   "alice signed in from two continents",
+		"severity": "high",
+		"score":    "7.5",
+		"hosts":    "web-01, web-02",
+	})
+	gt.NoError(t, err).Required()
+	gt.Bool(t, res.Repeat).False()
+	gt.Value(t, res.Case.Title).Equal("Impossible travel")
+	gt.Value(t, res.Case.Status).Equal(types.CaseStatusOpen)
+	gt.Value(t, res.Case.ReporterID).Equal("UALERTBOT")
+
+	got, err := repo.Case().Get(context.Background(), ws, res.Case.ID)
+	gt.NoError(t, err).Required()
+	gt.Value(t, got.Description).Equal("alice signed in from two continents")
+	gt.Value(t, got.FieldValues["severity"].Value).Equal("high")
+	gt.Value(t, got.FieldValues["score"].Value).Equal(7.5)
+	gt.Array(t, got.FieldValues["hosts"].Value.([]string)).Equal([]string{"web-01", "web-02"})
+
+	alerts, err := uc.ListByCase(context.Background(), ws, res.Case.ID)
+	gt.NoError(t, err).Required()
+	gt.Array(t, alerts).Length(1).Required()
+	gt.Value(t, alerts[0].Title).Equal("Impossible travel")
+	gt.Bool(t, alerts[0].Repeat).False()
+}
+
+func TestAlertIngestUseCase_FoldsRepeatIntoOpenCase(t *testing.T) {
+	slackMock := &actionTestSlackMock{}
+	uc, repo, ws := setupAlertIngest(t, slackMock)
+	ctx := context.Background()
+	alert := map[string]any{"rule": "Impossible travel", "detail": "d", "severity": "high"}
+
+	first, err := ingestAlert(t, uc, ws, alert)
+	gt.NoError(t, err).Required()
+
+	// Bind the case to a channel so the repeat has somewhere to be announced.
+	c, err := repo.Case().Get(ctx, ws, first.Case.ID)
+	gt.NoError(t, err).Required()
+	c.SlackChannelID = "C-ALERT"
+	_, err = repo.Case().Update(ctx, ws, c)
+	gt.NoError(t, err).Required()
+
+	second, err := ingestAlert(t, uc, ws, alert)
+	gt.NoError(t, err).Required()
+	gt.Bool(t, second.Repeat).True()
+	gt.Value(t, second.Case.ID).Equal(first.Case.ID)
+	gt.Bool(t, slackMock.postMessageCalled).True()
+	gt.Value(t, slackMock.postMessageChannel).Equal("C-ALERT")
+
+	cases, err := repo.Case().List(ctx, ws)
+	gt.NoError(t, err).Required()
+	gt.Array(t, cases).Length(1)
+
+	alerts, err := uc.ListByCase(ctx, ws, first.Case.ID)
+	gt.NoError(t, err).Required()
+	gt.Array(t, alerts).Length(2).Required()
+	gt.Bool(t, alerts[1].Repeat).True()
+}
+
+func TestAlertIngestUseCase_ClosedCaseOpensNewCase(t *testing.T) {
+	uc, repo, ws := setupAlertIngest(t, nil)
+	ctx := context.Background()
+	alert := map[string]any{"rule": "Impossible travel", "detail": "d", "severity": "low"}
+
+	first, err := ingestAlert(t, uc, ws, alert)
+	gt.NoError(t, err).Required()
+	c, err := repo.Case().Get(ctx, ws, first.Case.ID)
+	gt.NoError(t, err).Required()
+	c.Status = types.CaseStatusClosed
+	_, err = repo.Case().Update(ctx, ws, c)
+	gt.NoError(t, err).Required()
+
+	second, err := ingestAlert(t, uc, ws, alert)
+	gt.NoError(t, err).Required()
+	gt.Bool(t, second.Repeat).False()
+	gt.Value(t, second.Case.ID).NotEqual(first.Case.ID)
+}
+
+func TestAlertIngestUseCase_RepeatAfterMergeFoldsIntoTarget(t *testing.T) {
+	ctx := context.Background()
+	travel := map[string]any{"rule": "Impossible travel", "detail": "d", "severity": "high"}
+	brute := map[string]any{"rule": "Brute force", "detail": "d", "severity": "high"}
+
+	t.Run("merge moves the alerts along", func(t *testing.T) {
+		uc, repo, ws := setupAlertIngest(t, nil)
+		source, err := ingestAlert(t, uc, ws, travel)
+		gt.NoError(t, err).Required()
+		target, err := ingestAlert(t, uc, ws, brute)
+		gt.NoError(t, err).Required()
+
+		caseUC := usecase.NewCaseUseCase(repo, nil, nil, nil, "")
+		_, err = caseUC.MergeCases(ctx, ws, target.Case.ID, []int64{source.Case.ID})
+		gt.NoError(t, err).Required()
+
+		repeat, err := ingestAlert(t, uc, ws, travel)
+		gt.NoError(t, err).Required()
+		gt.Bool(t, repeat.Repeat).True()
+		gt.Value(t, repeat.Case.ID).Equal(target.Case.ID)
+
+		alerts, err := uc.ListByCase(ctx, ws, target.Case.ID)
+		gt.NoError(t, err).Required()
+		gt.Array(t, alerts).Length(3)
+		cases, err := repo.Case().List(ctx, ws)
+		gt.NoError(t, err).Required()
+		gt.Array(t, cases).Length(2)
+	})
+
+	t.Run("an alert left on a merged case follows the merge", func(t *testing.T) {
+		uc, repo, ws := setupAlertIngest(t, nil)
+		source, err := ingestAlert(t, uc, ws, travel)
+		gt.NoError(t, err).Required()
+		target, err := ingestAlert(t, uc, ws, brute)
+		gt.NoError(t, err).Required()
+
+		// A source merged without its alerts moving, as before merges moved
+		// them.
+		_, err = repo.Case().Transact(ctx, ws, source.Case.ID, func(c *model.Case) error {
+			c.MergedInto = target.Case.ID
+			c.Status = types.CaseStatusClosed
+			return nil
+		})
+		gt.NoError(t, err).Required()
+
+		repeat, err := ingestAlert(t, uc, ws, travel)
+		gt.NoError(t, err).Required()
+		gt.Bool(t, repeat.Repeat).True()
+		gt.Value(t, repeat.Case.ID).Equal(target.Case.ID)
+	})
+}
+
+func TestAlertIngestUseCase_Rejects(t *testing.T) {
+	t.Run("alert that does not fit the mapping", func(t *testing.T) {
+		uc, _, ws := setupAlertIngest(t, nil)
+		_, err := ingestAlert(t, uc, ws, map[string]any{"detail": "no rule"})
+		gt.Error(t, err).Is(model.ErrAlertMapping)
+	})
+
+	t.Run("non-number for a number field", func(t *testing.T) {
+		uc, _, ws := setupAlertIngest(t, nil)
+		_, err := ingestAlert(t, uc, ws, map[string]any{"rule": "r", "detail": "d", "severity": "high", "score": "lots"})
+		gt.Error(t, err).Is(model.ErrAlertMapping)
+	})
+
+	t.Run("workspace without [ingest]", func(t *testing.T) {
+		ws := newWS()
+		registry := model.NewWorkspaceRegistry()
+		registry.Register(&model.WorkspaceEntry{Workspace: model.Workspace{ID: ws, Name: "Plain"}})
+		repo := memory.New()
+		uc := usecase.NewAlertIngestUseCase(repo, registry, usecase.NewCaseUseCase(repo, registry, nil, nil, ""), nil)
+		_, err := ingestAlert(t, uc, ws, map[string]any{"rule": "r"})
+		gt.Error(t, err).Is(usecase.ErrIngestNotConfigured)
+	})
+}
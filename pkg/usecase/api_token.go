@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
+)
+
+const (
+	// DefaultAPITokenExpiryDays is the lifetime of a token created without
+	// an explicit expiry.
+	DefaultAPITokenExpiryDays = 90
+	// MaxAPITokenExpiryDays caps a token's lifetime; a token that never
+	// expires outlives the reason it was created.
+	MaxAPITokenExpiryDays = 365
+	// MaxAPITokensPerUser bounds how many tokens one user may hold.
+	MaxAPITokensPerUser = 50
+
+	maxAPITokenNameLength = 100
+)
+
+// APITokenUseCase manages the caller's personal access tokens.
+type APITokenUseCase struct {
+	repo     interfaces.Repository
+	registry *model.WorkspaceRegistry
+}
+
+// NewAPITokenUseCase constructs an APITokenUseCase.
+func NewAPITokenUseCase(repo interfaces.Repository, registry *model.WorkspaceRegistry) *APITokenUseCase {
+	return &APITokenUseCase{repo: repo, registry: registry}
+}
+
+// CreateAPITokenInput describes a token to mint.
+type CreateAPITokenInput struct {
+	Name string
+	// WorkspaceIDs scopes the token to these workspaces; nil admits all.
+	WorkspaceIDs []string
+	ReadOnly     bool
+	// ExpiresInDays is the token's lifetime; 0 means
+	// DefaultAPITokenExpiryDays.
+	ExpiresInDays int
+}
+
+// tokenOwner returns the browser session managing tokens. A request made with
+// a personal access token may not manage tokens: a workspace-scoped or
+// read-only token could otherwise mint itself an unrestricted one.
+func tokenOwner(ctx context.Context) (*auth.Token, error) {
+	token, err := auth.TokenFromContext(ctx)
+	if err != nil || token.Sub == "" {
+		return nil, goerr.Wrap(ErrAccessDenied, "managing API tokens requires an authenticated user")
+	}
+	if token.APIToken {
+		return nil, goerr.Wrap(ErrAccessDenied, "API tokens cannot be managed with an API token",
+			goerr.V("token_id", token.ID))
+	}
+	return token, nil
+}
+
+// Create mints a token acting as the caller and returns it together with the
+// bearer credential, which is not recoverable afterwards. A read-only session
+// can only mint read-only tokens.
+func (uc *APITokenUseCase) Create(ctx context.Context, in CreateAPITokenInput) (*auth.APIToken, string, error) {
+	owner, err := tokenOwner(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	name := strings.TrimSpace(in.Name)
+	if name == "" || utf8.RuneCountInString(name) > maxAPITokenNameLength {
+		return nil, "", goerr.Wrap(ErrInvalidArgument, "API token name must be 1 to 100 characters")
+	}
+
+	days := in.ExpiresInDays
+	if days == 0 {
+		days = DefaultAPITokenExpiryDays
+	}
+	if days < 1 || days > MaxAPITokenExpiryDays {
+		return nil, "", goerr.Wrap(ErrInvalidArgument, "API token expiry is out of range",
+			goerr.V("expires_in_days", in.ExpiresInDays), goerr.V("max", MaxAPITokenExpiryDays))
+	}
+
+	workspaces, err := uc.validateWorkspaces(in.WorkspaceIDs)
+	if err != nil {
+		return nil, "", err
+	}
+
+	existing, err := uc.repo.ListAPITokens(ctx, owner.Sub)
+	if err != nil {
+		return nil, "", goerr.Wrap(err, "failed to list API tokens")
+	}
+	if len(existing) >= MaxAPITokensPerUser {
+		return nil, "", goerr.Wrap(ErrInvalidArgument, "too many API tokens; revoke one first",
+			goerr.V("max", MaxAPITokensPerUser))
+	}
+
+	token, secret := auth.NewAPIToken(owner, name, workspaces, in.ReadOnly || owner.ReadOnly,
+		time.Duration(days)*24*time.Hour)
+	if err := uc.repo.PutAPIToken(ctx, token); err != nil {
+		return nil, "", goerr.Wrap(err, "failed to save API token", goerr.V("sub", owner.Sub))
+	}
+	return token, auth.FormatAPIToken(token.ID, secret), nil
+}
+
+// validateWorkspaces checks a scope names only configured workspaces. nil
+// stays nil (every workspace); an empty list would admit nothing and is
+// refused.
+func (uc *APITokenUseCase) validateWorkspaces(ids []string) ([]string, error) {
+	if ids == nil {
+		return nil, nil
+	}
+	if len(ids) == 0 {
+		return nil, goerr.Wrap(ErrInvalidArgument, "API token workspace scope must name at least one workspace")
+	}
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, err := uc.registry.Get(id); err != nil {
+			return nil, goerr.Wrap(ErrInvalidArgument, "unknown workspace in API token scope",
+				goerr.V("workspace_id", id))
+		}
+		if !slices.Contains(out, id) {
+			out = append(out, id)
+		}
+	}
+	return out, nil
+}
+
+// List returns the caller's tokens, newest first, expired ones included so
+// the owner can see and clean them up.
+func (uc *APITokenUseCase) List(ctx context.Context) ([]*auth.APIToken, error) {
+	owner, err := tokenOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := uc.repo.ListAPITokens(ctx, owner.Sub)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list API tokens", goerr.V("sub", owner.Sub))
+	}
+	return tokens, nil
+}
+
+// Revoke deletes one of the caller's tokens. Another user's token is reported
+// as not found, so token IDs cannot be probed.
+func (uc *APITokenUseCase) Revoke(ctx context.Context, id auth.TokenID) error {
+	owner, err := tokenOwner(ctx)
+	if err != nil {
+		return err
+	}
+	if err := id.Validate(); err != nil {
+		return goerr.Wrap(ErrAPITokenNotFound, "invalid API token ID", goerr.V("token_id", id))
+	}
+
+	token, err := uc.repo.GetAPIToken(ctx, id)
+	if err != nil {
+		if isRepoNotFound(err) {
+			return goerr.Wrap(ErrAPITokenNotFound, "API token not found", goerr.V("token_id", id))
+		}
+		return goerr.Wrap(err, "failed to get API token", goerr.V("token_id", id))
+	}
+	if token.Sub != owner.Sub {
+		return goerr.Wrap(ErrAPITokenNotFound, "API token not found", goerr.V("token_id", id))
+	}
+
+	if err := uc.repo.DeleteAPIToken(ctx, id); err != nil {
+		if isRepoNotFound(err) {
+			return goerr.Wrap(ErrAPITokenNotFound, "API token not found", goerr.V("token_id", id))
+		}
+		return goerr.Wrap(err, "failed to delete API token", goerr.V("token_id", id))
+	}
+	return nil
+}
+
+// ValidateAPIToken authenticates a bearer credential and returns the request
+// token it acts as. Tokens are not cached, so a revocation takes effect on the
+// next request. The last-used time is recorded at most once per
+// auth.APITokenLastUsedInterval; failing to record it does not fail the
+// request.
+func (uc *AuthUseCase) ValidateAPIToken(ctx context.Context, bearer string) (*auth.Token, error) {
+	id, secret, err := auth.ParseAPIToken(bearer)
+	if err != nil {
+		return nil, goerr.Wrap(err, "invalid API token", goerr.T(errutil.TagBenign))
+	}
+
+	token, err := uc.repo.GetAPIToken(ctx, id)
+	if err != nil {
+		opts := []goerr.Option{goerr.V("token_id", id)}
+		if isRepoNotFound(err) {
+			opts = append(opts, goerr.T(errutil.TagBenign))
+		}
+		return nil, goerr.Wrap(err, "failed to get API token from repository", opts...)
+	}
+	if !token.Verify(secret) {
+		return nil, goerr.New("invalid API token secret", goerr.V("token_id", id), goerr.T(errutil.TagBenign))
+	}
+	if token.IsExpired() {
+		return nil, goerr.New("API token expired", goerr.V("token_id", id), goerr.T(errutil.TagBenign))
+	}
+
+	if now := time.Now(); token.NeedsLastUsedUpdate(now) {
+		if err := uc.repo.TouchAPIToken(ctx, id, now); err != nil && !isRepoNotFound(err) {
+			errutil.Handle(ctx, goerr.Wrap(err, "failed to record API token use", goerr.V("token_id", id)),
+				"failed to record API token use")
+		}
+	}
+
+	return token.SessionToken(), nil
+}
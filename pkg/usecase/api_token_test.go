@@ -0,0 +1,132 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+)
+
+func TestAPITokenUseCase(t *testing.T) {
+	registry := model.NewWorkspaceRegistry()
+	registry.Register(&model.WorkspaceEntry{Workspace: model.Workspace{ID: "ws-a", Name: "A"}})
+	registry.Register(&model.WorkspaceEntry{Workspace: model.Workspace{ID: "ws-b", Name: "B"}})
+
+	sessionCtx := func(sub string, readOnly bool) context.Context {
+		token := auth.NewToken(sub, sub+"@example.com", sub)
+		token.ReadOnly = readOnly
+		return auth.ContextWithToken(context.Background(), token)
+	}
+
+	t.Run("a created token authenticates as its owner with its scopes", func(t *testing.T) {
+		repo := memory.New()
+		uc := usecase.NewAPITokenUseCase(repo, registry)
+		authUC := usecase.NewAuthUseCase(repo)
+
+		created, bearer, err := uc.Create(sessionCtx("U1", false), usecase.CreateAPITokenInput{
+			Name: " grafana ", WorkspaceIDs: []string{"ws-a", "ws-a"}, ReadOnly: true,
+		})
+		gt.NoError(t, err).Required()
+		gt.Value(t, created.Name).Equal("grafana")
+		gt.Value(t, created.Workspaces).Equal([]string{"ws-a"})
+		gt.Bool(t, created.ExpiresAt.Sub(time.Now().Add(usecase.DefaultAPITokenExpiryDays*24*time.Hour)).Abs() < time.Minute).True()
+
+		session, err := authUC.ValidateAPIToken(context.Background(), bearer)
+		gt.NoError(t, err).Required()
+		gt.Value(t, session.Sub).Equal("U1")
+		gt.Value(t, session.Workspaces).Equal([]string{"ws-a"})
+		gt.Bool(t, session.ReadOnly).True()
+		gt.Bool(t, session.APIToken).True()
+
+		stored, err := repo.GetAPIToken(context.Background(), created.ID)
+		gt.NoError(t, err).Required()
+		gt.Value(t, stored.LastUsedAt).NotNil()
+	})
+
+	t.Run("invalid input is refused", func(t *testing.T) {
+		uc := usecase.NewAPITokenUseCase(memory.New(), registry)
+		for name, in := range map[string]usecase.CreateAPITokenInput{
+			"empty name":        {Name: "  "},
+			"unknown workspace": {Name: "x", WorkspaceIDs: []string{"ws-z"}},
+			"empty scope":       {Name: "x", WorkspaceIDs: []string{}},
+			"expiry too long":   {Name: "x", ExpiresInDays: usecase.MaxAPITokenExpiryDays + 1},
+			"negative expiry":   {Name: "x", ExpiresInDays: -1},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, _, err := uc.Create(sessionCtx("U1", false), in)
+				gt.Error(t, err).Is(usecase.ErrInvalidArgument)
+			})
+		}
+	})
+
+	t.Run("a read-only session mints only read-only tokens", func(t *testing.T) {
+		uc := usecase.NewAPITokenUseCase(memory.New(), registry)
+		created, _, err := uc.Create(sessionCtx("okta:00u1", true), usecase.CreateAPITokenInput{Name: "audit"})
+		gt.NoError(t, err).Required()
+		gt.Bool(t, created.ReadOnly).True()
+	})
+
+	t.Run("an API token cannot manage tokens", func(t *testing.T) {
+		repo := memory.New()
+		uc := usecase.NewAPITokenUseCase(repo, registry)
+		_, bearer, err := uc.Create(sessionCtx("U1", false), usecase.CreateAPITokenInput{Name: "scoped", WorkspaceIDs: []string{"ws-a"}})
+		gt.NoError(t, err).Required()
+
+		session, err := usecase.NewAuthUseCase(repo).ValidateAPIToken(context.Background(), bearer)
+		gt.NoError(t, err).Required()
+		ctx := auth.ContextWithToken(context.Background(), session)
+
+		_, _, err = uc.Create(ctx, usecase.CreateAPITokenInput{Name: "escalated"})
+		gt.Error(t, err).Is(usecase.ErrAccessDenied)
+		_, err = uc.List(ctx)
+		gt.Error(t, err).Is(usecase.ErrAccessDenied)
+	})
+
+	t.Run("list and revoke see only the caller's tokens", func(t *testing.T) {
+		repo := memory.New()
+		uc := usecase.NewAPITokenUseCase(repo, registry)
+		authUC := usecase.NewAuthUseCase(repo)
+
+		mine, bearer, err := uc.Create(sessionCtx("U1", false), usecase.CreateAPITokenInput{Name: "mine"})
+		gt.NoError(t, err).Required()
+		theirs, _, err := uc.Create(sessionCtx("U2", false), usecase.CreateAPITokenInput{Name: "theirs"})
+		gt.NoError(t, err).Required()
+
+		tokens, err := uc.List(sessionCtx("U1", false))
+		gt.NoError(t, err).Required()
+		gt.Array(t, tokens).Length(1).Required()
+		gt.Value(t, tokens[0].ID).Equal(mine.ID)
+
+		gt.Error(t, uc.Revoke(sessionCtx("U1", false), theirs.ID)).Is(usecase.ErrAPITokenNotFound)
+		gt.Error(t, uc.Revoke(sessionCtx("U1", false), "not-an-id")).Is(usecase.ErrAPITokenNotFound)
+
+		gt.NoError(t, uc.Revoke(sessionCtx("U1", false), mine.ID)).Required()
+		_, err = authUC.ValidateAPIToken(context.Background(), bearer)
+		gt.Error(t, err)
+	})
+
+	t.Run("a wrong secret or an expired token does not authenticate", func(t *testing.T) {
+		repo := memory.New()
+		authUC := usecase.NewAuthUseCase(repo)
+		owner := auth.NewToken("U1", "u1@example.com", "U1")
+
+		token, _ := auth.NewAPIToken(owner, "script", nil, false, time.Hour)
+		gt.NoError(t, repo.PutAPIToken(context.Background(), token)).Required()
+		_, err := authUC.ValidateAPIToken(context.Background(), auth.FormatAPIToken(token.ID, auth.NewTokenSecret()))
+		gt.Error(t, err)
+
+		expired, secret := auth.NewAPIToken(owner, "old", nil, false, -time.Second)
+		gt.NoError(t, repo.PutAPIToken(context.Background(), expired)).Required()
+		_, err = authUC.ValidateAPIToken(context.Background(), auth.FormatAPIToken(expired.ID, secret))
+		gt.Error(t, err)
+
+		_, err = authUC.ValidateAPIToken(context.Background(), "not a token")
+		gt.Error(t, err)
+	})
+}
@@ -0,0 +1,411 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	goslack "github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+	"github.com/secmon-lab/hecatoncheires/pkg/i18n"
+	"github.com/secmon-lab/hecatoncheires/pkg/service/slack"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
+)
+
+// Action IDs of the App Home tab's buttons. The action status select reuses
+// SlackActionIDStatusSelect, so a status changed from the Home tab takes the
+// same path as one changed on the action card.
+const (
+	// SlackActionIDHomeCreateCase opens the case creation modal. Its value
+	// names the workspace, or is empty to ask for one.
+	SlackActionIDHomeCreateCase = "hc_home_create_case"
+	// slackActionIDHomeOpenCase is the URL button linking a case to the Web
+	// UI. Slack opens the link itself; the interaction it also sends is
+	// ignored.
+	slackActionIDHomeOpenCase = "hc_home_open_case"
+)
+
+const (
+	homeMaxCases   = 10
+	homeMaxActions = 15
+
+	// homeRefreshDelay batches the writes of one change (creating a case
+	// writes it several times) into a single views.publish per user.
+	homeRefreshDelay = 2 * time.Second
+	// homeWatchTTL is how long after publishing a user's Home the refresher
+	// keeps it current. Slack sends app_home_opened each time the tab is
+	// opened, which publishes it again and restarts the window.
+	homeWatchTTL = 12 * time.Hour
+)
+
+// AppHomeUseCase renders the Slack App Home tab: the Slack counterpart of the
+// Web UI dashboard, listing the user's open cases and actions, their favorite
+// workspaces and the generated home message, with buttons to open a case,
+// change an action's status and start a new case.
+//
+// A Home is published when the user opens the tab, and Run keeps it current:
+// it watches the live events of every workspace and re-publishes the Home of
+// each user the change concerns. Only Homes this instance published are
+// refreshed, so with several instances each user's Home is refreshed by the
+// instance that served their last app_home_opened.
+type AppHomeUseCase struct {
+	repo         interfaces.Repository
+	registry     *model.WorkspaceRegistry
+	dashboard    *DashboardUseCase
+	slackService slack.Service
+	bus          interfaces.LiveEventBus
+	baseURL      string
+
+	mu      sync.Mutex
+	watched map[string]*homeWatch
+	pending map[string]struct{}
+}
+
+// homeWatch is what the refresher remembers of a published Home: when it was
+// published and the cases it showed (directly or through one of their
+// actions), so a change that drops one of them off the Home re-renders it
+// too.
+type homeWatch struct {
+	publishedAt time.Time
+	cases       map[homeCaseKey]struct{}
+}
+
+type homeCaseKey struct {
+	workspaceID string
+	caseID      int64
+}
+
+// homeContent is the data one Home view is rendered from.
+type homeContent struct {
+	message   string
+	cases     []*model.MyOpenCase
+	actions   []*model.MyDueAction
+	favorites []string
+}
+
+// NewAppHomeUseCase constructs an AppHomeUseCase. bus may be nil, in which
+// case Homes are only rendered when opened.
+func NewAppHomeUseCase(repo interfaces.Repository, registry *model.WorkspaceRegistry, dashboard *DashboardUseCase, slackService slack.Service, bus interfaces.LiveEventBus, baseURL string) *AppHomeUseCase {
+	return &AppHomeUseCase{
+		repo:         repo,
+		registry:     registry,
+		dashboard:    dashboard,
+		slackService: slackService,
+		bus:          bus,
+		baseURL:      baseURL,
+		watched:      make(map[string]*homeWatch),
+		pending:      make(map[string]struct{}),
+	}
+}
+
+// HandleAppHomeOpened publishes the Home of the user who opened the App Home
+// tab. Opening the Messages or About tab is ignored.
+func (uc *AppHomeUseCase) HandleAppHomeOpened(ctx context.Context, ev *slackevents.AppHomeOpenedEvent) error {
+	if ev.Tab != "home" || ev.User == "" {
+		return nil
+	}
+	return uc.Publish(ctx, ev.User)
+}
+
+// Publish renders userID's Home in their Slack language and publishes it.
+// The dashboard queries run as that user, so the Home shows exactly what
+// their Web UI dashboard would.
+func (uc *AppHomeUseCase) Publish(ctx context.Context, userID string) error {
+	ctx = contextWithSlackUserLang(ctx, uc.slackService, userID)
+	ctx = auth.ContextWithToken(ctx, &auth.Token{Sub: userID})
+
+	content, err := uc.load(ctx)
+	if err != nil {
+		return err
+	}
+	view := goslack.HomeTabViewRequest{
+		Type:   goslack.VTHomeTab,
+		Blocks: goslack.Blocks{BlockSet: uc.buildBlocks(ctx, content, time.Now().UTC())},
+	}
+	if err := uc.slackService.PublishView(ctx, userID, view); err != nil {
+		return goerr.Wrap(err, "failed to publish app home", goerr.V("user_id", userID))
+	}
+	uc.watch(userID, content)
+	return nil
+}
+
+func (uc *AppHomeUseCase) load(ctx context.Context) (*homeContent, error) {
+	cases, err := uc.dashboard.ListMyOpenCases(ctx)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list open cases for app home")
+	}
+	actions, err := uc.dashboard.ListMyDueActions(ctx)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list actions for app home")
+	}
+	favorites, err := uc.dashboard.GetFavoriteWorkspaces(ctx)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get favorite workspaces for app home")
+	}
+
+	lang := i18n.LangFromContext(ctx)
+	if lang == "" {
+		lang = i18n.DefaultLang()
+	}
+	// The greeting is decoration: a failing LLM must not keep the Home from
+	// rendering.
+	message, err := uc.dashboard.GenerateHomeMessage(ctx, time.Now(), string(lang))
+	if err != nil {
+		errutil.Handle(ctx, err, "failed to generate app home message")
+		message = ""
+	}
+
+	return &homeContent{message: message, cases: cases, actions: actions, favorites: favorites}, nil
+}
+
+func (uc *AppHomeUseCase) buildBlocks(ctx context.Context, content *homeContent, now time.Time) []goslack.Block {
+	var blocks []goslack.Block
+	if content.message != "" {
+		blocks = append(blocks, goslack.NewSectionBlock(
+			goslack.NewTextBlockObject(goslack.MarkdownType, slackTextEscaper.Replace(content.message), false, false),
+			nil, nil))
+	}
+	newCase := goslack.NewButtonBlockElement(SlackActionIDHomeCreateCase, "",
+		goslack.NewTextBlockObject(goslack.PlainTextType, ":heavy_plus_sign: "+i18n.T(ctx, i18n.MsgHomeNewCase), true, false))
+	newCase.Style = goslack.StylePrimary
+	blocks = append(blocks, goslack.NewActionBlock("", newCase))
+
+	blocks = append(blocks, homeHeader(i18n.T(ctx, i18n.MsgHomeMyCases, len(content.cases))))
+	if len(content.cases) == 0 {
+		blocks = append(blocks, homeContext(i18n.T(ctx, i18n.MsgHomeNoCases)))
+	}
+	for i, row := range content.cases {
+		if i == homeMaxCases {
+			blocks = append(blocks, homeContext(i18n.T(ctx, i18n.MsgHomeMore, len(content.cases)-homeMaxCases)))
+			break
+		}
+		blocks = append(blocks, uc.caseBlock(ctx, row))
+	}
+
+	blocks = append(blocks, goslack.NewDividerBlock(),
+		homeHeader(i18n.T(ctx, i18n.MsgHomeMyActions, len(content.actions))))
+	if len(content.actions) == 0 {
+		blocks = append(blocks, homeContext(i18n.T(ctx, i18n.MsgHomeNoActions)))
+	}
+	for i, row := range content.actions {
+		if i == homeMaxActions {
+			blocks = append(blocks, homeContext(i18n.T(ctx, i18n.MsgHomeMore, len(content.actions)-homeMaxActions)))
+			break
+		}
+		blocks = append(blocks, uc.actionBlock(ctx, row, now))
+	}
+
+	var favorites []goslack.Block
+	for _, id := range content.favorites {
+		entry, err := uc.registry.Get(id)
+		if err != nil {
+			// A favorite naming a workspace that was since removed.
+			continue
+		}
+		favorites = append(favorites, uc.favoriteBlock(ctx, entry))
+	}
+	if len(favorites) > 0 {
+		blocks = append(blocks, goslack.NewDividerBlock(),
+			homeHeader(i18n.T(ctx, i18n.MsgHomeFavoriteWorkspaces)))
+		blocks = append(blocks, favorites...)
+	}
+	return blocks
+}
+
+func (uc *AppHomeUseCase) caseBlock(ctx context.Context, row *model.MyOpenCase) goslack.Block {
+	c := row.Case
+	title := fmt.Sprintf("#%d %s", c.ID, slackTextEscaper.Replace(c.Title))
+	url := buildCaseWebURL(uc.baseURL, row.WorkspaceID, c.ID)
+
+	details := []string{slackTextEscaper.Replace(row.WorkspaceName)}
+	if c.SlackChannelID != "" && !c.IsThreadBound() {
+		details = append(details, fmt.Sprintf("<#%s>", c.SlackChannelID))
+	}
+	if row.Stalled {
+		details = append(details, i18n.T(ctx, i18n.MsgHomeStalled))
+	}
+	text := fmt.Sprintf("*%s*\n%s", title, strings.Join(details, " · "))
+
+	var accessory *goslack.Accessory
+	if url != "" {
+		open := goslack.NewButtonBlockElement(slackActionIDHomeOpenCase, fmt.Sprintf("%s:%d", row.WorkspaceID, c.ID),
+			goslack.NewTextBlockObject(goslack.PlainTextType, i18n.T(ctx, i18n.MsgHomeOpen), true, false))
+		open.URL = url
+		accessory = goslack.NewAccessory(open)
+	}
+	return goslack.NewSectionBlock(goslack.NewTextBlockObject(goslack.MarkdownType, text, false, false), nil, accessory)
+}
+
+func (uc *AppHomeUseCase) actionBlock(ctx context.Context, row *model.MyDueAction, now time.Time) goslack.Block {
+	a := row.Action
+	title := slackTextEscaper.Replace(a.Title)
+	if url := buildActionWebURL(uc.baseURL, row.WorkspaceID, row.CaseID, a.ID); url != "" {
+		title = fmt.Sprintf("<%s|%s>", url, title)
+	}
+
+	details := []string{
+		slackTextEscaper.Replace(row.WorkspaceName),
+		fmt.Sprintf("#%d %s", row.CaseID, slackTextEscaper.Replace(row.CaseTitle)),
+	}
+	if a.DueDate != nil {
+		day := a.DueDate.UTC().Format(time.DateOnly)
+		if now.Before(model.ActionDueDeadline(*a.DueDate)) {
+			details = append(details, i18n.T(ctx, i18n.MsgHomeDue, day))
+		} else {
+			details = append(details, i18n.T(ctx, i18n.MsgHomeOverdue, day))
+		}
+	}
+	text := fmt.Sprintf("*%s*\n%s", title, strings.Join(details, " · "))
+
+	statusSelect := buildStatusSelect(ctx, row.WorkspaceID, a, resolveActionStatusSet(uc.registry, row.WorkspaceID))
+	return goslack.NewSectionBlock(goslack.NewTextBlockObject(goslack.MarkdownType, text, false, false),
+		nil, goslack.NewAccessory(statusSelect))
+}
+
+func (uc *AppHomeUseCase) favoriteBlock(ctx context.Context, entry *model.WorkspaceEntry) goslack.Block {
+	name := entry.Workspace.Name
+	if name == "" {
+		name = entry.Workspace.ID
+	}
+	text := "*" + slackTextEscaper.Replace(name) + "*"
+	if uc.baseURL != "" {
+		text = fmt.Sprintf("*<%s/ws/%s|%s>*", uc.baseURL, entry.Workspace.ID, slackTextEscaper.Replace(name))
+	}
+	button := goslack.NewButtonBlockElement(SlackActionIDHomeCreateCase, entry.Workspace.ID,
+		goslack.NewTextBlockObject(goslack.PlainTextType, i18n.T(ctx, i18n.MsgHomeNewCaseIn, name), true, false))
+	return goslack.NewSectionBlock(goslack.NewTextBlockObject(goslack.MarkdownType, text, false, false),
+		nil, goslack.NewAccessory(button))
+}
+
+func homeHeader(text string) goslack.Block {
+	return goslack.NewHeaderBlock(goslack.NewTextBlockObject(goslack.PlainTextType, text, true, false))
+}
+
+func homeContext(text string) goslack.Block {
+	return goslack.NewContextBlock("", goslack.NewTextBlockObject(goslack.MarkdownType, text, false, false))
+}
+
+// buildCaseWebURL builds the WebUI link to a Case, or "" without a base URL.
+func buildCaseWebURL(baseURL, workspaceID string, caseID int64) string {
+	if baseURL == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/ws/%s/cases/%d", baseURL, workspaceID, caseID)
+}
+
+func (uc *AppHomeUseCase) watch(userID string, content *homeContent) {
+	w := &homeWatch{publishedAt: time.Now(), cases: make(map[homeCaseKey]struct{})}
+	for _, row := range content.cases {
+		w.cases[homeCaseKey{row.WorkspaceID, row.Case.ID}] = struct{}{}
+	}
+	for _, row := range content.actions {
+		w.cases[homeCaseKey{row.WorkspaceID, row.CaseID}] = struct{}{}
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	uc.watched[userID] = w
+}
+
+// Run keeps the published Homes current until ctx is done. It subscribes to
+// the live events of every workspace, marks the Homes each change concerns,
+// and re-publishes the marked Homes every homeRefreshDelay. Without a live
+// event bus it returns immediately.
+func (uc *AppHomeUseCase) Run(ctx context.Context) error {
+	if uc.bus == nil || uc.registry == nil {
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	for _, entry := range uc.registry.List() {
+		events := uc.bus.Subscribe(ctx, model.WorkspaceLiveTopic(entry.Workspace.ID))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ev := range events {
+				uc.markAffected(ctx, ev)
+			}
+		}()
+	}
+	defer wg.Wait()
+
+	ticker := time.NewTicker(homeRefreshDelay)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			uc.refreshPending(ctx)
+		}
+	}
+}
+
+// markAffected marks for refresh the watched Homes ev concerns: those that
+// showed its case, and those of the users the case or action is now assigned
+// to. The entity is re-read for the latter, since the event carries only its
+// ID; one that can no longer be read (it was deleted) concerns only the Homes
+// that showed it.
+func (uc *AppHomeUseCase) markAffected(ctx context.Context, ev *model.LiveEvent) {
+	users := make(map[string]struct{})
+	switch ev.Kind {
+	case model.LiveEventCaseCreated, model.LiveEventCaseUpdated:
+		if c, err := uc.repo.Case().Get(ctx, ev.WorkspaceID, ev.CaseID); err == nil && c != nil {
+			for _, id := range c.AssigneeIDs {
+				users[id] = struct{}{}
+			}
+		}
+		// A case reopened or made accessible brings back its actions too.
+		if actions, err := uc.repo.Action().GetByCase(ctx, ev.WorkspaceID, ev.CaseID, interfaces.ActionListOptions{}); err == nil {
+			for _, a := range actions {
+				if a.AssigneeID != "" {
+					users[a.AssigneeID] = struct{}{}
+				}
+			}
+		}
+	case model.LiveEventActionCreated, model.LiveEventActionUpdated:
+		if a, err := uc.repo.Action().Get(ctx, ev.WorkspaceID, ev.ActionID); err == nil && a != nil && a.AssigneeID != "" {
+			users[a.AssigneeID] = struct{}{}
+		}
+	}
+
+	key := homeCaseKey{ev.WorkspaceID, ev.CaseID}
+	expiry := time.Now().Add(-homeWatchTTL)
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	for userID, w := range uc.watched {
+		if w.publishedAt.Before(expiry) {
+			delete(uc.watched, userID)
+			continue
+		}
+		_, assigned := users[userID]
+		_, shown := w.cases[key]
+		if assigned || shown {
+			uc.pending[userID] = struct{}{}
+		}
+	}
+}
+
+func (uc *AppHomeUseCase) refreshPending(ctx context.Context) {
+	uc.mu.Lock()
+	pending := uc.pending
+	uc.pending = make(map[string]struct{})
+	uc.mu.Unlock()
+
+	for userID := range pending {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := uc.Publish(ctx, userID); err != nil {
+			errutil.Handle(ctx, err, "failed to refresh app home")
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	goslack "github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+	"github.com/secmon-lab/hecatoncheires/pkg/i18n"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/agentarchive"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/service/livebus"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+)
+
+type publishedHome struct {
+	userID string
+	view   string
+}
+
+func setupAppHome(t *testing.T, repo interfaces.Repository, opts ...usecase.Option) (*usecase.UseCases, <-chan publishedHome) {
+	t.Helper()
+	published := make(chan publishedHome, 10)
+	mock := &mockSlackService{
+		publishViewFn: func(_ context.Context, userID string, view goslack.HomeTabViewRequest) error {
+			raw, err := json.Marshal(view)
+			gt.NoError(t, err).Required()
+			published <- publishedHome{userID: userID, view: string(raw)}
+			return nil
+		},
+	}
+	var calls int32
+	opts = append([]usecase.Option{
+		usecase.WithSlackService(mock),
+		usecase.WithLLMClient(newScriptedClient(nil)),
+		usecase.WithHomeMessageLLMClient(mockGreetingLLM(t, `{"message":"good morning"}`, &calls)),
+		usecase.WithHistoryRepository(agentarchive.NewMemoryHistoryRepository()),
+		usecase.WithTraceRepository(agentarchive.NewMemoryTraceRepository()),
+		usecase.WithBaseURL("https://hc.example"),
+	}, opts...)
+	return usecase.New(repo, dashTestRegistry("ws-1", "ws-2"), opts...), published
+}
+
+func receiveHome(t *testing.T, published <-chan publishedHome) publishedHome {
+	t.Helper()
+	select {
+	case home := <-published:
+		return home
+	case <-time.After(5 * time.Second):
+		t.Fatal("no app home published")
+		return publishedHome{}
+	}
+}
+
+// receiveHomeUntil skips the refreshes published before the change under
+// test reached the Home.
+func receiveHomeUntil(t *testing.T, published <-chan publishedHome, match func(view string) bool) publishedHome {
+	t.Helper()
+	deadline := time.After(10 * time.Second)
+	for {
+		select {
+		case home := <-published:
+			if match(home.view) {
+				return home
+			}
+		case <-deadline:
+			t.Fatal("app home was not refreshed")
+			return publishedHome{}
+		}
+	}
+}
+
+func TestAppHomeUseCase_HandleAppHomeOpened(t *testing.T) {
+	i18n.Init(i18n.LangEN)
+	repo := memory.New()
+	uc, published := setupAppHome(t, repo)
+	ctx := dashCtx(dashTestUser)
+	now := time.Now().UTC()
+
+	c, err := repo.Case().Create(ctx, "ws-1", &model.Case{
+		Title: "Phishing wave", Status: types.CaseStatusOpen, ReporterID: "U-rep",
+		AssigneeIDs: []string{dashTestUser}, SlackChannelID: "C-CASE", CreatedAt: now, UpdatedAt: now,
+	})
+	gt.NoError(t, err).Required()
+	overdue := now.Add(-72 * time.Hour)
+	a, err := repo.Action().Create(ctx, "ws-1", &model.Action{
+		CaseID: c.ID, Title: "Block sender", AssigneeID: dashTestUser,
+		Status: types.ActionStatus("BACKLOG"), DueDate: &overdue, CreatedAt: now, UpdatedAt: now,
+	})
+	gt.NoError(t, err).Required()
+	_, err = uc.Dashboard.SetFavoriteWorkspaces(ctx, []string{"ws-2"})
+	gt.NoError(t, err).Required()
+
+	openTab := func(tab string) error {
+		return uc.Slack.HandleSlackEvent(context.Background(), &slackevents.EventsAPIEvent{
+			Type: slackevents.CallbackEvent,
+			InnerEvent: slackevents.EventsAPIInnerEvent{
+				Type: "app_home_opened",
+				Data: &slackevents.AppHomeOpenedEvent{Type: "app_home_opened", User: dashTestUser, Tab: tab},
+			},
+		})
+	}
+
+	t.Run("publishes the user's cases, actions and favorites", func(t *testing.T) {
+		gt.NoError(t, openTab("home")).Required()
+		home := receiveHome(t, published)
+		gt.Value(t, home.userID).Equal(dashTestUser)
+
+		gt.String(t, home.view).Contains(`"type":"home"`)
+		gt.String(t, home.view).Contains("good morning")
+		gt.String(t, home.view).Contains(fmt.Sprintf("#%d Phishing wave", c.ID))
+		gt.String(t, home.view).Contains("\\u003c#C-CASE\\u003e")
+		gt.String(t, home.view).Contains(fmt.Sprintf("https://hc.example/ws/ws-1/cases/%d", c.ID))
+		gt.String(t, home.view).Contains(fmt.Sprintf("https://hc.example/ws/ws-1/cases/%d/actions/%d", c.ID, a.ID))
+		gt.String(t, home.view).Contains("Overdue since " + overdue.Format(time.DateOnly))
+		gt.String(t, home.view).Contains(fmt.Sprintf(`"value":"ws-1:%d:BACKLOG"`, a.ID))
+		gt.String(t, home.view).Contains(`"action_id":"` + usecase.SlackActionIDHomeCreateCase + `"`)
+		gt.String(t, home.view).Contains("New case in name-ws-2")
+	})
+
+	t.Run("ignores the other tabs", func(t *testing.T) {
+		gt.NoError(t, openTab("messages")).Required()
+		gt.Value(t, len(published)).Equal(0)
+	})
+}
+
+func TestAppHomeUseCase_Run(t *testing.T) {
+	i18n.Init(i18n.LangEN)
+	bus := livebus.New()
+	repo := livebus.PublishingRepository(memory.New(), bus)
+	uc, published := setupAppHome(t, repo, usecase.WithLiveEventBus(bus))
+	ctx := dashCtx(dashTestUser)
+	now := time.Now().UTC()
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- uc.AppHome.Run(runCtx) }()
+	defer func() {
+		cancel()
+		gt.NoError(t, <-done)
+	}()
+	// Let Run subscribe before writing.
+	time.Sleep(100 * time.Millisecond)
+
+	c, err := repo.Case().Create(ctx, "ws-1", &model.Case{
+		Title: "Phishing wave", Status: types.CaseStatusOpen, ReporterID: "U-rep",
+		AssigneeIDs: []string{dashTestUser}, CreatedAt: now, UpdatedAt: now,
+	})
+	gt.NoError(t, err).Required()
+	gt.NoError(t, uc.AppHome.Publish(context.Background(), dashTestUser)).Required()
+	home := receiveHome(t, published)
+	gt.String(t, home.view).Contains("Phishing wave")
+
+	_, err = repo.Action().Create(ctx, "ws-1", &model.Action{
+		CaseID: c.ID, Title: "Block sender", AssigneeID: dashTestUser,
+		Status: types.ActionStatus("BACKLOG"), CreatedAt: now, UpdatedAt: now,
+	})
+	gt.NoError(t, err).Required()
+	home = receiveHomeUntil(t, published, func(view string) bool {
+		return strings.Contains(view, "Block sender")
+	})
+	gt.Value(t, home.userID).Equal(dashTestUser)
+
+	// Closing the case takes it and its action off the Home.
+	_, err = repo.Case().Transact(ctx, "ws-1", c.ID, func(c *model.Case) error {
+		c.Status = types.CaseStatusClosed
+		return nil
+	})
+	gt.NoError(t, err).Required()
+	receiveHomeUntil(t, published, func(view string) bool {
+		return !strings.Contains(view, "Phishing wave") && !strings.Contains(view, "Block sender")
+	})
+}
@@ -2,98 +2,56 @@ package usecase
 
 import (
 	"context"
-	"encoding/json"
-	"io"
-	"net/http"
-	"net/url"
-	"strings"
-	"time"
 
-	"github.com/lestrrat-go/jwx/v3/jwk"
-	"github.com/lestrrat-go/jwx/v3/jwt"
 	"github.com/m-mizutani/goerr/v2"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
-	"github.com/secmon-lab/hecatoncheires/pkg/utils/safe"
-	"github.com/secmon-lab/hecatoncheires/pkg/utils/slackid"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
 )
 
 type AuthUseCase struct {
-	repo         interfaces.Repository
-	clientID     string
-	clientSecret string
-	callbackURL  string
-	teamID       string // Optional Slack team ID
-	botToken     string // Optional Bot token for API calls
-	cache        *authCache
+	repo      interfaces.Repository
+	providers []IdentityProvider
+	cache     *authCache
 }
 
-func NewAuthUseCase(repo interfaces.Repository, clientID, clientSecret, callbackURL string, options ...AuthOption) *AuthUseCase {
-	uc := &AuthUseCase{
-		repo:         repo,
-		clientID:     clientID,
-		clientSecret: clientSecret,
-		callbackURL:  callbackURL,
-		cache:        newAuthCache(),
+// NewAuthUseCase builds the sign-in flow over providers. The first provider is
+// the default: a login that names none goes to it.
+func NewAuthUseCase(repo interfaces.Repository, providers ...IdentityProvider) *AuthUseCase {
+	return &AuthUseCase{
+		repo:      repo,
+		providers: providers,
+		cache:     newAuthCache(),
 	}
-
-	for _, opt := range options {
-		opt(uc)
-	}
-
-	return uc
 }
 
-// AuthOption is a functional option for AuthUseCase
-type AuthOption func(*AuthUseCase)
-
-// WithTeamID sets the Slack team ID
-func WithTeamID(teamID string) AuthOption {
-	return func(uc *AuthUseCase) {
-		uc.teamID = teamID
+// Providers lists the configured identity providers, default first.
+func (uc *AuthUseCase) Providers() []IdentityProviderInfo {
+	out := make([]IdentityProviderInfo, 0, len(uc.providers))
+	for _, p := range uc.providers {
+		out = append(out, IdentityProviderInfo{Name: p.Name(), Label: p.Label()})
 	}
+	return out
 }
 
-// WithBotToken sets the Slack bot token
-func WithBotToken(botToken string) AuthOption {
-	return func(uc *AuthUseCase) {
-		uc.botToken = botToken
+// provider resolves name to a configured provider; "" is the default one.
+func (uc *AuthUseCase) provider(name string) (IdentityProvider, error) {
+	for _, p := range uc.providers {
+		if name == "" || p.Name() == name {
+			return p, nil
+		}
 	}
+	return nil, goerr.Wrap(ErrUnknownIdentityProvider, "identity provider is not configured",
+		goerr.V("provider", name), goerr.T(errutil.TagBenign))
 }
 
-// OpenIDConfiguration represents Slack's OpenID Connect configuration
-type OpenIDConfiguration struct {
-	Issuer                            string   `json:"issuer"`
-	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
-	TokenEndpoint                     string   `json:"token_endpoint"`
-	UserinfoEndpoint                  string   `json:"userinfo_endpoint"`
-	JWKSURI                           string   `json:"jwks_uri"`
-	ScopesSupported                   []string `json:"scopes_supported"`
-	ResponseTypesSupported            []string `json:"response_types_supported"`
-	ResponseModesSupported            []string `json:"response_modes_supported"`
-	GrantTypesSupported               []string `json:"grant_types_supported"`
-	SubjectTypesSupported             []string `json:"subject_types_supported"`
-	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
-	ClaimsSupported                   []string `json:"claims_supported"`
-	ClaimsParameterSupported          bool     `json:"claims_parameter_supported"`
-	RequestParameterSupported         bool     `json:"request_parameter_supported"`
-	RequestURIParameterSupported      bool     `json:"request_uri_parameter_supported"`
-	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
-}
-
-// GetAuthURL returns the URL for Slack OAuth
-func (uc *AuthUseCase) GetAuthURL(state string) string {
-	params := url.Values{}
-	params.Set("client_id", uc.clientID)
-	params.Set("scope", "openid,email,profile")
-	params.Set("redirect_uri", uc.callbackURL)
-	params.Set("response_type", "code")
-	params.Set("state", state)
-	if uc.teamID != "" {
-		params.Set("team", uc.teamID)
+// GetAuthURL returns the URL that starts a sign-in with the named provider
+func (uc *AuthUseCase) GetAuthURL(provider, state string) (string, error) {
+	p, err := uc.provider(provider)
+	if err != nil {
+		return "", err
 	}
-
-	return "https://slack.com/openid/connect/authorize?" + params.Encode()
+	return p.AuthURL(state), nil
 }
 
 // IsNoAuthn returns false for regular AuthUseCase
@@ -101,56 +59,22 @@ func (uc *AuthUseCase) IsNoAuthn() bool {
 	return false
 }
 
-// SlackTokenResponse represents the response from Slack token exchange
-type SlackTokenResponse struct {
-	OK          bool   `json:"ok"`
-	AccessToken string `json:"access_token"`
-	TokenType   string `json:"token_type"`
-	Scope       string `json:"scope"`
-	BotUserID   string `json:"bot_user_id"`
-	AppID       string `json:"app_id"`
-	Team        struct {
-		Name string `json:"name"`
-		ID   string `json:"id"`
-	} `json:"team"`
-	Enterprise interface{} `json:"enterprise"`
-	AuthedUser struct {
-		ID          string `json:"id"`
-		Scope       string `json:"scope"`
-		AccessToken string `json:"access_token"`
-		TokenType   string `json:"token_type"`
-	} `json:"authed_user"`
-	IDToken string `json:"id_token"`
-	Error   string `json:"error"`
-}
-
-// SlackIDToken represents the decoded ID token from Slack
-type SlackIDToken struct {
-	Sub   string `json:"sub"`
-	Email string `json:"email"`
-	Name  string `json:"name"`
-}
-
-// HandleCallback processes the OAuth callback
-func (uc *AuthUseCase) HandleCallback(ctx context.Context, code string) (*auth.Token, error) {
-	// Exchange code for access token
-	tokenResp, err := uc.exchangeCodeForToken(ctx, code)
+// HandleCallback processes the OAuth callback of the named provider
+func (uc *AuthUseCase) HandleCallback(ctx context.Context, provider, code string) (*auth.Token, error) {
+	p, err := uc.provider(provider)
 	if err != nil {
-		return nil, goerr.Wrap(err, "failed to exchange code for token")
+		return nil, err
 	}
 
-	if !tokenResp.OK || tokenResp.Error != "" {
-		return nil, goerr.New("slack oauth error", goerr.V("error", tokenResp.Error))
+	identity, err := p.Authenticate(ctx, code)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to authenticate", goerr.V("provider", p.Name()))
 	}
 
-	// Decode and verify ID token
-	idToken, err := uc.decodeIDToken(ctx, tokenResp.IDToken)
+	token, err := uc.issueToken(ctx, p, identity)
 	if err != nil {
-		return nil, goerr.Wrap(err, "failed to decode ID token")
+		return nil, err
 	}
-
-	// Create and store token
-	token := auth.NewToken(idToken.Sub, idToken.Email, idToken.Name)
 	if err := uc.repo.PutToken(ctx, token); err != nil {
 		// Attach the identifier (Slack user ID) only — Email/Name are PII
 		// and Secret is the actual auth secret, neither belongs in Sentry.
@@ -160,122 +84,37 @@ func (uc *AuthUseCase) HandleCallback(ctx context.Context, code string) (*auth.T
 	return token, nil
 }
 
-// exchangeCodeForToken exchanges the authorization code for an access token
-func (uc *AuthUseCase) exchangeCodeForToken(ctx context.Context, code string) (*SlackTokenResponse, error) {
-	data := url.Values{}
-	data.Set("client_id", uc.clientID)
-	data.Set("client_secret", uc.clientSecret)
-	data.Set("code", code)
-	data.Set("redirect_uri", uc.callbackURL)
-
-	encodedData := data.Encode()
-	req, err := http.NewRequestWithContext(ctx, "POST", "https://slack.com/api/openid.connect.token", strings.NewReader(encodedData))
-	if err != nil {
-		return nil, goerr.Wrap(err, "failed to create request")
-	}
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	req.ContentLength = int64(len(encodedData))
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, goerr.Wrap(err, "failed to make token request")
-	}
-	defer safe.Close(ctx, resp.Body)
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, goerr.Wrap(err, "failed to read response body")
-	}
-
-	var tokenResp SlackTokenResponse
-	if err := json.Unmarshal(body, &tokenResp); err != nil {
-		return nil, goerr.Wrap(err, "failed to parse token response")
-	}
-
-	return &tokenResp, nil
-}
-
-// getOpenIDConfiguration fetches Slack's OpenID Connect configuration
-func (uc *AuthUseCase) getOpenIDConfiguration(ctx context.Context) (*OpenIDConfiguration, error) {
-	req, err := http.NewRequestWithContext(ctx, "GET", "https://slack.com/.well-known/openid-configuration", nil)
-	if err != nil {
-		return nil, goerr.Wrap(err, "failed to create request")
-	}
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, goerr.Wrap(err, "failed to fetch OpenID configuration")
-	}
-	defer safe.Close(ctx, resp.Body)
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, goerr.New("failed to fetch OpenID configuration", goerr.V("status", resp.StatusCode))
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, goerr.Wrap(err, "failed to read OpenID configuration response")
-	}
-
-	var config OpenIDConfiguration
-	if err := json.Unmarshal(body, &config); err != nil {
-		return nil, goerr.Wrap(err, "failed to parse OpenID configuration")
-	}
-
-	return &config, nil
-}
-
-// decodeIDToken decodes and verifies the ID token using Slack's public keys
-func (uc *AuthUseCase) decodeIDToken(ctx context.Context, idToken string) (*SlackIDToken, error) {
-	// Get OpenID Connect configuration to find JWKS URI
-	config, err := uc.getOpenIDConfiguration(ctx)
-	if err != nil {
-		return nil, goerr.Wrap(err, "failed to get OpenID configuration")
-	}
-
-	// Fetch Slack's public JWK set from the discovered URI
-	keySet, err := jwk.Fetch(ctx, config.JWKSURI)
-	if err != nil {
-		return nil, goerr.Wrap(err, "failed to fetch Slack's public keys", goerr.V("jwks_uri", config.JWKSURI))
-	}
-
-	// Parse and verify the JWT token
-	// Allow 10 seconds of clock skew to handle time synchronization differences
-	token, err := jwt.Parse([]byte(idToken), jwt.WithKeySet(keySet), jwt.WithValidate(true), jwt.WithAudience(uc.clientID), jwt.WithAcceptableSkew(10*time.Second))
-	if err != nil {
-		return nil, goerr.Wrap(err, "failed to parse or verify JWT token")
-	}
-
-	// Extract claims. Token.Get decodes into the destination, so a missing
-	// claim and a wrong-typed one both surface as an error here.
-	var subStr string
-	if err := token.Get("sub", &subStr); err != nil {
-		return nil, goerr.Wrap(err, "sub claim missing or not a string in ID token")
-	}
-
-	var emailStr string
-	if err := token.Get("email", &emailStr); err != nil {
-		return nil, goerr.Wrap(err, "email claim missing or not a string in ID token")
-	}
-
-	var nameStr string
-	if err := token.Get("name", &nameStr); err != nil {
-		return nil, goerr.Wrap(err, "name claim missing or not a string in ID token")
-	}
-
-	// Slack's OIDC sub claim is the composite "Uxxx-Txxx" (user-team) form
-	// — see https://api.slack.com/authentication/sign-in-with-slack —
-	// while every downstream API (channel invites, Slack interactivity
-	// callback.User.ID, the channel-membership cache) keys on the bare
-	// "Uxxx" / "Wxxx" user ID. We normalize at the auth boundary so the
-	// rest of the codebase consistently sees a single user-ID form;
-	// otherwise reporter / actor IDs persisted from the Web side fail
-	// silently when Slack rejects them on InviteUsersToChannel.
-	return &SlackIDToken{
-		Sub:   slackid.Normalize(subStr),
-		Email: emailStr,
-		Name:  nameStr,
-	}, nil
+// issueToken resolves whom the session acts as. A Slack sign-in is the Slack
+// user. Any other sign-in is mapped by verified email to the Slack user with
+// that email in the synced user directory, so private-case membership, which is
+// keyed on Slack user IDs, applies to them unchanged. A user without a Slack
+// account gets a provider-scoped sub and a read-only session: they can browse
+// public cases, but anything they wrote would carry an author no Slack API can
+// resolve.
+func (uc *AuthUseCase) issueToken(ctx context.Context, p IdentityProvider, id *Identity) (*auth.Token, error) {
+	if id.SlackUserID != "" {
+		token := auth.NewToken(id.SlackUserID, id.Email, id.Name)
+		token.Provider = p.Name()
+		return token, nil
+	}
+
+	if id.Email != "" && id.EmailVerified {
+		user, err := uc.repo.SlackUser().GetByEmail(ctx, id.Email)
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to look up Slack user by email",
+				goerr.V("provider", p.Name()))
+		}
+		if user != nil {
+			token := auth.NewToken(string(user.ID), id.Email, id.Name)
+			token.Provider = p.Name()
+			return token, nil
+		}
+	}
+
+	token := auth.NewToken(p.Name()+":"+id.Subject, id.Email, id.Name)
+	token.Provider = p.Name()
+	token.ReadOnly = true
+	return token, nil
 }
 
 // ValidateToken validates the token and returns user info
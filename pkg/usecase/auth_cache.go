@@ -7,17 +7,17 @@ import (
 	"time"
 
 	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
-	"github.com/secmon-lab/hecatoncheires/pkg/repository/firestore"
-	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
 	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
 )
 
 // isRepoNotFound reports whether err comes from a repository signalling that
-// the requested document did not exist. Both backends define their own
-// sentinel; the codebase consistently checks both in this combined form.
+// the requested document did not exist. Every backend's ErrNotFound is the
+// shared interfaces.ErrNotFound, so one check covers memory, Firestore,
+// PostgreSQL and SQLite alike.
 func isRepoNotFound(err error) bool {
-	return errors.Is(err, memory.ErrNotFound) || errors.Is(err, firestore.ErrNotFound)
+	return errors.Is(err, interfaces.ErrNotFound)
 }
 
 const (
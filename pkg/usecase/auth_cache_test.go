@@ -3,6 +3,7 @@ package usecase_test
 import (
 	"context"
 	"errors"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -11,7 +12,10 @@ import (
 
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/firestore"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/postgres"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/sqlite"
 	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
 	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
 )
@@ -34,7 +38,8 @@ func (r *flakyAuthRepo) GetToken(ctx context.Context, tokenID auth.TokenID) (*au
 
 func newAuthUC(t *testing.T, repo interfaces.Repository) *usecase.AuthUseCase {
 	t.Helper()
-	return usecase.NewAuthUseCase(repo, "client-id", "client-secret", "https://example.test/cb")
+	return usecase.NewAuthUseCase(repo,
+		usecase.NewSlackIdentityProvider("client-id", "client-secret", "https://example.test/cb"))
 }
 
 func newRandomToken(t *testing.T) *auth.Token {
@@ -61,6 +66,45 @@ func TestValidateToken_NotFoundIsTaggedBenign(t *testing.T) {
 	gt.Bool(t, goerr.HasTag(err, errutil.TagBenign)).True()
 }
 
+func TestValidateToken_NotFoundIsTaggedBenignOnEveryBackend(t *testing.T) {
+	// Each backend's not-found must count as not-found: a sentinel the
+	// usecase does not recognise turns every missing row into a hard error
+	// on that backend.
+	for name, sentinel := range map[string]error{
+		"memory":    memory.ErrNotFound,
+		"firestore": firestore.ErrNotFound,
+		"postgres":  postgres.ErrNotFound,
+		"sqlite":    sqlite.ErrNotFound,
+	} {
+		t.Run(name, func(t *testing.T) {
+			repo := &flakyAuthRepo{
+				Repository:  memory.New(),
+				getTokenErr: goerr.Wrap(sentinel, "token not found"),
+			}
+			uc := newAuthUC(t, repo)
+
+			_, err := uc.ValidateToken(context.Background(), auth.NewTokenID(), auth.TokenSecret("any"))
+			gt.Value(t, err).NotNil().Required()
+			gt.Bool(t, goerr.HasTag(err, errutil.TagBenign)).True()
+		})
+	}
+}
+
+func TestValidateToken_NotFoundIsTaggedBenignOnSQLite(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "hecatoncheires.db")
+	_, err := sqlite.Migrate(ctx, path, false)
+	gt.NoError(t, err).Required()
+	repo, err := sqlite.New(ctx, path)
+	gt.NoError(t, err).Required()
+	t.Cleanup(func() { gt.NoError(t, repo.Close()) })
+	uc := newAuthUC(t, repo)
+
+	_, err = uc.ValidateToken(ctx, auth.NewTokenID(), auth.TokenSecret("whatever"))
+	gt.Value(t, err).NotNil().Required()
+	gt.Bool(t, goerr.HasTag(err, errutil.TagBenign)).True()
+}
+
 func TestValidateToken_RepoFailureIsNotTaggedBenign(t *testing.T) {
 	// The usecase MUST keep paging Sentry on real backend failures even
 	// though the same return path also handles the benign not-found case.
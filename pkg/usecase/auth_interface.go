@@ -8,9 +8,17 @@ import (
 
 // AuthUseCaseInterface defines the interface for authentication use cases
 type AuthUseCaseInterface interface {
-	GetAuthURL(state string) string
-	HandleCallback(ctx context.Context, code string) (*auth.Token, error)
+	// Providers lists the identity providers a user can sign in with,
+	// default first. Empty in no-auth mode.
+	Providers() []IdentityProviderInfo
+	// GetAuthURL and HandleCallback run the sign-in flow of the named
+	// provider; "" names the default one.
+	GetAuthURL(provider, state string) (string, error)
+	HandleCallback(ctx context.Context, provider, code string) (*auth.Token, error)
 	ValidateToken(ctx context.Context, tokenID auth.TokenID, tokenSecret auth.TokenSecret) (*auth.Token, error)
+	// ValidateAPIToken authenticates a personal access token presented as
+	// an `Authorization: Bearer` credential.
+	ValidateAPIToken(ctx context.Context, bearer string) (*auth.Token, error)
 	Logout(ctx context.Context, tokenID auth.TokenID) error
 	IsNoAuthn() bool // Added to identify NoAuthnUseCase
 }
@@ -0,0 +1,255 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/safe"
+)
+
+// OIDCConfig configures a generic OpenID Connect identity provider.
+type OIDCConfig struct {
+	// Name identifies the provider in URLs and provider-scoped subs.
+	Name string
+	// Label is the login button text.
+	Label string
+	// Issuer is the issuer URL; the discovery document is read from
+	// <Issuer>/.well-known/openid-configuration.
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// CallbackURL is the redirect URI registered with the provider.
+	CallbackURL string
+	// Scopes requested; "openid" is always included.
+	Scopes []string
+	// AllowedDomains, when set, admits only users whose verified email is in
+	// one of these domains. Required for issuers anyone can hold an account
+	// at, such as Google.
+	AllowedDomains []string
+	// TrustUnverifiedEmail counts the email of an ID token that carries no
+	// email_verified claim as verified. Entra ID omits the claim and its
+	// email is a directory attribute, so this is only safe for a
+	// single-tenant issuer whose directory the organization controls. An
+	// explicit email_verified=false is never trusted.
+	TrustUnverifiedEmail bool
+}
+
+// OIDCIdentityProvider signs users in with any OpenID Connect issuer that
+// supports the authorization-code flow (Google, Okta, Entra ID, ...).
+type OIDCIdentityProvider struct {
+	cfg       OIDCConfig
+	discovery *OpenIDConfiguration
+}
+
+var _ IdentityProvider = &OIDCIdentityProvider{}
+
+// NewOIDCIdentityProvider reads the issuer's discovery document, so a wrong
+// issuer fails at startup rather than on the first login.
+func NewOIDCIdentityProvider(ctx context.Context, cfg OIDCConfig) (*OIDCIdentityProvider, error) {
+	discoveryURL := strings.TrimSuffix(cfg.Issuer, "/") + "/.well-known/openid-configuration"
+	discovery, err := getOpenIDConfiguration(ctx, discoveryURL)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to discover OIDC issuer", goerr.V("issuer", cfg.Issuer))
+	}
+	// The discovery document must name the issuer it was fetched from
+	// (OpenID Connect Discovery 1.0, section 4.3); ID tokens are checked
+	// against this value.
+	if strings.TrimSuffix(discovery.Issuer, "/") != strings.TrimSuffix(cfg.Issuer, "/") {
+		return nil, goerr.New("OIDC discovery document names a different issuer",
+			goerr.V("issuer", cfg.Issuer), goerr.V("discovered", discovery.Issuer))
+	}
+	if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.JWKSURI == "" {
+		return nil, goerr.New("OIDC discovery document is missing endpoints", goerr.V("issuer", cfg.Issuer))
+	}
+
+	if !slices.Contains(cfg.Scopes, "openid") {
+		cfg.Scopes = append([]string{"openid"}, cfg.Scopes...)
+	}
+	return &OIDCIdentityProvider{cfg: cfg, discovery: discovery}, nil
+}
+
+func (p *OIDCIdentityProvider) Name() string { return p.cfg.Name }
+
+func (p *OIDCIdentityProvider) Label() string { return p.cfg.Label }
+
+// AuthURL returns the issuer's authorization endpoint URL
+func (p *OIDCIdentityProvider) AuthURL(state string) string {
+	params := url.Values{}
+	params.Set("client_id", p.cfg.ClientID)
+	params.Set("scope", strings.Join(p.cfg.Scopes, " "))
+	params.Set("redirect_uri", p.cfg.CallbackURL)
+	params.Set("response_type", "code")
+	params.Set("state", state)
+
+	sep := "?"
+	if strings.Contains(p.discovery.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return p.discovery.AuthorizationEndpoint + sep + params.Encode()
+}
+
+// oidcTokenResponse is the token endpoint response (RFC 6749 section 5.1),
+// of which only the ID token is used.
+type oidcTokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// Authenticate exchanges the code, verifies the ID token against the
+// issuer's keys and applies the allowed-domain restriction.
+func (p *OIDCIdentityProvider) Authenticate(ctx context.Context, code string) (*Identity, error) {
+	rawIDToken, err := p.exchangeCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	identity, err := p.decodeIDToken(ctx, rawIDToken)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to decode ID token")
+	}
+
+	if len(p.cfg.AllowedDomains) > 0 {
+		domain := emailDomain(identity.Email)
+		if !identity.EmailVerified || !slices.Contains(p.cfg.AllowedDomains, domain) {
+			return nil, goerr.Wrap(ErrIdentityNotAllowed, "email domain is not allowed",
+				goerr.V("provider", p.cfg.Name), goerr.V("domain", domain),
+				goerr.V("email_verified", identity.EmailVerified), goerr.T(errutil.TagBenign))
+		}
+	}
+	return identity, nil
+}
+
+// exchangeCode redeems the authorization code at the token endpoint. The
+// client authenticates with HTTP Basic unless the issuer advertises only
+// client_secret_post; Basic is the default the specification requires every
+// issuer to accept.
+func (p *OIDCIdentityProvider) exchangeCode(ctx context.Context, code string) (string, error) {
+	data := url.Values{}
+	data.Set("grant_type", "authorization_code")
+	data.Set("code", code)
+	data.Set("redirect_uri", p.cfg.CallbackURL)
+
+	methods := p.discovery.TokenEndpointAuthMethodsSupported
+	useBasic := len(methods) == 0 || slices.Contains(methods, "client_secret_basic")
+	if !useBasic {
+		data.Set("client_id", p.cfg.ClientID)
+		data.Set("client_secret", p.cfg.ClientSecret)
+	}
+
+	encodedData := data.Encode()
+	req, err := http.NewRequestWithContext(ctx, "POST", p.discovery.TokenEndpoint, strings.NewReader(encodedData))
+	if err != nil {
+		return "", goerr.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if useBasic {
+		req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", goerr.Wrap(err, "failed to make token request")
+	}
+	defer safe.Close(ctx, resp.Body)
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", goerr.Wrap(err, "failed to read response body")
+	}
+
+	var tokenResp oidcTokenResponse
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return "", goerr.Wrap(err, "failed to parse token response", goerr.V("status", resp.StatusCode))
+	}
+	if resp.StatusCode != http.StatusOK || tokenResp.Error != "" {
+		return "", goerr.New("oidc token error",
+			goerr.V("status", resp.StatusCode),
+			goerr.V("error", tokenResp.Error),
+			goerr.V("error_description", tokenResp.ErrorDescription))
+	}
+	if tokenResp.IDToken == "" {
+		return "", goerr.New("token response has no id_token")
+	}
+	return tokenResp.IDToken, nil
+}
+
+// decodeIDToken verifies the ID token's signature, issuer, audience and
+// expiry, then reads the claims. email is required because a session needs
+// one; name falls back to it, since not every issuer releases a name.
+func (p *OIDCIdentityProvider) decodeIDToken(ctx context.Context, idToken string) (*Identity, error) {
+	keySet, err := jwk.Fetch(ctx, p.discovery.JWKSURI)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to fetch issuer's public keys", goerr.V("jwks_uri", p.discovery.JWKSURI))
+	}
+
+	// Allow 10 seconds of clock skew to handle time synchronization differences
+	token, err := jwt.Parse([]byte(idToken),
+		jwt.WithKeySet(keySet),
+		jwt.WithValidate(true),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithIssuer(p.discovery.Issuer),
+		jwt.WithAcceptableSkew(10*time.Second))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to parse or verify JWT token")
+	}
+
+	var sub string
+	if err := token.Get("sub", &sub); err != nil || sub == "" {
+		return nil, goerr.New("sub claim missing or not a string in ID token")
+	}
+
+	var email string
+	if err := token.Get("email", &email); err != nil || email == "" {
+		return nil, goerr.New("email claim missing or not a string in ID token; request the email scope")
+	}
+
+	var name string
+	if err := token.Get("name", &name); err != nil || name == "" {
+		name = email
+	}
+
+	return &Identity{
+		Subject:       sub,
+		Email:         email,
+		Name:          name,
+		EmailVerified: emailVerified(token, p.cfg.TrustUnverifiedEmail),
+	}, nil
+}
+
+// emailVerified reads the email_verified claim, accepting the string "true"
+// some issuers send. A missing claim is unverified unless trustMissing is set:
+// a verified email is mapped to a Slack user and so to their access, and an
+// issuer that does not vouch for the email must not grant that.
+func emailVerified(token jwt.Token, trustMissing bool) bool {
+	var b bool
+	if err := token.Get("email_verified", &b); err == nil {
+		return b
+	}
+	var s string
+	if err := token.Get("email_verified", &s); err == nil {
+		return s == "true"
+	}
+	return trustMissing && !token.Has("email_verified")
+}
+
+// emailDomain returns the lowercased domain part of email, or "" when it has
+// none.
+func emailDomain(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 || at == len(email)-1 {
+		return ""
+	}
+	return strings.ToLower(email[at+1:])
+}
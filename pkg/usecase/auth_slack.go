@@ -0,0 +1,271 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/safe"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/slackid"
+)
+
+// SlackIdentityProviderName is the name of the Sign in with Slack provider.
+// The legacy /api/auth/callback route is its callback.
+const SlackIdentityProviderName = "slack"
+
+// SlackIdentityProvider implements Sign in with Slack (Slack's OpenID Connect
+// flow). Its users are Slack users by construction.
+type SlackIdentityProvider struct {
+	clientID     string
+	clientSecret string
+	callbackURL  string
+	teamID       string // Optional Slack team ID
+}
+
+var _ IdentityProvider = &SlackIdentityProvider{}
+
+// SlackIdentityOption is a functional option for SlackIdentityProvider
+type SlackIdentityOption func(*SlackIdentityProvider)
+
+// WithTeamID sets the Slack team ID
+func WithTeamID(teamID string) SlackIdentityOption {
+	return func(p *SlackIdentityProvider) {
+		p.teamID = teamID
+	}
+}
+
+func NewSlackIdentityProvider(clientID, clientSecret, callbackURL string, options ...SlackIdentityOption) *SlackIdentityProvider {
+	p := &SlackIdentityProvider{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		callbackURL:  callbackURL,
+	}
+
+	for _, opt := range options {
+		opt(p)
+	}
+
+	return p
+}
+
+func (p *SlackIdentityProvider) Name() string { return SlackIdentityProviderName }
+
+func (p *SlackIdentityProvider) Label() string { return "Slack" }
+
+// OpenIDConfiguration represents an OpenID Connect discovery document
+type OpenIDConfiguration struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserinfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	ResponseModesSupported            []string `json:"response_modes_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+	ClaimsParameterSupported          bool     `json:"claims_parameter_supported"`
+	RequestParameterSupported         bool     `json:"request_parameter_supported"`
+	RequestURIParameterSupported      bool     `json:"request_uri_parameter_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+}
+
+// AuthURL returns the URL for Slack OAuth
+func (p *SlackIdentityProvider) AuthURL(state string) string {
+	params := url.Values{}
+	params.Set("client_id", p.clientID)
+	params.Set("scope", "openid,email,profile")
+	params.Set("redirect_uri", p.callbackURL)
+	params.Set("response_type", "code")
+	params.Set("state", state)
+	if p.teamID != "" {
+		params.Set("team", p.teamID)
+	}
+
+	return "https://slack.com/openid/connect/authorize?" + params.Encode()
+}
+
+// SlackTokenResponse represents the response from Slack token exchange
+type SlackTokenResponse struct {
+	OK          bool   `json:"ok"`
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	Scope       string `json:"scope"`
+	BotUserID   string `json:"bot_user_id"`
+	AppID       string `json:"app_id"`
+	Team        struct {
+		Name string `json:"name"`
+		ID   string `json:"id"`
+	} `json:"team"`
+	Enterprise interface{} `json:"enterprise"`
+	AuthedUser struct {
+		ID          string `json:"id"`
+		Scope       string `json:"scope"`
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+	} `json:"authed_user"`
+	IDToken string `json:"id_token"`
+	Error   string `json:"error"`
+}
+
+// SlackIDToken represents the decoded ID token from Slack
+type SlackIDToken struct {
+	Sub   string `json:"sub"`
+	Email string `json:"email"`
+	Name  string `json:"name"`
+}
+
+// Authenticate exchanges the code and verifies the ID token Slack returns
+func (p *SlackIdentityProvider) Authenticate(ctx context.Context, code string) (*Identity, error) {
+	// Exchange code for access token
+	tokenResp, err := p.exchangeCodeForToken(ctx, code)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to exchange code for token")
+	}
+
+	if !tokenResp.OK || tokenResp.Error != "" {
+		return nil, goerr.New("slack oauth error", goerr.V("error", tokenResp.Error))
+	}
+
+	// Decode and verify ID token
+	idToken, err := p.decodeIDToken(ctx, tokenResp.IDToken)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to decode ID token")
+	}
+
+	return &Identity{
+		Subject:       idToken.Sub,
+		Email:         idToken.Email,
+		Name:          idToken.Name,
+		EmailVerified: true,
+		SlackUserID:   idToken.Sub,
+	}, nil
+}
+
+// exchangeCodeForToken exchanges the authorization code for an access token
+func (p *SlackIdentityProvider) exchangeCodeForToken(ctx context.Context, code string) (*SlackTokenResponse, error) {
+	data := url.Values{}
+	data.Set("client_id", p.clientID)
+	data.Set("client_secret", p.clientSecret)
+	data.Set("code", code)
+	data.Set("redirect_uri", p.callbackURL)
+
+	encodedData := data.Encode()
+	req, err := http.NewRequestWithContext(ctx, "POST", "https://slack.com/api/openid.connect.token", strings.NewReader(encodedData))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.ContentLength = int64(len(encodedData))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to make token request")
+	}
+	defer safe.Close(ctx, resp.Body)
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to read response body")
+	}
+
+	var tokenResp SlackTokenResponse
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return nil, goerr.Wrap(err, "failed to parse token response")
+	}
+
+	return &tokenResp, nil
+}
+
+// getOpenIDConfiguration fetches the OpenID Connect discovery document at
+// discoveryURL
+func getOpenIDConfiguration(ctx context.Context, discoveryURL string) (*OpenIDConfiguration, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", discoveryURL, nil)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to create request")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to fetch OpenID configuration")
+	}
+	defer safe.Close(ctx, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, goerr.New("failed to fetch OpenID configuration", goerr.V("status", resp.StatusCode))
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to read OpenID configuration response")
+	}
+
+	var config OpenIDConfiguration
+	if err := json.Unmarshal(body, &config); err != nil {
+		return nil, goerr.Wrap(err, "failed to parse OpenID configuration")
+	}
+
+	return &config, nil
+}
+
+// decodeIDToken decodes and verifies the ID token using Slack's public keys
+func (p *SlackIdentityProvider) decodeIDToken(ctx context.Context, idToken string) (*SlackIDToken, error) {
+	// Get OpenID Connect configuration to find JWKS URI
+	config, err := getOpenIDConfiguration(ctx, "https://slack.com/.well-known/openid-configuration")
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get OpenID configuration")
+	}
+
+	// Fetch Slack's public JWK set from the discovered URI
+	keySet, err := jwk.Fetch(ctx, config.JWKSURI)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to fetch Slack's public keys", goerr.V("jwks_uri", config.JWKSURI))
+	}
+
+	// Parse and verify the JWT token
+	// Allow 10 seconds of clock skew to handle time synchronization differences
+	token, err := jwt.Parse([]byte(idToken), jwt.WithKeySet(keySet), jwt.WithValidate(true), jwt.WithAudience(p.clientID), jwt.WithAcceptableSkew(10*time.Second))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to parse or verify JWT token")
+	}
+
+	// Extract claims. Token.Get decodes into the destination, so a missing
+	// claim and a wrong-typed one both surface as an error here.
+	var subStr string
+	if err := token.Get("sub", &subStr); err != nil {
+		return nil, goerr.Wrap(err, "sub claim missing or not a string in ID token")
+	}
+
+	var emailStr string
+	if err := token.Get("email", &emailStr); err != nil {
+		return nil, goerr.Wrap(err, "email claim missing or not a string in ID token")
+	}
+
+	var nameStr string
+	if err := token.Get("name", &nameStr); err != nil {
+		return nil, goerr.Wrap(err, "name claim missing or not a string in ID token")
+	}
+
+	// Slack's OIDC sub claim is the composite "Uxxx-Txxx" (user-team) form
+	// — see https://api.slack.com/authentication/sign-in-with-slack —
+	// while every downstream API (channel invites, Slack interactivity
+	// callback.User.ID, the channel-membership cache) keys on the bare
+	// "Uxxx" / "Wxxx" user ID. We normalize at the auth boundary so the
+	// rest of the codebase consistently sees a single user-ID form;
+	// otherwise reporter / actor IDs persisted from the Web side fail
+	// silently when Slack rejects them on InviteUsersToChannel.
+	return &SlackIDToken{
+		Sub:   slackid.Normalize(subStr),
+		Email: emailStr,
+		Name:  nameStr,
+	}, nil
+}
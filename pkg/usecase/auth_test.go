@@ -0,0 +1,258 @@
+package usecase_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+	"github.com/m-mizutani/gt"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+)
+
+// staticProvider authenticates every code as the same identity.
+type staticProvider struct {
+	name     string
+	identity usecase.Identity
+}
+
+func (p *staticProvider) Name() string  { return p.name }
+func (p *staticProvider) Label() string { return p.name }
+func (p *staticProvider) AuthURL(state string) string {
+	return "https://idp.example/auth?state=" + state
+}
+func (p *staticProvider) Authenticate(context.Context, string) (*usecase.Identity, error) {
+	id := p.identity
+	return &id, nil
+}
+
+func TestAuthUseCaseHandleCallback(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.New()
+	gt.NoError(t, repo.SlackUser().SaveMany(ctx, []*model.SlackUser{
+		{ID: "U_MAPPED", Name: "alice", Email: "alice@example.com", UpdatedAt: time.Now()},
+	})).Required()
+
+	for _, tc := range []struct {
+		name     string
+		identity usecase.Identity
+		sub      string
+		readOnly bool
+	}{
+		{
+			name:     "a Slack sign-in is the Slack user",
+			identity: usecase.Identity{Subject: "U_SLACK", Email: "slack@example.com", Name: "Slack", EmailVerified: true, SlackUserID: "U_SLACK"},
+			sub:      "U_SLACK",
+		},
+		{
+			name:     "a verified email is mapped to its Slack user",
+			identity: usecase.Identity{Subject: "00u1", Email: "Alice@Example.com", Name: "Alice", EmailVerified: true},
+			sub:      "U_MAPPED",
+		},
+		{
+			name:     "an unverified email is not mapped",
+			identity: usecase.Identity{Subject: "00u2", Email: "alice@example.com", Name: "Mallory"},
+			sub:      "okta:00u2",
+			readOnly: true,
+		},
+		{
+			name:     "a user without a Slack account is read-only",
+			identity: usecase.Identity{Subject: "00u3", Email: "auditor@example.com", Name: "Auditor", EmailVerified: true},
+			sub:      "okta:00u3",
+			readOnly: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := usecase.NewAuthUseCase(repo, &staticProvider{name: "okta", identity: tc.identity})
+
+			token, err := uc.HandleCallback(ctx, "okta", "code")
+			gt.NoError(t, err).Required()
+			gt.Value(t, token.Sub).Equal(tc.sub)
+			gt.Value(t, token.Provider).Equal("okta")
+			gt.Value(t, token.ReadOnly).Equal(tc.readOnly)
+
+			stored, err := uc.ValidateToken(ctx, token.ID, token.Secret)
+			gt.NoError(t, err).Required()
+			gt.Value(t, stored.Sub).Equal(tc.sub)
+		})
+	}
+
+	t.Run("an unknown provider is refused", func(t *testing.T) {
+		uc := usecase.NewAuthUseCase(repo, &staticProvider{name: "okta"})
+		_, err := uc.HandleCallback(ctx, "google", "code")
+		gt.Error(t, err).Is(usecase.ErrUnknownIdentityProvider)
+		_, err = uc.GetAuthURL("google", "state")
+		gt.Error(t, err).Is(usecase.ErrUnknownIdentityProvider)
+	})
+
+	t.Run("the first provider is the default", func(t *testing.T) {
+		uc := usecase.NewAuthUseCase(repo, &staticProvider{name: "slack"}, &staticProvider{name: "okta"})
+		u, err := uc.GetAuthURL("", "s1")
+		gt.NoError(t, err).Required()
+		gt.String(t, u).Equal("https://idp.example/auth?state=s1")
+		gt.Array(t, uc.Providers()).Length(2).Required()
+		gt.Value(t, uc.Providers()[0].Name).Equal("slack")
+	})
+}
+
+// fakeIssuer is a minimal OpenID Connect issuer: discovery, a token endpoint
+// that redeems the code "good" for an ID token carrying claims, and the key
+// set that verifies it.
+func fakeIssuer(t *testing.T, claims map[string]any) *httptest.Server {
+	t.Helper()
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	gt.NoError(t, err).Required()
+	key, err := jwk.Import(priv)
+	gt.NoError(t, err).Required()
+	gt.NoError(t, key.Set(jwk.KeyIDKey, "k1")).Required()
+	pub, err := jwk.PublicKeyOf(key)
+	gt.NoError(t, err).Required()
+	gt.NoError(t, pub.Set(jwk.AlgorithmKey, jwa.RS256())).Required()
+	set := jwk.NewSet()
+	gt.NoError(t, set.AddKey(pub)).Required()
+
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                 srv.URL,
+			"authorization_endpoint": srv.URL + "/authorize",
+			"token_endpoint":         srv.URL + "/token",
+			"jwks_uri":               srv.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(set)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != "client" || secret != "secret" || r.FormValue("code") != "good" {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		tok, err := jwt.NewBuilder().
+			Issuer(srv.URL).
+			Audience([]string{"client"}).
+			IssuedAt(time.Now()).
+			Expiration(time.Now().Add(time.Minute)).
+			Build()
+		gt.NoError(t, err).Required()
+		for k, v := range claims {
+			gt.NoError(t, tok.Set(k, v)).Required()
+		}
+		signed, err := jwt.Sign(tok, jwt.WithKey(jwa.RS256(), key))
+		gt.NoError(t, err).Required()
+		_ = json.NewEncoder(w).Encode(map[string]string{"id_token": string(signed)})
+	})
+	return srv
+}
+
+func TestOIDCIdentityProvider(t *testing.T) {
+	ctx := context.Background()
+	claims := map[string]any{
+		"sub": "00u1", "email": "alice@example.com", "email_verified": true, "name": "Alice",
+	}
+
+	t.Run("authenticates a verified user", func(t *testing.T) {
+		srv := fakeIssuer(t, claims)
+		p, err := usecase.NewOIDCIdentityProvider(ctx, usecase.OIDCConfig{
+			Name: "okta", Issuer: srv.URL, ClientID: "client", ClientSecret: "secret",
+			CallbackURL: "https://app.example/api/auth/callback/okta",
+			Scopes:      []string{"email"},
+		})
+		gt.NoError(t, err).Required()
+
+		u, err := url.Parse(p.AuthURL("s1"))
+		gt.NoError(t, err).Required()
+		gt.String(t, u.Path).Equal("/authorize")
+		gt.String(t, u.Query().Get("scope")).Equal("openid email")
+		gt.String(t, u.Query().Get("state")).Equal("s1")
+
+		id, err := p.Authenticate(ctx, "good")
+		gt.NoError(t, err).Required()
+		gt.Value(t, *id).Equal(usecase.Identity{
+			Subject: "00u1", Email: "alice@example.com", Name: "Alice", EmailVerified: true,
+		})
+
+		_, err = p.Authenticate(ctx, "bad")
+		gt.Error(t, err)
+	})
+
+	t.Run("a token for another audience is refused", func(t *testing.T) {
+		srv := fakeIssuer(t, map[string]any{"sub": "00u1", "email": "alice@example.com", "aud": "someone-else"})
+		p, err := usecase.NewOIDCIdentityProvider(ctx, usecase.OIDCConfig{
+			Name: "okta", Issuer: srv.URL, ClientID: "client", ClientSecret: "secret",
+		})
+		gt.NoError(t, err).Required()
+		_, err = p.Authenticate(ctx, "good")
+		gt.Error(t, err)
+	})
+
+	t.Run("a missing email_verified claim is unverified unless trusted", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			claims   map[string]any
+			trust    bool
+			verified bool
+		}{
+			{name: "missing", claims: map[string]any{"sub": "1", "email": "alice@example.com"}},
+			{name: "missing, trusted", claims: map[string]any{"sub": "1", "email": "alice@example.com"}, trust: true, verified: true},
+			{name: "false, trusted", claims: map[string]any{"sub": "1", "email": "alice@example.com", "email_verified": false}, trust: true},
+			{name: "string true", claims: map[string]any{"sub": "1", "email": "alice@example.com", "email_verified": "true"}, verified: true},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				srv := fakeIssuer(t, tc.claims)
+				p, err := usecase.NewOIDCIdentityProvider(ctx, usecase.OIDCConfig{
+					Name: "entra", Issuer: srv.URL, ClientID: "client", ClientSecret: "secret",
+					TrustUnverifiedEmail: tc.trust,
+				})
+				gt.NoError(t, err).Required()
+				id, err := p.Authenticate(ctx, "good")
+				gt.NoError(t, err).Required()
+				gt.Value(t, id.EmailVerified).Equal(tc.verified)
+			})
+		}
+	})
+
+	t.Run("allowed domains admit only verified emails in them", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			claims  map[string]any
+			allowed bool
+		}{
+			{name: "verified, in domain", claims: claims, allowed: true},
+			{name: "other domain", claims: map[string]any{"sub": "1", "email": "eve@evil.example", "email_verified": true}},
+			{name: "unverified", claims: map[string]any{"sub": "1", "email": "eve@example.com", "email_verified": false}},
+			{name: "email_verified missing", claims: map[string]any{"sub": "1", "email": "eve@example.com"}},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				srv := fakeIssuer(t, tc.claims)
+				p, err := usecase.NewOIDCIdentityProvider(ctx, usecase.OIDCConfig{
+					Name: "google", Issuer: srv.URL, ClientID: "client", ClientSecret: "secret",
+					AllowedDomains: []string{"example.com"},
+				})
+				gt.NoError(t, err).Required()
+				_, err = p.Authenticate(ctx, "good")
+				if tc.allowed {
+					gt.NoError(t, err)
+				} else {
+					gt.Error(t, err).Is(usecase.ErrIdentityNotAllowed)
+				}
+			})
+		}
+	})
+}
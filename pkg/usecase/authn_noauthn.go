@@ -25,13 +25,18 @@ func NewNoAuthnUseCase(repo interfaces.Repository, sub, email, name string) *NoA
 	}
 }
 
+// Providers returns no providers: nobody signs in in no-auth mode
+func (uc *NoAuthnUseCase) Providers() []IdentityProviderInfo {
+	return nil
+}
+
 // GetAuthURL returns a dummy URL (should not be called in no-auth mode)
-func (uc *NoAuthnUseCase) GetAuthURL(state string) string {
-	return "/"
+func (uc *NoAuthnUseCase) GetAuthURL(provider, state string) (string, error) {
+	return "/", nil
 }
 
 // HandleCallback handles OAuth callback (should not be called in no-auth mode)
-func (uc *NoAuthnUseCase) HandleCallback(ctx context.Context, code string) (*auth.Token, error) {
+func (uc *NoAuthnUseCase) HandleCallback(ctx context.Context, provider, code string) (*auth.Token, error) {
 	// In no-auth mode, return token for the specified user
 	return auth.NewToken(uc.sub, uc.email, uc.name), nil
 }
@@ -42,6 +47,12 @@ func (uc *NoAuthnUseCase) ValidateToken(ctx context.Context, tokenID auth.TokenI
 	return auth.NewToken(uc.sub, uc.email, uc.name), nil
 }
 
+// ValidateAPIToken always returns a token for the specified user, like
+// ValidateToken: in no-auth mode every request acts as that user.
+func (uc *NoAuthnUseCase) ValidateAPIToken(ctx context.Context, bearer string) (*auth.Token, error) {
+	return auth.NewToken(uc.sub, uc.email, uc.name), nil
+}
+
 // Logout does nothing in no-auth mode
 func (uc *NoAuthnUseCase) Logout(ctx context.Context, tokenID auth.TokenID) error {
 	// No-op in no-auth mode
@@ -29,7 +29,7 @@ func TestNoAuthnUseCase(t *testing.T) {
 
 	t.Run("HandleCallback returns specified user token", func(t *testing.T) {
 		ctx := context.Background()
-		token, err := uc.HandleCallback(ctx, "dummy-code")
+		token, err := uc.HandleCallback(ctx, "", "dummy-code")
 		gt.NoError(t, err).Required()
 
 		gt.Value(t, token.Sub).Equal(sub)
@@ -42,7 +42,8 @@ func TestNoAuthnUseCase(t *testing.T) {
 	})
 
 	t.Run("GetAuthURL returns root path", func(t *testing.T) {
-		url := uc.GetAuthURL("state")
+		url, err := uc.GetAuthURL("", "state")
+		gt.NoError(t, err).Required()
 		gt.Value(t, url).Equal("/")
 	})
 
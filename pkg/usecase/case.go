@@ -29,6 +29,7 @@ import (
 // would create a cycle: job → usecase → job).
 type CaseEventPublisher interface {
 	PublishCaseLifecycle(ctx context.Context, workspaceID string, c *model.Case, lifecycle model.CaseLifecycle, actorUserID string)
+	PublishCaseChange(ctx context.Context, workspaceID string, c *model.Case, change model.CaseChange, actorUserID string)
 }
 
 type CaseUseCase struct {
@@ -39,6 +40,7 @@ type CaseUseCase struct {
 	baseURL           string
 	welcomeRenderers  map[string]*welcomeRenderer
 	eventPublisher    CaseEventPublisher
+	embedClient       interfaces.EmbedClient
 }
 
 func NewCaseUseCase(repo interfaces.Repository, registry *model.WorkspaceRegistry, slackService slack.Service, slackAdminService slack.AdminService, baseURL string) *CaseUseCase {
@@ -91,6 +93,19 @@ func (uc *CaseUseCase) publishLifecycle(ctx context.Context, workspaceID string,
 	uc.eventPublisher.PublishCaseLifecycle(ctx, workspaceID, c, lifecycle, actor)
 }
 
+// publishChange forwards a detailed case change (field / status / assignee)
+// to the publisher. Same no-op and actor rules as publishLifecycle.
+func (uc *CaseUseCase) publishChange(ctx context.Context, workspaceID string, c *model.Case, change model.CaseChange) {
+	if uc == nil || uc.eventPublisher == nil || c == nil {
+		return
+	}
+	actor := ""
+	if tok, err := auth.TokenFromContext(ctx); err == nil {
+		actor = tok.Sub
+	}
+	uc.eventPublisher.PublishCaseChange(ctx, workspaceID, c, change, actor)
+}
+
 func (uc *CaseUseCase) fieldValidatorForWorkspace(workspaceID string) *model.FieldValidator {
 	if uc.workspaceRegistry == nil {
 		return nil
@@ -652,6 +667,7 @@ func (uc *CaseUseCase) createChannelModeCase(ctx context.Context, workspaceID st
 	// Fire the case lifecycle event AFTER activation succeeded. Failure here must
 	// not roll back the case — the Job dispatch is fire-and-forget by design.
 	uc.publishLifecycle(ctx, workspaceID, activated, model.CaseLifecycleCreated)
+	uc.suggestDuplicates(ctx, workspaceID, activated)
 	return activated, nil
 }
 
@@ -1037,6 +1053,7 @@ func (uc *CaseUseCase) UpdateCase(ctx context.Context, workspaceID string, id in
 	// enriched values onto the existing ones. Without a field patch, the map is
 	// left untouched (no validator pass — stale option IDs from a prior config
 	// must not cause an unrelated update to fail).
+	beforeFields := existingCase.FieldValues
 	if patch.Fields != nil {
 		validated, err := uc.validateCaseWrite(ctx, workspaceID, validatePartialStrict, patch.Fields, nil)
 		if err != nil {
@@ -1092,6 +1109,14 @@ func (uc *CaseUseCase) UpdateCase(ctx context.Context, workspaceID string, id in
 			i18n.T(ctx, i18n.MsgCaseChangeTitle, actor, beforeTitle, updated.Title))
 	}
 
+	// Drafts are not live cases yet: their field edits are work in progress,
+	// and the Job that cares sees the final values on `created`.
+	if patch.Fields != nil && !updated.IsDraft() {
+		for _, change := range fieldChanges(beforeFields, updated.FieldValues) {
+			uc.publishChange(ctx, workspaceID, updated, change)
+		}
+	}
+
 	return updated, nil
 }
 
@@ -1162,6 +1187,13 @@ func (uc *CaseUseCase) AssignCase(ctx context.Context, workspaceID string, id in
 		}
 		uc.postThreadContextLine(ctx, updated,
 			i18n.T(ctx, i18n.MsgCaseChangeAssigneeAssigned, actor, strings.Join(mentions, ", ")))
+		// Drafts are not live cases yet, as in UpdateCase.
+		if !updated.IsDraft() {
+			uc.publishChange(ctx, workspaceID, updated, model.CaseChange{
+				Lifecycle: model.CaseLifecycleAssigned,
+				To:        added,
+			})
+		}
 	}
 
 	return updated, nil
@@ -1357,6 +1389,19 @@ func (uc *CaseUseCase) DeleteCase(ctx context.Context, workspaceID string, id in
 		return goerr.Wrap(ErrCaseNotFound, "case not found", goerr.V(CaseIDKey, id))
 	}
 
+	// An incremental export cannot see a deleted document, so leave it a
+	// tombstone. The case is already gone, so a failure is reported rather
+	// than returned; the next full export removes the rows regardless.
+	if err := uc.repo.ExportState().PutTombstone(ctx, &model.Tombstone{
+		WorkspaceID: workspaceID,
+		Entity:      model.TombstoneEntityCase,
+		EntityID:    strconv.FormatInt(id, 10),
+		DeletedAt:   time.Now().UTC(),
+	}); err != nil {
+		errutil.Handle(ctx, goerr.Wrap(err, "failed to record case tombstone",
+			goerr.V(CaseIDKey, id)), "record case tombstone")
+	}
+
 	return nil
 }
 
@@ -1455,6 +1500,138 @@ func (uc *CaseUseCase) ListCases(ctx context.Context, workspaceID string, status
 	return cases, nil
 }
 
+// CaseSearchPageDefaultSize is the page size SearchCases uses when the caller
+// does not specify one (or specifies <= 0).
+const CaseSearchPageDefaultSize = 50
+
+// CaseSearchPageMaxSize caps the page size a caller can request.
+const CaseSearchPageMaxSize = 200
+
+// SearchCasesInput is the filter, sort and page of a SearchCases call. Every
+// zero-valued filter is unset; set filters are ANDed. Time ranges are
+// half-open [From, To).
+type SearchCasesInput struct {
+	Status       *types.CaseStatus
+	AssigneeID   string
+	ReporterID   string
+	FieldFilters []interfaces.CaseFieldFilter
+	Text         string
+	CreatedFrom  time.Time
+	CreatedTo    time.Time
+	UpdatedFrom  time.Time
+	UpdatedTo    time.Time
+	IsTest       *bool
+
+	Sort  interfaces.CaseSort
+	First int
+	After *string
+}
+
+// hasContentFilter reports whether a filter looks inside case content that
+// RestrictCase hides. Status and the timestamps survive RestrictCase, so
+// matching on them reveals nothing new about a private case.
+func (in SearchCasesInput) hasContentFilter() bool {
+	return in.AssigneeID != "" || in.ReporterID != "" || len(in.FieldFilters) > 0 ||
+		strings.TrimSpace(in.Text) != "" || in.IsTest != nil
+}
+
+// CasePage is the result of SearchCases. NextCursor is non-nil only when
+// more pages exist; it is an opaque token the caller passes back as After.
+type CasePage struct {
+	Items      []*model.Case
+	NextCursor *string
+}
+
+// SearchCases returns one page of the cases of workspaceID matching in,
+// ordered by in.Sort. Drafts are excluded unless in.Status asks for them.
+//
+// Private cases the caller cannot access are RestrictCase'd, as in ListCases.
+// When a filter looks inside case content they are dropped instead, so that
+// whether a restricted case matched cannot leak what it contains.
+func (uc *CaseUseCase) SearchCases(ctx context.Context, workspaceID string, in SearchCasesInput) (*CasePage, error) {
+	if workspaceID == "" {
+		return nil, goerr.Wrap(ErrInvalidArgument, "workspace id is empty")
+	}
+	if !in.Sort.IsValid() {
+		return nil, goerr.Wrap(ErrInvalidArgument, "unknown case sort key", goerr.V("key", in.Sort.Key))
+	}
+	for _, f := range in.FieldFilters {
+		if f.FieldID == "" {
+			return nil, goerr.Wrap(ErrInvalidArgument, "field filter has no field id")
+		}
+		if f.NumberMin != nil && f.NumberMax != nil && *f.NumberMin > *f.NumberMax {
+			return nil, goerr.Wrap(ErrInvalidArgument, "field filter number range is empty",
+				goerr.V("field_id", f.FieldID))
+		}
+	}
+
+	size := in.First
+	switch {
+	case size <= 0:
+		size = CaseSearchPageDefaultSize
+	case size > CaseSearchPageMaxSize:
+		size = CaseSearchPageMaxSize
+	}
+
+	var opts []interfaces.ListCaseOption
+	if in.Status != nil {
+		opts = append(opts, interfaces.WithStatus(*in.Status))
+	}
+	if in.AssigneeID != "" {
+		opts = append(opts, interfaces.WithAssignee(in.AssigneeID))
+	}
+	if in.ReporterID != "" {
+		opts = append(opts, interfaces.WithReporter(in.ReporterID))
+	}
+	for _, f := range in.FieldFilters {
+		opts = append(opts, interfaces.WithFieldFilter(f))
+	}
+	if in.Text != "" {
+		opts = append(opts, interfaces.WithText(in.Text))
+	}
+	if !in.CreatedFrom.IsZero() || !in.CreatedTo.IsZero() {
+		opts = append(opts, interfaces.WithCreatedBetween(in.CreatedFrom, in.CreatedTo))
+	}
+	if !in.UpdatedFrom.IsZero() || !in.UpdatedTo.IsZero() {
+		opts = append(opts, interfaces.WithUpdatedBetween(in.UpdatedFrom, in.UpdatedTo))
+	}
+	if in.IsTest != nil {
+		opts = append(opts, interfaces.WithIsTest(*in.IsTest))
+	}
+
+	token, tokenErr := auth.TokenFromContext(ctx)
+	if tokenErr == nil && in.hasContentFilter() {
+		opts = append(opts, interfaces.WithAccessibleBy(token.Sub))
+	}
+
+	var after string
+	if in.After != nil {
+		after = *in.After
+	}
+
+	cases, next, err := uc.repo.Case().Search(ctx, workspaceID, in.Sort, size, after, opts...)
+	if err != nil {
+		if errors.Is(err, interfaces.ErrInvalidCaseCursor) {
+			return nil, goerr.Wrap(ErrInvalidArgument, "invalid case search cursor", goerr.V("after", after))
+		}
+		return nil, goerr.Wrap(err, "failed to search cases")
+	}
+
+	if tokenErr == nil {
+		for i, c := range cases {
+			if !model.IsCaseAccessible(c, token.Sub) {
+				cases[i] = model.RestrictCase(c)
+			}
+		}
+	}
+
+	out := &CasePage{Items: cases}
+	if next != "" {
+		out.NextCursor = &next
+	}
+	return out, nil
+}
+
 // referenceableCasesLimit caps how many candidate cases a case_ref picker
 // / agent search returns in one call. It also serves as the default when the
 // caller passes a non-positive limit.
@@ -1480,13 +1657,7 @@ func (uc *CaseUseCase) ListReferenceableCases(ctx context.Context, workspaceID,
 			goerr.V("reference_workspace", workspaceID))
 	}
 
-	q := strings.TrimSpace(query)
-	lowerQ := strings.ToLower(q)
-	var idQuery int64 = -1
-	if n, perr := strconv.ParseInt(strings.TrimPrefix(q, "#"), 10, 64); perr == nil {
-		idQuery = n
-	}
-
+	q := newCaseQuery(query)
 	matched := make([]*model.Case, 0, len(cases))
 	for _, c := range cases {
 		// List excludes drafts by default, but enforce the "drafts are never
@@ -1494,15 +1665,12 @@ func (uc *CaseUseCase) ListReferenceableCases(ctx context.Context, workspaceID,
 		if c.IsPrivate || c.Status.Normalize() == types.CaseStatusDraft {
 			continue
 		}
-		if q != "" {
-			if !strings.Contains(strings.ToLower(c.Title), lowerQ) && c.ID != idQuery {
-				continue
-			}
+		if q.matches(c) {
+			matched = append(matched, c)
 		}
-		matched = append(matched, c)
 	}
 
-	openFirst := q == ""
+	openFirst := q.empty()
 	sort.SliceStable(matched, func(i, j int) bool {
 		if openFirst {
 			oi := matched[i].Status.Normalize() == types.CaseStatusOpen
@@ -1525,6 +1693,28 @@ func (uc *CaseUseCase) ListReferenceableCases(ctx context.Context, workspaceID,
 	return refs, nil
 }
 
+// caseQuery is a case picker's search: a case-insensitive substring of the
+// title, or the Case ID written as "#42" or "42". The empty query matches
+// every Case.
+type caseQuery struct {
+	lower string
+	id    int64
+}
+
+func newCaseQuery(query string) caseQuery {
+	q := caseQuery{lower: strings.ToLower(strings.TrimSpace(query)), id: -1}
+	if n, err := strconv.ParseInt(strings.TrimPrefix(q.lower, "#"), 10, 64); err == nil {
+		q.id = n
+	}
+	return q
+}
+
+func (q caseQuery) empty() bool { return q.lower == "" }
+
+func (q caseQuery) matches(c *model.Case) bool {
+	return q.empty() || c.ID == q.id || strings.Contains(strings.ToLower(c.Title), q.lower)
+}
+
 // ResolveCaseRefs resolves the given Case IDs in workspaceID to their CaseRef
 // summaries, dropping any ID that is missing, private, or a draft (the caller
 // renders those as "unavailable"). Used to label existing case_ref values
@@ -1687,6 +1877,7 @@ func (uc *CaseUseCase) CloseCase(ctx context.Context, workspaceID string, id int
 
 	existing.Status = types.CaseStatusClosed
 	existing.UpdatedAt = time.Now().UTC()
+	existing.TrackSLA(existing.UpdatedAt, uc.slaPolicyForWorkspace(workspaceID))
 	updated, err := uc.repo.Case().Update(ctx, workspaceID, existing)
 	if err != nil {
 		return nil, goerr.Wrap(err, "failed to close case", goerr.V(CaseIDKey, id))
@@ -1719,6 +1910,7 @@ func (uc *CaseUseCase) ReopenCase(ctx context.Context, workspaceID string, id in
 
 	existing.Status = types.CaseStatusOpen
 	existing.UpdatedAt = time.Now().UTC()
+	existing.TrackSLA(existing.UpdatedAt, uc.slaPolicyForWorkspace(workspaceID))
 	updated, err := uc.repo.Case().Update(ctx, workspaceID, existing)
 	if err != nil {
 		return nil, goerr.Wrap(err, "failed to reopen case", goerr.V(CaseIDKey, id))
@@ -1741,6 +1933,20 @@ func (uc *CaseUseCase) caseStatusSetForWorkspace(workspaceID string) *model.Acti
 	return entry.CaseStatusSet
 }
 
+// slaPolicyForWorkspace returns the workspace's [sla] policy, or nil when it
+// has none (or the registry cannot resolve it). A nil policy still tracks the
+// response and resolution timestamps; only board-status pauses need it.
+func (uc *CaseUseCase) slaPolicyForWorkspace(workspaceID string) *model.SLAPolicy {
+	if uc.workspaceRegistry == nil {
+		return nil
+	}
+	entry, err := uc.workspaceRegistry.Get(workspaceID)
+	if err != nil {
+		return nil
+	}
+	return entry.SLA
+}
+
 // workspaceIsThreadMode reports whether the workspace binds cases to Slack
 // threads (thread mode) rather than dedicated channels (channel mode).
 //
@@ -1816,6 +2022,7 @@ func (uc *CaseUseCase) createThreadBoundCase(ctx context.Context, workspaceID, c
 	}
 
 	uc.publishLifecycle(ctx, workspaceID, created, model.CaseLifecycleCreated)
+	uc.suggestDuplicates(ctx, workspaceID, created)
 	return created, nil
 }
 
@@ -1877,6 +2084,11 @@ func (uc *CaseUseCase) UpdateCaseStatus(ctx context.Context, workspaceID string,
 	existing.BoardStatus = boardStatus
 	existing.SyncLifecycleFromBoardStatus(set)
 	existing.UpdatedAt = time.Now().UTC()
+	// Moving the case off its board status is its first response.
+	if beforeStatus != boardStatus {
+		existing.MarkSLAResponse(existing.UpdatedAt)
+	}
+	existing.TrackSLA(existing.UpdatedAt, uc.slaPolicyForWorkspace(workspaceID))
 
 	updated, err := uc.repo.Case().Update(ctx, workspaceID, existing)
 	if err != nil {
@@ -1888,6 +2100,12 @@ func (uc *CaseUseCase) UpdateCaseStatus(ctx context.Context, workspaceID string,
 	}
 
 	if beforeStatus != updated.BoardStatus {
+		uc.publishChange(ctx, workspaceID, updated, model.CaseChange{
+			Lifecycle: model.CaseLifecycleStatusChanged,
+			From:      fieldValueStrings(beforeStatus),
+			To:        fieldValueStrings(updated.BoardStatus),
+		})
+
 		actor := i18n.T(ctx, i18n.MsgChangeActorSystem)
 		if tok, terr := auth.TokenFromContext(ctx); terr == nil && tok.Sub != "" {
 			actor = mentionUser(tok.Sub)
@@ -2085,6 +2303,9 @@ func (uc *CaseUseCase) SubmitDraft(ctx context.Context, workspaceID string, id i
 	}
 
 	c.UpdatedAt = time.Now().UTC()
+	// The SLA clocks start when the case is opened, not when the draft was
+	// first saved.
+	c.SLA.StartedAt = &c.UpdatedAt
 	updated, err := uc.repo.Case().Update(ctx, workspaceID, c)
 	if err != nil {
 		return nil, goerr.Wrap(err, "failed to flip draft to open", goerr.V(CaseIDKey, id))
@@ -2152,6 +2373,7 @@ func (uc *CaseUseCase) SubmitDraft(ctx context.Context, workspaceID string, id i
 	// new cases run uniformly whether they came from CreateCase or
 	// SubmitDraft.
 	uc.publishLifecycle(ctx, workspaceID, activated, model.CaseLifecycleCreated)
+	uc.suggestDuplicates(ctx, workspaceID, activated)
 	return activated, nil
 }
 
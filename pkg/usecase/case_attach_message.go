@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/m-mizutani/goerr/v2"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+	slackmodel "github.com/secmon-lab/hecatoncheires/pkg/domain/model/slack"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+	"github.com/secmon-lab/hecatoncheires/pkg/i18n"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
+)
+
+// AttachMessageInput is a Slack message posted outside the Case's channel or
+// thread, to be attached to the Case.
+type AttachMessageInput struct {
+	// Message is the attached message. Its ChannelID and ID locate it in
+	// Slack.
+	Message *slackmodel.Message
+	// Note is the attacher's optional comment, posted along with the link.
+	Note string
+	// NotifyJobs publishes message_attached, so the Case's Jobs and the
+	// workspace webhooks react to the message.
+	NotifyJobs bool
+}
+
+// AttachMessage records a Slack message from another channel on the Case's
+// timeline and cross-posts a link to it where the Case lives in Slack. With
+// NotifyJobs it also publishes message_attached, carrying the message's
+// permalink as the change's To.
+//
+// Only a published Case that is still open takes messages: a closed or merged
+// Case is rejected with ErrCaseAlreadyClosed / ErrCaseAlreadyMerged, a draft
+// with ErrCaseIsDraft. Private-Case access is enforced against the context
+// auth token, as on every Case write.
+func (uc *CaseUseCase) AttachMessage(ctx context.Context, workspaceID string, caseID int64, in AttachMessageInput) (*model.Case, error) {
+	if in.Message == nil || in.Message.ChannelID() == "" || in.Message.ID() == "" {
+		return nil, goerr.Wrap(ErrInvalidArgument, "message to attach has no channel or timestamp", goerr.V(CaseIDKey, caseID))
+	}
+
+	c, err := loadCaseForWrite(ctx, uc.repo, workspaceID, caseID)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case c.MergedInto != 0:
+		return nil, goerr.Wrap(ErrCaseAlreadyMerged, "cannot attach a message to a merged case",
+			goerr.V(CaseIDKey, caseID), goerr.V("merged_into", c.MergedInto))
+	case c.IsDraft():
+		return nil, goerr.Wrap(ErrCaseIsDraft, "cannot attach a message to a draft case", goerr.V(CaseIDKey, caseID))
+	case c.Status.Normalize() == types.CaseStatusClosed:
+		return nil, goerr.Wrap(ErrCaseAlreadyClosed, "cannot attach a message to a closed case", goerr.V(CaseIDKey, caseID))
+	}
+
+	if err := uc.repo.CaseMessage().Put(ctx, workspaceID, c.ID, in.Message); err != nil {
+		return nil, goerr.Wrap(err, "failed to save attached message",
+			goerr.V(CaseIDKey, caseID),
+			goerr.V("channel_id", in.Message.ChannelID()),
+			goerr.V("message_ts", in.Message.ID()))
+	}
+
+	permalink := ""
+	if uc.slackService != nil {
+		link, err := uc.slackService.GetPermalink(ctx, in.Message.ChannelID(), in.Message.ID())
+		if err != nil {
+			errutil.Handle(ctx, err, "failed to get permalink of attached message")
+		} else {
+			permalink = link
+		}
+	}
+	uc.postCaseNote(ctx, c, messageAttachedNote(ctx, in.Message.ChannelID(), permalink, in.Note))
+
+	if in.NotifyJobs {
+		change := model.CaseChange{Lifecycle: model.CaseLifecycleMessageAttached}
+		if permalink != "" {
+			change.To = []string{permalink}
+		}
+		uc.publishChange(ctx, workspaceID, c, change)
+	}
+	return c, nil
+}
+
+// messageAttachedNote renders the note announcing an attached message, with
+// the attacher's comment quoted below it.
+func messageAttachedNote(ctx context.Context, channelID, permalink, note string) string {
+	actor := i18n.T(ctx, i18n.MsgChangeActorSystem)
+	if tok, err := auth.TokenFromContext(ctx); err == nil && tok.Sub != "" {
+		actor = mentionUser(tok.Sub)
+	}
+
+	var body string
+	if permalink != "" {
+		body = i18n.T(ctx, i18n.MsgCaseMessageAttached, actor, permalink, channelID)
+	} else {
+		body = i18n.T(ctx, i18n.MsgCaseMessageAttachedNoLink, actor, channelID)
+	}
+	if note = strings.TrimSpace(note); note != "" {
+		body += "\n>" + strings.ReplaceAll(slackTextEscaper.Replace(note), "\n", "\n>")
+	}
+	return body
+}
+
+// ListAttachableCases returns the Cases of workspaceID a message can be
+// attached to by the actor: open, not merged, and accessible to them. The
+// query matches like ListReferenceableCases (title substring or "#42"), but
+// unlike a case_ref picker this one lists the private Cases the actor is a
+// member of, since attaching writes to the Case rather than revealing it to
+// others. Results are sorted by most recently updated and capped at limit
+// (clamped to referenceableCasesLimit).
+func (uc *CaseUseCase) ListAttachableCases(ctx context.Context, workspaceID, query string, limit int) ([]*model.Case, error) {
+	if limit <= 0 || limit > referenceableCasesLimit {
+		limit = referenceableCasesLimit
+	}
+
+	cases, err := uc.repo.Case().List(ctx, workspaceID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list attachable cases", goerr.V("workspace_id", workspaceID))
+	}
+
+	actorID, checkAccess := tokenActor(ctx)
+	q := newCaseQuery(query)
+	matched := make([]*model.Case, 0, len(cases))
+	for _, c := range cases {
+		if c.Status.Normalize() != types.CaseStatusOpen || c.MergedInto != 0 {
+			continue
+		}
+		if checkAccess && !model.IsCaseAccessible(c, actorID) {
+			continue
+		}
+		if q.matches(c) {
+			matched = append(matched, c)
+		}
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i].UpdatedAt.After(matched[j].UpdatedAt)
+	})
+	if len(matched) > limit {
+		matched = matched[:limit]
+	}
+	return matched, nil
+}
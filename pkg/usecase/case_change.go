@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+// fieldValueStrings flattens a stored field value into the string form the
+// Job `to` filter compares against: one element per selected option / user /
+// referenced case for multi-valued fields, a single element otherwise. A nil
+// value yields nil so "unset" and "set to empty" compare equal.
+func fieldValueStrings(v any) []string {
+	switch a := v.(type) {
+	case nil:
+		return nil
+	case string:
+		if a == "" {
+			return nil
+		}
+		return []string{a}
+	case []string:
+		return slices.Clone(a)
+	case []any:
+		out := make([]string, 0, len(a))
+		for _, item := range a {
+			out = append(out, fieldValueStrings(item)...)
+		}
+		return out
+	case []int64:
+		out := make([]string, 0, len(a))
+		for _, id := range a {
+			out = append(out, fmt.Sprint(id))
+		}
+		return out
+	case time.Time:
+		return []string{a.UTC().Format(time.RFC3339)}
+	default:
+		return []string{fmt.Sprint(a)}
+	}
+}
+
+// fieldChanges lists one field_changed CaseChange per custom field whose
+// value differs between before and after, in field-ID order so published
+// events are deterministic. A field present on only one side counts as a
+// change from / to nothing.
+func fieldChanges(before, after map[string]model.FieldValue) []model.CaseChange {
+	ids := make([]string, 0, len(after))
+	for id := range after {
+		ids = append(ids, id)
+	}
+	for id := range before {
+		if _, ok := after[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	var out []model.CaseChange
+	for _, id := range ids {
+		from := fieldValueStrings(before[id].Value)
+		to := fieldValueStrings(after[id].Value)
+		if slices.Equal(from, to) {
+			continue
+		}
+		out = append(out, model.CaseChange{
+			Lifecycle: model.CaseLifecycleFieldChanged,
+			FieldID:   id,
+			From:      from,
+			To:        to,
+		})
+	}
+	return out
+}
@@ -0,0 +1,417 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+	"github.com/secmon-lab/hecatoncheires/pkg/i18n"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/async"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
+	goslack "github.com/slack-go/slack"
+)
+
+const (
+	// duplicateSimilarityThreshold is the cosine similarity at or above which
+	// an open Case is suggested as a duplicate of a newly created one.
+	duplicateSimilarityThreshold = 0.85
+	// duplicateSuggestionLimit caps how many candidates are suggested.
+	duplicateSuggestionLimit = 3
+)
+
+// SetEmbedClient wires the embedding client used for duplicate suggestions.
+// nil is allowed: new Cases are then neither embedded nor compared.
+func (uc *CaseUseCase) SetEmbedClient(client interfaces.EmbedClient) {
+	uc.embedClient = client
+}
+
+// MergeCases folds the source Cases into target: their actions, memos, Slack
+// messages, job-run history, ingested alerts and SLA breaches are moved onto
+// target, each source is closed
+// with MergedInto pointing at target, target picks up the sources' assignees,
+// and both sides get a note in Slack linking them.
+//
+// The records are moved before any source is closed, so a merge that fails
+// part way can simply be retried with the same arguments: a source whose
+// records already moved has nothing left to move, and a source is only marked
+// merged once everything under it is on target.
+func (uc *CaseUseCase) MergeCases(ctx context.Context, workspaceID string, targetID int64, sourceIDs []int64) (*model.Case, error) {
+	if len(sourceIDs) == 0 {
+		return nil, goerr.Wrap(ErrInvalidArgument, "no source cases to merge", goerr.V(CaseIDKey, targetID))
+	}
+
+	target, err := loadCaseForWrite(ctx, uc.repo, workspaceID, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if target.MergedInto != 0 {
+		return nil, goerr.Wrap(ErrCaseAlreadyMerged, "merge target is itself merged",
+			goerr.V(CaseIDKey, targetID), goerr.V("merged_into", target.MergedInto))
+	}
+	switch target.Status.Normalize() {
+	case types.CaseStatusDraft:
+		return nil, goerr.Wrap(ErrCaseIsDraft, "draft case cannot be a merge target", goerr.V(CaseIDKey, targetID))
+	case types.CaseStatusClosed:
+		return nil, goerr.Wrap(ErrCaseAlreadyClosed, "merge target is closed", goerr.V(CaseIDKey, targetID))
+	}
+
+	set := uc.caseStatusSetForWorkspace(workspaceID)
+	sources := make([]*model.Case, 0, len(sourceIDs))
+	seen := make(map[int64]struct{}, len(sourceIDs))
+	for _, id := range sourceIDs {
+		if id == targetID {
+			return nil, goerr.Wrap(ErrInvalidArgument, "case cannot be merged into itself", goerr.V(CaseIDKey, id))
+		}
+		if _, ok := seen[id]; ok {
+			return nil, goerr.Wrap(ErrInvalidArgument, "source case listed twice", goerr.V(CaseIDKey, id))
+		}
+		seen[id] = struct{}{}
+
+		src, err := loadCaseForWrite(ctx, uc.repo, workspaceID, id)
+		if err != nil {
+			return nil, err
+		}
+		if src.IsDraft() {
+			return nil, goerr.Wrap(ErrCaseIsDraft, "draft case cannot be merged", goerr.V(CaseIDKey, id))
+		}
+		// A source already merged into this very target is a retry of a merge
+		// that failed after closing it; letting it through finishes the job.
+		if src.MergedInto != 0 && src.MergedInto != targetID {
+			return nil, goerr.Wrap(ErrCaseAlreadyMerged, "source case is already merged",
+				goerr.V(CaseIDKey, id), goerr.V("merged_into", src.MergedInto))
+		}
+		// Moving a private Case's records onto a public one would expose them
+		// to everyone in the workspace. Onto a private one, the two must have
+		// the same members: the source's members must keep seeing them, and
+		// nobody outside the source may start to.
+		if src.IsPrivate && !target.IsPrivate {
+			return nil, goerr.Wrap(ErrInvalidArgument, "private case cannot be merged into a public case",
+				goerr.V(CaseIDKey, id), goerr.V("target_id", targetID))
+		}
+		if src.IsPrivate {
+			missing := membersNotIn(src.ChannelUserIDs, target.ChannelUserIDs)
+			extra := membersNotIn(target.ChannelUserIDs, src.ChannelUserIDs)
+			if len(missing) > 0 || len(extra) > 0 {
+				return nil, goerr.Wrap(ErrInvalidArgument, "private case can only be merged into a private case with the same members",
+					goerr.V(CaseIDKey, id), goerr.V("target_id", targetID),
+					goerr.V("missing_members", missing), goerr.V("extra_members", extra))
+			}
+		}
+		if src.IsThreadBound() && (set == nil || len(set.ClosedIDs()) == 0) {
+			return nil, goerr.Wrap(ErrInvalidArgument, "workspace has no closed case status to close the merged case with",
+				goerr.V(CaseIDKey, id))
+		}
+		if err := uc.assertNoLiveJobRun(ctx, workspaceID, id); err != nil {
+			return nil, err
+		}
+		sources = append(sources, src)
+	}
+
+	now := time.Now().UTC()
+	for _, src := range sources {
+		if err := uc.moveCaseRecords(ctx, workspaceID, src.ID, targetID, now); err != nil {
+			return nil, err
+		}
+	}
+
+	policy := uc.slaPolicyForWorkspace(workspaceID)
+	merged := make([]*model.Case, 0, len(sources))
+	var assignees []string
+	for _, src := range sources {
+		var wasClosed bool
+		closed, err := uc.repo.Case().Transact(ctx, workspaceID, src.ID, func(c *model.Case) error {
+			if c.MergedInto != 0 && c.MergedInto != targetID {
+				return goerr.Wrap(ErrCaseAlreadyMerged, "source case was merged concurrently",
+					goerr.V(CaseIDKey, c.ID), goerr.V("merged_into", c.MergedInto))
+			}
+			wasClosed = c.Status.Normalize() == types.CaseStatusClosed
+			c.MergedInto = targetID
+			if c.IsThreadBound() {
+				if !set.IsClosed(c.BoardStatus) {
+					c.BoardStatus = set.ClosedIDs()[0]
+				}
+				c.SyncLifecycleFromBoardStatus(set)
+			} else {
+				c.Status = types.CaseStatusClosed
+			}
+			c.UpdatedAt = now
+			c.TrackSLA(now, policy)
+			return nil
+		})
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to close merged case",
+				goerr.V(CaseIDKey, src.ID), goerr.V("target_id", targetID))
+		}
+		if !wasClosed {
+			uc.publishLifecycle(ctx, workspaceID, closed, model.CaseLifecycleClosed)
+		}
+		merged = append(merged, closed)
+		assignees = append(assignees, closed.AssigneeIDs...)
+	}
+
+	updated, err := uc.repo.Case().Transact(ctx, workspaceID, targetID, func(c *model.Case) error {
+		c.AssignUsers(assignees)
+		c.UpdatedAt = now
+		return nil
+	})
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to update merge target", goerr.V(CaseIDKey, targetID))
+	}
+
+	uc.announceMerge(ctx, workspaceID, updated, merged)
+	return updated, nil
+}
+
+// membersNotIn returns the members that are not in allowed, in order.
+func membersNotIn(members, allowed []string) []string {
+	var out []string
+	for _, m := range members {
+		if !slices.Contains(allowed, m) {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// assertNoLiveJobRun rejects a merge while one of the Case's jobs is running
+// or suspended on a human answer: the run would keep writing under the old
+// Case ID after its history has moved.
+func (uc *CaseUseCase) assertNoLiveJobRun(ctx context.Context, workspaceID string, caseID int64) error {
+	runs, err := uc.repo.JobRun().ListByCase(ctx, workspaceID, caseID)
+	if err != nil {
+		return goerr.Wrap(err, "failed to list job runs", goerr.V(CaseIDKey, caseID))
+	}
+	now := time.Now().UTC()
+	for _, run := range runs {
+		if run.IsLeased(now) || run.IsSuspended() {
+			return goerr.Wrap(ErrJobAlreadyRunning, "case has a running job",
+				goerr.V(CaseIDKey, caseID), goerr.V("job_id", run.JobID))
+		}
+	}
+	return nil
+}
+
+// moveCaseRecords re-parents everything stored under fromID onto toID. The
+// job-run events and logs go before the JobRun records that anchor them.
+// Actions and memos are stamped with now so an incremental export re-sends
+// them under toID; the job-run rows, which the export keys by case, are left
+// a tombstone so the ones keyed to fromID are removed.
+func (uc *CaseUseCase) moveCaseRecords(ctx context.Context, workspaceID string, fromID, toID int64, now time.Time) error {
+	actions, err := uc.repo.Action().GetByCase(ctx, workspaceID, fromID,
+		interfaces.ActionListOptions{ArchiveScope: interfaces.ActionArchiveScopeAll})
+	if err != nil {
+		return goerr.Wrap(err, "failed to list actions to move", goerr.V(CaseIDKey, fromID))
+	}
+	for _, a := range actions {
+		a.CaseID = toID
+		a.UpdatedAt = now
+		if _, err := uc.repo.Action().Update(ctx, workspaceID, a); err != nil {
+			return goerr.Wrap(err, "failed to move action",
+				goerr.V(CaseIDKey, fromID), goerr.V(ActionIDKey, a.ID))
+		}
+	}
+
+	if err := uc.repo.Memo().MoveCase(ctx, workspaceID, fromID, toID, now); err != nil {
+		return goerr.Wrap(err, "failed to move memos",
+			goerr.V(CaseIDKey, fromID), goerr.V("target_id", toID))
+	}
+
+	moves := []struct {
+		what string
+		move func(context.Context, string, int64, int64) error
+	}{
+		{"messages", uc.repo.CaseMessage().MoveCase},
+		{"job run events", uc.repo.JobRunEvent().MoveCase},
+		{"job run logs", uc.repo.JobRunLog().MoveCase},
+		{"job runs", uc.repo.JobRun().MoveCase},
+		{"alerts", uc.repo.Alert().MoveCase},
+		{"sla breaches", uc.repo.SLABreach().MoveCase},
+	}
+	for _, m := range moves {
+		if err := m.move(ctx, workspaceID, fromID, toID); err != nil {
+			return goerr.Wrap(err, "failed to move "+m.what,
+				goerr.V(CaseIDKey, fromID), goerr.V("target_id", toID))
+		}
+	}
+
+	// Written before the source is closed, so a failure here leaves the merge
+	// to be retried rather than stale rows in the sink.
+	if err := uc.repo.ExportState().PutTombstone(ctx, &model.Tombstone{
+		WorkspaceID: workspaceID,
+		Entity:      model.TombstoneEntityCaseJobRuns,
+		EntityID:    strconv.FormatInt(fromID, 10),
+		DeletedAt:   now,
+	}); err != nil {
+		return goerr.Wrap(err, "failed to record moved job run tombstone",
+			goerr.V(CaseIDKey, fromID), goerr.V("target_id", toID))
+	}
+	return nil
+}
+
+// announceMerge posts the "merged into" note on every source and a single
+// "merged from" note on target, each linking the other side. Best-effort, like
+// every other Slack side effect of a Case write.
+func (uc *CaseUseCase) announceMerge(ctx context.Context, workspaceID string, target *model.Case, sources []*model.Case) {
+	if uc.slackService == nil {
+		return
+	}
+	actor := i18n.T(ctx, i18n.MsgChangeActorSystem)
+	if tok, err := auth.TokenFromContext(ctx); err == nil && tok.Sub != "" {
+		actor = mentionUser(tok.Sub)
+	}
+
+	targetRef := uc.caseSlackRef(ctx, workspaceID, target)
+	refs := make([]string, 0, len(sources))
+	for _, src := range sources {
+		uc.postCaseNote(ctx, src, i18n.T(ctx, i18n.MsgCaseMergedInto, actor, targetRef))
+		refs = append(refs, uc.caseSlackRef(ctx, workspaceID, src))
+	}
+	uc.postCaseNote(ctx, target, i18n.T(ctx, i18n.MsgCaseMergedFrom, actor, strings.Join(refs, ", ")))
+}
+
+// postCaseNote posts body as a context block where the Case lives in Slack:
+// its thread for a thread-mode Case, its dedicated channel otherwise.
+// Best-effort: failures go to errutil.Handle.
+func (uc *CaseUseCase) postCaseNote(ctx context.Context, c *model.Case, body string) {
+	if uc.slackService == nil || c == nil || c.SlackChannelID == "" || body == "" {
+		return
+	}
+	if c.IsThreadBound() {
+		uc.postThreadContextLine(ctx, c, body)
+		return
+	}
+	blocks := []goslack.Block{
+		goslack.NewContextBlock("", goslack.NewTextBlockObject(goslack.MarkdownType, body, false, false)),
+	}
+	if _, err := uc.slackService.PostMessage(ctx, c.SlackChannelID, blocks, body); err != nil {
+		errutil.Handle(ctx, err, "failed to post case note")
+	}
+}
+
+// caseSlackRef renders c as Slack mrkdwn pointing at where it lives: a link to
+// its thread for a thread-mode Case, its channel for a channel-mode one, and
+// the web UI (or the bare number) when neither is available.
+func (uc *CaseUseCase) caseSlackRef(ctx context.Context, workspaceID string, c *model.Case) string {
+	label := fmt.Sprintf("#%d", c.ID)
+	switch {
+	case c.IsThreadBound() && c.SlackChannelID != "" && uc.slackService != nil:
+		link, err := uc.slackService.GetPermalink(ctx, c.SlackChannelID, c.SlackThreadTS)
+		if err != nil {
+			errutil.Handle(ctx, err, "failed to get case thread permalink")
+		} else if link != "" {
+			return fmt.Sprintf("<%s|%s>", link, label)
+		}
+	case c.SlackChannelID != "":
+		return fmt.Sprintf("%s (<#%s>)", label, c.SlackChannelID)
+	}
+	if u := uc.CaseURL(workspaceID, c.ID); u != "" {
+		return fmt.Sprintf("<%s|%s>", u, label)
+	}
+	return label
+}
+
+// suggestDuplicates embeds a newly created Case and, in the background,
+// posts the open Cases that look like the same incident into its thread or
+// channel. A no-op without an embed client. The embedding is kept on the Case
+// so later Cases can be compared against it without embedding it again.
+func (uc *CaseUseCase) suggestDuplicates(ctx context.Context, workspaceID string, c *model.Case) {
+	if uc.embedClient == nil || c == nil {
+		return
+	}
+	caseID := c.ID
+	async.Dispatch(ctx, func(ctx context.Context) error {
+		vec, err := uc.embedCase(ctx, c)
+		if err != nil || len(vec) == 0 {
+			return err
+		}
+		updated, err := uc.repo.Case().Transact(ctx, workspaceID, caseID, func(c *model.Case) error {
+			c.Embedding = vec
+			return nil
+		})
+		if err != nil {
+			return goerr.Wrap(err, "failed to store case embedding", goerr.V(CaseIDKey, caseID))
+		}
+
+		candidates, err := uc.duplicateCandidates(ctx, workspaceID, updated, func(o *model.Case) bool {
+			return !o.IsPrivate
+		})
+		if err != nil || len(candidates) == 0 {
+			return err
+		}
+		refs := make([]string, 0, len(candidates))
+		for _, cand := range candidates {
+			refs = append(refs, fmt.Sprintf("%s %s",
+				uc.caseSlackRef(ctx, workspaceID, cand.Case), cand.Case.Title))
+		}
+		uc.postCaseNote(ctx, updated, i18n.T(ctx, i18n.MsgCaseDuplicateCandidates, strings.Join(refs, ", ")))
+		return nil
+	})
+}
+
+// DuplicateCandidates returns the open Cases whose embedding is close to the
+// given Case's, most similar first, limited to the ones the caller can see.
+// A Case created before an embed client was configured has no embedding and
+// therefore no candidates.
+func (uc *CaseUseCase) DuplicateCandidates(ctx context.Context, workspaceID string, id int64) ([]*model.CaseDuplicateCandidate, error) {
+	c, err := uc.GetCase(ctx, workspaceID, id)
+	if err != nil {
+		return nil, err
+	}
+	actorID, checkAccess := tokenActor(ctx)
+	return uc.duplicateCandidates(ctx, workspaceID, c, func(o *model.Case) bool {
+		return !checkAccess || model.IsCaseAccessible(o, actorID)
+	})
+}
+
+func (uc *CaseUseCase) duplicateCandidates(ctx context.Context, workspaceID string, c *model.Case, visible func(*model.Case) bool) ([]*model.CaseDuplicateCandidate, error) {
+	if len(c.Embedding) == 0 || c.MergedInto != 0 {
+		return nil, nil
+	}
+	open, err := uc.repo.Case().List(ctx, workspaceID, interfaces.WithStatus(types.CaseStatusOpen))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list open cases", goerr.V("workspace_id", workspaceID))
+	}
+
+	var out []*model.CaseDuplicateCandidate
+	for _, o := range open {
+		if o.ID == c.ID || o.MergedInto != 0 || o.IsTest != c.IsTest || !visible(o) {
+			continue
+		}
+		score := cosineSimilarity(c.Embedding, o.Embedding)
+		if score < duplicateSimilarityThreshold {
+			continue
+		}
+		out = append(out, &model.CaseDuplicateCandidate{Case: o, Score: score})
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Score > out[j].Score })
+	if len(out) > duplicateSuggestionLimit {
+		out = out[:duplicateSuggestionLimit]
+	}
+	return out, nil
+}
+
+func (uc *CaseUseCase) embedCase(ctx context.Context, c *model.Case) ([]float64, error) {
+	text := strings.TrimSpace(strings.Join(slices.DeleteFunc([]string{c.Title, c.Description}, func(s string) bool {
+		return strings.TrimSpace(s) == ""
+	}), "\n\n"))
+	if text == "" {
+		return nil, nil
+	}
+	vecs, err := uc.embedClient.GenerateEmbedding(ctx, model.EmbeddingDimension, []string{text})
+	if err != nil {
+		return nil, goerr.Wrap(err, "embedding generation failed", goerr.V(CaseIDKey, c.ID))
+	}
+	if len(vecs) == 0 {
+		return nil, nil
+	}
+	return vecs[0], nil
+}
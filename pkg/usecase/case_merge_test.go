@@ -0,0 +1,252 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+	"github.com/secmon-lab/hecatoncheires/pkg/i18n"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/async"
+)
+
+func TestCaseUseCase_MergeCases(t *testing.T) {
+	i18n.Init(i18n.LangEN)
+	ctx := dashCtx("UTESTUSER")
+
+	setup := func(t *testing.T) (*usecase.CaseUseCase, *memory.Memory, *mockSlackService) {
+		t.Helper()
+		repo := memory.New()
+		mock := &mockSlackService{
+			createChannelFn: func(_ context.Context, caseID int64, _ string, _ string) (string, error) {
+				return fmt.Sprintf("C%d", caseID), nil
+			},
+		}
+		return usecase.NewCaseUseCase(repo, nil, mock, nil, ""), repo, mock
+	}
+	createCase := func(t *testing.T, uc *usecase.CaseUseCase, title string, isPrivate bool) *model.Case {
+		t.Helper()
+		c, err := uc.CreateCase(ctx, testWorkspaceID, title, "", []string{}, nil, isPrivate, false, "", "")
+		gt.NoError(t, err).Required()
+		return c
+	}
+
+	t.Run("moves records, closes the sources and links both sides", func(t *testing.T) {
+		uc, repo, mock := setup(t)
+		actionUC := usecase.NewActionUseCase(repo, nil, nil, "", nil)
+		target := createCase(t, uc, "Phishing wave", false)
+		source := createCase(t, uc, "Suspicious mail", false)
+
+		_, err := repo.Case().Transact(context.Background(), testWorkspaceID, source.ID, func(c *model.Case) error {
+			c.AssigneeIDs = []string{"U-ALICE"}
+			return nil
+		})
+		gt.NoError(t, err).Required()
+		action, err := actionUC.CreateAction(ctx, testWorkspaceID, source.ID, "Block sender", "", "", "", types.ActionStatusTodo, nil)
+		gt.NoError(t, err).Required()
+		now := time.Now().UTC()
+		memo := &model.Memo{ID: model.NewMemoID(), WorkspaceID: testWorkspaceID, CaseID: source.ID, Title: "headers", CreatedAt: now, UpdatedAt: now}
+		_, err = repo.Memo().Create(context.Background(), testWorkspaceID, memo)
+		gt.NoError(t, err).Required()
+		_, err = repo.SLABreach().Record(context.Background(), &model.SLABreach{
+			WorkspaceID: testWorkspaceID, CaseID: source.ID, Kind: model.SLAKindResponse,
+			Target: time.Hour, DueAt: now, RecordedAt: now,
+		})
+		gt.NoError(t, err).Required()
+		mock.postedTexts = nil
+
+		merged, err := uc.MergeCases(ctx, testWorkspaceID, target.ID, []int64{source.ID})
+		gt.NoError(t, err).Required()
+		gt.Value(t, merged.ID).Equal(target.ID)
+		gt.Array(t, merged.AssigneeIDs).Equal([]string{"U-ALICE"})
+
+		closed, err := repo.Case().Get(context.Background(), testWorkspaceID, source.ID)
+		gt.NoError(t, err).Required()
+		gt.Value(t, closed.Status).Equal(types.CaseStatusClosed)
+		gt.Value(t, closed.MergedInto).Equal(target.ID)
+
+		movedAction, err := repo.Action().Get(context.Background(), testWorkspaceID, action.ID)
+		gt.NoError(t, err).Required()
+		gt.Value(t, movedAction.CaseID).Equal(target.ID)
+		memos, err := repo.Memo().List(context.Background(), testWorkspaceID, target.ID, interfaces.MemoListOptions{})
+		gt.NoError(t, err).Required()
+		gt.Array(t, memos).Length(1)
+		breaches, err := repo.SLABreach().ListByCase(context.Background(), testWorkspaceID, target.ID)
+		gt.NoError(t, err).Required()
+		gt.Array(t, breaches).Length(1)
+
+		// An incremental export must see the moves: moved rows are stamped,
+		// and the job-run rows keyed to the source get a tombstone.
+		gt.Bool(t, memos[0].UpdatedAt.After(now)).True()
+		gt.Bool(t, movedAction.UpdatedAt.After(now)).True()
+		tombstones, err := repo.ExportState().ListTombstones(context.Background(), testWorkspaceID, now.Add(-time.Minute))
+		gt.NoError(t, err).Required()
+		gt.Array(t, tombstones).Length(1).Required()
+		gt.Value(t, tombstones[0].Entity).Equal(model.TombstoneEntityCaseJobRuns)
+		gt.Value(t, tombstones[0].EntityID).Equal(fmt.Sprintf("%d", source.ID))
+
+		gt.Array(t, mock.postedTexts).Length(2).Required()
+		gt.String(t, mock.postedTexts[0]).Contains(fmt.Sprintf("merged this case into #%d", target.ID))
+		gt.String(t, mock.postedTexts[1]).Contains(fmt.Sprintf("#%d (<#C%d>) into this case", source.ID, source.ID))
+	})
+
+	t.Run("rejects invalid merges", func(t *testing.T) {
+		uc, repo, _ := setup(t)
+		target := createCase(t, uc, "Target", false)
+		source := createCase(t, uc, "Source", false)
+		private := createCase(t, uc, "Private", true)
+		_, err := repo.Case().Transact(context.Background(), testWorkspaceID, private.ID, func(c *model.Case) error {
+			c.ChannelUserIDs = []string{"UTESTUSER"}
+			return nil
+		})
+		gt.NoError(t, err).Required()
+
+		_, err = uc.MergeCases(ctx, testWorkspaceID, target.ID, nil)
+		gt.Error(t, err).Is(usecase.ErrInvalidArgument)
+		_, err = uc.MergeCases(ctx, testWorkspaceID, target.ID, []int64{target.ID})
+		gt.Error(t, err).Is(usecase.ErrInvalidArgument)
+		_, err = uc.MergeCases(ctx, testWorkspaceID, target.ID, []int64{private.ID})
+		gt.Error(t, err).Is(usecase.ErrInvalidArgument)
+
+		key := model.JobRunKey{WorkspaceID: testWorkspaceID, CaseID: source.ID, JobID: "triage"}
+		ok, err := repo.JobRun().TryAcquireLease(context.Background(), key, time.Now().UTC(), time.Minute)
+		gt.NoError(t, err).Required()
+		gt.True(t, ok)
+		_, err = uc.MergeCases(ctx, testWorkspaceID, target.ID, []int64{source.ID})
+		gt.Error(t, err).Is(usecase.ErrJobAlreadyRunning)
+		gt.NoError(t, repo.JobRun().ReleaseLease(context.Background(), key)).Required()
+
+		_, err = uc.MergeCases(ctx, testWorkspaceID, target.ID, []int64{source.ID})
+		gt.NoError(t, err).Required()
+		_, err = uc.MergeCases(ctx, testWorkspaceID, source.ID, []int64{private.ID})
+		gt.Error(t, err).Is(usecase.ErrCaseAlreadyMerged)
+
+		other := createCase(t, uc, "Other", false)
+		_, err = uc.MergeCases(ctx, testWorkspaceID, other.ID, []int64{source.ID})
+		gt.Error(t, err).Is(usecase.ErrCaseAlreadyMerged)
+
+		_, err = uc.CloseCase(ctx, testWorkspaceID, other.ID)
+		gt.NoError(t, err).Required()
+		_, err = uc.MergeCases(ctx, testWorkspaceID, other.ID, []int64{target.ID})
+		gt.Error(t, err).Is(usecase.ErrCaseAlreadyClosed)
+	})
+
+	t.Run("private cases merge only between the same members", func(t *testing.T) {
+		uc, repo, _ := setup(t)
+		setMembers := func(t *testing.T, id int64, members ...string) {
+			t.Helper()
+			_, err := repo.Case().Transact(context.Background(), testWorkspaceID, id, func(c *model.Case) error {
+				c.ChannelUserIDs = members
+				return nil
+			})
+			gt.NoError(t, err).Required()
+		}
+		source := createCase(t, uc, "Insider report", true)
+		setMembers(t, source.ID, "UTESTUSER", "U-BOB")
+		wider := createCase(t, uc, "HR escalation", true)
+		setMembers(t, wider.ID, "UTESTUSER", "U-BOB", "U-EVE")
+		narrower := createCase(t, uc, "Legal hold", true)
+		setMembers(t, narrower.ID, "UTESTUSER")
+		same := createCase(t, uc, "Insider follow-up", true)
+		setMembers(t, same.ID, "U-BOB", "UTESTUSER")
+
+		_, err := uc.MergeCases(ctx, testWorkspaceID, wider.ID, []int64{source.ID})
+		gt.Error(t, err).Is(usecase.ErrInvalidArgument)
+		_, err = uc.MergeCases(ctx, testWorkspaceID, narrower.ID, []int64{source.ID})
+		gt.Error(t, err).Is(usecase.ErrInvalidArgument)
+		got, err := repo.Case().Get(context.Background(), testWorkspaceID, source.ID)
+		gt.NoError(t, err).Required()
+		gt.Value(t, got.MergedInto).Equal(int64(0))
+
+		_, err = uc.MergeCases(ctx, testWorkspaceID, same.ID, []int64{source.ID})
+		gt.NoError(t, err).Required()
+	})
+
+	t.Run("thread-mode sources move to a closed board status", func(t *testing.T) {
+		repo := memory.New()
+		set, err := model.NewActionStatusSet("triage", []string{"done"}, []model.ActionStatusDefinition{
+			{ID: "triage", Name: "Triage"},
+			{ID: "done", Name: "Done"},
+		})
+		gt.NoError(t, err).Required()
+		registry := model.NewWorkspaceRegistry()
+		registry.Register(&model.WorkspaceEntry{
+			Workspace:             model.Workspace{ID: "support"},
+			CaseMode:              model.CaseModeThread,
+			SlackMonitorChannelID: "C-MONITOR",
+			CaseStatusSet:         set,
+		})
+		uc := usecase.NewCaseUseCase(repo, registry, nil, nil, "")
+
+		target, err := uc.CreateThreadBoundCaseForTest(context.Background(), "support", "C-MONITOR", "1700000000.000100", "U-REP", "VPN down", "", nil, "")
+		gt.NoError(t, err).Required()
+		source, err := uc.CreateThreadBoundCaseForTest(context.Background(), "support", "C-MONITOR", "1700000000.000200", "U-REP2", "VPN broken", "", nil, "")
+		gt.NoError(t, err).Required()
+
+		_, err = uc.MergeCases(context.Background(), "support", target.ID, []int64{source.ID})
+		gt.NoError(t, err).Required()
+		got, err := repo.Case().Get(context.Background(), "support", source.ID)
+		gt.NoError(t, err).Required()
+		gt.Value(t, got.BoardStatus).Equal("done")
+		gt.Value(t, got.Status).Equal(types.CaseStatusClosed)
+		gt.Value(t, got.MergedInto).Equal(target.ID)
+	})
+}
+
+func TestCaseUseCase_DuplicateCandidates(t *testing.T) {
+	i18n.Init(i18n.LangEN)
+	ctx := dashCtx("UTESTUSER")
+	repo := memory.New()
+	mock := &mockSlackService{
+		createChannelFn: func(_ context.Context, caseID int64, _ string, _ string) (string, error) {
+			return fmt.Sprintf("C%d", caseID), nil
+		},
+	}
+	uc := usecase.NewCaseUseCase(repo, nil, mock, nil, "")
+	embed := &fakeEmbedClient{}
+	uc.SetEmbedClient(embed)
+
+	create := func(title string) *model.Case {
+		t.Helper()
+		c, err := uc.CreateCase(ctx, testWorkspaceID, title, "", []string{}, nil, false, false, "", "")
+		gt.NoError(t, err).Required()
+		async.Wait()
+		return c
+	}
+	first := create("GitHub secret leaked")
+	create("npm package typosquat")
+	mock.postedTexts = nil
+	dup := create("Secret pushed to GitHub")
+
+	got, err := repo.Case().Get(context.Background(), testWorkspaceID, dup.ID)
+	gt.NoError(t, err).Required()
+	gt.Array(t, got.Embedding).Length(model.EmbeddingDimension)
+
+	candidates, err := uc.DuplicateCandidates(ctx, testWorkspaceID, dup.ID)
+	gt.NoError(t, err).Required()
+	gt.Array(t, candidates).Length(1).Required()
+	gt.Value(t, candidates[0].Case.ID).Equal(first.ID)
+	gt.True(t, candidates[0].Score > 0.99)
+
+	var suggested bool
+	for _, text := range mock.postedTexts {
+		if strings.Contains(text, fmt.Sprintf("looks similar to #%d", first.ID)) {
+			suggested = true
+		}
+	}
+	gt.True(t, suggested)
+
+	// Once merged, the duplicate no longer suggests anything.
+	_, err = uc.MergeCases(ctx, testWorkspaceID, first.ID, []int64{dup.ID})
+	gt.NoError(t, err).Required()
+	candidates, err = uc.DuplicateCandidates(ctx, testWorkspaceID, dup.ID)
+	gt.NoError(t, err).Required()
+	gt.Array(t, candidates).Length(0)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -944,6 +945,13 @@ func TestCaseUseCase_DeleteCase(t *testing.T) {
 		actions, err := actionUC.GetActionsByCase(ctx, testWorkspaceID, created.ID, interfaces.ActionListOptions{ArchiveScope: interfaces.ActionArchiveScopeAll})
 		gt.NoError(t, err).Required()
 		gt.Array(t, actions).Length(0)
+
+		// The deletion is left as a tombstone for the incremental export.
+		tombstones, err := repo.ExportState().ListTombstones(ctx, testWorkspaceID, time.Time{})
+		gt.NoError(t, err).Required()
+		gt.Array(t, tombstones).Length(1).Required()
+		gt.Value(t, tombstones[0].Entity).Equal(model.TombstoneEntityCase)
+		gt.Value(t, tombstones[0].EntityID).Equal(strconv.FormatInt(created.ID, 10))
 	})
 
 	t.Run("delete non-existent case fails", func(t *testing.T) {
@@ -1127,6 +1135,88 @@ func TestCaseUseCase_ListCases(t *testing.T) {
 	})
 }
 
+func TestCaseUseCase_SearchCases(t *testing.T) {
+	seed := func(t *testing.T, repo *memory.Memory, c *model.Case) *model.Case {
+		t.Helper()
+		now := time.Now().UTC()
+		c.Status = types.CaseStatusOpen
+		c.CreatedAt, c.UpdatedAt = now, now
+		created, err := repo.Case().Create(context.Background(), testWorkspaceID, c)
+		gt.NoError(t, err).Required()
+		return created
+	}
+
+	t.Run("pages through matching cases", func(t *testing.T) {
+		repo := memory.New()
+		uc := usecase.NewCaseUseCase(repo, nil, nil, nil, "")
+		ctx := auth.ContextWithToken(context.Background(), &auth.Token{Sub: "UTESTUSER"})
+
+		for i := 0; i < 3; i++ {
+			seed(t, repo, &model.Case{Title: fmt.Sprintf("mine %d", i), ReporterID: "UTESTUSER", AssigneeIDs: []string{"UTESTUSER"}})
+		}
+		seed(t, repo, &model.Case{Title: "other", ReporterID: "UOTHER"})
+
+		in := usecase.SearchCasesInput{
+			AssigneeID: "UTESTUSER",
+			Sort:       interfaces.CaseSort{Key: interfaces.CaseSortID, Ascending: true},
+			First:      2,
+		}
+		page, err := uc.SearchCases(ctx, testWorkspaceID, in)
+		gt.NoError(t, err).Required()
+		gt.Array(t, page.Items).Length(2)
+		gt.Value(t, page.Items[0].Title).Equal("mine 0")
+		gt.Value(t, page.NextCursor).NotNil()
+
+		in.After = page.NextCursor
+		page, err = uc.SearchCases(ctx, testWorkspaceID, in)
+		gt.NoError(t, err).Required()
+		gt.Array(t, page.Items).Length(1)
+		gt.Value(t, page.Items[0].Title).Equal("mine 2")
+		gt.Value(t, page.NextCursor).Nil()
+	})
+
+	t.Run("drops inaccessible private cases when filtering on content", func(t *testing.T) {
+		repo := memory.New()
+		uc := usecase.NewCaseUseCase(repo, nil, nil, nil, "")
+		ctx := auth.ContextWithToken(context.Background(), &auth.Token{Sub: "UTESTUSER"})
+
+		seed(t, repo, &model.Case{Title: "secret breach", ReporterID: "UOTHER", IsPrivate: true, ChannelUserIDs: []string{"UOTHER"}})
+		seed(t, repo, &model.Case{Title: "public breach", ReporterID: "UOTHER"})
+
+		page, err := uc.SearchCases(ctx, testWorkspaceID, usecase.SearchCasesInput{Text: "secret"})
+		gt.NoError(t, err).Required()
+		gt.Array(t, page.Items).Length(0)
+
+		// Without a content filter the private case is listed, restricted.
+		page, err = uc.SearchCases(ctx, testWorkspaceID, usecase.SearchCasesInput{})
+		gt.NoError(t, err).Required()
+		gt.Array(t, page.Items).Length(2)
+		denied := 0
+		for _, c := range page.Items {
+			if c.AccessDenied {
+				denied++
+				gt.Value(t, c.Title).Equal("")
+			}
+		}
+		gt.Value(t, denied).Equal(1)
+	})
+
+	t.Run("rejects a bad cursor or sort as invalid argument", func(t *testing.T) {
+		repo := memory.New()
+		uc := usecase.NewCaseUseCase(repo, nil, nil, nil, "")
+		ctx := auth.ContextWithToken(context.Background(), &auth.Token{Sub: "UTESTUSER"})
+
+		bad := "not-a-cursor"
+		_, err := uc.SearchCases(ctx, testWorkspaceID, usecase.SearchCasesInput{After: &bad})
+		gt.Bool(t, errors.Is(err, usecase.ErrInvalidArgument)).True()
+
+		_, err = uc.SearchCases(ctx, testWorkspaceID, usecase.SearchCasesInput{
+			Sort: interfaces.CaseSort{Key: "TITLE"},
+		})
+		gt.Bool(t, errors.Is(err, usecase.ErrInvalidArgument)).True()
+	})
+}
+
 func TestCaseUseCase_CreateCase_DefaultStatus(t *testing.T) {
 	repo := memory.New()
 	uc := usecase.NewCaseUseCase(repo, nil, nil, nil, "")
@@ -3109,8 +3199,9 @@ func TestCaseUseCase_GetCase_DraftVisibility(t *testing.T) {
 	})
 }
 
-// recordingCaseEventPublisher captures every PublishCaseLifecycle invocation
-// so lifecycle-wiring tests can assert what fired and what did not.
+// recordingCaseEventPublisher captures every PublishCaseLifecycle /
+// PublishCaseChange invocation so lifecycle-wiring tests can assert what
+// fired and what did not.
 type recordingCaseEventPublisher struct {
 	events []recordedCaseEvent
 }
@@ -3119,14 +3210,20 @@ type recordedCaseEvent struct {
 	workspaceID string
 	caseID      int64
 	lifecycle   model.CaseLifecycle
+	change      model.CaseChange
 	actor       string
 }
 
-func (r *recordingCaseEventPublisher) PublishCaseLifecycle(_ context.Context, workspaceID string, c *model.Case, lifecycle model.CaseLifecycle, actor string) {
+func (r *recordingCaseEventPublisher) PublishCaseLifecycle(ctx context.Context, workspaceID string, c *model.Case, lifecycle model.CaseLifecycle, actor string) {
+	r.PublishCaseChange(ctx, workspaceID, c, model.CaseLifecycleChange(lifecycle), actor)
+}
+
+func (r *recordingCaseEventPublisher) PublishCaseChange(_ context.Context, workspaceID string, c *model.Case, change model.CaseChange, actor string) {
 	r.events = append(r.events, recordedCaseEvent{
 		workspaceID: workspaceID,
 		caseID:      c.ID,
-		lifecycle:   lifecycle,
+		lifecycle:   change.Lifecycle,
+		change:      change,
 		actor:       actor,
 	})
 }
@@ -3176,6 +3273,164 @@ func TestCaseUseCase_PublishesClosedLifecycle(t *testing.T) {
 	gt.Value(t, pub.events[1].caseID).Equal(closed.ID)
 }
 
+func TestCaseUseCase_PublishesFieldChanged(t *testing.T) {
+	repo := memory.New()
+	registry := model.NewWorkspaceRegistry()
+	registry.Register(&model.WorkspaceEntry{
+		Workspace: model.Workspace{ID: testWorkspaceID, Name: "Test"},
+		FieldSchema: &config.FieldSchema{
+			Fields: []config.FieldDefinition{
+				{
+					ID:   "severity",
+					Name: "Severity",
+					Type: types.FieldTypeSelect,
+					Options: []config.FieldOption{
+						{ID: "low", Name: "Low"},
+						{ID: "critical", Name: "Critical"},
+					},
+				},
+			},
+		},
+	})
+	uc := usecase.NewCaseUseCase(repo, registry, nil, nil, "")
+	pub := &recordingCaseEventPublisher{}
+	uc.SetEventPublisher(pub)
+
+	ctx := auth.ContextWithToken(context.Background(), &auth.Token{Sub: "U-CALLER"})
+	created, err := uc.CreateCase(ctx, testWorkspaceID, "T", "", nil, map[string]model.FieldValue{
+		"severity": {FieldID: "severity", Value: "low"},
+	}, false, false, "", "")
+	gt.NoError(t, err).Required()
+	gt.Array(t, pub.events).Length(1).Required()
+
+	t.Run("changed value publishes field_changed with before and after", func(t *testing.T) {
+		_, err := uc.UpdateCase(ctx, testWorkspaceID, created.ID, usecase.CaseUpdate{
+			Fields: map[string]model.FieldValue{"severity": {FieldID: "severity", Value: "critical"}},
+		})
+		gt.NoError(t, err).Required()
+		gt.Array(t, pub.events).Length(2).Required()
+		ev := pub.events[1]
+		gt.Value(t, ev.lifecycle).Equal(model.CaseLifecycleFieldChanged)
+		gt.String(t, ev.change.FieldID).Equal("severity")
+		gt.Array(t, ev.change.From).Equal([]string{"low"})
+		gt.Array(t, ev.change.To).Equal([]string{"critical"})
+		gt.String(t, ev.actor).Equal("U-CALLER")
+	})
+
+	t.Run("re-saving the same value publishes nothing", func(t *testing.T) {
+		_, err := uc.UpdateCase(ctx, testWorkspaceID, created.ID, usecase.CaseUpdate{
+			Fields: map[string]model.FieldValue{"severity": {FieldID: "severity", Value: "critical"}},
+		})
+		gt.NoError(t, err).Required()
+		gt.Array(t, pub.events).Length(2)
+	})
+}
+
+func TestCaseUseCase_PublishesAssigned(t *testing.T) {
+	repo := memory.New()
+	registry := model.NewWorkspaceRegistry()
+	registry.Register(&model.WorkspaceEntry{
+		Workspace:   model.Workspace{ID: testWorkspaceID, Name: "Test"},
+		FieldSchema: &config.FieldSchema{},
+	})
+	uc := usecase.NewCaseUseCase(repo, registry, nil, nil, "")
+	pub := &recordingCaseEventPublisher{}
+	uc.SetEventPublisher(pub)
+	seedSlackUsers(t, repo, "U001", "U002")
+
+	ctx := auth.ContextWithToken(context.Background(), &auth.Token{Sub: "U-CALLER"})
+	created, err := uc.CreateCase(ctx, testWorkspaceID, "T", "", []string{"U001"}, nil, false, false, "", "")
+	gt.NoError(t, err).Required()
+	gt.Array(t, pub.events).Length(1).Required()
+
+	_, err = uc.AssignCase(ctx, testWorkspaceID, created.ID, []string{"U001", "U002"})
+	gt.NoError(t, err).Required()
+	gt.Array(t, pub.events).Length(2).Required()
+	gt.Value(t, pub.events[1].lifecycle).Equal(model.CaseLifecycleAssigned)
+	// Only the newly added assignee is reported; U001 was already assigned.
+	gt.Array(t, pub.events[1].change.To).Equal([]string{"U002"})
+}
+
+func TestCaseUseCase_DraftAssignDoesNotPublish(t *testing.T) {
+	repo := memory.New()
+	registry := model.NewWorkspaceRegistry()
+	registry.Register(&model.WorkspaceEntry{
+		Workspace:   model.Workspace{ID: testWorkspaceID, Name: "Test"},
+		FieldSchema: &config.FieldSchema{},
+	})
+	uc := usecase.NewCaseUseCase(repo, registry, nil, nil, "")
+	pub := &recordingCaseEventPublisher{}
+	uc.SetEventPublisher(pub)
+	seedSlackUsers(t, repo, "U001")
+
+	ctx := auth.ContextWithToken(context.Background(), &auth.Token{Sub: "U-CALLER"})
+	draft, err := uc.CreateDraft(ctx, testWorkspaceID, "Draft", "", nil, nil, false, false)
+	gt.NoError(t, err).Required()
+
+	_, err = uc.AssignCase(ctx, testWorkspaceID, draft.ID, []string{"U001"})
+	gt.NoError(t, err).Required()
+	gt.Array(t, pub.events).Length(0)
+}
+
+func TestCaseUseCase_PublishesStatusChanged(t *testing.T) {
+	repo := memory.New()
+	set, err := model.NewActionStatusSet("triage", []string{"done"}, []model.ActionStatusDefinition{
+		{ID: "triage", Name: "Triage"},
+		{ID: "in_review", Name: "In Review"},
+		{ID: "done", Name: "Done"},
+	})
+	gt.NoError(t, err).Required()
+	registry := model.NewWorkspaceRegistry()
+	registry.Register(&model.WorkspaceEntry{
+		Workspace:             model.Workspace{ID: "support"},
+		CaseMode:              model.CaseModeThread,
+		SlackMonitorChannelID: "C-MONITOR",
+		CaseStatusSet:         set,
+	})
+	uc := usecase.NewCaseUseCase(repo, registry, nil, nil, "")
+	pub := &recordingCaseEventPublisher{}
+	uc.SetEventPublisher(pub)
+
+	ctx := context.Background()
+	c, err := uc.CreateThreadBoundCaseForTest(ctx, "support", "C-MONITOR", "1700000000.000100", "U-REP", "t", "b", nil, "")
+	gt.NoError(t, err).Required()
+
+	statusChanges := func() []model.CaseChange {
+		var out []model.CaseChange
+		for _, ev := range pub.events {
+			if ev.lifecycle == model.CaseLifecycleStatusChanged {
+				out = append(out, ev.change)
+			}
+		}
+		return out
+	}
+
+	_, err = uc.UpdateCaseStatus(ctx, "support", c.ID, "in_review")
+	gt.NoError(t, err).Required()
+	gt.Array(t, statusChanges()).Length(1).Required()
+	gt.Array(t, statusChanges()[0].From).Equal([]string{"triage"})
+	gt.Array(t, statusChanges()[0].To).Equal([]string{"in_review"})
+
+	// Moving into a closed status reports both the status change and `closed`.
+	_, err = uc.UpdateCaseStatus(ctx, "support", c.ID, "done")
+	gt.NoError(t, err).Required()
+	gt.Array(t, statusChanges()).Length(2).Required()
+	gt.Array(t, statusChanges()[1].From).Equal([]string{"in_review"})
+	gt.Array(t, statusChanges()[1].To).Equal([]string{"done"})
+	closed := 0
+	for _, ev := range pub.events {
+		if ev.lifecycle == model.CaseLifecycleClosed {
+			closed++
+		}
+	}
+	gt.Number(t, closed).Equal(1)
+
+	// Re-selecting the current status is not a change.
+	_, err = uc.UpdateCaseStatus(ctx, "support", c.ID, "done")
+	gt.NoError(t, err).Required()
+	gt.Array(t, statusChanges()).Length(2)
+}
+
 func TestCaseUseCase_NoPublishWhenNotConfigured(t *testing.T) {
 	repo := memory.New()
 	registry := model.NewWorkspaceRegistry()
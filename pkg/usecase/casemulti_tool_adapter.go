@@ -108,8 +108,8 @@ func (a *caseMultiActionAdapter) GetAction(ctx context.Context, workspaceID stri
 // tool does not collect: an empty status is normalized to the workspace's
 // initial action status by CreateAction. Access + "created by" attribution are
 // ctx-token-only (no actor parameter), matching the real usecase.
-func (a *caseMultiActionAdapter) CreateAction(ctx context.Context, workspaceID string, caseID int64, title, description string) (*model.Action, error) {
-	return a.action.CreateAction(ctx, workspaceID, caseID, title, description, "", "", types.ActionStatus(""), nil)
+func (a *caseMultiActionAdapter) CreateAction(ctx context.Context, workspaceID string, caseID int64, title, description string, blockedBy []int64) (*model.Action, error) {
+	return a.action.CreateAction(ctx, workspaceID, caseID, title, description, "", "", types.ActionStatus(""), nil, blockedBy...)
 }
 
 func (a *caseMultiActionAdapter) UpdateAction(ctx context.Context, workspaceID string, actionID int64, patch casemulti.ActionUpdate, actorID string) (*model.Action, error) {
@@ -118,6 +118,7 @@ func (a *caseMultiActionAdapter) UpdateAction(ctx context.Context, workspaceID s
 		Title:       patch.Title,
 		Description: patch.Description,
 		Status:      patch.Status,
+		BlockedBy:   patch.BlockedBy,
 		Actor:       ActorRef{Kind: ActorKindSlackUser, ID: actorID},
 		SlackSync:   SlackSyncFull,
 	})
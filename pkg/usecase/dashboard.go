@@ -200,6 +200,71 @@ func (uc *DashboardUseCase) ListMyDueActions(ctx context.Context) ([]*model.MyDu
 	return result, nil
 }
 
+// ListOpenSLABreaches returns, across every workspace, the recorded SLA
+// breaches of the open Cases the caller may access, earliest due first. Unlike
+// the "my *" lists it is not narrowed to the caller's assignments: a missed
+// target is the whole team's concern.
+func (uc *DashboardUseCase) ListOpenSLABreaches(ctx context.Context) ([]*model.OpenSLABreach, error) {
+	token, err := auth.TokenFromContext(ctx)
+	if err != nil {
+		return nil, goerr.Wrap(ErrUnauthenticated, "list open sla breaches")
+	}
+
+	entries := uc.registry.List()
+	partial := make([][]*model.OpenSLABreach, len(entries))
+
+	if err := uc.fanOut(ctx, entries, func(fctx context.Context, i int, entry *model.WorkspaceEntry) error {
+		if entry.SLA == nil {
+			return nil
+		}
+		breaches, err := uc.repo.SLABreach().List(fctx, entry.Workspace.ID)
+		if err != nil {
+			return goerr.Wrap(err, "failed to list sla breaches", goerr.V("workspace_id", entry.Workspace.ID))
+		}
+		if len(breaches) == 0 {
+			return nil
+		}
+		cases, err := uc.repo.Case().List(fctx, entry.Workspace.ID, interfaces.WithStatus(types.CaseStatusOpen))
+		if err != nil {
+			return goerr.Wrap(err, "failed to list open cases", goerr.V("workspace_id", entry.Workspace.ID))
+		}
+		caseByID := make(map[int64]*model.Case, len(cases))
+		for _, c := range cases {
+			if model.IsCaseAccessible(c, token.Sub) {
+				caseByID[c.ID] = c
+			}
+		}
+
+		rows := make([]*model.OpenSLABreach, 0)
+		for _, b := range breaches {
+			c, ok := caseByID[b.CaseID]
+			if !ok {
+				continue
+			}
+			rows = append(rows, &model.OpenSLABreach{
+				WorkspaceID:   entry.Workspace.ID,
+				WorkspaceName: entry.Workspace.Name,
+				CaseID:        c.ID,
+				CaseTitle:     c.Title,
+				Breach:        b,
+			})
+		}
+		partial[i] = rows
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.OpenSLABreach, 0)
+	for _, rows := range partial {
+		result = append(result, rows...)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Breach.DueAt.Before(result[j].Breach.DueAt)
+	})
+	return result, nil
+}
+
 // GetFavoriteWorkspaces returns the caller's favorite workspace IDs, filtered to
 // those that still exist in the registry.
 func (uc *DashboardUseCase) GetFavoriteWorkspaces(ctx context.Context) ([]string, error) {
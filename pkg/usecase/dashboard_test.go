@@ -197,6 +197,51 @@ func TestDashboardUseCase_ListMyDueActions_PrivateCaseAccessControl(t *testing.T
 	gt.Array(t, nonMember).Length(0)
 }
 
+func TestDashboardUseCase_ListOpenSLABreaches(t *testing.T) {
+	t.Parallel()
+	repo := memory.New()
+	reg := model.NewWorkspaceRegistry()
+	for _, id := range []string{"ws-1", "ws-2"} {
+		reg.Register(&model.WorkspaceEntry{
+			Workspace: model.Workspace{ID: id, Name: "name-" + id},
+			SLA:       &model.SLAPolicy{},
+		})
+	}
+	reg.Register(&model.WorkspaceEntry{Workspace: model.Workspace{ID: "ws-nosla", Name: "name-ws-nosla"}})
+	uc := usecase.New(repo, reg)
+	ctx := dashCtx(dashTestUser)
+	now := time.Now().UTC()
+
+	mkBreach := func(wsID string, c *model.Case, due time.Time) {
+		c.ReporterID = "U-rep"
+		c.CreatedAt, c.UpdatedAt = now, now
+		created, err := repo.Case().Create(ctx, wsID, c)
+		gt.NoError(t, err).Required()
+		_, err = repo.SLABreach().Record(ctx, &model.SLABreach{
+			WorkspaceID: wsID, CaseID: created.ID, Kind: model.SLAKindResponse,
+			Target: time.Hour, DueAt: due, RecordedAt: now,
+		})
+		gt.NoError(t, err).Required()
+	}
+	mkBreach("ws-1", &model.Case{Title: "later", Status: types.CaseStatusOpen}, now.Add(-time.Hour))
+	mkBreach("ws-2", &model.Case{Title: "earlier", Status: types.CaseStatusOpen}, now.Add(-2*time.Hour))
+	// closed case -> excluded
+	mkBreach("ws-1", &model.Case{Title: "closed", Status: types.CaseStatusClosed}, now.Add(-3*time.Hour))
+	// private case the caller is not a member of -> excluded
+	mkBreach("ws-1", &model.Case{Title: "private", Status: types.CaseStatusOpen, IsPrivate: true, ChannelUserIDs: []string{"U-other"}}, now.Add(-3*time.Hour))
+	// workspace without [sla] -> excluded
+	mkBreach("ws-nosla", &model.Case{Title: "nosla", Status: types.CaseStatusOpen}, now.Add(-3*time.Hour))
+
+	got, err := uc.Dashboard.ListOpenSLABreaches(ctx)
+	gt.NoError(t, err).Required()
+	gt.Array(t, got).Length(2).Required()
+	gt.String(t, got[0].CaseTitle).Equal("earlier")
+	gt.Value(t, got[0].WorkspaceID).Equal("ws-2")
+	gt.Value(t, got[0].WorkspaceName).Equal("name-ws-2")
+	gt.String(t, got[1].CaseTitle).Equal("later")
+	gt.Value(t, got[1].Breach.Kind).Equal(model.SLAKindResponse)
+}
+
 func TestDashboardUseCase_Favorites(t *testing.T) {
 	t.Parallel()
 	repo := memory.New()
@@ -426,6 +471,8 @@ func TestDashboardUseCase_Unauthenticated(t *testing.T) {
 
 	_, err := uc.Dashboard.ListMyDueActions(ctx)
 	gt.Error(t, err).Is(usecase.ErrUnauthenticated)
+	_, err = uc.Dashboard.ListOpenSLABreaches(ctx)
+	gt.Error(t, err).Is(usecase.ErrUnauthenticated)
 	_, err = uc.Dashboard.GetFavoriteWorkspaces(ctx)
 	gt.Error(t, err).Is(usecase.ErrUnauthenticated)
 	_, err = uc.Dashboard.SetFavoriteWorkspaces(ctx, []string{"ws-1"})
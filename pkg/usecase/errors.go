@@ -22,6 +22,11 @@ var (
 	// ErrCaseNotDraft is returned by draft-specific operations (Submit /
 	// Discard) when the targeted case is not in DRAFT.
 	ErrCaseNotDraft = errors.New("case is not a draft")
+	// ErrCaseAlreadyMerged is returned by MergeCases when the target or one
+	// of the sources was already merged into another case, and by
+	// AttachMessage for a merged case. A merged case is closed for good; its
+	// records live on the case it was merged into.
+	ErrCaseAlreadyMerged = errors.New("case is already merged")
 	// ErrCaseThreadModeUseStatus is returned by CloseCase / ReopenCase when the
 	// targeted case is thread-mode (bound to a Slack thread). Thread-mode cases
 	// change lifecycle by moving the configurable board status via
@@ -93,6 +98,18 @@ var (
 	// Access control errors
 	ErrAccessDenied = errors.New("access denied to private case")
 
+	// ErrAPITokenNotFound is returned when revoking a personal access token
+	// the caller does not own, which includes one that does not exist.
+	ErrAPITokenNotFound = errors.New("API token not found")
+
+	// ErrWebhookDeliveryNotFound is returned when retrying a webhook delivery
+	// that does not exist in the workspace.
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+
+	// ErrIngestNotConfigured is returned when an alert is posted to a
+	// workspace that has no [ingest] section.
+	ErrIngestNotConfigured = errors.New("workspace does not accept alerts")
+
 	// Agent Job manual-trigger errors
 	//
 	// ErrJobNotFound is returned when a manual trigger names a Job that is
@@ -148,6 +148,14 @@ func (f *fakeSlack) UpdateView(_ context.Context, _ goslack.ModalViewRequest, _,
 	return nil
 }
 
+func (f *fakeSlack) PublishView(_ context.Context, _ string, _ goslack.HomeTabViewRequest) error {
+	return nil
+}
+
+func (f *fakeSlack) UnfurlMessage(_ context.Context, _, _ string, _ map[string]goslack.Attachment) error {
+	return nil
+}
+
 func (f *fakeSlack) ListUserGroups(_ context.Context, _ string) ([]slacksvc.UserGroup, error) {
 	return nil, nil
 }
@@ -1,16 +1,22 @@
 // Package export implements the `export` subcommand's core: reading the current
 // state of every configured workspace out of the repository and writing it, one
-// table per entity, to a pluggable Sink (BigQuery today). The Exporter is
-// sink-agnostic — it builds a generic Table (typed columns + rows of natural Go
-// values) and hands it to the Sink, which owns all backend-specific concerns
-// (schema evolution, full-refresh, encoding). This keeps a future Cloud Storage
-// sink a drop-in without touching the read/normalize logic here.
+// table per entity, to a pluggable Sink (BigQuery, Parquet/NDJSON files or
+// PostgreSQL). The Exporter is sink-agnostic — it builds a generic Table (typed
+// columns + rows of natural Go values) and hands it to the Sink, which owns all
+// backend-specific concerns (schema evolution, full-refresh, encoding), so a
+// new sink is a drop-in without touching the read/normalize logic here.
+//
+// A run is either a full refresh of every table (ModeFull) or, for a Sink that
+// also implements Merger, an incremental run (ModeIncremental) that ships only
+// the rows changed since the last run's per-table watermark; see
+// incremental.go.
 package export
 
 import (
 	"context"
 	"errors"
 	"io"
+	"time"
 
 	"github.com/m-mizutani/goerr/v2"
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
@@ -62,8 +68,49 @@ type Sink interface {
 	io.Closer
 }
 
-// Target binds one workspace to its destination namespace (a BigQuery dataset)
-// and its per-workspace privacy policy.
+// Aborter is implemented by a Sink that holds back part of its output until
+// Close (e.g. the tarball file sink). When a run fails, the caller calls Abort
+// instead of Close so the staged output is discarded and whatever the previous
+// run published is left untouched.
+type Aborter interface {
+	Abort() error
+}
+
+// Delta is an incremental update of one table: the rows to upsert and the
+// rows to remove. Table carries the full column list (the same one a full
+// refresh writes) and only the changed rows.
+type Delta struct {
+	Table *Table
+	// Key names the columns that identify a row. An incoming row replaces every
+	// existing row with the same key values.
+	Key []string
+	// Deletes lists predicates for rows to remove before the upsert. Each entry
+	// maps column names to values, ANDed together (e.g. {"case_id": 42}).
+	Deletes []map[string]any
+}
+
+// Merger is implemented by a Sink that can apply a Delta to a table written
+// earlier by WriteTable. MergeTable MUST apply the deletes and the upsert
+// atomically, and MUST fail (rather than guess) when the destination table is
+// missing or its schema differs from Delta.Table's columns.
+type Merger interface {
+	MergeTable(ctx context.Context, namespace string, delta *Delta) error
+}
+
+// Mode selects how a run writes the case-scoped tables.
+type Mode string
+
+const (
+	// ModeFull fully refreshes every table. It is the default.
+	ModeFull Mode = "full"
+	// ModeIncremental merges only what changed since the previous run. It needs
+	// a Sink that implements Merger and a sink name to key the watermarks by.
+	ModeIncremental Mode = "incremental"
+)
+
+// Target binds one workspace to its destination namespace (a BigQuery dataset,
+// a file-sink directory or a PostgreSQL schema) and its per-workspace privacy
+// policy.
 type Target struct {
 	Entry     *model.WorkspaceEntry
 	Namespace string
@@ -78,6 +125,9 @@ type Exporter struct {
 	repo        interfaces.Repository
 	sink        Sink
 	tablePrefix string
+	mode        Mode
+	sinkName    string
+	now         func() time.Time
 }
 
 // Option customizes an Exporter.
@@ -90,23 +140,49 @@ func WithTablePrefix(prefix string) Option {
 	return func(e *Exporter) { e.tablePrefix = prefix }
 }
 
+// WithMode selects full or incremental runs. The default is ModeFull.
+func WithMode(mode Mode) Option {
+	return func(e *Exporter) { e.mode = mode }
+}
+
+// WithSinkName names the sink kind ("bigquery", "postgres", ...) the
+// watermarks are recorded under. Without it no watermarks are kept, and
+// ModeIncremental is refused.
+func WithSinkName(name string) Option {
+	return func(e *Exporter) { e.sinkName = name }
+}
+
+// WithClock overrides the wall clock used to stamp watermarks. Tests use it
+// to pin the high-water mark; production uses time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(e *Exporter) { e.now = now }
+}
+
 // New builds an Exporter. repo and sink are required; opts are optional. The
 // per-workspace privacy policy travels on each Target, not here.
 func New(repo interfaces.Repository, sink Sink, opts ...Option) *Exporter {
-	e := &Exporter{repo: repo, sink: sink}
+	e := &Exporter{repo: repo, sink: sink, mode: ModeFull, now: time.Now}
 	for _, opt := range opts {
 		opt(e)
 	}
 	return e
 }
 
-// writeTable applies the (optional) table-name prefix and hands the table to the
-// sink.
-func (e *Exporter) writeTable(ctx context.Context, namespace string, t *Table) error {
-	if e.tablePrefix != "" {
-		t.Name = e.tablePrefix + t.Name
+// writeTable applies the (optional) table-name prefix, hands the table to the
+// sink, and then records the table's watermark so a later incremental run can
+// merge on top of this refresh.
+func (e *Exporter) writeTable(ctx context.Context, t Target, runStart time.Time, table *Table) error {
+	base := table.Name
+	table.Name = e.tableName(base)
+	if err := e.sink.WriteTable(ctx, t.Namespace, table); err != nil {
+		return err
 	}
-	return e.sink.WriteTable(ctx, namespace, t)
+	return e.putWatermark(ctx, t, runStart, base, table.Columns)
+}
+
+// tableName applies the (optional) table-name prefix.
+func (e *Exporter) tableName(base string) string {
+	return e.tablePrefix + base
 }
 
 // Run exports every target. A failure on one target is logged and collected but
@@ -120,7 +196,23 @@ func (e *Exporter) writeTable(ctx context.Context, namespace string, t *Table) e
 // ends up holding whichever run swapped last. This is a deliberate, documented
 // constraint (the export is a singly-run batch job, e.g. a scheduled task with
 // no overlap), not an oversight — see docs/export.md.
+//
+// In ModeIncremental the sink must implement Merger and a sink name must be
+// set; otherwise Run fails before touching any target.
 func (e *Exporter) Run(ctx context.Context, targets []Target) error {
+	switch e.mode {
+	case ModeFull:
+	case ModeIncremental:
+		if _, ok := e.sink.(Merger); !ok {
+			return goerr.New("incremental export is not supported by this sink; use full mode")
+		}
+		if e.sinkName == "" {
+			return goerr.New("incremental export requires a sink name to key its watermarks")
+		}
+	default:
+		return goerr.New("unknown export mode", goerr.V("mode", e.mode))
+	}
+
 	logger := logging.From(ctx)
 	var errs []error
 	for _, t := range targets {
@@ -137,12 +229,32 @@ func (e *Exporter) Run(ctx context.Context, targets []Target) error {
 	return errors.Join(errs...)
 }
 
-// exportWorkspace writes every entity table for one workspace. Per-table
+// exportWorkspace exports one workspace, incrementally when the mode asks for
+// it and every incremental table has a usable watermark, in full otherwise.
+func (e *Exporter) exportWorkspace(ctx context.Context, t Target) error {
+	// Captured before any read: a row changed while this run is reading is
+	// stamped after runStart, so the next run picks it up again.
+	runStart := e.now().UTC()
+
+	if e.mode == ModeIncremental {
+		since, ok, err := e.incrementalSince(ctx, t)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return e.exportWorkspaceDelta(ctx, t, since, runStart)
+		}
+		logging.From(ctx).Info("no usable watermark; exporting workspace in full",
+			"workspace_id", t.Entry.Workspace.ID, "dataset", t.Namespace)
+	}
+	return e.exportWorkspaceFull(ctx, t, runStart)
+}
+
+// exportWorkspaceFull writes every entity table for one workspace. Per-table
 // failures are collected (not fail-fast) so one bad table does not hide the
 // rest, and are returned joined.
-func (e *Exporter) exportWorkspace(ctx context.Context, t Target) error {
+func (e *Exporter) exportWorkspaceFull(ctx context.Context, t Target, runStart time.Time) error {
 	wsID := t.Entry.Workspace.ID
-	ns := t.Namespace
 	var errs []error
 
 	// Cases (drafts excluded by List; private filtered here when configured).
@@ -161,7 +273,7 @@ func (e *Exporter) exportWorkspace(ctx context.Context, t Target) error {
 		// memory repo does not implement ExcludePrivateCaseActions).
 		keptCaseIDs := caseIDSet(cases)
 
-		if err := e.writeTable(ctx, ns, buildCaseTable(ctx, t.Entry.FieldSchema, cases)); err != nil {
+		if err := e.writeTable(ctx, t, runStart, buildCaseTable(ctx, t.Entry.FieldSchema, cases)); err != nil {
 			errs = append(errs, goerr.Wrap(err, "failed to write cases table"))
 		}
 
@@ -170,7 +282,7 @@ func (e *Exporter) exportWorkspace(ctx context.Context, t Target) error {
 		memos, memoErr := e.collectMemos(ctx, wsID, cases)
 		if memoErr != nil {
 			errs = append(errs, memoErr)
-		} else if err := e.writeTable(ctx, ns, buildMemoTable(ctx, t.Entry.MemoConfig, memos)); err != nil {
+		} else if err := e.writeTable(ctx, t, runStart, buildMemoTable(ctx, t.Entry.MemoConfig, memos)); err != nil {
 			errs = append(errs, goerr.Wrap(err, "failed to write memos table"))
 		}
 
@@ -183,7 +295,7 @@ func (e *Exporter) exportWorkspace(ctx context.Context, t Target) error {
 			errs = append(errs, goerr.Wrap(actionsErr, "failed to list actions"))
 		} else {
 			actions = filterActionsByCases(actions, keptCaseIDs)
-			if err := e.writeTable(ctx, ns, buildActionTable(actions)); err != nil {
+			if err := e.writeTable(ctx, t, runStart, buildActionTable(actions)); err != nil {
 				errs = append(errs, goerr.Wrap(err, "failed to write actions table"))
 			}
 		}
@@ -196,38 +308,80 @@ func (e *Exporter) exportWorkspace(ctx context.Context, t Target) error {
 		history := e.collectJobRuns(ctx, wsID, cases)
 		errs = append(errs, history.errs...)
 		if history.runsComplete {
-			if err := e.writeTable(ctx, ns, buildJobRunTable(history.runs)); err != nil {
+			if err := e.writeTable(ctx, t, runStart, buildJobRunTable(history.runs)); err != nil {
 				errs = append(errs, goerr.Wrap(err, "failed to write job runs table"))
 			}
 		}
 		if history.logsComplete {
-			if err := e.writeTable(ctx, ns, buildJobRunLogTable(history.logs)); err != nil {
+			if err := e.writeTable(ctx, t, runStart, buildJobRunLogTable(history.logs)); err != nil {
 				errs = append(errs, goerr.Wrap(err, "failed to write job run logs table"))
 			}
 		}
 		if history.eventsComplete {
-			if err := e.writeTable(ctx, ns, buildJobRunEventTable(ctx, history.events)); err != nil {
+			if err := e.writeTable(ctx, t, runStart, buildJobRunEventTable(ctx, history.events)); err != nil {
 				errs = append(errs, goerr.Wrap(err, "failed to write job run events table"))
 			}
 		}
+
+		if err := e.writeSLABreaches(ctx, t, runStart, keptCaseIDs); err != nil {
+			errs = append(errs, err)
+		}
 	}
 
-	// Knowledge / Tag are workspace-level (not Case-scoped), always exported.
+	errs = append(errs, e.exportWorkspaceTables(ctx, t, runStart)...)
+	return errors.Join(errs...)
+}
+
+// exportWorkspaceTables fully refreshes the workspace-level Knowledge / Tag
+// tables. They are not Case-scoped, are always exported, and are small enough
+// that both the full and the incremental path simply rewrite them.
+func (e *Exporter) exportWorkspaceTables(ctx context.Context, t Target, runStart time.Time) []error {
+	wsID := t.Entry.Workspace.ID
+	var errs []error
+
 	knowledge, knowledgeErr := e.repo.Knowledge().List(ctx, wsID, interfaces.KnowledgeListOptions{})
 	if knowledgeErr != nil {
 		errs = append(errs, goerr.Wrap(knowledgeErr, "failed to list knowledge"))
-	} else if err := e.writeTable(ctx, ns, buildKnowledgeTable(knowledge)); err != nil {
+	} else if err := e.writeTable(ctx, t, runStart, buildKnowledgeTable(knowledge)); err != nil {
 		errs = append(errs, goerr.Wrap(err, "failed to write knowledge table"))
 	}
 
 	tags, tagsErr := e.repo.Tag().List(ctx, wsID)
 	if tagsErr != nil {
 		errs = append(errs, goerr.Wrap(tagsErr, "failed to list tags"))
-	} else if err := e.writeTable(ctx, ns, buildTagTable(tags)); err != nil {
+	} else if err := e.writeTable(ctx, t, runStart, buildTagTable(tags)); err != nil {
 		errs = append(errs, goerr.Wrap(err, "failed to write tags table"))
 	}
 
-	return errors.Join(errs...)
+	return errs
+}
+
+// writeSLABreaches fully refreshes the sla_breaches table with the breaches of
+// the exported cases. Breaches are few and never change once recorded, so
+// like Knowledge / Tag both the full and the incremental path rewrite them.
+func (e *Exporter) writeSLABreaches(ctx context.Context, t Target, runStart time.Time, exported caseFilter) error {
+	breaches, err := e.repo.SLABreach().List(ctx, t.Entry.Workspace.ID)
+	if err != nil {
+		return goerr.Wrap(err, "failed to list sla breaches")
+	}
+	caseIDs := make([]int64, 0, len(breaches))
+	for _, b := range breaches {
+		caseIDs = append(caseIDs, b.CaseID)
+	}
+	keep, err := exported.filter(ctx, caseIDs)
+	if err != nil {
+		return err
+	}
+	kept := make([]*model.SLABreach, 0, len(breaches))
+	for _, b := range breaches {
+		if keep[b.CaseID] {
+			kept = append(kept, b)
+		}
+	}
+	if err := e.writeTable(ctx, t, runStart, buildSLABreachTable(kept)); err != nil {
+		return goerr.Wrap(err, "failed to write sla breaches table")
+	}
+	return nil
 }
 
 // collectMemos gathers every memo (archived included) across the given cases.
@@ -337,15 +491,35 @@ func filterNonPrivate(cases []*model.Case) []*model.Case {
 	return out
 }
 
+// caseFilter returns the subset of a list of case IDs whose cases are
+// exported. The full path knows every exported case up front (caseSet); the
+// incremental path looks them up as it meets them (caseScope).
+type caseFilter interface {
+	filter(ctx context.Context, ids []int64) (map[int64]bool, error)
+}
+
+// caseSet is a set of case IDs.
+type caseSet map[int64]bool
+
 // caseIDSet indexes the given cases by ID.
-func caseIDSet(cases []*model.Case) map[int64]bool {
-	s := make(map[int64]bool, len(cases))
+func caseIDSet(cases []*model.Case) caseSet {
+	s := make(caseSet, len(cases))
 	for _, c := range cases {
 		s[c.ID] = true
 	}
 	return s
 }
 
+func (s caseSet) filter(_ context.Context, ids []int64) (map[int64]bool, error) {
+	out := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		if s[id] {
+			out[id] = true
+		}
+	}
+	return out, nil
+}
+
 // filterActionsByCases keeps only the actions whose parent Case is in keep.
 func filterActionsByCases(actions []*model.Action, keep map[int64]bool) []*model.Action {
 	out := make([]*model.Action, 0, len(actions))
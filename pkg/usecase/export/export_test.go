@@ -2,9 +2,11 @@ package export_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -17,6 +19,7 @@ import (
 	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
 	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
 	"github.com/secmon-lab/hecatoncheires/pkg/service/bqexport"
+	"github.com/secmon-lab/hecatoncheires/pkg/service/fileexport"
 	"github.com/secmon-lab/hecatoncheires/pkg/usecase/export"
 	"github.com/secmon-lab/hecatoncheires/pkg/utils/safe"
 	"google.golang.org/api/googleapi"
@@ -196,8 +199,9 @@ func seedJobRun(t *testing.T, repo interfaces.Repository, wsID string, caseID in
 // seededWorkspace builds a WorkspaceEntry and seeds a memory repository with a
 // normal case, a private case, a draft case, one action per non-draft case, one
 // memo per non-draft case, one finished job run per case (the draft included, so
-// the tests can prove its runs are dropped rather than merely absent), one tag,
-// and one knowledge entry. It returns the normal, private and draft case ids.
+// the tests can prove its runs are dropped rather than merely absent), one SLA
+// breach per non-draft case, one tag, and one knowledge entry. It returns the
+// normal, private and draft case ids.
 func seededWorkspace(t *testing.T) (interfaces.Repository, *model.WorkspaceEntry, string, int64, int64, int64) {
 	t.Helper()
 	ctx := context.Background()
@@ -282,6 +286,16 @@ func seededWorkspace(t *testing.T) (interfaces.Repository, *model.WorkspaceEntry
 	seedJobRun(t, repo, wsID, private.ID, "triage", now)
 	seedJobRun(t, repo, wsID, draft.ID, "triage", now)
 
+	// One response SLA breach per non-draft case.
+	for _, cid := range []int64{normal.ID, private.ID} {
+		_, err = repo.SLABreach().Record(ctx, &model.SLABreach{
+			WorkspaceID: wsID, CaseID: cid, Kind: model.SLAKindResponse,
+			FieldID: "severity", Value: "high", Target: time.Hour,
+			DueAt: now.Add(-time.Hour), RecordedAt: now,
+		})
+		gt.NoError(t, err).Required()
+	}
+
 	tag, err := repo.Tag().Create(ctx, wsID, &model.Tag{
 		ID: model.NewTagID(), WorkspaceID: wsID, Name: "urgent",
 		CreatedAt: now, UpdatedAt: now,
@@ -425,6 +439,16 @@ func TestExporter_Run_full(t *testing.T) {
 	gt.Value(t, emptyRow["cache_creation_input_tokens"]).Equal(int64(0))
 	gt.Value(t, emptyRow["cache_read_input_tokens"]).Equal(int64(0))
 
+	// SLA breaches: one per non-draft case, private included.
+	breaches := sink.table("ds", "sla_breaches")
+	gt.Array(t, breaches.Rows).Length(2)
+	breachRow := findRow(breaches, "case_id", normalID)
+	gt.Value(t, breachRow).NotNil().Required()
+	gt.Value(t, breachRow["kind"]).Equal("response")
+	gt.Value(t, breachRow["value"]).Equal("high")
+	gt.Value(t, breachRow["target_seconds"]).Equal(int64(3600))
+	gt.Value(t, findRow(breaches, "case_id", privateID)).NotNil()
+
 	// Knowledge / Tag.
 	knowledge := sink.table("ds", "knowledge")
 	gt.Array(t, knowledge.Rows).Length(1)
@@ -480,11 +504,106 @@ func TestExporter_Run_excludePrivate(t *testing.T) {
 	gt.True(t, findRow(jobRunEvents, "case_id", privateID) == nil)
 	gt.True(t, findRow(jobRunEvents, "case_id", draftID) == nil)
 
+	breaches := sink.table("ds", "sla_breaches")
+	gt.Array(t, breaches.Rows).Length(1)
+	gt.Value(t, breaches.Rows[0]["case_id"]).Equal(normalID)
+
 	// Knowledge / Tag are workspace-level and always exported.
 	gt.Array(t, sink.table("ds", "knowledge").Rows).Length(1)
 	gt.Array(t, sink.table("ds", "tags").Rows).Length(1)
 }
 
+// readNDJSON decodes every line of an NDJSON export file.
+func readNDJSON(t *testing.T, path string) []map[string]any {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	gt.NoError(t, err).Required()
+	var rows []map[string]any
+	for _, line := range strings.Split(strings.TrimSuffix(string(data), "\n"), "\n") {
+		if line == "" {
+			continue
+		}
+		var row map[string]any
+		gt.NoError(t, json.Unmarshal([]byte(line), &row)).Required()
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func TestExporter_Run_ndjsonFiles(t *testing.T) {
+	ctx := context.Background()
+	repo, entry, _, normalID, _, _ := seededWorkspace(t)
+	dir := t.TempDir()
+	sink, err := fileexport.New(dir, fileexport.FormatNDJSON)
+	gt.NoError(t, err).Required()
+
+	err = export.New(repo, sink).Run(ctx, []export.Target{{Entry: entry, Namespace: "ds", ExcludePrivate: true}})
+	gt.NoError(t, err).Required()
+	gt.NoError(t, sink.Close()).Required()
+
+	// One file per table under the namespace directory, nothing else.
+	entries, err := os.ReadDir(filepath.Join(dir, "ds"))
+	gt.NoError(t, err).Required()
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	gt.Array(t, names).Equal([]string{
+		"actions.ndjson", "cases.ndjson", "job_run_events.ndjson", "job_run_logs.ndjson",
+		"job_runs.ndjson", "knowledge.ndjson", "memos.ndjson", "sla_breaches.ndjson",
+		"tags.ndjson",
+	})
+	file := func(table string) []map[string]any {
+		return readNDJSON(t, filepath.Join(dir, "ds", table+".ndjson"))
+	}
+
+	cases := file("cases")
+	gt.Array(t, cases).Length(1).Required()
+	gt.Value(t, cases[0]["id"]).Equal(float64(normalID))
+	gt.Value(t, cases[0]["title"]).Equal("Normal")
+	gt.Value(t, cases[0]["is_private"]).Equal(false)
+	gt.Value(t, cases[0]["assignee_ids"]).Equal([]any{"U9"})
+	gt.Value(t, cases[0]["field_severity"]).Equal("high")
+	gt.Value(t, cases[0]["field_labels"]).Equal([]any{"x", "y"})
+	gt.Value(t, cases[0]["field_when"]).Equal(dateFieldValue.Format(time.RFC3339Nano))
+
+	actions := file("actions")
+	gt.Array(t, actions).Length(1).Required()
+	gt.Value(t, actions[0]["case_id"]).Equal(float64(normalID))
+	gt.Value(t, actions[0]["status"]).Equal("TODO")
+	// Every declared column is present; an unset due date is an explicit null.
+	due, ok := actions[0]["due_date"]
+	gt.Bool(t, ok).True()
+	gt.Value(t, due).Nil()
+
+	memos := file("memos")
+	gt.Array(t, memos).Length(1).Required()
+	gt.Value(t, memos[0]["field_note"]).Equal("hello")
+
+	gt.Array(t, file("job_runs")).Length(1)
+	logs := file("job_run_logs")
+	gt.Array(t, logs).Length(1).Required()
+	gt.Value(t, logs[0]["cost_nano_usd"]).Equal(float64(2_550_000))
+	gt.Array(t, file("job_run_events")).Length(4)
+
+	// The private case's breach goes with the private case.
+	breaches := file("sla_breaches")
+	gt.Array(t, breaches).Length(1).Required()
+	gt.Value(t, breaches[0]["case_id"]).Equal(float64(normalID))
+	gt.Value(t, breaches[0]["kind"]).Equal("response")
+	gt.Value(t, breaches[0]["field_id"]).Equal("severity")
+	gt.Value(t, breaches[0]["value"]).Equal("high")
+	gt.Value(t, breaches[0]["target_seconds"]).Equal(float64(3600))
+
+	knowledge := file("knowledge")
+	gt.Array(t, knowledge).Length(1).Required()
+	gt.Array(t, knowledge[0]["tag_ids"].([]any)).Length(1)
+
+	tags := file("tags")
+	gt.Array(t, tags).Length(1).Required()
+	gt.Value(t, tags[0]["name"]).Equal("urgent")
+}
+
 func TestExporter_Run_collectsErrorsAndContinues(t *testing.T) {
 	ctx := context.Background()
 	repo, entry, _, _, _, _ := seededWorkspace(t)
@@ -500,6 +619,7 @@ func TestExporter_Run_collectsErrorsAndContinues(t *testing.T) {
 	gt.Value(t, sink.table("ds", "job_runs")).NotNil()
 	gt.Value(t, sink.table("ds", "job_run_logs")).NotNil()
 	gt.Value(t, sink.table("ds", "job_run_events")).NotNil()
+	gt.Value(t, sink.table("ds", "sla_breaches")).NotNil()
 	gt.Value(t, sink.table("ds", "knowledge")).NotNil()
 	gt.Value(t, sink.table("ds", "tags")).NotNil()
 }
@@ -529,6 +649,12 @@ func (failingJobRunEventRepository) List(context.Context, model.JobRunKey, strin
 	return nil, errors.New("injected job run event read failure")
 }
 
+func (failingJobRunEventRepository) ListAfter(context.Context, model.JobRunKey, string, int64) ([]*model.JobRunEvent, error) {
+	return nil, errors.New("injected job run event read failure")
+}
+
+func (failingJobRunEventRepository) MoveCase(context.Context, string, int64, int64) error { return nil }
+
 // TestExporter_Run_eventFailureKeepsJobRunTables pins the failure granularity of
 // the agent-run tables. The event timeline is the largest and most failure-prone
 // read the export makes; when it breaks, the summaries and logs — which were
@@ -640,7 +766,7 @@ func TestExporter_LiveBigQuery(t *testing.T) {
 	for _, name := range []string{
 		"cases", "actions", "memos",
 		"job_runs", "job_run_logs", "job_run_events",
-		"knowledge", "tags",
+		"sla_breaches", "knowledge", "tags",
 	} {
 		deleteTableOnCleanup(t, ctx, client, dataset, tbl(name))
 	}
@@ -661,6 +787,7 @@ func TestExporter_LiveBigQuery(t *testing.T) {
 	gt.Array(t, readAllRows(t, ctx, client, dataset, tbl("actions"))).Length(2)
 	gt.Array(t, readAllRows(t, ctx, client, dataset, tbl("memos"))).Length(2)
 	gt.Array(t, readAllRows(t, ctx, client, dataset, tbl("tags"))).Length(1)
+	gt.Array(t, readAllRows(t, ctx, client, dataset, tbl("sla_breaches"))).Length(2)
 
 	// Job runs: one summary + one log per non-draft case, with the token totals
 	// read back through the real BigQuery INT64 columns.
@@ -0,0 +1,470 @@
+package export
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+// watermarkOverlap is subtracted from the oldest watermark before reading
+// changes. Timestamps are set by the writing process's clock, so a row
+// committed just before runStart by a host whose clock runs slightly behind
+// could otherwise fall between two runs. Re-sending a few minutes of rows is
+// harmless: a merge replaces rows by key.
+const watermarkOverlap = 5 * time.Minute
+
+// Incremental table names. Knowledge and tags are not listed: they are always
+// refreshed in full.
+const (
+	tableCases        = "cases"
+	tableActions      = "actions"
+	tableMemos        = "memos"
+	tableJobRuns      = "job_runs"
+	tableJobRunLogs   = "job_run_logs"
+	tableJobRunEvents = "job_run_events"
+)
+
+// incrementalTables maps each table an incremental run merges into to the
+// columns that identify one of its rows.
+var incrementalTables = map[string][]string{
+	tableCases:        {"id"},
+	tableActions:      {"id"},
+	tableMemos:        {"id"},
+	tableJobRuns:      {"case_id", "job_id"},
+	tableJobRunLogs:   {"case_id", "job_id", "run_id"},
+	tableJobRunEvents: {"event_id"},
+}
+
+// schemaHash fingerprints a column list. A watermark recorded under a
+// different hash cannot be merged into: the table must be refreshed first.
+func schemaHash(cols []Column) string {
+	h := sha256.New()
+	for _, c := range cols {
+		_, _ = fmt.Fprintf(h, "%s %s %t %t\n", c.Name, c.Type, c.Repeated, c.Nullable)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// expectedColumns returns the column list each incremental table is written
+// with for this workspace right now.
+func expectedColumns(ctx context.Context, t Target) map[string][]Column {
+	return map[string][]Column{
+		tableCases:        buildCaseTable(ctx, t.Entry.FieldSchema, nil).Columns,
+		tableActions:      buildActionTable(nil).Columns,
+		tableMemos:        buildMemoTable(ctx, t.Entry.MemoConfig, nil).Columns,
+		tableJobRuns:      buildJobRunTable(nil).Columns,
+		tableJobRunLogs:   buildJobRunLogTable(nil).Columns,
+		tableJobRunEvents: buildJobRunEventTable(ctx, nil).Columns,
+	}
+}
+
+// putWatermark records that table (its unprefixed name) was brought up to
+// runStart. It is a no-op without a sink name, and for tables that are never
+// merged into.
+func (e *Exporter) putWatermark(ctx context.Context, t Target, runStart time.Time, table string, cols []Column) error {
+	if e.sinkName == "" {
+		return nil
+	}
+	if _, ok := incrementalTables[table]; !ok {
+		return nil
+	}
+	if err := e.repo.ExportState().PutWatermark(ctx, &model.ExportWatermark{
+		WorkspaceID: t.Entry.Workspace.ID,
+		Sink:        e.sinkName,
+		Namespace:   t.Namespace,
+		Table:       e.tableName(table),
+		HighWater:   runStart,
+		SchemaHash:  schemaHash(cols),
+		UpdatedAt:   e.now().UTC(),
+	}); err != nil {
+		return goerr.Wrap(err, "failed to record export watermark", goerr.V("table", table))
+	}
+	return nil
+}
+
+// incrementalSince returns the instant to read changes after, and false when
+// the workspace must be exported in full instead: some incremental table has
+// never been written to this sink, or was written with a different schema.
+// All tables share one read window (the oldest watermark) so that a case and
+// its children are always read over the same span.
+func (e *Exporter) incrementalSince(ctx context.Context, t Target) (time.Time, bool, error) {
+	var since time.Time
+	for table, cols := range expectedColumns(ctx, t) {
+		w, err := e.repo.ExportState().GetWatermark(ctx, t.Entry.Workspace.ID, e.sinkName, t.Namespace, e.tableName(table))
+		if err != nil {
+			return time.Time{}, false, goerr.Wrap(err, "failed to get export watermark", goerr.V("table", table))
+		}
+		if w == nil || w.SchemaHash != schemaHash(cols) {
+			return time.Time{}, false, nil
+		}
+		if since.IsZero() || w.HighWater.Before(since) {
+			since = w.HighWater
+		}
+	}
+	return since.Add(-watermarkOverlap), true, nil
+}
+
+// exportWorkspaceDelta merges what changed after since into the case-scoped
+// tables and refreshes the workspace-level ones.
+//
+// Nothing is listed in full: cases, actions, memos and job run summaries are
+// each read by their own UpdatedAt, a changed case's children are read by
+// case, and the cases an unchanged child belongs to are read by ID to learn
+// whether they are exported (see caseScope).
+func (e *Exporter) exportWorkspaceDelta(ctx context.Context, t Target, since, runStart time.Time) error {
+	var errs []error
+	if err := e.mergeCaseScoped(ctx, t, since, runStart); err != nil {
+		errs = append(errs, err)
+	}
+	errs = append(errs, e.exportWorkspaceTables(ctx, t, runStart)...)
+	return errors.Join(errs...)
+}
+
+// mergeCaseScoped collects and merges the deltas of the case-scoped tables.
+// As in the full path, each table is merged only when its own level was read
+// completely, and a table's watermark moves only after its merge succeeded,
+// so a failed table is simply re-read from the same point next run.
+func (e *Exporter) mergeCaseScoped(ctx context.Context, t Target, since, runStart time.Time) error {
+	wsID := t.Entry.Workspace.ID
+	var errs []error
+
+	// The bound is inclusive; the check below makes it strict like every
+	// other change read.
+	updated, err := e.repo.Case().List(ctx, wsID, interfaces.WithUpdatedBetween(since, time.Time{}))
+	if err != nil {
+		return goerr.Wrap(err, "failed to list changed cases")
+	}
+	tombstones, err := e.repo.ExportState().ListTombstones(ctx, wsID, since)
+	if err != nil {
+		return goerr.Wrap(err, "failed to list tombstones")
+	}
+
+	// removed holds the cases whose rows must leave the sink: deleted cases,
+	// and (when private cases are excluded) cases that changed and are now
+	// private. movedRuns holds the cases whose job run history was merged
+	// into another case. changed are the exported cases that changed.
+	var removed, movedRuns []int64
+	for _, ts := range tombstones {
+		if ts.Entity != model.TombstoneEntityCase && ts.Entity != model.TombstoneEntityCaseJobRuns {
+			continue
+		}
+		id, err := strconv.ParseInt(ts.EntityID, 10, 64)
+		if err != nil {
+			errs = append(errs, goerr.Wrap(err, "invalid case tombstone id", goerr.V("entity_id", ts.EntityID)))
+			continue
+		}
+		if ts.Entity == model.TombstoneEntityCaseJobRuns {
+			movedRuns = append(movedRuns, id)
+			continue
+		}
+		removed = append(removed, id)
+	}
+	scope := newCaseScope(e.repo.Case(), t)
+	var changed []*model.Case
+	for _, c := range updated {
+		if !c.UpdatedAt.After(since) {
+			continue
+		}
+		if !scope.exports(c) {
+			removed = append(removed, c.ID)
+			continue
+		}
+		changed = append(changed, c)
+		scope.known[c.ID] = true
+	}
+	for _, id := range removed {
+		scope.known[id] = false
+	}
+	changedIDs := caseIDSet(changed)
+
+	caseDeletes := make([]map[string]any, 0, len(removed))
+	childDeletes := make([]map[string]any, 0, len(removed))
+	for _, id := range removed {
+		caseDeletes = append(caseDeletes, map[string]any{"id": id})
+		childDeletes = append(childDeletes, map[string]any{"case_id": id})
+	}
+	// Job run and job run log rows are keyed by case, so a merged case's
+	// moved history would otherwise stay in the sink under its old case too.
+	runDeletes := slices.Clip(childDeletes)
+	for _, id := range movedRuns {
+		runDeletes = append(runDeletes, map[string]any{"case_id": id})
+	}
+
+	merge := func(table *Table, deletes []map[string]any) {
+		if err := e.mergeTable(ctx, t, runStart, table, deletes); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	merge(buildCaseTable(ctx, t.Entry.FieldSchema, changed), caseDeletes)
+
+	if actions, err := e.changedActions(ctx, wsID, since, scope, changed); err != nil {
+		errs = append(errs, err)
+	} else {
+		merge(buildActionTable(actions), childDeletes)
+	}
+
+	if memos, err := e.changedMemos(ctx, wsID, since, scope, changed); err != nil {
+		errs = append(errs, err)
+	} else {
+		merge(buildMemoTable(ctx, t.Entry.MemoConfig, memos), childDeletes)
+	}
+
+	history := e.changedJobRuns(ctx, wsID, since, scope, changed, changedIDs)
+	errs = append(errs, history.errs...)
+	if history.runsComplete {
+		merge(buildJobRunTable(history.runs), runDeletes)
+	}
+	if history.logsComplete {
+		merge(buildJobRunLogTable(history.logs), runDeletes)
+	}
+	if history.eventsComplete {
+		merge(buildJobRunEventTable(ctx, history.events), childDeletes)
+	}
+
+	if err := e.writeSLABreaches(ctx, t, runStart, scope); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// mergeTable hands one table's delta to the sink and moves its watermark. An
+// empty delta skips the sink but still moves the watermark: nothing changed
+// up to runStart.
+func (e *Exporter) mergeTable(ctx context.Context, t Target, runStart time.Time, table *Table, deletes []map[string]any) error {
+	base := table.Name
+	if len(table.Rows) > 0 || len(deletes) > 0 {
+		table.Name = e.tableName(base)
+		delta := &Delta{Table: table, Key: incrementalTables[base], Deletes: deletes}
+		// Run already refused a non-Merger sink in incremental mode.
+		if err := e.sink.(Merger).MergeTable(ctx, t.Namespace, delta); err != nil {
+			return goerr.Wrap(err, "failed to merge table", goerr.V("table", base))
+		}
+	}
+	return e.putWatermark(ctx, t, runStart, base, table.Columns)
+}
+
+// caseScope tells which cases an incremental run exports without listing
+// them: the cases that changed are known up front, and a case a changed
+// child belongs to is read by ID the first time it is asked about.
+type caseScope struct {
+	cases  interfaces.CaseRepository
+	target Target
+	// known maps each case ID looked at so far to whether it is exported.
+	known map[int64]bool
+}
+
+func newCaseScope(cases interfaces.CaseRepository, t Target) *caseScope {
+	return &caseScope{cases: cases, target: t, known: map[int64]bool{}}
+}
+
+// exports reports whether c is exported, by the same rule as the full path:
+// drafts never are, and private cases are not when the target excludes them.
+func (s *caseScope) exports(c *model.Case) bool {
+	return !c.IsDraft() && !(s.target.ExcludePrivate && c.IsPrivate)
+}
+
+// filter returns the exported subset of ids. A case that no longer exists
+// is not exported.
+func (s *caseScope) filter(ctx context.Context, ids []int64) (map[int64]bool, error) {
+	var unknown []int64
+	for _, id := range ids {
+		if _, ok := s.known[id]; !ok && !slices.Contains(unknown, id) {
+			unknown = append(unknown, id)
+		}
+	}
+	if len(unknown) > 0 {
+		found, err := s.cases.GetByIDs(ctx, s.target.Entry.Workspace.ID, unknown)
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to get cases of changed records")
+		}
+		for _, id := range unknown {
+			c, ok := found[id]
+			s.known[id] = ok && s.exports(c)
+		}
+	}
+
+	out := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		if s.known[id] {
+			out[id] = true
+		}
+	}
+	return out, nil
+}
+
+// changedActions returns the actions of exported cases that changed after
+// since, plus every action of a case that itself changed (a case can flip back
+// from private, bringing along children that did not change themselves).
+func (e *Exporter) changedActions(ctx context.Context, wsID string, since time.Time, scope *caseScope, changed []*model.Case) ([]*model.Action, error) {
+	updated, err := e.repo.Action().List(ctx, wsID, interfaces.ActionListOptions{
+		ArchiveScope: interfaces.ActionArchiveScopeAll,
+		UpdatedAfter: &since,
+	})
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list changed actions")
+	}
+	caseIDs := make([]int64, 0, len(updated))
+	for _, a := range updated {
+		caseIDs = append(caseIDs, a.CaseID)
+	}
+	keep, err := scope.filter(ctx, caseIDs)
+	if err != nil {
+		return nil, err
+	}
+	actions := filterActionsByCases(updated, keep)
+
+	if len(changed) > 0 {
+		ids := make([]int64, 0, len(changed))
+		for _, c := range changed {
+			ids = append(ids, c.ID)
+		}
+		byCase, err := e.repo.Action().GetByCases(ctx, wsID, ids, interfaces.ActionListOptions{
+			ArchiveScope: interfaces.ActionArchiveScopeAll,
+		})
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to list actions of changed cases")
+		}
+		seen := make(map[int64]bool, len(actions))
+		for _, a := range actions {
+			seen[a.ID] = true
+		}
+		for _, c := range changed {
+			for _, a := range byCase[c.ID] {
+				if !seen[a.ID] {
+					seen[a.ID] = true
+					actions = append(actions, a)
+				}
+			}
+		}
+	}
+	return actions, nil
+}
+
+// changedMemos returns the memos of exported cases that changed after since,
+// plus every memo of a case that itself changed.
+func (e *Exporter) changedMemos(ctx context.Context, wsID string, since time.Time, scope *caseScope, changed []*model.Case) ([]*model.Memo, error) {
+	updated, err := e.repo.Memo().ListUpdatedAfter(ctx, wsID, since)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list changed memos")
+	}
+	caseIDs := make([]int64, 0, len(updated))
+	for _, m := range updated {
+		caseIDs = append(caseIDs, m.CaseID)
+	}
+	keep, err := scope.filter(ctx, caseIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var memos []*model.Memo
+	seen := make(map[model.MemoID]bool, len(updated))
+	for _, m := range updated {
+		if keep[m.CaseID] {
+			seen[m.ID] = true
+			memos = append(memos, m)
+		}
+	}
+	for _, c := range changed {
+		ms, err := e.repo.Memo().List(ctx, wsID, c.ID, interfaces.MemoListOptions{
+			ArchiveScope: interfaces.MemoArchiveScopeAll,
+		})
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to list memos", goerr.V("case_id", c.ID))
+		}
+		for _, m := range ms {
+			if !seen[m.ID] {
+				seen[m.ID] = true
+				memos = append(memos, m)
+			}
+		}
+	}
+	return memos, nil
+}
+
+// changedJobRuns narrows collectJobRuns to what changed after since: the
+// summaries of exported cases written after since, and all history of a case
+// that itself changed. Run logs carry no UpdatedAt, so a log of a selected
+// summary counts as changed when it started or ended after since or is still
+// open. Events are append-only within a run, so the events of every selected
+// log are re-sent in full.
+func (e *Exporter) changedJobRuns(ctx context.Context, wsID string, since time.Time, scope *caseScope, changed []*model.Case, changedIDs map[int64]bool) jobRunHistory {
+	var h jobRunHistory
+
+	updated, err := e.repo.JobRun().ListUpdatedAfter(ctx, wsID, since)
+	if err != nil {
+		h.errs = append(h.errs, goerr.Wrap(err, "failed to list changed job runs"))
+		return h
+	}
+	caseIDs := make([]int64, 0, len(updated))
+	for _, r := range updated {
+		caseIDs = append(caseIDs, r.CaseID)
+	}
+	keep, err := scope.filter(ctx, caseIDs)
+	if err != nil {
+		h.errs = append(h.errs, err)
+		return h
+	}
+	seen := make(map[model.JobRunKey]bool, len(updated))
+	for _, r := range updated {
+		if keep[r.CaseID] {
+			seen[r.Key()] = true
+			h.runs = append(h.runs, r)
+		}
+	}
+	for _, c := range changed {
+		rs, err := e.repo.JobRun().ListByCase(ctx, wsID, c.ID)
+		if err != nil {
+			h.errs = append(h.errs, goerr.Wrap(err, "failed to list job runs",
+				goerr.V("case_id", c.ID)))
+			return h
+		}
+		for _, r := range rs {
+			if !seen[r.Key()] {
+				seen[r.Key()] = true
+				h.runs = append(h.runs, r)
+			}
+		}
+	}
+	h.runsComplete = true
+
+	for _, r := range h.runs {
+		ls, err := e.repo.JobRunLog().List(ctx, r.Key(), 0)
+		if err != nil {
+			h.errs = append(h.errs, goerr.Wrap(err, "failed to list job run logs",
+				goerr.V("case_id", r.CaseID), goerr.V("job_id", r.JobID)))
+			return h
+		}
+		for _, l := range ls {
+			if changedIDs[l.CaseID] || l.StartedAt.After(since) || l.EndedAt.After(since) || l.EndedAt.IsZero() {
+				h.logs = append(h.logs, l)
+			}
+		}
+	}
+	h.logsComplete = true
+
+	for _, l := range h.logs {
+		key := model.JobRunKey{WorkspaceID: l.WorkspaceID, CaseID: l.CaseID, JobID: l.JobID}
+		evs, err := e.repo.JobRunEvent().List(ctx, key, l.RunID)
+		if err != nil {
+			h.errs = append(h.errs, goerr.Wrap(err, "failed to list job run events",
+				goerr.V("case_id", l.CaseID), goerr.V("job_id", l.JobID),
+				goerr.V("run_id", l.RunID)))
+			return h
+		}
+		h.events = append(h.events, evs...)
+	}
+	h.eventsComplete = true
+
+	return h
+}
@@ -0,0 +1,388 @@
+package export_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	domainconfig "github.com/secmon-lab/hecatoncheires/pkg/domain/model/config"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase/export"
+)
+
+// mergingSink is a fakeSink that also accepts deltas, capturing them keyed by
+// "namespace.tableName".
+type mergingSink struct {
+	*fakeSink
+	deltas map[string]*export.Delta
+}
+
+func newMergingSink() *mergingSink {
+	return &mergingSink{fakeSink: newFakeSink(), deltas: map[string]*export.Delta{}}
+}
+
+func (m *mergingSink) MergeTable(_ context.Context, namespace string, d *export.Delta) error {
+	m.deltas[namespace+"."+d.Table.Name] = d
+	return nil
+}
+
+func (m *mergingSink) delta(namespace, name string) *export.Delta {
+	return m.deltas[namespace+"."+name]
+}
+
+// reset forgets every captured write and merge so the next run's calls can be
+// asserted on their own.
+func (m *mergingSink) reset() {
+	m.tables = map[string]*export.Table{}
+	m.deltas = map[string]*export.Delta{}
+}
+
+func touchCase(t *testing.T, repo interfaces.Repository, wsID string, id int64, at time.Time, mutate func(*model.Case)) {
+	t.Helper()
+	ctx := context.Background()
+	c, err := repo.Case().Get(ctx, wsID, id)
+	gt.NoError(t, err).Required()
+	mutate(c)
+	c.UpdatedAt = at
+	_, err = repo.Case().Update(ctx, wsID, c)
+	gt.NoError(t, err).Required()
+}
+
+func TestExporter_Run_incremental(t *testing.T) {
+	ctx := context.Background()
+	repo, entry, wsID, normalID, privateID, _ := seededWorkspace(t)
+	sink := newMergingSink()
+
+	first := time.Now().Add(time.Hour).UTC()
+	second := first.Add(time.Hour)
+	third := second.Add(time.Hour)
+	now := first
+	exporter := export.New(repo, sink,
+		export.WithMode(export.ModeIncremental),
+		export.WithSinkName("fake"),
+		export.WithClock(func() time.Time { return now }),
+	)
+	targets := []export.Target{{Entry: entry, Namespace: "ds"}}
+
+	t.Run("first run has no watermark and refreshes everything", func(t *testing.T) {
+		gt.NoError(t, exporter.Run(ctx, targets)).Required()
+		gt.Map(t, sink.deltas).Length(0)
+		gt.Array(t, sink.table("ds", "cases").Rows).Length(2)
+
+		w, err := repo.ExportState().GetWatermark(ctx, wsID, "fake", "ds", "cases")
+		gt.NoError(t, err).Required()
+		gt.Value(t, w).NotNil().Required()
+		gt.Value(t, w.HighWater).Equal(first)
+
+		// Knowledge / tags are never merged, so they carry no watermark.
+		w, err = repo.ExportState().GetWatermark(ctx, wsID, "fake", "ds", "tags")
+		gt.NoError(t, err).Required()
+		gt.True(t, w == nil)
+	})
+
+	t.Run("second run merges only changed cases and deletes tombstoned ones", func(t *testing.T) {
+		sink.reset()
+		now = second
+		touchCase(t, repo, wsID, normalID, first.Add(time.Minute), func(c *model.Case) { c.Title = "Renamed" })
+		gt.NoError(t, repo.ExportState().PutTombstone(ctx, &model.Tombstone{
+			WorkspaceID: wsID, Entity: model.TombstoneEntityCase, EntityID: "999",
+			DeletedAt: first.Add(time.Minute),
+		})).Required()
+
+		gt.NoError(t, exporter.Run(ctx, targets)).Required()
+
+		cases := sink.delta("ds", "cases")
+		gt.Value(t, cases).NotNil().Required()
+		gt.Array(t, cases.Table.Rows).Length(1)
+		gt.Value(t, cases.Table.Rows[0]["title"]).Equal("Renamed")
+		gt.Value(t, cases.Key).Equal([]string{"id"})
+		gt.Value(t, cases.Deletes).Equal([]map[string]any{{"id": int64(999)}})
+
+		// The changed case's children are re-sent in full; the untouched
+		// private case's are not.
+		actions := sink.delta("ds", "actions")
+		gt.Value(t, actions).NotNil().Required()
+		gt.Array(t, actions.Table.Rows).Length(1)
+		gt.Value(t, actions.Table.Rows[0]["case_id"]).Equal(normalID)
+		gt.Value(t, actions.Deletes).Equal([]map[string]any{{"case_id": int64(999)}})
+
+		memos := sink.delta("ds", "memos")
+		gt.Value(t, memos).NotNil().Required()
+		gt.Array(t, memos.Table.Rows).Length(1)
+		gt.Value(t, memos.Table.Rows[0]["case_id"]).Equal(normalID)
+
+		jobRuns := sink.delta("ds", "job_runs")
+		gt.Value(t, jobRuns).NotNil().Required()
+		gt.Array(t, jobRuns.Table.Rows).Length(1)
+		gt.Value(t, jobRuns.Key).Equal([]string{"case_id", "job_id"})
+		gt.True(t, findRow(jobRuns.Table, "case_id", privateID) == nil)
+
+		// Workspace-level tables are still rewritten in full.
+		gt.Array(t, sink.table("ds", "tags").Rows).Length(1)
+		gt.True(t, sink.table("ds", "cases") == nil)
+
+		w, err := repo.ExportState().GetWatermark(ctx, wsID, "fake", "ds", "actions")
+		gt.NoError(t, err).Required()
+		gt.Value(t, w.HighWater).Equal(second)
+	})
+
+	t.Run("a run with nothing changed merges nothing", func(t *testing.T) {
+		sink.reset()
+		now = third
+		gt.NoError(t, exporter.Run(ctx, targets)).Required()
+		gt.Map(t, sink.deltas).Length(0)
+		gt.True(t, sink.table("ds", "cases") == nil)
+
+		// Empty deltas still move the watermark.
+		w, err := repo.ExportState().GetWatermark(ctx, wsID, "fake", "ds", "cases")
+		gt.NoError(t, err).Required()
+		gt.Value(t, w.HighWater).Equal(third)
+	})
+}
+
+func TestExporter_Run_incrementalRemovesCasesTurnedPrivate(t *testing.T) {
+	ctx := context.Background()
+	repo, entry, wsID, normalID, _, _ := seededWorkspace(t)
+	sink := newMergingSink()
+
+	first := time.Now().Add(time.Hour).UTC()
+	now := first
+	exporter := export.New(repo, sink,
+		export.WithMode(export.ModeIncremental),
+		export.WithSinkName("fake"),
+		export.WithClock(func() time.Time { return now }),
+	)
+	targets := []export.Target{{Entry: entry, Namespace: "ds", ExcludePrivate: true}}
+	gt.NoError(t, exporter.Run(ctx, targets)).Required()
+
+	sink.reset()
+	now = first.Add(time.Hour)
+	touchCase(t, repo, wsID, normalID, first.Add(time.Minute), func(c *model.Case) { c.IsPrivate = true })
+	gt.NoError(t, exporter.Run(ctx, targets)).Required()
+
+	cases := sink.delta("ds", "cases")
+	gt.Value(t, cases).NotNil().Required()
+	gt.Array(t, cases.Table.Rows).Length(0)
+	gt.Value(t, cases.Deletes).Equal([]map[string]any{{"id": normalID}})
+
+	for _, name := range []string{"actions", "memos", "job_runs", "job_run_logs", "job_run_events"} {
+		d := sink.delta("ds", name)
+		gt.Value(t, d).NotNil().Required()
+		gt.Array(t, d.Table.Rows).Length(0)
+		gt.Value(t, d.Deletes).Equal([]map[string]any{{"case_id": normalID}})
+	}
+
+	// SLA breaches are rewritten in full, without the case turned private.
+	gt.Array(t, sink.table("ds", "sla_breaches").Rows).Length(0)
+}
+
+func TestExporter_Run_incrementalRemovesJobRunsMovedByMerge(t *testing.T) {
+	ctx := context.Background()
+	repo, entry, wsID, _, _, _ := seededWorkspace(t)
+	sink := newMergingSink()
+
+	first := time.Now().Add(time.Hour).UTC()
+	now := first
+	exporter := export.New(repo, sink,
+		export.WithMode(export.ModeIncremental),
+		export.WithSinkName("fake"),
+		export.WithClock(func() time.Time { return now }),
+	)
+	targets := []export.Target{{Entry: entry, Namespace: "ds"}}
+	gt.NoError(t, exporter.Run(ctx, targets)).Required()
+
+	sink.reset()
+	now = first.Add(time.Hour)
+	gt.NoError(t, repo.ExportState().PutTombstone(ctx, &model.Tombstone{
+		WorkspaceID: wsID, Entity: model.TombstoneEntityCaseJobRuns, EntityID: "777",
+		DeletedAt: first.Add(time.Minute),
+	})).Required()
+	gt.NoError(t, exporter.Run(ctx, targets)).Required()
+
+	// Only the tables keyed by case lose the merged case's rows; the case
+	// itself and the tables keyed by their own ID are left alone.
+	for _, name := range []string{"job_runs", "job_run_logs"} {
+		d := sink.delta("ds", name)
+		gt.Value(t, d).NotNil().Required()
+		gt.Value(t, d.Deletes).Equal([]map[string]any{{"case_id": int64(777)}})
+	}
+	for _, name := range []string{"cases", "actions", "memos", "job_run_events"} {
+		gt.True(t, sink.delta("ds", name) == nil)
+	}
+}
+
+func TestExporter_Run_incrementalSchemaChangeRefreshesInFull(t *testing.T) {
+	ctx := context.Background()
+	repo, entry, _, _, _, _ := seededWorkspace(t)
+	sink := newMergingSink()
+
+	exporter := export.New(repo, sink,
+		export.WithMode(export.ModeIncremental),
+		export.WithSinkName("fake"),
+	)
+	targets := []export.Target{{Entry: entry, Namespace: "ds"}}
+	gt.NoError(t, exporter.Run(ctx, targets)).Required()
+
+	// A new custom field changes the cases table's columns, so its watermark
+	// no longer matches and the whole workspace is refreshed.
+	entry.FieldSchema.Fields = append(entry.FieldSchema.Fields,
+		domainconfig.FieldDefinition{ID: "owner", Type: types.FieldTypeText})
+	sink.reset()
+	gt.NoError(t, exporter.Run(ctx, targets)).Required()
+
+	gt.Map(t, sink.deltas).Length(0)
+	gt.Array(t, sink.table("ds", "cases").Rows).Length(2)
+	gt.Array(t, sink.table("ds", "actions").Rows).Length(2)
+}
+
+func TestExporter_Run_incrementalRequiresMerger(t *testing.T) {
+	ctx := context.Background()
+	repo, entry, _, _, _, _ := seededWorkspace(t)
+	targets := []export.Target{{Entry: entry, Namespace: "ds"}}
+
+	err := export.New(repo, newFakeSink(),
+		export.WithMode(export.ModeIncremental),
+		export.WithSinkName("fake"),
+	).Run(ctx, targets)
+	gt.Error(t, err)
+
+	err = export.New(repo, newMergingSink(),
+		export.WithMode(export.ModeIncremental),
+	).Run(ctx, targets)
+	gt.Error(t, err)
+}
+
+// readCountingRepository counts the reads an incremental run makes through
+// the repositories it scopes by case, so a test can tell a read of what
+// changed from a listing of everything.
+type readCountingRepository struct {
+	interfaces.Repository
+	reads *reads
+}
+
+type reads struct {
+	listedCases     int
+	lookedUpCases   []int64
+	memoLists       int
+	jobRunCaseLists int
+}
+
+func (r readCountingRepository) Case() interfaces.CaseRepository {
+	return countingCaseRepository{CaseRepository: r.Repository.Case(), reads: r.reads}
+}
+
+func (r readCountingRepository) Memo() interfaces.MemoRepository {
+	return countingMemoRepository{MemoRepository: r.Repository.Memo(), reads: r.reads}
+}
+
+func (r readCountingRepository) JobRun() interfaces.JobRunRepository {
+	return countingJobRunRepository{JobRunRepository: r.Repository.JobRun(), reads: r.reads}
+}
+
+type countingCaseRepository struct {
+	interfaces.CaseRepository
+	reads *reads
+}
+
+func (r countingCaseRepository) List(ctx context.Context, workspaceID string, opts ...interfaces.ListCaseOption) ([]*model.Case, error) {
+	cases, err := r.CaseRepository.List(ctx, workspaceID, opts...)
+	r.reads.listedCases += len(cases)
+	return cases, err
+}
+
+func (r countingCaseRepository) GetByIDs(ctx context.Context, workspaceID string, ids []int64) (map[int64]*model.Case, error) {
+	r.reads.lookedUpCases = append(r.reads.lookedUpCases, ids...)
+	return r.CaseRepository.GetByIDs(ctx, workspaceID, ids)
+}
+
+type countingMemoRepository struct {
+	interfaces.MemoRepository
+	reads *reads
+}
+
+func (r countingMemoRepository) List(ctx context.Context, workspaceID string, caseID int64, opts interfaces.MemoListOptions) ([]*model.Memo, error) {
+	r.reads.memoLists++
+	return r.MemoRepository.List(ctx, workspaceID, caseID, opts)
+}
+
+type countingJobRunRepository struct {
+	interfaces.JobRunRepository
+	reads *reads
+}
+
+func (r countingJobRunRepository) ListByCase(ctx context.Context, workspaceID string, caseID int64) ([]*model.JobRun, error) {
+	r.reads.jobRunCaseLists++
+	return r.JobRunRepository.ListByCase(ctx, workspaceID, caseID)
+}
+
+// TestExporter_Run_incrementalReadsOnlyWhatChanged pins the point of the
+// incremental mode on the read side: a run reads the cases, memos and job runs
+// that changed, not every case's, however many cases the workspace holds.
+func TestExporter_Run_incrementalReadsOnlyWhatChanged(t *testing.T) {
+	ctx := context.Background()
+	repo, entry, wsID, normalID, privateID, _ := seededWorkspace(t)
+
+	// Quiet cases, each with a memo, that a full listing would have to read.
+	created := time.Now().UTC()
+	var quiet []int64
+	for range 5 {
+		c, err := repo.Case().Create(ctx, wsID, &model.Case{
+			Title: "Quiet", Status: types.CaseStatusOpen, ReporterID: "U1",
+			CreatedAt: created, UpdatedAt: created,
+		})
+		gt.NoError(t, err).Required()
+		quiet = append(quiet, c.ID)
+		_, err = repo.Memo().Create(ctx, wsID, &model.Memo{
+			ID: model.NewMemoID(), WorkspaceID: wsID, CaseID: c.ID, Title: "quiet memo",
+			CreatedAt: created, UpdatedAt: created,
+		})
+		gt.NoError(t, err).Required()
+	}
+
+	counted := &reads{}
+	sink := newMergingSink()
+	first := time.Now().Add(time.Hour).UTC()
+	now := first
+	exporter := export.New(readCountingRepository{Repository: repo, reads: counted}, sink,
+		export.WithMode(export.ModeIncremental),
+		export.WithSinkName("fake"),
+		export.WithClock(func() time.Time { return now }),
+	)
+	targets := []export.Target{{Entry: entry, Namespace: "ds"}}
+	gt.NoError(t, exporter.Run(ctx, targets)).Required()
+
+	sink.reset()
+	*counted = reads{}
+	now = first.Add(time.Hour)
+	touchCase(t, repo, wsID, normalID, first.Add(time.Minute), func(c *model.Case) { c.Title = "Renamed" })
+	memos, err := repo.Memo().List(ctx, wsID, quiet[0], interfaces.MemoListOptions{})
+	gt.NoError(t, err).Required()
+	gt.Array(t, memos).Length(1).Required()
+	edited := memos[0]
+	edited.Title = "edited"
+	edited.UpdatedAt = first.Add(time.Minute)
+	_, err = repo.Memo().Update(ctx, wsID, edited)
+	gt.NoError(t, err).Required()
+
+	gt.NoError(t, exporter.Run(ctx, targets)).Required()
+
+	// Only the changed case is listed, and only its memos and job runs are
+	// read by case.
+	gt.Value(t, counted.listedCases).Equal(1)
+	gt.Value(t, counted.memoLists).Equal(1)
+	gt.Value(t, counted.jobRunCaseLists).Equal(1)
+	// A case is looked up only to scope a record of it that changed (the
+	// edited memo) or one the SLA table is rewritten with (the private
+	// case's breach); the changed case itself is already known.
+	gt.Array(t, counted.lookedUpCases).Length(2)
+	gt.Array(t, counted.lookedUpCases).Has(quiet[0])
+	gt.Array(t, counted.lookedUpCases).Has(privateID)
+
+	d := sink.delta("ds", "memos")
+	gt.Value(t, d).NotNil().Required()
+	gt.Array(t, d.Table.Rows).Length(2)
+	gt.Value(t, findRow(d.Table, "title", "edited")).NotNil()
+}
@@ -38,6 +38,7 @@ func buildCaseTable(ctx context.Context, schema *config.FieldSchema, cases []*mo
 			"is_private":       c.IsPrivate,
 			"is_test":          c.IsTest,
 			"request_key":      c.RequestKey,
+			"merged_into":      mergedInto(c),
 			"created_at":       c.CreatedAt,
 			"updated_at":       c.UpdatedAt,
 		}
@@ -58,6 +59,7 @@ func buildActionTable(actions []*model.Action) *Table {
 		{Name: "slack_message_ts", Type: TypeString, Nullable: true},
 		{Name: "status", Type: TypeString, Nullable: true},
 		{Name: "due_date", Type: TypeTimestamp, Nullable: true},
+		{Name: "blocked_by", Type: TypeInt, Repeated: true},
 		{Name: "archived_at", Type: TypeTimestamp, Nullable: true},
 		{Name: "created_at", Type: TypeTimestamp, Nullable: true},
 		{Name: "updated_at", Type: TypeTimestamp, Nullable: true},
@@ -73,6 +75,7 @@ func buildActionTable(actions []*model.Action) *Table {
 			"slack_message_ts": a.SlackMessageTS,
 			"status":           string(a.Status),
 			"due_date":         a.DueDate,
+			"blocked_by":       append([]int64{}, a.BlockedBy...),
 			"archived_at":      a.ArchivedAt,
 			"created_at":       a.CreatedAt,
 			"updated_at":       a.UpdatedAt,
@@ -421,6 +424,35 @@ func buildTagTable(tags []*model.Tag) *Table {
 	return &Table{Name: "tags", Columns: cols, Rows: rows}
 }
 
+// buildSLABreachTable builds the "sla_breaches" table: one row per SLA target
+// a case missed, joinable to cases on case_id.
+func buildSLABreachTable(breaches []*model.SLABreach) *Table {
+	cols := []Column{
+		{Name: "workspace_id", Type: TypeString},
+		{Name: "case_id", Type: TypeInt},
+		{Name: "kind", Type: TypeString},
+		{Name: "field_id", Type: TypeString, Nullable: true},
+		{Name: "value", Type: TypeString, Nullable: true},
+		{Name: "target_seconds", Type: TypeInt, Nullable: true},
+		{Name: "due_at", Type: TypeTimestamp, Nullable: true},
+		{Name: "recorded_at", Type: TypeTimestamp, Nullable: true},
+	}
+	rows := make([]map[string]any, 0, len(breaches))
+	for _, b := range breaches {
+		rows = append(rows, map[string]any{
+			"workspace_id":   b.WorkspaceID,
+			"case_id":        b.CaseID,
+			"kind":           string(b.Kind),
+			"field_id":       b.FieldID,
+			"value":          b.Value,
+			"target_seconds": int64(b.Target.Seconds()),
+			"due_at":         b.DueAt,
+			"recorded_at":    b.RecordedAt,
+		})
+	}
+	return &Table{Name: "sla_breaches", Columns: cols, Rows: rows}
+}
+
 // fixedCaseColumns returns the non-custom columns of the cases table. id is the
 // only REQUIRED column; the rest are nullable so a partially-populated case
 // never fails the write.
@@ -439,11 +471,21 @@ func fixedCaseColumns() []Column {
 		{Name: "is_private", Type: TypeBool, Nullable: true},
 		{Name: "is_test", Type: TypeBool, Nullable: true},
 		{Name: "request_key", Type: TypeString, Nullable: true},
+		{Name: "merged_into", Type: TypeInt, Nullable: true},
 		{Name: "created_at", Type: TypeTimestamp, Nullable: true},
 		{Name: "updated_at", Type: TypeTimestamp, Nullable: true},
 	}
 }
 
+// mergedInto is the merged_into cell of a case row: the surviving Case's ID,
+// or NULL for a case that was never merged.
+func mergedInto(c *model.Case) any {
+	if c.MergedInto == 0 {
+		return nil
+	}
+	return c.MergedInto
+}
+
 // fixedMemoColumns returns the non-custom columns of the memos table.
 func fixedMemoColumns() []Column {
 	return []Column{
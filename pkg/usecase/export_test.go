@@ -288,3 +288,27 @@ type GitHubReview = githubsvc.Review
 type SlackMessage = slackmodel.Message
 type SlackChannel = model.SlackChannel
 type ConversationMessage = slack.ConversationMessage
+
+// NewToolApprovalsForTest exposes the kernel's approval gate implementation.
+var NewToolApprovalsForTest = newToolApprovals
+
+// ParseToolApprovalValueForTest exposes the approval button value decoder.
+var ParseToolApprovalValueForTest = parseToolApprovalValue
+
+// SweepAtForTest runs a webhook sweep as of now, so a test can step past the
+// retry backoff without sleeping.
+func (uc *WebhookUseCase) SweepAtForTest(ctx context.Context, now time.Time) error {
+	return uc.sweep(ctx, now)
+}
+
+// SweepAtForTest runs a reminder sweep as of now, so a test can step through
+// the reminder stages without sleeping.
+func (uc *ActionReminderUseCase) SweepAtForTest(ctx context.Context, now time.Time) error {
+	return uc.sweep(ctx, now)
+}
+
+// SweepAtForTest runs an SLA sweep as of now, so a test can step past a
+// target without sleeping.
+func (uc *SLAUseCase) SweepAtForTest(ctx context.Context, now time.Time) error {
+	return uc.sweep(ctx, now)
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrUnknownIdentityProvider is returned when a login or callback names an
+// identity provider that is not configured.
+var ErrUnknownIdentityProvider = errors.New("unknown identity provider")
+
+// ErrIdentityNotAllowed is returned when an identity provider authenticated a
+// user this deployment does not admit (e.g. outside the allowed domains).
+var ErrIdentityNotAllowed = errors.New("identity is not allowed to sign in")
+
+// IdentityProvider is one way to sign in to the web UI: an OAuth 2.0 /
+// OpenID Connect authorization-code flow against a single issuer. Slack is
+// one; any OIDC issuer (Google, Okta, Entra ID) is another.
+type IdentityProvider interface {
+	// Name identifies the provider in URLs (/api/auth/login?provider=<name>,
+	// /api/auth/callback/<name>) and in the "<name>:<subject>" sub of users
+	// without a Slack account. Lowercase letters, digits and "-".
+	Name() string
+	// Label is the human-readable name the login page shows on its button.
+	Label() string
+	// AuthURL returns the authorization endpoint URL the browser is sent to.
+	AuthURL(state string) string
+	// Authenticate exchanges an authorization code for a verified identity.
+	Authenticate(ctx context.Context, code string) (*Identity, error)
+}
+
+// Identity is a user an IdentityProvider has authenticated.
+type Identity struct {
+	// Subject is the provider's stable identifier for the user.
+	Subject string
+	Email   string
+	Name    string
+	// EmailVerified reports whether the provider vouches that Email belongs
+	// to the user. Only a verified email is mapped to a Slack account.
+	EmailVerified bool
+	// SlackUserID is set by a provider that authenticates Slack accounts
+	// itself, so no email mapping is needed.
+	SlackUserID string
+}
+
+// IdentityProviderInfo describes a configured provider to the login page.
+type IdentityProviderInfo struct {
+	Name  string `json:"name"`
+	Label string `json:"label"`
+}
@@ -498,6 +498,7 @@ func (d *DurableRuntime) onSimpleFinish(ctx context.Context, pid agentkit.Proces
 	runtrace.FinishRun(ctx, r.deps.Repo, key, sc.JobRunID, d.processUsage(sc, proc), runErr, r.clock())
 
 	j, c := d.reloadRunContext(ctx, sc)
+	publishRun(ctx, r.deps.RunPublisher, r.deps.Repo, key, c, runEndChange(key, sc.JobRunID, runErr))
 	d.postCompletionMarker(ctx, sc, j, runErr)
 	if runErr == nil {
 		d.reflect(ctx, proc, sc, j, c)
@@ -540,6 +541,7 @@ func (d *DurableRuntime) onPlanexecFinish(ctx context.Context, pid agentkit.Proc
 	runtrace.FinishRun(ctx, r.deps.Repo, key, sc.JobRunID, usage, runErr, r.clock())
 
 	j, c := d.reloadRunContext(ctx, sc)
+	publishRun(ctx, r.deps.RunPublisher, r.deps.Repo, key, c, runEndChange(key, sc.JobRunID, runErr))
 	d.postCompletionMarker(ctx, sc, j, runErr)
 	if runErr == nil {
 		d.reflect(ctx, proc, sc, j, c)
@@ -25,7 +25,14 @@ type Event struct {
 	ActorUserID string
 
 	// Domain == case
+	//
+	// CaseLifecycle names the transition; the remaining fields carry its
+	// detail for the lifecycles that have one (see model.CaseChange).
 	CaseLifecycle model.CaseLifecycle
+	FieldID       string
+	From          []string
+	To            []string
+	ActionID      int64
 
 	// Domain == scheduled
 	//
@@ -67,6 +74,18 @@ func (e Event) validate() error {
 	return nil
 }
 
+// caseChange reassembles the case-domain detail of the event into the shape
+// the Job filters match against.
+func (e Event) caseChange() model.CaseChange {
+	return model.CaseChange{
+		Lifecycle: e.CaseLifecycle,
+		FieldID:   e.FieldID,
+		From:      e.From,
+		To:        e.To,
+		ActionID:  e.ActionID,
+	}
+}
+
 // EventPublisher is the dispatch entry point exposed to upstream callers
 // (CaseUseCase, ScheduledScanner). Publish is non-blocking; the actual
 // Job runs in a background goroutine via async.Dispatch so the calling
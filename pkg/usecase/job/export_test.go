@@ -165,3 +165,9 @@ const (
 // CASE thread, not the run's operational log thread — is easy to get wrong and
 // invisible from the outside, so it is pinned by a test.
 var CaseTaskContextForTest = caseTaskContext
+
+// MatchJobsForTest exposes UseCase.matchJobs so the case-change filters can be
+// asserted without dispatching a run.
+func MatchJobsForTest(uc *UseCase, ev Event) ([]*model.Job, error) {
+	return uc.matchJobs(ev)
+}
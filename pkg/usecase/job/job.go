@@ -115,6 +115,14 @@ func (uc *UseCase) Publish(ctx context.Context, ev Event) {
 // to Publish. Kept here so usecase/case.go does not need to know the
 // Event struct shape.
 func (uc *UseCase) PublishCaseLifecycle(ctx context.Context, workspaceID string, c *model.Case, lifecycle model.CaseLifecycle, actorUserID string) {
+	uc.PublishCaseChange(ctx, workspaceID, c, model.CaseLifecycleChange(lifecycle), actorUserID)
+}
+
+// PublishCaseChange is the detailed sibling of PublishCaseLifecycle, used for
+// the lifecycles that carry a field / status / assignee / action payload.
+// CaseUseCase and ActionUseCase both reach it through their narrow publisher
+// interfaces.
+func (uc *UseCase) PublishCaseChange(ctx context.Context, workspaceID string, c *model.Case, change model.CaseChange, actorUserID string) {
 	if uc == nil || c == nil {
 		return
 	}
@@ -122,14 +130,18 @@ func (uc *UseCase) PublishCaseLifecycle(ctx context.Context, workspaceID string,
 		Domain:        model.JobEventDomainCase,
 		WorkspaceID:   workspaceID,
 		CaseID:        c.ID,
-		CaseLifecycle: lifecycle,
+		CaseLifecycle: change.Lifecycle,
+		FieldID:       change.FieldID,
+		From:          change.From,
+		To:            change.To,
+		ActionID:      change.ActionID,
 		Timestamp:     time.Now().UTC(),
 		ActorUserID:   actorUserID,
 	})
 }
 
 // matchJobs returns the Jobs in the event's workspace the event is addressed
-// to: for the case domain every Job listening on that lifecycle (a fan-out),
+// to: for the case domain every Job listening on that change (a fan-out),
 // for the scheduled domain the single Job the event names. It is a pure
 // filter — reporting and dispatch belong to Publish.
 func (uc *UseCase) matchJobs(ev Event) ([]*model.Job, error) {
@@ -147,7 +159,7 @@ func (uc *UseCase) matchJobs(ev Event) ([]*model.Job, error) {
 		}
 		switch ev.Domain {
 		case model.JobEventDomainCase:
-			if j.ListensCase(ev.CaseLifecycle) {
+			if j.ListensCaseChange(ev.caseChange()) {
 				out = append(out, j)
 			}
 		case model.JobEventDomainScheduled:
@@ -101,3 +101,50 @@ func TestPublish_WithoutSweepContext(t *testing.T) {
 	async.Wait()
 	gt.Value(t, exec.firedJobIDs()).Equal([]string{"a"})
 }
+
+// A field_changed Job narrowed by `field` and `to` is addressed only by a change
+// of that field into one of the listed values.
+func TestMatchJobs_CaseChangeNarrowing(t *testing.T) {
+	registry := model.NewWorkspaceRegistry()
+	j := &model.Job{
+		ID:     "on_critical",
+		Prompt: "x",
+		Events: model.JobEvents{
+			Case: &model.CaseEventConfig{
+				On:    []model.CaseLifecycle{model.CaseLifecycleFieldChanged},
+				Field: "severity",
+				To:    []string{"critical"},
+			},
+		},
+	}
+	registry.Register(&model.WorkspaceEntry{
+		Workspace: model.Workspace{ID: "ws"},
+		Jobs:      []*model.Job{j},
+	})
+	uc := job.NewUseCase(registry, nil)
+
+	change := func(field string, to ...string) job.Event {
+		return job.Event{
+			Domain:        model.JobEventDomainCase,
+			WorkspaceID:   "ws",
+			CaseID:        1,
+			Timestamp:     time.Now().UTC(),
+			CaseLifecycle: model.CaseLifecycleFieldChanged,
+			FieldID:       field,
+			From:          []string{"medium"},
+			To:            to,
+		}
+	}
+
+	got, err := job.MatchJobsForTest(uc, change("severity", "critical"))
+	gt.NoError(t, err)
+	gt.Array(t, got).Length(1)
+
+	got, err = job.MatchJobsForTest(uc, change("severity", "low"))
+	gt.NoError(t, err)
+	gt.Array(t, got).Length(0)
+
+	got, err = job.MatchJobsForTest(uc, change("impact", "critical"))
+	gt.NoError(t, err)
+	gt.Array(t, got).Length(0)
+}
@@ -248,6 +248,10 @@ type systemPromptAction struct {
 
 type systemPromptTrigger struct {
 	CaseLifecycles []systemPromptLifecycle
+	// CaseField / CaseTo echo the events.case `field` / `to` narrowing;
+	// empty when the Job does not narrow.
+	CaseField      string
+	CaseTo         string
 	ScheduledEvery string
 	ScheduledCron  string
 }
@@ -260,6 +264,9 @@ type systemPromptLifecycle struct {
 type systemPromptReason struct {
 	CaseCreated bool
 	CaseClosed  bool
+	// CaseChanged carries the field / status / assignee / action transition
+	// for the detail-bearing lifecycles; nil for created / closed.
+	CaseChanged *systemPromptCaseChange
 	// Manual marks a run an operator started from the web UI. Actor then
 	// names the user who pressed Run, not the author of a case transition.
 	Manual         bool
@@ -273,6 +280,18 @@ type systemPromptReason struct {
 	Elapsed        string
 }
 
+// systemPromptCaseChange renders one detail-bearing case transition. From /
+// To are comma-joined, "(none)" when empty. FieldID and ActionID are set
+// only for field_changed and action_completed respectively.
+type systemPromptCaseChange struct {
+	Lifecycle   string
+	Description string
+	FieldID     string
+	ActionID    int64
+	From        string
+	To          string
+}
+
 // BuildSystemPrompt assembles the structured system prompt the Job agent
 // receives. Section content is fixed by the embedded `prompts/system.md`
 // template; this function only marshals PromptInputs into the typed
@@ -509,6 +528,8 @@ func buildSystemPromptData(in PromptInputs) systemPromptData {
 					Description: describeCaseLifecycle(lc),
 				})
 			}
+			data.Trigger.CaseField = cc.Field
+			data.Trigger.CaseTo = strings.Join(cc.To, ", ")
 		}
 		if sc := in.Job.Events.Scheduled; sc != nil {
 			switch {
@@ -534,6 +555,17 @@ func buildSystemPromptData(in PromptInputs) systemPromptData {
 			data.Reason.CaseCreated = true
 		case model.CaseLifecycleClosed:
 			data.Reason.CaseClosed = true
+		case model.CaseLifecycleFieldChanged, model.CaseLifecycleStatusChanged,
+			model.CaseLifecycleAssigned, model.CaseLifecycleActionCompleted,
+			model.CaseLifecycleMessageAttached:
+			data.Reason.CaseChanged = &systemPromptCaseChange{
+				Lifecycle:   string(in.Event.CaseLifecycle),
+				Description: describeCaseLifecycle(in.Event.CaseLifecycle),
+				FieldID:     in.Event.FieldID,
+				ActionID:    in.Event.ActionID,
+				From:        joinOrNone(in.Event.From),
+				To:          joinOrNone(in.Event.To),
+			}
 		}
 	case model.JobEventDomainManual:
 		actor := in.Event.ActorUserID
@@ -710,11 +742,29 @@ func describeCaseLifecycle(lc model.CaseLifecycle) string {
 		return "a new case is created"
 	case model.CaseLifecycleClosed:
 		return "the case status transitions to CLOSED"
+	case model.CaseLifecycleFieldChanged:
+		return "a custom field value of the case changes"
+	case model.CaseLifecycleStatusChanged:
+		return "the case moves to a different board status"
+	case model.CaseLifecycleAssigned:
+		return "users are newly assigned to the case"
+	case model.CaseLifecycleActionCompleted:
+		return "an action of the case moves into a closed status"
+	case model.CaseLifecycleMessageAttached:
+		return "a Slack message is attached to the case"
 	default:
 		return string(lc)
 	}
 }
 
+// joinOrNone renders a change's value list for the system prompt.
+func joinOrNone(values []string) string {
+	if len(values) == 0 {
+		return "(none)"
+	}
+	return strings.Join(values, ", ")
+}
+
 // userPromptCache memoises compiled user-prompt templates keyed on the prompt
 // SOURCE, never on the Job ID. A Job ID is unique only *within* a workspace
 // (`resolveJobs` in pkg/cli/config rejects duplicates per workspace), and
@@ -177,6 +177,63 @@ func TestBuildSystemPrompt_CaseClosedEvent(t *testing.T) {
 	mustContain(t, got, "the case status transitions to CLOSED")
 }
 
+func TestBuildSystemPrompt_CaseChangeEvent(t *testing.T) {
+	j := &model.Job{
+		ID:     "on-severity",
+		Prompt: "x",
+		Events: model.JobEvents{
+			Case: &model.CaseEventConfig{
+				On:    []model.CaseLifecycle{model.CaseLifecycleFieldChanged},
+				Field: "severity",
+				To:    []string{"critical"},
+			},
+		},
+	}
+	ev := job.Event{
+		Domain:        model.JobEventDomainCase,
+		WorkspaceID:   "ws",
+		CaseID:        7,
+		Timestamp:     time.Date(2026, 5, 23, 12, 0, 0, 0, time.UTC),
+		ActorUserID:   "U-OPS",
+		CaseLifecycle: model.CaseLifecycleFieldChanged,
+		FieldID:       "severity",
+		From:          []string{"low"},
+		To:            []string{"critical"},
+	}
+	got, err := job.BuildSystemPrompt(job.PromptInputs{
+		Job: j, Workspace: newWorkspace("ws", "WS"), Case: newCase(7), Event: ev,
+	})
+	gt.NoError(t, err).Required()
+	mustContain(t, got, "Case #7 event `field_changed` (a custom field value of the case changes) by U-OPS at 2026-05-23T12:00:00Z.")
+	mustContain(t, got, "- field: severity")
+	mustContain(t, got, "- from: low")
+	mustContain(t, got, "- to: critical")
+}
+
+// A case-domain Event without a lifecycle (a resumed run whose log predates
+// the persisted trigger payload) must not be rendered as an empty change.
+func TestBuildSystemPrompt_CaseEventWithoutLifecycle(t *testing.T) {
+	j := &model.Job{
+		ID:     "on-close",
+		Prompt: "x",
+		Events: model.JobEvents{
+			Case: &model.CaseEventConfig{On: []model.CaseLifecycle{model.CaseLifecycleClosed}},
+		},
+	}
+	ev := job.Event{
+		Domain:      model.JobEventDomainCase,
+		WorkspaceID: "ws",
+		CaseID:      99,
+		Timestamp:   time.Date(2026, 5, 23, 12, 0, 0, 0, time.UTC),
+	}
+	got, err := job.BuildSystemPrompt(job.PromptInputs{
+		Job: j, Workspace: newWorkspace("ws", "WS"), Case: newCase(99), Event: ev,
+	})
+	gt.NoError(t, err).Required()
+	mustNotContain(t, got, "event ``")
+	mustNotContain(t, got, "- from: (none)")
+}
+
 func TestBuildSystemPrompt_ScheduledEvery(t *testing.T) {
 	j := &model.Job{
 		ID:     "stale",
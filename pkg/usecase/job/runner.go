@@ -168,6 +168,15 @@ type SlackNotifier interface {
 	PostThreadReply(ctx context.Context, channelID, threadTS, text string) (string, error)
 }
 
+// RunPublisher is told when a Job run starts and when it ends, for the
+// workspace's outbound webhooks. A run starts when its run log is created, so
+// a trigger that fails before that is not announced at all. A run that pauses
+// on a question has not ended: it is announced once more, when it finally
+// succeeds or fails.
+type RunPublisher interface {
+	PublishJobRun(ctx context.Context, workspaceID string, c *model.Case, change model.JobRunChange)
+}
+
 // ToolBuilderFunc is the function form of ToolBuilder for inline use.
 type ToolBuilderFunc func(ctx context.Context, c *model.Case, ws *model.WorkspaceEntry) []gollem.Tool
 
@@ -223,6 +232,10 @@ type RunnerDeps struct {
 	// flag (the question is a deliberate agent interaction, not a log).
 	InteractionPoster jobQuestionPoster
 
+	// RunPublisher announces each run's start and end. Nil announces
+	// nothing.
+	RunPublisher RunPublisher
+
 	// UnansweredTimeout bounds how long a run may stay suspended awaiting
 	// user input before Run treats the suspension as stale and recovers it
 	// (so the Job is not blocked forever by an unanswered question). 0 →
@@ -542,6 +555,9 @@ func (r *JobRunner) Run(ctx context.Context, j *model.Job, ev Event) error {
 	runID := r.newRunID()
 	traceID := r.newTraceID()
 
+	// Attribute the run's writes (e.g. knowledge revisions) to this run.
+	ctx = model.ContextWithRunActor(ctx, model.RunActor{JobID: j.ID, RunID: runID})
+
 	strategy := model.NormaliseJobStrategy(j.Strategy)
 	sum.strategy = string(strategy)
 
@@ -573,6 +589,12 @@ func (r *JobRunner) Run(ctx context.Context, j *model.Job, ev Event) error {
 		EventType:      string(ev.Domain),
 		EventTriggerAt: ev.Timestamp.UTC(),
 		SystemPrompt:   runtrace.Truncate(systemPrompt, model.MaxInlineBytes),
+
+		EventCaseLifecycle: ev.CaseLifecycle,
+		EventFieldID:       ev.FieldID,
+		EventFrom:          ev.From,
+		EventTo:            ev.To,
+		EventActionID:      ev.ActionID,
 	}
 	if createErr := r.deps.Repo.JobRunLog().Create(ctx, logRec); createErr != nil {
 		// We never reached the event-emitting stage; surface as a
@@ -582,6 +604,9 @@ func (r *JobRunner) Run(ctx context.Context, j *model.Job, ev Event) error {
 	}
 	// The run log exists, so the attempt counts as started from here on.
 	sum.runID = runID
+	publishRun(ctx, r.deps.RunPublisher, r.deps.Repo, key, c, model.JobRunChange{
+		Lifecycle: model.JobRunLifecycleStarted, JobID: key.JobID, RunID: runID,
+	})
 
 	handler := runtrace.NewHandler(
 		r.deps.Repo.JobRunEvent(),
@@ -893,6 +918,7 @@ func (r *JobRunner) finishRun(
 	if finErr := r.deps.Repo.JobRunLog().Finish(ctx, logRec); finErr != nil {
 		errutil.Handle(ctx, finErr, "job: finish job run log")
 	}
+	publishRun(ctx, r.deps.RunPublisher, r.deps.Repo, key, c, runEndChange(key, runID, execErr))
 
 	jobRunStatus := model.JobRunStatusSuccess
 	if execErr != nil {
@@ -1089,6 +1115,7 @@ func (r *JobRunner) Resume(ctx context.Context, key model.JobRunKey, runID strin
 	sum.strategy = string(strategy)
 
 	ctx = WithJobActor(ctx, JobActorMarker{JobID: j.ID})
+	ctx = model.ContextWithRunActor(ctx, model.RunActor{JobID: j.ID, RunID: runID})
 	ctx = withQuiet(ctx, j.Quiet)
 
 	// A run on the durable runtime is still the SAME Process, parked on the
@@ -1144,6 +1171,12 @@ func (r *JobRunner) Resume(ctx context.Context, key model.JobRunKey, runID strin
 		CaseID:      key.CaseID,
 		JobID:       key.JobID,
 		Timestamp:   logRec.EventTriggerAt,
+
+		CaseLifecycle: logRec.EventCaseLifecycle,
+		FieldID:       logRec.EventFieldID,
+		From:          logRec.EventFrom,
+		To:            logRec.EventTo,
+		ActionID:      logRec.EventActionID,
 	}
 	prep, prepErr := r.prepareRun(ctx, j, ev)
 	if prepErr != nil {
@@ -1292,6 +1325,38 @@ func (r *JobRunner) finalizeOrphanedSuspension(ctx context.Context, run *model.J
 	if finErr := r.deps.Repo.JobRunLog().Finish(ctx, log); finErr != nil {
 		errutil.Handle(ctx, finErr, "job: finalize orphaned suspended run log")
 	}
+	publishRun(ctx, r.deps.RunPublisher, r.deps.Repo, run.Key(), nil, model.JobRunChange{
+		Lifecycle: model.JobRunLifecycleFailed, JobID: run.JobID, RunID: log.RunID, Error: log.Error,
+	})
+}
+
+// runEndChange describes how a run ended: runErr nil is a success.
+func runEndChange(key model.JobRunKey, runID string, runErr error) model.JobRunChange {
+	change := model.JobRunChange{Lifecycle: model.JobRunLifecycleSucceeded, JobID: key.JobID, RunID: runID}
+	if runErr != nil {
+		change.Lifecycle = model.JobRunLifecycleFailed
+		change.Error = runErr.Error()
+	}
+	return change
+}
+
+// publishRun announces a run transition through pub. c is nil on the paths
+// that never loaded the case; it is read here then, and a run whose case
+// cannot be read is reported and not announced.
+func publishRun(ctx context.Context, pub RunPublisher, repo interfaces.Repository, key model.JobRunKey, c *model.Case, change model.JobRunChange) {
+	if pub == nil {
+		return
+	}
+	if c == nil {
+		loaded, err := repo.Case().Get(ctx, key.WorkspaceID, key.CaseID)
+		if err != nil {
+			errutil.Handle(ctx, goerr.Wrap(err, "load the case to announce a job run",
+				goerr.V("case_id", key.CaseID), goerr.V("run_id", change.RunID)), "job: announce run")
+			return
+		}
+		c = loaded
+	}
+	pub.PublishJobRun(ctx, key.WorkspaceID, c, change)
 }
 
 // postStarting posts the "starting..." marker and returns the timestamp
@@ -452,6 +452,109 @@ func TestJobRunner_FailureIsRecorded(t *testing.T) {
 	gt.String(t, run.LastError).Contains("llm down")
 }
 
+// recordingRunPublisher collects the run transitions a runner announces.
+type recordingRunPublisher struct {
+	mu      sync.Mutex
+	changes []model.JobRunChange
+	caseIDs []int64
+}
+
+func (p *recordingRunPublisher) PublishJobRun(_ context.Context, _ string, c *model.Case, change model.JobRunChange) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.changes = append(p.changes, change)
+	p.caseIDs = append(p.caseIDs, c.ID)
+}
+
+func (p *recordingRunPublisher) lifecycles() []model.JobRunLifecycle {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	out := make([]model.JobRunLifecycle, 0, len(p.changes))
+	for _, ch := range p.changes {
+		out = append(out, ch.Lifecycle)
+	}
+	return out
+}
+
+// awaitRunLifecycles waits until pub has announced n transitions; a durable
+// run announces its end from the completion handler, after Run returned.
+func awaitRunLifecycles(t *testing.T, pub *recordingRunPublisher, n int) []model.JobRunLifecycle {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		got := pub.lifecycles()
+		if len(got) >= n || time.Now().After(deadline) {
+			return got
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestJobRunner_PublishesRunLifecycle(t *testing.T) {
+	j := &model.Job{
+		ID:     "announced",
+		Prompt: "x",
+		Events: model.JobEvents{
+			Case: &model.CaseEventConfig{On: []model.CaseLifecycle{model.CaseLifecycleCreated}},
+		},
+	}
+	run := func(t *testing.T, exec jobagent.JobExecutor) (*recordingRunPublisher, *model.Case, error) {
+		t.Helper()
+		repo, c := setupCase(t, "ws")
+		registry := model.NewWorkspaceRegistry()
+		registry.Register(&model.WorkspaceEntry{Workspace: model.Workspace{ID: "ws"}, Jobs: []*model.Job{j}})
+		pub := &recordingRunPublisher{}
+		runner := job.NewJobRunner(job.RunnerDeps{
+			Repo: repo, Registry: registry, LLMClient: inertLLM(), RunPublisher: pub,
+			Executors: map[model.JobStrategy]jobagent.JobExecutor{model.JobStrategySimple: exec},
+		})
+		err := runner.Run(context.Background(), j, job.Event{
+			Domain: model.JobEventDomainCase, WorkspaceID: "ws", CaseID: c.ID,
+			Timestamp: time.Now().UTC(), CaseLifecycle: model.CaseLifecycleCreated,
+		})
+		return pub, c, err
+	}
+
+	t.Run("a successful run announces its start and its success", func(t *testing.T) {
+		pub, c, err := run(t, &recordingExecutor{})
+		gt.NoError(t, err).Required()
+		gt.Array(t, pub.lifecycles()).Equal([]model.JobRunLifecycle{
+			model.JobRunLifecycleStarted, model.JobRunLifecycleSucceeded,
+		})
+		gt.Array(t, pub.caseIDs).Equal([]int64{c.ID, c.ID})
+		gt.Value(t, pub.changes[0].JobID).Equal(j.ID)
+		gt.String(t, pub.changes[0].RunID).NotEqual("")
+		gt.Value(t, pub.changes[1].RunID).Equal(pub.changes[0].RunID)
+		gt.Value(t, pub.changes[1].Error).Equal("")
+	})
+
+	t.Run("a failed run announces its failure with the error", func(t *testing.T) {
+		pub, _, err := run(t, &failingExecutor{err: goerr.New("llm down")})
+		gt.Error(t, err)
+		gt.Array(t, pub.lifecycles()).Equal([]model.JobRunLifecycle{
+			model.JobRunLifecycleStarted, model.JobRunLifecycleFailed,
+		})
+		gt.String(t, pub.changes[1].Error).Contains("llm down")
+	})
+
+	t.Run("a run that never started announces nothing", func(t *testing.T) {
+		// No executor for the strategy: the run fails before its run log exists.
+		repo, c := setupCase(t, "ws")
+		registry := model.NewWorkspaceRegistry()
+		registry.Register(&model.WorkspaceEntry{Workspace: model.Workspace{ID: "ws"}, Jobs: []*model.Job{j}})
+		pub := &recordingRunPublisher{}
+		runner := job.NewJobRunner(job.RunnerDeps{
+			Repo: repo, Registry: registry, LLMClient: inertLLM(), RunPublisher: pub,
+			Executors: map[model.JobStrategy]jobagent.JobExecutor{},
+		})
+		gt.Error(t, runner.Run(context.Background(), j, job.Event{
+			Domain: model.JobEventDomainCase, WorkspaceID: "ws", CaseID: c.ID,
+			Timestamp: time.Now().UTC(), CaseLifecycle: model.CaseLifecycleCreated,
+		}))
+		gt.Array(t, pub.lifecycles()).Length(0)
+	})
+}
+
 func TestJobRunner_SuccessClearsLease(t *testing.T) {
 	exec := &recordingExecutor{}
 	j := &model.Job{
@@ -586,8 +689,9 @@ func TestJobRunner_DurableSimpleRunRecordsItsOutcome(t *testing.T) {
 
 	llm := singleReplyLLM("the case looks fine", 120, 34)
 	durable := &job.DurableRuntime{History: agentarchive.NewMemoryHistoryStore()}
+	pub := &recordingRunPublisher{}
 	runner := job.NewJobRunner(job.RunnerDeps{
-		Repo: repo, Registry: registry, LLMClient: llm, Durable: durable,
+		Repo: repo, Registry: registry, LLMClient: llm, Durable: durable, RunPublisher: pub,
 		// No executor for the simple strategy: the durable path must be the one
 		// that runs, not a fallback into an in-process executor.
 		Executors: map[model.JobStrategy]jobagent.JobExecutor{},
@@ -622,6 +726,10 @@ func TestJobRunner_DurableSimpleRunRecordsItsOutcome(t *testing.T) {
 	gt.Array(t, events).Length(2).Required()
 	gt.Value(t, events[0].Kind).Equal(model.JobRunEventKindLLMRequest)
 	gt.Value(t, events[1].Kind).Equal(model.JobRunEventKindLLMResponse)
+
+	gt.Array(t, awaitRunLifecycles(t, pub, 2)).Equal([]model.JobRunLifecycle{
+		model.JobRunLifecycleStarted, model.JobRunLifecycleSucceeded,
+	})
 }
 
 // The deployment-wide concurrency limit must bound how many scheduled Job runs
@@ -804,8 +912,9 @@ func TestJobRunner_DurableSimpleRunRecordsAFailure(t *testing.T) {
 
 	llm := failingLLM("the model is unreachable")
 	durable := &job.DurableRuntime{History: agentarchive.NewMemoryHistoryStore()}
+	pub := &recordingRunPublisher{}
 	runner := job.NewJobRunner(job.RunnerDeps{
-		Repo: repo, Registry: registry, LLMClient: llm, Durable: durable,
+		Repo: repo, Registry: registry, LLMClient: llm, Durable: durable, RunPublisher: pub,
 		Executors: map[model.JobStrategy]jobagent.JobExecutor{},
 	})
 	// One attempt: the point is the terminal failure, not agentkit's retry
@@ -825,6 +934,13 @@ func TestJobRunner_DurableSimpleRunRecordsAFailure(t *testing.T) {
 	gt.NoError(t, err).Required()
 	gt.Array(t, logs).Length(1).Required()
 	gt.Value(t, logs[0].Stage).Equal(model.JobRunStageFailed)
+
+	// The completion handler announces the end of the run Run announced.
+	gt.Array(t, awaitRunLifecycles(t, pub, 2)).Equal([]model.JobRunLifecycle{
+		model.JobRunLifecycleStarted, model.JobRunLifecycleFailed,
+	})
+	gt.Value(t, pub.changes[1].RunID).Equal(logs[0].RunID)
+	gt.String(t, pub.changes[1].Error).Contains("the model is unreachable")
 }
 
 // A planexec-strategy Job runs on the durable runtime too: the planner rounds and
@@ -1264,6 +1380,8 @@ func TestJobRunner_GoldenPath(t *testing.T) {
 	gt.String(t, log.ExecutorKind).Equal("single_loop")
 	gt.String(t, log.EventType).Equal(string(model.JobEventDomainCase))
 	gt.Bool(t, log.EventTriggerAt.Equal(triggeredAt.UTC())).True()
+	// The lifecycle is persisted so a resumed turn gets its trigger reason back.
+	gt.Value(t, log.EventCaseLifecycle).Equal(model.CaseLifecycleCreated)
 	gt.String(t, log.SystemPrompt).NotEqual("")
 	// The run's totals come from the scripted agent loop below — one LLM call
 	// (120 in / 60 out) and one tool execution — summed by the trace handler and
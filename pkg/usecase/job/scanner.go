@@ -37,6 +37,9 @@ type ScannerDeps struct {
 	Repo      interfaces.Repository
 	Registry  *model.WorkspaceRegistry
 	Publisher EventPublisher
+	// RunPublisher announces the runs the sweep fails for going unanswered.
+	// Nil announces nothing.
+	RunPublisher RunPublisher
 
 	// UnansweredTimeout overrides DefaultUnansweredTimeout for the
 	// stale-suspended-run sweep. 0 → DefaultUnansweredTimeout.
@@ -259,6 +262,9 @@ func (s *ScheduledScanner) expireSuspendedRun(ctx context.Context, run *model.Jo
 			if finErr := s.deps.Repo.JobRunLog().Finish(ctx, logRec); finErr != nil {
 				errutil.Handle(ctx, finErr, "job: finish expired run log")
 			}
+			publishRun(ctx, s.deps.RunPublisher, s.deps.Repo, key, nil, model.JobRunChange{
+				Lifecycle: model.JobRunLifecycleFailed, JobID: key.JobID, RunID: runID, Error: reason,
+			})
 		}
 	}
 
@@ -292,6 +292,16 @@ func (uc *JobRunUseCase) GetLog(ctx context.Context, workspaceID string, caseID
 // in ascending Sequence order. As with GetLog, the JobID is resolved
 // by walking the per-Case JobRun list first.
 func (uc *JobRunUseCase) ListEvents(ctx context.Context, workspaceID string, caseID int64, runID string) ([]*model.JobRunEvent, error) {
+	return uc.listEvents(ctx, workspaceID, caseID, runID, nil)
+}
+
+// ListEventsAfter is ListEvents restricted to the events past
+// afterSequence, for a reader that already holds the timeline up to it.
+func (uc *JobRunUseCase) ListEventsAfter(ctx context.Context, workspaceID string, caseID int64, runID string, afterSequence int64) ([]*model.JobRunEvent, error) {
+	return uc.listEvents(ctx, workspaceID, caseID, runID, &afterSequence)
+}
+
+func (uc *JobRunUseCase) listEvents(ctx context.Context, workspaceID string, caseID int64, runID string, afterSequence *int64) ([]*model.JobRunEvent, error) {
 	if workspaceID == "" {
 		return nil, goerr.Wrap(ErrInvalidArgument, "workspace id is empty")
 	}
@@ -317,11 +327,17 @@ func (uc *JobRunUseCase) ListEvents(ctx context.Context, workspaceID string, cas
 			goerr.V("run_id", runID))
 	}
 
-	events, err := uc.repo.JobRunEvent().List(ctx, model.JobRunKey{
+	key := model.JobRunKey{
 		WorkspaceID: workspaceID,
 		CaseID:      caseID,
 		JobID:       log.JobID,
-	}, runID)
+	}
+	var events []*model.JobRunEvent
+	if afterSequence != nil {
+		events, err = uc.repo.JobRunEvent().ListAfter(ctx, key, runID, *afterSequence)
+	} else {
+		events, err = uc.repo.JobRunEvent().List(ctx, key, runID)
+	}
 	if err != nil {
 		return nil, goerr.Wrap(err, "list job run events",
 			goerr.V("workspace_id", workspaceID),
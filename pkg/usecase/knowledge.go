@@ -2,7 +2,7 @@ package usecase
 
 import (
 	"context"
-	"math"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -16,14 +16,14 @@ import (
 
 // KnowledgeUseCase orchestrates workspace-wide shared knowledge operations.
 // Embedding is optional: when no embed client is configured the use case
-// degrades gracefully (no semantic vectors, substring search fallback).
+// degrades gracefully (no semantic vectors, lexical-only search).
 type KnowledgeUseCase struct {
 	repo        interfaces.Repository
 	embedClient interfaces.EmbedClient
 }
 
 // NewKnowledgeUseCase constructs a KnowledgeUseCase. embedClient may be nil
-// (fail-open: create/update still succeed and search falls back to substring).
+// (fail-open: create/update still succeed and search is lexical only).
 func NewKnowledgeUseCase(repo interfaces.Repository, embedClient interfaces.EmbedClient) *KnowledgeUseCase {
 	return &KnowledgeUseCase{repo: repo, embedClient: embedClient}
 }
@@ -42,6 +42,9 @@ type CreateKnowledgeInput struct {
 	Title  string
 	Claim  string
 	TagIDs []model.TagID
+	// ValidUntil / ReviewBy are optional; nil leaves the entry open-ended.
+	ValidUntil *time.Time
+	ReviewBy   *time.Time
 }
 
 // Validate enforces input invariants at the entry point: a title and at least
@@ -62,12 +65,17 @@ func (input CreateKnowledgeInput) Validate() error {
 }
 
 // UpdateKnowledgeInput is the domain-level input for updating a knowledge entry.
-// Title / Claim / TagIDs are pointers: nil means "leave unchanged".
+// Title / Claim / TagIDs / ValidUntil / ReviewBy are pointers: nil means "leave
+// unchanged". ClearValidUntil / ClearReviewBy remove the respective date.
 type UpdateKnowledgeInput struct {
-	ID     model.KnowledgeID
-	Title  *string
-	Claim  *string
-	TagIDs *[]model.TagID
+	ID              model.KnowledgeID
+	Title           *string
+	Claim           *string
+	TagIDs          *[]model.TagID
+	ValidUntil      *time.Time
+	ReviewBy        *time.Time
+	ClearValidUntil bool
+	ClearReviewBy   bool
 }
 
 // Validate enforces input invariants for the fields that are present.
@@ -85,6 +93,12 @@ func (input UpdateKnowledgeInput) Validate() error {
 		return goerr.Wrap(ErrKnowledgeInput, "claim is too long",
 			goerr.V("length", utf8.RuneCountInString(*input.Claim)), goerr.V("max", model.MaxClaimLength))
 	}
+	if input.ValidUntil != nil && input.ClearValidUntil {
+		return goerr.Wrap(ErrKnowledgeInput, "validUntil and clearValidUntil are mutually exclusive")
+	}
+	if input.ReviewBy != nil && input.ClearReviewBy {
+		return goerr.Wrap(ErrKnowledgeInput, "reviewBy and clearReviewBy are mutually exclusive")
+	}
 	return nil
 }
 
@@ -98,10 +112,14 @@ type SearchKnowledgeInput struct {
 	// Limit caps the number of returned entries. Zero or negative means no cap;
 	// the caller (resolver / tool) supplies the default.
 	Limit int
+	// IncludeExpired keeps entries whose ValidUntil has passed. By default they
+	// are dropped before ranking so an agent is not handed stale intel.
+	IncludeExpired bool
 }
 
-// CreateKnowledge creates a new knowledge entry. The embedding is generated
-// best-effort: a failure or a missing embed client never blocks creation.
+// CreateKnowledge creates a new knowledge entry and records it as revision 1.
+// The embedding is generated best-effort: a failure or a missing embed client
+// never blocks creation.
 func (uc *KnowledgeUseCase) CreateKnowledge(ctx context.Context, workspaceID string, input CreateKnowledgeInput) (*model.Knowledge, error) {
 	if err := input.Validate(); err != nil {
 		return nil, err
@@ -120,6 +138,8 @@ func (uc *KnowledgeUseCase) CreateKnowledge(ctx context.Context, workspaceID str
 		Claim:       input.Claim,
 		TagIDs:      tagIDs,
 		CreatorID:   creatorFromContext(ctx),
+		ValidUntil:  input.ValidUntil,
+		ReviewBy:    input.ReviewBy,
 		CreatedAt:   now,
 		UpdatedAt:   now,
 	}
@@ -129,6 +149,9 @@ func (uc *KnowledgeUseCase) CreateKnowledge(ctx context.Context, workspaceID str
 		return nil, err
 	}
 
+	if err := uc.appendRevision(ctx, nil, knowledge, model.KnowledgeRevisionCreated, 0); err != nil {
+		return nil, err
+	}
 	created, err := uc.repo.Knowledge().Create(ctx, workspaceID, knowledge)
 	if err != nil {
 		return nil, goerr.Wrap(err, "failed to create knowledge", goerr.V("workspace_id", workspaceID))
@@ -155,8 +178,47 @@ func (uc *KnowledgeUseCase) ListKnowledge(ctx context.Context, workspaceID strin
 	return items, nil
 }
 
-// UpdateKnowledge applies a partial update. The embedding is regenerated when
-// the title or claim changes (best-effort).
+// ListKnowledgeDueForReview lists the entries that have expired or reached
+// their review date, longest-overdue first. Unlike the review sweep it does not
+// depend on ReviewFlaggedAt, so an entry shows up as soon as it is due.
+func (uc *KnowledgeUseCase) ListKnowledgeDueForReview(ctx context.Context, workspaceID string) ([]*model.Knowledge, error) {
+	items, err := uc.repo.Knowledge().List(ctx, workspaceID, interfaces.KnowledgeListOptions{})
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list knowledge for review", goerr.V("workspace_id", workspaceID))
+	}
+
+	now := time.Now()
+	items = slices.DeleteFunc(items, func(k *model.Knowledge) bool { return !k.IsReviewDue(now) })
+	sort.SliceStable(items, func(i, j int) bool {
+		return reviewDueAt(items[i]).Before(reviewDueAt(items[j]))
+	})
+	return items, nil
+}
+
+// sameInstant reports whether two optional times are both unset or equal.
+func sameInstant(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// reviewDueAt is the earlier of an entry's ValidUntil and ReviewBy. Only
+// called on due entries, which have at least one of the two set.
+func reviewDueAt(k *model.Knowledge) time.Time {
+	switch {
+	case k.ValidUntil == nil:
+		return *k.ReviewBy
+	case k.ReviewBy == nil || k.ValidUntil.Before(*k.ReviewBy):
+		return *k.ValidUntil
+	default:
+		return *k.ReviewBy
+	}
+}
+
+// UpdateKnowledge applies a partial update and records the result as a new
+// revision attributed to the caller (user and/or Job run). The embedding is
+// regenerated when the title or claim changes (best-effort).
 func (uc *KnowledgeUseCase) UpdateKnowledge(ctx context.Context, workspaceID string, input UpdateKnowledgeInput) (*model.Knowledge, error) {
 	if err := input.Validate(); err != nil {
 		return nil, err
@@ -168,6 +230,7 @@ func (uc *KnowledgeUseCase) UpdateKnowledge(ctx context.Context, workspaceID str
 			goerr.V("workspace_id", workspaceID), goerr.V("knowledge_id", input.ID))
 	}
 
+	prior := *knowledge
 	contentChanged := false
 	if input.Title != nil {
 		knowledge.Title = strings.TrimSpace(*input.Title)
@@ -187,12 +250,25 @@ func (uc *KnowledgeUseCase) UpdateKnowledge(ctx context.Context, workspaceID str
 	if contentChanged {
 		knowledge.Embedding = uc.embedKnowledge(ctx, knowledge)
 	}
+	// A new date re-arms the review sweep for the entry; re-sending the date it
+	// already has (a form save) must not, or the owner would be told again.
+	if (input.ValidUntil != nil || input.ClearValidUntil) && !sameInstant(knowledge.ValidUntil, input.ValidUntil) {
+		knowledge.ValidUntil = input.ValidUntil
+		knowledge.ReviewFlaggedAt = nil
+	}
+	if (input.ReviewBy != nil || input.ClearReviewBy) && !sameInstant(knowledge.ReviewBy, input.ReviewBy) {
+		knowledge.ReviewBy = input.ReviewBy
+		knowledge.ReviewFlaggedAt = nil
+	}
 	knowledge.UpdatedAt = time.Now().UTC()
 
 	if err := knowledge.Validate(); err != nil {
 		return nil, err
 	}
 
+	if err := uc.appendRevision(ctx, &prior, knowledge, model.KnowledgeRevisionUpdated, 0); err != nil {
+		return nil, err
+	}
 	updated, err := uc.repo.Knowledge().Update(ctx, workspaceID, knowledge)
 	if err != nil {
 		return nil, goerr.Wrap(err, "failed to update knowledge",
@@ -240,61 +316,6 @@ func (uc *KnowledgeUseCase) verifyTagsExist(ctx context.Context, workspaceID str
 	return nil
 }
 
-// SearchKnowledge ranks entries by semantic similarity to the query. When no
-// embedding is available (no embed client, embedding failure, or candidates
-// without vectors) it falls back to a substring-match score over title + claim.
-func (uc *KnowledgeUseCase) SearchKnowledge(ctx context.Context, workspaceID string, input SearchKnowledgeInput) ([]*model.Knowledge, error) {
-	items, err := uc.repo.Knowledge().List(ctx, workspaceID, interfaces.KnowledgeListOptions{TagIDs: input.TagIDs})
-	if err != nil {
-		return nil, goerr.Wrap(err, "failed to list knowledge for search", goerr.V("workspace_id", workspaceID))
-	}
-
-	query := strings.TrimSpace(input.Query)
-	if query == "" {
-		return applyLimit(items, input.Limit), nil
-	}
-
-	queryVec, embedErr := uc.embedText(ctx, query)
-	if embedErr != nil {
-		// Non-fatal: report and fall back to substring search rather than failing
-		// the whole query on a transient embedding outage.
-		errutil.Handle(ctx, embedErr, "failed to embed knowledge search query")
-		queryVec = nil
-	}
-
-	type scored struct {
-		k     *model.Knowledge
-		score float64
-	}
-	ranked := make([]scored, 0, len(items))
-	useEmbedding := len(queryVec) > 0
-	lowerQuery := strings.ToLower(query)
-	for _, k := range items {
-		var score float64
-		if useEmbedding && len(k.Embedding) > 0 {
-			score = cosineSimilarity(queryVec, k.Embedding)
-		} else {
-			score = substringScore(lowerQuery, k)
-		}
-		ranked = append(ranked, scored{k: k, score: score})
-	}
-
-	// Stable sort by score descending; tie-break on CreatedAt descending so the
-	// most recent of equally-scored entries comes first.
-	sort.SliceStable(ranked, func(i, j int) bool {
-		if ranked[i].score == ranked[j].score {
-			return ranked[i].k.CreatedAt.After(ranked[j].k.CreatedAt)
-		}
-		return ranked[i].score > ranked[j].score
-	})
-
-	out := make([]*model.Knowledge, len(ranked))
-	for i, r := range ranked {
-		out[i] = r.k
-	}
-	return applyLimit(out, input.Limit), nil
-}
-
 // embedKnowledge generates the embedding for a knowledge entry best-effort,
 // returning nil (and reporting via errutil) on failure so create/update never
 // blocks on embedding.
@@ -323,42 +344,3 @@ func (uc *KnowledgeUseCase) embedText(ctx context.Context, text string) ([]float
 	}
 	return vecs[0], nil
 }
-
-// applyLimit returns the first limit items, or all when limit <= 0.
-func applyLimit(items []*model.Knowledge, limit int) []*model.Knowledge {
-	if limit > 0 && len(items) > limit {
-		return items[:limit]
-	}
-	return items
-}
-
-// substringScore is the fallback relevance score when embeddings are
-// unavailable. A title hit weighs more than a claim hit.
-func substringScore(lowerQuery string, k *model.Knowledge) float64 {
-	var score float64
-	if strings.Contains(strings.ToLower(k.Title), lowerQuery) {
-		score += 2
-	}
-	if strings.Contains(strings.ToLower(k.Claim), lowerQuery) {
-		score++
-	}
-	return score
-}
-
-// cosineSimilarity returns the cosine similarity of two equal-length vectors.
-// Mismatched lengths or a zero-norm vector yield 0.
-func cosineSimilarity(a, b []float64) float64 {
-	if len(a) != len(b) || len(a) == 0 {
-		return 0
-	}
-	var dot, na, nb float64
-	for i := range a {
-		dot += a[i] * b[i]
-		na += a[i] * a[i]
-		nb += b[i] * b[i]
-	}
-	if na == 0 || nb == 0 {
-		return 0
-	}
-	return dot / (math.Sqrt(na) * math.Sqrt(nb))
-}
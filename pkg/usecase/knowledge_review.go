@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/i18n"
+	"github.com/secmon-lab/hecatoncheires/pkg/service/slack"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/logging"
+)
+
+// KnowledgeReviewUseCase runs the stale-knowledge sweep: it flags every entry
+// whose review date has been reached or whose validity has expired, and tells
+// the entry's owner (its human creator) on Slack. It is driven by the same
+// external scheduler as the scheduled-Job sweep (`hecatoncheires tick` /
+// `POST /hooks/tick`), not by a wall-clock timer, so a multi-instance
+// deployment does not notify once per instance.
+type KnowledgeReviewUseCase struct {
+	repo         interfaces.Repository
+	registry     *model.WorkspaceRegistry
+	slackService slack.Service
+	baseURL      string
+	now          func() time.Time
+}
+
+// NewKnowledgeReviewUseCase constructs a KnowledgeReviewUseCase. slackService
+// may be nil: entries are then flagged (visible in the Web UI) but nobody is
+// messaged.
+func NewKnowledgeReviewUseCase(repo interfaces.Repository, registry *model.WorkspaceRegistry, slackService slack.Service, baseURL string) *KnowledgeReviewUseCase {
+	return &KnowledgeReviewUseCase{
+		repo:         repo,
+		registry:     registry,
+		slackService: slackService,
+		baseURL:      baseURL,
+		now:          time.Now,
+	}
+}
+
+// Sweep flags the entries that became due since the previous sweep. Each entry
+// is flagged (ReviewFlaggedAt) BEFORE its owner is notified, so a notification
+// failure never causes a repeat message on the next sweep; the flag alone is
+// still surfaced in the Web UI. A failure listing or flagging one workspace's
+// entries stops the sweep; a failed notification does not.
+func (uc *KnowledgeReviewUseCase) Sweep(ctx context.Context) error {
+	if uc.registry == nil {
+		return goerr.New("knowledge review sweep has no registry")
+	}
+	now := uc.now().UTC()
+
+	for _, ws := range uc.registry.List() {
+		if ws == nil {
+			continue
+		}
+		workspaceID := ws.Workspace.ID
+		items, err := uc.repo.Knowledge().List(ctx, workspaceID, interfaces.KnowledgeListOptions{})
+		if err != nil {
+			return goerr.Wrap(err, "list knowledge for review sweep", goerr.V("workspace_id", workspaceID))
+		}
+
+		flagged, notified := 0, 0
+		for _, k := range items {
+			if k.ReviewFlaggedAt != nil || !k.IsReviewDue(now) {
+				continue
+			}
+			// A direct repository write, not UpdateKnowledge: the flag is sweep
+			// bookkeeping, not a content change, so it records no revision and
+			// leaves UpdatedAt alone.
+			k.ReviewFlaggedAt = &now
+			if _, err := uc.repo.Knowledge().Update(ctx, workspaceID, k); err != nil {
+				return goerr.Wrap(err, "flag knowledge for review",
+					goerr.V("workspace_id", workspaceID), goerr.V("knowledge_id", k.ID))
+			}
+			flagged++
+
+			if uc.notifyOwner(ctx, k, now) {
+				notified++
+			}
+		}
+
+		logging.From(ctx).Info("knowledge review sweep completed",
+			slog.String("workspace_id", workspaceID),
+			slog.Int("knowledge", len(items)),
+			slog.Int("flagged", flagged),
+			slog.Int("notified", notified))
+	}
+	return nil
+}
+
+// notifyOwner messages the entry's creator in a Slack DM. Agent-authored
+// entries have no owner to tell; they are only flagged. It reports whether a
+// message was sent.
+func (uc *KnowledgeReviewUseCase) notifyOwner(ctx context.Context, k *model.Knowledge, now time.Time) bool {
+	if uc.slackService == nil || k.CreatorID == "" {
+		return false
+	}
+
+	entry := k.Title
+	if uc.baseURL != "" {
+		entry = fmt.Sprintf("<%s|%s>", knowledgeURL(uc.baseURL, k.WorkspaceID, k.ID), k.Title)
+	}
+	key := i18n.MsgKnowledgeReviewDue
+	if k.IsExpired(now) {
+		key = i18n.MsgKnowledgeExpired
+	}
+	// chat.postMessage to a user ID delivers to the app's DM with that user.
+	if _, err := uc.slackService.PostMessage(ctx, k.CreatorID, nil, i18n.T(ctx, key, entry)); err != nil {
+		errutil.Handle(ctx, goerr.Wrap(err, "notify knowledge owner",
+			goerr.V("workspace_id", k.WorkspaceID),
+			goerr.V("knowledge_id", k.ID),
+			goerr.V("user_id", k.CreatorID)), "knowledge review: notify owner")
+		return false
+	}
+	return true
+}
+
+// knowledgeURL builds the Web UI URL of a knowledge entry.
+func knowledgeURL(baseURL, workspaceID string, id model.KnowledgeID) string {
+	return fmt.Sprintf("%s/ws/%s/knowledge/%s", baseURL, workspaceID, id)
+}
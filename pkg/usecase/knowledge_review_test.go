@@ -0,0 +1,225 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+)
+
+func TestKnowledgeUseCase_SearchExcludesExpired(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.New()
+	uc := usecase.NewKnowledgeUseCase(repo, nil)
+	tagUC := usecase.NewTagUseCase(repo)
+	ws := newWS()
+	opsID := createTestTag(t, ctx, tagUC, ws, "ops")
+
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+	_, err := uc.CreateKnowledge(ctx, ws, usecase.CreateKnowledgeInput{
+		Title: "github token rotation (old)", TagIDs: []model.TagID{opsID}, ValidUntil: &past,
+	})
+	gt.NoError(t, err).Required()
+	current, err := uc.CreateKnowledge(ctx, ws, usecase.CreateKnowledgeInput{
+		Title: "github token rotation", TagIDs: []model.TagID{opsID}, ValidUntil: &future,
+	})
+	gt.NoError(t, err).Required()
+
+	res, err := uc.SearchKnowledge(ctx, ws, usecase.SearchKnowledgeInput{Query: "github"})
+	gt.NoError(t, err).Required()
+	gt.Array(t, res).Length(1).Required()
+	gt.Value(t, res[0].Knowledge.ID).Equal(current.ID)
+
+	res, err = uc.SearchKnowledge(ctx, ws, usecase.SearchKnowledgeInput{Query: "github", IncludeExpired: true})
+	gt.NoError(t, err).Required()
+	gt.Array(t, res).Length(2)
+}
+
+func TestKnowledgeUseCase_UpdateReviewDates(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.New()
+	uc := usecase.NewKnowledgeUseCase(repo, nil)
+	tagUC := usecase.NewTagUseCase(repo)
+	ws := newWS()
+	opsID := createTestTag(t, ctx, tagUC, ws, "ops")
+
+	reviewBy := time.Now().Add(-time.Hour).UTC()
+	created, err := uc.CreateKnowledge(ctx, ws, usecase.CreateKnowledgeInput{
+		Title: "entry", TagIDs: []model.TagID{opsID}, ReviewBy: &reviewBy,
+	})
+	gt.NoError(t, err).Required()
+
+	// Simulate a sweep having flagged the entry.
+	flagged := time.Now().UTC()
+	created.ReviewFlaggedAt = &flagged
+	_, err = repo.Knowledge().Update(ctx, ws, created)
+	gt.NoError(t, err).Required()
+
+	t.Run("value and clear flag together are rejected", func(t *testing.T) {
+		_, err := uc.UpdateKnowledge(ctx, ws, usecase.UpdateKnowledgeInput{
+			ID: created.ID, ReviewBy: &reviewBy, ClearReviewBy: true,
+		})
+		gt.Error(t, err).Is(usecase.ErrKnowledgeInput)
+	})
+
+	t.Run("an unrelated edit keeps the flag", func(t *testing.T) {
+		title := "entry v2"
+		got, err := uc.UpdateKnowledge(ctx, ws, usecase.UpdateKnowledgeInput{ID: created.ID, Title: &title})
+		gt.NoError(t, err).Required()
+		gt.Value(t, got.ReviewFlaggedAt).NotNil()
+		gt.Value(t, got.ReviewBy).NotNil()
+	})
+
+	t.Run("re-sending the same date keeps the flag", func(t *testing.T) {
+		same := reviewBy
+		got, err := uc.UpdateKnowledge(ctx, ws, usecase.UpdateKnowledgeInput{ID: created.ID, ReviewBy: &same})
+		gt.NoError(t, err).Required()
+		gt.Value(t, got.ReviewFlaggedAt).NotNil()
+	})
+
+	t.Run("moving the review date clears the flag", func(t *testing.T) {
+		next := time.Now().Add(30 * 24 * time.Hour).UTC()
+		got, err := uc.UpdateKnowledge(ctx, ws, usecase.UpdateKnowledgeInput{ID: created.ID, ReviewBy: &next})
+		gt.NoError(t, err).Required()
+		gt.Value(t, got.ReviewFlaggedAt).Nil()
+		gt.Bool(t, got.ReviewBy.Equal(next)).True()
+	})
+
+	t.Run("clearing removes the date", func(t *testing.T) {
+		got, err := uc.UpdateKnowledge(ctx, ws, usecase.UpdateKnowledgeInput{ID: created.ID, ClearReviewBy: true})
+		gt.NoError(t, err).Required()
+		gt.Value(t, got.ReviewBy).Nil()
+	})
+}
+
+func TestKnowledgeUseCase_ListKnowledgeDueForReview(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.New()
+	uc := usecase.NewKnowledgeUseCase(repo, nil)
+	tagUC := usecase.NewTagUseCase(repo)
+	ws := newWS()
+	opsID := createTestTag(t, ctx, tagUC, ws, "ops")
+
+	create := func(title string, validUntil, reviewBy *time.Time) model.KnowledgeID {
+		t.Helper()
+		k, err := uc.CreateKnowledge(ctx, ws, usecase.CreateKnowledgeInput{
+			Title: title, TagIDs: []model.TagID{opsID}, ValidUntil: validUntil, ReviewBy: reviewBy,
+		})
+		gt.NoError(t, err).Required()
+		return k.ID
+	}
+	at := func(d time.Duration) *time.Time {
+		v := time.Now().Add(d)
+		return &v
+	}
+
+	create("open-ended", nil, nil)
+	create("review later", nil, at(time.Hour))
+	recent := create("review reached", nil, at(-time.Hour))
+	oldest := create("expired", at(-48*time.Hour), at(time.Hour))
+
+	due, err := uc.ListKnowledgeDueForReview(ctx, ws)
+	gt.NoError(t, err).Required()
+	gt.Array(t, due).Length(2).Required()
+	gt.Value(t, due[0].ID).Equal(oldest)
+	gt.Value(t, due[1].ID).Equal(recent)
+}
+
+func TestKnowledgeReviewUseCase_Sweep(t *testing.T) {
+	repo := memory.New()
+	ws := newWS()
+	registry := model.NewWorkspaceRegistry()
+	registry.Register(&model.WorkspaceEntry{Workspace: model.Workspace{ID: ws, Name: "Test"}})
+
+	knowledgeUC := usecase.NewKnowledgeUseCase(repo, nil)
+	tagUC := usecase.NewTagUseCase(repo)
+	opsID := createTestTag(t, context.Background(), tagUC, ws, "ops")
+
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+	create := func(ctx context.Context, title string, validUntil, reviewBy *time.Time) model.KnowledgeID {
+		t.Helper()
+		k, err := knowledgeUC.CreateKnowledge(ctx, ws, usecase.CreateKnowledgeInput{
+			Title: title, TagIDs: []model.TagID{opsID}, ValidUntil: validUntil, ReviewBy: reviewBy,
+		})
+		gt.NoError(t, err).Required()
+		return k.ID
+	}
+	userCtx := auth.ContextWithToken(context.Background(), &auth.Token{Sub: "U-OWNER"})
+	agentCtx := context.Background()
+
+	dueID := create(userCtx, "review me", nil, &past)
+	expiredID := create(userCtx, "expired intel", &past, nil)
+	agentID := create(agentCtx, "agent-written", nil, &past)
+	notDueID := create(userCtx, "fresh", &future, &future)
+
+	slackSvc := &slotSlackFake{}
+	reviewUC := usecase.NewKnowledgeReviewUseCase(repo, registry, slackSvc, "https://hc.example.com")
+
+	gt.NoError(t, reviewUC.Sweep(context.Background())).Required()
+
+	t.Run("flags every due entry, owner or not", func(t *testing.T) {
+		for _, id := range []model.KnowledgeID{dueID, expiredID, agentID} {
+			got, err := repo.Knowledge().Get(context.Background(), ws, id)
+			gt.NoError(t, err).Required()
+			gt.Value(t, got.ReviewFlaggedAt).NotNil()
+		}
+		got, err := repo.Knowledge().Get(context.Background(), ws, notDueID)
+		gt.NoError(t, err).Required()
+		gt.Value(t, got.ReviewFlaggedAt).Nil()
+	})
+
+	t.Run("DMs the human owner once per due entry", func(t *testing.T) {
+		gt.Array(t, slackSvc.postCalls).Length(2).Required()
+		var texts []string
+		for _, c := range slackSvc.postCalls {
+			gt.String(t, c.ChannelID).Equal("U-OWNER")
+			texts = append(texts, c.Text)
+		}
+		joined := strings.Join(texts, "\n")
+		gt.String(t, joined).Contains("https://hc.example.com/ws/" + ws + "/knowledge/" + string(dueID))
+		gt.String(t, joined).Contains("due for review")
+		gt.String(t, joined).Contains("has expired")
+	})
+
+	t.Run("a second sweep does not notify again", func(t *testing.T) {
+		gt.NoError(t, reviewUC.Sweep(context.Background())).Required()
+		gt.Array(t, slackSvc.postCalls).Length(2)
+	})
+}
+
+func TestKnowledgeReviewUseCase_SweepNotifyFailureStillFlags(t *testing.T) {
+	repo := memory.New()
+	ws := newWS()
+	registry := model.NewWorkspaceRegistry()
+	registry.Register(&model.WorkspaceEntry{Workspace: model.Workspace{ID: ws, Name: "Test"}})
+
+	knowledgeUC := usecase.NewKnowledgeUseCase(repo, nil)
+	tagUC := usecase.NewTagUseCase(repo)
+	opsID := createTestTag(t, context.Background(), tagUC, ws, "ops")
+
+	past := time.Now().Add(-time.Hour)
+	k, err := knowledgeUC.CreateKnowledge(auth.ContextWithToken(context.Background(), &auth.Token{Sub: "U-OWNER"}), ws, usecase.CreateKnowledgeInput{
+		Title: "review me", TagIDs: []model.TagID{opsID}, ReviewBy: &past,
+	})
+	gt.NoError(t, err).Required()
+
+	slackSvc := &slotSlackFake{postReturnErr: errors.New("channel_not_found")}
+	reviewUC := usecase.NewKnowledgeReviewUseCase(repo, registry, slackSvc, "")
+	gt.NoError(t, reviewUC.Sweep(context.Background())).Required()
+
+	got, err := repo.Knowledge().Get(context.Background(), ws, k.ID)
+	gt.NoError(t, err).Required()
+	gt.Value(t, got.ReviewFlaggedAt).NotNil()
+
+	gt.NoError(t, reviewUC.Sweep(context.Background())).Required()
+	gt.Array(t, slackSvc.postCalls).Length(1)
+}
@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+)
+
+// maxRevisionAttempts bounds how often appendRevision re-reads the history
+// after losing the race for a revision number to a concurrent writer.
+const maxRevisionAttempts = 3
+
+// ListKnowledgeRevisions returns the history of a knowledge entry, oldest
+// first.
+func (uc *KnowledgeUseCase) ListKnowledgeRevisions(ctx context.Context, workspaceID string, id model.KnowledgeID) ([]*model.KnowledgeRevision, error) {
+	revs, err := uc.repo.Knowledge().ListRevisions(ctx, workspaceID, id)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list knowledge revisions",
+			goerr.V("workspace_id", workspaceID), goerr.V("knowledge_id", id))
+	}
+	return revs, nil
+}
+
+// DiffKnowledgeRevisions compares two revisions of the same entry. from may be
+// later than to; the diff is always read as "from → to".
+func (uc *KnowledgeUseCase) DiffKnowledgeRevisions(ctx context.Context, workspaceID string, id model.KnowledgeID, from, to int) (*model.KnowledgeRevisionDiff, error) {
+	fromRev, err := uc.getRevision(ctx, workspaceID, id, from)
+	if err != nil {
+		return nil, err
+	}
+	toRev, err := uc.getRevision(ctx, workspaceID, id, to)
+	if err != nil {
+		return nil, err
+	}
+	return model.DiffKnowledgeRevisions(fromRev, toRev), nil
+}
+
+// RestoreKnowledgeRevision rolls an entry's content back to an earlier
+// revision. The rollback is itself recorded as a new revision, so nothing in
+// the history is lost and the restore can be undone the same way.
+func (uc *KnowledgeUseCase) RestoreKnowledgeRevision(ctx context.Context, workspaceID string, id model.KnowledgeID, revision int) (*model.Knowledge, error) {
+	source, err := uc.getRevision(ctx, workspaceID, id, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	knowledge, err := uc.repo.Knowledge().Get(ctx, workspaceID, id)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to load knowledge for restore",
+			goerr.V("workspace_id", workspaceID), goerr.V("knowledge_id", id))
+	}
+
+	// A tag deleted since the revision was written cannot be referenced again;
+	// fail the restore rather than persist a dangling tag id.
+	tagIDs := model.NormalizeTagIDs(source.TagIDs)
+	if err := uc.verifyTagsExist(ctx, workspaceID, tagIDs); err != nil {
+		return nil, err
+	}
+
+	prior := *knowledge
+	knowledge.Title = source.Title
+	knowledge.Claim = source.Claim
+	knowledge.TagIDs = tagIDs
+	knowledge.Embedding = uc.embedKnowledge(ctx, knowledge)
+	knowledge.UpdatedAt = time.Now().UTC()
+
+	if err := knowledge.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.appendRevision(ctx, &prior, knowledge, model.KnowledgeRevisionRestored, revision); err != nil {
+		return nil, err
+	}
+	restored, err := uc.repo.Knowledge().Update(ctx, workspaceID, knowledge)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to restore knowledge",
+			goerr.V("workspace_id", workspaceID), goerr.V("knowledge_id", id), goerr.V("revision", revision))
+	}
+	return restored, nil
+}
+
+func (uc *KnowledgeUseCase) getRevision(ctx context.Context, workspaceID string, id model.KnowledgeID, revision int) (*model.KnowledgeRevision, error) {
+	rev, err := uc.repo.Knowledge().GetRevision(ctx, workspaceID, id, revision)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get knowledge revision",
+			goerr.V("workspace_id", workspaceID), goerr.V("knowledge_id", id), goerr.V("revision", revision))
+	}
+	return rev, nil
+}
+
+// appendRevision records next as the entry's newest revision. It runs BEFORE
+// the entry itself is written: a failed entry write then leaves an extra
+// revision behind, which is recoverable, whereas the opposite order could
+// lose the record of a change that did land.
+//
+// prior is the entry as it stood before this write (nil on create). Entries
+// created before revisions existed have no history; their prior state is
+// recorded first as a baseline, attributed to the original creator at the
+// last update time, so the very first tracked edit can still be rolled back.
+func (uc *KnowledgeUseCase) appendRevision(ctx context.Context, prior, next *model.Knowledge, action model.KnowledgeRevisionAction, restoredFrom int) error {
+	workspaceID := next.WorkspaceID
+	for attempt := 1; ; attempt++ {
+		revs, err := uc.repo.Knowledge().ListRevisions(ctx, workspaceID, next.ID)
+		if err != nil {
+			return goerr.Wrap(err, "failed to list knowledge revisions",
+				goerr.V("workspace_id", workspaceID), goerr.V("knowledge_id", next.ID))
+		}
+
+		latest := 0
+		if len(revs) > 0 {
+			latest = revs[len(revs)-1].Revision
+		}
+		if latest == 0 && prior != nil {
+			baseline := model.NewKnowledgeRevision(prior, 1, model.KnowledgeRevisionCreated, prior.UpdatedAt)
+			baseline.EditorID = prior.CreatorID
+			err = uc.repo.Knowledge().CreateRevision(ctx, workspaceID, baseline)
+			if err == nil {
+				latest = 1
+			}
+		}
+
+		if err == nil {
+			rev := model.NewKnowledgeRevision(next, latest+1, action, next.UpdatedAt)
+			rev.RestoredFrom = restoredFrom
+			rev.EditorID = creatorFromContext(ctx)
+			if actor, ok := model.RunActorFromContext(ctx); ok {
+				rev.JobID = actor.JobID
+				rev.RunID = actor.RunID
+			}
+			err = uc.repo.Knowledge().CreateRevision(ctx, workspaceID, rev)
+		}
+
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, interfaces.ErrKnowledgeRevisionExists) || attempt == maxRevisionAttempts {
+			return goerr.Wrap(err, "failed to record knowledge revision",
+				goerr.V("workspace_id", workspaceID), goerr.V("knowledge_id", next.ID))
+		}
+	}
+}
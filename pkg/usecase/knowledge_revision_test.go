@@ -0,0 +1,165 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+)
+
+func TestKnowledgeUseCase_RevisionHistory(t *testing.T) {
+	repo := memory.New()
+	uc := usecase.NewKnowledgeUseCase(repo, nil)
+	tagUC := usecase.NewTagUseCase(repo)
+	ws := newWS()
+
+	userCtx := auth.ContextWithToken(context.Background(), &auth.Token{Sub: "U-HUMAN"})
+	agentCtx := model.ContextWithRunActor(context.Background(), model.RunActor{JobID: "triage", RunID: "run-1"})
+
+	opsID := createTestTag(t, userCtx, tagUC, ws, "ops")
+	npmID := createTestTag(t, userCtx, tagUC, ws, "npm")
+
+	created, err := uc.CreateKnowledge(userCtx, ws, usecase.CreateKnowledgeInput{
+		Title:  "Release policy",
+		Claim:  "line one\nline two",
+		TagIDs: []model.TagID{opsID},
+	})
+	gt.NoError(t, err).Required()
+
+	newClaim := "line one\nagent rewrote this"
+	newTags := []model.TagID{opsID, npmID}
+	_, err = uc.UpdateKnowledge(agentCtx, ws, usecase.UpdateKnowledgeInput{
+		ID:     created.ID,
+		Claim:  &newClaim,
+		TagIDs: &newTags,
+	})
+	gt.NoError(t, err).Required()
+
+	t.Run("every write is a revision attributed to its author", func(t *testing.T) {
+		revs, err := uc.ListKnowledgeRevisions(context.Background(), ws, created.ID)
+		gt.NoError(t, err).Required()
+		gt.Array(t, revs).Length(2).Required()
+
+		gt.Number(t, revs[0].Revision).Equal(1)
+		gt.Value(t, revs[0].Action).Equal(model.KnowledgeRevisionCreated)
+		gt.String(t, revs[0].EditorID).Equal("U-HUMAN")
+		gt.String(t, revs[0].JobID).Equal("")
+		gt.String(t, revs[0].Claim).Equal("line one\nline two")
+
+		gt.Number(t, revs[1].Revision).Equal(2)
+		gt.Value(t, revs[1].Action).Equal(model.KnowledgeRevisionUpdated)
+		gt.String(t, revs[1].EditorID).Equal("")
+		gt.String(t, revs[1].JobID).Equal("triage")
+		gt.String(t, revs[1].RunID).Equal("run-1")
+		gt.String(t, revs[1].Claim).Equal(newClaim)
+	})
+
+	t.Run("diff shows the changed lines and tags", func(t *testing.T) {
+		d, err := uc.DiffKnowledgeRevisions(context.Background(), ws, created.ID, 1, 2)
+		gt.NoError(t, err).Required()
+		gt.Value(t, d.Claim).Equal([]model.DiffLine{
+			{Op: model.DiffOpEqual, Text: "line one"},
+			{Op: model.DiffOpDelete, Text: "line two"},
+			{Op: model.DiffOpInsert, Text: "agent rewrote this"},
+		})
+		gt.Value(t, d.AddedTagIDs).Equal([]model.TagID{npmID})
+		gt.Array(t, d.RemovedTagIDs).Length(0)
+	})
+
+	t.Run("restore copies old content back as a new revision", func(t *testing.T) {
+		restored, err := uc.RestoreKnowledgeRevision(userCtx, ws, created.ID, 1)
+		gt.NoError(t, err).Required()
+		gt.String(t, restored.Claim).Equal("line one\nline two")
+		gt.Value(t, restored.TagIDs).Equal([]model.TagID{opsID})
+
+		got, err := uc.GetKnowledge(context.Background(), ws, created.ID)
+		gt.NoError(t, err).Required()
+		gt.String(t, got.Claim).Equal("line one\nline two")
+
+		revs, err := uc.ListKnowledgeRevisions(context.Background(), ws, created.ID)
+		gt.NoError(t, err).Required()
+		gt.Array(t, revs).Length(3).Required()
+		gt.Value(t, revs[2].Action).Equal(model.KnowledgeRevisionRestored)
+		gt.Number(t, revs[2].RestoredFrom).Equal(1)
+		gt.String(t, revs[2].EditorID).Equal("U-HUMAN")
+	})
+
+	t.Run("unknown revision fails", func(t *testing.T) {
+		_, err := uc.RestoreKnowledgeRevision(userCtx, ws, created.ID, 42)
+		gt.Error(t, err)
+		_, err = uc.DiffKnowledgeRevisions(context.Background(), ws, created.ID, 1, 42)
+		gt.Error(t, err)
+	})
+}
+
+func TestKnowledgeUseCase_RestoreWithDeletedTagFails(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.New()
+	uc := usecase.NewKnowledgeUseCase(repo, nil)
+	tagUC := usecase.NewTagUseCase(repo)
+	ws := newWS()
+
+	oldID := createTestTag(t, ctx, tagUC, ws, "old")
+	newID := createTestTag(t, ctx, tagUC, ws, "new")
+
+	created, err := uc.CreateKnowledge(ctx, ws, usecase.CreateKnowledgeInput{
+		Title: "entry", TagIDs: []model.TagID{oldID},
+	})
+	gt.NoError(t, err).Required()
+	tags := []model.TagID{newID}
+	_, err = uc.UpdateKnowledge(ctx, ws, usecase.UpdateKnowledgeInput{ID: created.ID, TagIDs: &tags})
+	gt.NoError(t, err).Required()
+	gt.NoError(t, tagUC.DeleteTag(ctx, ws, oldID)).Required()
+
+	_, err = uc.RestoreKnowledgeRevision(ctx, ws, created.ID, 1)
+	gt.Error(t, err).Is(usecase.ErrUnknownTag)
+
+	revs, err := uc.ListKnowledgeRevisions(ctx, ws, created.ID)
+	gt.NoError(t, err).Required()
+	gt.Array(t, revs).Length(2)
+}
+
+func TestKnowledgeUseCase_UpdateRecordsBaselineForUntrackedEntry(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.New()
+	uc := usecase.NewKnowledgeUseCase(repo, nil)
+	tagUC := usecase.NewTagUseCase(repo)
+	ws := newWS()
+	opsID := createTestTag(t, ctx, tagUC, ws, "ops")
+
+	// An entry written before revisions existed: persisted without history.
+	written := time.Now().Add(-24 * time.Hour).UTC().Truncate(time.Millisecond)
+	legacy := &model.Knowledge{
+		ID:          model.NewKnowledgeID(),
+		WorkspaceID: ws,
+		Title:       "curated",
+		Claim:       "human-curated claim",
+		TagIDs:      []model.TagID{opsID},
+		CreatorID:   "U-CURATOR",
+		CreatedAt:   written,
+		UpdatedAt:   written,
+	}
+	_, err := repo.Knowledge().Create(ctx, ws, legacy)
+	gt.NoError(t, err).Required()
+
+	claim := "bad agent edit"
+	_, err = uc.UpdateKnowledge(ctx, ws, usecase.UpdateKnowledgeInput{ID: legacy.ID, Claim: &claim})
+	gt.NoError(t, err).Required()
+
+	revs, err := uc.ListKnowledgeRevisions(ctx, ws, legacy.ID)
+	gt.NoError(t, err).Required()
+	gt.Array(t, revs).Length(2).Required()
+	gt.String(t, revs[0].Claim).Equal("human-curated claim")
+	gt.String(t, revs[0].EditorID).Equal("U-CURATOR")
+	gt.Bool(t, revs[0].CreatedAt.Equal(written)).True()
+	gt.String(t, revs[1].Claim).Equal("bad agent edit")
+
+	restored, err := uc.RestoreKnowledgeRevision(ctx, ws, legacy.ID, 1)
+	gt.NoError(t, err).Required()
+	gt.String(t, restored.Claim).Equal("human-curated claim")
+}
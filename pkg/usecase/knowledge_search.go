@@ -0,0 +1,204 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
+)
+
+// Hybrid knowledge search tuning. These are ranking constants, not deployment
+// configuration: changing them changes which entry an agent is handed first, so
+// they are fixed here and covered by the search tests.
+const (
+	// knowledgeVectorWeight is the share of the fused score taken by embedding
+	// similarity; the rest is the normalized BM25 score. Applied only when the
+	// query could be embedded.
+	knowledgeVectorWeight = 0.5
+	// knowledgeTitleWeight is how many times a title term counts relative to a
+	// claim term in BM25.
+	knowledgeTitleWeight = 2
+	// knowledgeRerankDepth bounds how many of the best fused results the rerank
+	// stage re-scores.
+	knowledgeRerankDepth = 50
+	// Rerank bonuses: the query appearing verbatim in the title / claim, and
+	// the fraction of distinct query terms the entry contains.
+	knowledgePhraseTitleBonus = 0.3
+	knowledgePhraseClaimBonus = 0.15
+	knowledgeCoverageBonus    = 0.2
+)
+
+// SearchKnowledge runs a hybrid search over the workspace's knowledge and
+// returns every candidate ranked, best first.
+//
+//  1. Candidates are the (optionally tag-filtered) entries, minus expired ones
+//     unless input.IncludeExpired is set.
+//  2. Each candidate gets a BM25 score over its title and claim, normalized to
+//     the best match, and — when the query and the entry both have an
+//     embedding — a cosine similarity. The two are fused with
+//     knowledgeVectorWeight. Without a query embedding (no embed client, or
+//     the embedding call failed), and for an entry without one, the lexical
+//     score stands alone.
+//  3. The top knowledgeRerankDepth results are reranked with bonuses for the
+//     query appearing verbatim and for covering every query term, so an exact
+//     hostname or ticket ID beats a merely similar entry.
+//
+// An empty query skips ranking and returns the candidates in list order with a
+// zero score.
+func (uc *KnowledgeUseCase) SearchKnowledge(ctx context.Context, workspaceID string, input SearchKnowledgeInput) ([]*model.KnowledgeSearchHit, error) {
+	items, err := uc.repo.Knowledge().List(ctx, workspaceID, interfaces.KnowledgeListOptions{TagIDs: input.TagIDs})
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list knowledge for search", goerr.V("workspace_id", workspaceID))
+	}
+
+	if !input.IncludeExpired {
+		now := time.Now()
+		items = slices.DeleteFunc(items, func(k *model.Knowledge) bool { return k.IsExpired(now) })
+	}
+
+	hits := make([]*model.KnowledgeSearchHit, len(items))
+	for i, k := range items {
+		hits[i] = &model.KnowledgeSearchHit{Knowledge: k}
+	}
+
+	query := strings.TrimSpace(input.Query)
+	if query == "" {
+		return applyLimit(hits, input.Limit), nil
+	}
+
+	queryVec, embedErr := uc.embedText(ctx, query)
+	if embedErr != nil {
+		// Non-fatal: report and search lexically rather than failing the whole
+		// query on a transient embedding outage.
+		errutil.Handle(ctx, embedErr, "failed to embed knowledge search query")
+		queryVec = nil
+	}
+
+	queryTerms := model.TokenizeSearchText(query)
+	lexical := model.BM25Scores(queryTerms, knowledgeSearchDocs(items))
+	maxLexical := 0.0
+	for _, s := range lexical {
+		maxLexical = max(maxLexical, s)
+	}
+
+	for i, h := range hits {
+		if maxLexical > 0 {
+			h.LexicalScore = lexical[i] / maxLexical
+		}
+		h.Score = h.LexicalScore
+		// Fuse only the signals the entry has: one not embedded yet would
+		// otherwise count as dissimilar and lose half its lexical score to
+		// weaker matches that happen to be embedded.
+		if len(queryVec) == 0 || len(h.Knowledge.Embedding) == 0 {
+			continue
+		}
+		vector := max(0, cosineSimilarity(queryVec, h.Knowledge.Embedding))
+		h.VectorScore = &vector
+		h.Score = knowledgeVectorWeight*vector + (1-knowledgeVectorWeight)*h.LexicalScore
+	}
+	sortKnowledgeHits(hits)
+
+	// Rerank the head. Bonuses are non-negative, so the reranked head still
+	// outscores everything below the cut.
+	head := hits[:min(len(hits), knowledgeRerankDepth)]
+	lowerQuery := strings.ToLower(query)
+	for _, h := range head {
+		h.Score += rerankBonus(lowerQuery, queryTerms, h.Knowledge)
+	}
+	sortKnowledgeHits(head)
+
+	return applyLimit(hits, input.Limit), nil
+}
+
+// knowledgeSearchDocs tokenizes each entry for BM25, repeating the title terms
+// knowledgeTitleWeight times.
+func knowledgeSearchDocs(items []*model.Knowledge) [][]string {
+	docs := make([][]string, len(items))
+	for i, k := range items {
+		title := model.TokenizeSearchText(k.Title)
+		doc := make([]string, 0, len(title)*knowledgeTitleWeight)
+		for range knowledgeTitleWeight {
+			doc = append(doc, title...)
+		}
+		docs[i] = append(doc, model.TokenizeSearchText(k.Claim)...)
+	}
+	return docs
+}
+
+// rerankBonus scores how literally k answers the query: the whole query
+// appearing in the title or claim, plus the share of distinct query terms the
+// entry contains. The verbatim check is a substring match, so it also rewards
+// a query that is a prefix of a longer term ("git" in "github").
+func rerankBonus(lowerQuery string, queryTerms []string, k *model.Knowledge) float64 {
+	var bonus float64
+	title := strings.ToLower(k.Title)
+	claim := strings.ToLower(k.Claim)
+	if strings.Contains(title, lowerQuery) {
+		bonus += knowledgePhraseTitleBonus
+	} else if strings.Contains(claim, lowerQuery) {
+		bonus += knowledgePhraseClaimBonus
+	}
+
+	distinct := make(map[string]bool, len(queryTerms))
+	for _, term := range queryTerms {
+		distinct[term] = true
+	}
+	if len(distinct) == 0 {
+		return bonus
+	}
+	entryTerms := make(map[string]bool)
+	for _, term := range model.TokenizeSearchText(k.Title + "\n" + k.Claim) {
+		entryTerms[term] = true
+	}
+	covered := 0
+	for term := range distinct {
+		if entryTerms[term] {
+			covered++
+		}
+	}
+	return bonus + knowledgeCoverageBonus*float64(covered)/float64(len(distinct))
+}
+
+// sortKnowledgeHits orders hits by score descending; ties go to the most
+// recently created entry.
+func sortKnowledgeHits(hits []*model.KnowledgeSearchHit) {
+	sort.SliceStable(hits, func(i, j int) bool {
+		if hits[i].Score == hits[j].Score {
+			return hits[i].Knowledge.CreatedAt.After(hits[j].Knowledge.CreatedAt)
+		}
+		return hits[i].Score > hits[j].Score
+	})
+}
+
+// applyLimit returns the first limit hits, or all when limit <= 0.
+func applyLimit(hits []*model.KnowledgeSearchHit, limit int) []*model.KnowledgeSearchHit {
+	if limit > 0 && len(hits) > limit {
+		return hits[:limit]
+	}
+	return hits
+}
+
+// cosineSimilarity returns the cosine similarity of two equal-length vectors.
+// Mismatched lengths or a zero-norm vector yield 0.
+func cosineSimilarity(a, b []float64) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+	var dot, na, nb float64
+	for i := range a {
+		dot += a[i] * b[i]
+		na += a[i] * a[i]
+		nb += b[i] * b[i]
+	}
+	if na == 0 || nb == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(na) * math.Sqrt(nb))
+}
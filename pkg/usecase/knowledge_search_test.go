@@ -0,0 +1,156 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+)
+
+func TestKnowledgeUseCase_SearchFindsIdentifiersEmbeddingsMiss(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.New()
+	// fakeEmbedClient only knows "github" / "secret" / "npm": a hostname or a
+	// ticket ID embeds to the zero vector, exactly the blind spot the lexical
+	// half of the search covers.
+	uc := usecase.NewKnowledgeUseCase(repo, &fakeEmbedClient{})
+	tagUC := usecase.NewTagUseCase(repo)
+	ws := newWS()
+	opsID := createTestTag(t, ctx, tagUC, ws, "ops")
+
+	host, err := uc.CreateKnowledge(ctx, ws, usecase.CreateKnowledgeInput{
+		Title: "Primary database", Claim: "db-01.prod.internal is the primary; never reboot it during business hours.",
+		TagIDs: []model.TagID{opsID},
+	})
+	gt.NoError(t, err).Required()
+	ticket, err := uc.CreateKnowledge(ctx, ws, usecase.CreateKnowledgeInput{
+		Title: "Leaked token follow-up", Claim: "Tracked in SEC-1234. Rotate the github secret.",
+		TagIDs: []model.TagID{opsID},
+	})
+	gt.NoError(t, err).Required()
+	_, err = uc.CreateKnowledge(ctx, ws, usecase.CreateKnowledgeInput{
+		Title: "GitHub policy", Claim: "github actions must pin versions", TagIDs: []model.TagID{opsID},
+	})
+	gt.NoError(t, err).Required()
+
+	cases := map[string]model.KnowledgeID{
+		"db-01.prod.internal": host.ID,
+		"db-01":               host.ID, // joiner-separated part of the hostname
+		"sec-1234":            ticket.ID,
+		"SEC-1234 status":     ticket.ID,
+	}
+	for query, want := range cases {
+		t.Run(query, func(t *testing.T) {
+			res, err := uc.SearchKnowledge(ctx, ws, usecase.SearchKnowledgeInput{Query: query})
+			gt.NoError(t, err).Required()
+			gt.Array(t, res).Length(3).Required()
+			gt.Value(t, res[0].Knowledge.ID).Equal(want)
+			gt.Bool(t, res[0].Score > res[1].Score).True()
+			gt.Number(t, res[0].LexicalScore).Equal(1)
+		})
+	}
+}
+
+func TestKnowledgeUseCase_SearchHitScores(t *testing.T) {
+	ctx := context.Background()
+	tagRepo := memory.New()
+	tagUC := usecase.NewTagUseCase(tagRepo)
+	ws := newWS()
+	opsID := createTestTag(t, ctx, tagUC, ws, "ops")
+
+	t.Run("with embeddings every hit carries a vector score", func(t *testing.T) {
+		uc := usecase.NewKnowledgeUseCase(tagRepo, &fakeEmbedClient{})
+		_, err := uc.CreateKnowledge(ctx, ws, usecase.CreateKnowledgeInput{
+			Title: "npm policy", Claim: "min release age", TagIDs: []model.TagID{opsID},
+		})
+		gt.NoError(t, err).Required()
+
+		res, err := uc.SearchKnowledge(ctx, ws, usecase.SearchKnowledgeInput{Query: "npm"})
+		gt.NoError(t, err).Required()
+		gt.Array(t, res).Length(1).Required()
+		gt.Value(t, res[0].VectorScore).NotNil().Required()
+		gt.Number(t, *res[0].VectorScore).Equal(1)
+		gt.Number(t, res[0].LexicalScore).Equal(1)
+		gt.Bool(t, res[0].Score >= 1).True()
+	})
+
+	t.Run("without an embed client the vector score is absent", func(t *testing.T) {
+		uc := usecase.NewKnowledgeUseCase(tagRepo, nil)
+		res, err := uc.SearchKnowledge(ctx, ws, usecase.SearchKnowledgeInput{Query: "npm"})
+		gt.NoError(t, err).Required()
+		gt.Array(t, res).Length(1).Required()
+		gt.Value(t, res[0].VectorScore).Nil()
+		gt.Number(t, res[0].LexicalScore).Equal(1)
+	})
+
+	t.Run("an empty query lists without scoring", func(t *testing.T) {
+		uc := usecase.NewKnowledgeUseCase(tagRepo, nil)
+		res, err := uc.SearchKnowledge(ctx, ws, usecase.SearchKnowledgeInput{Query: "  "})
+		gt.NoError(t, err).Required()
+		gt.Array(t, res).Length(1).Required()
+		gt.Number(t, res[0].Score).Equal(0)
+	})
+}
+
+// An entry saved before embeddings were configured has none. It must be
+// ranked on its lexical score alone, not as if it were dissimilar to the query.
+func TestKnowledgeUseCase_SearchUnembeddedEntryKeepsLexicalScore(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.New()
+	tagUC := usecase.NewTagUseCase(repo)
+	ws := newWS()
+	opsID := createTestTag(t, ctx, tagUC, ws, "ops")
+
+	unembedded, err := usecase.NewKnowledgeUseCase(repo, nil).CreateKnowledge(ctx, ws, usecase.CreateKnowledgeInput{
+		Title: "Lockfile review", Claim: "Every npm change needs a lockfile diff; lockfile drift breaks builds.",
+		TagIDs: []model.TagID{opsID},
+	})
+	gt.NoError(t, err).Required()
+	uc := usecase.NewKnowledgeUseCase(repo, &fakeEmbedClient{})
+	embedded, err := uc.CreateKnowledge(ctx, ws, usecase.CreateKnowledgeInput{
+		Title: "Package policy", Claim: "New npm packages wait a week before use; see the lockfile.",
+		TagIDs: []model.TagID{opsID},
+	})
+	gt.NoError(t, err).Required()
+
+	res, err := uc.SearchKnowledge(ctx, ws, usecase.SearchKnowledgeInput{Query: "npm lockfile"})
+	gt.NoError(t, err).Required()
+	gt.Array(t, res).Length(2).Required()
+
+	gt.Value(t, res[0].Knowledge.ID).Equal(unembedded.ID)
+	gt.Value(t, res[0].VectorScore).Nil()
+	gt.Number(t, res[0].LexicalScore).Equal(1)
+	gt.Bool(t, res[0].Score >= res[0].LexicalScore).True()
+
+	gt.Value(t, res[1].Knowledge.ID).Equal(embedded.ID)
+	gt.Value(t, res[1].VectorScore).NotNil().Required()
+	gt.Number(t, *res[1].VectorScore).Equal(1)
+	gt.Bool(t, res[1].LexicalScore < 1).True()
+}
+
+func TestKnowledgeUseCase_SearchJapanese(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.New()
+	uc := usecase.NewKnowledgeUseCase(repo, nil)
+	tagUC := usecase.NewTagUseCase(repo)
+	ws := newWS()
+	opsID := createTestTag(t, ctx, tagUC, ws, "ops")
+
+	hit, err := uc.CreateKnowledge(ctx, ws, usecase.CreateKnowledgeInput{
+		Title: "脆弱性診断の手順", Claim: "年に一度、外部の診断会社に依頼する。", TagIDs: []model.TagID{opsID},
+	})
+	gt.NoError(t, err).Required()
+	_, err = uc.CreateKnowledge(ctx, ws, usecase.CreateKnowledgeInput{
+		Title: "休暇申請", Claim: "人事システムから申請する。", TagIDs: []model.TagID{opsID},
+	})
+	gt.NoError(t, err).Required()
+
+	res, err := uc.SearchKnowledge(ctx, ws, usecase.SearchKnowledgeInput{Query: "脆弱性"})
+	gt.NoError(t, err).Required()
+	gt.Array(t, res).Length(2).Required()
+	gt.Value(t, res[0].Knowledge.ID).Equal(hit.ID)
+	gt.Number(t, res[1].Score).Equal(0)
+}
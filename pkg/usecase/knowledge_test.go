@@ -227,13 +227,13 @@ func TestKnowledgeUseCase_SearchSemanticRanking(t *testing.T) {
 	res, err := uc.SearchKnowledge(ctx, ws, usecase.SearchKnowledgeInput{Query: "github actions"})
 	gt.NoError(t, err).Required()
 	gt.Array(t, res).Length(3).Required()
-	gt.Value(t, res[0].ID).Equal(k1.ID) // highest cosine
+	gt.Value(t, res[0].Knowledge.ID).Equal(k1.ID) // highest cosine
 
 	// Tag pre-filter narrows the candidate set.
 	resOps, err := uc.SearchKnowledge(ctx, ws, usecase.SearchKnowledgeInput{Query: "github", TagIDs: []model.TagID{opsID}})
 	gt.NoError(t, err).Required()
 	gt.Array(t, resOps).Length(2).Required()
-	gt.Value(t, resOps[0].ID).Equal(k1.ID)
+	gt.Value(t, resOps[0].Knowledge.ID).Equal(k1.ID)
 
 	// Limit caps results.
 	resLimited, err := uc.SearchKnowledge(ctx, ws, usecase.SearchKnowledgeInput{Query: "github", Limit: 1})
@@ -241,7 +241,7 @@ func TestKnowledgeUseCase_SearchSemanticRanking(t *testing.T) {
 	gt.Array(t, resLimited).Length(1)
 }
 
-func TestKnowledgeUseCase_SearchSubstringFallback(t *testing.T) {
+func TestKnowledgeUseCase_SearchLexicalWithoutEmbedding(t *testing.T) {
 	ctx := context.Background()
 	repo := memory.New()
 	uc := usecase.NewKnowledgeUseCase(repo, nil) // no embed client
@@ -258,7 +258,7 @@ func TestKnowledgeUseCase_SearchSubstringFallback(t *testing.T) {
 	res, err := uc.SearchKnowledge(ctx, ws, usecase.SearchKnowledgeInput{Query: "github"})
 	gt.NoError(t, err).Required()
 	gt.Array(t, res).Length(2).Required()
-	gt.Value(t, res[0].ID).Equal(hit.ID) // substring match on title ranks first
+	gt.Value(t, res[0].Knowledge.ID).Equal(hit.ID) // title term match ranks first
 }
 
 func TestKnowledgeUseCase_Delete(t *testing.T) {
@@ -35,8 +35,8 @@ func NewKnowledgeToolMutator(uc *KnowledgeUseCase, tagUC *TagUseCase) knowledget
 	return &knowledgeToolAdapter{uc: uc, tagUC: tagUC}
 }
 
-func (a *knowledgeToolAdapter) SearchKnowledge(ctx context.Context, workspaceID, query string, tagIDs []model.TagID, limit int) ([]*model.Knowledge, error) {
-	return a.uc.SearchKnowledge(ctx, workspaceID, SearchKnowledgeInput{Query: query, TagIDs: tagIDs, Limit: limit})
+func (a *knowledgeToolAdapter) SearchKnowledge(ctx context.Context, workspaceID, query string, tagIDs []model.TagID, limit int, includeExpired bool) ([]*model.KnowledgeSearchHit, error) {
+	return a.uc.SearchKnowledge(ctx, workspaceID, SearchKnowledgeInput{Query: query, TagIDs: tagIDs, Limit: limit, IncludeExpired: includeExpired})
 }
 
 func (a *knowledgeToolAdapter) GetKnowledge(ctx context.Context, workspaceID string, id model.KnowledgeID) (*model.Knowledge, error) {
@@ -0,0 +1,328 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	goslack "github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+	"github.com/secmon-lab/hecatoncheires/pkg/i18n"
+	"github.com/secmon-lab/hecatoncheires/pkg/service/slack"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
+)
+
+// unfurlMaxFields caps the fields of a card at Slack's limit for one section.
+const unfurlMaxFields = 10
+
+// unfurlComposerChannel is the channel of a link_shared event sent while the
+// link is still being typed. Unfurling it needs the event's unfurl_id, which
+// the Slack client library does not decode, so such links are unfurled once
+// the message is posted instead.
+const unfurlComposerChannel = "COMPOSER"
+
+// LinkUnfurlUseCase unfurls the Web UI links of Cases and Actions posted in
+// Slack into a compact card: title, status, board status, assignees and the
+// select fields of a Case; title, status, assignee, due date and Case of an
+// Action.
+//
+// A private Case is shown only to whom may see it: the user who shared the
+// link must pass the same access check as a Case write (assertCaseWriteAccess),
+// and since the card is visible to everyone in the channel, the link must be
+// shared in the Case's own channel. Otherwise, and for the Actions of such a
+// Case, the card says the Case is restricted and shows nothing of it.
+type LinkUnfurlUseCase struct {
+	repo         interfaces.Repository
+	registry     *model.WorkspaceRegistry
+	slackService slack.Service
+	baseURL      string
+}
+
+// NewLinkUnfurlUseCase constructs a LinkUnfurlUseCase. Without a base URL no
+// link is recognized and link_shared events are ignored.
+func NewLinkUnfurlUseCase(repo interfaces.Repository, registry *model.WorkspaceRegistry, slackService slack.Service, baseURL string) *LinkUnfurlUseCase {
+	return &LinkUnfurlUseCase{
+		repo:         repo,
+		registry:     registry,
+		slackService: slackService,
+		baseURL:      strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// webLink is a Case or Action page of the Web UI. ActionID is 0 for a Case.
+type webLink struct {
+	WorkspaceID string
+	CaseID      int64
+	ActionID    int64
+}
+
+// HandleLinkShared unfurls the Case and Action links of a posted message.
+// Links that are not Web UI pages of a known workspace, or name a Case or
+// Action that does not exist, are left to Slack's default rendering. So is a
+// link whose Case or Action cannot be read, but that failure is reported.
+func (uc *LinkUnfurlUseCase) HandleLinkShared(ctx context.Context, ev *slackevents.LinkSharedEvent) error {
+	if uc.baseURL == "" || uc.slackService == nil || ev.Channel == "" || ev.Channel == unfurlComposerChannel {
+		return nil
+	}
+
+	unfurls := make(map[string]goslack.Attachment)
+	for _, shared := range ev.Links {
+		link, ok := parseWebLink(uc.baseURL, shared.URL)
+		if !ok {
+			continue
+		}
+		if _, err := uc.registry.Get(link.WorkspaceID); err != nil {
+			continue
+		}
+		att, ok, err := uc.unfurl(ctx, link, ev.User, ev.Channel)
+		if err != nil {
+			errutil.Handle(ctx, err, "failed to build link unfurl")
+			continue
+		}
+		if ok {
+			unfurls[shared.URL] = att
+		}
+	}
+	if len(unfurls) == 0 {
+		return nil
+	}
+
+	if err := uc.slackService.UnfurlMessage(ctx, ev.Channel, ev.MessageTimeStamp, unfurls); err != nil {
+		return goerr.Wrap(err, "failed to unfurl case links",
+			goerr.V("channel_id", ev.Channel), goerr.V("message_ts", ev.MessageTimeStamp))
+	}
+	return nil
+}
+
+// parseWebLink recognizes raw as a Case page (<base>/ws/<ws>/cases/<id>) or an
+// Action page (<base>/ws/<ws>/cases/<id>/actions/<id>) of the Web UI at
+// baseURL. The scheme, query and fragment are not compared.
+func parseWebLink(baseURL, raw string) (webLink, bool) {
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return webLink{}, false
+	}
+	u, err := url.Parse(raw)
+	if err != nil || !strings.EqualFold(u.Host, base.Host) {
+		return webLink{}, false
+	}
+	rest, ok := strings.CutPrefix(u.Path, strings.TrimSuffix(base.Path, "/")+"/")
+	if !ok {
+		return webLink{}, false
+	}
+
+	parts := strings.Split(strings.TrimSuffix(rest, "/"), "/")
+	if (len(parts) != 4 && len(parts) != 6) || parts[0] != "ws" || parts[1] == "" || parts[2] != "cases" {
+		return webLink{}, false
+	}
+	link := webLink{WorkspaceID: parts[1]}
+	if link.CaseID, err = strconv.ParseInt(parts[3], 10, 64); err != nil || link.CaseID <= 0 {
+		return webLink{}, false
+	}
+	if len(parts) == 6 {
+		if parts[4] != "actions" {
+			return webLink{}, false
+		}
+		if link.ActionID, err = strconv.ParseInt(parts[5], 10, 64); err != nil || link.ActionID <= 0 {
+			return webLink{}, false
+		}
+	}
+	return link, true
+}
+
+// unfurl builds the card of link as shared by userID in channelID. ok is false
+// when the Case or Action does not exist; any other repository failure is
+// returned so that an outage is reported rather than looking like a dead link.
+func (uc *LinkUnfurlUseCase) unfurl(ctx context.Context, link webLink, userID, channelID string) (goslack.Attachment, bool, error) {
+	c, err := uc.repo.Case().Get(ctx, link.WorkspaceID, link.CaseID)
+	if err != nil {
+		if isRepoNotFound(err) {
+			return goslack.Attachment{}, false, nil
+		}
+		return goslack.Attachment{}, false, goerr.Wrap(err, "failed to get case",
+			goerr.V("workspace_id", link.WorkspaceID), goerr.V(CaseIDKey, link.CaseID))
+	}
+	if c == nil {
+		return goslack.Attachment{}, false, nil
+	}
+	entry, err := uc.registry.Get(link.WorkspaceID)
+	if err != nil {
+		return goslack.Attachment{}, false, goerr.Wrap(err, "failed to get workspace", goerr.V("workspace_id", link.WorkspaceID))
+	}
+
+	var a *model.Action
+	if link.ActionID != 0 {
+		a, err = uc.repo.Action().Get(ctx, link.WorkspaceID, link.ActionID)
+		if err != nil {
+			if isRepoNotFound(err) {
+				return goslack.Attachment{}, false, nil
+			}
+			return goslack.Attachment{}, false, goerr.Wrap(err, "failed to get action",
+				goerr.V("workspace_id", link.WorkspaceID), goerr.V(ActionIDKey, link.ActionID))
+		}
+		if a == nil || a.CaseID != c.ID {
+			return goslack.Attachment{}, false, nil
+		}
+	}
+
+	if !canUnfurlCase(c, userID, channelID) {
+		return uc.restrictedAttachment(ctx, entry, c), true, nil
+	}
+	if a != nil {
+		return uc.actionAttachment(ctx, entry, c, a), true, nil
+	}
+	return uc.caseAttachment(ctx, entry, c), true, nil
+}
+
+// canUnfurlCase reports whether c's details may be shown in channelID where
+// userID shared its link. A public Case always may; a private one only when
+// userID has access to it and the channel is the Case's own, whose members
+// are the ones with access.
+func canUnfurlCase(c *model.Case, userID, channelID string) bool {
+	if !c.IsPrivate {
+		return true
+	}
+	if userID == "" || assertCaseWriteAccess(c, userID, true) != nil {
+		return false
+	}
+	return c.SlackChannelID != "" && c.SlackChannelID == channelID
+}
+
+func (uc *LinkUnfurlUseCase) caseAttachment(ctx context.Context, entry *model.WorkspaceEntry, c *model.Case) goslack.Attachment {
+	title := fmt.Sprintf("*<%s|#%d %s>*", buildCaseWebURL(uc.baseURL, entry.Workspace.ID, c.ID), c.ID, slackTextEscaper.Replace(c.Title))
+
+	fields := []*goslack.TextBlockObject{
+		unfurlField(i18n.T(ctx, i18n.MsgUnfurlStatus), caseLifecycleLabel(ctx, c)),
+	}
+	color := ""
+	if status := renderBoardStatus(c, entry); status != "" {
+		fields = append(fields, unfurlField(i18n.T(ctx, i18n.MsgUnfurlBoardStatus), slackTextEscaper.Replace(status)))
+		if entry.CaseStatusSet != nil {
+			if def, ok := entry.CaseStatusSet.Get(c.BoardStatus); ok {
+				color = def.SlackColor()
+			}
+		}
+	}
+	fields = append(fields, unfurlField(i18n.T(ctx, i18n.MsgUnfurlAssignees), unfurlAssignees(ctx, c.AssigneeIDs...)))
+
+	// Only select fields are shown: they are the short, categorizing values
+	// (severity, category) a glance at the card is for.
+	if entry.FieldSchema != nil {
+		for _, def := range entry.FieldSchema.Fields {
+			if len(fields) == unfurlMaxFields {
+				break
+			}
+			if def.Type != types.FieldTypeSelect && def.Type != types.FieldTypeMultiSelect {
+				continue
+			}
+			fv, ok := c.FieldValues[def.ID]
+			if !ok {
+				continue
+			}
+			if value := renderFieldValue(def, fv); value != "" {
+				fields = append(fields, unfurlField(slackTextEscaper.Replace(def.Name), slackTextEscaper.Replace(value)))
+			}
+		}
+	}
+
+	return goslack.Attachment{
+		Color:    color,
+		Fallback: fmt.Sprintf("#%d %s", c.ID, c.Title),
+		Blocks: goslack.Blocks{BlockSet: []goslack.Block{
+			goslack.NewSectionBlock(goslack.NewTextBlockObject(goslack.MarkdownType, title, false, false), fields, nil),
+			unfurlContext(entry, c),
+		}},
+	}
+}
+
+func (uc *LinkUnfurlUseCase) actionAttachment(ctx context.Context, entry *model.WorkspaceEntry, c *model.Case, a *model.Action) goslack.Attachment {
+	title := fmt.Sprintf("*<%s|%s>*", buildActionWebURL(uc.baseURL, entry.Workspace.ID, c.ID, a.ID), slackTextEscaper.Replace(a.Title))
+
+	statusSet := resolveActionStatusSet(uc.registry, entry.Workspace.ID)
+	status := string(a.Status)
+	color := ""
+	if def, ok := statusSet.Get(string(a.Status)); ok {
+		status = def.Name
+		color = def.SlackColor()
+	}
+	fields := []*goslack.TextBlockObject{
+		unfurlField(i18n.T(ctx, i18n.MsgUnfurlStatus), statusSet.Emoji(string(a.Status))+" "+slackTextEscaper.Replace(status)),
+		unfurlField(i18n.T(ctx, i18n.MsgUnfurlAssignee), unfurlAssignees(ctx, a.AssigneeID)),
+	}
+	if a.DueDate != nil {
+		fields = append(fields, unfurlField(i18n.T(ctx, i18n.MsgUnfurlDueDate), a.DueDate.UTC().Format(time.DateOnly)))
+	}
+	fields = append(fields, unfurlField(i18n.T(ctx, i18n.MsgUnfurlCase),
+		fmt.Sprintf("<%s|#%d %s>", buildCaseWebURL(uc.baseURL, entry.Workspace.ID, c.ID), c.ID, slackTextEscaper.Replace(c.Title))))
+
+	return goslack.Attachment{
+		Color:    color,
+		Fallback: a.Title,
+		Blocks: goslack.Blocks{BlockSet: []goslack.Block{
+			goslack.NewSectionBlock(goslack.NewTextBlockObject(goslack.MarkdownType, title, false, false), fields, nil),
+			unfurlContext(entry, c),
+		}},
+	}
+}
+
+// restrictedAttachment is the card of a private Case, or of one of its
+// Actions, that may not be shown: the Case number and nothing else.
+func (uc *LinkUnfurlUseCase) restrictedAttachment(ctx context.Context, entry *model.WorkspaceEntry, c *model.Case) goslack.Attachment {
+	text := fmt.Sprintf("*#%d*\n%s", c.ID, i18n.T(ctx, i18n.MsgUnfurlRestricted))
+	return goslack.Attachment{
+		Fallback: fmt.Sprintf("#%d", c.ID),
+		Blocks: goslack.Blocks{BlockSet: []goslack.Block{
+			goslack.NewSectionBlock(goslack.NewTextBlockObject(goslack.MarkdownType, text, false, false), nil, nil),
+			goslack.NewContextBlock("", goslack.NewTextBlockObject(goslack.MarkdownType,
+				slackTextEscaper.Replace(workspaceLabel(entry.Workspace)), false, false)),
+		}},
+	}
+}
+
+func caseLifecycleLabel(ctx context.Context, c *model.Case) string {
+	switch {
+	case c.MergedInto != 0:
+		return i18n.T(ctx, i18n.MsgUnfurlCaseMerged, c.MergedInto)
+	case c.IsDraft():
+		return i18n.T(ctx, i18n.MsgUnfurlCaseDraft)
+	case c.Status.Normalize() == types.CaseStatusClosed:
+		return i18n.T(ctx, i18n.MsgUnfurlCaseClosed)
+	default:
+		return i18n.T(ctx, i18n.MsgUnfurlCaseOpen)
+	}
+}
+
+func unfurlAssignees(ctx context.Context, userIDs ...string) string {
+	mentions := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		if id != "" {
+			mentions = append(mentions, mentionUser(id))
+		}
+	}
+	if len(mentions) == 0 {
+		return i18n.T(ctx, i18n.MsgUnfurlUnassigned)
+	}
+	return strings.Join(mentions, ", ")
+}
+
+func unfurlField(label, value string) *goslack.TextBlockObject {
+	return goslack.NewTextBlockObject(goslack.MarkdownType, fmt.Sprintf("*%s*\n%s", label, value), false, false)
+}
+
+// unfurlContext is the card's footer: the workspace, and the Case's channel
+// when it has one of its own.
+func unfurlContext(entry *model.WorkspaceEntry, c *model.Case) goslack.Block {
+	parts := []string{slackTextEscaper.Replace(workspaceLabel(entry.Workspace))}
+	if c.SlackChannelID != "" && !c.IsThreadBound() {
+		parts = append(parts, fmt.Sprintf("<#%s>", c.SlackChannelID))
+	}
+	return goslack.NewContextBlock("", goslack.NewTextBlockObject(goslack.MarkdownType, strings.Join(parts, " · "), false, false))
+}
@@ -0,0 +1,230 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/m-mizutani/gt"
+	goslack "github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/config"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+	"github.com/secmon-lab/hecatoncheires/pkg/i18n"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/logging"
+)
+
+type unfurlCall struct {
+	channelID string
+	messageTS string
+	unfurls   map[string]string // URL -> JSON of the attachment
+}
+
+func TestLinkUnfurlUseCase_HandleLinkShared(t *testing.T) {
+	i18n.Init(i18n.LangEN)
+	repo := memory.New()
+	registry := model.NewWorkspaceRegistry()
+	registry.Register(&model.WorkspaceEntry{
+		Workspace: model.Workspace{ID: "ws-1", Name: "Security"},
+		FieldSchema: &config.FieldSchema{Fields: []config.FieldDefinition{
+			{ID: "severity", Name: "Severity", Type: types.FieldTypeSelect, Options: []config.FieldOption{
+				{ID: "high", Name: "High"}, {ID: "low", Name: "Low"},
+			}},
+			{ID: "notes", Name: "Notes", Type: types.FieldTypeText},
+		}},
+	})
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	public, err := repo.Case().Create(ctx, "ws-1", &model.Case{
+		Title: "Phishing wave", Status: types.CaseStatusOpen, ReporterID: "U-REP",
+		AssigneeIDs: []string{"U-ALICE"}, SlackChannelID: "C-PUBLIC", CreatedAt: now, UpdatedAt: now,
+		FieldValues: map[string]model.FieldValue{
+			"severity": {FieldID: "severity", Type: types.FieldTypeSelect, Value: "high"},
+			"notes":    {FieldID: "notes", Type: types.FieldTypeText, Value: "free text"},
+		},
+	})
+	gt.NoError(t, err).Required()
+	due := time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)
+	action, err := repo.Action().Create(ctx, "ws-1", &model.Action{
+		CaseID: public.ID, Title: "Block sender", AssigneeID: "U-BOB",
+		Status: types.ActionStatus("IN_PROGRESS"), DueDate: &due, CreatedAt: now, UpdatedAt: now,
+	})
+	gt.NoError(t, err).Required()
+	private, err := repo.Case().Create(ctx, "ws-1", &model.Case{
+		Title: "Insider leak", Status: types.CaseStatusOpen, ReporterID: "U-REP", IsPrivate: true,
+		SlackChannelID: "C-PRIVATE", ChannelUserIDs: []string{"U-MEMBER"}, CreatedAt: now, UpdatedAt: now,
+	})
+	gt.NoError(t, err).Required()
+
+	var calls []unfurlCall
+	mock := &mockSlackService{
+		unfurlMessageFn: func(_ context.Context, channelID, messageTS string, unfurls map[string]goslack.Attachment) error {
+			call := unfurlCall{channelID: channelID, messageTS: messageTS, unfurls: make(map[string]string)}
+			for u, att := range unfurls {
+				raw, err := json.Marshal(att)
+				gt.NoError(t, err).Required()
+				call.unfurls[u] = string(raw)
+			}
+			calls = append(calls, call)
+			return nil
+		},
+	}
+	uc := usecase.NewLinkUnfurlUseCase(repo, registry, mock, "https://hc.example/")
+
+	share := func(t *testing.T, user, channel string, urls ...string) *unfurlCall {
+		t.Helper()
+		calls = nil
+		ev := &slackevents.LinkSharedEvent{User: user, Channel: channel, MessageTimeStamp: "1700000000.000100"}
+		for _, u := range urls {
+			ev.Links = append(ev.Links, slackevents.SharedLinks{Domain: "hc.example", URL: u})
+		}
+		gt.NoError(t, uc.HandleLinkShared(ctx, ev)).Required()
+		if len(calls) == 0 {
+			return nil
+		}
+		gt.A(t, calls).Length(1).Required()
+		return &calls[0]
+	}
+	caseURL := func(id int64) string { return fmt.Sprintf("https://hc.example/ws/ws-1/cases/%d", id) }
+
+	t.Run("unfurls a case with its status, assignees and select fields", func(t *testing.T) {
+		u := caseURL(public.ID) + "?tab=actions"
+		call := share(t, "U-ANYONE", "C-OTHER", u)
+		gt.Value(t, call).NotNil().Required()
+		gt.Value(t, call.channelID).Equal("C-OTHER")
+		gt.Value(t, call.messageTS).Equal("1700000000.000100")
+
+		card := call.unfurls[u]
+		gt.String(t, card).Contains(fmt.Sprintf("#%d Phishing wave", public.ID))
+		gt.String(t, card).Contains(`*Status*\nOpen`)
+		gt.String(t, card).Contains("\\u003c@U-ALICE\\u003e")
+		gt.String(t, card).Contains(`*Severity*\nHigh`)
+		gt.String(t, card).NotContains("free text")
+		gt.String(t, card).Contains("Security")
+	})
+
+	t.Run("unfurls an action with its status, due date and case", func(t *testing.T) {
+		u := fmt.Sprintf("%s/actions/%d", caseURL(public.ID), action.ID)
+		call := share(t, "U-ANYONE", "C-OTHER", u)
+		gt.Value(t, call).NotNil().Required()
+
+		card := call.unfurls[u]
+		gt.String(t, card).Contains("Block sender")
+		gt.String(t, card).Contains("In Progress")
+		gt.String(t, card).Contains("\\u003c@U-BOB\\u003e")
+		gt.String(t, card).Contains("2026-10-20")
+		gt.String(t, card).Contains(fmt.Sprintf("#%d Phishing wave", public.ID))
+	})
+
+	t.Run("a private case is restricted for a non-member", func(t *testing.T) {
+		u := caseURL(private.ID)
+		call := share(t, "U-OUTSIDER", "C-PRIVATE", u)
+		gt.Value(t, call).NotNil().Required()
+		gt.String(t, call.unfurls[u]).Contains("Private case")
+		gt.String(t, call.unfurls[u]).NotContains("Insider leak")
+	})
+
+	t.Run("a private case is restricted outside its own channel", func(t *testing.T) {
+		u := caseURL(private.ID)
+		call := share(t, "U-MEMBER", "C-OTHER", u)
+		gt.Value(t, call).NotNil().Required()
+		gt.String(t, call.unfurls[u]).NotContains("Insider leak")
+	})
+
+	t.Run("a private case unfurls for a member in its channel", func(t *testing.T) {
+		u := caseURL(private.ID)
+		call := share(t, "U-MEMBER", "C-PRIVATE", u)
+		gt.Value(t, call).NotNil().Required()
+		gt.String(t, call.unfurls[u]).Contains("Insider leak")
+	})
+
+	t.Run("ignores links that are not case pages", func(t *testing.T) {
+		call := share(t, "U-ANYONE", "C-OTHER",
+			"https://hc.example/ws/ws-1/knowledge",
+			"https://hc.example/ws/ws-unknown/cases/1",
+			caseURL(9999),
+			fmt.Sprintf("https://other.example/ws/ws-1/cases/%d", public.ID),
+		)
+		gt.Value(t, call).Nil()
+	})
+
+	t.Run("ignores links typed in the composer", func(t *testing.T) {
+		gt.Value(t, share(t, "U-ANYONE", "COMPOSER", caseURL(public.ID))).Nil()
+	})
+}
+
+// unfurlFailingRepo fails every Case().Get, standing in for a backend outage.
+type unfurlFailingRepo struct {
+	interfaces.Repository
+	err error
+}
+
+func (r *unfurlFailingRepo) Case() interfaces.CaseRepository {
+	return &unfurlFailingCaseRepo{CaseRepository: r.Repository.Case(), err: r.err}
+}
+
+type unfurlFailingCaseRepo struct {
+	interfaces.CaseRepository
+	err error
+}
+
+func (r *unfurlFailingCaseRepo) Get(context.Context, string, int64) (*model.Case, error) {
+	return nil, r.err
+}
+
+// A repository failure must be reported, not treated as a link to a Case that
+// does not exist; only a real not-found stays silent.
+func TestLinkUnfurlUseCase_HandleLinkShared_ReportsRepositoryFailure(t *testing.T) {
+	i18n.Init(i18n.LangEN)
+	registry := model.NewWorkspaceRegistry()
+	registry.Register(&model.WorkspaceEntry{Workspace: model.Workspace{ID: "ws-1", Name: "Security"}})
+
+	for _, tc := range []struct {
+		name     string
+		err      error
+		reported bool
+	}{
+		{name: "outage", err: errors.New("connection refused"), reported: true},
+		{name: "not found", err: goerr.Wrap(memory.ErrNotFound, "case not found")},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var logged bytes.Buffer
+			ctx := logging.With(context.Background(), logging.New(&logged, slog.LevelInfo, logging.FormatJSON, false))
+
+			unfurled := false
+			mock := &mockSlackService{
+				unfurlMessageFn: func(context.Context, string, string, map[string]goslack.Attachment) error {
+					unfurled = true
+					return nil
+				},
+			}
+			repo := &unfurlFailingRepo{Repository: memory.New(), err: tc.err}
+			uc := usecase.NewLinkUnfurlUseCase(repo, registry, mock, "https://hc.example/")
+
+			gt.NoError(t, uc.HandleLinkShared(ctx, &slackevents.LinkSharedEvent{
+				User: "U-ANYONE", Channel: "C-OTHER", MessageTimeStamp: "1700000000.000100",
+				Links: []slackevents.SharedLinks{{Domain: "hc.example", URL: "https://hc.example/ws/ws-1/cases/1"}},
+			})).Required()
+			gt.Bool(t, unfurled).False()
+
+			if tc.reported {
+				gt.String(t, logged.String()).Contains("failed to build link unfurl")
+				gt.String(t, logged.String()).Contains("connection refused")
+			} else {
+				gt.String(t, logged.String()).NotContains("failed to build link unfurl")
+			}
+		})
+	}
+}
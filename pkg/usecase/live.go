@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
+)
+
+// LiveUseCase turns the live event bus into the streams behind the GraphQL
+// subscriptions. Events only say what changed; each one is re-read through
+// the same usecase method a query would use, with the subscriber's token, so
+// a subscription never shows more than polling would.
+//
+// Delivery is best-effort: an event dropped for a slow subscriber or lost in
+// the relay is not retried, and a client that must not miss a change re-reads
+// after reconnecting.
+type LiveUseCase struct {
+	bus      interfaces.LiveEventBus
+	caseUC   *CaseUseCase
+	actionUC *ActionUseCase
+	jobRunUC *JobRunUseCase
+}
+
+// NewLiveUseCase constructs a LiveUseCase.
+func NewLiveUseCase(bus interfaces.LiveEventBus, caseUC *CaseUseCase, actionUC *ActionUseCase, jobRunUC *JobRunUseCase) *LiveUseCase {
+	return &LiveUseCase{bus: bus, caseUC: caseUC, actionUC: actionUC, jobRunUC: jobRunUC}
+}
+
+// CaseChange is one change to a case. Case is the case as the subscriber may
+// see it, and nil when it was deleted.
+type CaseChange struct {
+	Change model.LiveChange
+	CaseID int64
+	Case   *model.Case
+}
+
+// ActionChange is one change to an action. Action is nil when it was
+// deleted.
+type ActionChange struct {
+	Change   model.LiveChange
+	ActionID int64
+	Action   *model.Action
+}
+
+// SubscribeCases streams the changes to the workspace's cases until ctx is
+// done. A private case the subscriber is not a member of arrives restricted,
+// as it does in a query; a private draft of someone else's is not sent.
+func (uc *LiveUseCase) SubscribeCases(ctx context.Context, workspaceID string) (<-chan *CaseChange, error) {
+	if workspaceID == "" {
+		return nil, goerr.Wrap(ErrInvalidArgument, "workspace id is empty")
+	}
+
+	events := uc.bus.Subscribe(ctx, model.CaseLiveTopic(workspaceID))
+	return relayChanges(ctx, events, func(ev *model.LiveEvent) *CaseChange {
+		change := &CaseChange{Change: ev.Change(), CaseID: ev.CaseID}
+		if change.Change == model.LiveChangeDeleted {
+			return change
+		}
+		c, err := uc.caseUC.GetCase(ctx, workspaceID, ev.CaseID)
+		if err != nil {
+			// Deleted again since the event, or a private draft hidden from
+			// this subscriber.
+			if !errors.Is(err, ErrCaseNotFound) {
+				errutil.Handle(ctx, err, "failed to read case for subscription")
+			}
+			return nil
+		}
+		change.Case = c
+		return change
+	}), nil
+}
+
+// SubscribeActions streams the changes to a case's actions until ctx is done.
+// The subscriber must be able to read the case.
+func (uc *LiveUseCase) SubscribeActions(ctx context.Context, workspaceID string, caseID int64) (<-chan *ActionChange, error) {
+	if workspaceID == "" {
+		return nil, goerr.Wrap(ErrInvalidArgument, "workspace id is empty")
+	}
+	c, err := uc.caseUC.GetCase(ctx, workspaceID, caseID)
+	if err != nil {
+		return nil, err
+	}
+	if c.AccessDenied {
+		return nil, goerr.Wrap(ErrAccessDenied, "cannot watch actions of private case",
+			goerr.V(CaseIDKey, caseID))
+	}
+
+	events := uc.bus.Subscribe(ctx, model.ActionLiveTopic(workspaceID, caseID))
+	return relayChanges(ctx, events, func(ev *model.LiveEvent) *ActionChange {
+		change := &ActionChange{Change: ev.Change(), ActionID: ev.ActionID}
+		if change.Change == model.LiveChangeDeleted {
+			return change
+		}
+		a, err := uc.actionUC.GetAction(ctx, workspaceID, ev.ActionID)
+		if err != nil {
+			if !errors.Is(err, ErrActionNotFound) {
+				errutil.Handle(ctx, err, "failed to read action for subscription")
+			}
+			return nil
+		}
+		change.Action = a
+		return change
+	}), nil
+}
+
+// SubscribeJobRunEvents streams the events appended to a job run, in
+// Sequence order, until ctx is done. With afterSequence set, the events
+// already recorded after it are sent first, so a client that has rendered the
+// timeline up to some event can resume from there; without it only new events
+// are sent.
+func (uc *LiveUseCase) SubscribeJobRunEvents(ctx context.Context, workspaceID string, caseID int64, runID string, afterSequence *int64) (<-chan *model.JobRunEvent, error) {
+	// Subscribe before the first read so an event appended in between is
+	// not missed.
+	subCtx, cancel := context.WithCancel(ctx)
+	notifications := uc.bus.Subscribe(subCtx, model.JobRunLiveTopic(workspaceID, caseID, runID))
+
+	// The first read also checks the caller may read the case and that the
+	// run exists. A resuming client needs only the events past its
+	// afterSequence; a fresh one needs the whole timeline read once, for the
+	// sequence to stream from.
+	var cursor int64
+	var backlog []*model.JobRunEvent
+	if afterSequence != nil {
+		cursor = *afterSequence
+		events, err := uc.jobRunUC.ListEventsAfter(ctx, workspaceID, caseID, runID, cursor)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		backlog = events
+	} else {
+		existing, err := uc.jobRunUC.ListEvents(ctx, workspaceID, caseID, runID)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		if len(existing) > 0 {
+			cursor = existing[len(existing)-1].Sequence
+		}
+	}
+
+	out := make(chan *model.JobRunEvent)
+	go func() {
+		defer cancel()
+		defer close(out)
+
+		send := func(events []*model.JobRunEvent) bool {
+			for _, ev := range events {
+				select {
+				case out <- ev:
+					cursor = ev.Sequence
+				case <-ctx.Done():
+					return false
+				}
+			}
+			return true
+		}
+
+		if !send(backlog) {
+			return
+		}
+		for n := range notifications {
+			if n.Sequence <= cursor {
+				continue
+			}
+			// A burst of appends needs one read, not one per event.
+			drainPending(notifications)
+			events, err := uc.jobRunUC.ListEventsAfter(ctx, workspaceID, caseID, runID, cursor)
+			if err != nil {
+				if ctx.Err() == nil {
+					errutil.Handle(ctx, err, "failed to read job run events for subscription")
+				}
+				continue
+			}
+			if !send(events) {
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// relayChanges forwards each event, converted by convert, until events is
+// closed or ctx is done. Events convert maps to nil are skipped.
+func relayChanges[T any](ctx context.Context, events <-chan *model.LiveEvent, convert func(*model.LiveEvent) *T) <-chan *T {
+	out := make(chan *T)
+	go func() {
+		defer close(out)
+		for ev := range events {
+			change := convert(ev)
+			if change == nil {
+				continue
+			}
+			select {
+			case out <- change:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// drainPending discards the notifications already queued on ch.
+func drainPending(ch <-chan *model.LiveEvent) {
+	for {
+		select {
+		case _, ok := <-ch:
+			if !ok {
+				return
+			}
+		default:
+			return
+		}
+	}
+}
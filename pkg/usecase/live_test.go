@@ -0,0 +1,243 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/service/livebus"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+)
+
+func receiveLive[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+	select {
+	case v, ok := <-ch:
+		gt.Bool(t, ok).True().Required()
+		return v
+	case <-time.After(time.Second):
+		t.Fatal("no subscription event received")
+		var zero T
+		return zero
+	}
+}
+
+func setupLive(t *testing.T) (interfaces.Repository, *usecase.UseCases, string) {
+	t.Helper()
+	bus := livebus.New()
+	repo := livebus.PublishingRepository(memory.New(), bus)
+	return repo, usecase.New(repo, nil, usecase.WithLiveEventBus(bus)), fmt.Sprintf("ws-%d", time.Now().UnixNano())
+}
+
+func TestLiveUseCase_SubscribeCases(t *testing.T) {
+	_, uc, ws := setupLive(t)
+	ctx, cancel := context.WithCancel(auth.ContextWithToken(context.Background(), &auth.Token{Sub: "UREPORTER"}))
+	defer cancel()
+
+	changes, err := uc.Live.SubscribeCases(ctx, ws)
+	gt.NoError(t, err).Required()
+
+	c, err := uc.Case.CreateCase(ctx, ws, "phishing", "", nil, nil, false, false, "", "")
+	gt.NoError(t, err).Required()
+	change := receiveLive(t, changes)
+	gt.Value(t, change.Change).Equal(model.LiveChangeCreated)
+	gt.Value(t, change.Case.Title).Equal("phishing")
+
+	gt.NoError(t, uc.Case.DeleteCase(ctx, ws, c.ID)).Required()
+	change = receiveLive(t, changes)
+	gt.Value(t, change.Change).Equal(model.LiveChangeDeleted)
+	gt.Value(t, change.CaseID).Equal(c.ID)
+	gt.Value(t, change.Case).Nil()
+
+	cancel()
+	select {
+	case _, ok := <-changes:
+		gt.Bool(t, ok).False()
+	case <-time.After(time.Second):
+		t.Fatal("subscription was not closed")
+	}
+}
+
+// A merge writes below the usecases it would otherwise publish through: its
+// actions, memos and job runs are moved and the source is closed. Watchers of
+// both cases must see it.
+func TestLiveUseCase_SubscribeMerge(t *testing.T) {
+	repo, uc, ws := setupLive(t)
+	ctx, cancel := context.WithCancel(auth.ContextWithToken(context.Background(), &auth.Token{Sub: "UREPORTER"}))
+	defer cancel()
+
+	target, err := uc.Case.CreateCase(ctx, ws, "phishing wave", "", nil, nil, false, false, "", "")
+	gt.NoError(t, err).Required()
+	source, err := uc.Case.CreateCase(ctx, ws, "suspicious mail", "", nil, nil, false, false, "", "")
+	gt.NoError(t, err).Required()
+	action, err := uc.Action.CreateAction(ctx, ws, source.ID, "block sender", "", "", "", types.ActionStatusTodo, nil)
+	gt.NoError(t, err).Required()
+	now := time.Now().UTC()
+	_, err = repo.Memo().Create(ctx, ws, &model.Memo{
+		ID: model.NewMemoID(), WorkspaceID: ws, CaseID: source.ID, Title: "headers", CreatedAt: now, UpdatedAt: now,
+	})
+	gt.NoError(t, err).Required()
+
+	cases, err := uc.Live.SubscribeCases(ctx, ws)
+	gt.NoError(t, err).Required()
+	sourceActions, err := uc.Live.SubscribeActions(ctx, ws, source.ID)
+	gt.NoError(t, err).Required()
+	targetActions, err := uc.Live.SubscribeActions(ctx, ws, target.ID)
+	gt.NoError(t, err).Required()
+
+	_, err = uc.Case.MergeCases(ctx, ws, target.ID, []int64{source.ID})
+	gt.NoError(t, err).Required()
+
+	left := receiveLive(t, sourceActions)
+	gt.Value(t, left.Change).Equal(model.LiveChangeDeleted)
+	gt.Value(t, left.ActionID).Equal(action.ID)
+	arrived := receiveLive(t, targetActions)
+	gt.Value(t, arrived.Change).Equal(model.LiveChangeUpdated)
+	gt.Value(t, arrived.Action.CaseID).Equal(target.ID)
+
+	// Every move and close re-announces the cases; wait until the source has
+	// arrived merged and the target updated.
+	var sourceMerged, targetUpdated bool
+	for !sourceMerged || !targetUpdated {
+		change := receiveLive(t, cases)
+		gt.Value(t, change.Change).Equal(model.LiveChangeUpdated)
+		switch change.CaseID {
+		case source.ID:
+			sourceMerged = sourceMerged || change.Case.MergedInto == target.ID
+		case target.ID:
+			targetUpdated = true
+		}
+	}
+}
+
+func TestLiveUseCase_SubscribeJobRunEvents(t *testing.T) {
+	repo, uc, ws := setupLive(t)
+	ctx, cancel := context.WithCancel(auth.ContextWithToken(context.Background(), &auth.Token{Sub: "UREPORTER"}))
+	defer cancel()
+
+	c, err := uc.Case.CreateCase(ctx, ws, "agent target", "", nil, nil, false, false, "", "")
+	gt.NoError(t, err).Required()
+	seedLog(t, repo, ws, c.ID, "job-1", "run-1", time.Now(), model.JobRunStageRunning, "")
+	appendEvent := func(id string) *model.JobRunEvent {
+		ev := &model.JobRunEvent{
+			WorkspaceID: ws, CaseID: c.ID, JobID: "job-1", RunID: "run-1", TraceID: "trace-run-1",
+			EventID: id, OccurredAt: time.Now(), Kind: model.JobRunEventKindLLMResponse, Phase: "execute",
+			LLMResponse: &model.LLMResponsePayload{Model: "test"},
+		}
+		gt.NoError(t, repo.JobRunEvent().AppendNext(ctx, ev)).Required()
+		return ev
+	}
+	first := appendEvent("ev-1")
+	second := appendEvent("ev-2")
+
+	t.Run("resumes after the given sequence", func(t *testing.T) {
+		after := first.Sequence
+		events, err := uc.Live.SubscribeJobRunEvents(ctx, ws, c.ID, "run-1", &after)
+		gt.NoError(t, err).Required()
+		gt.Value(t, receiveLive(t, events).EventID).Equal(second.EventID)
+
+		third := appendEvent("ev-3")
+		gt.Value(t, receiveLive(t, events).EventID).Equal(third.EventID)
+	})
+
+	t.Run("a private case's run is refused", func(t *testing.T) {
+		other := auth.ContextWithToken(context.Background(), &auth.Token{Sub: "UOTHER"})
+		_, err := repo.Case().Transact(ctx, ws, c.ID, func(c *model.Case) error {
+			c.IsPrivate = true
+			c.ChannelUserIDs = []string{"UREPORTER"}
+			return nil
+		})
+		gt.NoError(t, err).Required()
+
+		_, err = uc.Live.SubscribeJobRunEvents(other, ws, c.ID, "run-1", nil)
+		gt.Error(t, err).Is(usecase.ErrAccessDenied)
+		_, err = uc.Live.SubscribeActions(other, ws, c.ID)
+		gt.Error(t, err).Is(usecase.ErrAccessDenied)
+	})
+}
+
+// listRecordingRepo records the reads made through JobRunEvent() so a test can
+// tell a full timeline read from a tail read.
+type listRecordingRepo struct {
+	interfaces.Repository
+	events *listRecordingJobRunEvents
+}
+
+func (r listRecordingRepo) JobRunEvent() interfaces.JobRunEventRepository { return r.events }
+
+type listRecordingJobRunEvents struct {
+	interfaces.JobRunEventRepository
+	mu        sync.Mutex
+	fullReads int
+	afters    []int64
+}
+
+func (r *listRecordingJobRunEvents) List(ctx context.Context, key model.JobRunKey, runID string) ([]*model.JobRunEvent, error) {
+	r.mu.Lock()
+	r.fullReads++
+	r.mu.Unlock()
+	return r.JobRunEventRepository.List(ctx, key, runID)
+}
+
+func (r *listRecordingJobRunEvents) ListAfter(ctx context.Context, key model.JobRunKey, runID string, afterSequence int64) ([]*model.JobRunEvent, error) {
+	r.mu.Lock()
+	r.afters = append(r.afters, afterSequence)
+	r.mu.Unlock()
+	return r.JobRunEventRepository.ListAfter(ctx, key, runID, afterSequence)
+}
+
+func (r *listRecordingJobRunEvents) reads() (int, []int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.fullReads, append([]int64(nil), r.afters...)
+}
+
+// TestLiveUseCase_SubscribeJobRunEvents_readsOnlyTheTail pins that a
+// notification reads the events past the last one delivered, not the whole
+// timeline: a long run would otherwise be re-read once per appended event.
+func TestLiveUseCase_SubscribeJobRunEvents_readsOnlyTheTail(t *testing.T) {
+	bus := livebus.New()
+	published := livebus.PublishingRepository(memory.New(), bus)
+	recorder := &listRecordingJobRunEvents{JobRunEventRepository: published.JobRunEvent()}
+	uc := usecase.New(listRecordingRepo{Repository: published, events: recorder}, nil, usecase.WithLiveEventBus(bus))
+	ws := fmt.Sprintf("ws-%d", time.Now().UnixNano())
+	ctx, cancel := context.WithCancel(auth.ContextWithToken(context.Background(), &auth.Token{Sub: "UREPORTER"}))
+	defer cancel()
+
+	c, err := uc.Case.CreateCase(ctx, ws, "agent target", "", nil, nil, false, false, "", "")
+	gt.NoError(t, err).Required()
+	seedLog(t, published, ws, c.ID, "job-1", "run-1", time.Now(), model.JobRunStageRunning, "")
+	appendEvent := func(id string) *model.JobRunEvent {
+		ev := &model.JobRunEvent{
+			WorkspaceID: ws, CaseID: c.ID, JobID: "job-1", RunID: "run-1", TraceID: "trace-run-1",
+			EventID: id, OccurredAt: time.Now(), Kind: model.JobRunEventKindLLMResponse, Phase: "execute",
+			LLMResponse: &model.LLMResponsePayload{Model: "test"},
+		}
+		gt.NoError(t, published.JobRunEvent().AppendNext(ctx, ev)).Required()
+		return ev
+	}
+	appendEvent("ev-1")
+
+	events, err := uc.Live.SubscribeJobRunEvents(ctx, ws, c.ID, "run-1", nil)
+	gt.NoError(t, err).Required()
+
+	second := appendEvent("ev-2")
+	gt.Value(t, receiveLive(t, events).Sequence).Equal(second.Sequence)
+	third := appendEvent("ev-3")
+	gt.Value(t, receiveLive(t, events).Sequence).Equal(third.Sequence)
+
+	fullReads, afters := recorder.reads()
+	gt.Value(t, fullReads).Equal(1)
+	gt.Array(t, afters).Length(2).Required()
+	gt.Value(t, afters[0]).Equal(second.Sequence - 1)
+	gt.Value(t, afters[1]).Equal(second.Sequence)
+}
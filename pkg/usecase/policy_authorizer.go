@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/authz"
+)
+
+// PolicyAuthorizer evaluates the operator's Rego policy for GraphQL mutations
+// and agent tool calls — the same policy bundle the MCP endpoint is authorized
+// with, queried at different entrypoints (authz.MutationQuery,
+// authz.AgentToolQuery).
+//
+// Both surfaces are opt-in per entrypoint: a policy that defines nothing under
+// data.auth.graphql leaves every mutation allowed, exactly as before a policy
+// existed. Once the entrypoint is defined it fails closed — a deny, and any
+// evaluation error, refuses the operation.
+//
+// A nil *PolicyAuthorizer allows everything, so callers need no
+// "is a policy configured" branch.
+type PolicyAuthorizer struct {
+	policy interfaces.PolicyClient
+}
+
+// NewPolicyAuthorizer returns an authorizer backed by policy, or nil when
+// policy is nil.
+func NewPolicyAuthorizer(policy interfaces.PolicyClient) *PolicyAuthorizer {
+	if policy == nil {
+		return nil
+	}
+	return &PolicyAuthorizer{policy: policy}
+}
+
+// AuthorizeMutation evaluates authz.MutationQuery for one root GraphQL
+// mutation. A deny is returned as ErrAccessDenied.
+func (a *PolicyAuthorizer) AuthorizeMutation(ctx context.Context, in authz.OperationInput) error {
+	return a.authorize(ctx, authz.MutationQuery, in)
+}
+
+// AuthorizeToolCall evaluates authz.AgentToolQuery for one agent tool call. A
+// deny is returned as ErrAccessDenied; the message carries the policy's reason
+// so the model can tell the call was refused rather than failed.
+func (a *PolicyAuthorizer) AuthorizeToolCall(ctx context.Context, in authz.OperationInput) error {
+	return a.authorize(ctx, authz.AgentToolQuery, in)
+}
+
+func (a *PolicyAuthorizer) authorize(ctx context.Context, query string, in authz.OperationInput) error {
+	if a == nil {
+		return nil
+	}
+
+	var decision authz.Decision
+	if err := a.policy.Query(ctx, query, in, &decision); err != nil {
+		if errors.Is(err, interfaces.ErrPolicyUndefined) {
+			return nil
+		}
+		return goerr.Wrap(err, "authorization policy evaluation failed",
+			goerr.V("query", query), goerr.V("operation", in.Operation))
+	}
+	if decision.Allow {
+		return nil
+	}
+
+	msg := "operation denied by policy"
+	if decision.Reason != "" {
+		msg += ": " + decision.Reason
+	}
+	return goerr.Wrap(ErrAccessDenied, msg,
+		goerr.V("operation", in.Operation),
+		goerr.V("workspace_id", in.WorkspaceID),
+		goerr.V("case_id", in.CaseID))
+}
@@ -0,0 +1,68 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/m-mizutani/gt"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/authz"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+)
+
+// fakeDecisionPolicy answers every query with a fixed Decision (or error) and
+// records the query it was asked.
+type fakeDecisionPolicy struct {
+	decision  authz.Decision
+	err       error
+	lastQuery string
+}
+
+func (f *fakeDecisionPolicy) Query(_ context.Context, query string, _, out any) error {
+	f.lastQuery = query
+	if f.err != nil {
+		return f.err
+	}
+	*out.(*authz.Decision) = f.decision
+	return nil
+}
+
+func TestPolicyAuthorizer(t *testing.T) {
+	ctx := context.Background()
+	in := authz.OperationInput{Operation: "closeCase", WorkspaceID: "ws", CaseID: 1}
+
+	t.Run("allow", func(t *testing.T) {
+		p := &fakeDecisionPolicy{decision: authz.Decision{Allow: true}}
+		a := usecase.NewPolicyAuthorizer(p)
+		gt.NoError(t, a.AuthorizeMutation(ctx, in))
+		gt.String(t, p.lastQuery).Equal(authz.MutationQuery)
+		gt.NoError(t, a.AuthorizeToolCall(ctx, in))
+		gt.String(t, p.lastQuery).Equal(authz.AgentToolQuery)
+	})
+
+	t.Run("deny carries the policy's reason", func(t *testing.T) {
+		p := &fakeDecisionPolicy{decision: authz.Decision{Reason: "closing needs an approver"}}
+		err := usecase.NewPolicyAuthorizer(p).AuthorizeMutation(ctx, in)
+		gt.Error(t, err).Is(usecase.TestErrAccessDenied)
+		gt.String(t, err.Error()).Contains("closing needs an approver")
+	})
+
+	t.Run("an undefined entrypoint leaves the surface open", func(t *testing.T) {
+		p := &fakeDecisionPolicy{err: goerr.Wrap(interfaces.ErrPolicyUndefined, "no result")}
+		gt.NoError(t, usecase.NewPolicyAuthorizer(p).AuthorizeToolCall(ctx, in))
+	})
+
+	t.Run("an evaluation error fails closed", func(t *testing.T) {
+		p := &fakeDecisionPolicy{err: goerr.New("rego runtime error")}
+		gt.Error(t, usecase.NewPolicyAuthorizer(p).AuthorizeToolCall(ctx, in))
+	})
+
+	t.Run("no policy allows everything", func(t *testing.T) {
+		a := usecase.NewPolicyAuthorizer(nil)
+		gt.Value(t, a).Nil()
+		gt.NoError(t, a.AuthorizeMutation(ctx, in))
+		gt.NoError(t, a.AuthorizeToolCall(ctx, in))
+	})
+}
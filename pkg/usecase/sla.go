@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/logging"
+)
+
+// slaClosedLookback is how far back the SLA sweep looks for closed cases. A
+// case that missed its target and was closed between two sweeps still needs
+// its breach recorded; a day leaves room for a tick schedule with gaps.
+const slaClosedLookback = 24 * time.Hour
+
+// SLAUseCase computes the [sla] clocks of cases and records their breaches.
+// The clocks are derived from the timestamps the Case and Action use cases
+// keep on model.Case.SLA, so reading them needs no sweep; the sweep, driven
+// by `hecatoncheires tick` / `POST /hooks/tick` like the reminder sweep, only
+// persists the breaches so they can be listed and exported after the fact.
+type SLAUseCase struct {
+	repo     interfaces.Repository
+	registry *model.WorkspaceRegistry
+}
+
+// NewSLAUseCase constructs an SLAUseCase.
+func NewSLAUseCase(repo interfaces.Repository, registry *model.WorkspaceRegistry) *SLAUseCase {
+	return &SLAUseCase{repo: repo, registry: registry}
+}
+
+// Clocks returns c's SLA clocks as of now, or nil when the workspace has no
+// [sla] section or no rule matches c.
+func (uc *SLAUseCase) Clocks(workspaceID string, c *model.Case) []model.SLAClock {
+	return uc.policy(workspaceID).Clocks(c, time.Now().UTC())
+}
+
+// ListByCase returns the breaches recorded for one case, earliest due first.
+func (uc *SLAUseCase) ListByCase(ctx context.Context, workspaceID string, caseID int64) ([]*model.SLABreach, error) {
+	breaches, err := uc.repo.SLABreach().ListByCase(ctx, workspaceID, caseID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list sla breaches",
+			goerr.V("workspace_id", workspaceID), goerr.V(CaseIDKey, caseID))
+	}
+	return breaches, nil
+}
+
+// Sweep records every breached clock of the open cases, and of the cases
+// closed within the last day, of each workspace with an [sla] section. A
+// breach already recorded is left as it is. A failure listing cases or
+// recording a breach stops the sweep.
+func (uc *SLAUseCase) Sweep(ctx context.Context) error {
+	return uc.sweep(ctx, time.Now().UTC())
+}
+
+func (uc *SLAUseCase) sweep(ctx context.Context, now time.Time) error {
+	if uc.registry == nil {
+		return goerr.New("sla sweep has no registry")
+	}
+	for _, ws := range uc.registry.List() {
+		if ws == nil || ws.SLA == nil {
+			continue
+		}
+		if err := uc.sweepWorkspace(ctx, ws, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (uc *SLAUseCase) sweepWorkspace(ctx context.Context, ws *model.WorkspaceEntry, now time.Time) error {
+	workspaceID := ws.Workspace.ID
+	open, err := uc.repo.Case().List(ctx, workspaceID, interfaces.WithStatus(types.CaseStatusOpen))
+	if err != nil {
+		return goerr.Wrap(err, "list open cases for sla sweep", goerr.V("workspace_id", workspaceID))
+	}
+	closed, err := uc.repo.Case().List(ctx, workspaceID,
+		interfaces.WithStatus(types.CaseStatusClosed),
+		interfaces.WithUpdatedBetween(now.Add(-slaClosedLookback), time.Time{}))
+	if err != nil {
+		return goerr.Wrap(err, "list closed cases for sla sweep", goerr.V("workspace_id", workspaceID))
+	}
+
+	recorded := 0
+	for _, c := range append(open, closed...) {
+		rule := ws.SLA.RuleFor(c)
+		for _, clock := range ws.SLA.Clocks(c, now) {
+			if !clock.Breached {
+				continue
+			}
+			ok, err := uc.repo.SLABreach().Record(ctx, &model.SLABreach{
+				WorkspaceID: workspaceID,
+				CaseID:      c.ID,
+				Kind:        clock.Kind,
+				FieldID:     rule.FieldID,
+				Value:       rule.Value,
+				Target:      clock.Target,
+				DueAt:       clock.DueAt,
+				RecordedAt:  now,
+			})
+			if err != nil {
+				return goerr.Wrap(err, "record sla breach",
+					goerr.V("workspace_id", workspaceID),
+					goerr.V(CaseIDKey, c.ID),
+					goerr.V("kind", clock.Kind))
+			}
+			if ok {
+				recorded++
+			}
+		}
+	}
+
+	logging.From(ctx).Info("sla sweep completed",
+		slog.String("workspace_id", workspaceID),
+		slog.Int("cases", len(open)+len(closed)),
+		slog.Int("recorded", recorded))
+	return nil
+}
+
+func (uc *SLAUseCase) policy(workspaceID string) *model.SLAPolicy {
+	if uc.registry == nil {
+		return nil
+	}
+	entry, err := uc.registry.Get(workspaceID)
+	if err != nil {
+		return nil
+	}
+	return entry.SLA
+}
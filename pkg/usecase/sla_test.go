@@ -0,0 +1,207 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+)
+
+var slaTestPolicy = &model.SLAPolicy{
+	Rules: []model.SLARule{
+		{FieldID: "severity", Value: "critical", Response: time.Hour, Resolution: 24 * time.Hour},
+	},
+	PauseStatuses: []string{"waiting"},
+}
+
+var slaCritical = map[string]model.FieldValue{
+	"severity": {FieldID: "severity", Type: types.FieldTypeSelect, Value: "critical"},
+}
+
+func TestSLAUseCase_Sweep(t *testing.T) {
+	ctx := context.Background()
+	opened := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	setup := func(t *testing.T) (*usecase.SLAUseCase, *memory.Memory, string) {
+		t.Helper()
+		repo := memory.New()
+		ws := newWS()
+		registry := model.NewWorkspaceRegistry()
+		registry.Register(&model.WorkspaceEntry{
+			Workspace: model.Workspace{ID: ws, Name: "Test"},
+			SLA:       slaTestPolicy,
+		})
+		return usecase.NewSLAUseCase(repo, registry), repo, ws
+	}
+	createCase := func(t *testing.T, repo interfaces.Repository, ws string, c *model.Case) *model.Case {
+		t.Helper()
+		c.Title = "Phishing"
+		c.ReporterID = "U-REP"
+		c.FieldValues = slaCritical
+		c.CreatedAt = opened
+		if c.UpdatedAt.IsZero() {
+			c.UpdatedAt = opened
+		}
+		created, err := repo.Case().Create(ctx, ws, c)
+		gt.NoError(t, err).Required()
+		return created
+	}
+
+	t.Run("each breach is recorded once", func(t *testing.T) {
+		uc, repo, ws := setup(t)
+		c := createCase(t, repo, ws, &model.Case{Status: types.CaseStatusOpen})
+
+		gt.NoError(t, uc.SweepAtForTest(ctx, opened.Add(30*time.Minute))).Required()
+		breaches, err := uc.ListByCase(ctx, ws, c.ID)
+		gt.NoError(t, err).Required()
+		gt.Array(t, breaches).Length(0)
+
+		gt.NoError(t, uc.SweepAtForTest(ctx, opened.Add(2*time.Hour))).Required()
+		gt.NoError(t, uc.SweepAtForTest(ctx, opened.Add(3*time.Hour))).Required()
+		breaches, err = uc.ListByCase(ctx, ws, c.ID)
+		gt.NoError(t, err).Required()
+		gt.Array(t, breaches).Length(1).Required()
+		gt.Value(t, breaches[0].Kind).Equal(model.SLAKindResponse)
+		gt.Value(t, breaches[0].FieldID).Equal("severity")
+		gt.Value(t, breaches[0].Value).Equal("critical")
+		gt.Value(t, breaches[0].DueAt).Equal(opened.Add(time.Hour))
+		gt.Value(t, breaches[0].RecordedAt).Equal(opened.Add(2 * time.Hour))
+
+		gt.NoError(t, uc.SweepAtForTest(ctx, opened.Add(25*time.Hour))).Required()
+		breaches, err = uc.ListByCase(ctx, ws, c.ID)
+		gt.NoError(t, err).Required()
+		gt.Array(t, breaches).Length(2).Required()
+		gt.Value(t, breaches[1].Kind).Equal(model.SLAKindResolution)
+	})
+
+	t.Run("a case closed late is recorded within a day", func(t *testing.T) {
+		uc, repo, ws := setup(t)
+		closedAt := opened.Add(30 * time.Hour)
+		c := createCase(t, repo, ws, &model.Case{
+			Status:    types.CaseStatusClosed,
+			SLA:       model.CaseSLA{RespondedAt: &closedAt, ResolvedAt: &closedAt},
+			UpdatedAt: closedAt,
+		})
+
+		gt.NoError(t, uc.SweepAtForTest(ctx, closedAt.Add(2*time.Hour))).Required()
+		breaches, err := uc.ListByCase(ctx, ws, c.ID)
+		gt.NoError(t, err).Required()
+		gt.Array(t, breaches).Length(2)
+	})
+
+	t.Run("cases closed before the lookback are skipped", func(t *testing.T) {
+		uc, repo, ws := setup(t)
+		closedAt := opened.Add(30 * time.Hour)
+		c := createCase(t, repo, ws, &model.Case{
+			Status:    types.CaseStatusClosed,
+			SLA:       model.CaseSLA{RespondedAt: &closedAt, ResolvedAt: &closedAt},
+			UpdatedAt: closedAt,
+		})
+
+		gt.NoError(t, uc.SweepAtForTest(ctx, closedAt.Add(48*time.Hour))).Required()
+		breaches, err := uc.ListByCase(ctx, ws, c.ID)
+		gt.NoError(t, err).Required()
+		gt.Array(t, breaches).Length(0)
+	})
+
+	t.Run("workspaces without [sla] are skipped", func(t *testing.T) {
+		repo := memory.New()
+		ws := newWS()
+		registry := model.NewWorkspaceRegistry()
+		registry.Register(&model.WorkspaceEntry{Workspace: model.Workspace{ID: ws}})
+		uc := usecase.NewSLAUseCase(repo, registry)
+		c := createCase(t, repo, ws, &model.Case{Status: types.CaseStatusOpen})
+
+		gt.NoError(t, uc.SweepAtForTest(ctx, opened.Add(48*time.Hour))).Required()
+		breaches, err := uc.ListByCase(ctx, ws, c.ID)
+		gt.NoError(t, err).Required()
+		gt.Array(t, breaches).Length(0)
+	})
+}
+
+func TestSLA_TrackedByCaseAndActionUseCases(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("the first action is the response", func(t *testing.T) {
+		repo := memory.New()
+		caseUC := usecase.NewCaseUseCase(repo, nil, nil, nil, "")
+		actionUC := usecase.NewActionUseCase(repo, nil, nil, "", nil)
+		authCtx := dashCtx("UTESTUSER")
+
+		c, err := caseUC.CreateCase(authCtx, testWorkspaceID, "Test Case", "", []string{}, nil, false, false, "", "")
+		gt.NoError(t, err).Required()
+		gt.Value(t, c.SLA.RespondedAt).Nil()
+
+		_, err = actionUC.CreateAction(authCtx, testWorkspaceID, c.ID, "First", "", "", "", types.ActionStatusTodo, nil)
+		gt.NoError(t, err).Required()
+		got, err := repo.Case().Get(ctx, testWorkspaceID, c.ID)
+		gt.NoError(t, err).Required()
+		gt.Value(t, got.SLA.RespondedAt).NotNil().Required()
+		first := *got.SLA.RespondedAt
+
+		_, err = actionUC.CreateAction(authCtx, testWorkspaceID, c.ID, "Second", "", "", "", types.ActionStatusTodo, nil)
+		gt.NoError(t, err).Required()
+		got, err = repo.Case().Get(ctx, testWorkspaceID, c.ID)
+		gt.NoError(t, err).Required()
+		gt.True(t, got.SLA.RespondedAt.Equal(first))
+	})
+
+	t.Run("closing resolves and reopening resumes", func(t *testing.T) {
+		repo := memory.New()
+		uc := usecase.NewCaseUseCase(repo, nil, nil, nil, "")
+		authCtx := dashCtx("UTESTUSER")
+
+		c, err := uc.CreateCase(authCtx, testWorkspaceID, "Test Case", "", []string{}, nil, false, false, "", "")
+		gt.NoError(t, err).Required()
+
+		closed, err := uc.CloseCase(authCtx, testWorkspaceID, c.ID)
+		gt.NoError(t, err).Required()
+		gt.Value(t, closed.SLA.RespondedAt).NotNil()
+		gt.Value(t, closed.SLA.ResolvedAt).NotNil()
+
+		reopened, err := uc.ReopenCase(authCtx, testWorkspaceID, c.ID)
+		gt.NoError(t, err).Required()
+		gt.Value(t, reopened.SLA.ResolvedAt).Nil()
+		gt.Array(t, reopened.SLA.Pauses).Length(1).Required()
+		gt.Value(t, reopened.SLA.Pauses[0].To).NotNil()
+	})
+
+	t.Run("board status changes respond and pause", func(t *testing.T) {
+		repo := memory.New()
+		set, err := model.NewActionStatusSet("triage", []string{"done"}, []model.ActionStatusDefinition{
+			{ID: "triage", Name: "Triage"},
+			{ID: "waiting", Name: "Waiting"},
+			{ID: "done", Name: "Done"},
+		})
+		gt.NoError(t, err).Required()
+		registry := model.NewWorkspaceRegistry()
+		registry.Register(&model.WorkspaceEntry{
+			Workspace:             model.Workspace{ID: "support"},
+			CaseMode:              model.CaseModeThread,
+			SlackMonitorChannelID: "C-MONITOR",
+			CaseStatusSet:         set,
+			SLA:                   slaTestPolicy,
+		})
+		uc := usecase.NewCaseUseCase(repo, registry, nil, nil, "")
+
+		c, err := uc.CreateThreadBoundCaseForTest(ctx, "support", "C-MONITOR", "1700000000.000100", "U-REP", "t", "b", nil, "")
+		gt.NoError(t, err).Required()
+
+		waiting, err := uc.UpdateCaseStatus(ctx, "support", c.ID, "waiting")
+		gt.NoError(t, err).Required()
+		gt.Value(t, waiting.SLA.RespondedAt).NotNil()
+		gt.Array(t, waiting.SLA.Pauses).Length(1).Required()
+		gt.Value(t, waiting.SLA.Pauses[0].To).Nil()
+
+		resumed, err := uc.UpdateCaseStatus(ctx, "support", c.ID, "triage")
+		gt.NoError(t, err).Required()
+		gt.Array(t, resumed.SLA.Pauses).Length(1).Required()
+		gt.Value(t, resumed.SLA.Pauses[0].To).NotNil()
+	})
+}
@@ -23,6 +23,12 @@ type SlackUseCases struct {
 	agent           *AgentUseCase
 	slackService    slacksvc.Service
 	mentionProposal *MentionProposalUseCase
+	// appHome publishes the App Home tab on app_home_opened. Set by New
+	// once the dashboard it renders from is built; nil ignores the event.
+	appHome *AppHomeUseCase
+	// linkUnfurl unfurls case and action links on link_shared. Set by New;
+	// nil ignores the event.
+	linkUnfurl *LinkUnfurlUseCase
 }
 
 // NewSlackUseCases creates a new SlackUseCases instance. agent and
@@ -77,6 +83,18 @@ func (uc *SlackUseCases) HandleSlackEvent(ctx context.Context, event *slackevent
 		// Reaction-triggered case creation. Handled before slack.NewMessage so a
 		// reaction event does not fall through to the "unsupported type" path.
 		return uc.handleReactionEvent(ctx, event)
+	case "app_home_opened":
+		ev, ok := event.InnerEvent.Data.(*slackevents.AppHomeOpenedEvent)
+		if !ok || uc.appHome == nil {
+			return nil
+		}
+		return uc.appHome.HandleAppHomeOpened(ctx, ev)
+	case "link_shared":
+		ev, ok := event.InnerEvent.Data.(*slackevents.LinkSharedEvent)
+		if !ok || uc.linkUnfurl == nil {
+			return nil
+		}
+		return uc.linkUnfurl.HandleLinkShared(ctx, ev)
 	}
 
 	// Convert event to domain model
@@ -0,0 +1,341 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/slack-go/slack"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model/auth"
+	slackmodel "github.com/secmon-lab/hecatoncheires/pkg/domain/model/slack"
+	"github.com/secmon-lab/hecatoncheires/pkg/i18n"
+	slacksvc "github.com/secmon-lab/hecatoncheires/pkg/service/slack"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
+)
+
+// Slack IDs of the "Attach to case" message shortcut and its modal.
+const (
+	// SlackCallbackIDAttachMessage is the callback ID of both the message
+	// shortcut (as configured in the Slack app) and the modal it opens.
+	SlackCallbackIDAttachMessage = "hc_attach_message"
+
+	SlackBlockIDAttachWorkspace  = "hc_attach_ws_block"
+	SlackActionIDAttachWorkspace = "hc_attach_ws"
+	// The case menu is an external_select: its options come from
+	// block_suggestion requests, answered by SuggestAttachCases.
+	SlackBlockIDAttachCase    = "hc_attach_case_block"
+	SlackActionIDAttachCase   = "hc_attach_case"
+	SlackBlockIDAttachNote    = "hc_attach_note_block"
+	SlackActionIDAttachNote   = "hc_attach_note"
+	SlackBlockIDAttachOptions = "hc_attach_options_block"
+	SlackActionIDAttachOption = "hc_attach_options"
+
+	attachOptionValueNotify = "notify"
+)
+
+// attachMetadataMaxBytes keeps the modal's private_metadata under Slack's
+// 3000-character limit; the message text is shortened until it fits.
+const attachMetadataMaxBytes = 3000
+
+// attachMessageMetadata is stored in the attach modal's private_metadata: the
+// message the shortcut was used on, and the response_url to report back to.
+// The modal carries the text itself because the bot need not be a member of
+// the message's channel, and could then not read it back.
+type attachMessageMetadata struct {
+	ChannelID   string `json:"channel_id"`
+	MessageTS   string `json:"message_ts"`
+	ThreadTS    string `json:"thread_ts,omitempty"`
+	TeamID      string `json:"team_id,omitempty"`
+	UserID      string `json:"user_id,omitempty"`
+	UserName    string `json:"user_name,omitempty"`
+	Text        string `json:"text,omitempty"`
+	ResponseURL string `json:"response_url,omitempty"`
+}
+
+// HandleAttachMessageShortcut opens the "Attach to case" modal for the
+// message the shortcut was used on. It consumes the callback's trigger_id, so
+// the caller must run it within the interaction's 3-second ack window.
+func (uc *SlackUseCases) HandleAttachMessageShortcut(ctx context.Context, callback *slack.InteractionCallback) error {
+	if uc.slackService == nil {
+		return goerr.New("slack service is not available")
+	}
+	if uc.registry == nil {
+		return goerr.New("workspace registry is not available")
+	}
+	ctx = uc.contextWithUserLang(ctx, callback.User.ID)
+
+	msg := callback.Message
+	ts := callback.MessageTs
+	if ts == "" {
+		ts = msg.Timestamp
+	}
+	meta := attachMessageMetadata{
+		ChannelID:   callback.Channel.ID,
+		MessageTS:   ts,
+		TeamID:      callback.Team.ID,
+		UserID:      msg.User,
+		UserName:    msg.Username,
+		Text:        slacksvc.MessageBody(msg.Text, msg.Blocks, msg.Attachments),
+		ResponseURL: callback.ResponseURL,
+	}
+	if msg.ThreadTimestamp != "" && msg.ThreadTimestamp != ts {
+		meta.ThreadTS = msg.ThreadTimestamp
+	}
+	if meta.UserID == "" {
+		meta.UserID = msg.BotID
+	}
+	if meta.UserName == "" {
+		meta.UserName = meta.UserID
+	}
+	metaJSON, err := encodeAttachMetadata(meta)
+	if err != nil {
+		return err
+	}
+
+	view := uc.buildAttachMessageModal(ctx, metaJSON)
+	if err := uc.slackService.OpenView(ctx, callback.TriggerID, view); err != nil {
+		return goerr.Wrap(err, "failed to open attach message modal",
+			goerr.V("channel_id", meta.ChannelID), goerr.V("message_ts", meta.MessageTS))
+	}
+	return nil
+}
+
+// encodeAttachMetadata marshals meta, halving the message text until the JSON
+// fits in private_metadata. The attached record then holds the head of a long
+// message; the permalink posted to the case still leads to all of it.
+func encodeAttachMetadata(meta attachMessageMetadata) (string, error) {
+	for {
+		raw, err := json.Marshal(meta)
+		if err != nil {
+			return "", goerr.Wrap(err, "failed to encode attach message metadata")
+		}
+		if len(raw) <= attachMetadataMaxBytes {
+			return string(raw), nil
+		}
+		if meta.Text == "" {
+			return "", goerr.New("attach message metadata does not fit in private_metadata",
+				goerr.V("size", len(raw)))
+		}
+		runes := []rune(strings.TrimSuffix(meta.Text, clampSuffixSingleLine))
+		if len(runes) <= 1 {
+			meta.Text = ""
+			continue
+		}
+		meta.Text = string(runes[:len(runes)/2]) + clampSuffixSingleLine
+	}
+}
+
+func (uc *SlackUseCases) buildAttachMessageModal(ctx context.Context, metaJSON string) slack.ModalViewRequest {
+	var blocks []slack.Block
+
+	if workspaces := uc.registry.Workspaces(); len(workspaces) > 1 {
+		options := make([]*slack.OptionBlockObject, len(workspaces))
+		for i, ws := range workspaces {
+			options[i] = slack.NewOptionBlockObject(ws.ID,
+				slack.NewTextBlockObject(slack.PlainTextType, workspaceLabel(ws), false, false), nil)
+		}
+		wsSelect := slack.NewOptionsSelectBlockElement(slack.OptTypeStatic, nil, SlackActionIDAttachWorkspace, options...)
+		wsInput := slack.NewInputBlock(SlackBlockIDAttachWorkspace,
+			slack.NewTextBlockObject(slack.PlainTextType, i18n.T(ctx, i18n.MsgAttachWorkspaceLabel), false, false),
+			slack.NewTextBlockObject(slack.PlainTextType, i18n.T(ctx, i18n.MsgAttachWorkspaceHint), false, false),
+			wsSelect)
+		wsInput.Optional = true
+		blocks = append(blocks, wsInput)
+	}
+
+	// min_query_length 0 loads the most recently updated Cases as soon as the
+	// menu is opened, before anything is typed.
+	minQuery := 0
+	caseSelect := slack.NewOptionsSelectBlockElement(slack.OptTypeExternal,
+		slack.NewTextBlockObject(slack.PlainTextType, i18n.T(ctx, i18n.MsgAttachCasePlaceholder), false, false),
+		SlackActionIDAttachCase)
+	caseSelect.MinQueryLength = &minQuery
+	blocks = append(blocks, slack.NewInputBlock(SlackBlockIDAttachCase,
+		slack.NewTextBlockObject(slack.PlainTextType, i18n.T(ctx, i18n.MsgAttachCaseLabel), false, false),
+		nil, caseSelect))
+
+	note := slack.NewPlainTextInputBlockElement(
+		slack.NewTextBlockObject(slack.PlainTextType, i18n.T(ctx, i18n.MsgAttachNotePlaceholder), false, false),
+		SlackActionIDAttachNote)
+	note.Multiline = true
+	noteInput := slack.NewInputBlock(SlackBlockIDAttachNote,
+		slack.NewTextBlockObject(slack.PlainTextType, i18n.T(ctx, i18n.MsgAttachNoteLabel), false, false),
+		nil, note)
+	noteInput.Optional = true
+	blocks = append(blocks, noteInput)
+
+	notify := slack.NewCheckboxGroupsBlockElement(SlackActionIDAttachOption,
+		slack.NewOptionBlockObject(attachOptionValueNotify,
+			slack.NewTextBlockObject(slack.PlainTextType, i18n.T(ctx, i18n.MsgAttachNotifyJobs), false, false), nil))
+	optionsInput := slack.NewInputBlock(SlackBlockIDAttachOptions,
+		slack.NewTextBlockObject(slack.PlainTextType, i18n.T(ctx, i18n.MsgAttachOptionsLabel), false, false),
+		nil, notify)
+	optionsInput.Optional = true
+	blocks = append(blocks, optionsInput)
+
+	return slack.ModalViewRequest{
+		Type:            slack.VTModal,
+		CallbackID:      SlackCallbackIDAttachMessage,
+		Title:           slack.NewTextBlockObject(slack.PlainTextType, i18n.T(ctx, i18n.MsgAttachModalTitle), false, false),
+		Submit:          slack.NewTextBlockObject(slack.PlainTextType, i18n.T(ctx, i18n.MsgAttachModalSubmit), false, false),
+		Close:           slack.NewTextBlockObject(slack.PlainTextType, i18n.T(ctx, i18n.MsgModalCreateCaseCancel), false, false),
+		PrivateMetadata: metaJSON,
+		Blocks:          slack.Blocks{BlockSet: blocks},
+	}
+}
+
+func workspaceLabel(ws model.Workspace) string {
+	if ws.Name != "" {
+		return ws.Name
+	}
+	return ws.ID
+}
+
+// SuggestAttachCases answers the block_suggestion request of the attach
+// modal's case menu: the open Cases matching the typed query that the user can
+// attach to, from the workspace chosen in the modal or, with none chosen, from
+// every workspace. Each option's value is "<workspace ID>:<case ID>".
+func (uc *SlackUseCases) SuggestAttachCases(ctx context.Context, caseUC *CaseUseCase, callback *slack.InteractionCallback) (*slack.OptionsResponse, error) {
+	if uc.registry == nil {
+		return nil, goerr.New("workspace registry is not available")
+	}
+	ctx = auth.ContextWithToken(ctx, &auth.Token{Sub: callback.User.ID})
+
+	entries := uc.registry.List()
+	ws := ""
+	if callback.View.State != nil {
+		ws = callback.View.State.Values[SlackBlockIDAttachWorkspace][SlackActionIDAttachWorkspace].SelectedOption.Value
+	}
+	if ws != "" {
+		entry, err := uc.registry.Get(ws)
+		if err != nil {
+			return nil, goerr.Wrap(err, "invalid workspace in attach modal", goerr.V("workspace_id", ws))
+		}
+		entries = []*model.WorkspaceEntry{entry}
+	}
+
+	type candidate struct {
+		workspace model.Workspace
+		c         *model.Case
+	}
+	var candidates []candidate
+	for _, entry := range entries {
+		cases, err := caseUC.ListAttachableCases(ctx, entry.Workspace.ID, callback.Value, referenceableCasesLimit)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range cases {
+			candidates = append(candidates, candidate{workspace: entry.Workspace, c: c})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].c.UpdatedAt.After(candidates[j].c.UpdatedAt)
+	})
+	if len(candidates) > referenceableCasesLimit {
+		candidates = candidates[:referenceableCasesLimit]
+	}
+
+	// Options name the workspace only when the Cases may come from several.
+	showWorkspace := len(uc.registry.Workspaces()) > 1
+	options := make([]*slack.OptionBlockObject, len(candidates))
+	for i, cand := range candidates {
+		label := fmt.Sprintf("#%d %s", cand.c.ID, cand.c.Title)
+		if showWorkspace {
+			label = workspaceLabel(cand.workspace) + " / " + label
+		}
+		// Option text shares the 75-character cap of option descriptions.
+		options[i] = slack.NewOptionBlockObject(formatAttachCaseValue(cand.workspace.ID, cand.c.ID),
+			slack.NewTextBlockObject(slack.PlainTextType, clampSlackOptionDescription(label), false, false), nil)
+	}
+	return &slack.OptionsResponse{Options: options}, nil
+}
+
+func formatAttachCaseValue(workspaceID string, caseID int64) string {
+	return fmt.Sprintf("%s:%d", workspaceID, caseID)
+}
+
+// parseAttachCaseValue splits a case option value back into the workspace ID
+// and the Case ID. The ID is cut at the last colon.
+func parseAttachCaseValue(value string) (string, int64, error) {
+	i := strings.LastIndex(value, ":")
+	if i <= 0 {
+		return "", 0, goerr.New("invalid attach case value", goerr.V("value", value))
+	}
+	caseID, err := strconv.ParseInt(value[i+1:], 10, 64)
+	if err != nil {
+		return "", 0, goerr.Wrap(err, "invalid case ID in attach case value", goerr.V("value", value))
+	}
+	return value[:i], caseID, nil
+}
+
+// HandleAttachMessageSubmit attaches the message to the Case chosen in the
+// modal as the submitting user, then reports the outcome to them privately in
+// the channel the shortcut was used in. A failure the user can act on (the
+// Case was closed meanwhile, or is private to them) is reported to the user
+// only; it is not returned.
+func (uc *SlackUseCases) HandleAttachMessageSubmit(ctx context.Context, caseUC *CaseUseCase, callback *slack.InteractionCallback) error {
+	userID := callback.User.ID
+	ctx = uc.contextWithUserLang(ctx, userID)
+	ctx = auth.ContextWithToken(ctx, &auth.Token{Sub: userID})
+
+	var meta attachMessageMetadata
+	if err := json.Unmarshal([]byte(callback.View.PrivateMetadata), &meta); err != nil {
+		return goerr.Wrap(err, "failed to parse private_metadata")
+	}
+
+	values := callback.View.State.Values
+	workspaceID, caseID, err := parseAttachCaseValue(values[SlackBlockIDAttachCase][SlackActionIDAttachCase].SelectedOption.Value)
+	if err != nil {
+		return err
+	}
+	in := AttachMessageInput{
+		Message: slackmodel.NewMessageFromData(meta.MessageTS, meta.ChannelID, meta.ThreadTS, meta.TeamID,
+			meta.UserID, meta.UserName, meta.Text, meta.MessageTS, parseSlackTS(meta.MessageTS), nil),
+		Note: values[SlackBlockIDAttachNote][SlackActionIDAttachNote].Value,
+	}
+	for _, opt := range values[SlackBlockIDAttachOptions][SlackActionIDAttachOption].SelectedOptions {
+		if opt.Value == attachOptionValueNotify {
+			in.NotifyJobs = true
+		}
+	}
+
+	c, err := caseUC.AttachMessage(ctx, workspaceID, caseID, in)
+	if err != nil {
+		text, _ := prepareUserError(ctx, goerr.Wrap(err, "failed to attach message to case",
+			goerr.V("workspace_id", workspaceID), goerr.V(CaseIDKey, caseID)), "failed to attach message to case")
+		uc.respondAttachResult(ctx, meta, userID, text)
+		return nil
+	}
+	uc.respondAttachResult(ctx, meta, userID,
+		i18n.T(ctx, i18n.MsgAttachDone, caseUC.caseSlackRef(ctx, workspaceID, c)))
+	return nil
+}
+
+// respondAttachResult tells the user how the attach went, as an ephemeral
+// reply through the shortcut's response_url. That needs no channel
+// membership, unlike chat.postEphemeral, which is only the fallback.
+func (uc *SlackUseCases) respondAttachResult(ctx context.Context, meta attachMessageMetadata, userID, text string) {
+	if meta.ResponseURL != "" {
+		body := map[string]any{
+			"response_type":    "ephemeral",
+			"replace_original": false,
+			"text":             text,
+		}
+		if err := postJSON(ctx, meta.ResponseURL, body); err != nil {
+			errutil.Handle(ctx, err, "failed to respond to attach message shortcut")
+		}
+		return
+	}
+	if uc.slackService == nil || meta.ChannelID == "" {
+		return
+	}
+	if err := uc.slackService.PostEphemeral(ctx, meta.ChannelID, userID, text); err != nil {
+		errutil.Handle(ctx, err, "failed to post attach message result")
+	}
+}
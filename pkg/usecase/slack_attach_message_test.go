@@ -0,0 +1,236 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	goslack "github.com/slack-go/slack"
+
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	slackmodel "github.com/secmon-lab/hecatoncheires/pkg/domain/model/slack"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+	"github.com/secmon-lab/hecatoncheires/pkg/i18n"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+)
+
+func newAttachedMessage(ts string) *slackmodel.Message {
+	return slackmodel.NewMessageFromData(ts, "C-SRC", "", "T1", "U-AUTHOR", "author",
+		"suspicious login from 203.0.113.7", ts, time.Now().UTC(), nil)
+}
+
+func TestCaseUseCase_AttachMessage(t *testing.T) {
+	i18n.Init(i18n.LangEN)
+	ctx := dashCtx("U-ME")
+
+	setup := func(t *testing.T, c *model.Case) (*usecase.CaseUseCase, *memory.Memory, *mockSlackService, *recordingCaseEventPublisher, *model.Case) {
+		t.Helper()
+		repo := memory.New()
+		now := time.Now().UTC()
+		c.Title = "Phishing wave"
+		c.ReporterID = "U-ME"
+		c.SlackChannelID = "C-CASE"
+		c.CreatedAt, c.UpdatedAt = now, now
+		if c.Status == "" {
+			c.Status = types.CaseStatusOpen
+		}
+		created, err := repo.Case().Create(context.Background(), testWorkspaceID, c)
+		gt.NoError(t, err).Required()
+
+		mock := &mockSlackService{}
+		pub := &recordingCaseEventPublisher{}
+		uc := usecase.NewCaseUseCase(repo, nil, mock, nil, "")
+		uc.SetEventPublisher(pub)
+		return uc, repo, mock, pub, created
+	}
+
+	t.Run("stores the message and cross-posts a link with the note", func(t *testing.T) {
+		uc, repo, mock, pub, c := setup(t, &model.Case{})
+
+		_, err := uc.AttachMessage(ctx, testWorkspaceID, c.ID, usecase.AttachMessageInput{
+			Message: newAttachedMessage("1700000000.000100"),
+			Note:    "same IP as\nthe phishing sender",
+		})
+		gt.NoError(t, err).Required()
+
+		msgs, _, err := repo.CaseMessage().List(context.Background(), testWorkspaceID, c.ID, 10, "")
+		gt.NoError(t, err).Required()
+		gt.A(t, msgs).Length(1).Required()
+		gt.Value(t, msgs[0].ChannelID()).Equal("C-SRC")
+		gt.Value(t, msgs[0].Text()).Equal("suspicious login from 203.0.113.7")
+
+		gt.A(t, mock.postedChannelIDs).Length(1).Required()
+		gt.Value(t, mock.postedChannelIDs[0]).Equal("C-CASE")
+		note := mock.postedTexts[0]
+		gt.String(t, note).Contains("<@U-ME>")
+		gt.String(t, note).Contains("https://slack.test/C-SRC/1700000000.000100")
+		gt.String(t, note).Contains("\n>same IP as\n>the phishing sender")
+		gt.A(t, pub.events).Length(0)
+	})
+
+	t.Run("notifies jobs with the permalink", func(t *testing.T) {
+		uc, _, _, pub, c := setup(t, &model.Case{})
+
+		_, err := uc.AttachMessage(ctx, testWorkspaceID, c.ID, usecase.AttachMessageInput{
+			Message:    newAttachedMessage("1700000000.000200"),
+			NotifyJobs: true,
+		})
+		gt.NoError(t, err).Required()
+
+		gt.A(t, pub.events).Length(1).Required()
+		ev := pub.events[0]
+		gt.Value(t, ev.lifecycle).Equal(model.CaseLifecycleMessageAttached)
+		gt.Value(t, ev.change.To).Equal([]string{"https://slack.test/C-SRC/1700000000.000200"})
+		gt.Value(t, ev.actor).Equal("U-ME")
+	})
+
+	t.Run("rejects a closed case", func(t *testing.T) {
+		uc, repo, mock, _, c := setup(t, &model.Case{Status: types.CaseStatusClosed})
+
+		_, err := uc.AttachMessage(ctx, testWorkspaceID, c.ID, usecase.AttachMessageInput{
+			Message: newAttachedMessage("1700000000.000300"),
+		})
+		gt.Error(t, err).Is(usecase.ErrCaseAlreadyClosed)
+
+		msgs, _, err := repo.CaseMessage().List(context.Background(), testWorkspaceID, c.ID, 10, "")
+		gt.NoError(t, err).Required()
+		gt.A(t, msgs).Length(0)
+		gt.A(t, mock.postedTexts).Length(0)
+	})
+
+	t.Run("rejects a merged case", func(t *testing.T) {
+		uc, _, _, _, c := setup(t, &model.Case{Status: types.CaseStatusClosed, MergedInto: 99})
+
+		_, err := uc.AttachMessage(ctx, testWorkspaceID, c.ID, usecase.AttachMessageInput{
+			Message: newAttachedMessage("1700000000.000400"),
+		})
+		gt.Error(t, err).Is(usecase.ErrCaseAlreadyMerged)
+	})
+
+	t.Run("rejects a private case the user is not in", func(t *testing.T) {
+		uc, _, _, _, c := setup(t, &model.Case{IsPrivate: true, ChannelUserIDs: []string{"U-OTHER"}})
+
+		_, err := uc.AttachMessage(ctx, testWorkspaceID, c.ID, usecase.AttachMessageInput{
+			Message: newAttachedMessage("1700000000.000500"),
+		})
+		gt.Error(t, err).Is(usecase.TestErrAccessDenied)
+	})
+}
+
+func TestSlackUseCases_AttachMessageFlow(t *testing.T) {
+	i18n.Init(i18n.LangEN)
+	repo := memory.New()
+	registry := dashTestRegistry("ws-1", "ws-2")
+	now := time.Now().UTC()
+
+	createCase := func(t *testing.T, ws string, c *model.Case) *model.Case {
+		t.Helper()
+		c.ReporterID = "U-REP"
+		c.SlackChannelID = "C-CASE-" + ws
+		c.CreatedAt, c.UpdatedAt = now, now
+		if c.Status == "" {
+			c.Status = types.CaseStatusOpen
+		}
+		created, err := repo.Case().Create(context.Background(), ws, c)
+		gt.NoError(t, err).Required()
+		return created
+	}
+	target := createCase(t, "ws-1", &model.Case{Title: "Phishing wave"})
+	createCase(t, "ws-1", &model.Case{Title: "Phishing old", Status: types.CaseStatusClosed})
+	createCase(t, "ws-2", &model.Case{Title: "Phishing private", IsPrivate: true, ChannelUserIDs: []string{"U-OTHER"}})
+	other := createCase(t, "ws-2", &model.Case{Title: "Lost laptop"})
+
+	var opened []goslack.ModalViewRequest
+	mock := &mockSlackService{
+		openViewFn: func(_ context.Context, _ string, view goslack.ModalViewRequest) error {
+			opened = append(opened, view)
+			return nil
+		},
+	}
+	slackUC := usecase.NewSlackUseCases(repo, registry, nil, nil, mock)
+	caseUC := usecase.NewCaseUseCase(repo, registry, mock, nil, "")
+
+	t.Run("shortcut opens the modal with the message in its metadata", func(t *testing.T) {
+		cb := &goslack.InteractionCallback{
+			Type:       goslack.InteractionTypeMessageAction,
+			CallbackID: usecase.SlackCallbackIDAttachMessage,
+			TriggerID:  "trigger-1",
+			User:       goslack.User{ID: "U-ME"},
+			MessageTs:  "1700000000.000100",
+		}
+		cb.Channel.ID = "C-SRC"
+		cb.Message.User = "U-AUTHOR"
+		cb.Message.Text = "suspicious login"
+
+		gt.NoError(t, slackUC.HandleAttachMessageShortcut(context.Background(), cb)).Required()
+		gt.A(t, opened).Length(1).Required()
+		gt.Value(t, opened[0].CallbackID).Equal(usecase.SlackCallbackIDAttachMessage)
+		gt.String(t, opened[0].PrivateMetadata).Contains(`"channel_id":"C-SRC"`)
+		gt.String(t, opened[0].PrivateMetadata).Contains(`"text":"suspicious login"`)
+	})
+
+	t.Run("suggests open accessible cases", func(t *testing.T) {
+		cb := &goslack.InteractionCallback{
+			Type:     goslack.InteractionTypeBlockSuggestion,
+			User:     goslack.User{ID: "U-ME"},
+			ActionID: usecase.SlackActionIDAttachCase,
+			Value:    "phishing",
+		}
+		resp, err := slackUC.SuggestAttachCases(context.Background(), caseUC, cb)
+		gt.NoError(t, err).Required()
+		gt.A(t, resp.Options).Length(1).Required()
+		gt.Value(t, resp.Options[0].Value).Equal(fmt.Sprintf("ws-1:%d", target.ID))
+		gt.Value(t, resp.Options[0].Text.Text).Equal(fmt.Sprintf("name-ws-1 / #%d Phishing wave", target.ID))
+
+		// Narrowed to the workspace picked in the modal, and matched by number.
+		cb.Value = fmt.Sprintf("#%d", other.ID)
+		cb.View.State = &goslack.ViewState{Values: map[string]map[string]goslack.BlockAction{
+			usecase.SlackBlockIDAttachWorkspace: {
+				usecase.SlackActionIDAttachWorkspace: {SelectedOption: goslack.OptionBlockObject{Value: "ws-2"}},
+			},
+		}}
+		resp, err = slackUC.SuggestAttachCases(context.Background(), caseUC, cb)
+		gt.NoError(t, err).Required()
+		gt.A(t, resp.Options).Length(1).Required()
+		gt.Value(t, resp.Options[0].Value).Equal(fmt.Sprintf("ws-2:%d", other.ID))
+	})
+
+	t.Run("submit attaches the message and reports back", func(t *testing.T) {
+		respURL, captured := captureResponseURL(t)
+		meta, err := json.Marshal(map[string]string{
+			"channel_id":   "C-SRC",
+			"message_ts":   "1700000000.000100",
+			"user_id":      "U-AUTHOR",
+			"text":         "suspicious login",
+			"response_url": respURL,
+		})
+		gt.NoError(t, err).Required()
+		cb := &goslack.InteractionCallback{
+			Type: goslack.InteractionTypeViewSubmission,
+			User: goslack.User{ID: "U-ME"},
+			View: goslack.View{
+				CallbackID:      usecase.SlackCallbackIDAttachMessage,
+				PrivateMetadata: string(meta),
+				State: &goslack.ViewState{Values: map[string]map[string]goslack.BlockAction{
+					usecase.SlackBlockIDAttachCase: {
+						usecase.SlackActionIDAttachCase: {SelectedOption: goslack.OptionBlockObject{Value: fmt.Sprintf("ws-1:%d", target.ID)}},
+					},
+				}},
+			},
+		}
+
+		gt.NoError(t, slackUC.HandleAttachMessageSubmit(context.Background(), caseUC, cb)).Required()
+
+		msgs, _, err := repo.CaseMessage().List(context.Background(), "ws-1", target.ID, 10, "")
+		gt.NoError(t, err).Required()
+		gt.A(t, msgs).Length(1)
+		gt.A(t, *captured).Length(1).Required()
+		gt.Value(t, (*captured)[0]["response_type"]).Equal("ephemeral")
+		text, _ := (*captured)[0]["text"].(string)
+		gt.String(t, text).Contains("Attached the message to")
+	})
+}
@@ -42,6 +42,14 @@ func (m *saveDraftMockSlack) UpdateView(_ context.Context, view goslack.ModalVie
 	return nil
 }
 
+func (m *saveDraftMockSlack) PublishView(_ context.Context, _ string, _ goslack.HomeTabViewRequest) error {
+	return nil
+}
+
+func (m *saveDraftMockSlack) UnfurlMessage(_ context.Context, _, _ string, _ map[string]goslack.Attachment) error {
+	return nil
+}
+
 // newSaveDraftCallback builds a block_actions InteractionCallback whose
 // view carries the form state and private_metadata expected by
 // HandleSaveAsDraftClick. The callback's User is set to userID so the
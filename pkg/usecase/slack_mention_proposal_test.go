@@ -864,6 +864,13 @@ func (m *collectorOnlyMockSlack) OpenView(_ context.Context, triggerID string, v
 func (m *collectorOnlyMockSlack) UpdateView(_ context.Context, _ goslack.ModalViewRequest, _, _, _ string) error {
 	return nil
 }
+
+func (m *collectorOnlyMockSlack) PublishView(_ context.Context, _ string, _ goslack.HomeTabViewRequest) error {
+	return nil
+}
+func (m *collectorOnlyMockSlack) UnfurlMessage(_ context.Context, _, _ string, _ map[string]goslack.Attachment) error {
+	return nil
+}
 func (m *collectorOnlyMockSlack) ListUserGroups(context.Context, string) ([]slacksvc.UserGroup, error) {
 	return nil, nil
 }
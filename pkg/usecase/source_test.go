@@ -65,7 +65,10 @@ type mockSlackService struct {
 	getUserInfoFn            func(ctx context.Context, userID string) (*slack.User, error)
 	listUsersFn              func(ctx context.Context) ([]*slack.User, error)
 	createChannelFn          func(ctx context.Context, caseID int64, caseName string, prefix string) (string, error)
+	openViewFn               func(ctx context.Context, triggerID string, view goslack.ModalViewRequest) error
 	updateViewFn             func(ctx context.Context, view goslack.ModalViewRequest, externalID, hash, viewID string) error
+	publishViewFn            func(ctx context.Context, userID string, view goslack.HomeTabViewRequest) error
+	unfurlMessageFn          func(ctx context.Context, channelID, messageTS string, unfurls map[string]goslack.Attachment) error
 	renameChannelFn          func(ctx context.Context, channelID string, caseID int64, caseName string, prefix string) error
 	inviteUsersToChannelFn   func(ctx context.Context, channelID string, userIDs []string) error
 	addBookmarkFn            func(ctx context.Context, channelID, title, link string) error
@@ -248,6 +251,9 @@ func (m *mockSlackService) GetBotUserID(ctx context.Context) (string, error) {
 }
 
 func (m *mockSlackService) OpenView(ctx context.Context, triggerID string, view goslack.ModalViewRequest) error {
+	if m.openViewFn != nil {
+		return m.openViewFn(ctx, triggerID, view)
+	}
 	return nil
 }
 
@@ -258,6 +264,20 @@ func (m *mockSlackService) UpdateView(ctx context.Context, view goslack.ModalVie
 	return nil
 }
 
+func (m *mockSlackService) PublishView(ctx context.Context, userID string, view goslack.HomeTabViewRequest) error {
+	if m.publishViewFn != nil {
+		return m.publishViewFn(ctx, userID, view)
+	}
+	return nil
+}
+
+func (m *mockSlackService) UnfurlMessage(ctx context.Context, channelID, messageTS string, unfurls map[string]goslack.Attachment) error {
+	if m.unfurlMessageFn != nil {
+		return m.unfurlMessageFn(ctx, channelID, messageTS, unfurls)
+	}
+	return nil
+}
+
 func (m *mockSlackService) ListUserGroups(ctx context.Context, teamID string) ([]slack.UserGroup, error) {
 	if m.listUserGroupsFn != nil {
 		return m.listUserGroupsFn(ctx)
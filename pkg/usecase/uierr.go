@@ -19,7 +19,7 @@ import (
 //  1. an origin-authored uierr.UserFacing carried as a goerr typed value
 //     (the Slack API-failure path attaches this) — highest precision;
 //  2. a domain sentinel matched via errors.Is (access, field validation,
-//     workspace availability);
+//     closed case, workspace availability);
 //
 // It returns ok=false when nothing matches, so the caller falls back to
 // unexpectedUserFacing. It never inspects error message strings.
@@ -56,6 +56,15 @@ func classifyUserError(err error) (uierr.UserFacing, bool) {
 			Cause:       missingFieldNames(err),
 		}, true
 
+	case errors.Is(err, ErrCaseAlreadyClosed),
+		errors.Is(err, ErrCaseAlreadyMerged):
+		return uierr.UserFacing{
+			Kind:        uierr.KindValidation,
+			What:        i18n.MsgUIErrCaseClosedWhat,
+			Detail:      i18n.MsgUIErrCaseClosedDetail,
+			Remediation: i18n.MsgUIErrCaseClosedFix,
+		}, true
+
 	case errors.Is(err, ErrNoAccessibleWorkspace):
 		return uierr.UserFacing{
 			Kind:        uierr.KindPermission,
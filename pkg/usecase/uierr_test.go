@@ -49,6 +49,15 @@ func TestClassifyUserError(t *testing.T) {
 		gt.Value(t, got.Cause).Equal("Priority, Due date")
 	})
 
+	t.Run("closed or merged case", func(t *testing.T) {
+		for _, sentinel := range []error{usecase.ErrCaseAlreadyClosed, usecase.ErrCaseAlreadyMerged} {
+			got, ok := usecase.ClassifyUserErrorForTest(goerr.Wrap(sentinel, "attach message"))
+			gt.Bool(t, ok).True()
+			gt.Value(t, got.Kind).Equal(uierr.KindValidation)
+			gt.Value(t, got.What).Equal(i18n.MsgUIErrCaseClosedWhat)
+		}
+	})
+
 	t.Run("no accessible workspace maps to config", func(t *testing.T) {
 		err := goerr.Wrap(usecase.ErrNoAccessibleWorkspace, "resolve workspace")
 		got, ok := usecase.ClassifyUserErrorForTest(err)
@@ -37,15 +37,20 @@ type UseCases struct {
 	notificationSlotDuration time.Duration
 	dashboardStaleThreshold  time.Duration
 	homeMessageLLMClient     gollem.LLMClient
+	liveBus                  interfaces.LiveEventBus
 	Case                     *CaseUseCase
 	Action                   *ActionUseCase
 	Memo                     *MemoUseCase
 	Knowledge                *KnowledgeUseCase
+	KnowledgeReview          *KnowledgeReviewUseCase
+	ActionReminder           *ActionReminderUseCase
+	SLA                      *SLAUseCase
 	Tag                      *TagUseCase
 	ActionStep               *ActionStepUseCase
 	ActionComment            *ActionCommentUseCase
 	Agent                    *AgentUseCase
 	Auth                     AuthUseCaseInterface
+	APIToken                 *APITokenUseCase
 	Slack                    *SlackUseCases
 	Source                   *SourceUseCase
 	Assist                   *AssistUseCase
@@ -53,6 +58,19 @@ type UseCases struct {
 	JobRun                   *JobRunUseCase
 	Import                   *ImportUseCase
 	Dashboard                *DashboardUseCase
+	// AppHome renders the Slack App Home tab. Nil unless Slack is wired.
+	AppHome *AppHomeUseCase
+	// LinkUnfurl unfurls case and action links posted in Slack. Nil unless
+	// Slack is wired.
+	LinkUnfurl  *LinkUnfurlUseCase
+	Webhook     *WebhookUseCase
+	AlertIngest *AlertIngestUseCase
+	// Live serves the GraphQL subscriptions. Nil unless WithLiveEventBus is
+	// given.
+	Live *LiveUseCase
+	// Authorizer evaluates the Rego policy for GraphQL mutations and agent tool
+	// calls. Nil (no policy configured) allows everything.
+	Authorizer *PolicyAuthorizer
 }
 
 type Option func(*UseCases)
@@ -86,6 +104,15 @@ func WithSlackService(svc slack.Service) Option {
 	}
 }
 
+// WithPolicy installs the Rego policy that authorizes GraphQL mutations and
+// agent tool calls (see PolicyAuthorizer). Optional: without it nothing beyond
+// the built-in access rules is enforced.
+func WithPolicy(policy interfaces.PolicyClient) Option {
+	return func(uc *UseCases) {
+		uc.Authorizer = NewPolicyAuthorizer(policy)
+	}
+}
+
 func WithBaseURL(url string) Option {
 	return func(uc *UseCases) {
 		uc.baseURL = url
@@ -216,6 +243,15 @@ func WithHomeMessageLLMClient(client gollem.LLMClient) Option {
 	}
 }
 
+// WithLiveEventBus enables the GraphQL subscriptions, which wait on bus for
+// changes. The caller is responsible for making writes publish to it (see
+// livebus.PublishingRepository).
+func WithLiveEventBus(bus interfaces.LiveEventBus) Option {
+	return func(uc *UseCases) {
+		uc.liveBus = bus
+	}
+}
+
 func New(repo interfaces.Repository, registry *model.WorkspaceRegistry, opts ...Option) *UseCases {
 	uc := &UseCases{
 		repo:              repo,
@@ -240,11 +276,18 @@ func New(repo interfaces.Repository, registry *model.WorkspaceRegistry, opts ...
 	}
 
 	uc.Case = NewCaseUseCase(repo, registry, uc.slackService, uc.slackAdminService, uc.baseURL)
+	uc.Case.SetEmbedClient(uc.embedClient)
 	slotCoord := newNotificationSlotCoordinator(repo.NotificationSlot(), uc.slackService, uc.notificationSlotDuration, nil)
 	uc.Action = NewActionUseCase(repo, registry, uc.slackService, uc.baseURL, slotCoord)
 	uc.Memo = NewMemoUseCase(repo, registry)
 	uc.Knowledge = NewKnowledgeUseCase(repo, uc.embedClient)
+	uc.KnowledgeReview = NewKnowledgeReviewUseCase(repo, registry, uc.slackService, uc.baseURL)
+	uc.ActionReminder = NewActionReminderUseCase(repo, registry, uc.slackService, uc.baseURL, slotCoord)
+	uc.SLA = NewSLAUseCase(repo, registry)
 	uc.Tag = NewTagUseCase(repo)
+	uc.APIToken = NewAPITokenUseCase(repo, registry)
+	uc.Webhook = NewWebhookUseCase(repo, registry, uc.baseURL)
+	uc.AlertIngest = NewAlertIngestUseCase(repo, registry, uc.Case, uc.slackService)
 	uc.ActionStep = NewActionStepUseCase(repo, uc.slackService, slotCoord)
 	uc.ActionComment = NewActionCommentUseCase(repo, uc.slackService, uc.baseURL, slotCoord)
 
@@ -259,6 +302,9 @@ func New(repo interfaces.Repository, registry *model.WorkspaceRegistry, opts ...
 	uc.Source = NewSourceUseCase(repo, uc.notion, uc.slackService, githubSvc)
 	uc.JobRun = NewJobRunUseCase(repo, registry)
 	uc.Import = NewImportUseCase(repo, registry, uc.Case, uc.Action)
+	if uc.liveBus != nil {
+		uc.Live = NewLiveUseCase(uc.liveBus, uc.Case, uc.Action, uc.JobRun)
+	}
 
 	// Whenever Slack is wired, the LLM client must also be wired — Slack-driven
 	// flows (agent mention, mention-draft, assist) all require LLM by design.
@@ -319,6 +365,10 @@ func New(repo interfaces.Repository, registry *model.WorkspaceRegistry, opts ...
 		}
 	}
 	uc.Slack = NewSlackUseCases(repo, registry, uc.Agent, uc.MentionProposal, uc.slackService)
+	if uc.slackService != nil {
+		uc.LinkUnfurl = NewLinkUnfurlUseCase(repo, registry, uc.slackService, uc.baseURL)
+		uc.Slack.linkUnfurl = uc.LinkUnfurl
+	}
 
 	// Dashboard is built last so it sees option-set values (stale threshold,
 	// greeting LLM). The greeting uses a dedicated client when configured,
@@ -329,6 +379,10 @@ func New(repo interfaces.Repository, registry *model.WorkspaceRegistry, opts ...
 		homeMessageLLM = uc.llmClient
 	}
 	uc.Dashboard = newDashboardUseCase(repo, registry, uc.dashboardStaleThreshold, homeMessageLLM)
+	if uc.slackService != nil {
+		uc.AppHome = NewAppHomeUseCase(repo, registry, uc.Dashboard, uc.slackService, uc.liveBus, uc.baseURL)
+		uc.Slack.appHome = uc.AppHome
+	}
 
 	return uc
 }
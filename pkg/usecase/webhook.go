@@ -0,0 +1,395 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/interfaces"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/async"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/errutil"
+)
+
+const (
+	// webhookTimeout bounds one delivery attempt.
+	webhookTimeout = 10 * time.Second
+	// webhookLease is how long a claimed delivery is held for its attempt.
+	// It outlasts webhookTimeout, so a delivery is only picked up again by a
+	// sweep when the instance attempting it died mid-attempt.
+	webhookLease = time.Minute
+	// webhookSweepBatch caps the deliveries one sweep attempts per
+	// workspace; the rest wait for the next tick.
+	webhookSweepBatch = 100
+	// webhookErrorBodyLen caps the part of a failed response's body kept in
+	// the delivery's LastError.
+	webhookErrorBodyLen = 256
+
+	// DefaultWebhookDeliveryLimit and MaxWebhookDeliveryLimit bound a
+	// delivery history listing.
+	DefaultWebhookDeliveryLimit = 50
+	MaxWebhookDeliveryLimit     = 200
+)
+
+// WebhookUseCase delivers case and Job run events to the webhooks a
+// workspace declares in [[webhook]]. It implements CaseEventPublisher and
+// ActionEventPublisher, so it receives exactly the case events Jobs do, and
+// job.RunPublisher, so the Job runner tells it when a run starts and ends.
+//
+// An event is queued as one WebhookDelivery per matching webhook and
+// attempted at once. A failed attempt is retried with exponential backoff by
+// Sweep, which runs on every tick, until it succeeds or WebhookMaxAttempts is
+// reached and the delivery is dead-lettered.
+type WebhookUseCase struct {
+	repo     interfaces.Repository
+	registry *model.WorkspaceRegistry
+	baseURL  string
+	client   *http.Client
+}
+
+// NewWebhookUseCase constructs a WebhookUseCase.
+func NewWebhookUseCase(repo interfaces.Repository, registry *model.WorkspaceRegistry, baseURL string) *WebhookUseCase {
+	return &WebhookUseCase{
+		repo:     repo,
+		registry: registry,
+		baseURL:  baseURL,
+		client:   &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// PublishCaseLifecycle implements CaseEventPublisher.
+func (uc *WebhookUseCase) PublishCaseLifecycle(ctx context.Context, workspaceID string, c *model.Case, lifecycle model.CaseLifecycle, actorUserID string) {
+	uc.PublishCaseChange(ctx, workspaceID, c, model.CaseLifecycleChange(lifecycle), actorUserID)
+}
+
+// PublishCaseChange implements CaseEventPublisher and ActionEventPublisher.
+// It never fails the write that published the event: a delivery that cannot
+// be queued is reported and dropped.
+func (uc *WebhookUseCase) PublishCaseChange(ctx context.Context, workspaceID string, c *model.Case, change model.CaseChange, actorUserID string) {
+	uc.publish(ctx, workspaceID, c, model.WebhookEventTypeOf(change.Lifecycle), actorUserID, func(p *model.WebhookPayload) {
+		if c.IsPrivate {
+			return
+		}
+		if change.FieldID != "" || len(change.From) > 0 || len(change.To) > 0 || change.ActionID != 0 {
+			p.Change = &model.WebhookChange{
+				FieldID:  change.FieldID,
+				From:     change.From,
+				To:       change.To,
+				ActionID: change.ActionID,
+			}
+		}
+	})
+}
+
+// PublishJobRun implements job.RunPublisher. Like PublishCaseChange it never
+// fails the run: a delivery that cannot be queued is reported and dropped.
+func (uc *WebhookUseCase) PublishJobRun(ctx context.Context, workspaceID string, c *model.Case, change model.JobRunChange) {
+	uc.publish(ctx, workspaceID, c, model.WebhookJobRunEventTypeOf(change.Lifecycle), "", func(p *model.WebhookPayload) {
+		p.JobRun = &model.WebhookJobRun{JobID: change.JobID, RunID: change.RunID}
+		// The error can quote what the run read from the case.
+		if !c.IsPrivate {
+			p.JobRun.Error = change.Error
+		}
+	})
+}
+
+// publish queues event for every webhook of the workspace that listens to
+// it, and attempts each delivery. detail adds the event's own part to the
+// payload.
+func (uc *WebhookUseCase) publish(ctx context.Context, workspaceID string, c *model.Case, event model.WebhookEventType, actorUserID string, detail func(*model.WebhookPayload)) {
+	if uc == nil || uc.registry == nil || c == nil {
+		return
+	}
+	entry, err := uc.registry.Get(workspaceID)
+	if err != nil || len(entry.Webhooks) == 0 {
+		return
+	}
+
+	now := time.Now().UTC()
+	for _, w := range entry.Webhooks {
+		if !w.Listens(event) {
+			continue
+		}
+		d, err := uc.enqueue(ctx, workspaceID, w, event, c, actorUserID, detail, now)
+		if err != nil {
+			errutil.Handle(ctx, goerr.Wrap(err, "failed to queue webhook delivery",
+				goerr.V("workspace_id", workspaceID),
+				goerr.V("webhook_id", w.ID),
+				goerr.V("event", event),
+				goerr.V(CaseIDKey, c.ID)), "webhook delivery dropped")
+			continue
+		}
+		uc.dispatch(ctx, d)
+	}
+}
+
+func (uc *WebhookUseCase) enqueue(ctx context.Context, workspaceID string, w *model.Webhook, event model.WebhookEventType, c *model.Case, actorUserID string, detail func(*model.WebhookPayload), now time.Time) (*model.WebhookDelivery, error) {
+	d := &model.WebhookDelivery{
+		ID:            model.NewWebhookDeliveryID(),
+		WorkspaceID:   workspaceID,
+		WebhookID:     w.ID,
+		Event:         event,
+		CaseID:        c.ID,
+		Status:        model.WebhookDeliveryPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	payload := uc.payload(d, c, actorUserID)
+	detail(payload)
+	body, err := renderWebhookBody(w, payload)
+	if err != nil {
+		return nil, err
+	}
+	d.Payload = body
+	if err := uc.repo.WebhookDelivery().Create(ctx, d); err != nil {
+		return nil, goerr.Wrap(err, "failed to store webhook delivery")
+	}
+	return d, nil
+}
+
+// payload describes the event's case. A private case is sent as its ID
+// alone: a webhook receiver is outside the case's membership.
+func (uc *WebhookUseCase) payload(d *model.WebhookDelivery, c *model.Case, actorUserID string) *model.WebhookPayload {
+	p := &model.WebhookPayload{
+		DeliveryID:  d.ID.String(),
+		Event:       d.Event,
+		WorkspaceID: d.WorkspaceID,
+		OccurredAt:  d.CreatedAt,
+		ActorUserID: actorUserID,
+		Case: model.WebhookCase{
+			ID:        c.ID,
+			IsPrivate: c.IsPrivate,
+			URL:       uc.caseURL(d.WorkspaceID, c.ID),
+		},
+	}
+	if c.IsPrivate {
+		return p
+	}
+
+	p.Case.Title = c.Title
+	p.Case.Description = c.Description
+	p.Case.Status = c.Status.String()
+	p.Case.BoardStatus = c.BoardStatus
+	p.Case.ReporterID = c.ReporterID
+	p.Case.AssigneeIDs = c.AssigneeIDs
+	p.Case.IsTest = c.IsTest
+	return p
+}
+
+func (uc *WebhookUseCase) caseURL(workspaceID string, caseID int64) string {
+	if uc.baseURL == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/ws/%s/cases/%d", uc.baseURL, workspaceID, caseID)
+}
+
+// renderWebhookBody renders the request body: the webhook's template
+// executed over the payload, or the payload as JSON.
+func renderWebhookBody(w *model.Webhook, payload *model.WebhookPayload) (string, error) {
+	if w.Template == "" {
+		raw, err := json.Marshal(payload)
+		if err != nil {
+			return "", goerr.Wrap(err, "failed to encode webhook payload")
+		}
+		return string(raw), nil
+	}
+
+	tmpl, err := model.ParseWebhookTemplate(w.Template)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, payload); err != nil {
+		return "", goerr.Wrap(err, "failed to render webhook template", goerr.V("webhook_id", w.ID))
+	}
+	return buf.String(), nil
+}
+
+// dispatch attempts a delivery in the background, so the write that
+// published the event does not wait on the receiver.
+func (uc *WebhookUseCase) dispatch(ctx context.Context, d *model.WebhookDelivery) {
+	workspaceID, id := d.WorkspaceID, d.ID
+	async.Dispatch(ctx, func(ctx context.Context) error {
+		return uc.attempt(ctx, workspaceID, id, time.Now().UTC())
+	})
+}
+
+// Sweep attempts every due delivery of every workspace: the retries whose
+// backoff has elapsed, and the first attempts an instance stopped before
+// making. It backs the tick alongside the scheduled-Job scan.
+func (uc *WebhookUseCase) Sweep(ctx context.Context) error {
+	return uc.sweep(ctx, time.Now().UTC())
+}
+
+func (uc *WebhookUseCase) sweep(ctx context.Context, now time.Time) error {
+	if uc == nil || uc.registry == nil {
+		return nil
+	}
+	var errs []error
+	for _, entry := range uc.registry.List() {
+		due, err := uc.repo.WebhookDelivery().ListDue(ctx, entry.Workspace.ID, now, webhookSweepBatch)
+		if err != nil {
+			errs = append(errs, goerr.Wrap(err, "failed to list due webhook deliveries",
+				goerr.V("workspace_id", entry.Workspace.ID)))
+			continue
+		}
+		for _, d := range due {
+			if err := uc.attempt(ctx, d.WorkspaceID, d.ID, now); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// attempt makes one attempt of a delivery, if it is still due, and records
+// the outcome. A receiver refusing the request is an outcome, not an error;
+// the error return is for the queue itself failing.
+func (uc *WebhookUseCase) attempt(ctx context.Context, workspaceID string, id model.WebhookDeliveryID, now time.Time) error {
+	d, err := uc.repo.WebhookDelivery().Claim(ctx, workspaceID, id, now, now.Add(webhookLease))
+	if err != nil {
+		return goerr.Wrap(err, "failed to claim webhook delivery",
+			goerr.V("workspace_id", workspaceID), goerr.V("delivery_id", id))
+	}
+	if d == nil {
+		// Delivered, dead-lettered or being attempted elsewhere.
+		return nil
+	}
+
+	var w *model.Webhook
+	if entry, err := uc.registry.Get(workspaceID); err == nil {
+		w = entry.Webhook(d.WebhookID)
+	}
+	if w == nil {
+		d.Kill(now, "webhook is no longer configured")
+	} else {
+		statusCode, sendErr := uc.send(ctx, w, d, now)
+		done := time.Now().UTC()
+		if sendErr != nil {
+			d.RecordFailure(done, statusCode, sendErr.Error())
+		} else {
+			d.RecordSuccess(done, statusCode)
+		}
+	}
+
+	if err := uc.repo.WebhookDelivery().Update(ctx, d); err != nil {
+		return goerr.Wrap(err, "failed to record webhook delivery attempt",
+			goerr.V("workspace_id", workspaceID), goerr.V("delivery_id", id))
+	}
+	return nil
+}
+
+// send posts the delivery's payload to the webhook. statusCode is zero when
+// no response was received.
+func (uc *WebhookUseCase) send(ctx context.Context, w *model.Webhook, d *model.WebhookDelivery, now time.Time) (int, error) {
+	body := []byte(d.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, goerr.Wrap(err, "invalid webhook request")
+	}
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Hecatoncheires-Webhook")
+	req.Header.Set(model.WebhookEventHeader, string(d.Event))
+	req.Header.Set(model.WebhookDeliveryHeader, d.ID.String())
+	req.Header.Set(model.WebhookTimestampHeader, timestamp)
+	if w.Secret != "" {
+		req.Header.Set(model.WebhookSignatureHeader, "sha256="+model.WebhookSignature(w.Secret, timestamp, body))
+	}
+
+	resp, err := uc.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return resp.StatusCode, nil
+	}
+	excerpt, _ := io.ReadAll(io.LimitReader(resp.Body, webhookErrorBodyLen))
+	msg := resp.Status
+	if s := strings.TrimSpace(string(excerpt)); s != "" {
+		msg += ": " + s
+	}
+	return resp.StatusCode, errors.New(msg)
+}
+
+// ListDeliveries returns the workspace's delivery history, newest first. A
+// non-empty status narrows it, e.g. to the dead-letter list. limit defaults
+// to DefaultWebhookDeliveryLimit and is clamped to MaxWebhookDeliveryLimit.
+func (uc *WebhookUseCase) ListDeliveries(ctx context.Context, workspaceID string, status model.WebhookDeliveryStatus, limit int) ([]*model.WebhookDelivery, error) {
+	if _, err := uc.registry.Get(workspaceID); err != nil {
+		return nil, err
+	}
+	if status != "" && !status.IsValid() {
+		return nil, goerr.Wrap(ErrInvalidArgument, "unknown webhook delivery status", goerr.V("status", status))
+	}
+	if limit <= 0 {
+		limit = DefaultWebhookDeliveryLimit
+	}
+	limit = min(limit, MaxWebhookDeliveryLimit)
+
+	deliveries, err := uc.repo.WebhookDelivery().List(ctx, workspaceID, status, limit)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list webhook deliveries", goerr.V("workspace_id", workspaceID))
+	}
+	return deliveries, nil
+}
+
+// RetryDelivery returns a dead-lettered delivery to the queue with a fresh
+// set of attempts, and attempts it at once.
+func (uc *WebhookUseCase) RetryDelivery(ctx context.Context, workspaceID string, id model.WebhookDeliveryID) (*model.WebhookDelivery, error) {
+	if _, err := uc.registry.Get(workspaceID); err != nil {
+		return nil, err
+	}
+	d, err := uc.repo.WebhookDelivery().Get(ctx, workspaceID, id)
+	if err != nil {
+		if isRepoNotFound(err) {
+			return nil, goerr.Wrap(ErrWebhookDeliveryNotFound, "webhook delivery not found",
+				goerr.V("workspace_id", workspaceID), goerr.V("delivery_id", id))
+		}
+		return nil, goerr.Wrap(err, "failed to get webhook delivery",
+			goerr.V("workspace_id", workspaceID), goerr.V("delivery_id", id))
+	}
+	if d.Status != model.WebhookDeliveryDead {
+		return nil, goerr.Wrap(ErrInvalidArgument, "only a dead-lettered delivery can be retried",
+			goerr.V("delivery_id", id), goerr.V("status", d.Status))
+	}
+
+	d.Requeue(time.Now().UTC())
+	if err := uc.repo.WebhookDelivery().Update(ctx, d); err != nil {
+		return nil, goerr.Wrap(err, "failed to requeue webhook delivery",
+			goerr.V("workspace_id", workspaceID), goerr.V("delivery_id", id))
+	}
+	uc.dispatch(ctx, d)
+	return d, nil
+}
+
+// CaseEventPublishers fans each case event out to every publisher in it, in
+// order. It lets the Job dispatcher and the webhooks share the one publisher
+// slot of CaseUseCase and ActionUseCase.
+type CaseEventPublishers []CaseEventPublisher
+
+// PublishCaseLifecycle implements CaseEventPublisher.
+func (p CaseEventPublishers) PublishCaseLifecycle(ctx context.Context, workspaceID string, c *model.Case, lifecycle model.CaseLifecycle, actorUserID string) {
+	for _, pub := range p {
+		pub.PublishCaseLifecycle(ctx, workspaceID, c, lifecycle, actorUserID)
+	}
+}
+
+// PublishCaseChange implements CaseEventPublisher and ActionEventPublisher.
+func (p CaseEventPublishers) PublishCaseChange(ctx context.Context, workspaceID string, c *model.Case, change model.CaseChange, actorUserID string) {
+	for _, pub := range p {
+		pub.PublishCaseChange(ctx, workspaceID, c, change, actorUserID)
+	}
+}
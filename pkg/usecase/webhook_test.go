@@ -0,0 +1,296 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/model"
+	"github.com/secmon-lab/hecatoncheires/pkg/domain/types"
+	"github.com/secmon-lab/hecatoncheires/pkg/repository/memory"
+	"github.com/secmon-lab/hecatoncheires/pkg/usecase"
+	"github.com/secmon-lab/hecatoncheires/pkg/utils/async"
+)
+
+// webhookReceiver records the requests it is sent and answers with status.
+type webhookReceiver struct {
+	mu       sync.Mutex
+	requests []*http.Request
+	bodies   [][]byte
+	status   atomic.Int32
+}
+
+func newWebhookReceiver(t *testing.T) (*webhookReceiver, string) {
+	t.Helper()
+	r := &webhookReceiver{}
+	r.status.Store(http.StatusNoContent)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		r.mu.Lock()
+		r.requests = append(r.requests, req)
+		r.bodies = append(r.bodies, body)
+		r.mu.Unlock()
+		w.WriteHeader(int(r.status.Load()))
+		if r.status.Load() >= 300 {
+			_, _ = w.Write([]byte("receiver unavailable"))
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return r, srv.URL
+}
+
+func (r *webhookReceiver) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.requests)
+}
+
+func (r *webhookReceiver) last() (*http.Request, []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.requests[len(r.requests)-1], r.bodies[len(r.bodies)-1]
+}
+
+func setupWebhookUseCase(t *testing.T, webhooks ...*model.Webhook) (*usecase.WebhookUseCase, string) {
+	t.Helper()
+	ws := newWS()
+	registry := model.NewWorkspaceRegistry()
+	registry.Register(&model.WorkspaceEntry{
+		Workspace: model.Workspace{ID: ws, Name: "Test"},
+		Webhooks:  webhooks,
+	})
+	return usecase.NewWebhookUseCase(memory.New(), registry, "https://hc.example.com"), ws
+}
+
+func TestWebhookUseCase_DeliversSignedPayload(t *testing.T) {
+	ctx := context.Background()
+	recv, url := newWebhookReceiver(t)
+	uc, ws := setupWebhookUseCase(t, &model.Webhook{ID: "siem", URL: url, Secret: "s3cret"})
+
+	c := &model.Case{ID: 7, Title: "Leaked key", Status: types.CaseStatusOpen, AssigneeIDs: []string{"U1"}}
+	uc.PublishCaseChange(ctx, ws, c, model.CaseChange{
+		Lifecycle: model.CaseLifecycleAssigned, To: []string{"U1"},
+	}, "U9")
+	async.Wait()
+
+	gt.Value(t, recv.count()).Equal(1).Required()
+	req, body := recv.last()
+	gt.Value(t, req.Header.Get("Content-Type")).Equal("application/json")
+	gt.Value(t, req.Header.Get(model.WebhookEventHeader)).Equal("case.assigned")
+	timestamp := req.Header.Get(model.WebhookTimestampHeader)
+	gt.Value(t, req.Header.Get(model.WebhookSignatureHeader)).
+		Equal("sha256=" + model.WebhookSignature("s3cret", timestamp, body))
+
+	var payload model.WebhookPayload
+	gt.NoError(t, json.Unmarshal(body, &payload)).Required()
+	gt.Value(t, payload.Event).Equal(model.WebhookEventCaseAssigned)
+	gt.Value(t, payload.WorkspaceID).Equal(ws)
+	gt.Value(t, payload.ActorUserID).Equal("U9")
+	gt.Value(t, payload.Case.Title).Equal("Leaked key")
+	gt.Value(t, payload.Case.URL).Equal("https://hc.example.com/ws/" + ws + "/cases/7")
+	gt.Value(t, payload.Change).NotNil().Required()
+	gt.Array(t, payload.Change.To).Equal([]string{"U1"})
+	gt.Value(t, req.Header.Get(model.WebhookDeliveryHeader)).Equal(payload.DeliveryID)
+
+	deliveries, err := uc.ListDeliveries(ctx, ws, "", 0)
+	gt.NoError(t, err).Required()
+	gt.Array(t, deliveries).Length(1).Required()
+	gt.Value(t, deliveries[0].Status).Equal(model.WebhookDeliveryDelivered)
+	gt.Value(t, deliveries[0].Attempts).Equal(1)
+	gt.Value(t, deliveries[0].LastStatusCode).Equal(http.StatusNoContent)
+}
+
+func TestWebhookUseCase_EventFilterAndTemplate(t *testing.T) {
+	ctx := context.Background()
+	recv, url := newWebhookReceiver(t)
+	uc, ws := setupWebhookUseCase(t, &model.Webhook{
+		ID:       "chat",
+		URL:      url,
+		Events:   []model.WebhookEventType{model.WebhookEventCaseClosed},
+		Template: `{"text": {{ json (printf "closed: %s" .Case.Title) }}}`,
+	})
+	c := &model.Case{ID: 1, Title: `"quoted"`, Status: types.CaseStatusClosed}
+
+	uc.PublishCaseLifecycle(ctx, ws, c, model.CaseLifecycleCreated, "U1")
+	async.Wait()
+	gt.Value(t, recv.count()).Equal(0)
+
+	uc.PublishCaseLifecycle(ctx, ws, c, model.CaseLifecycleClosed, "U1")
+	async.Wait()
+	gt.Value(t, recv.count()).Equal(1).Required()
+	req, body := recv.last()
+	gt.Value(t, string(body)).Equal(`{"text": "closed: \"quoted\""}`)
+	gt.Value(t, req.Header.Get(model.WebhookSignatureHeader)).Equal("")
+}
+
+func TestWebhookUseCase_PrivateCaseSendsIDOnly(t *testing.T) {
+	ctx := context.Background()
+	recv, url := newWebhookReceiver(t)
+	uc, ws := setupWebhookUseCase(t, &model.Webhook{ID: "siem", URL: url})
+
+	c := &model.Case{ID: 3, Title: "secret incident", IsPrivate: true}
+	uc.PublishCaseChange(ctx, ws, c, model.CaseChange{
+		Lifecycle: model.CaseLifecycleFieldChanged, FieldID: "severity", To: []string{"high"},
+	}, "U1")
+	async.Wait()
+
+	gt.Value(t, recv.count()).Equal(1).Required()
+	_, body := recv.last()
+	var payload model.WebhookPayload
+	gt.NoError(t, json.Unmarshal(body, &payload)).Required()
+	gt.Value(t, payload.Case.ID).Equal(int64(3))
+	gt.Bool(t, payload.Case.IsPrivate).True()
+	gt.Value(t, payload.Case.Title).Equal("")
+	gt.Value(t, payload.Change).Nil()
+}
+
+func TestWebhookUseCase_PublishJobRun(t *testing.T) {
+	ctx := context.Background()
+	recv, url := newWebhookReceiver(t)
+	uc, ws := setupWebhookUseCase(t, &model.Webhook{
+		ID: "ops", URL: url, Events: []model.WebhookEventType{model.WebhookEventJobRunFailed},
+	})
+	failed := model.JobRunChange{
+		Lifecycle: model.JobRunLifecycleFailed, JobID: "triage", RunID: "run-1", Error: "llm down",
+	}
+
+	uc.PublishJobRun(ctx, ws, &model.Case{ID: 5, Title: "Leaked key"}, model.JobRunChange{
+		Lifecycle: model.JobRunLifecycleStarted, JobID: "triage", RunID: "run-1",
+	})
+	async.Wait()
+	gt.Value(t, recv.count()).Equal(0)
+
+	uc.PublishJobRun(ctx, ws, &model.Case{ID: 5, Title: "Leaked key"}, failed)
+	async.Wait()
+	gt.Value(t, recv.count()).Equal(1).Required()
+	req, body := recv.last()
+	gt.Value(t, req.Header.Get(model.WebhookEventHeader)).Equal("job_run.failed")
+	var payload model.WebhookPayload
+	gt.NoError(t, json.Unmarshal(body, &payload)).Required()
+	gt.Value(t, payload.Case.Title).Equal("Leaked key")
+	gt.Value(t, payload.Change).Nil()
+	gt.Value(t, payload.JobRun).NotNil().Required()
+	gt.Value(t, *payload.JobRun).Equal(model.WebhookJobRun{JobID: "triage", RunID: "run-1", Error: "llm down"})
+
+	// The error of a private case's run may quote the case; it is left out.
+	uc.PublishJobRun(ctx, ws, &model.Case{ID: 6, Title: "secret", IsPrivate: true}, failed)
+	async.Wait()
+	gt.Value(t, recv.count()).Equal(2).Required()
+	_, body = recv.last()
+	payload = model.WebhookPayload{}
+	gt.NoError(t, json.Unmarshal(body, &payload)).Required()
+	gt.Value(t, payload.Case.Title).Equal("")
+	gt.Value(t, payload.JobRun).NotNil().Required()
+	gt.Value(t, *payload.JobRun).Equal(model.WebhookJobRun{JobID: "triage", RunID: "run-1"})
+}
+
+func TestWebhookUseCase_RetriesThenDeadLetters(t *testing.T) {
+	ctx := context.Background()
+	recv, url := newWebhookReceiver(t)
+	recv.status.Store(http.StatusInternalServerError)
+	uc, ws := setupWebhookUseCase(t, &model.Webhook{ID: "siem", URL: url})
+
+	uc.PublishCaseLifecycle(ctx, ws, &model.Case{ID: 1, Title: "t"}, model.CaseLifecycleCreated, "U1")
+	async.Wait()
+
+	pending, err := uc.ListDeliveries(ctx, ws, model.WebhookDeliveryPending, 0)
+	gt.NoError(t, err).Required()
+	gt.Array(t, pending).Length(1).Required()
+	gt.Value(t, pending[0].Attempts).Equal(1)
+	gt.Value(t, pending[0].LastStatusCode).Equal(http.StatusInternalServerError)
+	gt.Value(t, pending[0].LastError).Equal("500 Internal Server Error: receiver unavailable")
+
+	// Not due yet: the backoff has not elapsed.
+	gt.NoError(t, uc.SweepAtForTest(ctx, time.Now().UTC())).Required()
+	gt.Value(t, recv.count()).Equal(1)
+
+	for i := 1; i < model.WebhookMaxAttempts; i++ {
+		gt.NoError(t, uc.SweepAtForTest(ctx, time.Now().UTC().Add(2*time.Hour))).Required()
+	}
+	gt.Value(t, recv.count()).Equal(model.WebhookMaxAttempts)
+
+	dead, err := uc.ListDeliveries(ctx, ws, model.WebhookDeliveryDead, 0)
+	gt.NoError(t, err).Required()
+	gt.Array(t, dead).Length(1).Required()
+
+	// A dead delivery is left alone by the sweep...
+	gt.NoError(t, uc.SweepAtForTest(ctx, time.Now().UTC().Add(2*time.Hour))).Required()
+	gt.Value(t, recv.count()).Equal(model.WebhookMaxAttempts)
+
+	// ...until it is retried by hand.
+	recv.status.Store(http.StatusOK)
+	retried, err := uc.RetryDelivery(ctx, ws, dead[0].ID)
+	gt.NoError(t, err).Required()
+	gt.Value(t, retried.Status).Equal(model.WebhookDeliveryPending)
+	async.Wait()
+
+	delivered, err := uc.ListDeliveries(ctx, ws, model.WebhookDeliveryDelivered, 0)
+	gt.NoError(t, err).Required()
+	gt.Array(t, delivered).Length(1).Required()
+	gt.Value(t, delivered[0].Attempts).Equal(1)
+
+	_, err = uc.RetryDelivery(ctx, ws, dead[0].ID)
+	gt.Error(t, err).Is(usecase.ErrInvalidArgument)
+	_, err = uc.RetryDelivery(ctx, ws, model.NewWebhookDeliveryID())
+	gt.Error(t, err).Is(usecase.ErrWebhookDeliveryNotFound)
+}
+
+func TestWebhookUseCase_RemovedWebhookIsDeadLettered(t *testing.T) {
+	ctx := context.Background()
+	_, url := newWebhookReceiver(t)
+	ws := newWS()
+	repo := memory.New()
+	registry := model.NewWorkspaceRegistry()
+	registry.Register(&model.WorkspaceEntry{
+		Workspace: model.Workspace{ID: ws, Name: "Test"},
+		Webhooks:  []*model.Webhook{{ID: "siem", URL: url}},
+	})
+	uc := usecase.NewWebhookUseCase(repo, registry, "")
+
+	d := &model.WebhookDelivery{
+		ID:            model.NewWebhookDeliveryID(),
+		WorkspaceID:   ws,
+		WebhookID:     "gone",
+		Event:         model.WebhookEventCaseCreated,
+		Payload:       "{}",
+		Status:        model.WebhookDeliveryPending,
+		NextAttemptAt: time.Now().UTC(),
+		CreatedAt:     time.Now().UTC(),
+	}
+	gt.NoError(t, repo.WebhookDelivery().Create(ctx, d)).Required()
+
+	gt.NoError(t, uc.SweepAtForTest(ctx, time.Now().UTC())).Required()
+	got, err := repo.WebhookDelivery().Get(ctx, ws, d.ID)
+	gt.NoError(t, err).Required()
+	gt.Value(t, got.Status).Equal(model.WebhookDeliveryDead)
+	gt.Value(t, got.LastError).Equal("webhook is no longer configured")
+}
+
+func TestWebhookUseCase_ListDeliveriesRejectsUnknownStatus(t *testing.T) {
+	uc, ws := setupWebhookUseCase(t)
+	_, err := uc.ListDeliveries(context.Background(), ws, "lost", 0)
+	gt.Error(t, err).Is(usecase.ErrInvalidArgument)
+}
+
+func TestCaseEventPublishers_FansOut(t *testing.T) {
+	ctx := context.Background()
+	recvA, urlA := newWebhookReceiver(t)
+	recvB, urlB := newWebhookReceiver(t)
+	ucA, wsA := setupWebhookUseCase(t, &model.Webhook{ID: "a", URL: urlA})
+	ucB, _ := setupWebhookUseCase(t, &model.Webhook{ID: "b", URL: urlB})
+
+	// Each publisher only knows its own workspace, so only A delivers; B is
+	// still called and ignores the unknown workspace.
+	pubs := usecase.CaseEventPublishers{ucA, ucB}
+	pubs.PublishCaseLifecycle(ctx, wsA, &model.Case{ID: 1}, model.CaseLifecycleCreated, "U1")
+	async.Wait()
+	gt.Value(t, recvA.count()).Equal(1)
+	gt.Value(t, recvB.count()).Equal(0)
+}